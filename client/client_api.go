@@ -16,6 +16,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -33,6 +34,9 @@ type ConfigItem = generic.ConfigItem
 type UpdateItem struct {
 	Message proto.Message
 	Labels  map[string]string
+	// Lease limits the lifetime of the item, after it expires the item
+	// is automatically removed. Zero value means unlimited lifetime.
+	Lease time.Duration
 }
 
 // GetValue exists so that UpdateItem satisfies datasync.LazyValue interface.
@@ -47,6 +51,10 @@ func (item UpdateItem) GetLabels() map[string]string {
 	return item.Labels
 }
 
+func (item UpdateItem) GetLease() time.Duration {
+	return item.Lease
+}
+
 type UpdateResult struct {
 	Key    string
 	Status *generic.ItemStatus
@@ -40,44 +40,79 @@ type names struct {
 // dynamically-created Config to read/write configuration from/to json/yaml files in the same way as it is
 // for hardcoded configurator.Config.
 var backwardCompatibleNames = map[string]names{
-	"netallocConfig.IPAllocation":      names{protoName: "ip_addresses", jsonName: "ipAddresses"},
-	"linuxConfig.Interface":            names{protoName: "interfaces", jsonName: "interfaces"},
-	"linuxConfig.ARPEntry":             names{protoName: "arp_entries", jsonName: "arpEntries"},
-	"linuxConfig.Route":                names{protoName: "routes", jsonName: "routes"},
-	"linuxConfig.RuleChain":            names{protoName: "RuleChain", jsonName: "RuleChain"},
-	"vppConfig.ABF":                    names{protoName: "abfs", jsonName: "abfs"},
-	"vppConfig.ACL":                    names{protoName: "acls", jsonName: "acls"},
-	"vppConfig.SecurityPolicyDatabase": names{protoName: "ipsec_spds", jsonName: "ipsecSpds"},
-	"vppConfig.SecurityPolicy":         names{protoName: "ipsec_sps", jsonName: "ipsecSps"},
-	"vppConfig.SecurityAssociation":    names{protoName: "ipsec_sas", jsonName: "ipsecSas"},
-	"vppConfig.TunnelProtection":       names{protoName: "ipsec_tunnel_protections", jsonName: "ipsecTunnelProtections"},
-	"vppConfig.Interface":              names{protoName: "interfaces", jsonName: "interfaces"},
-	"vppConfig.Span":                   names{protoName: "spans", jsonName: "spans"},
-	"vppConfig.IPFIX":                  names{protoName: "ipfix_global", jsonName: "ipfixGlobal"},
-	"vppConfig.FlowProbeParams":        names{protoName: "ipfix_flowprobe_params", jsonName: "ipfixFlowprobeParams"},
-	"vppConfig.FlowProbeFeature":       names{protoName: "ipfix_flowprobes", jsonName: "ipfixFlowprobes"},
-	"vppConfig.BridgeDomain":           names{protoName: "bridge_domains", jsonName: "bridgeDomains"},
-	"vppConfig.FIBEntry":               names{protoName: "fibs", jsonName: "fibs"},
-	"vppConfig.XConnectPair":           names{protoName: "xconnect_pairs", jsonName: "xconnectPairs"},
-	"vppConfig.ARPEntry":               names{protoName: "arps", jsonName: "arps"},
-	"vppConfig.Route":                  names{protoName: "routes", jsonName: "routes"},
-	"vppConfig.ProxyARP":               names{protoName: "proxy_arp", jsonName: "proxyArp"},
-	"vppConfig.IPScanNeighbor":         names{protoName: "ipscan_neighbor", jsonName: "ipscanNeighbor"},
-	"vppConfig.VrfTable":               names{protoName: "vrfs", jsonName: "vrfs"},
-	"vppConfig.DHCPProxy":              names{protoName: "dhcp_proxies", jsonName: "dhcpProxies"},
-	"vppConfig.L3XConnect":             names{protoName: "l3xconnects", jsonName: "l3xconnects"},
-	"vppConfig.TeibEntry":              names{protoName: "teib_entries", jsonName: "teibEntries"},
-	"vppConfig.Nat44Global":            names{protoName: "nat44_global", jsonName: "nat44Global"},
-	"vppConfig.DNat44":                 names{protoName: "dnat44s", jsonName: "dnat44s"},
-	"vppConfig.Nat44Interface":         names{protoName: "nat44_interfaces", jsonName: "nat44Interfaces"},
-	"vppConfig.Nat44AddressPool":       names{protoName: "nat44_pools", jsonName: "nat44Pools"},
-	"vppConfig.IPRedirect":             names{protoName: "punt_ipredirects", jsonName: "puntIpredirects"},
-	"vppConfig.ToHost":                 names{protoName: "punt_tohosts", jsonName: "puntTohosts"},
-	"vppConfig.Exception":              names{protoName: "punt_exceptions", jsonName: "puntExceptions"},
-	"vppConfig.LocalSID":               names{protoName: "srv6_localsids", jsonName: "srv6Localsids"},
-	"vppConfig.Policy":                 names{protoName: "srv6_policies", jsonName: "srv6Policies"},
-	"vppConfig.Steering":               names{protoName: "srv6_steerings", jsonName: "srv6Steerings"},
-	"vppConfig.SRv6Global":             names{protoName: "srv6_global", jsonName: "srv6Global"},
+	"netallocConfig.IPAllocation":       names{protoName: "ip_addresses", jsonName: "ipAddresses"},
+	"netallocConfig.IPPool":             names{protoName: "ip_pools", jsonName: "ipPools"},
+	"netallocConfig.ResourceAllocation": names{protoName: "resources", jsonName: "resources"},
+	"netallocConfig.ResourcePool":       names{protoName: "resource_pools", jsonName: "resourcePools"},
+	"linuxConfig.Interface":             names{protoName: "interfaces", jsonName: "interfaces"},
+	"linuxConfig.ARPEntry":              names{protoName: "arp_entries", jsonName: "arpEntries"},
+	"linuxConfig.Route":                 names{protoName: "routes", jsonName: "routes"},
+	"linuxConfig.RuleChain":             names{protoName: "RuleChain", jsonName: "RuleChain"},
+	"vppConfig.ABF":                     names{protoName: "abfs", jsonName: "abfs"},
+	"vppConfig.Policer":                 names{protoName: "policers", jsonName: "policers"},
+	"vppConfig.BfdSession":              names{protoName: "bfd_sessions", jsonName: "bfdSessions"},
+	"vppConfig.BfdAuthKey":              names{protoName: "bfd_auth_keys", jsonName: "bfdAuthKeys"},
+	"vppConfig.LCPGlobals":              names{protoName: "lcp_globals", jsonName: "lcpGlobals"},
+	"vppConfig.LCPInterfacePair":        names{protoName: "lcp_interface_pairs", jsonName: "lcpInterfacePairs"},
+	"vppConfig.Translation":             names{protoName: "cnat_translations", jsonName: "cnatTranslations"},
+	"vppConfig.SnatPolicy":              names{protoName: "cnat_snat_policy", jsonName: "cnatSnatPolicy"},
+	"vppConfig.SnatPolicyInterface":     names{protoName: "cnat_snat_policy_interfaces", jsonName: "cnatSnatPolicyInterfaces"},
+	"vppConfig.ACL":                     names{protoName: "acls", jsonName: "acls"},
+	"vppConfig.SecurityPolicyDatabase":  names{protoName: "ipsec_spds", jsonName: "ipsecSpds"},
+	"vppConfig.SecurityPolicy":          names{protoName: "ipsec_sps", jsonName: "ipsecSps"},
+	"vppConfig.SecurityAssociation":     names{protoName: "ipsec_sas", jsonName: "ipsecSas"},
+	"vppConfig.TunnelProtection":        names{protoName: "ipsec_tunnel_protections", jsonName: "ipsecTunnelProtections"},
+	"vppConfig.IPSecGlobal":             names{protoName: "ipsec_global", jsonName: "ipsecGlobal"},
+	"vppConfig.IKEv2Profile":            names{protoName: "ipsec_ikev2_profiles", jsonName: "ipsecIkev2Profiles"},
+	"vppConfig.Interface":               names{protoName: "interfaces", jsonName: "interfaces"},
+	"vppConfig.Span":                    names{protoName: "spans", jsonName: "spans"},
+	"vppConfig.IPFIX":                   names{protoName: "ipfix_global", jsonName: "ipfixGlobal"},
+	"vppConfig.FlowProbeParams":         names{protoName: "ipfix_flowprobe_params", jsonName: "ipfixFlowprobeParams"},
+	"vppConfig.FlowProbeFeature":        names{protoName: "ipfix_flowprobes", jsonName: "ipfixFlowprobes"},
+	"vppConfig.BridgeDomain":            names{protoName: "bridge_domains", jsonName: "bridgeDomains"},
+	"vppConfig.FIBEntry":                names{protoName: "fibs", jsonName: "fibs"},
+	"vppConfig.XConnectPair":            names{protoName: "xconnect_pairs", jsonName: "xconnectPairs"},
+	"vppConfig.ARPEntry":                names{protoName: "arps", jsonName: "arps"},
+	"vppConfig.Route":                   names{protoName: "routes", jsonName: "routes"},
+	"vppConfig.MultipathRoute":          names{protoName: "multipath_routes", jsonName: "multipathRoutes"},
+	"vppConfig.MRoute":                  names{protoName: "mroutes", jsonName: "mroutes"},
+	"vppConfig.ProxyARP":                names{protoName: "proxy_arp", jsonName: "proxyArp"},
+	"vppConfig.IPScanNeighbor":          names{protoName: "ipscan_neighbor", jsonName: "ipscanNeighbor"},
+	"vppConfig.VrfTable":                names{protoName: "vrfs", jsonName: "vrfs"},
+	"vppConfig.DHCPProxy":               names{protoName: "dhcp_proxies", jsonName: "dhcpProxies"},
+	"vppConfig.L3XConnect":              names{protoName: "l3xconnects", jsonName: "l3xconnects"},
+	"vppConfig.TeibEntry":               names{protoName: "teib_entries", jsonName: "teibEntries"},
+	"vppConfig.MplsTable":               names{protoName: "mpls_tables", jsonName: "mplsTables"},
+	"vppConfig.MplsInterface":           names{protoName: "mpls_interfaces", jsonName: "mplsInterfaces"},
+	"vppConfig.MplsRoute":               names{protoName: "mpls_routes", jsonName: "mplsRoutes"},
+	"vppConfig.Nat44Global":             names{protoName: "nat44_global", jsonName: "nat44Global"},
+	"vppConfig.DNat44":                  names{protoName: "dnat44s", jsonName: "dnat44s"},
+	"vppConfig.Nat44Interface":          names{protoName: "nat44_interfaces", jsonName: "nat44Interfaces"},
+	"vppConfig.Nat44AddressPool":        names{protoName: "nat44_pools", jsonName: "nat44Pools"},
+	"vppConfig.Nat64Global":             names{protoName: "nat64_global", jsonName: "nat64Global"},
+	"vppConfig.Nat64Interface":          names{protoName: "nat64_interfaces", jsonName: "nat64Interfaces"},
+	"vppConfig.Nat64AddressPool":        names{protoName: "nat64_pools", jsonName: "nat64Pools"},
+	"vppConfig.Nat64Prefix":             names{protoName: "nat64_prefixes", jsonName: "nat64Prefixes"},
+	"vppConfig.Nat64StaticBib":          names{protoName: "nat64_static_bibs", jsonName: "nat64StaticBibs"},
+	"vppConfig.Nat66Global":             names{protoName: "nat66_global", jsonName: "nat66Global"},
+	"vppConfig.Nat66Interface":          names{protoName: "nat66_interfaces", jsonName: "nat66Interfaces"},
+	"vppConfig.Nat66StaticMapping":      names{protoName: "nat66_static_mappings", jsonName: "nat66StaticMappings"},
+	"vppConfig.ClassifyTable":           names{protoName: "classify_tables", jsonName: "classifyTables"},
+	"vppConfig.ClassifySession":         names{protoName: "classify_sessions", jsonName: "classifySessions"},
+	"vppConfig.PolicerClassify":         names{protoName: "policer_classifies", jsonName: "policerClassifies"},
+	"vppConfig.QosEgressMap":            names{protoName: "qos_egress_maps", jsonName: "qosEgressMaps"},
+	"vppConfig.QosRecord":               names{protoName: "qos_records", jsonName: "qosRecords"},
+	"vppConfig.QosMark":                 names{protoName: "qos_marks", jsonName: "qosMarks"},
+	"vppConfig.SessionGlobal":           names{protoName: "session_global", jsonName: "sessionGlobal"},
+	"vppConfig.AppNamespace":            names{protoName: "app_namespaces", jsonName: "appNamespaces"},
+	"vppConfig.SessionRule":             names{protoName: "session_rules", jsonName: "sessionRules"},
+	"vppConfig.IPRedirect":              names{protoName: "punt_ipredirects", jsonName: "puntIpredirects"},
+	"vppConfig.ToHost":                  names{protoName: "punt_tohosts", jsonName: "puntTohosts"},
+	"vppConfig.Exception":               names{protoName: "punt_exceptions", jsonName: "puntExceptions"},
+	"vppConfig.LocalSID":                names{protoName: "srv6_localsids", jsonName: "srv6Localsids"},
+	"vppConfig.Policy":                  names{protoName: "srv6_policies", jsonName: "srv6Policies"},
+	"vppConfig.Steering":                names{protoName: "srv6_steerings", jsonName: "srv6Steerings"},
+	"vppConfig.SRv6Global":              names{protoName: "srv6_global", jsonName: "srv6Global"},
 }
 
 // NewDynamicConfig creates dynamically proto Message that contains all given configuration models(knowModels).
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.ligato.io/vpp-agent/v3/client"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
@@ -227,6 +229,7 @@ func (c *grpcClient) UpdateItems(ctx context.Context, items []client.UpdateItem,
 		req.Updates = append(req.Updates, &generic.UpdateItem{
 			Item:   item,
 			Labels: ui.Labels,
+			Lease:  leaseToProto(ui.Lease),
 		})
 	}
 	res, err := c.manager.SetConfig(ctx, req)
@@ -302,10 +305,12 @@ func (r *setItemRequest) update(delete bool, items ...client.UpdateItem) *setIte
 		if delete {
 			item.Data = nil
 			ui.Labels = nil
+			ui.Lease = 0
 		}
 		r.req.Updates = append(r.req.Updates, &generic.UpdateItem{
 			Item:   item,
 			Labels: ui.Labels,
+			Lease:  leaseToProto(ui.Lease),
 		})
 	}
 	return r
@@ -459,3 +464,10 @@ func protoMapToList(protoMap map[string]proto.Message) []proto.Message {
 	}
 	return result
 }
+
+func leaseToProto(lease time.Duration) *durationpb.Duration {
+	if lease <= 0 {
+		return nil
+	}
+	return durationpb.New(lease)
+}
@@ -14,6 +14,11 @@
 
 package types
 
+import (
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
 // ErrorResponse represents an error.
 type ErrorResponse struct {
 	Message string `json:"message"`
@@ -39,6 +44,53 @@ type Version struct {
 	OSType     string
 }
 
+// BfdSession contains BFD session together with its state as returned by
+// Agent REST API:
+// GET "/dump/vpp/v2/bfd/sessions"
+type BfdSession struct {
+	Session *vpp_bfd.BfdSession `json:"bfd_session"`
+	Meta    struct {
+		SwIfIndex uint32                        `json:"sw_if_index"`
+		State     vpp_bfd.BfdSessionState_State `json:"state"`
+	} `json:"bfd_session_meta"`
+}
+
+// Nat64BibEntry contains NAT64 BIB entry as returned by Agent REST API:
+// GET "/dump/vpp/v2/nat64/bib"
+type Nat64BibEntry struct {
+	VrfID       uint32                          `json:"vrf_id"`
+	Protocol    vpp_nat.Nat64StaticBib_Protocol `json:"protocol"`
+	InsideIP    string                          `json:"inside_ip"`
+	InsidePort  uint16                          `json:"inside_port"`
+	OutsideIP   string                          `json:"outside_ip"`
+	OutsidePort uint16                          `json:"outside_port"`
+	IsStatic    bool                            `json:"is_static"`
+	Sessions    uint32                          `json:"sessions"`
+}
+
+// Nat64Session contains NAT64 session as returned by Agent REST API:
+// GET "/dump/vpp/v2/nat64/sessions"
+type Nat64Session struct {
+	VrfID            uint32                          `json:"vrf_id"`
+	Protocol         vpp_nat.Nat64StaticBib_Protocol `json:"protocol"`
+	InsideLocalIP    string                          `json:"inside_local_ip"`
+	InsideLocalPort  uint16                          `json:"inside_local_port"`
+	OutsideLocalIP   string                          `json:"outside_local_ip"`
+	OutsideLocalPort uint16                          `json:"outside_local_port"`
+	InsideRemoteIP   string                          `json:"inside_remote_ip"`
+	OutsideRemoteIP  string                          `json:"outside_remote_ip"`
+	RemotePort       uint16                          `json:"remote_port"`
+}
+
+// Nat66StaticMapping contains NAT66 static mapping together with its counters
+// as returned by Agent REST API:
+// GET "/dump/vpp/v2/nat66/mappings"
+type Nat66StaticMapping struct {
+	Mapping      *vpp_nat.Nat66StaticMapping `json:"mapping"`
+	TotalPackets uint64                      `json:"total_packets"`
+	TotalBytes   uint64                      `json:"total_bytes"`
+}
+
 type Logger struct {
 	Logger string
 	Level  string `json:"level,omitempty"`
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	govppapi "go.fd.io/govpp/api"
@@ -15,6 +16,8 @@ import (
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
 )
 
 // APIClient is an interface that clients that talk with a agent server must implement.
@@ -64,6 +67,19 @@ type SchedulerAPIClient interface {
 type VppAPIClient interface {
 	VppStatsAPIClient
 	VppRunCli(ctx context.Context, cmd string) (reply string, err error)
+	VppGetBfdSessions(ctx context.Context) ([]types.BfdSession, error)
+	VppGetACLStats(ctx context.Context) ([]*vpp_acl.ACLStats, error)
+	VppGetACLSessions(ctx context.Context) ([]*vpp_acl.ACLInterfaceSessions, error)
+	VppGetNat64Bib(ctx context.Context) ([]types.Nat64BibEntry, error)
+	VppGetNat64Sessions(ctx context.Context) ([]types.Nat64Session, error)
+	VppGetNat66Mappings(ctx context.Context) ([]types.Nat66StaticMapping, error)
+	VppTraceStart(ctx context.Context, req *vpp_trace.StartTraceRequest) error
+	VppTraceGet(ctx context.Context, clear bool) ([]*vpp_trace.TracedPacket, error)
+	VppTraceClear(ctx context.Context) error
+	VppPcapStart(ctx context.Context, req *vpp_trace.StartPcapRequest) (*vpp_trace.PcapStatus, error)
+	VppPcapStop(ctx context.Context) (*vpp_trace.PcapStatus, error)
+	VppPcapStatus(ctx context.Context) (*vpp_trace.PcapStatus, error)
+	VppPcapDownload(ctx context.Context) (io.ReadCloser, error)
 }
 
 // VppStatsAPIClient defines stats API client methods for the VPP
@@ -18,8 +18,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
 
 	"go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
 )
 
 func (c *Client) VppRunCli(ctx context.Context, cmd string) (reply string, err error) {
@@ -36,6 +42,178 @@ func (c *Client) VppRunCli(ctx context.Context, cmd string) (reply string, err e
 	return reply, nil
 }
 
+// VppGetBfdSessions returns BFD sessions configured in VPP with their state.
+func (c *Client) VppGetBfdSessions(ctx context.Context) ([]types.BfdSession, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/bfd/sessions", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var sessions []types.BfdSession
+	if err := json.NewDecoder(resp.body).Decode(&sessions); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return sessions, nil
+}
+
+// VppGetACLStats returns hit counters of ACL rules.
+func (c *Client) VppGetACLStats(ctx context.Context) ([]*vpp_acl.ACLStats, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/acl/stats", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var stats []*vpp_acl.ACLStats
+	if err := json.NewDecoder(resp.body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return stats, nil
+}
+
+// VppGetACLSessions returns the number of reflexive ACL sessions for each interface.
+func (c *Client) VppGetACLSessions(ctx context.Context) ([]*vpp_acl.ACLInterfaceSessions, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/acl/sessions", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var sessions []*vpp_acl.ACLInterfaceSessions
+	if err := json.NewDecoder(resp.body).Decode(&sessions); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return sessions, nil
+}
+
+// VppGetNat64Bib returns NAT64 BIB entries (both static and dynamic) from VPP.
+func (c *Client) VppGetNat64Bib(ctx context.Context) ([]types.Nat64BibEntry, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/nat64/bib", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var bib []types.Nat64BibEntry
+	if err := json.NewDecoder(resp.body).Decode(&bib); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return bib, nil
+}
+
+// VppGetNat64Sessions returns NAT64 sessions from VPP.
+func (c *Client) VppGetNat64Sessions(ctx context.Context) ([]types.Nat64Session, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/nat64/sessions", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var sessions []types.Nat64Session
+	if err := json.NewDecoder(resp.body).Decode(&sessions); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return sessions, nil
+}
+
+// VppGetNat66Mappings returns NAT66 static mappings configured in VPP with their counters.
+func (c *Client) VppGetNat66Mappings(ctx context.Context) ([]types.Nat66StaticMapping, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/nat66/mappings", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var mappings []types.Nat66StaticMapping
+	if err := json.NewDecoder(resp.body).Decode(&mappings); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return mappings, nil
+}
+
+// VppTraceStart starts VPP packet tracer.
+func (c *Client) VppTraceStart(ctx context.Context, req *vpp_trace.StartTraceRequest) error {
+	resp, err := c.post(ctx, "/vpp/trace/start", nil, req, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return fmt.Errorf("HTTP POST request failed: %v", err)
+	}
+	return nil
+}
+
+// VppTraceGet returns packets captured by VPP packet tracer, the trace is
+// cleared afterwards if clear is true.
+func (c *Client) VppTraceGet(ctx context.Context, clear bool) ([]*vpp_trace.TracedPacket, error) {
+	query := url.Values{}
+	if clear {
+		query.Set("clear", "true")
+	}
+	resp, err := c.get(ctx, "/vpp/trace", query, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	var reply vpp_trace.GetTraceResponse
+	if err := json.NewDecoder(resp.body).Decode(&reply); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return reply.Packets, nil
+}
+
+// VppTraceClear removes packets captured by VPP packet tracer.
+func (c *Client) VppTraceClear(ctx context.Context) error {
+	resp, err := c.post(ctx, "/vpp/trace/clear", nil, nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return fmt.Errorf("HTTP POST request failed: %v", err)
+	}
+	return nil
+}
+
+// VppPcapStart starts pcap capture in VPP.
+func (c *Client) VppPcapStart(ctx context.Context, req *vpp_trace.StartPcapRequest) (*vpp_trace.PcapStatus, error) {
+	resp, err := c.post(ctx, "/vpp/pcap/start", nil, req, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP POST request failed: %v", err)
+	}
+	return decodePcapStatus(resp)
+}
+
+// VppPcapStop stops running pcap capture in VPP.
+func (c *Client) VppPcapStop(ctx context.Context) (*vpp_trace.PcapStatus, error) {
+	resp, err := c.post(ctx, "/vpp/pcap/stop", nil, nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP POST request failed: %v", err)
+	}
+	return decodePcapStatus(resp)
+}
+
+// VppPcapStatus returns status of the last pcap capture.
+func (c *Client) VppPcapStatus(ctx context.Context) (*vpp_trace.PcapStatus, error) {
+	resp, err := c.get(ctx, "/vpp/pcap", nil, nil)
+	defer ensureReaderClosed(resp)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	return decodePcapStatus(resp)
+}
+
+// VppPcapDownload returns content of the pcap file of the last capture.
+// The returned reader must be closed by the caller.
+func (c *Client) VppPcapDownload(ctx context.Context) (io.ReadCloser, error) {
+	resp, err := c.get(ctx, "/vpp/pcap/download", nil, nil)
+	if err != nil {
+		ensureReaderClosed(resp)
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	return resp.body, nil
+}
+
+func decodePcapStatus(resp serverResponse) (*vpp_trace.PcapStatus, error) {
+	var status vpp_trace.PcapStatus
+	if err := json.NewDecoder(resp.body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return &status, nil
+}
+
 func (c *Client) VppGetStats(ctx context.Context, typ string) error {
 	// TODO: implement more generic stats provider that goes beyond GoVPP StatsProvider (git.fd.io/govpp/api/stats.go)
 	//  and can dump any possible stats or all of them (just like in stats dump example in
@@ -25,11 +25,13 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
 	agentcli "go.ligato.io/vpp-agent/v3/cmd/agentctl/cli"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 )
 
 func NewDumpCommand(cli agentcli.Cli) *cobra.Command {
@@ -50,6 +52,9 @@ func NewDumpCommand(cli agentcli.Cli) *cobra.Command {
 # Dump all VPP data in JSON format
 {{.CommandPath}} -f json vpp.*
 
+# Dump VPP ACLs together with their rule hit counters
+{{.CommandPath}} vpp.acls
+
 # Dump only VPP memif interfaces
 {{.CommandPath}} -f '{{` + "`{{range .}}{{if eq .Value.Type.String \"MEMIF\" }}{{json .}}{{end}}{{end}}`" + `}}' vpp.interfaces
 
@@ -164,11 +169,13 @@ func runDump(cli agentcli.Cli, opts DumpOptions) error {
 		return dumps[i].Key < dumps[j].Key
 	})
 
+	stats := dumpStats(ctx, cli, dumps)
+
 	format := opts.Format
 	if len(format) == 0 {
-		printDumpTable(cli.Out(), dumps)
+		printDumpTable(cli.Out(), dumps, stats)
 	} else {
-		fdumps, err := convertDumps(dumps)
+		fdumps, err := convertDumps(dumps, stats)
 		if err != nil {
 			return err
 		}
@@ -179,6 +186,31 @@ func runDump(cli agentcli.Cli, opts DumpOptions) error {
 	return nil
 }
 
+// dumpStats returns runtime statistics of the dumped items that are not part
+// of the scheduler dump (ACL rule hit counters), indexed by the item key.
+func dumpStats(ctx context.Context, cli agentcli.Cli, dumps []api.RecordedKVWithMetadata) map[string]proto.Message {
+	var hasACLs bool
+	for _, d := range dumps {
+		if vpp_acl.ModelACL.IsKeyValid(d.Key) {
+			hasACLs = true
+			break
+		}
+	}
+	if !hasACLs {
+		return nil
+	}
+	aclStats, err := cli.Client().VppGetACLStats(ctx)
+	if err != nil {
+		logging.Debugf("getting ACL stats failed: %v", err)
+		return nil
+	}
+	stats := make(map[string]proto.Message, len(aclStats))
+	for _, aclStat := range aclStats {
+		stats[vpp_acl.Key(aclStat.GetAclName())] = aclStat
+	}
+	return stats
+}
+
 func filterDumpByOrigin(dumps []api.RecordedKVWithMetadata, origin string) []api.RecordedKVWithMetadata {
 	if origin == "" {
 		return dumps
@@ -193,7 +225,7 @@ func filterDumpByOrigin(dumps []api.RecordedKVWithMetadata, origin string) []api
 	return filtered
 }
 
-func printDumpTable(out io.Writer, dump []api.RecordedKVWithMetadata) {
+func printDumpTable(out io.Writer, dump []api.RecordedKVWithMetadata, stats map[string]proto.Message) {
 	table := tablewriter.NewWriter(out)
 	table.SetHeader([]string{
 		"Model", "Origin", "Value", "Metadata", "Key",
@@ -208,6 +240,9 @@ func printDumpTable(out io.Writer, dump []api.RecordedKVWithMetadata) {
 		if d.Metadata != nil {
 			meta = yamlTmpl(d.Metadata)
 		}
+		if st, ok := stats[d.Key]; ok {
+			meta += fmt.Sprintf("# %s\n%s", st.ProtoReflect().Descriptor().FullName(), yamlTmpl(st))
+		}
 		var (
 			name  = "-"
 			model string
@@ -239,9 +274,10 @@ type formatDump struct {
 	Value    map[string]interface{}
 	Metadata api.Metadata
 	Origin   api.ValueOrigin
+	Stats    map[string]interface{} `json:",omitempty"`
 }
 
-func convertDumps(in []api.RecordedKVWithMetadata) (out []formatDump, err error) {
+func convertDumps(in []api.RecordedKVWithMetadata, stats map[string]proto.Message) (out []formatDump, err error) {
 	for _, d := range in {
 		b, err := d.Value.MarshalJSON()
 		if err != nil {
@@ -257,12 +293,18 @@ func convertDumps(in []api.RecordedKVWithMetadata) (out []formatDump, err error)
 		// field name a part of the public kvscheduler API so we do not have to rely
 		// on string key here.
 		if val, ok := values["ProtoMsgData"]; ok {
-			out = append(out, formatDump{
+			fdump := formatDump{
 				Key:      d.Key,
 				Value:    val.(map[string]interface{}),
 				Metadata: d.Metadata,
 				Origin:   d.Origin,
-			})
+			}
+			if st, ok := stats[d.Key]; ok {
+				if err = json.Unmarshal(encodeJson(st, ""), &fdump.Stats); err != nil {
+					return nil, err
+				}
+			}
+			out = append(out, fdump)
 		}
 	}
 	return out, nil
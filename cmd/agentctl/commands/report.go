@@ -484,7 +484,7 @@ func writeKVschedulerReport(subTaskActionName string, view string, ignoreModels
 	sort.Slice(dumps, func(i, j int) bool {
 		return dumps[i].Key < dumps[j].Key
 	})
-	printDumpTable(w, dumps)
+	printDumpTable(w, dumps, nil)
 
 	// error handling
 	if len(errs) > 0 {
@@ -17,11 +17,20 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
 	agentcli "go.ligato.io/vpp-agent/v3/cmd/agentctl/cli"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
 )
 
 func NewVppCommand(cli agentcli.Cli) *cobra.Command {
@@ -32,6 +41,12 @@ func NewVppCommand(cli agentcli.Cli) *cobra.Command {
 	cmd.AddCommand(
 		newVppCliCommand(cli),
 		newVppInfoCommand(cli),
+		newVppBfdCommand(cli),
+		newVppACLCommand(cli),
+		newVppNat64Command(cli),
+		newVppNat66Command(cli),
+		newVppTraceCommand(cli),
+		newVppPcapCommand(cli),
 	)
 	return cmd
 }
@@ -105,3 +120,524 @@ func runVppInfo(cli agentcli.Cli) error {
 
 	return nil
 }
+
+func newVppBfdCommand(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bfd",
+		Short: "Show state of BFD sessions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVppBfd(cli)
+		},
+		SilenceUsage: true,
+	}
+	return cmd
+}
+
+func runVppBfd(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sessions, err := cli.Client().VppGetBfdSessions(ctx)
+	if err != nil {
+		return err
+	}
+	printBfdSessions(cli.Out(), sessions)
+	return nil
+}
+
+func printBfdSessions(out io.Writer, sessions []types.BfdSession) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "INTERFACE\tLOCAL IP\tPEER IP\tSTATE\t\n")
+	for _, s := range sessions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", s.Session.GetInterface(),
+			s.Session.GetLocalIp(), s.Session.GetPeerIp(), s.Meta.State)
+	}
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func newVppACLCommand(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acl",
+		Short: "Show ACL counters",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "stats",
+			Short: "Show hit counters of ACL rules (requires enable-stats in ACL plugin config)",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppACLStats(cli)
+			},
+			SilenceUsage: true,
+		},
+		&cobra.Command{
+			Use:   "sessions",
+			Short: "Show reflexive ACL sessions per interface",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppACLSessions(cli)
+			},
+			SilenceUsage: true,
+		},
+	)
+	return cmd
+}
+
+func runVppACLStats(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stats, err := cli.Client().VppGetACLStats(ctx)
+	if err != nil {
+		return err
+	}
+	printACLStats(cli.Out(), stats)
+	return nil
+}
+
+func printACLStats(out io.Writer, stats []*vpp_acl.ACLStats) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ACL\tINDEX\tRULE\tPACKETS\tBYTES\t\n")
+	for _, acl := range stats {
+		for _, rule := range acl.GetRules() {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t\n", acl.GetAclName(), acl.GetAclIndex(),
+				rule.GetRuleIndex(), rule.GetPackets(), rule.GetBytes())
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func runVppACLSessions(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sessions, err := cli.Client().VppGetACLSessions(ctx)
+	if err != nil {
+		return err
+	}
+	printACLSessions(cli.Out(), sessions)
+	return nil
+}
+
+func printACLSessions(out io.Writer, sessions []*vpp_acl.ACLInterfaceSessions) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "INTERFACE\tSESSIONS\tADDED\tDELETED\t\n")
+	for _, s := range sessions {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t\n", s.GetInterface(), s.GetSessions(),
+			s.GetAdded(), s.GetDeleted())
+	}
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func newVppNat64Command(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nat64",
+		Short: "Show NAT64 state",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "bib",
+			Short: "Show NAT64 BIB entries",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppNat64Bib(cli)
+			},
+			SilenceUsage: true,
+		},
+		&cobra.Command{
+			Use:   "sessions",
+			Short: "Show NAT64 sessions",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppNat64Sessions(cli)
+			},
+			SilenceUsage: true,
+		},
+	)
+	return cmd
+}
+
+func runVppNat64Bib(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bib, err := cli.Client().VppGetNat64Bib(ctx)
+	if err != nil {
+		return err
+	}
+	printNat64Bib(cli.Out(), bib)
+	return nil
+}
+
+func printNat64Bib(out io.Writer, bib []types.Nat64BibEntry) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "VRF\tPROTOCOL\tINSIDE\tOUTSIDE\tSTATIC\tSESSIONS\t\n")
+	for _, e := range bib {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%t\t%d\t\n", e.VrfID, e.Protocol,
+			hostPort(e.InsideIP, e.InsidePort), hostPort(e.OutsideIP, e.OutsidePort),
+			e.IsStatic, e.Sessions)
+	}
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func runVppNat64Sessions(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sessions, err := cli.Client().VppGetNat64Sessions(ctx)
+	if err != nil {
+		return err
+	}
+	printNat64Sessions(cli.Out(), sessions)
+	return nil
+}
+
+func printNat64Sessions(out io.Writer, sessions []types.Nat64Session) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "VRF\tPROTOCOL\tINSIDE LOCAL\tOUTSIDE LOCAL\tINSIDE REMOTE\tOUTSIDE REMOTE\t\n")
+	for _, s := range sessions {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t\n", s.VrfID, s.Protocol,
+			hostPort(s.InsideLocalIP, s.InsideLocalPort), hostPort(s.OutsideLocalIP, s.OutsideLocalPort),
+			hostPort(s.InsideRemoteIP, s.RemotePort), hostPort(s.OutsideRemoteIP, s.RemotePort))
+	}
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func newVppNat66Command(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nat66",
+		Short: "Show NAT66 static mappings with their counters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVppNat66(cli)
+		},
+		SilenceUsage: true,
+	}
+	return cmd
+}
+
+func runVppNat66(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mappings, err := cli.Client().VppGetNat66Mappings(ctx)
+	if err != nil {
+		return err
+	}
+	printNat66Mappings(cli.Out(), mappings)
+	return nil
+}
+
+func printNat66Mappings(out io.Writer, mappings []types.Nat66StaticMapping) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "VRF\tLOCAL IP\tEXTERNAL IP\tPACKETS\tBYTES\t\n")
+	for _, m := range mappings {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%d\t\n", m.Mapping.GetVrfId(),
+			m.Mapping.GetLocalIp(), m.Mapping.GetExternalIp(), m.TotalPackets, m.TotalBytes)
+	}
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func newVppTraceCommand(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Trace packets processed by VPP",
+		Example: `
+# Trace 10 packets received on memif interfaces
+{{.CommandPath}} start --count 10 memif-input
+
+# Trace only packets matched by classify table 'icmp'
+{{.CommandPath}} start --classify-table icmp af-packet-input
+
+# Show traced packets and clear the trace
+{{.CommandPath}} show --clear
+`,
+	}
+	cmd.AddCommand(
+		newVppTraceStartCommand(cli),
+		newVppTraceShowCommand(cli),
+		&cobra.Command{
+			Use:   "clear",
+			Short: "Clear traced packets",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppTraceClear(cli)
+			},
+			SilenceUsage: true,
+		},
+	)
+	return cmd
+}
+
+func newVppTraceStartCommand(cli agentcli.Cli) *cobra.Command {
+	var req vpp_trace.StartTraceRequest
+	cmd := &cobra.Command{
+		Use:   "start INPUT-NODE...",
+		Short: "Start tracing of packets arriving to the given input nodes",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.InputNodes = args
+			return runVppTraceStart(cli, &req)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.Uint32VarP(&req.Count, "count", "n", 0, "Number of packets traced on each input node (0 = agent default)")
+	flags.StringVar(&req.ClassifyTable, "classify-table", "", "Trace only packets matched by the classify table")
+	flags.BoolVar(&req.Verbose, "verbose", false, "Enable detailed trace output")
+	return cmd
+}
+
+func runVppTraceStart(cli agentcli.Cli, req *vpp_trace.StartTraceRequest) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := cli.Client().VppTraceStart(ctx, req); err != nil {
+		return err
+	}
+	fmt.Fprintf(cli.Out(), "Trace started on: %s\n", strings.Join(req.InputNodes, ", "))
+	return nil
+}
+
+func newVppTraceShowCommand(cli agentcli.Cli) *cobra.Command {
+	var clear bool
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show traced packets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVppTraceShow(cli, clear)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().BoolVar(&clear, "clear", false, "Clear the trace after it is shown")
+	return cmd
+}
+
+func runVppTraceShow(cli agentcli.Cli, clear bool) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	packets, err := cli.Client().VppTraceGet(ctx, clear)
+	if err != nil {
+		return err
+	}
+	printTracedPackets(cli.Out(), packets)
+	return nil
+}
+
+func printTracedPackets(out io.Writer, packets []*vpp_trace.TracedPacket) {
+	if len(packets) == 0 {
+		fmt.Fprintln(out, "No packets in trace buffer")
+		return
+	}
+	for i, p := range packets {
+		fmt.Fprintf(out, "Packet %d (thread %d)\n\n", i+1, p.GetThreadId())
+		for _, n := range p.GetNodes() {
+			fmt.Fprintf(out, "%s: %s\n", n.GetTimestamp(), n.GetName())
+			for _, line := range strings.Split(n.GetData(), "\n") {
+				if line != "" {
+					fmt.Fprintf(out, "  %s\n", line)
+				}
+			}
+		}
+		fmt.Fprintln(out)
+	}
+}
+
+func runVppTraceClear(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	return cli.Client().VppTraceClear(ctx)
+}
+
+func newVppPcapCommand(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pcap",
+		Short: "Capture packets processed by VPP into pcap file",
+		Example: `
+# Capture received and transmitted packets of interface 'memif1' for 1 minute
+{{.CommandPath}} start --interface memif1 --duration 1m
+
+# Stop the capture before the duration elapses
+{{.CommandPath}} stop
+
+# Download the captured packets
+{{.CommandPath}} download -o memif1.pcap
+`,
+	}
+	cmd.AddCommand(
+		newVppPcapStartCommand(cli),
+		&cobra.Command{
+			Use:   "stop",
+			Short: "Stop running capture",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppPcapStop(cli)
+			},
+			SilenceUsage: true,
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "Show status of the last capture",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runVppPcapStatus(cli)
+			},
+			SilenceUsage: true,
+		},
+		newVppPcapDownloadCommand(cli),
+	)
+	return cmd
+}
+
+func newVppPcapStartCommand(cli agentcli.Cli) *cobra.Command {
+	var (
+		req      vpp_trace.StartPcapRequest
+		duration time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start capture of packets",
+		Long: `Start capture of packets. The capture is stopped automatically after the
+duration elapses. The duration and the packet limits are capped by the agent
+configuration.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.DurationSec = uint32(duration.Round(time.Second) / time.Second)
+			return runVppPcapStart(cli, &req)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&req.Interface, "interface", "i", "", "Capture packets of the interface (all interfaces if empty)")
+	flags.BoolVar(&req.CaptureRx, "rx", false, "Capture received packets")
+	flags.BoolVar(&req.CaptureTx, "tx", false, "Capture transmitted packets")
+	flags.BoolVar(&req.CaptureDrop, "drop", false, "Capture dropped packets")
+	flags.Uint32Var(&req.MaxPackets, "max-packets", 0, "Maximum number of captured packets (0 = agent default)")
+	flags.Uint32Var(&req.MaxBytesPerPacket, "max-bytes", 0, "Maximum number of captured bytes of each packet (0 = agent default)")
+	flags.DurationVar(&duration, "duration", 0, "Duration of the capture (0 = agent default)")
+	return cmd
+}
+
+func runVppPcapStart(cli agentcli.Cli, req *vpp_trace.StartPcapRequest) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	status, err := cli.Client().VppPcapStart(ctx, req)
+	if err != nil {
+		return err
+	}
+	printPcapStatus(cli.Out(), status)
+	return nil
+}
+
+func runVppPcapStop(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	status, err := cli.Client().VppPcapStop(ctx)
+	if err != nil {
+		return err
+	}
+	printPcapStatus(cli.Out(), status)
+	return nil
+}
+
+func runVppPcapStatus(cli agentcli.Cli) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	status, err := cli.Client().VppPcapStatus(ctx)
+	if err != nil {
+		return err
+	}
+	printPcapStatus(cli.Out(), status)
+	return nil
+}
+
+func printPcapStatus(out io.Writer, status *vpp_trace.PcapStatus) {
+	if status.GetStartTime() == 0 {
+		fmt.Fprintln(out, "No capture was taken")
+		return
+	}
+	state := "stopped"
+	stopLabel := "STOPPED"
+	if status.GetRunning() {
+		state = "running"
+		stopLabel = "STOPS"
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "STATE\tSTARTED\t%s\tMAX PACKETS\tMAX BYTES\tFILE\t\n", stopLabel)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\t\n", state,
+		time.Unix(status.GetStartTime(), 0).Format(time.RFC3339),
+		time.Unix(status.GetStopTime(), 0).Format(time.RFC3339),
+		status.GetMaxPackets(), status.GetMaxBytesPerPacket(), status.GetFilename())
+	if err := w.Flush(); err != nil {
+		return
+	}
+}
+
+func newVppPcapDownloadCommand(cli agentcli.Cli) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download pcap file of the last capture",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVppPcapDownload(cli, output)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "vpp.pcap", "Output file (- for stdout)")
+	return cmd
+}
+
+func runVppPcapDownload(cli agentcli.Cli, output string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	data, err := cli.Client().VppPcapDownload(ctx)
+	if err != nil {
+		return err
+	}
+	defer data.Close()
+
+	if output == "-" {
+		_, err = io.Copy(cli.Out(), data)
+		return err
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(file, data)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing pcap file failed: %v", err)
+	}
+	fmt.Fprintf(cli.Out(), "Pcap file saved to %s (%d bytes)\n", output, n)
+	return nil
+}
+
+// hostPort formats IP address and port as host:port (IPv6 addresses are
+// enclosed in square brackets).
+func hostPort(ip string, port uint16) string {
+	return net.JoinHostPort(ip, strconv.Itoa(int(port)))
+}
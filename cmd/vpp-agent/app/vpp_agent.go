@@ -41,16 +41,24 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/dnsplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin"
 )
 
@@ -79,6 +87,7 @@ type VPPAgent struct {
 	Probe        *probe.Plugin
 	StatusCheck  *statuscheck.Plugin
 	Telemetry    *telemetry.Plugin
+	Trace        *traceplugin.TracePlugin
 }
 
 // New creates new VPPAgent instance.
@@ -149,6 +158,7 @@ func New() *VPPAgent {
 		Probe:          &probe.DefaultPlugin,
 		StatusCheck:    &statuscheck.DefaultPlugin,
 		Telemetry:      &telemetry.DefaultPlugin,
+		Trace:          &traceplugin.DefaultPlugin,
 	}
 }
 
@@ -175,36 +185,50 @@ func (a *VPPAgent) Close() error {
 
 // VPP contains all VPP plugins.
 type VPP struct {
-	ABFPlugin   *abfplugin.ABFPlugin
-	ACLPlugin   *aclplugin.ACLPlugin
-	DNSPlugin   *dnsplugin.DNSPlugin
-	IfPlugin    *ifplugin.IfPlugin
-	IPFIXPlugin *ipfixplugin.IPFIXPlugin
-	IPSecPlugin *ipsecplugin.IPSecPlugin
-	L2Plugin    *l2plugin.L2Plugin
-	L3Plugin    *l3plugin.L3Plugin
-	NATPlugin   *natplugin.NATPlugin
-	PuntPlugin  *puntplugin.PuntPlugin
-	STNPlugin   *stnplugin.STNPlugin
-	SRPlugin    *srplugin.SRPlugin
-	WgPlugin    *wireguardplugin.WgPlugin
+	ABFPlugin      *abfplugin.ABFPlugin
+	ACLPlugin      *aclplugin.ACLPlugin
+	BfdPlugin      *bfdplugin.BfdPlugin
+	ClassifyPlugin *classifyplugin.ClassifyPlugin
+	CnatPlugin     *cnatplugin.CnatPlugin
+	DNSPlugin      *dnsplugin.DNSPlugin
+	IfPlugin       *ifplugin.IfPlugin
+	IPFIXPlugin    *ipfixplugin.IPFIXPlugin
+	IPSecPlugin    *ipsecplugin.IPSecPlugin
+	L2Plugin       *l2plugin.L2Plugin
+	L3Plugin       *l3plugin.L3Plugin
+	LCPPlugin      *lcpplugin.LCPPlugin
+	NATPlugin      *natplugin.NATPlugin
+	PolicerPlugin  *policerplugin.PolicerPlugin
+	PuntPlugin     *puntplugin.PuntPlugin
+	QosPlugin      *qosplugin.QosPlugin
+	SessionPlugin  *sessionplugin.SessionPlugin
+	STNPlugin      *stnplugin.STNPlugin
+	SRPlugin       *srplugin.SRPlugin
+	WgPlugin       *wireguardplugin.WgPlugin
 }
 
 func DefaultVPP() VPP {
 	return VPP{
-		ABFPlugin:   &abfplugin.DefaultPlugin,
-		ACLPlugin:   &aclplugin.DefaultPlugin,
-		DNSPlugin:   &dnsplugin.DefaultPlugin,
-		IfPlugin:    &ifplugin.DefaultPlugin,
-		IPFIXPlugin: &ipfixplugin.DefaultPlugin,
-		IPSecPlugin: &ipsecplugin.DefaultPlugin,
-		L2Plugin:    &l2plugin.DefaultPlugin,
-		L3Plugin:    &l3plugin.DefaultPlugin,
-		NATPlugin:   &natplugin.DefaultPlugin,
-		PuntPlugin:  &puntplugin.DefaultPlugin,
-		STNPlugin:   &stnplugin.DefaultPlugin,
-		SRPlugin:    &srplugin.DefaultPlugin,
-		WgPlugin:    &wireguardplugin.DefaultPlugin,
+		ABFPlugin:      &abfplugin.DefaultPlugin,
+		ACLPlugin:      &aclplugin.DefaultPlugin,
+		BfdPlugin:      &bfdplugin.DefaultPlugin,
+		ClassifyPlugin: &classifyplugin.DefaultPlugin,
+		CnatPlugin:     &cnatplugin.DefaultPlugin,
+		DNSPlugin:      &dnsplugin.DefaultPlugin,
+		IfPlugin:       &ifplugin.DefaultPlugin,
+		IPFIXPlugin:    &ipfixplugin.DefaultPlugin,
+		IPSecPlugin:    &ipsecplugin.DefaultPlugin,
+		L2Plugin:       &l2plugin.DefaultPlugin,
+		L3Plugin:       &l3plugin.DefaultPlugin,
+		LCPPlugin:      &lcpplugin.DefaultPlugin,
+		NATPlugin:      &natplugin.DefaultPlugin,
+		PolicerPlugin:  &policerplugin.DefaultPlugin,
+		PuntPlugin:     &puntplugin.DefaultPlugin,
+		QosPlugin:      &qosplugin.DefaultPlugin,
+		SessionPlugin:  &sessionplugin.DefaultPlugin,
+		STNPlugin:      &stnplugin.DefaultPlugin,
+		SRPlugin:       &srplugin.DefaultPlugin,
+		WgPlugin:       &wireguardplugin.DefaultPlugin,
 	}
 }
 
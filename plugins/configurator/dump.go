@@ -17,6 +17,7 @@ package configurator
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"go.ligato.io/cn-infra/v2/logging"
@@ -25,24 +26,34 @@ import (
 	l3linuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
 	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
+	bfdvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	cnatvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
 	l2vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	lcpvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
 	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	policervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
+	qosvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
 	wireguardvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
 	rpc "go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	vpp_cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
 	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+	vpp_policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
 	vpp_punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
+	vpp_qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
 	vpp_wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
 )
 
@@ -60,6 +71,13 @@ type dumpService struct {
 	aclHandler       aclvppcalls.ACLVppRead
 	abfHandler       abfvppcalls.ABFVppRead
 	natHandler       natvppcalls.NatVppRead
+	nat64Handler     natvppcalls.Nat64VppRead
+	nat66Handler     natvppcalls.Nat66VppRead
+	bfdHandler       bfdvppcalls.BfdVppRead
+	policerHandler   policervppcalls.PolicerVppRead
+	qosHandler       qosvppcalls.QosVppRead
+	lcpHandler       lcpvppcalls.LCPVppRead
+	cnatHandler      cnatvppcalls.CnatVppRead
 	puntHandler      vppcalls.PuntVPPRead
 	wireguardHandler wireguardvppcalls.WgVppRead
 
@@ -109,6 +127,21 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpRoutes failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.Mroutes, err = svc.DumpMRoutes()
+	if err != nil {
+		svc.log.Errorf("DumpMRoutes failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.MplsTables, err = svc.DumpMplsTables()
+	if err != nil {
+		svc.log.Errorf("DumpMplsTables failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.MplsRoutes, err = svc.DumpMplsRoutes()
+	if err != nil {
+		svc.log.Errorf("DumpMplsRoutes failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.Arps, err = svc.DumpARPs()
 	if err != nil {
 		svc.log.Errorf("DumpARPs failed: %v", err)
@@ -129,6 +162,11 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpIPSecSAs failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.IpsecIkev2Profiles, err = svc.DumpIPSecIKEv2Profiles()
+	if err != nil {
+		svc.log.Errorf("DumpIPSecIKEv2Profiles failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.Acls, err = svc.DumpACLs()
 	if err != nil {
 		svc.log.Errorf("DumpACLs failed: %v", err)
@@ -159,6 +197,36 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpNAT44AddressPools failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.Nat64Interfaces, err = svc.DumpNAT64Interfaces()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64Interfaces failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64Pools, err = svc.DumpNAT64AddressPools()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64AddressPools failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64Prefixes, err = svc.DumpNAT64Prefixes()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64Prefixes failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64StaticBibs, err = svc.DumpNAT64StaticBibs()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64StaticBibs failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat66Interfaces, err = svc.DumpNAT66Interfaces()
+	if err != nil {
+		svc.log.Errorf("DumpNAT66Interfaces failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat66StaticMappings, err = svc.DumpNAT66StaticMappings()
+	if err != nil {
+		svc.log.Errorf("DumpNAT66StaticMappings failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.PuntTohosts, err = svc.DumpPunt()
 	if err != nil {
 		svc.log.Errorf("DumpPunt failed: %v", err)
@@ -174,6 +242,56 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpWgPeers failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.Policers, err = svc.DumpPolicers()
+	if err != nil {
+		svc.log.Errorf("DumpPolicers failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.BfdSessions, err = svc.DumpBfdSessions()
+	if err != nil {
+		svc.log.Errorf("DumpBfdSessions failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.BfdAuthKeys, err = svc.DumpBfdAuthKeys()
+	if err != nil {
+		svc.log.Errorf("DumpBfdAuthKeys failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.QosEgressMaps, err = svc.DumpQosEgressMaps()
+	if err != nil {
+		svc.log.Errorf("DumpQosEgressMaps failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.QosRecords, err = svc.DumpQosRecords()
+	if err != nil {
+		svc.log.Errorf("DumpQosRecords failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.QosMarks, err = svc.DumpQosMarks()
+	if err != nil {
+		svc.log.Errorf("DumpQosMarks failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.LcpGlobals, err = svc.DumpLCPGlobals(ctx)
+	if err != nil {
+		svc.log.Errorf("DumpLCPGlobals failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.LcpInterfacePairs, err = svc.DumpLCPInterfacePairs(ctx)
+	if err != nil {
+		svc.log.Errorf("DumpLCPInterfacePairs failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.CnatTranslations, err = svc.DumpCnatTranslations(ctx)
+	if err != nil {
+		svc.log.Errorf("DumpCnatTranslations failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.CnatSnatPolicy, err = svc.DumpCnatSnatPolicy(ctx)
+	if err != nil {
+		svc.log.Errorf("DumpCnatSnatPolicy failed: %v", err)
+		return nil, err
+	}
 
 	// -----
 	// Linux
@@ -256,6 +374,16 @@ func (svc *dumpService) DumpIPSecSAs() (sas []*vpp_ipsec.SecurityAssociation, er
 	return sas, nil
 }
 
+// DumpIPSecIKEv2Profiles dumps IPSec IKEv2 profiles.
+func (svc *dumpService) DumpIPSecIKEv2Profiles() (profiles []*vpp_ipsec.IKEv2Profile, err error) {
+	if svc.ipsecHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	return svc.ipsecHandler.DumpIKEv2Profiles()
+}
+
 // DumpBDs reads bridge domains and returns them as an *BDResponse. If reading ends up with error,
 // only error is send back in response
 func (svc *dumpService) DumpBDs() (bds []*vpp_l2.BridgeDomain, err error) {
@@ -328,6 +456,33 @@ func (svc *dumpService) DumpRoutes() (routes []*vpp_l3.Route, err error) {
 	return routes, nil
 }
 
+// DumpMRoutes reads VPP IP multicast routes.
+func (svc *dumpService) DumpMRoutes() ([]*vpp_l3.MRoute, error) {
+	if svc.l3Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.l3Handler.DumpMRoutes()
+}
+
+// DumpMplsTables reads VPP MPLS tables.
+func (svc *dumpService) DumpMplsTables() ([]*vpp_l3.MplsTable, error) {
+	if svc.l3Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.l3Handler.DumpMplsTables()
+}
+
+// DumpMplsRoutes reads VPP MPLS label routes of all MPLS tables.
+func (svc *dumpService) DumpMplsRoutes() ([]*vpp_l3.MplsRoute, error) {
+	if svc.l3Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.l3Handler.DumpMplsRoutes()
+}
+
 // DumpARPs reads VPP ARPs and returns them as an *ARPsResponse. If reading ends up with error,
 // only error is send back in response
 func (svc *dumpService) DumpARPs() (arps []*vpp_l3.ARPEntry, err error) {
@@ -445,6 +600,60 @@ func (svc *dumpService) DumpNAT44AddressPools() (natPools []*vpp_nat.Nat44Addres
 	return natPools, nil
 }
 
+// DumpNAT64Interfaces reads VPP NAT64 interfaces.
+func (svc *dumpService) DumpNAT64Interfaces() ([]*vpp_nat.Nat64Interface, error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.nat64Handler.Nat64InterfacesDump()
+}
+
+// DumpNAT64AddressPools reads VPP NAT64 address pools.
+func (svc *dumpService) DumpNAT64AddressPools() ([]*vpp_nat.Nat64AddressPool, error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.nat64Handler.Nat64AddressPoolsDump()
+}
+
+// DumpNAT64Prefixes reads VPP NAT64 prefixes.
+func (svc *dumpService) DumpNAT64Prefixes() ([]*vpp_nat.Nat64Prefix, error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.nat64Handler.Nat64PrefixesDump()
+}
+
+// DumpNAT64StaticBibs reads VPP NAT64 static BIB entries.
+func (svc *dumpService) DumpNAT64StaticBibs() ([]*vpp_nat.Nat64StaticBib, error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.nat64Handler.Nat64StaticBibsDump()
+}
+
+// DumpNAT66Interfaces reads VPP NAT66 interfaces.
+func (svc *dumpService) DumpNAT66Interfaces() ([]*vpp_nat.Nat66Interface, error) {
+	if svc.nat66Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.nat66Handler.Nat66InterfacesDump()
+}
+
+// DumpNAT66StaticMappings reads VPP NAT66 static mappings.
+func (svc *dumpService) DumpNAT66StaticMappings() ([]*vpp_nat.Nat66StaticMapping, error) {
+	if svc.nat66Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.nat66Handler.Nat66StaticMappingsDump()
+}
+
 // DumpPunt reads VPP Punt socket registrations and returns them as an *PuntResponse.
 func (svc *dumpService) DumpPunt() (punts []*vpp_punt.ToHost, err error) {
 	if svc.puntHandler == nil {
@@ -492,6 +701,142 @@ func (svc *dumpService) DumpWgPeers() (peers []*vpp_wg.Peer, err error) {
 	return
 }
 
+// DumpPolicers reads VPP policers. Interfaces with the policer applied
+// are not included (cannot be dumped from VPP).
+func (svc *dumpService) DumpPolicers() (policers []*vpp_policer.Policer, err error) {
+	if svc.policerHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	dump, err := svc.policerHandler.DumpPolicers()
+	if err != nil {
+		return nil, err
+	}
+	for _, policerDetails := range dump {
+		policers = append(policers, policerDetails.Policer)
+	}
+	return policers, nil
+}
+
+// DumpBfdSessions reads VPP BFD sessions.
+func (svc *dumpService) DumpBfdSessions() (sessions []*vpp_bfd.BfdSession, err error) {
+	if svc.bfdHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	dump, err := svc.bfdHandler.DumpBfdSessions()
+	if err != nil {
+		return nil, err
+	}
+	for _, sessionDetails := range dump {
+		sessions = append(sessions, sessionDetails.Session)
+	}
+	return sessions, nil
+}
+
+// DumpBfdAuthKeys reads VPP BFD authentication keys. Secrets are not included
+// (cannot be dumped from VPP).
+func (svc *dumpService) DumpBfdAuthKeys() (authKeys []*vpp_bfd.BfdAuthKey, err error) {
+	if svc.bfdHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	dump, err := svc.bfdHandler.DumpBfdAuthKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, authKeyDetails := range dump {
+		authKeys = append(authKeys, authKeyDetails.AuthKey)
+	}
+	return authKeys, nil
+}
+
+// DumpQosEgressMaps reads VPP QoS egress maps.
+func (svc *dumpService) DumpQosEgressMaps() ([]*vpp_qos.QosEgressMap, error) {
+	if svc.qosHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.qosHandler.DumpQosEgressMaps()
+}
+
+// DumpQosRecords reads interfaces with VPP QoS recording enabled.
+func (svc *dumpService) DumpQosRecords() ([]*vpp_qos.QosRecord, error) {
+	if svc.qosHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.qosHandler.DumpQosRecords()
+}
+
+// DumpQosMarks reads interfaces with VPP QoS marking enabled.
+func (svc *dumpService) DumpQosMarks() ([]*vpp_qos.QosMark, error) {
+	if svc.qosHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.qosHandler.DumpQosMarks()
+}
+
+// DumpLCPGlobals reads global settings of VPP linux-cp plugin. Netlink
+// synchronization is not included (cannot be dumped from VPP).
+func (svc *dumpService) DumpLCPGlobals(ctx context.Context) (*vpp_lcp.LCPGlobals, error) {
+	if svc.lcpHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.lcpHandler.DumpLCPGlobals(ctx)
+}
+
+// DumpLCPInterfacePairs reads VPP linux-cp interface pairs.
+func (svc *dumpService) DumpLCPInterfacePairs(ctx context.Context) (pairs []*vpp_lcp.LCPInterfacePair, err error) {
+	if svc.lcpHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	dump, err := svc.lcpHandler.DumpLCPInterfacePairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pairDetails := range dump {
+		pairs = append(pairs, pairDetails.Pair)
+	}
+	return pairs, nil
+}
+
+// DumpCnatTranslations reads VPP cnat translations. Translations are not
+// labeled in VPP, the labels are therefore derived from translation IDs.
+func (svc *dumpService) DumpCnatTranslations(ctx context.Context) (translations []*vpp_cnat.Translation, err error) {
+	if svc.cnatHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	dump, err := svc.cnatHandler.DumpTranslations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range dump {
+		details.Translation.Label = fmt.Sprintf("translation-%d", details.Meta.ID)
+		translations = append(translations, details.Translation)
+	}
+	return translations, nil
+}
+
+// DumpCnatSnatPolicy reads source NAT addresses of VPP cnat plugin. The policy
+// itself and excluded prefixes are not included (cannot be dumped from VPP).
+func (svc *dumpService) DumpCnatSnatPolicy(ctx context.Context) (*vpp_cnat.SnatPolicy, error) {
+	if svc.cnatHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.cnatHandler.DumpSnatAddresses(ctx)
+}
+
 // DumpLinuxInterfaces reads linux interfaces and returns them as an *LinuxInterfaceResponse. If reading ends up with error,
 // only error is send back in response
 func (svc *dumpService) DumpLinuxInterfaces() (linuxIfs []*linux_interfaces.Interface, err error) {
@@ -24,7 +24,9 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
 )
@@ -43,7 +45,9 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.ServiceLabel = &servicelabel.DefaultPlugin
 	p.AddrAlloc = &netalloc.DefaultPlugin
 	p.VPPACLPlugin = &aclplugin.DefaultPlugin
+	p.VPPBfdPlugin = &bfdplugin.DefaultPlugin
 	p.VPPIfPlugin = &ifplugin.DefaultPlugin
+	p.VPPIPSecPlugin = &ipsecplugin.DefaultPlugin
 	p.VPPL2Plugin = &l2plugin.DefaultPlugin
 	p.VPPL3Plugin = &l3plugin.DefaultPlugin
 	p.LinuxIfPlugin = &linuxifplugin.DefaultPlugin
@@ -30,15 +30,22 @@ import (
 	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin"
+	bfdvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	cnatvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin"
 	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	l2vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
 	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	lcpvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
 	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	policervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
 	puntvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
+	qosvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
 	wireguardvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
 	pb "go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
@@ -58,17 +65,19 @@ type Plugin struct {
 // Deps - dependencies of Plugin
 type Deps struct {
 	infra.PluginDeps
-	GRPCServer    grpc.Server
-	Dispatch      orchestrator.Dispatcher
-	VPP           govppmux.API
-	ServiceLabel  servicelabel.ReaderAPI
-	AddrAlloc     netalloc.AddressAllocator
-	VPPACLPlugin  aclplugin.API
-	VPPIfPlugin   ifplugin.API
-	VPPL2Plugin   *l2plugin.L2Plugin
-	VPPL3Plugin   l3plugin.API
-	LinuxIfPlugin iflinuxplugin.API
-	NsPlugin      nsplugin.API
+	GRPCServer     grpc.Server
+	Dispatch       orchestrator.Dispatcher
+	VPP            govppmux.API
+	ServiceLabel   servicelabel.ReaderAPI
+	AddrAlloc      netalloc.AddressAllocator
+	VPPACLPlugin   aclplugin.API
+	VPPBfdPlugin   bfdplugin.API
+	VPPIfPlugin    ifplugin.API
+	VPPIPSecPlugin ipsecplugin.API
+	VPPL2Plugin    *l2plugin.L2Plugin
+	VPPL3Plugin    l3plugin.API
+	LinuxIfPlugin  iflinuxplugin.API
+	NsPlugin       nsplugin.API
 }
 
 // Init sets plugin child loggers
@@ -93,6 +102,21 @@ func (p *Plugin) Init() error {
 			p.sendNotification(notification)
 		})
 	}
+	if p.VPPBfdPlugin != nil {
+		p.VPPBfdPlugin.SetNotifyService(func(notification *vpp.Notification) {
+			p.sendNotification(notification)
+		})
+	}
+	if p.VPPL3Plugin != nil {
+		p.VPPL3Plugin.SetNotifyService(func(notification *vpp.Notification) {
+			p.sendNotification(notification)
+		})
+	}
+	if p.VPPIPSecPlugin != nil {
+		p.VPPIPSecPlugin.SetNotifyService(func(notification *vpp.Notification) {
+			p.sendNotification(notification)
+		})
+	}
 	if p.LinuxIfPlugin != nil {
 		p.LinuxIfPlugin.SetNotifyService(func(notification *linux.Notification) {
 			p.sendNotification(notification)
@@ -168,6 +192,34 @@ func (p *Plugin) initHandlers() (err error) {
 	if p.configurator.natHandler == nil {
 		p.Log.Info("VPP NAT handler is not available, it will be skipped")
 	}
+	p.configurator.nat64Handler = natvppcalls.CompatibleNat64VppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.nat64Handler == nil {
+		p.Log.Info("VPP NAT64 handler is not available, it will be skipped")
+	}
+	p.configurator.nat66Handler = natvppcalls.CompatibleNat66VppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.nat66Handler == nil {
+		p.Log.Info("VPP NAT66 handler is not available, it will be skipped")
+	}
+	p.configurator.bfdHandler = bfdvppcalls.CompatibleBfdVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.bfdHandler == nil {
+		p.Log.Info("VPP BFD handler is not available, it will be skipped")
+	}
+	p.configurator.policerHandler = policervppcalls.CompatiblePolicerVppHandler(p.VPP, p.Log)
+	if p.configurator.policerHandler == nil {
+		p.Log.Info("VPP Policer handler is not available, it will be skipped")
+	}
+	p.configurator.qosHandler = qosvppcalls.CompatibleQosVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.qosHandler == nil {
+		p.Log.Info("VPP QoS handler is not available, it will be skipped")
+	}
+	p.configurator.lcpHandler = lcpvppcalls.CompatibleLCPVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.lcpHandler == nil {
+		p.Log.Info("VPP linux-cp handler is not available, it will be skipped")
+	}
+	p.configurator.cnatHandler = cnatvppcalls.CompatibleCnatVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.cnatHandler == nil {
+		p.Log.Info("VPP cnat handler is not available, it will be skipped")
+	}
 	p.configurator.puntHandler = puntvppcalls.CompatiblePuntVppHandler(p.VPP, ifIndexes, p.Log)
 	if p.configurator.puntHandler == nil {
 		p.Log.Info("VPP Punt handler is not available, it will be skipped")
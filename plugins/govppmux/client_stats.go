@@ -24,6 +24,8 @@ func (p *Plugin) ListStats(prefixes ...string) ([]adapter.StatIdentifier, error)
 	if p.statsAdapter == nil {
 		return nil, nil
 	}
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
 	return p.statsAdapter.ListStats(prefixes...)
 }
 
@@ -32,6 +34,8 @@ func (p *Plugin) DumpStats(prefixes ...string) ([]adapter.StatEntry, error) {
 	if p.statsAdapter == nil {
 		return nil, nil
 	}
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
 	return p.statsAdapter.DumpStats(prefixes...)
 }
 
@@ -0,0 +1,72 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/mock"
+	"go.ligato.io/cn-infra/v2/logging"
+)
+
+// failingStatsAdapter is a stats adapter which cannot connect.
+type failingStatsAdapter struct {
+	*mock.StatsAdapter
+}
+
+func (a *failingStatsAdapter) Connect() error {
+	return errors.New("stats socket unavailable")
+}
+
+func TestDumpStats(t *testing.T) {
+	RegisterTestingT(t)
+
+	entries := []adapter.StatEntry{
+		{
+			StatIdentifier: adapter.StatIdentifier{Name: []byte("/net/ipsec/sa")},
+			Type:           adapter.CombinedCounterVector,
+		},
+	}
+	statsAdapter := mock.NewStatsAdapter()
+	statsAdapter.MockStats(entries)
+
+	p := &Plugin{}
+	p.Log = logging.ForPlugin("govppmux")
+	p.connectStats(statsAdapter)
+
+	stats, err := p.DumpStats("/net/ipsec/sa")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(stats).To(Equal(entries))
+
+	names, err := p.ListStats()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(names).To(HaveLen(1))
+	Expect(names[0].Name).To(BeEquivalentTo("/net/ipsec/sa"))
+}
+
+func TestDumpStatsNotConnected(t *testing.T) {
+	RegisterTestingT(t)
+
+	p := &Plugin{}
+	p.Log = logging.ForPlugin("govppmux")
+	p.connectStats(&failingStatsAdapter{mock.NewStatsAdapter()})
+
+	stats, err := p.DumpStats()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(stats).To(BeNil())
+}
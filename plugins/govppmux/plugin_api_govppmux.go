@@ -15,6 +15,8 @@
 package govppmux
 
 import (
+	"go.fd.io/govpp/adapter"
+
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 )
@@ -24,6 +26,10 @@ type API interface {
 	// VPPInfo returns VPP information which is retrieved immediatelly after connecting to VPP.
 	VPPInfo() VPPInfo
 
+	// DumpStats returns stats with name, type and value from the VPP stats
+	// segment, optionally filtered by name prefixes.
+	DumpStats(prefixes ...string) ([]adapter.StatEntry, error)
+
 	vpp.Client
 }
 
@@ -156,11 +156,7 @@ func (p *Plugin) Init() (err error) {
 	} else {
 		statsSocket = adapter.DefaultStatsSocket
 	}
-	statsAdapter := NewStatsAdapter(statsSocket)
-	if p.statsConn, err = govpp.ConnectStats(statsAdapter); err != nil {
-		p.Log.Warnf("Unable to connect to the VPP statistics socket, %v", err)
-		p.statsAdapter = nil
-	}
+	p.connectStats(NewStatsAdapter(statsSocket))
 
 	if p.config.ProxyEnabled {
 		// register binapi messages to gob package (required for proxy)
@@ -288,6 +284,19 @@ func (p *Plugin) Close() error {
 	return nil
 }
 
+// connectStats connects to the VPP stats segment using the given adapter.
+// The adapter is kept for stats which are read directly from it (ListStats,
+// DumpStats) and for disconnecting on Close.
+func (p *Plugin) connectStats(statsAdapter adapter.StatsAPI) {
+	var err error
+	if p.statsConn, err = govpp.ConnectStats(statsAdapter); err != nil {
+		p.Log.Warnf("Unable to connect to the VPP statistics socket, %v", err)
+		p.statsAdapter = nil
+		return
+	}
+	p.statsAdapter = statsAdapter
+}
+
 func (p *Plugin) Version() vpp.Version {
 	return p.binapiVersion
 }
@@ -0,0 +1,350 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package descriptortest implements a reusable conformance test suite
+// for KVDescriptor implementations.
+//
+// The suite drives the descriptor directly (without KVScheduler) against
+// a fake southbound and verifies that the descriptor respects the contract
+// expected by the scheduler:
+//   - Create followed by Retrieve returns an equivalent value (compared
+//     using ValueComparator, or proto.Equal if not defined),
+//   - values are updated either in-place using Update or by re-creation,
+//     as decided by UpdateWithRecreate, and the result is again retrievable,
+//   - in-place Update leaves the southbound in the same state as Delete
+//     followed by Create of the new value (i.e. Retrieve returns the same
+//     value in both cases),
+//   - Delete removes the value from the output of Retrieve,
+//   - Dependencies and DerivedValues are deterministic and the same for
+//     the configured and the retrieved value,
+//   - metadata are returned by Create and Retrieve when WithMetadata is set.
+package descriptortest
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+// Southbound is a fake southbound (e.g. mocked VPP or Linux handler)
+// that the tested descriptor is wired to.
+type Southbound interface {
+	// Reset removes all the configuration from the southbound.
+	// It is called before every sample is tested.
+	Reset()
+
+	// Sync is called before every Retrieve, allowing the fake to prepare
+	// replies describing the current southbound state.
+	Sync()
+}
+
+// Sample is a value used to exercise the tested descriptor.
+type Sample struct {
+	// Name is used to identify the sub-test (defaults to Key).
+	Name string
+
+	// Key under which the value is configured (defaults to models.Key(Value)).
+	Key string
+
+	// Value is created and later deleted by the suite.
+	Value proto.Message
+
+	// Updates are applied in order after Value is created.
+	Updates []proto.Message
+
+	// Correlate, if set, is used instead of the configured value as the
+	// correlation input for Retrieve.
+	Correlate func(key string, value proto.Message) []kvs.KVWithMetadata
+}
+
+// Suite is a conformance test suite for a single descriptor.
+type Suite struct {
+	Descriptor *kvs.KVDescriptor
+	Southbound Southbound
+	Samples    []Sample
+}
+
+// Run runs all the checks for every sample as sub-tests of t.
+func (s *Suite) Run(t *testing.T) {
+	if s.Descriptor == nil {
+		t.Fatal("descriptor is not defined")
+	}
+	if s.Descriptor.Create == nil || s.Descriptor.Delete == nil {
+		t.Fatalf("descriptor %s does not implement Create/Delete", s.Descriptor.Name)
+	}
+	if s.Descriptor.UpdateWithRecreate != nil && s.Descriptor.Update == nil {
+		t.Errorf("descriptor %s implements UpdateWithRecreate, but not Update", s.Descriptor.Name)
+	}
+	if len(s.Samples) == 0 {
+		t.Fatalf("no samples defined for descriptor %s", s.Descriptor.Name)
+	}
+	for _, sample := range s.Samples {
+		sample := sample
+		key := sample.Key
+		if key == "" {
+			key = models.Key(sample.Value)
+		}
+		name := sample.Name
+		if name == "" {
+			name = key
+		}
+		t.Run(name, func(t *testing.T) {
+			s.runSample(t, key, sample)
+		})
+	}
+}
+
+func (s *Suite) runSample(t *testing.T, key string, sample Sample) {
+	d := s.Descriptor
+	if s.Southbound != nil {
+		s.Southbound.Reset()
+	}
+
+	// static checks
+	if d.KeySelector != nil && !d.KeySelector(key) {
+		t.Fatalf("key %q is not selected by descriptor %s", key, d.Name)
+	}
+	if d.ValueTypeName != "" {
+		if valueType := string(proto.MessageName(sample.Value)); valueType != d.ValueTypeName {
+			t.Fatalf("value type %q does not match ValueTypeName %q", valueType, d.ValueTypeName)
+		}
+	}
+	if d.Validate != nil {
+		if err := d.Validate(key, sample.Value); err != nil {
+			t.Fatalf("sample value is not valid: %v", err)
+		}
+	}
+	s.checkDependencies(t, key, sample.Value, sample.Value)
+	s.checkDerivedValues(t, key, sample.Value, sample.Value)
+
+	// create
+	metadata, err := d.Create(key, sample.Value)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if d.WithMetadata && metadata == nil {
+		t.Errorf("Create returned no metadata although WithMetadata is set")
+	}
+	s.checkRetrieved(t, "Create", key, sample, sample.Value)
+
+	// update
+	value := sample.Value
+	for i, newValue := range sample.Updates {
+		step := fmt.Sprintf("Update #%d", i+1)
+		if d.Validate != nil {
+			if err := d.Validate(key, newValue); err != nil {
+				t.Fatalf("%s: updated value is not valid: %v", step, err)
+			}
+		}
+		// the same logic as used by KVScheduler
+		recreate := d.Update == nil
+		if !recreate && d.UpdateWithRecreate != nil {
+			recreate = d.UpdateWithRecreate(key, value, newValue, metadata)
+		}
+		if recreate {
+			if err := d.Delete(key, value, metadata); err != nil {
+				t.Fatalf("%s: Delete (re-create) failed: %v", step, err)
+			}
+			metadata, err = d.Create(key, newValue)
+			if err != nil {
+				t.Fatalf("%s: Create (re-create) failed: %v", step, err)
+			}
+		} else {
+			metadata, err = d.Update(key, value, newValue, metadata)
+			if err != nil {
+				t.Fatalf("%s: Update failed: %v", step, err)
+			}
+		}
+		if d.WithMetadata && metadata == nil {
+			t.Errorf("%s: no metadata returned although WithMetadata is set", step)
+		}
+		value = newValue
+		s.checkRetrieved(t, step, key, sample, value)
+		if !recreate {
+			metadata = s.checkUpdateEqualsRecreate(t, step, key, sample, value, metadata)
+		}
+	}
+
+	// delete
+	if err := d.Delete(key, value, metadata); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if d.Retrieve != nil {
+		// some objects cannot be removed completely (e.g. built-in iptables chains),
+		// but the deleted value must not be retrieved anymore
+		if kv, found := s.retrieve(t, key, sample, value); found && s.equivalent(key, kv.Value, value) {
+			t.Errorf("value %s is still retrieved after Delete", key)
+		}
+	}
+}
+
+// checkRetrieved verifies that the given value is retrieved and equivalent
+// to the configured one.
+func (s *Suite) checkRetrieved(t *testing.T, step, key string, sample Sample, value proto.Message) {
+	d := s.Descriptor
+	if d.Retrieve == nil {
+		return
+	}
+	kv, found := s.retrieve(t, key, sample, value)
+	if !found {
+		t.Errorf("%s: value %s was not retrieved", step, key)
+		return
+	}
+	if !s.equivalent(key, kv.Value, value) {
+		t.Errorf("%s: retrieved value is not equivalent to the configured one:\n configured: %v\n retrieved:  %v",
+			step, value, kv.Value)
+	}
+	if d.WithMetadata && kv.Metadata == nil {
+		t.Errorf("%s: retrieved value %s has no metadata although WithMetadata is set", step, key)
+	}
+	s.checkDependencies(t, key, value, kv.Value)
+	s.checkDerivedValues(t, key, value, kv.Value)
+}
+
+// checkUpdateEqualsRecreate verifies that the value updated in-place is retrieved
+// the same as when it is re-created from scratch. The value is left re-created
+// and the new metadata are returned.
+func (s *Suite) checkUpdateEqualsRecreate(t *testing.T, step, key string, sample Sample,
+	value proto.Message, metadata kvs.Metadata) kvs.Metadata {
+	d := s.Descriptor
+	if d.Retrieve == nil {
+		return metadata
+	}
+	updated, found := s.retrieve(t, key, sample, value)
+	if !found {
+		// already reported by checkRetrieved
+		return metadata
+	}
+	if err := d.Delete(key, value, metadata); err != nil {
+		t.Fatalf("%s: Delete (compare with re-create) failed: %v", step, err)
+	}
+	metadata, err := d.Create(key, value)
+	if err != nil {
+		t.Fatalf("%s: Create (compare with re-create) failed: %v", step, err)
+	}
+	recreated, found := s.retrieve(t, key, sample, value)
+	if !found {
+		t.Errorf("%s: value %s was not retrieved after re-create", step, key)
+		return metadata
+	}
+	if !proto.Equal(updated.Value, recreated.Value) {
+		t.Errorf("%s: value updated in-place differs from the re-created one:\n updated:    %v\n re-created: %v",
+			step, updated.Value, recreated.Value)
+	}
+	return metadata
+}
+
+// retrieve calls Retrieve and returns the key-value pair with the given key.
+func (s *Suite) retrieve(t *testing.T, key string, sample Sample, value proto.Message) (kvs.KVWithMetadata, bool) {
+	if s.Southbound != nil {
+		s.Southbound.Sync()
+	}
+	var correlate []kvs.KVWithMetadata
+	if sample.Correlate != nil {
+		correlate = sample.Correlate(key, value)
+	} else {
+		correlate = []kvs.KVWithMetadata{{Key: key, Value: value, Origin: kvs.FromNB}}
+	}
+	retrieved, err := s.Descriptor.Retrieve(correlate)
+	if err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	for _, kv := range retrieved {
+		if kv.Key == key {
+			return kv, true
+		}
+	}
+	return kvs.KVWithMetadata{}, false
+}
+
+// equivalent compares the retrieved value with the expected one the same way
+// as KVScheduler does during the verification of a transaction.
+func (s *Suite) equivalent(key string, retrieved, expected proto.Message) bool {
+	if s.Descriptor.ValueComparator != nil {
+		return s.Descriptor.ValueComparator(key, retrieved, expected)
+	}
+	return proto.Equal(retrieved, expected)
+}
+
+// checkDependencies verifies that dependencies are stable.
+func (s *Suite) checkDependencies(t *testing.T, key string, value, retrieved proto.Message) {
+	d := s.Descriptor
+	if d.Dependencies == nil {
+		return
+	}
+	deps := d.Dependencies(key, value)
+	if !equalDependencies(deps, d.Dependencies(key, value)) {
+		t.Errorf("dependencies of %s are not deterministic", key)
+	}
+	if retrieved != value && !equalDependencies(deps, d.Dependencies(key, retrieved)) {
+		t.Errorf("dependencies of the retrieved value %s differ: %v vs. %v",
+			key, deps, d.Dependencies(key, retrieved))
+	}
+}
+
+// checkDerivedValues verifies that derived values are stable.
+func (s *Suite) checkDerivedValues(t *testing.T, key string, value, retrieved proto.Message) {
+	d := s.Descriptor
+	if d.DerivedValues == nil {
+		return
+	}
+	derived := d.DerivedValues(key, value)
+	if !equalDerivedValues(derived, d.DerivedValues(key, value)) {
+		t.Errorf("derived values of %s are not deterministic", key)
+	}
+	if retrieved != value && !equalDerivedValues(derived, d.DerivedValues(key, retrieved)) {
+		t.Errorf("derived values of the retrieved value %s differ", key)
+	}
+}
+
+func equalDependencies(deps1, deps2 []kvs.Dependency) bool {
+	if len(deps1) != len(deps2) {
+		return false
+	}
+	for i := range deps1 {
+		if deps1[i].Label != deps2[i].Label || deps1[i].Key != deps2[i].Key {
+			return false
+		}
+		prefixes1 := deps1[i].AnyOf.KeyPrefixes
+		prefixes2 := deps2[i].AnyOf.KeyPrefixes
+		if len(prefixes1) != len(prefixes2) {
+			return false
+		}
+		for j := range prefixes1 {
+			if prefixes1[j] != prefixes2[j] {
+				return false
+			}
+		}
+		if (deps1[i].AnyOf.KeySelector == nil) != (deps2[i].AnyOf.KeySelector == nil) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalDerivedValues(values1, values2 []kvs.KeyValuePair) bool {
+	if len(values1) != len(values2) {
+		return false
+	}
+	for i := range values1 {
+		if values1[i].Key != values2[i].Key || !proto.Equal(values1[i].Value, values2[i].Value) {
+			return false
+		}
+	}
+	return true
+}
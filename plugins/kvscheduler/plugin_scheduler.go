@@ -69,6 +69,13 @@ const (
 	// to stdout
 	defaultPrintTxnSummary = true
 
+	// by default, NB transactions are not coalesced
+	defaultTxnCoalescingWindow = 0 // in milliseconds
+
+	// by default, coalescing may postpone execution of NB transaction by at most
+	// one second
+	defaultTxnCoalescingMaxDelay = 1000 // in milliseconds
+
 	// name of the environment variable used to enable verification after every transaction
 	verifyModeEnv = "KVSCHED_VERIFY_MODE"
 
@@ -138,6 +145,8 @@ type Config struct {
 	PermanentlyRecordedInitPeriod uint32 `json:"permanently-recorded-init-period"` // in minutes
 	EnableTxnSimulation           bool   `json:"enable-txn-simulation"`
 	PrintTxnSummary               bool   `json:"print-txn-summary"`
+	TxnCoalescingWindow           uint32 `json:"txn-coalescing-window"`    // in milliseconds
+	TxnCoalescingMaxDelay         uint32 `json:"txn-coalescing-max-delay"` // in milliseconds
 }
 
 // SchedulerTxn implements transaction for the KV scheduler.
@@ -162,6 +171,8 @@ func (s *Scheduler) Init() error {
 		PermanentlyRecordedInitPeriod: defaultPermanentlyRecordedInitPeriod,
 		EnableTxnSimulation:           defaultEnableTxnSimulation,
 		PrintTxnSummary:               defaultPrintTxnSummary,
+		TxnCoalescingWindow:           defaultTxnCoalescingWindow,
+		TxnCoalescingMaxDelay:         defaultTxnCoalescingMaxDelay,
 	}
 
 	// load configuration
@@ -66,6 +66,19 @@ type nbTxn struct {
 	withSimulation  bool
 	description     string
 	resultChan      chan txnResult
+
+	// keys changed by the caller of this transaction, set only once other
+	// transactions are coalesced into it
+	ownKeys map[string]struct{}
+	// callers of blocking transactions coalesced into this one
+	coalescedCallers []*txnCaller
+}
+
+// txnCaller is a caller of blocking NB transaction coalesced into another
+// transaction.
+type txnCaller struct {
+	resultChan chan txnResult
+	keys       map[string]struct{}
 }
 
 type implTxn struct {
@@ -97,10 +110,20 @@ type txnResult struct {
 // consumeTransactions pulls the oldest queued transaction and starts the processing.
 func (s *Scheduler) consumeTransactions() {
 	defer s.wg.Done()
+	var next *transaction
 	for {
-		txn, canceled := s.dequeueTxn()
-		if canceled {
-			return
+		txn, canceled := next, false
+		if txn == nil {
+			txn, canceled = s.dequeueTxn()
+			if canceled {
+				return
+			}
+		}
+		if s.config.TxnCoalescingWindow > 0 {
+			txn, next, canceled = s.coalesceTxns(txn)
+			if canceled {
+				return
+			}
 		}
 		reportQueueWait(txn.txnType, time.Since(txn.created).Seconds())
 		s.processTransaction(txn)
@@ -406,14 +429,22 @@ func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.Record
 	if len(kvErrors) > 0 {
 		txnErr = kvs.NewTransactionError(nil, kvErrors)
 	}
+	var baseKeys map[string]string
+	if txn.txnType == kvs.NBTransaction && txn.nb.ownKeys != nil {
+		// callers of coalesced transactions get only errors of their own values
+		baseKeys = s.getErrorBaseKeys(kvErrors)
+		for _, caller := range txn.nb.coalescedCallers {
+			s.deliverTxnResult(txn, caller.resultChan,
+				callerTxnError(txn, kvErrors, baseKeys, caller.keys))
+		}
+	}
 	if txn.txnType == kvs.NBTransaction && txn.nb.isBlocking {
 		// for blocking txn, send non-nil errors to the resultChan
-		select {
-		case txn.nb.resultChan <- txnResult{txnSeqNum: txn.seqNum, err: txnErr}:
-		default:
-			s.Log.WithField("txnSeq", txn.seqNum).
-				Warn("Failed to deliver transaction result to the caller")
+		callerErr := txnErr
+		if txn.nb.ownKeys != nil {
+			callerErr = callerTxnError(txn, kvErrors, baseKeys, txn.nb.ownKeys)
 		}
+		s.deliverTxnResult(txn, txn.nb.resultChan, callerErr)
 	} else {
 		// for asynchronous events, just log the transaction error
 		if txnErr == nil {
@@ -447,6 +478,54 @@ func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.Record
 	}
 }
 
+// deliverTxnResult sends the result of the transaction to the caller waiting
+// on blocking Commit.
+func (s *Scheduler) deliverTxnResult(txn *transaction, resultChan chan txnResult, txnErr error) {
+	select {
+	case resultChan <- txnResult{txnSeqNum: txn.seqNum, err: txnErr}:
+	default:
+		s.Log.WithField("txnSeq", txn.seqNum).
+			Warn("Failed to deliver transaction result to the caller")
+	}
+}
+
+// getErrorBaseKeys returns keys of the base values for the values that failed
+// in the transaction.
+func (s *Scheduler) getErrorBaseKeys(kvErrors []kvs.KeyWithError) map[string]string {
+	baseKeys := make(map[string]string, len(kvErrors))
+	graphR := s.graph.Read()
+	defer graphR.Release()
+	for _, kvErr := range kvErrors {
+		baseKeys[kvErr.Key] = kvErr.Key
+		if node := graphR.GetNode(kvErr.Key); node != nil {
+			baseKeys[kvErr.Key] = getNodeBaseKey(node)
+		}
+	}
+	return baseKeys
+}
+
+// callerTxnError returns error of the coalesced transaction as seen by the caller
+// who changed the given keys. Failures of values changed only by the other callers
+// are left out, failures of values not changed by any of the callers (e.g. values
+// updated because of changed dependencies) are reported to everyone.
+func callerTxnError(txn *transaction, kvErrors []kvs.KeyWithError, baseKeys map[string]string,
+	callerKeys map[string]struct{}) error {
+	txnKeys := txnValueKeys(txn)
+	var callerErrors []kvs.KeyWithError
+	for _, kvErr := range kvErrors {
+		baseKey := baseKeys[kvErr.Key]
+		_, ownValue := callerKeys[baseKey]
+		_, txnValue := txnKeys[baseKey]
+		if ownValue || !txnValue {
+			callerErrors = append(callerErrors, kvErr)
+		}
+	}
+	if len(callerErrors) == 0 {
+		return nil
+	}
+	return kvs.NewTransactionError(nil, callerErrors)
+}
+
 func (s *Scheduler) scheduleUnimpl(txn *transaction, graphR graph.ReadAccess, toImpl utils.KeySet) {
 	if txn.txnType == kvs.RetryUnimplOps {
 		return
@@ -62,6 +62,117 @@ func (s *Scheduler) dequeueTxn() (txn *transaction, canceled bool) {
 	}
 }
 
+// coalesceTxns merges NB transactions following the given transaction into
+// one net change as long as they arrive within the coalescing window and touch
+// keys overlapping with the transaction. The first dequeued transaction that
+// cannot be merged is returned as <next> to be processed afterwards.
+// Execution of the transaction is postponed by at most TxnCoalescingMaxDelay.
+func (s *Scheduler) coalesceTxns(txn *transaction) (merged, next *transaction, canceled bool) {
+	if !canCoalesceTxn(txn) {
+		return txn, nil, false
+	}
+	window := time.Duration(s.config.TxnCoalescingWindow) * time.Millisecond
+	deadline := time.Now().Add(time.Duration(s.config.TxnCoalescingMaxDelay) * time.Millisecond)
+	count := 1
+	for {
+		wait := window
+		if untilDeadline := time.Until(deadline); untilDeadline < wait {
+			wait = untilDeadline
+		}
+		if wait <= 0 {
+			break
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return nil, nil, true
+		case <-timer.C:
+			return txn, nil, false
+		case next = <-s.txnQueue:
+			timer.Stop()
+			reportQueued(-1)
+		}
+		if !canCoalesceTxn(next) || !overlappingTxns(txn, next) {
+			return txn, next, false
+		}
+		mergeTxns(txn, next)
+		count++
+		s.Log.Debugf("Coalesced %d NB transactions", count)
+	}
+	return txn, nil, false
+}
+
+// canCoalesceTxn returns true if the transaction can be merged with other
+// NB transactions. Resync and transactions with revert are executed as they are.
+func canCoalesceTxn(txn *transaction) bool {
+	return txn.txnType == kvs.NBTransaction &&
+		txn.nb.resyncType == kvs.NotResync &&
+		!txn.nb.revertOnFailure
+}
+
+// overlappingTxns returns true if the transactions change at least one common key.
+func overlappingTxns(txn1, txn2 *transaction) bool {
+	keys := txnValueKeys(txn1)
+	for _, kv := range txn2.values {
+		if _, overlaps := keys[kv.key]; overlaps {
+			return true
+		}
+	}
+	return false
+}
+
+// txnValueKeys returns the set of keys changed by the transaction.
+func txnValueKeys(txn *transaction) map[string]struct{} {
+	keys := make(map[string]struct{}, len(txn.values))
+	for _, kv := range txn.values {
+		keys[kv.key] = struct{}{}
+	}
+	return keys
+}
+
+// mergeTxns merges NB transaction <txn2> into <txn1>. Values of <txn2> take
+// precedence. Caller of blocking <txn2> receives the result of <txn1> limited
+// to the values changed by <txn2>.
+func mergeTxns(txn1, txn2 *transaction) {
+	nb1, nb2 := txn1.nb, txn2.nb
+	if nb1.ownKeys == nil {
+		nb1.ownKeys = txnValueKeys(txn1)
+	}
+	if nb2.isBlocking {
+		nb1.coalescedCallers = append(nb1.coalescedCallers, &txnCaller{
+			resultChan: nb2.resultChan,
+			keys:       txnValueKeys(txn2),
+		})
+	}
+	nb1.coalescedCallers = append(nb1.coalescedCallers, nb2.coalescedCallers...)
+
+	keyIdx := make(map[string]int, len(txn1.values))
+	for i, kv := range txn1.values {
+		keyIdx[kv.key] = i
+	}
+	for _, kv := range txn2.values {
+		if i, hasKey := keyIdx[kv.key]; hasKey {
+			txn1.values[i] = kv
+			continue
+		}
+		keyIdx[kv.key] = len(txn1.values)
+		txn1.values = append(txn1.values, kv)
+	}
+
+	if nb2.retryEnabled {
+		nb1.retryEnabled = true
+		nb1.retryArgs = nb2.retryArgs
+	}
+	nb1.withSimulation = nb1.withSimulation || nb2.withSimulation
+	if nb2.description != "" {
+		if nb1.description != "" {
+			nb1.description += "; "
+		}
+		nb1.description += nb2.description
+	}
+}
+
 // enqueueRetry schedules retry for failed operations.
 func (s *Scheduler) enqueueRetry(args *retryTxn) {
 	go s.delayRetry(args)
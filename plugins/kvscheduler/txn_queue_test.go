@@ -0,0 +1,250 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+)
+
+func nbTxnWithValues(blocking bool, values ...kvForTxn) *transaction {
+	txn := &transaction{
+		txnType: NBTransaction,
+		nb:      &nbTxn{isBlocking: blocking},
+		values:  values,
+	}
+	if blocking {
+		txn.nb.resultChan = make(chan txnResult, 1)
+	}
+	return txn
+}
+
+func TestCanCoalesceTxn(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(canCoalesceTxn(nbTxnWithValues(false))).To(BeTrue())
+
+	resync := nbTxnWithValues(false)
+	resync.nb.resyncType = FullResync
+	Expect(canCoalesceTxn(resync)).To(BeFalse())
+
+	withRevert := nbTxnWithValues(false)
+	withRevert.nb.revertOnFailure = true
+	Expect(canCoalesceTxn(withRevert)).To(BeFalse())
+
+	Expect(canCoalesceTxn(&transaction{txnType: SBNotification})).To(BeFalse())
+}
+
+func TestMergeTxns(t *testing.T) {
+	RegisterTestingT(t)
+
+	txn1 := nbTxnWithValues(false,
+		kvForTxn{key: prefixA + baseValue1, value: test.NewStringValue("a")},
+		kvForTxn{key: prefixA + baseValue2, value: test.NewStringValue("b")})
+	txn1.nb.description = "first"
+	txn2 := nbTxnWithValues(true,
+		kvForTxn{key: prefixA + baseValue1, value: nil},
+		kvForTxn{key: prefixA + baseValue3, value: test.NewStringValue("c")})
+	txn2.nb.description = "second"
+	txn3 := nbTxnWithValues(false,
+		kvForTxn{key: prefixB + baseValue1, value: test.NewStringValue("d")})
+
+	Expect(overlappingTxns(txn1, txn2)).To(BeTrue())
+	Expect(overlappingTxns(txn1, txn3)).To(BeFalse())
+
+	mergeTxns(txn1, txn2)
+	Expect(txn1.values).To(HaveLen(3))
+	Expect(txn1.values[0].key).To(Equal(prefixA + baseValue1))
+	Expect(txn1.values[0].value).To(BeNil())
+	Expect(txn1.values[1].key).To(Equal(prefixA + baseValue2))
+	Expect(txn1.values[2].key).To(Equal(prefixA + baseValue3))
+	Expect(txn1.nb.description).To(Equal("first; second"))
+	Expect(txn1.nb.isBlocking).To(BeFalse())
+	Expect(txn1.nb.ownKeys).To(Equal(map[string]struct{}{
+		prefixA + baseValue1: {},
+		prefixA + baseValue2: {},
+	}))
+	Expect(txn1.nb.coalescedCallers).To(HaveLen(1))
+	Expect(txn1.nb.coalescedCallers[0].resultChan).To(Equal(txn2.nb.resultChan))
+	Expect(txn1.nb.coalescedCallers[0].keys).To(Equal(map[string]struct{}{
+		prefixA + baseValue1: {},
+		prefixA + baseValue3: {},
+	}))
+}
+
+func TestCallerTxnError(t *testing.T) {
+	RegisterTestingT(t)
+
+	txn := nbTxnWithValues(true,
+		kvForTxn{key: prefixA + baseValue1, value: test.NewStringValue("a")},
+		kvForTxn{key: prefixA + baseValue2, value: test.NewStringValue("b")})
+	kvErrors := []KeyWithError{
+		{Key: prefixA + baseValue1 + "/item1", Error: errors.New("derived value failed")},
+		{Key: prefixA + baseValue2, Error: errors.New("value failed")},
+		{Key: prefixB + baseValue1, Error: errors.New("dependent value failed")},
+	}
+	baseKeys := map[string]string{
+		prefixA + baseValue1 + "/item1": prefixA + baseValue1,
+		prefixA + baseValue2:            prefixA + baseValue2,
+		prefixB + baseValue1:            prefixB + baseValue1,
+	}
+
+	// errors of own values (including derived) and of values not changed by any caller
+	err := callerTxnError(txn, kvErrors, baseKeys, map[string]struct{}{prefixA + baseValue1: {}})
+	txnErr, isTxnErr := err.(*TransactionError)
+	Expect(isTxnErr).To(BeTrue())
+	Expect(txnErr.GetKVErrors()).To(HaveLen(2))
+	Expect(txnErr.GetKVErrors()[0].Key).To(Equal(prefixA + baseValue1 + "/item1"))
+	Expect(txnErr.GetKVErrors()[1].Key).To(Equal(prefixB + baseValue1))
+
+	// no error at all
+	err = callerTxnError(txn, kvErrors[:2], baseKeys, map[string]struct{}{prefixA + baseValue3: {}})
+	Expect(err).To(BeNil())
+}
+
+func TestCoalescedTxnResults(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler with coalescing enabled
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	scheduler.config.TxnCoalescingWindow = 200
+	scheduler.config.TxnCoalescingMaxDelay = 1000
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// descriptor for values blocking the processing of the transactions
+	createCalled := make(chan struct{})
+	releaseCreate := make(chan struct{})
+	scheduler.RegisterKVDescriptor(&KVDescriptor{
+		Name:          descriptor2Name,
+		NBKeyPrefix:   prefixB,
+		KeySelector:   prefixSelector(prefixB),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+		Create: func(key string, value proto.Message) (metadata Metadata, err error) {
+			close(createCalled)
+			<-releaseCreate
+			return nil, nil
+		},
+		Delete: func(key string, value proto.Message, metadata Metadata) error {
+			return nil
+		},
+	})
+
+	type txnOutcome struct {
+		seqNum uint64
+		err    error
+	}
+	var wg sync.WaitGroup
+	commitTxn := func(outcome *txnOutcome, values map[string]proto.Message) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			txn := scheduler.StartNBTransaction()
+			for key, value := range values {
+				txn.SetValue(key, value)
+			}
+			outcome.seqNum, outcome.err = txn.Commit(testCtx)
+		}()
+	}
+
+	// block the processing of transactions
+	var blocker txnOutcome
+	commitTxn(&blocker, map[string]proto.Message{
+		prefixB + baseValue1: test.NewStringValue("blocker"),
+	})
+	Eventually(createCalled, time.Second).Should(BeClosed())
+
+	// queue overlapping transactions, the last one does not overlap and is run separately
+	mockSB.PlanError(prefixA+baseValue3, errors.New("failed to create value"), nil)
+	var outcomes [4]txnOutcome
+	txnValues := []map[string]proto.Message{
+		{
+			prefixA + baseValue1: test.NewStringValue("a"),
+			prefixA + baseValue2: test.NewStringValue("b"),
+		},
+		{
+			prefixA + baseValue1: test.NewStringValue("c"),
+		},
+		{
+			prefixA + baseValue2: nil,
+			prefixA + baseValue3: test.NewStringValue("d"),
+		},
+		{
+			prefixA + baseValue4: test.NewStringValue("e"),
+		},
+	}
+	for i, values := range txnValues {
+		commitTxn(&outcomes[i], values)
+		// keep the order of the transactions in the queue
+		queued := i + 1
+		Eventually(func() int { return len(scheduler.txnQueue) }, time.Second).Should(Equal(queued))
+	}
+	close(releaseCreate)
+	wg.Wait()
+	Expect(blocker.err).ToNot(HaveOccurred())
+
+	// callers of the coalesced transactions got the result of the merged transaction,
+	// but only the caller of the failed value got the error
+	for i := 0; i < 3; i++ {
+		Expect(outcomes[i].seqNum).To(Equal(blocker.seqNum + 1))
+	}
+	Expect(outcomes[0].err).ToNot(HaveOccurred())
+	Expect(outcomes[1].err).ToNot(HaveOccurred())
+	Expect(outcomes[2].err).To(HaveOccurred())
+	txnErr, isTxnErr := outcomes[2].err.(*TransactionError)
+	Expect(isTxnErr).To(BeTrue())
+	Expect(txnErr.GetKVErrors()).To(HaveLen(1))
+	Expect(txnErr.GetKVErrors()[0].Key).To(Equal(prefixA + baseValue3))
+	// the non-overlapping transaction got its own result
+	Expect(outcomes[3].seqNum).To(Equal(blocker.seqNum + 2))
+	Expect(outcomes[3].err).ToNot(HaveOccurred())
+
+	// check the state of SB - values of the later transactions take precedence
+	value := mockSB.GetValue(prefixA + baseValue1)
+	Expect(value).ToNot(BeNil())
+	Expect(proto.Equal(value.Value, test.NewStringValue("c"))).To(BeTrue())
+	Expect(mockSB.GetValue(prefixA + baseValue2)).To(BeNil())
+	Expect(mockSB.GetValue(prefixA + baseValue3)).To(BeNil())
+	Expect(mockSB.GetValue(prefixA + baseValue4)).ToNot(BeNil())
+
+	// check transaction operations - only one transaction with the net change
+	txnHistory := scheduler.GetTransactionHistory(time.Time{}, time.Now())
+	Expect(txnHistory).To(HaveLen(3))
+	Expect(txnHistory[1].Values).To(HaveLen(3))
+
+	// close scheduler
+	err = scheduler.Close()
+	Expect(err).To(BeNil())
+}
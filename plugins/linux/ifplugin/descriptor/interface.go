@@ -50,6 +50,7 @@ import (
 	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
 	vpp_intf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
 )
 
 const (
@@ -69,6 +70,7 @@ const (
 	// dependency labels
 	existingHostInterfaceDep = "host-interface-exists"
 	tapInterfaceDep          = "vpp-tap-interface-exists"
+	lcpInterfacePairDep      = "vpp-lcp-interface-pair-exists"
 	vethPeerDep              = "veth-peer-exists"
 	microserviceDep          = "microservice-available"
 
@@ -106,6 +108,10 @@ var (
 	// is not loaded.
 	ErrTAPRequiresVPPIfPlugin = errors.New("TAP_TO_VPP interface requires VPP interface plugin to be loaded")
 
+	// ErrLCPWithoutVPPReference is returned when LCP_TO_VPP interface is missing
+	// reference to the paired VPP interface.
+	ErrLCPWithoutVPPReference = errors.New("LCP_TO_VPP interface defined without reference to VPP interface")
+
 	// ErrNamespaceWithoutReference is returned when namespace is missing reference.
 	ErrNamespaceWithoutReference = errors.New("namespace defined without name")
 
@@ -231,6 +237,10 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		if oldIntf.GetTap().GetVppTapIfName() != newIntf.GetTap().GetVppTapIfName() {
 			return false
 		}
+	case interfaces.Interface_LCP_TO_VPP:
+		if oldIntf.GetLcp().GetVppIfName() != newIntf.GetLcp().GetVppIfName() {
+			return false
+		}
 	case interfaces.Interface_VRF_DEVICE:
 		if oldIntf.GetVrfDev().GetRoutingTable() != newIntf.GetVrfDev().GetRoutingTable() {
 			return false
@@ -339,6 +349,13 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if linuxIf.GetTap().GetVppTapIfName() == "" {
 			return kvs.NewInvalidValueError(ErrTAPWithoutVPPReference, "vpp_tap_if_name")
 		}
+	case *interfaces.Interface_Lcp:
+		if linuxIf.GetType() != interfaces.Interface_LCP_TO_VPP {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+		if linuxIf.GetLcp().GetVppIfName() == "" {
+			return kvs.NewInvalidValueError(ErrLCPWithoutVPPReference, "vpp_if_name")
+		}
 	case *interfaces.Interface_Veth:
 		if linuxIf.GetType() != interfaces.Interface_VETH {
 			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
@@ -368,6 +385,8 @@ func (d *InterfaceDescriptor) Create(key string, linuxIf *interfaces.Interface)
 		metadata, err = d.createVETH(nsCtx, key, linuxIf)
 	case interfaces.Interface_TAP_TO_VPP:
 		metadata, err = d.createTAPToVPP(nsCtx, key, linuxIf)
+	case interfaces.Interface_LCP_TO_VPP:
+		metadata, err = d.createLCPToVPP(nsCtx, key, linuxIf)
 	case interfaces.Interface_LOOPBACK:
 		metadata, err = d.createLoopback(nsCtx, linuxIf)
 	case interfaces.Interface_EXISTING:
@@ -482,6 +501,8 @@ func (d *InterfaceDescriptor) Delete(key string, linuxIf *interfaces.Interface,
 		return d.deleteVETH(nsCtx, key, linuxIf, metadata)
 	case interfaces.Interface_TAP_TO_VPP:
 		return d.deleteAutoTAP(nsCtx, key, linuxIf, metadata)
+	case interfaces.Interface_LCP_TO_VPP:
+		return d.deleteLCPToVPP(key, linuxIf)
 	case interfaces.Interface_LOOPBACK:
 		return d.deleteLoopback(nsCtx, linuxIf)
 	case interfaces.Interface_EXISTING:
@@ -616,6 +637,10 @@ func (d *InterfaceDescriptor) UpdateWithRecreate(key string, oldLinuxIf, newLinu
 		return oldLinuxIf.GetVeth().GetPeerIfName() != newLinuxIf.GetVeth().GetPeerIfName()
 	case interfaces.Interface_TAP_TO_VPP:
 		return oldLinuxIf.GetTap().GetVppTapIfName() != newLinuxIf.GetTap().GetVppTapIfName()
+	case interfaces.Interface_LCP_TO_VPP:
+		// host interface is named by the linux-cp plugin and cannot be renamed
+		return oldLinuxIf.GetLcp().GetVppIfName() != newLinuxIf.GetLcp().GetVppIfName() ||
+			getHostIfName(oldLinuxIf) != getHostIfName(newLinuxIf)
 	case interfaces.Interface_VRF_DEVICE:
 		return oldLinuxIf.GetVrfDev().GetRoutingTable() != newLinuxIf.GetVrfDev().GetRoutingTable()
 	}
@@ -640,6 +665,13 @@ func (d *InterfaceDescriptor) Dependencies(key string, linuxIf *interfaces.Inter
 			Key:   vpp_intf.InterfaceKey(linuxIf.GetTap().GetVppTapIfName()),
 		})
 	}
+	if linuxIf.Type == interfaces.Interface_LCP_TO_VPP {
+		// dependency on linux-cp pair creating the host interface
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: lcpInterfacePairDep,
+			Key:   vpp_lcp.InterfacePairKey(linuxIf.GetLcp().GetVppIfName()),
+		})
+	}
 
 	// circular dependency between VETH ends
 	if linuxIf.Type == interfaces.Interface_VETH {
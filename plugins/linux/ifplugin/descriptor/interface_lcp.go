@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createLCPToVPP associates host interface created by the VPP linux-cp plugin
+// with the logical name of the LCP_TO_VPP interface. The host interface is expected
+// to already exist in the destination namespace under the requested host name.
+func (d *InterfaceDescriptor) createLCPToVPP(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, key string, linuxIf *interfaces.Interface,
+) (
+	md *ifaceidx.LinuxIfMetadata, err error) {
+
+	hostName := getHostIfName(linuxIf)
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	// move to the namespace with the interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	defer revert()
+
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		err = errors.Errorf("failed to find host interface %s of the LCP-To-VPP interface %s: %v",
+			hostName, linuxIf.Name, err)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	// add alias to associate the host interface with the logical name and VPP interface reference
+	alias := agentPrefix + linuxcalls.GetLcpAlias(linuxIf)
+	err = d.ifHandler.SetInterfaceAlias(hostName, alias)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+	}, nil
+}
+
+// deleteLCPToVPP only removes the alias from the host interface, which is owned
+// by the VPP linux-cp plugin and removed together with the interface pair.
+func (d *InterfaceDescriptor) deleteLCPToVPP(key string, linuxIf *interfaces.Interface) error {
+	// vishvananda/netlink does not support alias removal, so we just change
+	// it to a string which is not prefixed with agent label
+	err := d.ifHandler.SetInterfaceAlias(getHostIfName(linuxIf), "unconfigured")
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/cn-infra/v2/servicelabel"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/linuxmock"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+func TestInterfaceDescriptorConformance(t *testing.T) {
+	log := logrus.NewLogger("test-log")
+	serviceLabel := &servicelabel.Plugin{}
+	ifHandler := linuxmock.NewIfHandlerMock(serviceLabel.GetAgentPrefix())
+
+	ifDescriptor, ctx := descriptor.NewInterfaceDescriptor(serviceLabel, linuxmock.NewNsPluginMock(),
+		nil, netalloc_mock.NewMockNetAlloc(), log)
+	ctx.SetInterfaceIndex(ifaceidx.NewLinuxIfIndex(log, "linux-if-idx"))
+	ctx.SetInterfaceHandler(ifHandler)
+
+	suite := &descriptortest.Suite{
+		Descriptor: ifDescriptor,
+		Southbound: ifHandler,
+		Samples: []descriptortest.Sample{
+			{
+				Value: &interfaces.Interface{
+					Name:        "dummy1",
+					Type:        interfaces.Interface_DUMMY,
+					Enabled:     true,
+					PhysAddress: "02:00:00:00:00:01",
+					Mtu:         1450,
+				},
+				Updates: []proto.Message{
+					&interfaces.Interface{
+						Name:        "dummy1",
+						Type:        interfaces.Interface_DUMMY,
+						PhysAddress: "02:00:00:00:00:01",
+						Mtu:         1450,
+					},
+					&interfaces.Interface{
+						Name:        "dummy1",
+						Type:        interfaces.Interface_DUMMY,
+						Enabled:     true,
+						PhysAddress: "02:00:00:00:00:02",
+						Mtu:         9000,
+					},
+					&interfaces.Interface{
+						Name:        "dummy1",
+						Type:        interfaces.Interface_DUMMY,
+						HostIfName:  "dummy-host",
+						Enabled:     true,
+						PhysAddress: "02:00:00:00:00:02",
+					},
+				},
+			},
+			{
+				Value: &interfaces.Interface{
+					Name:    "dummy2",
+					Type:    interfaces.Interface_DUMMY,
+					Enabled: true,
+				},
+				Updates: []proto.Message{
+					&interfaces.Interface{
+						Name:    "dummy2",
+						Type:    interfaces.Interface_DUMMY,
+						Enabled: true,
+						Mtu:     9000,
+					},
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
@@ -38,6 +38,9 @@ const (
 	// minimum number of namespaces to be given to a single Go routine for processing
 	// in the Retrieve operation
 	minWorkForGoRoutine = 3
+
+	// prefix of the alias of LCP_TO_VPP interfaces (after the agent prefix)
+	lcpAliasPrefix = "lcp:"
 )
 
 // retrievedIfaces is used as the return value sent via channel by retrieveInterfaces().
@@ -198,6 +201,29 @@ func ParseTapAlias(alias string) (linuxTapName, vppTapName, origHostIfName strin
 	return
 }
 
+// GetLcpAlias returns alias for Linux host interface of a linux-cp pair managed by the agent.
+// The alias stores the LCP_TO_VPP logical name together with the logical name of the paired
+// VPP interface. The alias is prefixed to be distinguishable from TAP_TO_VPP, both being
+// TAP/TUN interfaces created by VPP.
+func GetLcpAlias(linuxIf *interfaces.Interface) string {
+	return lcpAliasPrefix + linuxIf.Name + "/" + linuxIf.GetLcp().GetVppIfName()
+}
+
+// ParseLcpAlias parses out LCP_TO_VPP logical name together with the name of the
+// paired VPP interface. Returns false if the alias does not belong to LCP_TO_VPP interface.
+func ParseLcpAlias(alias string) (linuxIfName, vppIfName string, isLcp bool) {
+	if !strings.HasPrefix(alias, lcpAliasPrefix) {
+		return "", "", false
+	}
+	// VPP interface name may contain slashes (e.g. GigabitEthernet0/8/0)
+	aliasParts := strings.SplitN(strings.TrimPrefix(alias, lcpAliasPrefix), "/", 2)
+	linuxIfName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		vppIfName = aliasParts[1]
+	}
+	return linuxIfName, vppIfName, true
+}
+
 // GetDummyIfAlias returns alias for Linux Dummy interface managed by the agent.
 func GetDummyIfAlias(linuxIf *interfaces.Interface) string {
 	return linuxIf.Name
@@ -277,13 +303,23 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 				iface.Type = interfaces.Interface_DUMMY
 				iface.Name = ParseDummyIfAlias(alias)
 			} else if link.Type() == "tuntap" || link.Type() == "tun" /* not defined in vishvananda */ {
-				iface.Type = interfaces.Interface_TAP_TO_VPP
-				var vppTapIfName string
-				iface.Name, vppTapIfName, _ = ParseTapAlias(alias)
-				iface.Link = &interfaces.Interface_Tap{
-					Tap: &interfaces.TapLink{
-						VppTapIfName: vppTapIfName,
-					},
+				if lcpName, vppIfName, isLcp := ParseLcpAlias(alias); isLcp {
+					iface.Type = interfaces.Interface_LCP_TO_VPP
+					iface.Name = lcpName
+					iface.Link = &interfaces.Interface_Lcp{
+						Lcp: &interfaces.LcpLink{
+							VppIfName: vppIfName,
+						},
+					}
+				} else {
+					iface.Type = interfaces.Interface_TAP_TO_VPP
+					var vppTapIfName string
+					iface.Name, vppTapIfName, _ = ParseTapAlias(alias)
+					iface.Link = &interfaces.Interface_Tap{
+						Tap: &interfaces.TapLink{
+							VppTapIfName: vppTapIfName,
+						},
+					}
 				}
 			} else if link.Type() == "vrf" {
 				vrfDev, isVrf := link.(*netlink.Vrf)
@@ -299,6 +299,9 @@ func (d *RuleChainDescriptor) Retrieve(correlate []adapter.RuleChainKVWithMetada
 	if goRoutinesCnt > d.goRoutinesCnt {
 		goRoutinesCnt = d.goRoutinesCnt
 	}
+	if goRoutinesCnt < 1 {
+		goRoutinesCnt = 1
+	}
 
 	ch := make(chan retrievedRuleChains, goRoutinesCnt)
 
@@ -0,0 +1,66 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/linuxmock"
+	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
+)
+
+func TestRuleChainDescriptorConformance(t *testing.T) {
+	ipTablesHandler := linuxmock.NewIPTablesHandlerMock()
+
+	suite := &descriptortest.Suite{
+		Descriptor: descriptor.NewRuleChainDescriptor(nil, ipTablesHandler, linuxmock.NewNsPluginMock(),
+			logrus.NewLogger("test-log"), 1, 0),
+		Southbound: ipTablesHandler,
+		Samples: []descriptortest.Sample{
+			{
+				Value: &linux_iptables.RuleChain{
+					Name:      "filter-input",
+					Table:     linux_iptables.RuleChain_FILTER,
+					ChainType: linux_iptables.RuleChain_INPUT,
+					Rules:     []string{"-i eth0 -s 192.168.0.1 -j ACCEPT"},
+				},
+				Updates: []proto.Message{
+					&linux_iptables.RuleChain{
+						Name:      "filter-input",
+						Table:     linux_iptables.RuleChain_FILTER,
+						ChainType: linux_iptables.RuleChain_INPUT,
+						Rules:     []string{"-i eth0 -s 192.168.0.1 -j ACCEPT", "-i eth1 -j DROP"},
+					},
+				},
+			},
+			{
+				Value: &linux_iptables.RuleChain{
+					Name:      "nat-custom",
+					Protocol:  linux_iptables.RuleChain_IPV6,
+					Table:     linux_iptables.RuleChain_NAT,
+					ChainType: linux_iptables.RuleChain_CUSTOM,
+					ChainName: "CUSTOM",
+					Rules:     []string{"-p tcp -j RETURN"},
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/linuxmock"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+)
+
+func TestARPDescriptorConformance(t *testing.T) {
+	ifIndex := testIfIndex()
+	l3Handler := linuxmock.NewL3HandlerMock(ifIndex)
+
+	suite := &descriptortest.Suite{
+		Descriptor: descriptor.NewARPDescriptor(nil, linuxmock.NewIfPluginMock(ifIndex),
+			linuxmock.NewNsPluginMock(), netalloc_mock.NewMockNetAlloc(), l3Handler,
+			logrus.NewLogger("test-log"), 1),
+		Southbound: l3Handler,
+		Samples: []descriptortest.Sample{
+			{
+				Value: &l3.ARPEntry{
+					Interface: "if1",
+					IpAddress: "10.0.0.2",
+					HwAddress: "02:00:00:00:00:01",
+				},
+				Updates: []proto.Message{
+					&l3.ARPEntry{
+						Interface: "if1",
+						IpAddress: "10.0.0.2",
+						HwAddress: "02:00:00:00:00:AA",
+					},
+				},
+			},
+			{
+				Value: &l3.ARPEntry{
+					Interface: "if2",
+					IpAddress: "2001:db8::2",
+					HwAddress: "02:00:00:00:00:02",
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
+
+// testIfIndex returns index with Linux interfaces referenced by the samples.
+func testIfIndex() ifaceidx.LinuxIfMetadataIndexRW {
+	ifIndex := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-log"), "linux-if-idx")
+	ifIndex.Put("if1", &ifaceidx.LinuxIfMetadata{LinuxIfIndex: 1, HostIfName: "if1"})
+	ifIndex.Put("if2", &ifaceidx.LinuxIfMetadata{LinuxIfIndex: 2, HostIfName: "if2"})
+	return ifIndex
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/linuxmock"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+)
+
+func TestRouteDescriptorConformance(t *testing.T) {
+	ifIndex := testIfIndex()
+	l3Handler := linuxmock.NewL3HandlerMock(ifIndex)
+
+	suite := &descriptortest.Suite{
+		Descriptor: descriptor.NewRouteDescriptor(nil, linuxmock.NewIfPluginMock(ifIndex),
+			linuxmock.NewNsPluginMock(), netalloc_mock.NewMockNetAlloc(), l3Handler,
+			logrus.NewLogger("test-log"), 1),
+		Southbound: l3Handler,
+		Samples: []descriptortest.Sample{
+			{
+				Value: &l3.Route{
+					OutgoingInterface: "if1",
+					DstNetwork:        "10.1.0.0/24",
+					GwAddr:            "10.0.0.1",
+					Scope:             l3.Route_GLOBAL,
+					Metric:            10,
+				},
+				Updates: []proto.Message{
+					// updated in-place
+					&l3.Route{
+						OutgoingInterface: "if1",
+						DstNetwork:        "10.1.0.0/24",
+						GwAddr:            "10.0.0.254",
+						Scope:             l3.Route_GLOBAL,
+						Metric:            10,
+					},
+					// re-created
+					&l3.Route{
+						OutgoingInterface: "if1",
+						DstNetwork:        "10.1.0.0/24",
+						GwAddr:            "10.0.0.254",
+						Scope:             l3.Route_GLOBAL,
+						Metric:            20,
+					},
+				},
+			},
+			{
+				Value: &l3.Route{
+					OutgoingInterface: "if1",
+					DstNetwork:        "10.2.0.0/24",
+					Scope:             l3.Route_LINK,
+				},
+			},
+			{
+				Value: &l3.Route{
+					OutgoingInterface: "if2",
+					DstNetwork:        "2001:db8:1::/64",
+					GwAddr:            "2001:db8::1",
+					Metric:            1024,
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
@@ -0,0 +1,492 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxmock
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	namespaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+const defaultLinkMTU = 1500
+
+// IfHandlerMock is an in-memory implementation of the Linux interface netlink handler.
+// All links are kept in a single (default) namespace and only DUMMY, VETH
+// and VRF_DEVICE interfaces are supported. MAC address of a new link is derived
+// from its name, so that re-created links are the same as updated ones.
+type IfHandlerMock struct {
+	agentPrefix string
+
+	mx        sync.Mutex
+	links     map[string]*mockLink // host name -> link
+	lastIndex int
+}
+
+type mockLink struct {
+	link       netlink.Link
+	addrs      []*net.IPNet
+	rxChecksum bool
+	txChecksum bool
+}
+
+// NewIfHandlerMock creates new instance of the mock with no links. Links with alias
+// starting with <agentPrefix> are dumped as configured by the agent.
+func NewIfHandlerMock(agentPrefix string) *IfHandlerMock {
+	mock := &IfHandlerMock{agentPrefix: agentPrefix}
+	mock.Reset()
+	return mock
+}
+
+// Reset removes all links.
+func (mock *IfHandlerMock) Reset() {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	mock.links = make(map[string]*mockLink)
+	mock.lastIndex = 0
+}
+
+// Sync does nothing, the mock state is always up-to-date.
+func (mock *IfHandlerMock) Sync() {}
+
+// AddVethInterfacePair implements NetlinkAPI.
+func (mock *IfHandlerMock) AddVethInterfacePair(ifName, peerIfName string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	if err := mock.checkNewLink(ifName, peerIfName); err != nil {
+		return err
+	}
+	mock.addLink(&netlink.Veth{LinkAttrs: mock.newLinkAttrs(ifName), PeerName: peerIfName})
+	mock.addLink(&netlink.Veth{LinkAttrs: mock.newLinkAttrs(peerIfName), PeerName: ifName})
+	return nil
+}
+
+// AddDummyInterface implements NetlinkAPI.
+func (mock *IfHandlerMock) AddDummyInterface(ifName string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	if err := mock.checkNewLink(ifName); err != nil {
+		return err
+	}
+	mock.addLink(&netlink.Dummy{LinkAttrs: mock.newLinkAttrs(ifName)})
+	return nil
+}
+
+// AddVRFDevice implements NetlinkAPI.
+func (mock *IfHandlerMock) AddVRFDevice(vrfDevName string, routingTable uint32) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	if err := mock.checkNewLink(vrfDevName); err != nil {
+		return err
+	}
+	mock.addLink(&netlink.Vrf{LinkAttrs: mock.newLinkAttrs(vrfDevName), Table: routingTable})
+	return nil
+}
+
+// PutInterfaceIntoVRF implements NetlinkAPI.
+func (mock *IfHandlerMock) PutInterfaceIntoVRF(ifName, vrfDevName string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return err
+	}
+	vrf, err := mock.getLink(vrfDevName)
+	if err != nil {
+		return err
+	}
+	link.link.Attrs().MasterIndex = vrf.link.Attrs().Index
+	return nil
+}
+
+// RemoveInterfaceFromVRF implements NetlinkAPI.
+func (mock *IfHandlerMock) RemoveInterfaceFromVRF(ifName, vrfDevName string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return err
+	}
+	link.link.Attrs().MasterIndex = 0
+	return nil
+}
+
+// DeleteInterface implements NetlinkAPI.
+func (mock *IfHandlerMock) DeleteInterface(ifName string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return err
+	}
+	delete(mock.links, ifName)
+	if veth, isVeth := link.link.(*netlink.Veth); isVeth {
+		// removing one VETH end removes the peer as well
+		delete(mock.links, veth.PeerName)
+	}
+	return nil
+}
+
+// SetInterfaceUp implements NetlinkAPI.
+func (mock *IfHandlerMock) SetInterfaceUp(ifName string) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		link.link.Attrs().Flags |= net.FlagUp
+		return nil
+	})
+}
+
+// SetInterfaceDown implements NetlinkAPI.
+func (mock *IfHandlerMock) SetInterfaceDown(ifName string) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		link.link.Attrs().Flags &^= net.FlagUp
+		return nil
+	})
+}
+
+// AddInterfaceIP implements NetlinkAPI.
+func (mock *IfHandlerMock) AddInterfaceIP(ifName string, addr *net.IPNet) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		for _, ipNet := range link.addrs {
+			if ipNet.String() == addr.String() {
+				return fmt.Errorf("address %s is already assigned to %s", addr, ifName)
+			}
+		}
+		link.addrs = append(link.addrs, addr)
+		return nil
+	})
+}
+
+// DelInterfaceIP implements NetlinkAPI.
+func (mock *IfHandlerMock) DelInterfaceIP(ifName string, addr *net.IPNet) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		for i, ipNet := range link.addrs {
+			if ipNet.String() == addr.String() {
+				link.addrs = append(link.addrs[:i], link.addrs[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("address %s is not assigned to %s", addr, ifName)
+	})
+}
+
+// SetInterfaceMac implements NetlinkAPI.
+func (mock *IfHandlerMock) SetInterfaceMac(ifName string, macAddress string) error {
+	hwAddr, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return err
+	}
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		link.link.Attrs().HardwareAddr = hwAddr
+		return nil
+	})
+}
+
+// SetInterfaceMTU implements NetlinkAPI.
+func (mock *IfHandlerMock) SetInterfaceMTU(ifName string, mtu int) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		link.link.Attrs().MTU = mtu
+		return nil
+	})
+}
+
+// RenameInterface implements NetlinkAPI.
+func (mock *IfHandlerMock) RenameInterface(ifName string, newName string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return err
+	}
+	if err := mock.checkNewLink(newName); err != nil {
+		return err
+	}
+	delete(mock.links, ifName)
+	link.link.Attrs().Name = newName
+	mock.links[newName] = link
+	if veth, isVeth := link.link.(*netlink.Veth); isVeth {
+		if peer, hasPeer := mock.links[veth.PeerName]; hasPeer {
+			peer.link.(*netlink.Veth).PeerName = newName
+		}
+	}
+	return nil
+}
+
+// SetInterfaceAlias implements NetlinkAPI.
+func (mock *IfHandlerMock) SetInterfaceAlias(ifName, alias string) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		link.link.Attrs().Alias = alias
+		return nil
+	})
+}
+
+// SetLinkNamespace implements NetlinkAPI. Namespaces are not modelled by the mock.
+func (mock *IfHandlerMock) SetLinkNamespace(link netlink.Link, ns netns.NsHandle) error {
+	return nil
+}
+
+// SetChecksumOffloading implements NetlinkAPI.
+func (mock *IfHandlerMock) SetChecksumOffloading(ifName string, rxOn, txOn bool) error {
+	return mock.updateLink(ifName, func(link *mockLink) error {
+		link.rxChecksum, link.txChecksum = rxOn, txOn
+		return nil
+	})
+}
+
+// GetLinkByName implements NetlinkAPI.
+func (mock *IfHandlerMock) GetLinkByName(ifName string) (netlink.Link, error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return nil, err
+	}
+	return link.link, nil
+}
+
+// GetLinkByIndex implements NetlinkAPI.
+func (mock *IfHandlerMock) GetLinkByIndex(ifIdx int) (netlink.Link, error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	for _, link := range mock.links {
+		if link.link.Attrs().Index == ifIdx {
+			return link.link, nil
+		}
+	}
+	return nil, fmt.Errorf("link with index %d not found", ifIdx)
+}
+
+// GetLinkList implements NetlinkAPI.
+func (mock *IfHandlerMock) GetLinkList() (links []netlink.Link, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	for _, name := range sortedKeys(mock.links) {
+		links = append(links, mock.links[name].link)
+	}
+	return links, nil
+}
+
+// LinkSubscribe implements NetlinkAPI. No notifications are sent by the mock.
+func (mock *IfHandlerMock) LinkSubscribe(ch chan<- netlink.LinkUpdate, done <-chan struct{}) error {
+	return nil
+}
+
+// AddrSubscribe implements NetlinkAPI. No notifications are sent by the mock.
+func (mock *IfHandlerMock) AddrSubscribe(ch chan<- netlink.AddrUpdate, done <-chan struct{}) error {
+	return nil
+}
+
+// GetAddressList implements NetlinkAPI.
+func (mock *IfHandlerMock) GetAddressList(ifName string) (addrs []netlink.Addr, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return nil, err
+	}
+	for _, ipNet := range link.addrs {
+		addrs = append(addrs, netlink.Addr{IPNet: ipNet})
+	}
+	return addrs, nil
+}
+
+// InterfaceExists implements NetlinkAPI.
+func (mock *IfHandlerMock) InterfaceExists(ifName string) (bool, error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	_, exists := mock.links[ifName]
+	return exists, nil
+}
+
+// IsInterfaceUp implements NetlinkAPI.
+func (mock *IfHandlerMock) IsInterfaceUp(ifName string) (bool, error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return false, err
+	}
+	return link.link.Attrs().Flags&net.FlagUp == net.FlagUp, nil
+}
+
+// GetInterfaceType implements NetlinkAPI.
+func (mock *IfHandlerMock) GetInterfaceType(ifName string) (string, error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return "", err
+	}
+	return link.link.Type(), nil
+}
+
+// GetChecksumOffloading implements NetlinkAPI.
+func (mock *IfHandlerMock) GetChecksumOffloading(ifName string) (rxOn, txOn bool, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return false, false, err
+	}
+	return link.rxChecksum, link.txChecksum, nil
+}
+
+// DumpInterfaces implements NetlinkAPI.
+func (mock *IfHandlerMock) DumpInterfaces() ([]*linuxcalls.InterfaceDetails, error) {
+	return mock.DumpInterfacesFromNamespaces(nil)
+}
+
+// DumpInterfacesFromNamespaces implements NetlinkAPI. The namespace list is ignored,
+// all links configured by the agent are dumped from the default namespace.
+func (mock *IfHandlerMock) DumpInterfacesFromNamespaces(nsList []*namespaces.NetNamespace) (
+	ifaces []*linuxcalls.InterfaceDetails, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	vrfDevs := make(map[int]string) // vrf index -> vrf name
+	for _, name := range sortedKeys(mock.links) {
+		link := mock.links[name]
+		attrs := link.link.Attrs()
+		if !strings.HasPrefix(attrs.Alias, mock.agentPrefix) {
+			// skip interface not configured by this agent
+			continue
+		}
+		alias := strings.TrimPrefix(attrs.Alias, mock.agentPrefix)
+		iface := &interfaces.Interface{
+			HostIfName:  attrs.Name,
+			PhysAddress: attrs.HardwareAddr.String(),
+			Mtu:         uint32(attrs.MTU),
+			Enabled:     attrs.Flags&net.FlagUp == net.FlagUp,
+		}
+		switch l := link.link.(type) {
+		case *netlink.Veth:
+			var peerName string
+			iface.Type = interfaces.Interface_VETH
+			iface.Name, peerName = linuxcalls.ParseVethAlias(alias)
+			iface.Link = &interfaces.Interface_Veth{Veth: &interfaces.VethLink{PeerIfName: peerName}}
+			if !link.rxChecksum {
+				iface.GetVeth().RxChecksumOffloading = interfaces.VethLink_CHKSM_OFFLOAD_DISABLED
+			}
+			if !link.txChecksum {
+				iface.GetVeth().TxChecksumOffloading = interfaces.VethLink_CHKSM_OFFLOAD_DISABLED
+			}
+		case *netlink.Dummy:
+			iface.Type = interfaces.Interface_DUMMY
+			iface.Name = linuxcalls.ParseDummyIfAlias(alias)
+		case *netlink.Vrf:
+			iface.Type = interfaces.Interface_VRF_DEVICE
+			iface.Name = linuxcalls.ParseVRFAlias(alias)
+			iface.Link = &interfaces.Interface_VrfDev{VrfDev: &interfaces.VrfDevLink{RoutingTable: l.Table}}
+			vrfDevs[attrs.Index] = iface.Name
+		}
+		if iface.Name == "" {
+			continue
+		}
+		for _, ipNet := range link.addrs {
+			iface.IpAddresses = append(iface.IpAddresses, ipNet.String())
+		}
+		ifaces = append(ifaces, &linuxcalls.InterfaceDetails{
+			Interface: iface,
+			Meta: &linuxcalls.InterfaceMeta{
+				LinuxIfIndex: attrs.Index,
+				MasterIndex:  attrs.MasterIndex,
+			},
+		})
+	}
+	for _, iface := range ifaces {
+		if vrfDev, inVrf := vrfDevs[iface.Meta.MasterIndex]; inVrf {
+			iface.Interface.VrfMasterInterface = vrfDev
+		}
+	}
+	return ifaces, nil
+}
+
+// DumpInterfaceStats implements NetlinkAPI.
+func (mock *IfHandlerMock) DumpInterfaceStats() ([]*linuxcalls.InterfaceStatistics, error) {
+	return mock.DumpInterfaceStatsFromNamespaces(nil)
+}
+
+// DumpInterfaceStatsFromNamespaces implements NetlinkAPI. The mock has no traffic,
+// all the counters are zero.
+func (mock *IfHandlerMock) DumpInterfaceStatsFromNamespaces(nsList []*namespaces.NetNamespace) (
+	stats []*linuxcalls.InterfaceStatistics, err error) {
+	ifaces, err := mock.DumpInterfacesFromNamespaces(nsList)
+	if err != nil {
+		return nil, err
+	}
+	for _, iface := range ifaces {
+		stats = append(stats, &linuxcalls.InterfaceStatistics{
+			Name:         iface.Interface.Name,
+			Type:         iface.Interface.Type,
+			LinuxIfIndex: iface.Meta.LinuxIfIndex,
+		})
+	}
+	return stats, nil
+}
+
+func (mock *IfHandlerMock) checkNewLink(ifNames ...string) error {
+	for _, ifName := range ifNames {
+		if _, exists := mock.links[ifName]; exists {
+			return fmt.Errorf("link %s already exists", ifName)
+		}
+	}
+	return nil
+}
+
+func (mock *IfHandlerMock) newLinkAttrs(ifName string) netlink.LinkAttrs {
+	mock.lastIndex++
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = ifName
+	attrs.Index = mock.lastIndex
+	attrs.MTU = defaultLinkMTU
+	attrs.HardwareAddr = linkMAC(ifName)
+	return attrs
+}
+
+func (mock *IfHandlerMock) addLink(link netlink.Link) {
+	mock.links[link.Attrs().Name] = &mockLink{link: link, rxChecksum: true, txChecksum: true}
+}
+
+func (mock *IfHandlerMock) getLink(ifName string) (*mockLink, error) {
+	link, exists := mock.links[ifName]
+	if !exists {
+		return nil, fmt.Errorf("link %s not found", ifName)
+	}
+	return link, nil
+}
+
+func (mock *IfHandlerMock) updateLink(ifName string, update func(link *mockLink) error) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	link, err := mock.getLink(ifName)
+	if err != nil {
+		return err
+	}
+	return update(link)
+}
+
+// linkMAC returns locally administered MAC address derived from the link name.
+func linkMAC(ifName string) net.HardwareAddr {
+	h := fnv.New32a()
+	h.Write([]byte(ifName))
+	sum := h.Sum32()
+	return net.HardwareAddr{0x02, 0x00, byte(sum >> 24), byte(sum >> 16), byte(sum >> 8), byte(sum)}
+}
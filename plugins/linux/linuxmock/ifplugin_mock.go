@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxmock
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+)
+
+// IfPluginMock implements ifplugin.API with the interface index filled
+// directly by the test.
+type IfPluginMock struct {
+	ifIndex ifaceidx.LinuxIfMetadataIndexRW
+}
+
+// NewIfPluginMock creates new instance of the interface plugin mock.
+func NewIfPluginMock(ifIndex ifaceidx.LinuxIfMetadataIndexRW) *IfPluginMock {
+	return &IfPluginMock{ifIndex: ifIndex}
+}
+
+// GetInterfaceIndex returns the interface index given to the constructor.
+func (mock *IfPluginMock) GetInterfaceIndex() ifaceidx.LinuxIfMetadataIndex {
+	return mock.ifIndex
+}
+
+// SetNotifyService does nothing, the mock sends no notifications.
+func (mock *IfPluginMock) SetNotifyService(notify func(notification *linux.Notification)) {}
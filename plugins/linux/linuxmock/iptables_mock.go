@@ -0,0 +1,180 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxmock
+
+import (
+	"fmt"
+	"sync"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/linuxcalls"
+)
+
+// IPTablesHandlerMock is an in-memory implementation of the iptables handler.
+// Built-in chains exist implicitly, custom chains have to be created first.
+type IPTablesHandlerMock struct {
+	mx       sync.Mutex
+	chains   map[string][]string // chain ID -> rules
+	policies map[string]string   // chain ID -> default policy
+}
+
+// NewIPTablesHandlerMock creates new instance of the mock with no rules configured.
+func NewIPTablesHandlerMock() *IPTablesHandlerMock {
+	mock := &IPTablesHandlerMock{}
+	mock.Reset()
+	return mock
+}
+
+// Reset removes all chains and rules.
+func (mock *IPTablesHandlerMock) Reset() {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	mock.chains = make(map[string][]string)
+	mock.policies = make(map[string]string)
+}
+
+// Sync does nothing, the mock state is always up-to-date.
+func (mock *IPTablesHandlerMock) Sync() {}
+
+// Policy returns the default policy set for the given chain.
+func (mock *IPTablesHandlerMock) Policy(protocol linuxcalls.L3Protocol, table, chain string) string {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	return mock.policies[chainID(protocol, table, chain)]
+}
+
+// Init implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) Init(config *linuxcalls.HandlerConfig) error {
+	return nil
+}
+
+// CreateChain implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) CreateChain(protocol linuxcalls.L3Protocol, table, chain string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	if _, exists := mock.chains[id]; exists {
+		return fmt.Errorf("chain %s already exists", id)
+	}
+	mock.chains[id] = nil
+	return nil
+}
+
+// DeleteChain implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) DeleteChain(protocol linuxcalls.L3Protocol, table, chain string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	if isBuiltinChain(chain) {
+		return fmt.Errorf("cannot delete built-in chain %s", id)
+	}
+	if _, exists := mock.chains[id]; !exists {
+		return fmt.Errorf("chain %s does not exist", id)
+	}
+	delete(mock.chains, id)
+	delete(mock.policies, id)
+	return nil
+}
+
+// SetChainDefaultPolicy implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) SetChainDefaultPolicy(protocol linuxcalls.L3Protocol, table, chain, defaultPolicy string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	if !mock.chainExists(id, chain) {
+		return fmt.Errorf("chain %s does not exist", id)
+	}
+	mock.policies[id] = defaultPolicy
+	return nil
+}
+
+// AppendRule implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) AppendRule(protocol linuxcalls.L3Protocol, table, chain string, rule string) error {
+	return mock.AppendRules(protocol, table, chain, rule)
+}
+
+// AppendRules implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) AppendRules(protocol linuxcalls.L3Protocol, table, chain string, rules ...string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	if !mock.chainExists(id, chain) {
+		return fmt.Errorf("chain %s does not exist", id)
+	}
+	mock.chains[id] = append(mock.chains[id], rules...)
+	return nil
+}
+
+// DeleteRule implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) DeleteRule(protocol linuxcalls.L3Protocol, table, chain string, rule string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	rules := mock.chains[id]
+	for i := range rules {
+		if rules[i] == rule {
+			mock.chains[id] = append(rules[:i], rules[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("rule %q not found in chain %s", rule, id)
+}
+
+// DeleteAllRules implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) DeleteAllRules(protocol linuxcalls.L3Protocol, table, chain string) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	if !mock.chainExists(id, chain) {
+		return fmt.Errorf("chain %s does not exist", id)
+	}
+	if _, exists := mock.chains[id]; exists {
+		mock.chains[id] = nil
+	}
+	return nil
+}
+
+// ListRules implements IPTablesAPI.
+func (mock *IPTablesHandlerMock) ListRules(protocol linuxcalls.L3Protocol, table, chain string) (rules []string, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := chainID(protocol, table, chain)
+	if !mock.chainExists(id, chain) {
+		return nil, fmt.Errorf("chain %s does not exist", id)
+	}
+	return append(rules, mock.chains[id]...), nil
+}
+
+func (mock *IPTablesHandlerMock) chainExists(id, chain string) bool {
+	if isBuiltinChain(chain) {
+		return true
+	}
+	_, exists := mock.chains[id]
+	return exists
+}
+
+func chainID(protocol linuxcalls.L3Protocol, table, chain string) string {
+	if protocol == linuxcalls.ProtocolIPv6 {
+		return "ip6/" + table + "/" + chain
+	}
+	return "ip4/" + table + "/" + chain
+}
+
+func isBuiltinChain(chain string) bool {
+	switch chain {
+	case "INPUT", "OUTPUT", "FORWARD", "PREROUTING", "POSTROUTING":
+		return true
+	}
+	return false
+}
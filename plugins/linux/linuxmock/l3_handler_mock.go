@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxmock
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vishvananda/netlink"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+)
+
+// L3HandlerMock is an in-memory implementation of the Linux L3 netlink handler.
+// Interface indexes used by ARP entries and routes are translated to interface
+// names using the given Linux interface index.
+type L3HandlerMock struct {
+	ifIndexes ifaceidx.LinuxIfMetadataIndex
+
+	mx     sync.Mutex
+	arps   map[string]netlink.Neigh // link index + IP -> ARP entry
+	routes map[string]netlink.Route // link index + table + destination + metric -> route
+}
+
+// NewL3HandlerMock creates new instance of the mock with no ARP entries and routes.
+func NewL3HandlerMock(ifIndexes ifaceidx.LinuxIfMetadataIndex) *L3HandlerMock {
+	mock := &L3HandlerMock{ifIndexes: ifIndexes}
+	mock.Reset()
+	return mock
+}
+
+// Reset removes all ARP entries and routes.
+func (mock *L3HandlerMock) Reset() {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	mock.arps = make(map[string]netlink.Neigh)
+	mock.routes = make(map[string]netlink.Route)
+}
+
+// Sync does nothing, the mock state is always up-to-date.
+func (mock *L3HandlerMock) Sync() {}
+
+// SetARPEntry implements NetlinkAPI.
+func (mock *L3HandlerMock) SetARPEntry(arpEntry *netlink.Neigh) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	mock.arps[arpID(arpEntry)] = *arpEntry
+	return nil
+}
+
+// DelARPEntry implements NetlinkAPI.
+func (mock *L3HandlerMock) DelARPEntry(arpEntry *netlink.Neigh) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := arpID(arpEntry)
+	if _, exists := mock.arps[id]; !exists {
+		return fmt.Errorf("ARP entry %s does not exist", id)
+	}
+	delete(mock.arps, id)
+	return nil
+}
+
+// AddRoute implements NetlinkAPI.
+func (mock *L3HandlerMock) AddRoute(route *netlink.Route) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := routeID(route)
+	if _, exists := mock.routes[id]; exists {
+		return fmt.Errorf("route %s already exists", id)
+	}
+	mock.routes[id] = *route
+	return nil
+}
+
+// ReplaceRoute implements NetlinkAPI.
+func (mock *L3HandlerMock) ReplaceRoute(route *netlink.Route) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	mock.routes[routeID(route)] = *route
+	return nil
+}
+
+// DelRoute implements NetlinkAPI.
+func (mock *L3HandlerMock) DelRoute(route *netlink.Route) error {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	id := routeID(route)
+	if _, exists := mock.routes[id]; !exists {
+		return fmt.Errorf("route %s does not exist", id)
+	}
+	delete(mock.routes, id)
+	return nil
+}
+
+// GetARPEntries implements NetlinkAPI.
+func (mock *L3HandlerMock) GetARPEntries(interfaceIdx int) (arps []netlink.Neigh, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	for _, id := range sortedKeys(mock.arps) {
+		arp := mock.arps[id]
+		if interfaceIdx == 0 || arp.LinkIndex == interfaceIdx {
+			arps = append(arps, arp)
+		}
+	}
+	return arps, nil
+}
+
+// DumpARPEntries implements NetlinkAPI.
+func (mock *L3HandlerMock) DumpARPEntries() (arpDetails []*linuxcalls.ArpDetails, err error) {
+	arps, _ := mock.GetARPEntries(0)
+	for _, arp := range arps {
+		ifName, found := mock.lookupInterface(arp.LinkIndex)
+		if !found {
+			continue
+		}
+		arpDetails = append(arpDetails, &linuxcalls.ArpDetails{
+			ARP: &linux_l3.ARPEntry{
+				Interface: ifName,
+				IpAddress: arp.IP.String(),
+				HwAddress: arp.HardwareAddr.String(),
+			},
+			Meta: &linuxcalls.ArpMeta{
+				InterfaceIndex: uint32(arp.LinkIndex),
+				IPFamily:       uint32(arp.Family),
+				VNI:            uint32(arp.VNI),
+			},
+		})
+	}
+	return arpDetails, nil
+}
+
+// GetRoutes implements NetlinkAPI.
+func (mock *L3HandlerMock) GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes []netlink.Route, err error) {
+	mock.mx.Lock()
+	defer mock.mx.Unlock()
+	for _, id := range sortedKeys(mock.routes) {
+		route := mock.routes[id]
+		if interfaceIdx != 0 && route.LinkIndex != interfaceIdx {
+			continue
+		}
+		if table != 0 && route.Table != table {
+			continue
+		}
+		if route.Dst != nil && route.Dst.IP.To4() == nil {
+			v6Routes = append(v6Routes, route)
+		} else {
+			v4Routes = append(v4Routes, route)
+		}
+	}
+	return v4Routes, v6Routes, nil
+}
+
+// DumpRoutes implements NetlinkAPI.
+func (mock *L3HandlerMock) DumpRoutes() (routeDetails []*linuxcalls.RouteDetails, err error) {
+	v4Routes, v6Routes, _ := mock.GetRoutes(0, 0)
+	for _, route := range append(v4Routes, v6Routes...) {
+		ifName, found := mock.lookupInterface(route.LinkIndex)
+		if !found {
+			continue
+		}
+		dstNet := linuxcalls.IPv4AddrAny + "/0"
+		if route.Dst != nil {
+			dstNet = route.Dst.String()
+		}
+		var gwAddr string
+		if len(route.Gw) != 0 {
+			gwAddr = route.Gw.String()
+		}
+		routeDetails = append(routeDetails, &linuxcalls.RouteDetails{
+			Route: &linux_l3.Route{
+				OutgoingInterface: ifName,
+				DstNetwork:        dstNet,
+				GwAddr:            gwAddr,
+				Metric:            uint32(route.Priority),
+			},
+			Meta: &linuxcalls.RouteMeta{
+				InterfaceIndex: uint32(route.LinkIndex),
+				NetlinkScope:   route.Scope,
+				Protocol:       uint32(route.Protocol),
+				MTU:            uint32(route.MTU),
+				Table:          uint32(route.Table),
+			},
+		})
+	}
+	return routeDetails, nil
+}
+
+// lookupInterface returns the logical name of the interface with the given Linux index.
+func (mock *L3HandlerMock) lookupInterface(linuxIfIndex int) (ifName string, found bool) {
+	for _, ifName := range mock.ifIndexes.ListAllInterfaces() {
+		ifMeta, exists := mock.ifIndexes.LookupByName(ifName)
+		if exists && ifMeta.LinuxIfIndex == linuxIfIndex {
+			return ifName, true
+		}
+	}
+	return "", false
+}
+
+func arpID(arp *netlink.Neigh) string {
+	return fmt.Sprintf("%d/%s", arp.LinkIndex, arp.IP)
+}
+
+func routeID(route *netlink.Route) string {
+	return fmt.Sprintf("%d/%d/%s/%d", route.LinkIndex, route.Table, route.Dst, route.Priority)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxmock
+
+import (
+	"github.com/vishvananda/netns"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+// NsPluginMock implements nsplugin.API without actually switching
+// the network namespace of the current thread.
+type NsPluginMock struct{}
+
+// NewNsPluginMock creates new instance of the namespace plugin mock.
+func NewNsPluginMock() *NsPluginMock {
+	return &NsPluginMock{}
+}
+
+// SwitchToNamespace returns no-op revert function.
+func (mock *NsPluginMock) SwitchToNamespace(ctx linuxcalls.NamespaceMgmtCtx, ns *linux_namespace.NetNamespace) (revert func(), err error) {
+	return func() {}, nil
+}
+
+// GetNamespaceHandle returns handle of the current namespace.
+func (mock *NsPluginMock) GetNamespaceHandle(ctx linuxcalls.NamespaceMgmtCtx, ns *linux_namespace.NetNamespace) (handle netns.NsHandle, err error) {
+	return netns.Get()
+}
@@ -15,11 +15,16 @@
 package descriptor
 
 import (
+	"errors"
+	"fmt"
 	"net"
+	"sort"
 
+	"go.ligato.io/cn-infra/v2/idxmap"
 	"go.ligato.io/cn-infra/v2/logging"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"go.ligato.io/vpp-agent/v3/pkg/models"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
@@ -30,17 +35,23 @@ const (
 	// IPAllocDescriptorName is the name of the descriptor for allocating
 	// IP addresses.
 	IPAllocDescriptorName = "netalloc-ip-address"
+
+	// dependency labels
+	ipPoolDep = "ip-pool-exists"
 )
 
-// IPAllocDescriptor just validates and parses allocated IP addresses.
+// IPAllocDescriptor validates and parses statically allocated IP addresses
+// and assigns addresses to allocations from IP pools.
 type IPAllocDescriptor struct {
-	log logging.Logger
+	log       logging.Logger
+	poolIndex idxmap.NamedMapping
 }
 
 // NewAddrAllocDescriptor creates a new instance of IPAllocDescriptor.
-func NewAddrAllocDescriptor(log logging.PluginLogger) (descr *kvs.KVDescriptor) {
+func NewAddrAllocDescriptor(log logging.PluginLogger, poolIndex idxmap.NamedMapping) (descr *kvs.KVDescriptor) {
 	ctx := &IPAllocDescriptor{
-		log: log.NewLogger("ip-address-alloc-descriptor"),
+		log:       log.NewLogger("ip-address-alloc-descriptor"),
+		poolIndex: poolIndex,
 	}
 	typedDescr := &adapter.IPAllocDescriptor{
 		Name:          IPAllocDescriptorName,
@@ -54,31 +65,78 @@ func NewAddrAllocDescriptor(log logging.PluginLogger) (descr *kvs.KVDescriptor)
 		Delete:        ctx.Delete,
 		Retrieve:      ctx.Retrieve,
 		DerivedValues: ctx.DerivedValues,
+		Dependencies:  ctx.Dependencies,
+		// pools have to be retrieved first to correlate allocations made from them
+		RetrieveDependencies: []string{IPPoolDescriptorName},
 	}
 	descr = adapter.NewIPAllocDescriptor(typedDescr)
 	return
 }
 
-// Validate checks if the address can be parsed.
+// Validate checks if the address can be parsed, or if the allocation refers
+// to a pool, that the address is not also given statically.
 func (d *IPAllocDescriptor) Validate(key string, addrAlloc *netalloc.IPAllocation) (err error) {
+	if addrAlloc.Pool != "" {
+		if addrAlloc.Address != "" {
+			return kvs.NewInvalidValueError(
+				errors.New("address cannot be set for allocation from a pool"), "address", "pool")
+		}
+		if addrAlloc.Gw != "" {
+			if _, _, err = utils.ParseIPAddr(addrAlloc.Gw, nil); err != nil {
+				return kvs.NewInvalidValueError(err, "gw")
+			}
+		}
+		return nil
+	}
 	_, _, err = d.parseAddr(addrAlloc)
 	return err
 }
 
-// Create parses the address and stores it into the metadata.
+// Create parses the address and stores it into the metadata. For allocations
+// from a pool, a free address of the pool is assigned.
 func (d *IPAllocDescriptor) Create(key string, addrAlloc *netalloc.IPAllocation) (metadata *netalloc.IPAllocMetadata, err error) {
+	if addrAlloc.Pool != "" {
+		return d.allocateFromPool(addrAlloc)
+	}
 	metadata, _, err = d.parseAddr(addrAlloc)
 	return
 }
 
-// Delete is NOOP.
+// Delete returns address allocated from a pool back to the pool.
+// For statically allocated addresses it is NOOP.
 func (d *IPAllocDescriptor) Delete(key string, addrAlloc *netalloc.IPAllocation, metadata *netalloc.IPAllocMetadata) (err error) {
-	return err
+	if addrAlloc.Pool == "" {
+		return nil
+	}
+	poolMeta, err := d.getPool(addrAlloc.Pool)
+	if err != nil {
+		// pool already removed together with its allocations
+		return nil
+	}
+	utils.ReleaseToPool(poolMeta, models.Name(addrAlloc))
+	return nil
+}
+
+// Dependencies lists the referenced pool as the only dependency for allocations
+// from a pool.
+func (d *IPAllocDescriptor) Dependencies(key string, addrAlloc *netalloc.IPAllocation) (deps []kvs.Dependency) {
+	if addrAlloc.Pool != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: ipPoolDep,
+			Key:   models.Key(&netalloc.IPPool{Name: addrAlloc.Pool}),
+		})
+	}
+	return deps
 }
 
 // DerivedValues derives "neighbour-gateway" key if GW is a neighbour of the interface
 // (addresses are from the same IP network).
+// For allocations from a pool the address is not known in advance, therefore
+// the key is not derived.
 func (d *IPAllocDescriptor) DerivedValues(key string, addrAlloc *netalloc.IPAllocation) (derValues []kvs.KeyValuePair) {
+	if addrAlloc.Pool != "" {
+		return nil
+	}
 	_, neighGw, _ := d.parseAddr(addrAlloc)
 	if neighGw {
 		derValues = append(derValues, kvs.KeyValuePair{
@@ -90,9 +148,17 @@ func (d *IPAllocDescriptor) DerivedValues(key string, addrAlloc *netalloc.IPAllo
 }
 
 // Retrieve always returns what is expected to exists since Create doesn't really change
-// anything in SB.
+// anything in SB. Allocations from a pool which are not recorded in the pool
+// metadata (e.g. the state of the pool was lost with the agent restart) are
+// assigned addresses again. Allocation from a pool is deterministic, therefore
+// they are assigned the same addresses as before.
 func (d *IPAllocDescriptor) Retrieve(correlate []adapter.IPAllocKVWithMetadata) (valid []adapter.IPAllocKVWithMetadata, err error) {
+	var poolAllocs []adapter.IPAllocKVWithMetadata
 	for _, addrAlloc := range correlate {
+		if addrAlloc.Value.Pool != "" {
+			poolAllocs = append(poolAllocs, addrAlloc)
+			continue
+		}
 		if meta, _, err := d.parseAddr(addrAlloc.Value); err == nil {
 			valid = append(valid, adapter.IPAllocKVWithMetadata{
 				Key:      addrAlloc.Key,
@@ -102,6 +168,20 @@ func (d *IPAllocDescriptor) Retrieve(correlate []adapter.IPAllocKVWithMetadata)
 			})
 		}
 	}
+	// allocate in the same order every time to resolve conflicts deterministically
+	sort.Slice(poolAllocs, func(i, j int) bool {
+		return poolAllocs[i].Key < poolAllocs[j].Key
+	})
+	for _, addrAlloc := range poolAllocs {
+		if meta := d.retrievePoolAlloc(addrAlloc.Value); meta != nil {
+			valid = append(valid, adapter.IPAllocKVWithMetadata{
+				Key:      addrAlloc.Key,
+				Value:    addrAlloc.Value,
+				Metadata: meta,
+				Origin:   kvs.FromNB,
+			})
+		}
+	}
 	return valid, nil
 }
 
@@ -120,3 +200,59 @@ func (d *IPAllocDescriptor) parseAddr(addrAlloc *netalloc.IPAllocation) (parsed
 	}
 	return &netalloc.IPAllocMetadata{IfaceAddr: ifaceAddr, GwAddr: gwAddr}, neighGw, nil
 }
+
+// allocateFromPool assigns address from the pool to the given allocation.
+func (d *IPAllocDescriptor) allocateFromPool(addrAlloc *netalloc.IPAllocation) (*netalloc.IPAllocMetadata, error) {
+	poolMeta, err := d.getPool(addrAlloc.Pool)
+	if err != nil {
+		return nil, err
+	}
+	ifaceAddr, err := utils.AllocateFromPool(poolMeta, models.Name(addrAlloc))
+	if err != nil {
+		return nil, err
+	}
+	return d.poolAllocMetadata(addrAlloc, poolMeta, ifaceAddr)
+}
+
+// retrievePoolAlloc returns metadata for allocation from a pool. Address is
+// assigned to the allocation if the pool does not have it recorded.
+func (d *IPAllocDescriptor) retrievePoolAlloc(addrAlloc *netalloc.IPAllocation) *netalloc.IPAllocMetadata {
+	meta, err := d.allocateFromPool(addrAlloc)
+	if err != nil {
+		d.log.Warnf("failed to retrieve allocation from IP pool '%s': %v", addrAlloc.Pool, err)
+		return nil
+	}
+	return meta
+}
+
+// poolAllocMetadata builds metadata for address assigned from a pool.
+func (d *IPAllocDescriptor) poolAllocMetadata(addrAlloc *netalloc.IPAllocation,
+	poolMeta *netalloc.IPPoolMetadata, ifaceAddr *net.IPNet) (*netalloc.IPAllocMetadata, error) {
+
+	gwAddr := poolMeta.Gateway
+	if addrAlloc.Gw != "" {
+		var err error
+		gwAddr, _, err = utils.ParseIPAddr(addrAlloc.Gw, ifaceAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &netalloc.IPAllocMetadata{
+		IfaceAddr: ifaceAddr,
+		GwAddr:    gwAddr,
+		Pool:      addrAlloc.Pool,
+	}, nil
+}
+
+// getPool returns metadata of the given IP pool.
+func (d *IPAllocDescriptor) getPool(poolName string) (*netalloc.IPPoolMetadata, error) {
+	poolVal, found := d.poolIndex.GetValue(poolName)
+	if !found {
+		return nil, fmt.Errorf("failed to find metadata for IP pool '%s'", poolName)
+	}
+	poolMeta, ok := poolVal.(*netalloc.IPPoolMetadata)
+	if !ok {
+		return nil, fmt.Errorf("invalid type of metadata stored for IP pool '%s'", poolName)
+	}
+	return poolMeta, nil
+}
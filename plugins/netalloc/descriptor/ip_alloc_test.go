@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/idxmap/mem"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+func TestRetrievePoolAllocationsAfterRestart(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool := &netalloc.IPPool{Name: "p2p", Subnet: "10.10.1.0/24", Gateway: "10.10.1.1"}
+	allocs := []*netalloc.IPAllocation{
+		{NetworkName: "net1", InterfaceName: "memif1", Pool: "p2p"},
+		{NetworkName: "net1", InterfaceName: "memif2", Pool: "p2p"},
+		{NetworkName: "net1", InterfaceName: "tap1", Address: "192.168.1.1/24"},
+	}
+	var correlate []kvs.KVWithMetadata
+	for _, alloc := range allocs {
+		correlate = append(correlate, kvs.KVWithMetadata{Key: models.Key(alloc), Value: alloc})
+	}
+
+	// addresses assigned before the restart
+	poolMeta, err := utils.ParseIPPool(pool)
+	Expect(err).ToNot(HaveOccurred())
+	var assigned []string
+	for _, alloc := range allocs[:2] {
+		addr, err := utils.AllocateFromPool(poolMeta, models.Name(alloc))
+		Expect(err).ToNot(HaveOccurred())
+		assigned = append(assigned, addr.String())
+	}
+
+	// after the restart the pool is retrieved without any allocations
+	poolIndex := mem.NewNamedMapping(logrus.DefaultLogger(), "ip-pools", nil)
+	poolMeta, err = utils.ParseIPPool(pool)
+	Expect(err).ToNot(HaveOccurred())
+	poolIndex.Put(pool.Name, poolMeta)
+
+	allocDescriptor := descriptor.NewAddrAllocDescriptor(logging.ForPlugin("netalloc"), poolIndex)
+	retrieved, err := allocDescriptor.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(3))
+
+	retrievedAddrs := make(map[string]string)
+	for _, kv := range retrieved {
+		Expect(kv.Origin).To(Equal(kvs.FromNB))
+		retrievedAddrs[kv.Key] = kv.Metadata.(*netalloc.IPAllocMetadata).IfaceAddr.String()
+	}
+	Expect(retrievedAddrs[models.Key(allocs[0])]).To(Equal(assigned[0]))
+	Expect(retrievedAddrs[models.Key(allocs[1])]).To(Equal(assigned[1]))
+	Expect(retrievedAddrs[models.Key(allocs[2])]).To(Equal("192.168.1.1/24"))
+
+	// the state of the pool is rebuilt
+	Expect(poolMeta.Allocated).To(HaveLen(2))
+	Expect(poolMeta.Allocated).To(HaveKey(models.Name(allocs[0])))
+	Expect(poolMeta.Allocated).To(HaveKey(models.Name(allocs[1])))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+const (
+	// IPPoolDescriptorName is the name of the descriptor for pools
+	// of IP addresses.
+	IPPoolDescriptorName = "netalloc-ip-pool"
+)
+
+// IPPoolDescriptor validates and parses IP pools. The state of allocations
+// made from the pool is kept in the pool metadata.
+type IPPoolDescriptor struct {
+	log logging.Logger
+}
+
+// NewIPPoolDescriptor creates a new instance of IPPoolDescriptor.
+func NewIPPoolDescriptor(log logging.PluginLogger) (descr *kvs.KVDescriptor) {
+	ctx := &IPPoolDescriptor{
+		log: log.NewLogger("ip-pool-descriptor"),
+	}
+	typedDescr := &adapter.IPPoolDescriptor{
+		Name:          IPPoolDescriptorName,
+		NBKeyPrefix:   netalloc.ModelIPPool.KeyPrefix(),
+		ValueTypeName: netalloc.ModelIPPool.ProtoName(),
+		KeySelector:   netalloc.ModelIPPool.IsKeyValid,
+		KeyLabel:      netalloc.ModelIPPool.StripKeyPrefix,
+		WithMetadata:  true,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+	}
+	descr = adapter.NewIPPoolDescriptor(typedDescr)
+	return
+}
+
+// Validate checks if the pool configuration can be parsed.
+func (d *IPPoolDescriptor) Validate(key string, pool *netalloc.IPPool) (err error) {
+	_, err = utils.ParseIPPool(pool)
+	if err != nil {
+		return kvs.NewInvalidValueError(err)
+	}
+	return nil
+}
+
+// Create parses the pool and stores it into the metadata, initially without
+// any allocations.
+func (d *IPPoolDescriptor) Create(key string, pool *netalloc.IPPool) (metadata *netalloc.IPPoolMetadata, err error) {
+	return utils.ParseIPPool(pool)
+}
+
+// Delete is NOOP (allocations from the pool depend on it and are therefore
+// removed first).
+func (d *IPPoolDescriptor) Delete(key string, pool *netalloc.IPPool, metadata *netalloc.IPPoolMetadata) (err error) {
+	return nil
+}
+
+// Retrieve always returns what is expected to exists since Create doesn't really change
+// anything in SB. Metadata of already created pools are preserved to keep
+// the addresses allocated from them.
+func (d *IPPoolDescriptor) Retrieve(correlate []adapter.IPPoolKVWithMetadata) (valid []adapter.IPPoolKVWithMetadata, err error) {
+	for _, pool := range correlate {
+		meta := pool.Metadata
+		if meta == nil {
+			if meta, err = utils.ParseIPPool(pool.Value); err != nil {
+				continue
+			}
+		}
+		valid = append(valid, adapter.IPPoolKVWithMetadata{
+			Key:      pool.Key,
+			Value:    pool.Value,
+			Metadata: meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+	return valid, nil
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+const (
+	// ResourceAllocDescriptorName is the name of the descriptor for allocating
+	// MAC addresses, VLAN IDs and VXLAN VNIs.
+	ResourceAllocDescriptorName = "netalloc-resource"
+
+	// dependency labels
+	resourcePoolDep = "resource-pool-exists"
+)
+
+// ResourceAllocDescriptor validates and parses statically allocated resources
+// and assigns resources to allocations from resource pools.
+// Every resource can be allocated only once (across all allocations of the same
+// type), conflicting allocations fail to be created.
+// Resources configured statically by other plugins (not allocated via netalloc)
+// can be reserved to never get assigned from a pool.
+type ResourceAllocDescriptor struct {
+	log       logging.Logger
+	poolIndex idxmap.NamedMapping
+
+	// allocated resources (type -> value -> allocation name)
+	allocated map[netalloc.ResourceType]map[uint64]string
+	// reserved resources (type -> value -> owners)
+	reserved map[netalloc.ResourceType]map[uint64]map[string]struct{}
+}
+
+// NewResourceAllocDescriptor creates a new instance of ResourceAllocDescriptor.
+func NewResourceAllocDescriptor(log logging.PluginLogger, poolIndex idxmap.NamedMapping) (
+	descr *kvs.KVDescriptor, ctx *ResourceAllocDescriptor) {
+	ctx = &ResourceAllocDescriptor{
+		log:       log.NewLogger("resource-alloc-descriptor"),
+		poolIndex: poolIndex,
+		allocated: make(map[netalloc.ResourceType]map[uint64]string),
+		reserved:  make(map[netalloc.ResourceType]map[uint64]map[string]struct{}),
+	}
+	typedDescr := &adapter.ResourceAllocDescriptor{
+		Name:          ResourceAllocDescriptorName,
+		NBKeyPrefix:   netalloc.ModelResourceAllocation.KeyPrefix(),
+		ValueTypeName: netalloc.ModelResourceAllocation.ProtoName(),
+		KeySelector:   netalloc.ModelResourceAllocation.IsKeyValid,
+		KeyLabel:      netalloc.ModelResourceAllocation.StripKeyPrefix,
+		WithMetadata:  true,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+		// pools have to be retrieved first to correlate allocations made from them
+		RetrieveDependencies: []string{ResourcePoolDescriptorName},
+	}
+	descr = adapter.NewResourceAllocDescriptor(typedDescr)
+	return descr, ctx
+}
+
+// Validate checks if the resource type is defined and the statically allocated
+// resource can be parsed.
+func (d *ResourceAllocDescriptor) Validate(key string, resAlloc *netalloc.ResourceAllocation) error {
+	if resAlloc.Type == netalloc.ResourceType_UNDEFINED_RESOURCE {
+		return kvs.NewInvalidValueError(errors.New("undefined resource type"), "type")
+	}
+	if resAlloc.NetworkName == "" || strings.Contains(resAlloc.NetworkName, "/") {
+		return kvs.NewInvalidValueError(
+			fmt.Errorf("invalid network name: '%s'", resAlloc.NetworkName), "network_name")
+	}
+	if resAlloc.Pool != "" {
+		if resAlloc.Value != "" {
+			return kvs.NewInvalidValueError(
+				errors.New("value cannot be set for allocation from a pool"), "value", "pool")
+		}
+		return nil
+	}
+	if _, err := utils.ParseResource(resAlloc.Type, resAlloc.Value); err != nil {
+		return kvs.NewInvalidValueError(err, "value")
+	}
+	return nil
+}
+
+// Create parses the resource and stores it into the metadata. For allocations
+// from a pool, a free resource of the pool is assigned.
+func (d *ResourceAllocDescriptor) Create(key string, resAlloc *netalloc.ResourceAllocation) (metadata *netalloc.ResourceAllocMetadata, err error) {
+	if resAlloc.Pool != "" {
+		return d.allocateFromPool(resAlloc)
+	}
+
+	allocName := models.Name(resAlloc)
+	value, err := utils.ParseResource(resAlloc.Type, resAlloc.Value)
+	if err != nil {
+		return nil, err
+	}
+	if owner, taken := d.allocated[resAlloc.Type][value]; taken && owner != allocName {
+		err = fmt.Errorf("%s %s is already allocated by '%s'", resAlloc.Type, resAlloc.Value, owner)
+		d.log.Error(err)
+		return nil, err
+	}
+	if owners := d.reserved[resAlloc.Type][value]; len(owners) > 0 {
+		err = fmt.Errorf("%s %s is already used by %s", resAlloc.Type, resAlloc.Value, ownerList(owners))
+		d.log.Error(err)
+		return nil, err
+	}
+	d.markAllocated(resAlloc.Type, value, allocName)
+	return &netalloc.ResourceAllocMetadata{
+		Type:  resAlloc.Type,
+		Value: value,
+	}, nil
+}
+
+// Delete releases the allocated resource.
+func (d *ResourceAllocDescriptor) Delete(key string, resAlloc *netalloc.ResourceAllocation, metadata *netalloc.ResourceAllocMetadata) error {
+	allocName := models.Name(resAlloc)
+	if metadata != nil && d.allocated[resAlloc.Type][metadata.Value] == allocName {
+		delete(d.allocated[resAlloc.Type], metadata.Value)
+	}
+	if resAlloc.Pool != "" {
+		if poolMeta, err := d.getPool(resAlloc.Pool, resAlloc.Type); err == nil {
+			utils.ReleaseResourceToPool(poolMeta, allocName)
+		}
+	}
+	return nil
+}
+
+// Dependencies lists the referenced pool as the only dependency for allocations
+// from a pool.
+func (d *ResourceAllocDescriptor) Dependencies(key string, resAlloc *netalloc.ResourceAllocation) (deps []kvs.Dependency) {
+	if resAlloc.Pool != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: resourcePoolDep,
+			Key:   models.Key(&netalloc.ResourcePool{Name: resAlloc.Pool}),
+		})
+	}
+	return deps
+}
+
+// Retrieve returns allocations which are expected to exist, since Create doesn't
+// really change anything in SB. Allocations from a pool which are not recorded
+// in the pool metadata (e.g. the state of the pool was lost with the agent restart)
+// are assigned resources again. Allocation from a pool is deterministic, therefore
+// they are assigned the same resources as before.
+func (d *ResourceAllocDescriptor) Retrieve(correlate []adapter.ResourceAllocKVWithMetadata) (valid []adapter.ResourceAllocKVWithMetadata, err error) {
+	// rebuild the set of allocated resources from scratch
+	d.allocated = make(map[netalloc.ResourceType]map[uint64]string)
+
+	var unrecorded []adapter.ResourceAllocKVWithMetadata
+	for _, resAlloc := range correlate {
+		var meta *netalloc.ResourceAllocMetadata
+		allocName := models.Name(resAlloc.Value)
+		if resAlloc.Value.Pool != "" {
+			poolMeta, err := d.getPool(resAlloc.Value.Pool, resAlloc.Value.Type)
+			if err != nil {
+				continue
+			}
+			value, allocated := poolMeta.Allocated[allocName]
+			if !allocated {
+				unrecorded = append(unrecorded, resAlloc)
+				continue
+			}
+			meta = &netalloc.ResourceAllocMetadata{
+				Type:  resAlloc.Value.Type,
+				Value: value,
+				Pool:  resAlloc.Value.Pool,
+			}
+		} else {
+			value, err := utils.ParseResource(resAlloc.Value.Type, resAlloc.Value.Value)
+			if err != nil {
+				continue
+			}
+			meta = &netalloc.ResourceAllocMetadata{
+				Type:  resAlloc.Value.Type,
+				Value: value,
+			}
+		}
+		if _, taken := d.allocated[meta.Type][meta.Value]; taken {
+			// conflicting allocation - let Create report the error
+			continue
+		}
+		d.markAllocated(meta.Type, meta.Value, allocName)
+		valid = append(valid, adapter.ResourceAllocKVWithMetadata{
+			Key:      resAlloc.Key,
+			Value:    resAlloc.Value,
+			Metadata: meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+
+	// allocate in the same order every time to resolve conflicts deterministically
+	sort.Slice(unrecorded, func(i, j int) bool {
+		return unrecorded[i].Key < unrecorded[j].Key
+	})
+	for _, resAlloc := range unrecorded {
+		meta, err := d.allocateFromPool(resAlloc.Value)
+		if err != nil {
+			d.log.Warnf("failed to retrieve allocation from resource pool '%s': %v",
+				resAlloc.Value.Pool, err)
+			continue
+		}
+		valid = append(valid, adapter.ResourceAllocKVWithMetadata{
+			Key:      resAlloc.Key,
+			Value:    resAlloc.Value,
+			Metadata: meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+	return valid, nil
+}
+
+// Reserve marks resource configured statically by the given owner (not allocated
+// via netalloc) as used, so that it is never assigned from a pool. Resource can be
+// reserved by multiple owners, but it cannot be reserved if it is already allocated
+// via netalloc.
+func (d *ResourceAllocDescriptor) Reserve(owner string, resType netalloc.ResourceType, value uint64) error {
+	if allocName, taken := d.allocated[resType][value]; taken {
+		return fmt.Errorf("%s %s is already allocated by '%s'",
+			resType, utils.FormatResource(resType, value), allocName)
+	}
+	if _, hasType := d.reserved[resType]; !hasType {
+		d.reserved[resType] = make(map[uint64]map[string]struct{})
+	}
+	if _, hasValue := d.reserved[resType][value]; !hasValue {
+		d.reserved[resType][value] = make(map[string]struct{})
+	}
+	d.reserved[resType][value][owner] = struct{}{}
+	return nil
+}
+
+// Release removes reservation made by the given owner.
+func (d *ResourceAllocDescriptor) Release(owner string, resType netalloc.ResourceType, value uint64) {
+	owners := d.reserved[resType][value]
+	delete(owners, owner)
+	if len(owners) == 0 {
+		delete(d.reserved[resType], value)
+	}
+}
+
+// allocateFromPool assigns resource from the pool to the given allocation.
+func (d *ResourceAllocDescriptor) allocateFromPool(resAlloc *netalloc.ResourceAllocation) (*netalloc.ResourceAllocMetadata, error) {
+	allocName := models.Name(resAlloc)
+	poolMeta, err := d.getPool(resAlloc.Pool, resAlloc.Type)
+	if err != nil {
+		return nil, err
+	}
+	value, err := utils.AllocateResourceFromPool(poolMeta, allocName,
+		func(value uint64) bool {
+			if len(d.reserved[resAlloc.Type][value]) > 0 {
+				return true
+			}
+			owner, taken := d.allocated[resAlloc.Type][value]
+			return taken && owner != allocName
+		})
+	if err != nil {
+		return nil, err
+	}
+	d.markAllocated(resAlloc.Type, value, allocName)
+	return &netalloc.ResourceAllocMetadata{
+		Type:  resAlloc.Type,
+		Value: value,
+		Pool:  resAlloc.Pool,
+	}, nil
+}
+
+// markAllocated records the resource as allocated by the given allocation.
+func (d *ResourceAllocDescriptor) markAllocated(resType netalloc.ResourceType, value uint64, allocName string) {
+	if _, hasType := d.allocated[resType]; !hasType {
+		d.allocated[resType] = make(map[uint64]string)
+	}
+	d.allocated[resType][value] = allocName
+}
+
+// ownerList returns sorted and quoted names of the owners of a reserved resource.
+func ownerList(owners map[string]struct{}) string {
+	var names []string
+	for owner := range owners {
+		names = append(names, "'"+owner+"'")
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// getPool returns metadata of the given resource pool.
+func (d *ResourceAllocDescriptor) getPool(poolName string, resType netalloc.ResourceType) (*netalloc.ResourcePoolMetadata, error) {
+	poolVal, found := d.poolIndex.GetValue(poolName)
+	if !found {
+		return nil, fmt.Errorf("failed to find metadata for resource pool '%s'", poolName)
+	}
+	poolMeta, ok := poolVal.(*netalloc.ResourcePoolMetadata)
+	if !ok {
+		return nil, fmt.Errorf("invalid type of metadata stored for resource pool '%s'", poolName)
+	}
+	if poolMeta.Type != resType {
+		return nil, fmt.Errorf("resource pool '%s' is of type %v, expected %v",
+			poolName, poolMeta.Type, resType)
+	}
+	return poolMeta, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/idxmap/mem"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+func TestReservedResources(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool, err := utils.ParseResourcePool(&netalloc.ResourcePool{
+		Name:  "vlans",
+		Type:  netalloc.ResourceType_VLAN_ID,
+		First: "10",
+		Last:  "11",
+	})
+	Expect(err).ToNot(HaveOccurred())
+	poolIndex := mem.NewNamedMapping(logrus.DefaultLogger(), "resource-pools", nil)
+	poolIndex.Put("vlans", pool)
+	_, ctx := descriptor.NewResourceAllocDescriptor(logging.ForPlugin("netalloc"), poolIndex)
+
+	// the same sub-interface ID can be used statically under different parents
+	Expect(ctx.Reserve("sub1", netalloc.ResourceType_VLAN_ID, 10)).To(Succeed())
+	Expect(ctx.Reserve("sub2", netalloc.ResourceType_VLAN_ID, 10)).To(Succeed())
+
+	// static allocation conflicting with the reservation
+	static := &netalloc.ResourceAllocation{
+		Type:          netalloc.ResourceType_VLAN_ID,
+		NetworkName:   "net1",
+		InterfaceName: "sub3",
+		Value:         "10",
+	}
+	_, err = ctx.Create(models.Key(static), static)
+	Expect(err).To(HaveOccurred())
+
+	// pool never assigns reserved resource
+	fromPool := &netalloc.ResourceAllocation{
+		Type:          netalloc.ResourceType_VLAN_ID,
+		NetworkName:   "net1",
+		InterfaceName: "sub4",
+		Pool:          "vlans",
+	}
+	meta, err := ctx.Create(models.Key(fromPool), fromPool)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(meta.Value).To(BeEquivalentTo(11))
+
+	// resource allocated via netalloc cannot be reserved
+	Expect(ctx.Reserve("sub5", netalloc.ResourceType_VLAN_ID, 11)).ToNot(Succeed())
+
+	// resource is free once released by all the owners
+	ctx.Release("sub1", netalloc.ResourceType_VLAN_ID, 10)
+	_, err = ctx.Create(models.Key(static), static)
+	Expect(err).To(HaveOccurred())
+	ctx.Release("sub2", netalloc.ResourceType_VLAN_ID, 10)
+	meta, err = ctx.Create(models.Key(static), static)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(meta.Value).To(BeEquivalentTo(10))
+}
+
+func TestRetrieveResourceAllocationsAfterRestart(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool := &netalloc.ResourcePool{
+		Name:  "macs",
+		Type:  netalloc.ResourceType_MAC_ADDRESS,
+		First: "02:fe:00:00:00:00",
+		Last:  "02:fe:00:00:ff:ff",
+	}
+	allocs := []*netalloc.ResourceAllocation{
+		{Type: netalloc.ResourceType_MAC_ADDRESS, NetworkName: "net1", InterfaceName: "memif1", Pool: "macs"},
+		{Type: netalloc.ResourceType_MAC_ADDRESS, NetworkName: "net1", InterfaceName: "memif2", Pool: "macs"},
+		{Type: netalloc.ResourceType_MAC_ADDRESS, NetworkName: "net1", InterfaceName: "tap1", Value: "02:00:00:00:00:01"},
+	}
+	var correlate []kvs.KVWithMetadata
+	for _, alloc := range allocs {
+		correlate = append(correlate, kvs.KVWithMetadata{Key: models.Key(alloc), Value: alloc})
+	}
+
+	// resources assigned before the restart
+	poolMeta, err := utils.ParseResourcePool(pool)
+	Expect(err).ToNot(HaveOccurred())
+	var assigned []uint64
+	for _, alloc := range allocs[:2] {
+		value, err := utils.AllocateResourceFromPool(poolMeta, models.Name(alloc), nil)
+		Expect(err).ToNot(HaveOccurred())
+		assigned = append(assigned, value)
+	}
+
+	// after the restart the pool is retrieved without any allocations
+	poolIndex := mem.NewNamedMapping(logrus.DefaultLogger(), "resource-pools", nil)
+	poolMeta, err = utils.ParseResourcePool(pool)
+	Expect(err).ToNot(HaveOccurred())
+	poolIndex.Put(pool.Name, poolMeta)
+
+	allocDescriptor, _ := descriptor.NewResourceAllocDescriptor(logging.ForPlugin("netalloc"), poolIndex)
+	retrieved, err := allocDescriptor.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(3))
+
+	retrievedValues := make(map[string]uint64)
+	for _, kv := range retrieved {
+		Expect(kv.Origin).To(Equal(kvs.FromNB))
+		retrievedValues[kv.Key] = kv.Metadata.(*netalloc.ResourceAllocMetadata).Value
+	}
+	Expect(retrievedValues[models.Key(allocs[0])]).To(Equal(assigned[0]))
+	Expect(retrievedValues[models.Key(allocs[1])]).To(Equal(assigned[1]))
+	Expect(retrievedValues[models.Key(allocs[2])]).To(BeEquivalentTo(0x020000000001))
+
+	// the state of the pool is rebuilt
+	Expect(poolMeta.Allocated).To(HaveLen(2))
+	Expect(poolMeta.Allocated).To(HaveKey(models.Name(allocs[0])))
+	Expect(poolMeta.Allocated).To(HaveKey(models.Name(allocs[1])))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+const (
+	// ResourcePoolDescriptorName is the name of the descriptor for pools
+	// of MAC addresses, VLAN IDs and VXLAN VNIs.
+	ResourcePoolDescriptorName = "netalloc-resource-pool"
+)
+
+// ResourcePoolDescriptor validates and parses resource pools. The state of allocations
+// made from the pool is kept in the pool metadata.
+type ResourcePoolDescriptor struct {
+	log logging.Logger
+}
+
+// NewResourcePoolDescriptor creates a new instance of ResourcePoolDescriptor.
+func NewResourcePoolDescriptor(log logging.PluginLogger) (descr *kvs.KVDescriptor) {
+	ctx := &ResourcePoolDescriptor{
+		log: log.NewLogger("resource-pool-descriptor"),
+	}
+	typedDescr := &adapter.ResourcePoolDescriptor{
+		Name:          ResourcePoolDescriptorName,
+		NBKeyPrefix:   netalloc.ModelResourcePool.KeyPrefix(),
+		ValueTypeName: netalloc.ModelResourcePool.ProtoName(),
+		KeySelector:   netalloc.ModelResourcePool.IsKeyValid,
+		KeyLabel:      netalloc.ModelResourcePool.StripKeyPrefix,
+		WithMetadata:  true,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+	}
+	descr = adapter.NewResourcePoolDescriptor(typedDescr)
+	return
+}
+
+// Validate checks if the pool configuration can be parsed.
+func (d *ResourcePoolDescriptor) Validate(key string, pool *netalloc.ResourcePool) (err error) {
+	_, err = utils.ParseResourcePool(pool)
+	if err != nil {
+		return kvs.NewInvalidValueError(err)
+	}
+	return nil
+}
+
+// Create parses the pool and stores it into the metadata, initially without
+// any allocations.
+func (d *ResourcePoolDescriptor) Create(key string, pool *netalloc.ResourcePool) (metadata *netalloc.ResourcePoolMetadata, err error) {
+	return utils.ParseResourcePool(pool)
+}
+
+// Delete is NOOP (allocations from the pool depend on it and are therefore
+// removed first).
+func (d *ResourcePoolDescriptor) Delete(key string, pool *netalloc.ResourcePool, metadata *netalloc.ResourcePoolMetadata) (err error) {
+	return nil
+}
+
+// Retrieve always returns what is expected to exists since Create doesn't really change
+// anything in SB. Metadata of already created pools are preserved to keep
+// the addresses allocated from them.
+func (d *ResourcePoolDescriptor) Retrieve(correlate []adapter.ResourcePoolKVWithMetadata) (valid []adapter.ResourcePoolKVWithMetadata, err error) {
+	for _, pool := range correlate {
+		meta := pool.Metadata
+		if meta == nil {
+			if meta, err = utils.ParseResourcePool(pool.Value); err != nil {
+				continue
+			}
+		}
+		valid = append(valid, adapter.ResourcePoolKVWithMetadata{
+			Key:      pool.Key,
+			Value:    pool.Value,
+			Metadata: meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+	return valid, nil
+}
@@ -15,6 +15,7 @@ import (
 type NetAlloc struct {
 	realNetAlloc *plugin.Plugin
 	allocated    map[string]*netalloc.IPAllocMetadata // allocation name -> parsed address
+	resources    map[string]uint64                    // allocation name -> parsed resource
 }
 
 // NewMockNetAlloc is a constructor for mock netalloc plugin.
@@ -22,6 +23,7 @@ func NewMockNetAlloc() *NetAlloc {
 	return &NetAlloc{
 		realNetAlloc: &plugin.Plugin{},
 		allocated:    make(map[string]*netalloc.IPAllocMetadata),
+		resources:    make(map[string]uint64),
 	}
 }
 
@@ -129,3 +131,84 @@ func (p *NetAlloc) CorrelateRetrievedIPs(expAddrsOrRefs []string, retrievedAddrs
 	ifaceName string, addrForm netalloc.IPAddressForm) (correlated []string) {
 	return retrievedAddrs
 }
+
+// AllocateResource simulates allocation of a MAC address, VLAN ID or VNI.
+func (p *NetAlloc) AllocateResource(network, ifaceName string, resType netalloc.ResourceType, value string) {
+	parsed, err := utils.ParseResource(resType, value)
+	if err != nil {
+		panic(err)
+	}
+	p.resources[p.resourceAllocName(network, ifaceName, resType)] = parsed
+}
+
+// DeallocateResource simulates de-allocation of a MAC address, VLAN ID or VNI.
+func (p *NetAlloc) DeallocateResource(network, ifaceName string, resType netalloc.ResourceType) {
+	delete(p.resources, p.resourceAllocName(network, ifaceName, resType))
+}
+
+// GetResourceAllocDep is not implemented here.
+func (p *NetAlloc) GetResourceAllocDep(valueOrAllocRef, ifaceName string, resType netalloc.ResourceType,
+	depLabelPrefix string) (dep kvs.Dependency, hasAllocDep bool) {
+	return kvs.Dependency{}, false
+}
+
+// ValidateResourceRef checks validity of a reference to an allocated resource
+// or, if <valueOrAllocRef> already contains an actual value, it tries to parse it.
+func (p *NetAlloc) ValidateResourceRef(valueOrAllocRef, ifaceName string, resType netalloc.ResourceType,
+	fieldName string) error {
+	return p.realNetAlloc.ValidateResourceRef(valueOrAllocRef, ifaceName, resType, fieldName)
+}
+
+// GetOrParseMACAddress returns MAC address allocated for the interface
+// or parses the address if it is not a reference.
+func (p *NetAlloc) GetOrParseMACAddress(macOrAllocRef, ifaceName string) (string, error) {
+	value, err := p.getOrParseResource(macOrAllocRef, ifaceName, netalloc.ResourceType_MAC_ADDRESS)
+	if err != nil {
+		return "", err
+	}
+	return utils.FormatResource(netalloc.ResourceType_MAC_ADDRESS, value), nil
+}
+
+// GetAllocatedID returns VLAN ID or VXLAN VNI allocated for the interface.
+func (p *NetAlloc) GetAllocatedID(allocRef, ifaceName string, resType netalloc.ResourceType) (uint32, error) {
+	value, err := p.getOrParseResource(allocRef, ifaceName, resType)
+	return uint32(value), err
+}
+
+// CorrelateRetrievedResource is not implemented here.
+func (p *NetAlloc) CorrelateRetrievedResource(expValueOrAllocRef, retrieved, ifaceName string,
+	resType netalloc.ResourceType) string {
+	return retrieved
+}
+
+// ReserveResource is not implemented here.
+func (p *NetAlloc) ReserveResource(owner, value string, resType netalloc.ResourceType) error {
+	return nil
+}
+
+// ReleaseResource is not implemented here.
+func (p *NetAlloc) ReleaseResource(owner, value string, resType netalloc.ResourceType) {
+}
+
+func (p *NetAlloc) getOrParseResource(valueOrAllocRef, ifaceName string, resType netalloc.ResourceType) (uint64, error) {
+	network, iface, _, isRef, err := utils.ParseAddrAllocRef(valueOrAllocRef, ifaceName)
+	if !isRef {
+		return utils.ParseResource(resType, valueOrAllocRef)
+	}
+	if err != nil {
+		return 0, err
+	}
+	value, found := p.resources[p.resourceAllocName(network, iface, resType)]
+	if !found {
+		return 0, errors.New("resource is not allocated")
+	}
+	return value, nil
+}
+
+func (p *NetAlloc) resourceAllocName(network, ifaceName string, resType netalloc.ResourceType) string {
+	return models.Name(&netalloc.ResourceAllocation{
+		Type:          resType,
+		NetworkName:   network,
+		InterfaceName: ifaceName,
+	})
+}
@@ -132,3 +132,54 @@ type AddressAllocator interface {
 	CorrelateRetrievedIPs(expAddrsOrRefs []string, retrievedAddrs []string, expIface string,
 		addrForm netalloc.IPAddressForm) []string
 }
+
+// ResourceAllocator provides methods for descriptors of other plugins to reference
+// and obtain allocated MAC addresses, VLAN IDs and VXLAN VNIs.
+//
+// The usage is analogous to AddressAllocator - resource allocation reference
+// ("alloc:<network>/<iface>" or just "alloc:<network>" when interface is given)
+// should be validated in Validate, turned into a dependency in Dependencies
+// and resolved into the actual value in Create/Update. Retrieve should use
+// CorrelateRetrievedResource to put the reference back in place of the value.
+// Don't forget to include the netalloc descriptor for resources
+// (ResourceAllocDescriptorName from plugins/netalloc/descriptor) in the list
+// of "RetrieveDependencies".
+type ResourceAllocator interface {
+	// GetResourceAllocDep reads what can be potentially a reference to an allocated
+	// resource. If <valueOrAllocRef> is indeed a reference, the function returns
+	// the corresponding dependency to be passed further into KVScheduler
+	// from the descriptor. Otherwise <hasAllocDep> is returned as false.
+	GetResourceAllocDep(valueOrAllocRef, expIface string, resType netalloc.ResourceType,
+		depLabelPrefix string) (dep kvs.Dependency, hasAllocDep bool)
+
+	// ValidateResourceRef checks validity of a reference to an allocated resource
+	// or, if <valueOrAllocRef> already contains an actual value, it tries to parse it.
+	ValidateResourceRef(valueOrAllocRef, expIface string, resType netalloc.ResourceType,
+		fieldName string) error
+
+	// GetOrParseMACAddress returns MAC address allocated for the interface
+	// and referenced by <macOrAllocRef>. If the string contains an actual
+	// MAC address instead of a reference, the address is parsed and returned
+	// in the normalized form.
+	GetOrParseMACAddress(macOrAllocRef, expIface string) (string, error)
+
+	// GetAllocatedID returns VLAN ID or VXLAN VNI allocated for the interface
+	// and referenced by <allocRef>.
+	GetAllocatedID(allocRef, expIface string, resType netalloc.ResourceType) (uint32, error)
+
+	// CorrelateRetrievedResource should be used in Retrieve to replace retrieved
+	// resource with the allocation reference from the expected configuration,
+	// if the reference resolves to the retrieved value.
+	CorrelateRetrievedResource(expValueOrAllocRef, retrieved, expIface string,
+		resType netalloc.ResourceType) string
+
+	// ReserveResource marks MAC address, VLAN ID or VNI configured statically
+	// by the given owner (i.e. not a reference to an allocated resource) as used,
+	// so that it is never assigned from a pool. Error is returned if the resource
+	// is already allocated via netalloc. The same resource can be reserved
+	// by multiple owners.
+	ReserveResource(owner, value string, resType netalloc.ResourceType) error
+
+	// ReleaseResource removes reservation made by ReserveResource.
+	ReleaseResource(owner, value string, resType netalloc.ResourceType)
+}
@@ -13,6 +13,9 @@
 // limitations under the License.
 
 //go:generate descriptor-adapter --descriptor-name IPAlloc --value-type *netalloc.IPAllocation --meta-type *netalloc.IPAllocMetadata --import "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IPPool --value-type *netalloc.IPPool --meta-type *netalloc.IPPoolMetadata --import "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ResourcePool --value-type *netalloc.ResourcePool --meta-type *netalloc.ResourcePoolMetadata --import "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ResourceAlloc --value-type *netalloc.ResourceAllocation --meta-type *netalloc.ResourceAllocMetadata --import "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc" --output-dir "descriptor"
 
 package netalloc
 
@@ -41,6 +44,17 @@ type Plugin struct {
 	// IP address allocation
 	ipAllocDescriptor *kvs.KVDescriptor
 	ipIndex           idxmap.NamedMapping
+
+	// IP address pools
+	ipPoolDescriptor *kvs.KVDescriptor
+	ipPoolIndex      idxmap.NamedMapping
+
+	// allocation of MAC addresses, VLAN IDs and VXLAN VNIs
+	resPoolDescriptor  *kvs.KVDescriptor
+	resPoolIndex       idxmap.NamedMapping
+	resAllocDescriptor *kvs.KVDescriptor
+	resAllocCtx        *descriptor.ResourceAllocDescriptor
+	resIndex           idxmap.NamedMapping
 }
 
 // Deps lists dependencies of the netalloc plugin.
@@ -52,8 +66,20 @@ type Deps struct {
 // Init initializes netalloc descriptors.
 func (p *Plugin) Init() error {
 	// init & register descriptors
-	p.ipAllocDescriptor = descriptor.NewAddrAllocDescriptor(p.Log)
-	err := p.Deps.KVScheduler.RegisterKVDescriptor(p.ipAllocDescriptor)
+	p.ipPoolDescriptor = descriptor.NewIPPoolDescriptor(p.Log)
+	err := p.Deps.KVScheduler.RegisterKVDescriptor(p.ipPoolDescriptor)
+	if err != nil {
+		return err
+	}
+
+	// obtain map with metadata of IP pools
+	p.ipPoolIndex = p.KVScheduler.GetMetadataMap(descriptor.IPPoolDescriptorName)
+	if p.ipPoolIndex == nil {
+		return errors.New("missing index with metadata of IP pools")
+	}
+
+	p.ipAllocDescriptor = descriptor.NewAddrAllocDescriptor(p.Log, p.ipPoolIndex)
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(p.ipAllocDescriptor)
 	if err != nil {
 		return err
 	}
@@ -63,6 +89,26 @@ func (p *Plugin) Init() error {
 	if p.ipIndex == nil {
 		return errors.New("missing index with metadata of allocated addresses")
 	}
+
+	// init & register descriptors for other resources
+	p.resPoolDescriptor = descriptor.NewResourcePoolDescriptor(p.Log)
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(p.resPoolDescriptor)
+	if err != nil {
+		return err
+	}
+	p.resPoolIndex = p.KVScheduler.GetMetadataMap(descriptor.ResourcePoolDescriptorName)
+	if p.resPoolIndex == nil {
+		return errors.New("missing index with metadata of resource pools")
+	}
+	p.resAllocDescriptor, p.resAllocCtx = descriptor.NewResourceAllocDescriptor(p.Log, p.resPoolIndex)
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(p.resAllocDescriptor)
+	if err != nil {
+		return err
+	}
+	p.resIndex = p.KVScheduler.GetMetadataMap(descriptor.ResourceAllocDescriptorName)
+	if p.resIndex == nil {
+		return errors.New("missing index with metadata of allocated resources")
+	}
 	return nil
 }
 
@@ -231,3 +277,138 @@ func (p *Plugin) CorrelateRetrievedIPs(expAddrsOrRefs []string, retrievedAddrs [
 	}
 	return correlated
 }
+
+// GetResourceAllocDep reads what can be potentially a reference to an allocated
+// MAC address, VLAN ID or VNI. If <valueOrAllocRef> is indeed a reference,
+// the function returns the corresponding dependency to be passed further
+// into KVScheduler from the descriptor.
+func (p *Plugin) GetResourceAllocDep(valueOrAllocRef, ifaceName string, resType netalloc.ResourceType,
+	depLabelPrefix string) (dep kvs.Dependency, hasAllocDep bool) {
+
+	network, iface, isGW, isRef, err := utils.ParseAddrAllocRef(valueOrAllocRef, ifaceName)
+	if !isRef || isGW || err != nil {
+		return kvs.Dependency{}, false
+	}
+
+	return kvs.Dependency{
+		Label: depLabelPrefix + valueOrAllocRef,
+		Key: models.Key(&netalloc.ResourceAllocation{
+			Type:          resType,
+			NetworkName:   network,
+			InterfaceName: iface,
+		}),
+	}, true
+}
+
+// ValidateResourceRef checks validity of a reference to an allocated resource
+// or, if <valueOrAllocRef> already contains an actual value, it tries to parse it.
+func (p *Plugin) ValidateResourceRef(valueOrAllocRef, ifaceName string, resType netalloc.ResourceType,
+	fieldName string) error {
+
+	_, _, isGW, isRef, err := utils.ParseAddrAllocRef(valueOrAllocRef, ifaceName)
+	if !isRef {
+		_, err = utils.ParseResource(resType, valueOrAllocRef)
+	} else if err == nil && isGW {
+		err = errors.New("GW reference is not allowed for resource allocation")
+	}
+	if err != nil {
+		if fieldName != "" {
+			return kvs.NewInvalidValueError(err, fieldName)
+		}
+		return kvs.NewInvalidValueError(err)
+	}
+	return nil
+}
+
+// GetOrParseMACAddress returns MAC address allocated for the interface and referenced
+// by <macOrAllocRef>. If the string contains an actual MAC address instead
+// of a reference, the address is parsed and returned in the normalized form.
+func (p *Plugin) GetOrParseMACAddress(macOrAllocRef, ifaceName string) (string, error) {
+	value, err := p.getOrParseResource(macOrAllocRef, ifaceName, netalloc.ResourceType_MAC_ADDRESS)
+	if err != nil {
+		return "", err
+	}
+	return utils.FormatResource(netalloc.ResourceType_MAC_ADDRESS, value), nil
+}
+
+// GetAllocatedID returns VLAN ID or VXLAN VNI allocated for the interface
+// and referenced by <allocRef>.
+func (p *Plugin) GetAllocatedID(allocRef, ifaceName string, resType netalloc.ResourceType) (uint32, error) {
+	if resType != netalloc.ResourceType_VLAN_ID && resType != netalloc.ResourceType_VXLAN_VNI {
+		return 0, fmt.Errorf("resource of type %v is not an ID", resType)
+	}
+	value, err := p.getOrParseResource(allocRef, ifaceName, resType)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(value), nil
+}
+
+// CorrelateRetrievedResource should be used in Retrieve to replace retrieved resource
+// with the allocation reference from the expected configuration, if the reference
+// resolves to the retrieved value.
+func (p *Plugin) CorrelateRetrievedResource(expValueOrAllocRef, retrieved, ifaceName string,
+	resType netalloc.ResourceType) string {
+
+	if _, _, _, isRef, _ := utils.ParseAddrAllocRef(expValueOrAllocRef, ifaceName); !isRef {
+		return retrieved
+	}
+	expValue, err := p.getOrParseResource(expValueOrAllocRef, ifaceName, resType)
+	if err != nil {
+		return retrieved
+	}
+	retrievedValue, err := utils.ParseResource(resType, retrieved)
+	if err != nil || retrievedValue != expValue {
+		return retrieved
+	}
+	return expValueOrAllocRef
+}
+
+// ReserveResource marks MAC address, VLAN ID or VNI configured statically
+// by the given owner as used, so that it is never assigned from a pool.
+// Values which cannot be parsed are never assigned from a pool and therefore
+// are not reserved.
+func (p *Plugin) ReserveResource(owner, value string, resType netalloc.ResourceType) error {
+	parsed, err := utils.ParseResource(resType, value)
+	if err != nil {
+		return nil
+	}
+	return p.resAllocCtx.Reserve(owner, resType, parsed)
+}
+
+// ReleaseResource removes reservation made by ReserveResource.
+func (p *Plugin) ReleaseResource(owner, value string, resType netalloc.ResourceType) {
+	if parsed, err := utils.ParseResource(resType, value); err == nil {
+		p.resAllocCtx.Release(owner, resType, parsed)
+	}
+}
+
+// getOrParseResource returns allocated resource referenced by <valueOrAllocRef>
+// or parses the value if it is not a reference.
+func (p *Plugin) getOrParseResource(valueOrAllocRef, ifaceName string, resType netalloc.ResourceType) (uint64, error) {
+	network, iface, isGW, isRef, err := utils.ParseAddrAllocRef(valueOrAllocRef, ifaceName)
+	if !isRef {
+		return utils.ParseResource(resType, valueOrAllocRef)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if isGW {
+		return 0, fmt.Errorf("GW reference is not allowed for resource allocation: %s", valueOrAllocRef)
+	}
+
+	allocName := models.Name(&netalloc.ResourceAllocation{
+		Type:          resType,
+		NetworkName:   network,
+		InterfaceName: iface,
+	})
+	allocVal, found := p.resIndex.GetValue(allocName)
+	if !found {
+		return 0, fmt.Errorf("failed to find metadata for resource allocation '%s'", allocName)
+	}
+	allocMeta, ok := allocVal.(*netalloc.ResourceAllocMetadata)
+	if !ok {
+		return 0, fmt.Errorf("invalid type of metadata stored for resource allocation '%s'", allocName)
+	}
+	return allocMeta.Value, nil
+}
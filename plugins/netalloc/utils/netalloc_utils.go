@@ -1,7 +1,11 @@
 package utils
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"math/big"
 	"net"
 	"strings"
 
@@ -129,3 +133,174 @@ func GetIPAddrInGivenForm(addr *net.IPNet, form netalloc.IPAddressForm) *net.IPN
 	}
 	return addr
 }
+
+// ParseIPPool parses IP pool configuration into metadata with an empty
+// set of allocations.
+func ParseIPPool(pool *netalloc.IPPool) (parsed *netalloc.IPPoolMetadata, err error) {
+	if pool.Name == "" {
+		return nil, errors.New("missing pool name")
+	}
+	if strings.Contains(pool.Name, "/") {
+		return nil, fmt.Errorf("pool name contains forward slash: %s", pool.Name)
+	}
+	_, subnet, err := net.ParseCIDR(pool.Subnet)
+	if err != nil {
+		return nil, err
+	}
+	if subnet.IP.To4() != nil {
+		subnet.IP = subnet.IP.To4()
+	}
+	parsed = &netalloc.IPPoolMetadata{
+		Subnet:    subnet,
+		Allocated: make(map[string]net.IP),
+	}
+	if pool.Gateway != "" {
+		gw, inSubnet, err := ParseIPAddr(pool.Gateway, subnet)
+		if err != nil {
+			return nil, err
+		}
+		if !inSubnet {
+			return nil, fmt.Errorf("gateway %s is outside of the pool subnet %s",
+				pool.Gateway, pool.Subnet)
+		}
+		parsed.Gateway = gw
+	}
+	for _, excluded := range pool.Excluded {
+		exclNet, _, err := ParseIPAddr(excluded, nil)
+		if err != nil {
+			return nil, err
+		}
+		exclNet.IP = exclNet.IP.Mask(exclNet.Mask)
+		parsed.Excluded = append(parsed.Excluded, exclNet)
+	}
+	return parsed, nil
+}
+
+// AllocateFromPool assigns a free address of the pool to the given allocation.
+// The search for a free address starts at the position in the pool given by
+// the hash of the allocation name, therefore the allocation gets the same address
+// also after the state of the pool is lost (e.g. after the agent restart),
+// unless the address is taken by another allocation. If the allocation already
+// has an address assigned from the pool, the same address is returned.
+// The address is returned with the mask of the pool subnet.
+func AllocateFromPool(pool *netalloc.IPPoolMetadata, allocName string) (*net.IPNet, error) {
+	if ip, allocated := pool.Allocated[allocName]; allocated {
+		return &net.IPNet{IP: ip, Mask: pool.Subnet.Mask}, nil
+	}
+	taken := make(map[string]struct{}, len(pool.Allocated))
+	for _, ip := range pool.Allocated {
+		taken[ip.String()] = struct{}{}
+	}
+	first, last := poolHostRange(pool.Subnet)
+	start := hashedIP(first, last, allocName)
+	ip := findFreeIP(pool, taken, start, last)
+	if ip == nil && !start.Equal(first) {
+		ip = findFreeIP(pool, taken, first, start)
+	}
+	if ip == nil {
+		return nil, fmt.Errorf("no free address left in the pool subnet %v", pool.Subnet)
+	}
+	pool.Allocated[allocName] = ip
+	return &net.IPNet{IP: ip, Mask: pool.Subnet.Mask}, nil
+}
+
+// ReleaseToPool returns address assigned to the given allocation back to the pool.
+func ReleaseToPool(pool *netalloc.IPPoolMetadata, allocName string) {
+	delete(pool.Allocated, allocName)
+}
+
+// excludedBy returns the excluded network which contains the given IP address
+// (nil if the address is not excluded).
+func excludedBy(pool *netalloc.IPPoolMetadata, ip net.IP) *net.IPNet {
+	for _, exclNet := range pool.Excluded {
+		if exclNet.Contains(ip) {
+			return exclNet
+		}
+	}
+	return nil
+}
+
+// poolHostRange returns the first and the last address of the pool subnet
+// which can be assigned to an interface. The network address and the IPv4
+// broadcast address are left out, except for point-to-point subnets (/31, /127)
+// and subnets with a single address (/32, /128).
+func poolHostRange(subnet *net.IPNet) (first, last net.IP) {
+	first = subnet.IP.Mask(subnet.Mask)
+	last = lastIP(subnet)
+	ones, bits := subnet.Mask.Size()
+	if bits-ones <= 1 {
+		return first, last
+	}
+	first = nextIP(first)
+	if bits == 32 {
+		last = prevIP(last)
+	}
+	return first, last
+}
+
+// hashedIP returns address from the range <first, last> selected by the hash
+// of the given allocation name.
+func hashedIP(first, last net.IP, allocName string) net.IP {
+	h := fnv.New64a()
+	h.Write([]byte(allocName))
+	firstInt := new(big.Int).SetBytes(first)
+	size := new(big.Int).SetBytes(last)
+	size.Sub(size, firstInt).Add(size, big.NewInt(1))
+	offset := new(big.Int).SetUint64(h.Sum64())
+	offset.Mod(offset, size)
+	return offset.Add(offset, firstInt).FillBytes(make(net.IP, len(first)))
+}
+
+// findFreeIP returns the first address from the range <from, to> which is not
+// excluded, taken or used as the gateway (nil if there is no such address).
+func findFreeIP(pool *netalloc.IPPoolMetadata, taken map[string]struct{}, from, to net.IP) net.IP {
+	for ip := from; ; ip = nextIP(ip) {
+		if exclNet := excludedBy(pool, ip); exclNet != nil {
+			// skip the whole excluded range
+			ip = lastIP(exclNet)
+		} else if pool.Gateway == nil || !ip.Equal(pool.Gateway.IP) {
+			if _, isTaken := taken[ip.String()]; !isTaken {
+				return ip
+			}
+		}
+		if bytes.Compare(ip, to) >= 0 {
+			return nil
+		}
+	}
+}
+
+// nextIP returns IP address following the given one.
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+// prevIP returns IP address preceding the given one.
+func prevIP(ip net.IP) net.IP {
+	prev := make(net.IP, len(ip))
+	copy(prev, ip)
+	for i := len(prev) - 1; i >= 0; i-- {
+		prev[i]--
+		if prev[i] != 0xff {
+			break
+		}
+	}
+	return prev
+}
+
+// lastIP returns the last IP address of the given network.
+func lastIP(ipNet *net.IPNet) net.IP {
+	ip := ipNet.IP.Mask(ipNet.Mask)
+	last := make(net.IP, len(ip))
+	for i := range ip {
+		last[i] = ip[i] | ^ipNet.Mask[len(ipNet.Mask)-len(ip)+i]
+	}
+	return last
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+func TestParseIPPool(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool, err := utils.ParseIPPool(&netalloc.IPPool{
+		Name:     "p2p",
+		Subnet:   "10.10.1.0/24",
+		Gateway:  "10.10.1.1",
+		Excluded: []string{"10.10.1.2", "10.10.1.8/29"},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(pool.Subnet.String()).To(Equal("10.10.1.0/24"))
+	Expect(pool.Gateway.String()).To(Equal("10.10.1.1/24"))
+	Expect(pool.Excluded).To(HaveLen(2))
+	Expect(pool.Excluded[1].String()).To(Equal("10.10.1.8/29"))
+	Expect(pool.Allocated).To(BeEmpty())
+
+	_, err = utils.ParseIPPool(&netalloc.IPPool{Name: "p2p", Subnet: "10.10.1.0/24", Gateway: "10.10.2.1"})
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseIPPool(&netalloc.IPPool{Name: "p2p/1", Subnet: "10.10.1.0/24"})
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseIPPool(&netalloc.IPPool{Name: "p2p", Subnet: "10.10.1.0"})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestAllocateFromPool(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool, err := utils.ParseIPPool(&netalloc.IPPool{
+		Name:     "p2p",
+		Subnet:   "10.10.1.0/28",
+		Gateway:  "10.10.1.1",
+		Excluded: []string{"10.10.1.3", "10.10.1.4/31"},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	addr1, err := utils.AllocateFromPool(pool, "memif1")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(addr1.Mask).To(Equal(pool.Subnet.Mask))
+	addr2, err := utils.AllocateFromPool(pool, "memif2")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(addr2.IP.Equal(addr1.IP)).To(BeFalse())
+
+	// repeated allocation returns the same address
+	addr, err := utils.AllocateFromPool(pool, "memif1")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(addr.String()).To(Equal(addr1.String()))
+
+	// 10 addresses are available (without network, broadcast, gateway and excluded addresses)
+	for i := 0; i < 8; i++ {
+		_, err = utils.AllocateFromPool(pool, "tap"+string(rune('a'+i)))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	var allocated []string
+	for _, ip := range pool.Allocated {
+		allocated = append(allocated, ip.String())
+	}
+	Expect(allocated).To(ConsistOf("10.10.1.2", "10.10.1.6", "10.10.1.7", "10.10.1.8",
+		"10.10.1.9", "10.10.1.10", "10.10.1.11", "10.10.1.12", "10.10.1.13", "10.10.1.14"))
+	_, err = utils.AllocateFromPool(pool, "tapx")
+	Expect(err).To(HaveOccurred())
+
+	// released address is re-used
+	utils.ReleaseToPool(pool, "memif1")
+	addr, err = utils.AllocateFromPool(pool, "memif3")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(addr.String()).To(Equal(addr1.String()))
+}
+
+func TestAllocateFromPoolAfterRestart(t *testing.T) {
+	RegisterTestingT(t)
+
+	config := &netalloc.IPPool{
+		Name:   "p2p",
+		Subnet: "10.10.0.0/16",
+	}
+	pool, err := utils.ParseIPPool(config)
+	Expect(err).ToNot(HaveOccurred())
+	names := []string{"memif1", "memif2", "tap1", "tap2"}
+	for _, name := range names {
+		_, err = utils.AllocateFromPool(pool, name)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	// allocations made in a different order from the pool with lost state
+	// get the same addresses
+	restarted, err := utils.ParseIPPool(config)
+	Expect(err).ToNot(HaveOccurred())
+	for i := len(names) - 1; i >= 0; i-- {
+		addr, err := utils.AllocateFromPool(restarted, names[i])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addr.IP.String()).To(Equal(pool.Allocated[names[i]].String()))
+	}
+}
+
+func TestAllocateFromSmallPools(t *testing.T) {
+	RegisterTestingT(t)
+
+	tests := []struct {
+		subnet    string
+		available []string
+	}{
+		{subnet: "10.10.1.0/30", available: []string{"10.10.1.1", "10.10.1.2"}},
+		{subnet: "10.10.1.2/31", available: []string{"10.10.1.2", "10.10.1.3"}},
+		{subnet: "10.10.1.5/32", available: []string{"10.10.1.5"}},
+		{subnet: "fd00::4/126", available: []string{"fd00::5", "fd00::6", "fd00::7"}},
+		{subnet: "fd00::4/127", available: []string{"fd00::4", "fd00::5"}},
+		{subnet: "fd00::5/128", available: []string{"fd00::5"}},
+	}
+	for _, test := range tests {
+		t.Run(test.subnet, func(t *testing.T) {
+			pool, err := utils.ParseIPPool(&netalloc.IPPool{Name: "p2p", Subnet: test.subnet})
+			Expect(err).ToNot(HaveOccurred())
+			var allocated []string
+			for i := range test.available {
+				addr, err := utils.AllocateFromPool(pool, "memif"+string(rune('a'+i)))
+				Expect(err).ToNot(HaveOccurred())
+				allocated = append(allocated, addr.IP.String())
+			}
+			Expect(allocated).To(ConsistOf(test.available))
+			_, err = utils.AllocateFromPool(pool, "memifx")
+			Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestAllocateFromIPv6Pool(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool, err := utils.ParseIPPool(&netalloc.IPPool{
+		Name:     "p2p6",
+		Subnet:   "fd00::/64",
+		Gateway:  "fd00::1",
+		Excluded: []string{"fd00::/120"},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	for i := 0; i < 16; i++ {
+		addr, err := utils.AllocateFromPool(pool, "memif"+string(rune('a'+i)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addr.Mask).To(Equal(pool.Subnet.Mask))
+		Expect(pool.Subnet.Contains(addr.IP)).To(BeTrue())
+		Expect(pool.Excluded[0].Contains(addr.IP)).To(BeFalse())
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+const (
+	maxVlanID = 4094
+	maxVNI    = 1<<24 - 1
+)
+
+// ParseResource parses MAC address, VLAN ID or VNI from string into a number.
+func ParseResource(resType netalloc.ResourceType, value string) (uint64, error) {
+	switch resType {
+	case netalloc.ResourceType_MAC_ADDRESS:
+		mac, err := net.ParseMAC(value)
+		if err != nil {
+			return 0, err
+		}
+		if len(mac) != 6 {
+			return 0, fmt.Errorf("invalid 48-bit MAC address: %s", value)
+		}
+		if mac[0]&0x01 != 0 {
+			// I/G bit is set for multicast and broadcast addresses
+			return 0, fmt.Errorf("not a unicast MAC address: %s", value)
+		}
+		var num uint64
+		for _, b := range mac {
+			num = num<<8 | uint64(b)
+		}
+		return num, nil
+	case netalloc.ResourceType_VLAN_ID:
+		return parseID(value, maxVlanID)
+	case netalloc.ResourceType_VXLAN_VNI:
+		return parseID(value, maxVNI)
+	}
+	return 0, fmt.Errorf("undefined resource type: %v", resType)
+}
+
+// FormatResource returns string representation of a resource parsed
+// by ParseResource.
+func FormatResource(resType netalloc.ResourceType, value uint64) string {
+	if resType == netalloc.ResourceType_MAC_ADDRESS {
+		return ResourceToMAC(value).String()
+	}
+	return strconv.FormatUint(value, 10)
+}
+
+// ResourceToMAC converts MAC address stored as a number back to net.HardwareAddr.
+func ResourceToMAC(value uint64) net.HardwareAddr {
+	mac := make(net.HardwareAddr, 6)
+	for i := 5; i >= 0; i-- {
+		mac[i] = byte(value)
+		value >>= 8
+	}
+	return mac
+}
+
+// parseID parses VLAN ID or VNI.
+func parseID(value string, maxID uint64) (uint64, error) {
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 || id > maxID {
+		return 0, fmt.Errorf("value %d is out of range <1, %d>", id, maxID)
+	}
+	return id, nil
+}
+
+// ParseResourcePool parses resource pool configuration into metadata with
+// an empty set of allocations.
+func ParseResourcePool(pool *netalloc.ResourcePool) (parsed *netalloc.ResourcePoolMetadata, err error) {
+	if pool.Name == "" {
+		return nil, errors.New("missing pool name")
+	}
+	if strings.Contains(pool.Name, "/") {
+		return nil, fmt.Errorf("pool name contains forward slash: %s", pool.Name)
+	}
+	parsed = &netalloc.ResourcePoolMetadata{
+		Type:      pool.Type,
+		Allocated: make(map[string]uint64),
+	}
+	if parsed.First, err = ParseResource(pool.Type, pool.First); err != nil {
+		return nil, err
+	}
+	if parsed.Last, err = ParseResource(pool.Type, pool.Last); err != nil {
+		return nil, err
+	}
+	if parsed.First > parsed.Last {
+		return nil, fmt.Errorf("first resource of the pool (%s) is greater than the last one (%s)",
+			pool.First, pool.Last)
+	}
+	if pool.Type == netalloc.ResourceType_MAC_ADDRESS && parsed.First>>40 != parsed.Last>>40 {
+		// every other value of the first octet has the I/G bit set
+		return nil, fmt.Errorf("MAC addresses of the pool <%s, %s> differ in the first octet "+
+			"and therefore include multicast addresses", pool.First, pool.Last)
+	}
+	for _, excluded := range pool.Excluded {
+		value, err := ParseResource(pool.Type, excluded)
+		if err != nil {
+			return nil, err
+		}
+		parsed.Excluded = append(parsed.Excluded, value)
+	}
+	return parsed, nil
+}
+
+// AllocateResourceFromPool assigns a free resource of the pool to the given
+// allocation. Resource is considered as free if it is not excluded, not allocated
+// from the pool and <isTaken> (if given) returns false for it.
+// The search for a free resource starts at the position in the pool given by
+// the hash of the allocation name, therefore the allocation gets the same resource
+// also after the state of the pool is lost (e.g. after the agent restart),
+// unless the resource is taken by another allocation.
+// If the allocation already has a resource assigned from the pool, the same
+// resource is returned.
+func AllocateResourceFromPool(pool *netalloc.ResourcePoolMetadata, allocName string,
+	isTaken func(value uint64) bool) (uint64, error) {
+
+	if value, allocated := pool.Allocated[allocName]; allocated {
+		return value, nil
+	}
+	unavailable := make(map[uint64]struct{}, len(pool.Allocated)+len(pool.Excluded))
+	for _, value := range pool.Allocated {
+		unavailable[value] = struct{}{}
+	}
+	for _, value := range pool.Excluded {
+		unavailable[value] = struct{}{}
+	}
+	h := fnv.New64a()
+	h.Write([]byte(allocName))
+	size := pool.Last - pool.First + 1
+	start := h.Sum64() % size
+	for i := uint64(0); i < size; i++ {
+		value := pool.First + (start+i)%size
+		if _, isUnavailable := unavailable[value]; isUnavailable {
+			continue
+		}
+		if isTaken != nil && isTaken(value) {
+			continue
+		}
+		pool.Allocated[allocName] = value
+		return value, nil
+	}
+	return 0, fmt.Errorf("no free resource left in the pool <%s, %s>",
+		FormatResource(pool.Type, pool.First), FormatResource(pool.Type, pool.Last))
+}
+
+// ReleaseResourceToPool returns resource assigned to the given allocation back
+// to the pool.
+func ReleaseResourceToPool(pool *netalloc.ResourcePoolMetadata, allocName string) {
+	delete(pool.Allocated, allocName)
+}
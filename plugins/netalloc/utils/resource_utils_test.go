@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+func TestParseResource(t *testing.T) {
+	RegisterTestingT(t)
+
+	mac, err := utils.ParseResource(netalloc.ResourceType_MAC_ADDRESS, "02:FE:00:00:01:0a")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(mac).To(BeEquivalentTo(0x02fe0000010a))
+	Expect(utils.FormatResource(netalloc.ResourceType_MAC_ADDRESS, mac)).To(Equal("02:fe:00:00:01:0a"))
+
+	vlan, err := utils.ParseResource(netalloc.ResourceType_VLAN_ID, "100")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(vlan).To(BeEquivalentTo(100))
+	Expect(utils.FormatResource(netalloc.ResourceType_VLAN_ID, vlan)).To(Equal("100"))
+
+	_, err = utils.ParseResource(netalloc.ResourceType_VLAN_ID, "4095")
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseResource(netalloc.ResourceType_VXLAN_VNI, "0")
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseResource(netalloc.ResourceType_VXLAN_VNI, "16777216")
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseResource(netalloc.ResourceType_MAC_ADDRESS, "00:00:00:00:fe:80:00:00")
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseResource(netalloc.ResourceType_UNDEFINED_RESOURCE, "1")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestParseMACPool(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := utils.ParseResource(netalloc.ResourceType_MAC_ADDRESS, "01:00:5e:00:00:01")
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseResource(netalloc.ResourceType_MAC_ADDRESS, "ff:ff:ff:ff:ff:ff")
+	Expect(err).To(HaveOccurred())
+
+	// the range would include 03:00:00:00:00:00 - 03:ff:ff:ff:ff:ff
+	_, err = utils.ParseResourcePool(&netalloc.ResourcePool{
+		Name:  "macs",
+		Type:  netalloc.ResourceType_MAC_ADDRESS,
+		First: "02:ff:ff:ff:ff:00",
+		Last:  "04:00:00:00:00:ff",
+	})
+	Expect(err).To(HaveOccurred())
+	_, err = utils.ParseResourcePool(&netalloc.ResourcePool{
+		Name:  "macs",
+		Type:  netalloc.ResourceType_MAC_ADDRESS,
+		First: "fe:ff:ff:ff:ff:00",
+		Last:  "ff:ff:ff:ff:ff:ff",
+	})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestAllocateResourceFromPool(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := utils.ParseResourcePool(&netalloc.ResourcePool{
+		Name:  "vlans",
+		Type:  netalloc.ResourceType_VLAN_ID,
+		First: "20",
+		Last:  "10",
+	})
+	Expect(err).To(HaveOccurred())
+
+	pool, err := utils.ParseResourcePool(&netalloc.ResourcePool{
+		Name:     "vlans",
+		Type:     netalloc.ResourceType_VLAN_ID,
+		First:    "10",
+		Last:     "14",
+		Excluded: []string{"11"},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	// 12 is taken by another allocation (e.g. statically allocated)
+	isTaken := func(value uint64) bool { return value == 12 }
+
+	assigned := make(map[uint64]string)
+	for _, allocName := range []string{"sub1", "sub2", "sub3"} {
+		value, err := utils.AllocateResourceFromPool(pool, allocName, isTaken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(BeElementOf(uint64(10), uint64(13), uint64(14)))
+		Expect(assigned).ToNot(HaveKey(value))
+		assigned[value] = allocName
+	}
+	sub1 := pool.Allocated["sub1"]
+	value, err := utils.AllocateResourceFromPool(pool, "sub1", isTaken)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(value).To(Equal(sub1))
+	_, err = utils.AllocateResourceFromPool(pool, "sub4", isTaken)
+	Expect(err).To(HaveOccurred())
+
+	sub2 := pool.Allocated["sub2"]
+	utils.ReleaseResourceToPool(pool, "sub2")
+	value, err = utils.AllocateResourceFromPool(pool, "sub4", isTaken)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(value).To(Equal(sub2))
+}
+
+func TestAllocateResourceFromPoolAfterRestart(t *testing.T) {
+	RegisterTestingT(t)
+
+	config := &netalloc.ResourcePool{
+		Name:  "vnis",
+		Type:  netalloc.ResourceType_VXLAN_VNI,
+		First: "1000",
+		Last:  "1999",
+	}
+	allocNames := []string{"net1/vxlan1", "net1/vxlan2", "net2/vxlan1", "net2/vxlan2"}
+
+	pool, err := utils.ParseResourcePool(config)
+	Expect(err).ToNot(HaveOccurred())
+	assigned := make(map[string]uint64)
+	for _, allocName := range allocNames {
+		value, err := utils.AllocateResourceFromPool(pool, allocName, nil)
+		Expect(err).ToNot(HaveOccurred())
+		assigned[allocName] = value
+	}
+
+	// the state of the pool is lost, allocations are made again in a different order
+	pool, err = utils.ParseResourcePool(config)
+	Expect(err).ToNot(HaveOccurred())
+	for i := len(allocNames) - 1; i >= 0; i-- {
+		value, err := utils.AllocateResourceFromPool(pool, allocNames[i], nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(assigned[allocNames[i]]))
+	}
+}
+
+func TestAllocateMACFromPool(t *testing.T) {
+	RegisterTestingT(t)
+
+	pool, err := utils.ParseResourcePool(&netalloc.ResourcePool{
+		Name:  "macs",
+		Type:  netalloc.ResourceType_MAC_ADDRESS,
+		First: "02:fe:00:00:00:ff",
+		Last:  "02:fe:00:00:01:ff",
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	value1, err := utils.AllocateResourceFromPool(pool, "memif1", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(value1).To(BeNumerically(">=", 0x02fe000000ff))
+	Expect(value1).To(BeNumerically("<=", 0x02fe000001ff))
+	value2, err := utils.AllocateResourceFromPool(pool, "memif2", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(value2).To(BeNumerically(">=", 0x02fe000000ff))
+	Expect(value2).To(BeNumerically("<=", 0x02fe000001ff))
+	Expect(value2).ToNot(Equal(value1))
+	Expect(utils.FormatResource(netalloc.ResourceType_MAC_ADDRESS, value1)).To(HavePrefix("02:fe:00:00:0"))
+}
@@ -15,7 +15,10 @@
 // Package contextdecorator handles insertions and extractions of orchestrator related data from context.
 package contextdecorator
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type dataSrcKeyT string
 
@@ -29,3 +32,19 @@ func DataSrcFromContext(ctx context.Context) (dataSrc string, ok bool) {
 	dataSrc, ok = ctx.Value(dataSrcKey).(string)
 	return
 }
+
+type leasesKeyT string
+
+var leasesKey = leasesKeyT("leases")
+
+// LeasesContext returns context carrying leases (lifetime) for the pushed
+// key-value pairs. Keys without lease are not limited in lifetime.
+func LeasesContext(ctx context.Context, leases map[string]time.Duration) context.Context {
+	return context.WithValue(ctx, leasesKey, leases)
+}
+
+// LeasesFromContext returns leases of the pushed key-value pairs.
+func LeasesFromContext(ctx context.Context) (leases map[string]time.Duration, ok bool) {
+	leases, ok = ctx.Value(leasesKey).(map[string]time.Duration)
+	return
+}
@@ -62,13 +62,15 @@ type Dispatcher interface {
 	GetStatus(key string) (*Status, error)
 	ListState() (KVPairs, error)
 	ListLabels(key string) Labels
+	GetLeaseExpiry(key string) (time.Time, bool)
 }
 
 type dispatcher struct {
-	log logging.Logger
-	kvs kvs.KVScheduler
-	mu  sync.Mutex
-	db  Store
+	log    logging.Logger
+	kvs    kvs.KVScheduler
+	mu     sync.Mutex
+	db     Store
+	leases map[leaseKey]*lease
 }
 
 // ListData retrieves actual data.
@@ -108,6 +110,11 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 		uniq[kv.Key] = kv.Val
 	}
 
+	leases, _ := contextdecorator.LeasesFromContext(ctx)
+	if err := validateLeases(leases); err != nil {
+		return nil, err
+	}
+
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
@@ -125,6 +132,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 	if typ, _ := kvs.IsResync(ctx); typ == kvs.FullResync {
 		trace.Log(ctx, "resyncType", typ.String())
 		p.db.Reset(dataSrc)
+		p.cancelLeases(dataSrc)
 		for _, kv := range kvPairs {
 			if kv.Val == nil {
 				p.log.Debugf(" - PUT: %q (skipped nil value for resync)", kv.Key)
@@ -136,6 +144,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 			for lkey, lval := range keyLabels[kv.Key] {
 				p.db.AddLabel(kv.Key, lkey, lval)
 			}
+			p.setLease(dataSrc, kv.Key, leases[kv.Key])
 		}
 		allPairs := p.db.ListAll()
 		p.log.Debugf("will resync %d pairs", len(allPairs))
@@ -151,6 +160,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 				for lkey := range keyLabels[kv.Key] {
 					p.db.DeleteLabel(kv.Key, lkey)
 				}
+				p.cancelLease(dataSrc, kv.Key)
 			} else {
 				p.log.Debugf(" - UPDATE: %q ", kv.Key)
 				txn.SetValue(kv.Key, kv.Val)
@@ -159,6 +169,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 				for lkey, lval := range keyLabels[kv.Key] {
 					p.db.AddLabel(kv.Key, lkey, lval)
 				}
+				p.setLease(dataSrc, kv.Key, leases[kv.Key])
 			}
 		}
 	}
@@ -0,0 +1,157 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+// ErrInvalidLease is returned when the lease duration is not positive.
+var ErrInvalidLease = errors.New("lease duration must be positive")
+
+// leaseKey identifies a value pushed by a data source. The same value
+// can be pushed by more data sources, each with its own lease.
+type leaseKey struct {
+	dataSrc string
+	key     string
+}
+
+// lease limits the lifetime of a value pushed by a data source.
+type lease struct {
+	ttl    time.Duration
+	expiry time.Time
+	timer  *time.Timer
+}
+
+// validateLeases checks that all leases have positive duration.
+func validateLeases(leases map[string]time.Duration) error {
+	for key, ttl := range leases {
+		if ttl <= 0 {
+			return errors.Wrapf(ErrInvalidLease, "lease of %q (%v)", key, ttl)
+		}
+	}
+	return nil
+}
+
+// GetLeaseExpiry returns the time when the value will be removed due to
+// expired lease. If the value was pushed with lease by more data sources,
+// the latest expiry is returned. The ok is false if the value is not leased.
+func (p *dispatcher) GetLeaseExpiry(key string) (expiry time.Time, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for lk, l := range p.leases {
+		if lk.key == key && l.expiry.After(expiry) {
+			expiry, ok = l.expiry, true
+		}
+	}
+	return expiry, ok
+}
+
+// setLease creates, renews or (for zero ttl, i.e. no lease) cancels the lease of the given value.
+// Must be called with dispatcher mutex locked.
+func (p *dispatcher) setLease(dataSrc, key string, ttl time.Duration) {
+	p.cancelLease(dataSrc, key)
+	if ttl <= 0 {
+		return
+	}
+	if p.leases == nil {
+		p.leases = make(map[leaseKey]*lease)
+	}
+	lk := leaseKey{dataSrc: dataSrc, key: key}
+	l := &lease{
+		ttl:    ttl,
+		expiry: time.Now().Add(ttl),
+	}
+	l.timer = time.AfterFunc(ttl, func() {
+		p.expireLease(lk, l)
+	})
+	p.leases[lk] = l
+	p.log.Debugf(" - LEASE: %q (source: %s, ttl: %v)", key, dataSrc, ttl)
+}
+
+// cancelLease cancels the lease of the value pushed by the given data source
+// (if there is any).
+// Must be called with dispatcher mutex locked.
+func (p *dispatcher) cancelLease(dataSrc, key string) {
+	lk := leaseKey{dataSrc: dataSrc, key: key}
+	if l, ok := p.leases[lk]; ok {
+		l.timer.Stop()
+		delete(p.leases, lk)
+	}
+}
+
+// cancelLeases cancels all leases of values pushed by the given data source.
+// Must be called with dispatcher mutex locked.
+func (p *dispatcher) cancelLeases(dataSrc string) {
+	for lk, l := range p.leases {
+		if lk.dataSrc == dataSrc {
+			l.timer.Stop()
+			delete(p.leases, lk)
+		}
+	}
+}
+
+// stopLeases stops all lease timers, values with leases are left as they are.
+func (p *dispatcher) stopLeases() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for lk, l := range p.leases {
+		l.timer.Stop()
+		delete(p.leases, lk)
+	}
+}
+
+// expireLease removes value with expired lease using a regular NB transaction.
+func (p *dispatcher) expireLease(lk leaseKey, l *lease) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.leases[lk] != l {
+		// lease was renewed or cancelled in the meantime
+		return
+	}
+	delete(p.leases, lk)
+
+	key := lk.key
+	p.log.Infof("Lease of %q (source: %s, ttl: %v) expired, removing the value", key, lk.dataSrc, l.ttl)
+
+	p.db.Delete(lk.dataSrc, key)
+	// the value may still be defined by another data source
+	val := p.db.ListAll()[key]
+	if val == nil {
+		p.db.ResetLabels(key)
+	}
+
+	txn := p.kvs.StartNBTransaction()
+	txn.SetValue(key, val)
+
+	ctx := kvs.WithDescription(context.Background(),
+		fmt.Sprintf("lease of %s expired (ttl: %v)", key, l.ttl))
+	ctx = kvs.WithRetryDefault(ctx)
+	seqID, err := txn.Commit(ctx)
+	if err != nil {
+		p.log.Errorf("Transaction #%d removing value %q with expired lease failed: %v", seqID, key, err)
+		return
+	}
+	p.log.Infof("Transaction #%d removed value %q with expired lease", seqID, key)
+}
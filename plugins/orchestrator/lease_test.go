@@ -0,0 +1,221 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// mockScheduler records values committed via NB transactions.
+type mockScheduler struct {
+	kvs.KVScheduler
+
+	mu     sync.Mutex
+	values map[string]proto.Message
+	seqNum uint64
+}
+
+type mockTxn struct {
+	scheduler *mockScheduler
+	values    map[string]proto.Message
+}
+
+func newMockScheduler() *mockScheduler {
+	return &mockScheduler{values: make(map[string]proto.Message)}
+}
+
+func (s *mockScheduler) StartNBTransaction() kvs.Txn {
+	return &mockTxn{scheduler: s, values: make(map[string]proto.Message)}
+}
+
+func (s *mockScheduler) TransactionBarrier() {}
+
+func (s *mockScheduler) GetValueStatus(key string) *kvscheduler.BaseValueStatus {
+	return nil
+}
+
+func (s *mockScheduler) hasValue(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.values[key]
+	return ok
+}
+
+func (t *mockTxn) SetValue(key string, value proto.Message) kvs.Txn {
+	t.values[key] = value
+	return t
+}
+
+func (t *mockTxn) Commit(ctx context.Context) (uint64, error) {
+	s := t.scheduler
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, value := range t.values {
+		if value == nil {
+			delete(s.values, key)
+		} else {
+			s.values[key] = value
+		}
+	}
+	s.seqNum++
+	return s.seqNum, nil
+}
+
+func leaseTestSetup(t *testing.T) (*dispatcher, *mockScheduler, KeyVal) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := &dispatcher{
+		log: logging.DefaultLogger,
+		db:  newMemStore(),
+		kvs: scheduler,
+	}
+	t.Cleanup(d.stopLeases)
+
+	val := &interfaces.Interface{Name: "if1", Type: interfaces.Interface_SOFTWARE_LOOPBACK}
+	return d, scheduler, KeyVal{Key: models.Key(val), Val: val}
+}
+
+func pushWithLease(d *dispatcher, kv KeyVal, lease time.Duration) error {
+	return pushFromSource(d, "test", kv, lease)
+}
+
+func pushFromSource(d *dispatcher, dataSrc string, kv KeyVal, lease time.Duration) error {
+	ctx := contextdecorator.DataSrcContext(context.Background(), dataSrc)
+	if lease != 0 {
+		ctx = contextdecorator.LeasesContext(ctx, map[string]time.Duration{kv.Key: lease})
+	}
+	_, err := d.PushData(ctx, []KeyVal{kv}, nil)
+	return err
+}
+
+func listFromSource(d *dispatcher, dataSrc string) KVPairs {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.db.List(dataSrc)
+}
+
+func TestLeaseExpiry(t *testing.T) {
+	d, scheduler, kv := leaseTestSetup(t)
+
+	Expect(pushWithLease(d, kv, 50*time.Millisecond)).To(Succeed())
+	Expect(scheduler.hasValue(kv.Key)).To(BeTrue())
+	_, leased := d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeTrue())
+
+	Eventually(func() bool { return scheduler.hasValue(kv.Key) }, time.Second).Should(BeFalse())
+	Expect(d.ListData()).ToNot(HaveKey(kv.Key))
+	_, leased = d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeFalse())
+}
+
+func TestLeaseRenewal(t *testing.T) {
+	d, scheduler, kv := leaseTestSetup(t)
+
+	Expect(pushWithLease(d, kv, 100*time.Millisecond)).To(Succeed())
+	expiry, _ := d.GetLeaseExpiry(kv.Key)
+
+	// renew the lease before it expires
+	time.Sleep(50 * time.Millisecond)
+	Expect(pushWithLease(d, kv, 200*time.Millisecond)).To(Succeed())
+	renewedExpiry, leased := d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeTrue())
+	Expect(renewedExpiry).To(BeTemporally(">", expiry))
+
+	// the original lease would have expired by now
+	Consistently(func() bool { return scheduler.hasValue(kv.Key) }, 150*time.Millisecond).Should(BeTrue())
+	Eventually(func() bool { return scheduler.hasValue(kv.Key) }, time.Second).Should(BeFalse())
+}
+
+func TestLeaseReplacedByPush(t *testing.T) {
+	d, scheduler, kv := leaseTestSetup(t)
+
+	Expect(pushWithLease(d, kv, 50*time.Millisecond)).To(Succeed())
+
+	// push without lease makes the value permanent
+	Expect(pushWithLease(d, kv, 0)).To(Succeed())
+	_, leased := d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeFalse())
+	Consistently(func() bool { return scheduler.hasValue(kv.Key) }, 150*time.Millisecond).Should(BeTrue())
+
+	// deleted value has no lease
+	Expect(pushWithLease(d, kv, time.Second)).To(Succeed())
+	Expect(pushWithLease(d, KeyVal{Key: kv.Key}, 0)).To(Succeed())
+	_, leased = d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeFalse())
+	Expect(scheduler.hasValue(kv.Key)).To(BeFalse())
+}
+
+func TestLeasePerDataSource(t *testing.T) {
+	d, scheduler, kv := leaseTestSetup(t)
+
+	Expect(pushFromSource(d, "src1", kv, 50*time.Millisecond)).To(Succeed())
+	Expect(pushFromSource(d, "src2", kv, 300*time.Millisecond)).To(Succeed())
+	expiry, leased := d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeTrue())
+
+	// lease of src1 expires, the value is still defined by src2
+	Eventually(func() KVPairs { return listFromSource(d, "src1") }, time.Second).Should(BeEmpty())
+	Expect(scheduler.hasValue(kv.Key)).To(BeTrue())
+	Expect(listFromSource(d, "src2")).To(HaveKey(kv.Key))
+	remaining, leased := d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeTrue())
+	Expect(remaining).To(Equal(expiry))
+
+	Eventually(func() bool { return scheduler.hasValue(kv.Key) }, time.Second).Should(BeFalse())
+	Expect(d.ListData()).To(BeEmpty())
+}
+
+func TestLeaseNotReplacedByOtherDataSource(t *testing.T) {
+	d, scheduler, kv := leaseTestSetup(t)
+
+	Expect(pushFromSource(d, "src1", kv, 50*time.Millisecond)).To(Succeed())
+	// push without lease by another data source keeps the lease of src1
+	Expect(pushFromSource(d, "src2", kv, 0)).To(Succeed())
+	_, leased := d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeTrue())
+
+	Eventually(func() KVPairs { return listFromSource(d, "src1") }, time.Second).Should(BeEmpty())
+	_, leased = d.GetLeaseExpiry(kv.Key)
+	Expect(leased).To(BeFalse())
+	Consistently(func() bool { return scheduler.hasValue(kv.Key) }, 100*time.Millisecond).Should(BeTrue())
+	Expect(listFromSource(d, "src2")).To(HaveKey(kv.Key))
+}
+
+func TestInvalidLease(t *testing.T) {
+	d, scheduler, kv := leaseTestSetup(t)
+
+	Expect(pushWithLease(d, kv, -time.Second)).To(MatchError(ContainSubstring(ErrInvalidLease.Error())))
+
+	ctx := contextdecorator.LeasesContext(context.Background(), map[string]time.Duration{kv.Key: 0})
+	_, err := d.PushData(ctx, []KeyVal{kv}, nil)
+	Expect(err).To(MatchError(ContainSubstring(ErrInvalidLease.Error())))
+
+	Expect(scheduler.hasValue(kv.Key)).To(BeFalse())
+	Expect(d.ListData()).To(BeEmpty())
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.ligato.io/cn-infra/v2/logging"
 	"google.golang.org/grpc/codes"
@@ -28,6 +29,7 @@ import (
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
@@ -84,6 +86,7 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 	var ops = make(map[string]generic.UpdateResult_Operation)
 	var kvPairs []KeyVal
 	var keyLabels = make(map[string]Labels)
+	var leases = make(map[string]time.Duration)
 
 	for _, update := range req.Updates {
 		item := update.Item
@@ -104,6 +107,15 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 			if err != nil {
 				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
+			if lease := update.GetLease(); lease != nil {
+				if err := lease.CheckValid(); err != nil {
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+				if lease.AsDuration() <= 0 {
+					return nil, status.Error(codes.InvalidArgument, ErrInvalidLease.Error())
+				}
+				leases[key] = lease.AsDuration()
+			}
 			ops[key] = generic.UpdateResult_UPDATE
 		} else if item.Id != nil {
 			model, err := models.GetModelForItem(item)
@@ -131,6 +143,9 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 	if req.OverwriteAll {
 		ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 	}
+	if len(leases) > 0 {
+		ctx = contextdecorator.LeasesContext(ctx, leases)
+	}
 	ctx = kvs.WithRetryDefault(ctx)
 	results, err := s.dispatch.PushData(ctx, kvPairs, keyLabels)
 	if err != nil {
@@ -207,10 +222,15 @@ func (s *genericService) GetConfig(ctx context.Context, req *generic.GetConfigRe
 				Message: msg,
 			}
 		}
+		var leaseExpiry *timestamppb.Timestamp
+		if expiry, leased := s.dispatch.GetLeaseExpiry(key); leased {
+			leaseExpiry = timestamppb.New(expiry)
+		}
 		configItems = append(configItems, &generic.ConfigItem{
-			Item:   item,
-			Status: itemStatus,
-			Labels: labels,
+			Item:        item,
+			Status:      itemStatus,
+			Labels:      labels,
+			LeaseExpiry: leaseExpiry,
 		})
 	}
 
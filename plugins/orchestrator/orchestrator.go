@@ -19,6 +19,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-errors/errors"
 	"go.ligato.io/cn-infra/v2/datasync"
@@ -150,6 +151,7 @@ func (p *Plugin) AfterInit() (err error) {
 func (p *Plugin) Close() (err error) {
 	close(p.quit)
 	p.wg.Wait()
+	p.dispatcher.stopLeases()
 	return nil
 }
 
@@ -186,6 +188,7 @@ func (p *Plugin) watchEvents() {
 			var err error
 			var kvPairs []KeyVal
 			labels := make(map[string]Labels)
+			leases := make(map[string]time.Duration)
 
 			for _, x := range e.GetChanges() {
 				kv := KeyVal{
@@ -200,6 +203,10 @@ func (p *Plugin) watchEvents() {
 				}
 				if item, ok := assertUpdateItem(x); ok {
 					labels[kv.Key] = item.GetLabels()
+					// negative lease is passed on to get rejected
+					if leased, ok := item.(leasedItem); ok && leased.GetLease() != 0 {
+						leases[kv.Key] = leased.GetLease()
+					}
 				}
 				kvPairs = append(kvPairs, kv)
 			}
@@ -220,6 +227,9 @@ func (p *Plugin) watchEvents() {
 			if !withDataSrc {
 				ctx = contextdecorator.DataSrcContext(ctx, "datasync")
 			}
+			if len(leases) > 0 {
+				ctx = contextdecorator.LeasesContext(ctx, leases)
+			}
 			ctx = kvs.WithRetryDefault(ctx)
 			_, err = p.PushData(ctx, kvPairs, labels)
 			e.Done(err)
@@ -288,6 +298,11 @@ type updateItem interface {
 	GetLabels() map[string]string
 }
 
+// leasedItem is implemented by update items with limited lifetime.
+type leasedItem interface {
+	GetLease() time.Duration
+}
+
 func assertUpdateItem(protoResp datasync.ProtoWatchResp) (updateItem, bool) {
 	if changeResp, ok := protoResp.(*syncbase.ChangeResp); ok {
 		if change, ok := changeResp.CurrVal.(*syncbase.Change); ok {
@@ -122,6 +122,20 @@ func (p *Plugin) registerACLHandlers() {
 		}
 		return p.aclHandler.DumpMACIPACL()
 	})
+	// GET ACL rule hit counters
+	p.registerHTTPHandler(resturl.ACLStats, GET, func() (interface{}, error) {
+		if p.VPPACLPlugin == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.VPPACLPlugin.GetACLStats()
+	})
+	// GET reflexive ACL sessions
+	p.registerHTTPHandler(resturl.ACLSessions, GET, func() (interface{}, error) {
+		if p.VPPACLPlugin == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.VPPACLPlugin.GetACLInterfaceSessions()
+	})
 }
 
 // Registers interface REST handlers
@@ -188,6 +202,31 @@ func (p *Plugin) registerNATHandlers() {
 	})
 }
 
+// Registers NAT64 and NAT66 REST handlers
+func (p *Plugin) registerNAT64Handlers() {
+	// GET NAT64 BIB entries
+	p.registerHTTPHandler(resturl.Nat64Bib, GET, func() (interface{}, error) {
+		if p.nat64Handler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.nat64Handler.Nat64BibDump()
+	})
+	// GET NAT64 sessions
+	p.registerHTTPHandler(resturl.Nat64Sessions, GET, func() (interface{}, error) {
+		if p.nat64Handler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.nat64Handler.Nat64SessionsDump()
+	})
+	// GET NAT66 static mappings with counters
+	p.registerHTTPHandler(resturl.Nat66Mappings, GET, func() (interface{}, error) {
+		if p.nat66Handler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.nat66Handler.Nat66StaticMappingsStatsDump()
+	})
+}
+
 // Registers L2 plugin REST handlers
 func (p *Plugin) registerL2Handlers() {
 	// GET bridge domains
@@ -282,6 +321,74 @@ func (p *Plugin) registerIPSecHandlers() {
 		}
 		return p.ipSecHandler.DumpIPSecSA()
 	})
+	// GET IPSec IKEv2 profiles
+	p.registerHTTPHandler(resturl.IKEv2Profiles, GET, func() (interface{}, error) {
+		if p.ipSecHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.ipSecHandler.DumpIKEv2Profiles()
+	})
+	// GET IPSec IKEv2 security associations
+	p.registerHTTPHandler(resturl.IKEv2SAs, GET, func() (interface{}, error) {
+		if p.ipSecHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.ipSecHandler.DumpIKEv2SAs()
+	})
+}
+
+// Registers BFD plugin REST handlers
+func (p *Plugin) registerBfdHandlers() {
+	// GET BFD sessions
+	p.registerHTTPHandler(resturl.BfdSessions, GET, func() (interface{}, error) {
+		if p.bfdHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.bfdHandler.DumpBfdSessions()
+	})
+	// GET BFD authentication keys
+	p.registerHTTPHandler(resturl.BfdAuthKeys, GET, func() (interface{}, error) {
+		if p.bfdHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.bfdHandler.DumpBfdAuthKeys()
+	})
+}
+
+// Registers cnat plugin REST handlers
+func (p *Plugin) registerCnatHandlers() {
+	// GET cnat translations
+	p.registerHTTPHandler(resturl.CnatTranslations, GET, func() (interface{}, error) {
+		if p.cnatHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.cnatHandler.DumpTranslations(context.TODO())
+	})
+	// GET cnat source NAT addresses
+	p.registerHTTPHandler(resturl.CnatSnat, GET, func() (interface{}, error) {
+		if p.cnatHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.cnatHandler.DumpSnatAddresses(context.TODO())
+	})
+	// GET cnat sessions
+	p.registerHTTPHandler(resturl.CnatSessions, GET, func() (interface{}, error) {
+		if p.cnatHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.cnatHandler.DumpSessions(context.TODO())
+	})
+}
+
+// Registers policer plugin REST handlers
+func (p *Plugin) registerPolicerHandlers() {
+	// GET policers
+	p.registerHTTPHandler(resturl.Policers, GET, func() (interface{}, error) {
+		if p.policerHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.policerHandler.DumpPolicers()
+	})
 }
 
 // Registers punt plugin REST handlers
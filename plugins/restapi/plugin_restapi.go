@@ -37,6 +37,8 @@ import (
 	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
+	bfdvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	cnatvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
@@ -45,6 +47,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
 	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
 	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	policervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
 	puntvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
 	wireguardvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
 )
@@ -72,11 +75,16 @@ type Plugin struct {
 	// VPP Handlers
 	abfHandler       abfvppcalls.ABFVppRead
 	aclHandler       aclvppcalls.ACLVppRead
+	bfdHandler       bfdvppcalls.BfdVppRead
+	cnatHandler      cnatvppcalls.CnatVppRead
 	ifHandler        ifvppcalls.InterfaceVppRead
 	natHandler       natvppcalls.NatVppRead
+	nat64Handler     natvppcalls.Nat64VppRead
+	nat66Handler     natvppcalls.Nat66VppRead
 	l2Handler        l2vppcalls.L2VppAPI
 	l3Handler        l3vppcalls.L3VppAPI
 	ipSecHandler     ipsecvppcalls.IPSecVPPRead
+	policerHandler   policervppcalls.PolicerVppRead
 	puntHandler      puntvppcalls.PuntVPPRead
 	wireguardHandler wireguardvppcalls.WgVppRead
 	// Linux handlers
@@ -165,10 +173,30 @@ func (p *Plugin) Init() (err error) {
 	if p.aclHandler == nil {
 		p.Log.Infof("ACL handler is not available, it will be skipped")
 	}
+	p.bfdHandler = bfdvppcalls.CompatibleBfdVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.bfdHandler == nil {
+		p.Log.Infof("BFD handler is not available, it will be skipped")
+	}
+	p.cnatHandler = cnatvppcalls.CompatibleCnatVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.cnatHandler == nil {
+		p.Log.Infof("Cnat handler is not available, it will be skipped")
+	}
 	p.natHandler = natvppcalls.CompatibleNatVppHandler(p.VPP, ifIndexes, dhcpIndexes, p.Log)
 	if p.natHandler == nil {
 		p.Log.Infof("NAT handler is not available, it will be skipped")
 	}
+	p.nat64Handler = natvppcalls.CompatibleNat64VppHandler(p.VPP, ifIndexes, p.Log)
+	if p.nat64Handler == nil {
+		p.Log.Infof("NAT64 handler is not available, it will be skipped")
+	}
+	p.nat66Handler = natvppcalls.CompatibleNat66VppHandler(p.VPP, ifIndexes, p.Log)
+	if p.nat66Handler == nil {
+		p.Log.Infof("NAT66 handler is not available, it will be skipped")
+	}
+	p.policerHandler = policervppcalls.CompatiblePolicerVppHandler(p.VPP, p.Log)
+	if p.policerHandler == nil {
+		p.Log.Infof("Policer handler is not available, it will be skipped")
+	}
 	p.puntHandler = puntvppcalls.CompatiblePuntVppHandler(p.VPP, ifIndexes, p.Log)
 	if p.puntHandler == nil {
 		p.Log.Infof("Punt handler is not available, it will be skipped")
@@ -209,7 +237,11 @@ func (p *Plugin) AfterInit() (err error) {
 	// plugins
 	p.registerABFHandler()
 	p.registerACLHandlers()
+	p.registerBfdHandlers()
+	p.registerCnatHandlers()
 	p.registerNATHandlers()
+	p.registerNAT64Handlers()
+	p.registerPolicerHandlers()
 	p.registerPuntHandlers()
 	// Linux handlers
 	p.registerLinuxInterfaceHandlers()
@@ -239,6 +271,22 @@ func getIndexPageItems() map[string][]indexItem {
 		"ACL plugin": {
 			{Name: "IP-type access lists", Path: resturl.ACLIP},
 			{Name: "MACIP-type access lists", Path: resturl.ACLMACIP},
+			{Name: "ACL rule hit counters", Path: resturl.ACLStats},
+			{Name: "Reflexive ACL sessions", Path: resturl.ACLSessions},
+		},
+		"BFD plugin": {
+			{Name: "BFD sessions", Path: resturl.BfdSessions},
+			{Name: "BFD authentication keys", Path: resturl.BfdAuthKeys},
+		},
+		"Cnat plugin": {
+			{Name: "Translations", Path: resturl.CnatTranslations},
+			{Name: "Source NAT addresses", Path: resturl.CnatSnat},
+			{Name: "Sessions", Path: resturl.CnatSessions},
+		},
+		"NAT64 & NAT66": {
+			{Name: "NAT64 BIB entries", Path: resturl.Nat64Bib},
+			{Name: "NAT64 sessions", Path: resturl.Nat64Sessions},
+			{Name: "NAT66 static mappings", Path: resturl.Nat66Mappings},
 		},
 		"Interface plugin": {
 			{Name: "All interfaces", Path: resturl.Interface},
@@ -249,6 +297,13 @@ func getIndexPageItems() map[string][]indexItem {
 			{Name: "VxLANs", Path: resturl.VxLan},
 			{Name: "Af-packets", Path: resturl.AfPacket},
 		},
+		"IPSec plugin": {
+			{Name: "Security policy databases", Path: resturl.SPDs},
+			{Name: "Security policies", Path: resturl.SPs},
+			{Name: "Security associations", Path: resturl.SAs},
+			{Name: "IKEv2 profiles", Path: resturl.IKEv2Profiles},
+			{Name: "IKEv2 security associations", Path: resturl.IKEv2SAs},
+		},
 		"L2 plugin": {
 			{Name: "Bridge domains", Path: resturl.Bd},
 			{Name: "L2Fibs", Path: resturl.Fib},
@@ -260,6 +315,9 @@ func getIndexPageItems() map[string][]indexItem {
 			{Name: "Proxy ARP interfaces", Path: resturl.PArpIfs},
 			{Name: "Proxy ARP ranges", Path: resturl.PArpRngs},
 		},
+		"Policer plugin": {
+			{Name: "Policers", Path: resturl.Policers},
+		},
 		"Telemetry": {
 			{Name: "All data", Path: resturl.Telemetry},
 			{Name: "Memory", Path: resturl.TMemory},
@@ -273,7 +331,7 @@ func getIndexPageItems() map[string][]indexItem {
 	return idxMap
 }
 
-// Create permission groups (tracer, telemetry, dump - optionally add more in the future). Used only if
+// Create permission groups (tracer, telemetry, trace, dump - optionally add more in the future). Used only if
 // REST security is enabled in plugin
 func getPermissionsGroups() []*access.PermissionGroup {
 	infoPg := &access.PermissionGroup{
@@ -322,6 +380,19 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission(resturl.TNodeCount, GET),
 		},
 	}
+	tracePg := &access.PermissionGroup{
+		Name: "trace",
+		Permissions: []*access.PermissionGroup_Permissions{
+			newPermission("/", GET),
+			newPermission(resturl.Trace, GET),
+			newPermission(resturl.TraceStart, POST),
+			newPermission(resturl.TraceClear, POST),
+			newPermission(resturl.Pcap, GET),
+			newPermission(resturl.PcapStart, POST),
+			newPermission(resturl.PcapStop, POST),
+			newPermission(resturl.PcapDownload, GET),
+		},
+	}
 	dumpPg := &access.PermissionGroup{
 		Name: "dump",
 		Permissions: []*access.PermissionGroup_Permissions{
@@ -329,6 +400,10 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission(resturl.ABF, GET),
 			newPermission(resturl.ACLIP, GET),
 			newPermission(resturl.ACLMACIP, GET),
+			newPermission(resturl.ACLStats, GET),
+			newPermission(resturl.ACLSessions, GET),
+			newPermission(resturl.BfdSessions, GET),
+			newPermission(resturl.BfdAuthKeys, GET),
 			newPermission(resturl.Interface, GET),
 			newPermission(resturl.Loopback, GET),
 			newPermission(resturl.Ethernet, GET),
@@ -336,6 +411,11 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission(resturl.Tap, GET),
 			newPermission(resturl.VxLan, GET),
 			newPermission(resturl.AfPacket, GET),
+			newPermission(resturl.SPDs, GET),
+			newPermission(resturl.SPs, GET),
+			newPermission(resturl.SAs, GET),
+			newPermission(resturl.IKEv2Profiles, GET),
+			newPermission(resturl.IKEv2SAs, GET),
 			newPermission(resturl.Bd, GET),
 			newPermission(resturl.Fib, GET),
 			newPermission(resturl.Xc, GET),
@@ -343,10 +423,17 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission(resturl.Routes, GET),
 			newPermission(resturl.PArpIfs, GET),
 			newPermission(resturl.PArpRngs, GET),
+			newPermission(resturl.Policers, GET),
+			newPermission(resturl.CnatTranslations, GET),
+			newPermission(resturl.CnatSnat, GET),
+			newPermission(resturl.CnatSessions, GET),
+			newPermission(resturl.Nat64Bib, GET),
+			newPermission(resturl.Nat64Sessions, GET),
+			newPermission(resturl.Nat66Mappings, GET),
 		},
 	}
 
-	return []*access.PermissionGroup{infoPg, tracerPg, telemetryPg, dumpPg,
+	return []*access.PermissionGroup{infoPg, tracerPg, telemetryPg, tracePg, dumpPg,
 		nbConfigValidationPg, nbConfigReadPg, nbConfigWritePg}
 }
 
@@ -61,6 +61,10 @@ const (
 	ACLIP = "/dump/vpp/v2/acl/ip"
 	// REST ACL MACIP prefix
 	ACLMACIP = "/dump/vpp/v2/acl/macip"
+	// REST ACL rule hit counters
+	ACLStats = "/dump/vpp/v2/acl/stats"
+	// REST ACL reflexive sessions per interface
+	ACLSessions = "/dump/vpp/v2/acl/sessions"
 )
 
 // VPP Interfaces
@@ -94,6 +98,16 @@ const (
 	NatAddressPools = "/dump/vpp/v2/nat/pools"
 )
 
+// VPP NAT64 & NAT66
+const (
+	// Nat64Bib is a REST path of NAT64 BIB entries (both static and dynamic)
+	Nat64Bib = "/dump/vpp/v2/nat64/bib"
+	// Nat64Sessions is a REST path of NAT64 sessions
+	Nat64Sessions = "/dump/vpp/v2/nat64/sessions"
+	// Nat66Mappings is a REST path of NAT66 static mappings with counters
+	Nat66Mappings = "/dump/vpp/v2/nat66/mappings"
+)
+
 // L2 plugin
 const (
 	// restBd is rest bridge domain path
@@ -128,6 +142,34 @@ const (
 	SPs = "/dump/vpp/v2/ipsec/sps"
 	// SAs is rest IPSec security association path
 	SAs = "/dump/vpp/v2/ipsec/sas"
+	// IKEv2Profiles is rest IPSec IKEv2 profile path
+	IKEv2Profiles = "/dump/vpp/v2/ipsec/ikev2/profiles"
+	// IKEv2SAs is rest path of IKE security associations negotiated by VPP
+	IKEv2SAs = "/dump/vpp/v2/ipsec/ikev2/sas"
+)
+
+// VPP BFD plugin
+const (
+	// BfdSessions is rest BFD session path
+	BfdSessions = "/dump/vpp/v2/bfd/sessions"
+	// BfdAuthKeys is rest BFD authentication key path
+	BfdAuthKeys = "/dump/vpp/v2/bfd/authkeys"
+)
+
+// VPP Cnat plugin
+const (
+	// CnatTranslations is rest cnat translation path
+	CnatTranslations = "/dump/vpp/v2/cnat/translations"
+	// CnatSnat is rest path of cnat source NAT addresses
+	CnatSnat = "/dump/vpp/v2/cnat/snat"
+	// CnatSessions is rest cnat session path
+	CnatSessions = "/dump/vpp/v2/cnat/sessions"
+)
+
+// VPP Policer plugin
+const (
+	// Policers is rest policer path
+	Policers = "/dump/vpp/v2/policers"
 )
 
 const (
@@ -149,6 +191,24 @@ const (
 	TNodeCount = "/vpp/telemetry/nodecount"
 )
 
+// Packet trace
+const (
+	// Trace returns packets captured by the VPP packet tracer
+	Trace = "/vpp/trace"
+	// TraceStart starts the VPP packet tracer
+	TraceStart = "/vpp/trace/start"
+	// TraceClear removes packets captured by the VPP packet tracer
+	TraceClear = "/vpp/trace/clear"
+	// Pcap returns status of the pcap capture
+	Pcap = "/vpp/pcap"
+	// PcapStart starts the pcap capture
+	PcapStart = "/vpp/pcap/start"
+	// PcapStop stops the pcap capture
+	PcapStop = "/vpp/pcap/stop"
+	// PcapDownload returns content of the pcap file
+	PcapDownload = "/vpp/pcap/download"
+)
+
 // Stats
 const (
 	// Configurator stats
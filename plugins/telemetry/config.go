@@ -32,7 +32,7 @@ type Config struct {
 	// Allows to export prometheus in telemetry plugin
 	PrometheusDisabled bool `json:"prometheus-disabled"`
 	// Skip collecting some of the metrics:
-	// 	runtime, memory, buffers, nodes, interfaces
+	// 	runtime, memory, buffers, nodes, interfaces, acl
 	Skipped []string `json:"skipped"`
 }
 
@@ -7,6 +7,7 @@ import (
 	"go.ligato.io/cn-infra/v2/servicelabel"
 
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 )
 
@@ -24,6 +25,7 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.GRPC = &grpc.DefaultPlugin
 	p.HTTPHandlers = &rest.DefaultPlugin
 	p.IfPlugin = &ifplugin.DefaultPlugin
+	p.ACLPlugin = &aclplugin.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
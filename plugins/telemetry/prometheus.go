@@ -112,6 +112,22 @@ const (
 	ifCounterRxMiss    = "rx_miss"
 )
 
+// ACL metrics
+const (
+	aclMetricsNamespace = "acl"
+
+	aclNameLabel      = "acl"
+	aclIndexLabel     = "index"
+	aclRuleLabel      = "rule"
+	aclInterfaceLabel = "interface"
+
+	aclRulePacketsMetric     = "rule_packets"
+	aclRuleBytesMetric       = "rule_bytes"
+	aclSessionsMetric        = "sessions"
+	aclSessionsAddedMetric   = "sessions_added"
+	aclSessionsDeletedMetric = "sessions_deleted"
+)
+
 type prometheusMetrics struct {
 	runtimeGaugeVecs map[string]*prometheus.GaugeVec
 	runtimeStats     map[string]*runtimeStats
@@ -127,6 +143,12 @@ type prometheusMetrics struct {
 
 	ifCounterGaugeVecs map[string]*prometheus.GaugeVec
 	ifCounterStats     map[string]*ifCounterStats
+
+	aclRuleGaugeVecs map[string]*prometheus.GaugeVec
+	aclRuleStats     map[string]*aclStats
+
+	aclSessionGaugeVecs map[string]*prometheus.GaugeVec
+	aclSessionStats     map[string]*aclStats
 }
 
 type runtimeStats struct {
@@ -159,6 +181,12 @@ type ifCounterStats struct {
 	metrics map[string]prometheus.Gauge
 }
 
+type aclStats struct {
+	labels  prometheus.Labels
+	metrics map[string]prometheus.Gauge
+	updated bool
+}
+
 func (p *Plugin) registerPrometheus() error {
 	p.Log.Debugf("registering prometheus registry path: %v", registryPath)
 
@@ -329,6 +357,56 @@ func (p *Plugin) registerPrometheus() error {
 		}
 	}
 
+	// ACL metrics
+	p.aclRuleGaugeVecs = make(map[string]*prometheus.GaugeVec)
+	p.aclRuleStats = make(map[string]*aclStats)
+
+	for _, metric := range [][2]string{
+		{aclRulePacketsMetric, "Packets matched by ACL rule"},
+		{aclRuleBytesMetric, "Bytes matched by ACL rule"},
+	} {
+		name := metric[0]
+		p.aclRuleGaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: vppMetricsNamespace,
+			Subsystem: aclMetricsNamespace,
+			Name:      name,
+			Help:      metric[1],
+			ConstLabels: prometheus.Labels{
+				agentLabel: p.ServiceLabel.GetAgentLabel(),
+			},
+		}, []string{aclNameLabel, aclIndexLabel, aclRuleLabel})
+	}
+
+	p.aclSessionGaugeVecs = make(map[string]*prometheus.GaugeVec)
+	p.aclSessionStats = make(map[string]*aclStats)
+
+	for _, metric := range [][2]string{
+		{aclSessionsMetric, "Active reflexive ACL sessions"},
+		{aclSessionsAddedMetric, "Added reflexive ACL sessions"},
+		{aclSessionsDeletedMetric, "Deleted reflexive ACL sessions"},
+	} {
+		name := metric[0]
+		p.aclSessionGaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: vppMetricsNamespace,
+			Subsystem: aclMetricsNamespace,
+			Name:      name,
+			Help:      metric[1],
+			ConstLabels: prometheus.Labels{
+				agentLabel: p.ServiceLabel.GetAgentLabel(),
+			},
+		}, []string{aclInterfaceLabel})
+	}
+
+	// register created vectors to prometheus
+	for _, vecs := range []map[string]*prometheus.GaugeVec{p.aclRuleGaugeVecs, p.aclSessionGaugeVecs} {
+		for name, metric := range vecs {
+			if err := p.Prometheus.Register(registryPath, metric); err != nil {
+				p.Log.Errorf("failed to register %v metric: %v", name, err)
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -492,6 +570,11 @@ func (p *Plugin) updatePrometheus(ctx context.Context) {
 		}
 	}
 
+	if !p.skipped[aclMetricsNamespace] && p.ACLPlugin != nil {
+		// Update ACL counters
+		p.updateACLPrometheus()
+	}
+
 	if !p.skipped[ifMetricsNamespace] {
 		// Update interface counters
 		ifStats, err := p.handler.GetInterfaceStats(ctx)
@@ -542,3 +625,80 @@ func (p *Plugin) updatePrometheus(ctx context.Context) {
 
 	p.tracef("update complete")
 }
+
+func (p *Plugin) updateACLPrometheus() {
+	aclRules, err := p.ACLPlugin.GetACLStats()
+	if err != nil {
+		p.Log.Errorf("GetACLStats failed: %v", err)
+	} else {
+		p.tracef("ACL stats: %+v", aclRules)
+		for _, acl := range aclRules {
+			for _, rule := range acl.Rules {
+				stats := p.getACLStats(p.aclRuleStats, p.aclRuleGaugeVecs, prometheus.Labels{
+					aclNameLabel:  acl.AclName,
+					aclIndexLabel: fmt.Sprint(acl.AclIndex),
+					aclRuleLabel:  fmt.Sprint(rule.RuleIndex),
+				})
+				stats.metrics[aclRulePacketsMetric].Set(float64(rule.Packets))
+				stats.metrics[aclRuleBytesMetric].Set(float64(rule.Bytes))
+			}
+		}
+		removeStaleACLStats(p.aclRuleStats, p.aclRuleGaugeVecs)
+	}
+
+	aclSessions, err := p.ACLPlugin.GetACLInterfaceSessions()
+	if err != nil {
+		p.Log.Errorf("GetACLInterfaceSessions failed: %v", err)
+	} else {
+		p.tracef("ACL sessions: %+v", aclSessions)
+		for _, item := range aclSessions {
+			stats := p.getACLStats(p.aclSessionStats, p.aclSessionGaugeVecs, prometheus.Labels{
+				aclInterfaceLabel: item.Interface,
+			})
+			stats.metrics[aclSessionsMetric].Set(float64(item.Sessions))
+			stats.metrics[aclSessionsAddedMetric].Set(float64(item.Added))
+			stats.metrics[aclSessionsDeletedMetric].Set(float64(item.Deleted))
+		}
+		removeStaleACLStats(p.aclSessionStats, p.aclSessionGaugeVecs)
+	}
+}
+
+// getACLStats returns gauges with the given labels and marks them as updated.
+func (p *Plugin) getACLStats(statsMap map[string]*aclStats, vecs map[string]*prometheus.GaugeVec,
+	labels prometheus.Labels) *aclStats {
+	key := fmt.Sprint(labels)
+	stats, ok := statsMap[key]
+	if !ok {
+		stats = &aclStats{
+			labels:  labels,
+			metrics: map[string]prometheus.Gauge{},
+		}
+		statsMap[key] = stats
+
+		// add gauges with corresponding labels into vectors
+		for k, vec := range vecs {
+			var err error
+			stats.metrics[k], err = vec.GetMetricWith(labels)
+			if err != nil {
+				p.Log.Error(err)
+			}
+		}
+	}
+	stats.updated = true
+	return stats
+}
+
+// removeStaleACLStats removes gauges of ACLs (rules, interfaces) which
+// were not updated since the last call.
+func removeStaleACLStats(statsMap map[string]*aclStats, vecs map[string]*prometheus.GaugeVec) {
+	for key, stats := range statsMap {
+		if stats.updated {
+			stats.updated = false
+			continue
+		}
+		for _, vec := range vecs {
+			vec.Delete(stats.labels)
+		}
+		delete(statsMap, key)
+	}
+}
@@ -21,8 +21,9 @@ import (
 type statsPollerServer struct {
 	configurator.UnimplementedStatsPollerServiceServer
 
-	handler vppcalls.TelemetryVppAPI
-	ifIndex ifaceidx.IfaceMetadataIndex
+	handler  vppcalls.TelemetryVppAPI
+	ifIndex  ifaceidx.IfaceMetadataIndex
+	aclStats ACLStatsProvider
 
 	log logging.Logger
 }
@@ -141,6 +142,42 @@ func (s *statsPollerServer) streamVppStats(ctx context.Context, ch chan *vpp.Sta
 			return ctx.Err()
 		}
 	}
+
+	if s.aclStats == nil {
+		return nil
+	}
+	return s.streamACLStats(ctx, ch)
+}
+
+// streamACLStats streams ACL counters and sessions (if enabled in the ACL plugin).
+// Failures are only logged to keep streaming of the interface stats unaffected.
+func (s *statsPollerServer) streamACLStats(ctx context.Context, ch chan *vpp.Stats) error {
+	aclStats, err := s.aclStats.GetACLStats()
+	if err != nil {
+		s.log.Warnf("getting ACL stats failed: %v", err)
+	}
+	aclSessions, err := s.aclStats.GetACLInterfaceSessions()
+	if err != nil {
+		s.log.Warnf("getting ACL sessions failed: %v", err)
+	}
+
+	s.log.Debugf("streaming %d ACL stats and %d ACL session stats", len(aclStats), len(aclSessions))
+
+	var stats []*vpp.Stats
+	for _, acl := range aclStats {
+		stats = append(stats, &vpp.Stats{Acl: acl})
+	}
+	for _, sessions := range aclSessions {
+		stats = append(stats, &vpp.Stats{AclSessions: sessions})
+	}
+	for _, vppStats := range stats {
+		select {
+		case ch <- vppStats:
+			// stats sent
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	return nil
 }
 
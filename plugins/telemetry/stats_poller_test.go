@@ -0,0 +1,107 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+)
+
+// warnLogger records logged warnings.
+type warnLogger struct {
+	logging.Logger
+	warnings []string
+}
+
+func (l *warnLogger) Warnf(format string, args ...interface{}) {
+	l.warnings = append(l.warnings, fmt.Sprintf(format, args...))
+}
+
+type mockACLStats struct {
+	stats       []*vpp_acl.ACLStats
+	statsErr    error
+	sessions    []*vpp_acl.ACLInterfaceSessions
+	sessionsErr error
+}
+
+func (m *mockACLStats) GetACLStats() ([]*vpp_acl.ACLStats, error) {
+	return m.stats, m.statsErr
+}
+
+func (m *mockACLStats) GetACLInterfaceSessions() ([]*vpp_acl.ACLInterfaceSessions, error) {
+	return m.sessions, m.sessionsErr
+}
+
+func TestStreamACLStats(t *testing.T) {
+	sessions := []*vpp_acl.ACLInterfaceSessions{
+		{Interface: "if1", Sessions: 2},
+	}
+	tests := []struct {
+		name        string
+		aclStats    *mockACLStats
+		expStats    int
+		expWarnings int
+	}{
+		{name: "counters failed",
+			aclStats: &mockACLStats{
+				statsErr: errors.New("stats segment unavailable"),
+				sessions: sessions,
+			},
+			expStats:    1,
+			expWarnings: 1,
+		},
+		{name: "sessions failed",
+			aclStats: &mockACLStats{
+				stats:       []*vpp_acl.ACLStats{{AclName: "acl1"}},
+				sessionsErr: errors.New("dump failed"),
+			},
+			expStats:    1,
+			expWarnings: 1,
+		},
+		{name: "all failed",
+			aclStats: &mockACLStats{
+				statsErr:    errors.New("stats segment unavailable"),
+				sessionsErr: errors.New("dump failed"),
+			},
+			expStats:    0,
+			expWarnings: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			log := &warnLogger{Logger: logging.DefaultLogger}
+			s := &statsPollerServer{
+				aclStats: test.aclStats,
+				log:      log,
+			}
+			ch := make(chan *vpp.Stats, 10)
+
+			err := s.streamACLStats(context.Background(), ch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ch).To(HaveLen(test.expStats))
+			Expect(log.warnings).To(HaveLen(test.expWarnings))
+		})
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls/vpp2202"
 	_ "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls/vpp2210"
@@ -71,6 +72,14 @@ type InterfaceIndexProvider interface {
 	GetInterfaceIndex() ifaceidx.IfaceMetadataIndex
 }
 
+type ACLStatsProvider interface {
+	// GetACLStats returns hit counters of ACL rules.
+	GetACLStats() ([]*vpp_acl.ACLStats, error)
+	// GetACLInterfaceSessions returns the number of reflexive ACL sessions
+	// for each interface.
+	GetACLInterfaceSessions() ([]*vpp_acl.ACLInterfaceSessions, error)
+}
+
 // Deps represents dependencies of Telemetry Plugin
 type Deps struct {
 	infra.PluginDeps
@@ -80,6 +89,7 @@ type Deps struct {
 	GRPC         grpc.Server
 	HTTPHandlers rest.HTTPHandlers
 	IfPlugin     InterfaceIndexProvider
+	ACLPlugin    ACLStatsProvider // optional
 }
 
 // Init initializes Telemetry Plugin
@@ -163,6 +173,7 @@ func (p *Plugin) setupStatsPoller() error {
 		p.statsPollerServer.handler = h
 	}
 	p.statsPollerServer.ifIndex = p.IfPlugin.GetInterfaceIndex()
+	p.statsPollerServer.aclStats = p.ACLPlugin
 
 	if p.GRPC != nil && p.GRPC.GetServer() != nil {
 		configurator.RegisterStatsPollerServiceServer(p.GRPC.GetServer(), &p.statsPollerServer)
@@ -32,6 +32,9 @@ type ACLMetadataIndex interface {
 	// LookupName looks up previously stored item identified by name in mapping.
 	LookupByIndex(idx uint32) (name string, metadata *ACLMetadata, exists bool)
 
+	// ListAllACLs returns slice of names of all ACLs in the mapping.
+	ListAllACLs() (names []string)
+
 	// WatchAcls
 	WatchAcls(subscriber string, channel chan<- ACLMetadataDto)
 }
@@ -101,6 +104,11 @@ func (aclIdx *aclMetadataIndex) LookupByIndex(idx uint32) (name string, metadata
 	return
 }
 
+// ListAllACLs returns slice of names of all ACLs in the mapping.
+func (aclIdx *aclMetadataIndex) ListAllACLs() (names []string) {
+	return aclIdx.ListAllNames()
+}
+
 // WatchAcls ...
 func (aclIdx *aclMetadataIndex) WatchAcls(subscriber string, channel chan<- ACLMetadataDto) {
 	watcher := func(dto idxmap.NamedMappingGenericEvent) {
@@ -44,6 +44,8 @@ type ACLPlugin struct {
 
 	// index maps
 	aclIndex aclidx.ACLMetadataIndex
+
+	config *Config
 }
 
 // Deps represents dependencies for the plugin.
@@ -62,11 +64,21 @@ func (p *ACLPlugin) Init() (err error) {
 		return nil
 	}
 
+	p.config, err = p.loadConfig()
+	if err != nil {
+		return errors.WithMessage(err, "loading config failed")
+	}
+
 	// init handlers
 	p.aclHandler = vppcalls.CompatibleACLHandler(p.VPP, p.IfPlugin.GetInterfaceIndex())
 	if p.aclHandler == nil {
 		return errors.New("aclHandler is not available")
 	}
+	if p.config.EnableStats {
+		if err = p.aclHandler.EnableACLStats(true); err != nil {
+			return errors.WithMessage(err, "enabling ACL stats failed")
+		}
+	}
 
 	// init & register descriptors
 	p.aclDescriptor = descriptor.NewACLDescriptor(p.aclHandler, p.IfPlugin, p.Log)
@@ -16,6 +16,7 @@ package aclplugin
 
 import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/aclidx"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 )
 
 // API defines methods exposed by VPP-ACLPlugin.
@@ -23,4 +24,13 @@ type API interface {
 	// GetInterfaceIndex gives read-only access to map with metadata of all configured
 	// VPP access lists.
 	GetACLIndex() aclidx.ACLMetadataIndex
+
+	// GetACLStats returns hit counters of ACL rules. The counters are
+	// available only if enabled in the plugin config (enable-stats).
+	GetACLStats() ([]*vpp_acl.ACLStats, error)
+
+	// GetACLInterfaceSessions returns the number of reflexive ACL sessions
+	// for each interface. Like the counters, the sessions are available
+	// only if enabled in the plugin config (enable-stats).
+	GetACLInterfaceSessions() ([]*vpp_acl.ACLInterfaceSessions, error)
 }
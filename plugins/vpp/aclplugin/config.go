@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aclplugin
+
+// Config file representation for ACL plugin
+type Config struct {
+	// EnableStats enables counting of ACL rule hits in VPP. The counters
+	// are read from the VPP stats segment.
+	EnableStats bool `json:"enable-stats"`
+}
+
+var DefaultConfig = func() *Config {
+	return &Config{
+		EnableStats: false,
+	}
+}
+
+// loadConfig returns ACL plugin file configuration if exists
+func (p *ACLPlugin) loadConfig() (*Config, error) {
+	cfg := &Config{}
+	if DefaultConfig != nil {
+		cfg = DefaultConfig()
+	}
+
+	found, err := p.Cfg.LoadValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		p.Log.Debugf("ACL config not found. Using default config: %+v", cfg)
+	} else {
+		p.Log.Debugf("ACL config found: %+v", cfg)
+	}
+
+	return cfg, nil
+}
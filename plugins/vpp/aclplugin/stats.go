@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aclplugin
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	"go.fd.io/govpp/adapter"
+
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+)
+
+// aclStatsPattern selects combined counters with rule hits of every ACL.
+// Each counter is indexed by rule.
+const aclStatsPattern = `^/acl/[0-9]+/matches$`
+
+var aclStatsNameRe = regexp.MustCompile(`^/acl/([0-9]+)/matches$`)
+
+// GetACLStats returns hit counters of the configured ACLs.
+func (p *ACLPlugin) GetACLStats() ([]*vpp_acl.ACLStats, error) {
+	if p.aclIndex == nil || p.config == nil || !p.config.EnableStats {
+		return nil, nil
+	}
+	entries, err := p.VPP.DumpStats(aclStatsPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// MACIP ACLs use a separate index space and have no counters
+	aclNames := make(map[uint32]string)
+	for _, name := range p.aclIndex.ListAllACLs() {
+		if meta, found := p.aclIndex.LookupByName(name); found && !meta.L2 {
+			aclNames[meta.Index] = name
+		}
+	}
+
+	var stats []*vpp_acl.ACLStats
+	for _, entry := range entries {
+		match := aclStatsNameRe.FindStringSubmatch(string(entry.Name))
+		if match == nil {
+			continue
+		}
+		aclIdx, err := strconv.ParseUint(match[1], 10, 32)
+		if err != nil {
+			continue
+		}
+		name, found := aclNames[uint32(aclIdx)]
+		if !found {
+			continue
+		}
+		counters, ok := entry.Data.(adapter.CombinedCounterStat)
+		if !ok {
+			continue
+		}
+		stats = append(stats, &vpp_acl.ACLStats{
+			AclName:  name,
+			AclIndex: uint32(aclIdx),
+			Rules:    sumRuleCounters(counters),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].AclName < stats[j].AclName
+	})
+	return stats, nil
+}
+
+// GetACLInterfaceSessions returns the number of reflexive ACL sessions
+// for each interface. The sessions are scraped from the VPP CLI only
+// if the stats are enabled in the plugin config.
+func (p *ACLPlugin) GetACLInterfaceSessions() ([]*vpp_acl.ACLInterfaceSessions, error) {
+	if p.aclHandler == nil || p.config == nil || !p.config.EnableStats {
+		return nil, nil
+	}
+	return p.aclHandler.DumpACLInterfaceSessions()
+}
+
+// sumRuleCounters sums up rule counters of all workers.
+func sumRuleCounters(counters adapter.CombinedCounterStat) []*vpp_acl.ACLStats_RuleStats {
+	var rules []*vpp_acl.ACLStats_RuleStats
+	for _, perWorker := range counters {
+		for ruleIdx, counter := range perWorker {
+			for len(rules) <= ruleIdx {
+				rules = append(rules, &vpp_acl.ACLStats_RuleStats{
+					RuleIndex: uint32(len(rules)),
+				})
+			}
+			rules[ruleIdx].Packets += counter.Packets()
+			rules[ruleIdx].Bytes += counter.Bytes()
+		}
+	}
+	return rules
+}
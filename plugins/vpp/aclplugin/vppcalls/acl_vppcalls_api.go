@@ -90,6 +90,8 @@ type ACLVppAPI interface {
 	AddMACIPACLToInterface(aclIndex uint32, ifName string) error
 	// DeleteMACIPACLFromInterface deletes MACIP ACL (L2) from single interface.
 	DeleteMACIPACLFromInterface(aclIndex uint32, ifName string) error
+	// EnableACLStats enables or disables ACL rule hit counters in the stats segment.
+	EnableACLStats(enable bool) error
 }
 
 // ACLVppRead provides read methods for ACL plugin
@@ -106,6 +108,8 @@ type ACLVppRead interface {
 	DumpInterfaceACLs(ifIdx uint32) ([]*acl.ACL, error)
 	// DumpInterfaceMACIPACLs finds interface in VPP and returns its MACIP ACL (L2) configuration.
 	DumpInterfaceMACIPACLs(ifIdx uint32) ([]*acl.ACL, error)
+	// DumpACLInterfaceSessions returns the number of reflexive ACL sessions for each interface.
+	DumpACLInterfaceSessions() ([]*acl.ACLInterfaceSessions, error)
 }
 
 var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	vpp_acl "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/acl"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vlib"
+	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+)
+
+// showSessionsCmd prints reflexive session counters of every VPP thread.
+const showSessionsCmd = "show acl-plugin sessions"
+
+// sessionCountersRe matches per-interface session counters printed by showSessionsCmd
+// (e.g. "sw_if_index 1: add 10 - del 4 = 6").
+var sessionCountersRe = regexp.MustCompile(`sw_if_index (\d+): add (\d+) - del (\d+) = (-?\d+)`)
+
+// EnableACLStats implements ACL handler.
+func (h *ACLVppHandler) EnableACLStats(enable bool) error {
+	req := &vpp_acl.ACLStatsIntfCountersEnable{
+		Enable: enable,
+	}
+	reply := &vpp_acl.ACLStatsIntfCountersEnableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set ACL stats (enable: %t): %v", enable, err)
+	}
+	return nil
+}
+
+// DumpACLInterfaceSessions implements ACL handler.
+func (h *ACLVppHandler) DumpACLInterfaceSessions() ([]*acl.ACLInterfaceSessions, error) {
+	req := &vlib.CliInband{
+		Cmd: showSessionsCmd,
+	}
+	reply := &vlib.CliInbandReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("failed to dump ACL sessions: %v", err)
+	}
+	counters := parseSessionCounters(reply.Reply)
+
+	var sessions []*acl.ACLInterfaceSessions
+	for swIfIdx, c := range counters {
+		name, _, found := h.ifIndexes.LookupBySwIfIndex(swIfIdx)
+		if !found {
+			continue
+		}
+		c.Interface = name
+		if c.Added > c.Deleted {
+			c.Sessions = c.Added - c.Deleted
+		}
+		sessions = append(sessions, c)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Interface < sessions[j].Interface
+	})
+	return sessions, nil
+}
+
+// parseSessionCounters sums up session counters of all threads for each interface.
+func parseSessionCounters(output string) map[uint32]*acl.ACLInterfaceSessions {
+	counters := make(map[uint32]*acl.ACLInterfaceSessions)
+	for _, match := range sessionCountersRe.FindAllStringSubmatch(output, -1) {
+		swIfIdx, err := strconv.ParseUint(match[1], 10, 32)
+		if err != nil {
+			continue
+		}
+		added, _ := strconv.ParseUint(match[2], 10, 64)
+		deleted, _ := strconv.ParseUint(match[3], 10, 64)
+
+		c, ok := counters[uint32(swIfIdx)]
+		if !ok {
+			c = &acl.ACLInterfaceSessions{}
+			counters[uint32(swIfIdx)] = c
+		}
+		c.Added += added
+		c.Deleted += deleted
+	}
+	return counters
+}
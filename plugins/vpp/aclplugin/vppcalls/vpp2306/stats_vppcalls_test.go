@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_acl "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/acl"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+)
+
+func TestEnableACLStats(t *testing.T) {
+	ctx := setupACLTest(t)
+	defer ctx.teardownACLTest()
+
+	ctx.MockVpp.MockReply(&vpp_acl.ACLStatsIntfCountersEnableReply{})
+	err := ctx.aclHandler.EnableACLStats(true)
+	Expect(err).To(BeNil())
+	msg, ok := ctx.MockChannel.Msg.(*vpp_acl.ACLStatsIntfCountersEnable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_acl.ACLStatsIntfCountersEnableReply{Retval: -1})
+	err = ctx.aclHandler.EnableACLStats(false)
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDumpACLInterfaceSessions(t *testing.T) {
+	ctx := setupACLTest(t)
+	defer ctx.teardownACLTest()
+
+	ctx.ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ctx.ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{
+		Reply: `Sessions total: add 17 - del 5 = 12
+
+Per-thread data:
+Thread #0:
+  connection add/del stats:
+    sw_if_index 1: add 10 - del 4 = 6
+    sw_if_index 2: add 2 - del 0 = 2
+Thread #1:
+  connection add/del stats:
+    sw_if_index 1: add 4 - del 1 = 3
+    sw_if_index 5: add 1 - del 0 = 1
+`,
+	})
+	sessions, err := ctx.aclHandler.DumpACLInterfaceSessions()
+	Expect(err).To(BeNil())
+	Expect(sessions).To(HaveLen(2))
+	Expect(sessions[0].Interface).To(Equal("if1"))
+	Expect(sessions[0].Added).To(BeEquivalentTo(14))
+	Expect(sessions[0].Deleted).To(BeEquivalentTo(5))
+	Expect(sessions[0].Sessions).To(BeEquivalentTo(9))
+	Expect(sessions[1].Interface).To(Equal("if2"))
+	Expect(sessions[1].Sessions).To(BeEquivalentTo(2))
+	msg, ok := ctx.MockChannel.Msg.(*vlib.CliInband)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Cmd).To(Equal("show acl-plugin sessions"))
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name BfdSession --value-type *vpp_bfd.BfdSession --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name BfdAuthKey --value-type *vpp_bfd.BfdAuthKey --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd" --output-dir "descriptor"
+
+package bfdplugin
+
+import (
+	"context"
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2306"
+)
+
+// BfdPlugin is a plugin that manages VPP BFD sessions and publishes their
+// state changes.
+type BfdPlugin struct {
+	Deps
+
+	bfdHandler vppcalls.BfdVppAPI
+
+	// descriptors
+	sessionStateDescriptor *descriptor.BfdSessionStateDescriptor
+
+	// state updater
+	stateUpdater *sessionStateUpdater
+
+	// notification callback, set by SetNotifyService
+	notifyMu         sync.Mutex
+	pushNotification func(notification *vpp.Notification)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes BFD plugin.
+func (p *BfdPlugin) Init() (err error) {
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
+	// init handler
+	p.bfdHandler = vppcalls.CompatibleBfdVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.bfdHandler == nil {
+		p.Log.Warnf("BFD handler is not available for this VPP version, BFD is disabled")
+		return nil
+	}
+
+	// init & register descriptors
+	var sessionStateDescriptor *kvs.KVDescriptor
+	sessionStateDescriptor, p.sessionStateDescriptor = descriptor.NewBfdSessionStateDescriptor(
+		p.KVScheduler, p.bfdHandler, p.Log)
+	p.stateUpdater = newSessionStateUpdater(p.KVScheduler, p.bfdHandler, p.IfPlugin.GetInterfaceIndex(),
+		p.sessionStateDescriptor, p.publishSessionState, p.Log)
+
+	authKeyDescriptor := descriptor.NewBfdAuthKeyDescriptor(p.bfdHandler, p.Log)
+	sessionDescriptor := descriptor.NewBfdSessionDescriptor(p.bfdHandler, p.IfPlugin.GetInterfaceIndex(),
+		p.stateUpdater.removeSession, p.Log)
+
+	if err = p.KVScheduler.RegisterKVDescriptor(authKeyDescriptor); err != nil {
+		return err
+	}
+	if err = p.KVScheduler.RegisterKVDescriptor(sessionDescriptor); err != nil {
+		return err
+	}
+	if err = p.KVScheduler.RegisterKVDescriptor(sessionStateDescriptor); err != nil {
+		return err
+	}
+
+	registerMetrics()
+	p.stateUpdater.start(p.ctx)
+	return nil
+}
+
+// AfterInit subscribes for BFD session events and registers plugin with StatusCheck.
+func (p *BfdPlugin) AfterInit() error {
+	if p.stateUpdater != nil {
+		if err := p.stateUpdater.subscribeVPPEvents(p.ctx); err != nil {
+			return err
+		}
+		p.VPP.OnReconnect(func() {
+			if err := p.stateUpdater.subscribeVPPEvents(p.ctx); err != nil {
+				p.Log.Warnf("WatchBfdSessionEvents failed: %v", err)
+			}
+		})
+	}
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// Close stops watching BFD session events.
+func (p *BfdPlugin) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	if p.stateUpdater != nil {
+		p.stateUpdater.wait()
+	}
+	return nil
+}
+
+// SetNotifyService sets notification callback for processing VPP notifications.
+func (p *BfdPlugin) SetNotifyService(notify func(notification *vpp.Notification)) {
+	p.notifyMu.Lock()
+	defer p.notifyMu.Unlock()
+	p.pushNotification = notify
+}
+
+// publishSessionState sends notification about BFD session state change.
+func (p *BfdPlugin) publishSessionState(notification *vpp_bfd.BfdSessionNotification) {
+	p.notifyMu.Lock()
+	defer p.notifyMu.Unlock()
+	if p.pushNotification != nil {
+		p.pushNotification(&vpp.Notification{
+			BfdSession: notification,
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+const (
+	// BfdAuthKeyDescriptorName is the name of the descriptor for VPP BFD
+	// authentication keys.
+	BfdAuthKeyDescriptorName = "vpp-bfd-auth-key"
+
+	// maximum length of the BFD authentication key supported by VPP
+	maxBfdSecretLen = 20
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrBfdAuthKeyInvalidSecret is returned when BFD authentication key secret
+	// is empty or too long.
+	ErrBfdAuthKeyInvalidSecret = errors.New("VPP BFD authentication key secret must have 1 to 20 bytes")
+)
+
+// BfdAuthKeyDescriptor teaches KVScheduler how to configure VPP BFD
+// authentication keys.
+type BfdAuthKeyDescriptor struct {
+	log        logging.Logger
+	bfdHandler vppcalls.BfdVppAPI
+}
+
+// NewBfdAuthKeyDescriptor creates a new instance of the BFD authentication key descriptor.
+func NewBfdAuthKeyDescriptor(bfdHandler vppcalls.BfdVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &BfdAuthKeyDescriptor{
+		log:        log.NewLogger("bfd-auth-key-descriptor"),
+		bfdHandler: bfdHandler,
+	}
+	typedDescr := &adapter.BfdAuthKeyDescriptor{
+		Name:            BfdAuthKeyDescriptorName,
+		NBKeyPrefix:     bfd.ModelBfdAuthKey.KeyPrefix(),
+		ValueTypeName:   bfd.ModelBfdAuthKey.ProtoName(),
+		KeySelector:     bfd.ModelBfdAuthKey.IsKeyValid,
+		KeyLabel:        bfd.ModelBfdAuthKey.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentBfdAuthKeys,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+	}
+	return adapter.NewBfdAuthKeyDescriptor(typedDescr)
+}
+
+// EquivalentBfdAuthKeys compares BFD authentication keys.
+func (d *BfdAuthKeyDescriptor) EquivalentBfdAuthKeys(key string, oldKey, newKey *bfd.BfdAuthKey) bool {
+	return oldKey.Type == newKey.Type && oldKey.Secret == newKey.Secret
+}
+
+// Validate validates VPP BFD authentication key configuration.
+func (d *BfdAuthKeyDescriptor) Validate(key string, authKey *bfd.BfdAuthKey) error {
+	if len(authKey.Secret) == 0 || len(authKey.Secret) > maxBfdSecretLen {
+		return kvs.NewInvalidValueError(ErrBfdAuthKeyInvalidSecret, "secret")
+	}
+	return nil
+}
+
+// Create configures BFD authentication key. Key cannot be changed while
+// used by any session, therefore every change is applied by re-creation
+// (together with the dependent sessions).
+func (d *BfdAuthKeyDescriptor) Create(key string, authKey *bfd.BfdAuthKey) (metadata interface{}, err error) {
+	if err = d.bfdHandler.SetBfdAuthKey(authKey); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes BFD authentication key.
+func (d *BfdAuthKeyDescriptor) Delete(key string, authKey *bfd.BfdAuthKey, metadata interface{}) error {
+	if err := d.bfdHandler.DeleteBfdAuthKey(authKey.Id); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all BFD authentication keys configured in VPP.
+func (d *BfdAuthKeyDescriptor) Retrieve(correlate []adapter.BfdAuthKeyKVWithMetadata) (retrieved []adapter.BfdAuthKeyKVWithMetadata, err error) {
+	// secret cannot be read back from VPP, the expected value is therefore
+	// taken from NB
+	nbKeys := make(map[uint32]*bfd.BfdAuthKey, len(correlate))
+	for _, kv := range correlate {
+		nbKeys[kv.Value.Id] = kv.Value
+	}
+
+	authKeys, err := d.bfdHandler.DumpBfdAuthKeys()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump BFD authentication keys: %v", err)
+	}
+	for _, details := range authKeys {
+		if nbKey, ok := nbKeys[details.AuthKey.Id]; ok {
+			details.AuthKey.Secret = nbKey.Secret
+		}
+		retrieved = append(retrieved, adapter.BfdAuthKeyKVWithMetadata{
+			Key:    bfd.AuthKeyKey(details.AuthKey.Id),
+			Value:  details.AuthKey,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
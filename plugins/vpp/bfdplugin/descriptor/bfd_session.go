@@ -0,0 +1,283 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// BfdSessionDescriptorName is the name of the descriptor for VPP BFD sessions.
+	BfdSessionDescriptorName = "vpp-bfd-session"
+
+	// dependency labels
+	bfdInterfaceDep = "interface-exists"
+	bfdLocalIPDep   = "local-ip-assigned"
+	bfdAuthKeyDep   = "auth-key-exists"
+
+	// maximum values of the BFD control packet fields
+	maxBfdMultiplier = 255
+	maxBfdAuthKeyID  = 255
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrBfdSessionWithoutInterface is returned when BFD session configuration
+	// has undefined interface.
+	ErrBfdSessionWithoutInterface = errors.New("VPP BFD session defined without interface")
+
+	// ErrBfdSessionInvalidLocalIP is returned when BFD session local IP is not valid.
+	ErrBfdSessionInvalidLocalIP = errors.New("VPP BFD session local IP is not valid")
+
+	// ErrBfdSessionInvalidPeerIP is returned when BFD session peer IP is not valid.
+	ErrBfdSessionInvalidPeerIP = errors.New("VPP BFD session peer IP is not valid")
+
+	// ErrBfdSessionIPVersionMismatch is returned when BFD session local and peer IP
+	// addresses are of different IP versions.
+	ErrBfdSessionIPVersionMismatch = errors.New("VPP BFD session local and peer IP versions do not match")
+
+	// ErrBfdSessionInvalidMultiplier is returned when BFD session detect multiplier
+	// is out of range.
+	ErrBfdSessionInvalidMultiplier = errors.New("VPP BFD session detect multiplier is out of range <1, 255>")
+
+	// ErrBfdSessionInvalidTxInterval is returned when BFD session desired minimal
+	// transmit interval is not defined.
+	ErrBfdSessionInvalidTxInterval = errors.New("VPP BFD session desired min TX interval must be non-zero")
+
+	// ErrBfdSessionInvalidAuthKeyID is returned when BFD session advertised
+	// authentication key ID is out of range.
+	ErrBfdSessionInvalidAuthKeyID = errors.New("VPP BFD session advertised key ID is out of range <0, 255>")
+)
+
+// BfdSessionDescriptor teaches KVScheduler how to configure VPP BFD sessions.
+type BfdSessionDescriptor struct {
+	log            logging.Logger
+	bfdHandler     vppcalls.BfdVppAPI
+	ifIndex        ifaceidx.IfaceMetadataIndex
+	sessionRemoved func(iface, peerIP string)
+}
+
+// NewBfdSessionDescriptor creates a new instance of the BFD session descriptor.
+// Callback <sessionRemoved> is called for every BFD session removed from VPP.
+func NewBfdSessionDescriptor(bfdHandler vppcalls.BfdVppAPI, ifIndex ifaceidx.IfaceMetadataIndex,
+	sessionRemoved func(iface, peerIP string), log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &BfdSessionDescriptor{
+		log:            log.NewLogger("bfd-session-descriptor"),
+		bfdHandler:     bfdHandler,
+		ifIndex:        ifIndex,
+		sessionRemoved: sessionRemoved,
+	}
+	typedDescr := &adapter.BfdSessionDescriptor{
+		Name:                 BfdSessionDescriptorName,
+		NBKeyPrefix:          bfd.ModelBfdSession.KeyPrefix(),
+		ValueTypeName:        bfd.ModelBfdSession.ProtoName(),
+		KeySelector:          bfd.ModelBfdSession.IsKeyValid,
+		KeyLabel:             bfd.ModelBfdSession.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentBfdSessions,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName, BfdAuthKeyDescriptorName},
+	}
+	return adapter.NewBfdSessionDescriptor(typedDescr)
+}
+
+// EquivalentBfdSessions compares BFD sessions, IP addresses are compared
+// regardless of their notation.
+func (d *BfdSessionDescriptor) EquivalentBfdSessions(key string, oldSession, newSession *bfd.BfdSession) bool {
+	if oldSession.Interface != newSession.Interface ||
+		!equalIPs(oldSession.LocalIp, newSession.LocalIp) ||
+		!equalIPs(oldSession.PeerIp, newSession.PeerIp) ||
+		oldSession.DesiredMinTxInterval != newSession.DesiredMinTxInterval ||
+		oldSession.RequiredMinRxInterval != newSession.RequiredMinRxInterval ||
+		oldSession.DetectMultiplier != newSession.DetectMultiplier {
+		return false
+	}
+	return equivalentAuthentication(oldSession.Authentication, newSession.Authentication)
+}
+
+// Validate validates VPP BFD session configuration.
+func (d *BfdSessionDescriptor) Validate(key string, session *bfd.BfdSession) error {
+	if session.Interface == "" {
+		return kvs.NewInvalidValueError(ErrBfdSessionWithoutInterface, "interface")
+	}
+	localIP := net.ParseIP(session.LocalIp)
+	if localIP == nil {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidLocalIP, "local_ip")
+	}
+	peerIP := net.ParseIP(session.PeerIp)
+	if peerIP == nil {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidPeerIP, "peer_ip")
+	}
+	if (localIP.To4() == nil) != (peerIP.To4() == nil) {
+		return kvs.NewInvalidValueError(ErrBfdSessionIPVersionMismatch, "local_ip", "peer_ip")
+	}
+	if session.DetectMultiplier == 0 || session.DetectMultiplier > maxBfdMultiplier {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidMultiplier, "detect_multiplier")
+	}
+	if session.DesiredMinTxInterval == 0 {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidTxInterval, "desired_min_tx_interval")
+	}
+	if session.GetAuthentication().GetAdvertisedKeyId() > maxBfdAuthKeyID {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidAuthKeyID, "authentication.advertised_key_id")
+	}
+	return nil
+}
+
+// Create adds new BFD session.
+func (d *BfdSessionDescriptor) Create(key string, session *bfd.BfdSession) (metadata interface{}, err error) {
+	ifMeta, found := d.ifIndex.LookupByName(session.Interface)
+	if !found {
+		err = errors.Errorf("failed to find BFD session interface %s", session.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.bfdHandler.AddBfdSession(session, ifMeta.SwIfIndex); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes BFD session.
+func (d *BfdSessionDescriptor) Delete(key string, session *bfd.BfdSession, metadata interface{}) error {
+	ifMeta, found := d.ifIndex.LookupByName(session.Interface)
+	if !found {
+		err := errors.Errorf("failed to find BFD session interface %s", session.Interface)
+		d.log.Error(err)
+		return err
+	}
+	if err := d.bfdHandler.DeleteBfdSession(session, ifMeta.SwIfIndex); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	if d.sessionRemoved != nil {
+		d.sessionRemoved(session.Interface, session.PeerIp)
+	}
+	return nil
+}
+
+// Update modifies timers of the BFD session.
+func (d *BfdSessionDescriptor) Update(key string, oldSession, newSession *bfd.BfdSession, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	ifMeta, found := d.ifIndex.LookupByName(newSession.Interface)
+	if !found {
+		err = errors.Errorf("failed to find BFD session interface %s", newSession.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.bfdHandler.ModifyBfdSession(newSession, ifMeta.SwIfIndex); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// UpdateWithRecreate returns true if local IP or authentication of the BFD
+// session has changed - only timers can be modified in place.
+func (d *BfdSessionDescriptor) UpdateWithRecreate(key string, oldSession, newSession *bfd.BfdSession, metadata interface{}) bool {
+	return !equalIPs(oldSession.LocalIp, newSession.LocalIp) ||
+		!equivalentAuthentication(oldSession.Authentication, newSession.Authentication)
+}
+
+// Retrieve returns all BFD sessions configured in VPP.
+func (d *BfdSessionDescriptor) Retrieve(correlate []adapter.BfdSessionKVWithMetadata) (retrieved []adapter.BfdSessionKVWithMetadata, err error) {
+	sessions, err := d.bfdHandler.DumpBfdSessions()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump BFD sessions: %v", err)
+	}
+	for _, details := range sessions {
+		session := details.Session
+		// keep IP addresses in the same notation as in NB to get matching keys
+		for _, kv := range correlate {
+			if kv.Value.Interface == session.Interface && equalIPs(kv.Value.PeerIp, session.PeerIp) {
+				session.PeerIp = kv.Value.PeerIp
+				if equalIPs(kv.Value.LocalIp, session.LocalIp) {
+					session.LocalIp = kv.Value.LocalIp
+				}
+				break
+			}
+		}
+		retrieved = append(retrieved, adapter.BfdSessionKVWithMetadata{
+			Key:    bfd.SessionKey(session.Interface, session.PeerIp),
+			Value:  session,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface with the local IP address assigned and
+// the authentication key (if used) as the dependencies of the BFD session.
+func (d *BfdSessionDescriptor) Dependencies(key string, session *bfd.BfdSession) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: bfdInterfaceDep,
+		Key:   interfaces.InterfaceKey(session.Interface),
+	})
+	if localIP := net.ParseIP(session.LocalIp); localIP != nil {
+		deps = append(deps, kvs.Dependency{
+			Label: bfdLocalIPDep,
+			AnyOf: kvs.AnyOfDependency{
+				KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(session.Interface)},
+				KeySelector: func(key string) bool {
+					iface, address, source, _, isAddrKey := interfaces.ParseInterfaceAddressKey(key)
+					if !isAddrKey || iface != session.Interface ||
+						source == netalloc_api.IPAddressSource_ALLOC_REF {
+						return false
+					}
+					ip, _, err := net.ParseCIDR(address)
+					return err == nil && ip.Equal(localIP)
+				},
+			},
+		})
+	}
+	if auth := session.GetAuthentication(); auth != nil {
+		deps = append(deps, kvs.Dependency{
+			Label: bfdAuthKeyDep,
+			Key:   bfd.AuthKeyKey(auth.KeyId),
+		})
+	}
+	return deps
+}
+
+// equalIPs compares IP addresses regardless of their notation.
+func equalIPs(ip1, ip2 string) bool {
+	if ip1 == ip2 {
+		return true
+	}
+	return net.ParseIP(ip1).Equal(net.ParseIP(ip2))
+}
+
+// equivalentAuthentication compares BFD session authentication.
+func equivalentAuthentication(oldAuth, newAuth *bfd.BfdSession_Authentication) bool {
+	return oldAuth.GetKeyId() == newAuth.GetKeyId() &&
+		oldAuth.GetAdvertisedKeyId() == newAuth.GetAdvertisedKeyId() &&
+		(oldAuth == nil) == (newAuth == nil)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+const (
+	// BfdSessionStateDescriptorName is the name of the descriptor notifying
+	// about the state changes of VPP BFD sessions.
+	BfdSessionStateDescriptorName = "vpp-bfd-session-state"
+)
+
+// sessionID identifies BFD session (peer IP is normalized).
+type sessionID struct {
+	iface  string
+	peerIP string
+}
+
+// BfdSessionStateDescriptor notifies kvscheduler about the state changes
+// of VPP BFD sessions.
+type BfdSessionStateDescriptor struct {
+	// input arguments
+	log         logging.Logger
+	kvscheduler kvs.KVScheduler
+	bfdHandler  vppcalls.BfdVppAPI
+
+	sessionStatesMx sync.Mutex
+	sessionStates   map[sessionID]bool // session -> session is up
+}
+
+// NewBfdSessionStateDescriptor creates a new instance of the BFD session state descriptor.
+func NewBfdSessionStateDescriptor(kvscheduler kvs.KVScheduler, bfdHandler vppcalls.BfdVppAPI,
+	log logging.PluginLogger) (descr *kvs.KVDescriptor, ctx *BfdSessionStateDescriptor) {
+
+	descrCtx := &BfdSessionStateDescriptor{
+		log:           log.NewLogger("bfd-session-state"),
+		kvscheduler:   kvscheduler,
+		bfdHandler:    bfdHandler,
+		sessionStates: make(map[sessionID]bool),
+	}
+	return &kvs.KVDescriptor{
+		Name:        BfdSessionStateDescriptorName,
+		KeySelector: descrCtx.IsBfdSessionStateKey,
+		Retrieve:    descrCtx.Retrieve,
+		// Retrieve depends on the BFD session descriptor: sessions are dumped
+		// with interface names taken from the interface index
+		RetrieveDependencies: []string{BfdSessionDescriptorName},
+	}, descrCtx
+}
+
+// IsBfdSessionStateKey returns <true> for keys representing state of BFD sessions.
+func (d *BfdSessionStateDescriptor) IsBfdSessionStateKey(key string) bool {
+	_, _, _, isSessionStateKey := bfd.ParseSessionStateKey(key)
+	return isSessionStateKey
+}
+
+// Retrieve returns key for every VPP BFD session describing whether
+// the session is up (value is empty).
+func (d *BfdSessionStateDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (values []kvs.KVWithMetadata, err error) {
+	sessions, err := d.bfdHandler.DumpBfdSessions()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	d.sessionStatesMx.Lock()
+	defer d.sessionStatesMx.Unlock()
+	d.sessionStates = make(map[sessionID]bool) // clear the map
+
+	for _, details := range sessions {
+		isUp := details.Meta.State == bfd.BfdSessionState_UP
+		d.sessionStates[newSessionID(details.Session.Interface, details.Session.PeerIp)] = isUp
+		values = append(values, kvs.KVWithMetadata{
+			Key:    bfd.SessionStateKey(details.Session.Interface, details.Session.PeerIp, isUp),
+			Value:  &emptypb.Empty{},
+			Origin: kvs.FromSB,
+		})
+	}
+
+	return values, nil
+}
+
+// UpdateSessionState notifies scheduler about a change in the state of a BFD session.
+func (d *BfdSessionStateDescriptor) UpdateSessionState(iface, peerIP string, isUp bool) {
+	d.sessionStatesMx.Lock()
+	defer d.sessionStatesMx.Unlock()
+
+	id := newSessionID(iface, peerIP)
+	wasUp, hadState := d.sessionStates[id]
+	if hadState && wasUp == isUp {
+		return
+	}
+
+	var notifs []kvs.KVWithMetadata
+	if hadState {
+		// remove now obsolete key-value pair
+		notifs = append(notifs, kvs.KVWithMetadata{
+			Key:   bfd.SessionStateKey(iface, peerIP, wasUp),
+			Value: nil,
+		})
+	}
+	// push new key-value pair
+	notifs = append(notifs, kvs.KVWithMetadata{
+		Key:   bfd.SessionStateKey(iface, peerIP, isUp),
+		Value: &emptypb.Empty{},
+	})
+	d.sessionStates[id] = isUp
+	d.pushNotifications(notifs)
+}
+
+// RemoveSessionState notifies scheduler that a BFD session was removed.
+func (d *BfdSessionStateDescriptor) RemoveSessionState(iface, peerIP string) {
+	d.sessionStatesMx.Lock()
+	defer d.sessionStatesMx.Unlock()
+
+	id := newSessionID(iface, peerIP)
+	wasUp, hadState := d.sessionStates[id]
+	if !hadState {
+		return
+	}
+	delete(d.sessionStates, id)
+	d.pushNotifications([]kvs.KVWithMetadata{{
+		Key:   bfd.SessionStateKey(iface, peerIP, wasUp),
+		Value: nil,
+	}})
+}
+
+func (d *BfdSessionStateDescriptor) pushNotifications(notifs []kvs.KVWithMetadata) {
+	if err := d.kvscheduler.PushSBNotification(notifs...); err != nil {
+		d.log.Errorf("failed to send notifications to KVScheduler: %v", err)
+	}
+}
+
+func newSessionID(iface, peerIP string) sessionID {
+	if ip := net.ParseIP(peerIP); ip != nil {
+		peerIP = ip.String()
+	}
+	return sessionID{iface: iface, peerIP: peerIP}
+}
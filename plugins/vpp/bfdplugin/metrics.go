@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdplugin
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var sessionStates = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "ligato",
+	Subsystem: "bfdplugin",
+	Name:      "session_state",
+	Help:      "The state of BFD sessions (1 - admin down, 2 - down, 3 - init, 4 - up).",
+}, []string{"interface", "peer"})
+
+func registerMetrics() {
+	prometheus.MustRegister(sessionStates)
+}
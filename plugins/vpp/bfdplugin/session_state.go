@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdplugin
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+// sessionStateUpdater watches BFD session events from VPP, keeps the last
+// known state of every session and publishes the state changes.
+type sessionStateUpdater struct {
+	log logging.Logger
+
+	kvScheduler     kvs.KVScheduler
+	bfdHandler      vppcalls.BfdVppAPI
+	ifIndexes       ifaceidx.IfaceMetadataIndex
+	stateDescriptor *descriptor.BfdSessionStateDescriptor
+	publishState    func(notification *vpp_bfd.BfdSessionNotification)
+
+	// access guards access to sessions map
+	access   sync.Mutex
+	sessions map[string]*vpp_bfd.BfdSessionState // interface + peer IP -> state
+
+	bfdEvents       chan *vppcalls.BfdSessionEvent
+	cancelBfdEvents func()
+
+	wg sync.WaitGroup
+}
+
+func newSessionStateUpdater(
+	kvScheduler kvs.KVScheduler,
+	bfdHandler vppcalls.BfdVppAPI,
+	ifIndexes ifaceidx.IfaceMetadataIndex,
+	stateDescriptor *descriptor.BfdSessionStateDescriptor,
+	publishState func(notification *vpp_bfd.BfdSessionNotification),
+	logger logging.PluginLogger,
+) *sessionStateUpdater {
+	return &sessionStateUpdater{
+		log:             logger.NewLogger("bfd-state"),
+		kvScheduler:     kvScheduler,
+		bfdHandler:      bfdHandler,
+		ifIndexes:       ifIndexes,
+		stateDescriptor: stateDescriptor,
+		publishState:    publishState,
+		sessions:        make(map[string]*vpp_bfd.BfdSessionState),
+		bfdEvents:       make(chan *vppcalls.BfdSessionEvent, 100),
+	}
+}
+
+// start starts watching for delivery of BFD session events.
+func (u *sessionStateUpdater) start(ctx context.Context) {
+	u.wg.Add(1)
+	go u.watchVPPEvents(ctx)
+}
+
+// wait waits until the watcher has finished.
+func (u *sessionStateUpdater) wait() {
+	u.wg.Wait()
+}
+
+// subscribeVPPEvents (re)subscribes for BFD session events from VPP.
+func (u *sessionStateUpdater) subscribeVPPEvents(ctx context.Context) error {
+	if u.cancelBfdEvents != nil {
+		u.cancelBfdEvents()
+	}
+	ctx, u.cancelBfdEvents = context.WithCancel(ctx)
+	return u.bfdHandler.WatchBfdSessionEvents(ctx, u.bfdEvents)
+}
+
+// watchVPPEvents watches for delivery of BFD session events from VPP.
+func (u *sessionStateUpdater) watchVPPEvents(ctx context.Context) {
+	defer u.wg.Done()
+
+	for {
+		select {
+		case event := <-u.bfdEvents:
+			// if the event is a result of a configuration change,
+			// make sure the associated transaction has already finalized
+			u.kvScheduler.TransactionBarrier()
+
+			u.processSessionEvent(event)
+
+		case <-ctx.Done():
+			u.log.Debug("BFD session event watcher stopped")
+			return
+		}
+	}
+}
+
+// processSessionEvent updates the state of the BFD session and publishes
+// the change (events not changing the state are ignored).
+func (u *sessionStateUpdater) processSessionEvent(event *vppcalls.BfdSessionEvent) {
+	iface, _, found := u.ifIndexes.LookupBySwIfIndex(event.SwIfIndex)
+	if !found {
+		u.log.Debugf("BFD session event for unknown interface with index %d, ignoring", event.SwIfIndex)
+		return
+	}
+
+	u.access.Lock()
+	id := sessionID(iface, event.PeerIP)
+	if prev, ok := u.sessions[id]; ok && prev.State == event.State {
+		u.access.Unlock()
+		return
+	}
+	state := &vpp_bfd.BfdSessionState{
+		Interface:  iface,
+		LocalIp:    event.LocalIP,
+		PeerIp:     event.PeerIP,
+		State:      event.State,
+		LastChange: time.Now().Unix(),
+	}
+	u.sessions[id] = state
+	u.access.Unlock()
+
+	u.log.Debugf("BFD session %s -> %s on interface %s is %v",
+		state.LocalIp, state.PeerIp, state.Interface, state.State)
+
+	sessionStates.WithLabelValues(iface, state.PeerIp).Set(float64(state.State))
+	u.stateDescriptor.UpdateSessionState(iface, state.PeerIp, state.State == vpp_bfd.BfdSessionState_UP)
+	u.publishState(&vpp_bfd.BfdSessionNotification{
+		State: proto.Clone(state).(*vpp_bfd.BfdSessionState),
+	})
+}
+
+// removeSession forgets the state of a removed BFD session.
+func (u *sessionStateUpdater) removeSession(iface, peerIP string) {
+	u.access.Lock()
+	id := sessionID(iface, peerIP)
+	state, ok := u.sessions[id]
+	delete(u.sessions, id)
+	u.access.Unlock()
+
+	if ok {
+		sessionStates.DeleteLabelValues(iface, state.PeerIp)
+	}
+	u.stateDescriptor.RemoveSessionState(iface, peerIP)
+}
+
+// sessionID returns identifier of the BFD session with normalized peer IP.
+func sessionID(iface, peerIP string) string {
+	if ip := net.ParseIP(peerIP); ip != nil {
+		peerIP = ip.String()
+	}
+	return iface + "/" + peerIP
+}
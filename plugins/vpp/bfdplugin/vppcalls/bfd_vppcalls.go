@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	"context"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+// BfdSessionDetails contains proto-modeled BFD session data together with
+// VPP-related metadata.
+type BfdSessionDetails struct {
+	Session *bfd.BfdSession `json:"bfd_session"`
+	Meta    *BfdSessionMeta `json:"bfd_session_meta"`
+}
+
+// BfdSessionMeta contains operational data of BFD session.
+type BfdSessionMeta struct {
+	SwIfIndex uint32                    `json:"sw_if_index"`
+	State     bfd.BfdSessionState_State `json:"state"`
+}
+
+// BfdAuthKeyDetails contains proto-modeled BFD authentication key data
+// (without the secret, which cannot be read back) together with VPP-related
+// metadata.
+type BfdAuthKeyDetails struct {
+	AuthKey *bfd.BfdAuthKey `json:"bfd_auth_key"`
+	Meta    *BfdAuthKeyMeta `json:"bfd_auth_key_meta"`
+}
+
+// BfdAuthKeyMeta contains number of BFD sessions using the key.
+type BfdAuthKeyMeta struct {
+	UseCount uint32 `json:"use_count"`
+}
+
+// BfdSessionEvent is a notification about BFD session state change.
+type BfdSessionEvent struct {
+	SwIfIndex uint32
+	LocalIP   string
+	PeerIP    string
+	State     bfd.BfdSessionState_State
+}
+
+// BfdVppAPI provides read/write methods required to handle VPP BFD.
+type BfdVppAPI interface {
+	BfdVppRead
+
+	// AddBfdSession creates new BFD UDP session.
+	AddBfdSession(session *bfd.BfdSession, swIfIndex uint32) error
+	// ModifyBfdSession changes timers of existing BFD UDP session.
+	ModifyBfdSession(session *bfd.BfdSession, swIfIndex uint32) error
+	// DeleteBfdSession removes existing BFD UDP session.
+	DeleteBfdSession(session *bfd.BfdSession, swIfIndex uint32) error
+	// SetBfdAuthKey configures BFD authentication key.
+	SetBfdAuthKey(authKey *bfd.BfdAuthKey) error
+	// DeleteBfdAuthKey removes BFD authentication key.
+	DeleteBfdAuthKey(id uint32) error
+	// WatchBfdSessionEvents starts watching for BFD session state changes.
+	WatchBfdSessionEvents(ctx context.Context, events chan<- *BfdSessionEvent) error
+}
+
+// BfdVppRead provides read methods for BFD.
+type BfdVppRead interface {
+	// DumpBfdSessions retrieves all BFD UDP sessions configured in VPP.
+	DumpBfdSessions() ([]*BfdSessionDetails, error)
+	// DumpBfdAuthKeys retrieves all BFD authentication keys configured in VPP.
+	DumpBfdAuthKeys() ([]*BfdAuthKeyDetails, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "bfd",
+	HandlerAPI: (*BfdVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) BfdVppAPI
+
+func AddBfdHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleBfdVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) BfdVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(BfdVppAPI)
+	}
+	return nil
+}
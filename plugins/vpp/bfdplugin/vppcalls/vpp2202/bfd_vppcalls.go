@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bfd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+const (
+	// BFD authentication types as defined by RFC 5880
+	authTypeKeyedSHA1           uint8 = 4
+	authTypeMeticulousKeyedSHA1 uint8 = 5
+
+	// maximum length of the BFD authentication key supported by VPP
+	maxAuthKeyLen = 20
+)
+
+// AddBfdSession implements BFD handler.
+func (h *BfdVppHandler) AddBfdSession(session *bfd.BfdSession, swIfIndex uint32) error {
+	localAddr, peerAddr, err := sessionAddrs(session)
+	if err != nil {
+		return err
+	}
+	req := &vpp_bfd.BfdUDPAdd{
+		SwIfIndex:     interface_types.InterfaceIndex(swIfIndex),
+		DesiredMinTx:  session.DesiredMinTxInterval,
+		RequiredMinRx: session.RequiredMinRxInterval,
+		LocalAddr:     localAddr,
+		PeerAddr:      peerAddr,
+		DetectMult:    uint8(session.DetectMultiplier),
+	}
+	if auth := session.GetAuthentication(); auth != nil {
+		req.IsAuthenticated = true
+		req.BfdKeyID = uint8(auth.AdvertisedKeyId)
+		req.ConfKeyID = auth.KeyId
+	}
+	reply := &vpp_bfd.BfdUDPAddReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// ModifyBfdSession implements BFD handler.
+func (h *BfdVppHandler) ModifyBfdSession(session *bfd.BfdSession, swIfIndex uint32) error {
+	localAddr, peerAddr, err := sessionAddrs(session)
+	if err != nil {
+		return err
+	}
+	req := &vpp_bfd.BfdUDPMod{
+		SwIfIndex:     interface_types.InterfaceIndex(swIfIndex),
+		DesiredMinTx:  session.DesiredMinTxInterval,
+		RequiredMinRx: session.RequiredMinRxInterval,
+		LocalAddr:     localAddr,
+		PeerAddr:      peerAddr,
+		DetectMult:    uint8(session.DetectMultiplier),
+	}
+	reply := &vpp_bfd.BfdUDPModReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// DeleteBfdSession implements BFD handler.
+func (h *BfdVppHandler) DeleteBfdSession(session *bfd.BfdSession, swIfIndex uint32) error {
+	localAddr, peerAddr, err := sessionAddrs(session)
+	if err != nil {
+		return err
+	}
+	req := &vpp_bfd.BfdUDPDel{
+		SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+		LocalAddr: localAddr,
+		PeerAddr:  peerAddr,
+	}
+	reply := &vpp_bfd.BfdUDPDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetBfdAuthKey implements BFD handler.
+func (h *BfdVppHandler) SetBfdAuthKey(authKey *bfd.BfdAuthKey) error {
+	if len(authKey.Secret) > maxAuthKeyLen {
+		return errors.Errorf("BFD authentication key %d is longer than %d bytes",
+			authKey.Id, maxAuthKeyLen)
+	}
+	req := &vpp_bfd.BfdAuthSetKey{
+		ConfKeyID: authKey.Id,
+		KeyLen:    uint8(len(authKey.Secret)),
+		AuthType:  toVppAuthType(authKey.Type),
+		Key:       []byte(authKey.Secret),
+	}
+	reply := &vpp_bfd.BfdAuthSetKeyReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// DeleteBfdAuthKey implements BFD handler.
+func (h *BfdVppHandler) DeleteBfdAuthKey(id uint32) error {
+	req := &vpp_bfd.BfdAuthDelKey{
+		ConfKeyID: id,
+	}
+	reply := &vpp_bfd.BfdAuthDelKeyReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// sessionAddrs parses local and peer IP address of the BFD session.
+func sessionAddrs(session *bfd.BfdSession) (localAddr, peerAddr ip_types.Address, err error) {
+	if localAddr, err = ip_types.ParseAddress(session.LocalIp); err != nil {
+		return localAddr, peerAddr, errors.Errorf("BFD session local IP: %v", err)
+	}
+	if peerAddr, err = ip_types.ParseAddress(session.PeerIp); err != nil {
+		return localAddr, peerAddr, errors.Errorf("BFD session peer IP: %v", err)
+	}
+	return localAddr, peerAddr, nil
+}
+
+func toVppAuthType(authType bfd.BfdAuthKey_AuthType) uint8 {
+	if authType == bfd.BfdAuthKey_METICULOUS_KEYED_SHA1 {
+		return authTypeMeticulousKeyedSHA1
+	}
+	return authTypeKeyedSHA1
+}
+
+func fromVppAuthType(authType uint8) bfd.BfdAuthKey_AuthType {
+	if authType == authTypeMeticulousKeyedSHA1 {
+		return bfd.BfdAuthKey_METICULOUS_KEYED_SHA1
+	}
+	return bfd.BfdAuthKey_KEYED_SHA1
+}
+
+// fromVppState converts BFD session state from its VPP representation
+// (the proto enum has UNKNOWN state at 0 shifting the rest by one).
+func fromVppState(state vpp_bfd.BfdState) bfd.BfdSessionState_State {
+	return bfd.BfdSessionState_State(state + 1)
+}
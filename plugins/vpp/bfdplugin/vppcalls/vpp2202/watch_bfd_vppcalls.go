@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bfd"
+)
+
+var (
+	// EventDeliverTimeout defines maximum time to deliver event upstream.
+	EventDeliverTimeout = time.Second
+	// NotifChanBufferSize defines size of notification channel buffer.
+	NotifChanBufferSize = 10
+)
+
+// WatchBfdSessionEvents implements BFD handler.
+func (h *BfdVppHandler) WatchBfdSessionEvents(ctx context.Context, eventsCh chan<- *vppcalls.BfdSessionEvent) error {
+	notifChan := make(chan govppapi.Message, NotifChanBufferSize)
+
+	// subscribe to BfdUDPSessionEvent notifications
+	sub, err := h.callsChannel.SubscribeNotification(notifChan, &vpp_bfd.BfdUDPSessionEvent{})
+	if err != nil {
+		return errors.Errorf("subscribing to VPP notification (bfd_udp_session_event) failed: %v", err)
+	}
+	unsub := func() {
+		if err := sub.Unsubscribe(); err != nil {
+			h.log.Warnf("unsubscribing VPP notification (bfd_udp_session_event) failed: %v", err)
+		}
+	}
+
+	go func() {
+		h.log.Debugf("start watching BFD session events")
+		defer h.log.Debugf("done watching BFD session events (%v)", ctx.Err())
+
+		for {
+			select {
+			case e, open := <-notifChan:
+				if !open {
+					h.log.Debugf("BFD session events channel was closed")
+					unsub()
+					return
+				}
+
+				bfdEvent, ok := e.(*vpp_bfd.BfdUDPSessionEvent)
+				if !ok {
+					h.log.Debugf("unexpected notification type: %#v", e)
+					continue
+				}
+
+				// try to send event
+				select {
+				case eventsCh <- toBfdSessionEvent(bfdEvent):
+					// sent ok
+				case <-ctx.Done():
+					unsub()
+					return
+				default:
+					// channel full send event in goroutine for later processing
+					go func() {
+						select {
+						case eventsCh <- toBfdSessionEvent(bfdEvent):
+							// sent ok
+						case <-time.After(EventDeliverTimeout):
+							h.log.Warnf("unable to deliver BFD session event, dropping it: %+v", bfdEvent)
+						}
+					}()
+				}
+			case <-ctx.Done():
+				unsub()
+				return
+			}
+		}
+	}()
+
+	// enable BFD events from VPP
+	req := &vpp_bfd.WantBfdEvents{
+		EnableDisable: true,
+		PID:           uint32(os.Getpid()),
+	}
+	reply := &vpp_bfd.WantBfdEventsReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		if errors.Is(err, govppapi.VPPApiError(govppapi.INVALID_REGISTRATION)) {
+			h.log.Warnf("already subscribed to BFD events: %v", err)
+			return nil
+		}
+		return errors.Errorf("failed to watch BFD events: %v", err)
+	}
+
+	return nil
+}
+
+func toBfdSessionEvent(e *vpp_bfd.BfdUDPSessionEvent) *vppcalls.BfdSessionEvent {
+	return &vppcalls.BfdSessionEvent{
+		SwIfIndex: uint32(e.SwIfIndex),
+		LocalIP:   e.LocalAddr.ToIP().String(),
+		PeerIP:    e.PeerAddr.ToIP().String(),
+		State:     fromVppState(e.State),
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210_test
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2210"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/bfd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+func TestAddBfdSession(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPAddReply{})
+
+	err := bfdHandler.AddBfdSession(&bfd.BfdSession{
+		Interface:             "if1",
+		LocalIp:               "10.0.0.1",
+		PeerIp:                "10.0.0.2",
+		DesiredMinTxInterval:  100000,
+		RequiredMinRxInterval: 200000,
+		DetectMultiplier:      3,
+		Authentication: &bfd.BfdSession_Authentication{
+			KeyId:           7,
+			AdvertisedKeyId: 1,
+		},
+	}, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPAdd)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.LocalAddr.ToIP().String()).To(Equal("10.0.0.1"))
+	Expect(vppMsg.PeerAddr.ToIP().String()).To(Equal("10.0.0.2"))
+	Expect(vppMsg.DesiredMinTx).To(BeEquivalentTo(100000))
+	Expect(vppMsg.RequiredMinRx).To(BeEquivalentTo(200000))
+	Expect(vppMsg.DetectMult).To(BeEquivalentTo(3))
+	Expect(vppMsg.IsAuthenticated).To(BeTrue())
+	Expect(vppMsg.ConfKeyID).To(BeEquivalentTo(7))
+	Expect(vppMsg.BfdKeyID).To(BeEquivalentTo(1))
+}
+
+func TestAddBfdSessionError(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := bfdHandler.AddBfdSession(&bfd.BfdSession{
+		Interface: "if1",
+		LocalIp:   "10.0.0.1",
+		PeerIp:    "invalid-ip",
+	}, 2)
+	Expect(err).Should(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPAddReply{
+		Retval: 1,
+	})
+	err = bfdHandler.AddBfdSession(&bfd.BfdSession{
+		Interface: "if1",
+		LocalIp:   "10.0.0.1",
+		PeerIp:    "10.0.0.2",
+	}, 2)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestModifyBfdSession(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPModReply{})
+
+	err := bfdHandler.ModifyBfdSession(&bfd.BfdSession{
+		Interface:             "if1",
+		LocalIp:               "fd00::1",
+		PeerIp:                "fd00::2",
+		DesiredMinTxInterval:  300000,
+		RequiredMinRxInterval: 300000,
+		DetectMultiplier:      5,
+	}, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPMod)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.PeerAddr.Af).To(Equal(ip_types.ADDRESS_IP6))
+	Expect(vppMsg.DesiredMinTx).To(BeEquivalentTo(300000))
+	Expect(vppMsg.DetectMult).To(BeEquivalentTo(5))
+}
+
+func TestDeleteBfdSession(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPDelReply{})
+
+	err := bfdHandler.DeleteBfdSession(&bfd.BfdSession{
+		Interface: "if1",
+		LocalIp:   "10.0.0.1",
+		PeerIp:    "10.0.0.2",
+	}, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.PeerAddr.ToIP().String()).To(Equal("10.0.0.2"))
+}
+
+func TestSetBfdAuthKey(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdAuthSetKeyReply{})
+
+	err := bfdHandler.SetBfdAuthKey(&bfd.BfdAuthKey{
+		Id:     7,
+		Type:   bfd.BfdAuthKey_METICULOUS_KEYED_SHA1,
+		Secret: "secret",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdAuthSetKey)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.ConfKeyID).To(BeEquivalentTo(7))
+	Expect(vppMsg.AuthType).To(BeEquivalentTo(5))
+	Expect(vppMsg.KeyLen).To(BeEquivalentTo(6))
+	Expect(vppMsg.Key).To(Equal([]byte("secret")))
+
+	err = bfdHandler.SetBfdAuthKey(&bfd.BfdAuthKey{
+		Id:     8,
+		Secret: "this-secret-is-too-long",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDeleteBfdAuthKey(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdAuthDelKeyReply{})
+
+	err := bfdHandler.DeleteBfdAuthKey(7)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdAuthDelKey)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.ConfKeyID).To(BeEquivalentTo(7))
+}
+
+func TestDumpBfdSessions(t *testing.T) {
+	ctx, bfdHandler, ifIndex := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(
+		&vpp_bfd.BfdUDPSessionDetails{
+			SwIfIndex:       2,
+			LocalAddr:       ip_types.NewAddress(net.ParseIP("10.0.0.1")),
+			PeerAddr:        ip_types.NewAddress(net.ParseIP("10.0.0.2")),
+			State:           vpp_bfd.BFD_STATE_API_UP,
+			IsAuthenticated: true,
+			BfdKeyID:        1,
+			ConfKeyID:       7,
+			RequiredMinRx:   200000,
+			DesiredMinTx:    100000,
+			DetectMult:      3,
+		},
+		// session on unknown interface is skipped
+		&vpp_bfd.BfdUDPSessionDetails{
+			SwIfIndex: 5,
+			LocalAddr: ip_types.NewAddress(net.ParseIP("10.0.1.1")),
+			PeerAddr:  ip_types.NewAddress(net.ParseIP("10.0.1.2")),
+		},
+		&memclnt.ControlPingReply{},
+	)
+
+	sessions, err := bfdHandler.DumpBfdSessions()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(sessions).To(HaveLen(1))
+	Expect(sessions[0].Session.Interface).To(Equal("if1"))
+	Expect(sessions[0].Session.LocalIp).To(Equal("10.0.0.1"))
+	Expect(sessions[0].Session.PeerIp).To(Equal("10.0.0.2"))
+	Expect(sessions[0].Session.DesiredMinTxInterval).To(BeEquivalentTo(100000))
+	Expect(sessions[0].Session.RequiredMinRxInterval).To(BeEquivalentTo(200000))
+	Expect(sessions[0].Session.DetectMultiplier).To(BeEquivalentTo(3))
+	Expect(sessions[0].Session.Authentication.KeyId).To(BeEquivalentTo(7))
+	Expect(sessions[0].Session.Authentication.AdvertisedKeyId).To(BeEquivalentTo(1))
+	Expect(sessions[0].Meta.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(sessions[0].Meta.State).To(Equal(bfd.BfdSessionState_UP))
+}
+
+func TestDumpBfdAuthKeys(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_bfd.BfdAuthKeysDetails{
+			ConfKeyID: 7,
+			UseCount:  2,
+			AuthType:  4,
+		},
+		&memclnt.ControlPingReply{},
+	)
+
+	authKeys, err := bfdHandler.DumpBfdAuthKeys()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(authKeys).To(HaveLen(1))
+	Expect(authKeys[0].AuthKey.Id).To(BeEquivalentTo(7))
+	Expect(authKeys[0].AuthKey.Type).To(Equal(bfd.BfdAuthKey_KEYED_SHA1))
+	Expect(authKeys[0].Meta.UseCount).To(BeEquivalentTo(2))
+}
+
+func bfdTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.BfdVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndex := ifaceidx.NewIfaceIndex(log, "bfd-test-ifidx")
+	bfdHandler := vpp2210.NewBfdVppHandler(ctx.MockChannel, ifIndex, log)
+	return ctx, bfdHandler, ifIndex
+}
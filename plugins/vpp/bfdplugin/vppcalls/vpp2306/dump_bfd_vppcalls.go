@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/bfd"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+// DumpBfdSessions implements BFD handler.
+func (h *BfdVppHandler) DumpBfdSessions() (sessions []*vppcalls.BfdSessionDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_bfd.BfdUDPSessionDump{})
+	for {
+		details := &vpp_bfd.BfdUDPSessionDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(details.SwIfIndex))
+		if !exists {
+			h.log.Warnf("BFD session dump: interface name for index %d not found", details.SwIfIndex)
+			continue
+		}
+		session := &bfd.BfdSession{
+			Interface:             ifName,
+			LocalIp:               details.LocalAddr.ToIP().String(),
+			PeerIp:                details.PeerAddr.ToIP().String(),
+			DesiredMinTxInterval:  details.DesiredMinTx,
+			RequiredMinRxInterval: details.RequiredMinRx,
+			DetectMultiplier:      uint32(details.DetectMult),
+		}
+		if details.IsAuthenticated {
+			session.Authentication = &bfd.BfdSession_Authentication{
+				KeyId:           details.ConfKeyID,
+				AdvertisedKeyId: uint32(details.BfdKeyID),
+			}
+		}
+		sessions = append(sessions, &vppcalls.BfdSessionDetails{
+			Session: session,
+			Meta: &vppcalls.BfdSessionMeta{
+				SwIfIndex: uint32(details.SwIfIndex),
+				State:     fromVppState(details.State),
+			},
+		})
+	}
+	return sessions, nil
+}
+
+// DumpBfdAuthKeys implements BFD handler.
+func (h *BfdVppHandler) DumpBfdAuthKeys() (authKeys []*vppcalls.BfdAuthKeyDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_bfd.BfdAuthKeysDump{})
+	for {
+		details := &vpp_bfd.BfdAuthKeysDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		authKeys = append(authKeys, &vppcalls.BfdAuthKeyDetails{
+			AuthKey: &bfd.BfdAuthKey{
+				Id:   details.ConfKeyID,
+				Type: fromVppAuthType(details.AuthType),
+			},
+			Meta: &vppcalls.BfdAuthKeyMeta{
+				UseCount: details.UseCount,
+			},
+		})
+	}
+	return authKeys, nil
+}
@@ -0,0 +1,1267 @@
+// Code generated by GoVPP's binapi-generator. DO NOT EDIT.
+
+// Package policer contains generated bindings for API file policer.api.
+//
+// Contents:
+// - 25 messages
+package policer
+
+import (
+	api "go.fd.io/govpp/api"
+	codec "go.fd.io/govpp/codec"
+	interface_types "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	policer_types "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/policer_types"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the GoVPP api package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// GoVPP api package needs to be updated.
+const _ = api.GoVppAPIPackageIsVersion2
+
+const (
+	APIFile    = "policer"
+	APIVersion = "3.0.0"
+	VersionCrc = 0x341163a6
+)
+
+// PolicerAdd defines message 'policer_add'.
+type PolicerAdd struct {
+	Name  string                      `binapi:"string[64],name=name" json:"name,omitempty"`
+	Infos policer_types.PolicerConfig `binapi:"policer_config,name=infos" json:"infos,omitempty"`
+}
+
+func (m *PolicerAdd) Reset()               { *m = PolicerAdd{} }
+func (*PolicerAdd) GetMessageName() string { return "policer_add" }
+func (*PolicerAdd) GetCrcString() string   { return "4d949e35" }
+func (*PolicerAdd) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerAdd) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 64 // m.Name
+	size += 4  // m.Infos.Cir
+	size += 4  // m.Infos.Eir
+	size += 8  // m.Infos.Cb
+	size += 8  // m.Infos.Eb
+	size += 1  // m.Infos.RateType
+	size += 1  // m.Infos.RoundType
+	size += 1  // m.Infos.Type
+	size += 1  // m.Infos.ColorAware
+	size += 1  // m.Infos.ConformAction.Type
+	size += 1  // m.Infos.ConformAction.Dscp
+	size += 1  // m.Infos.ExceedAction.Type
+	size += 1  // m.Infos.ExceedAction.Dscp
+	size += 1  // m.Infos.ViolateAction.Type
+	size += 1  // m.Infos.ViolateAction.Dscp
+	return size
+}
+func (m *PolicerAdd) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeString(m.Name, 64)
+	buf.EncodeUint32(m.Infos.Cir)
+	buf.EncodeUint32(m.Infos.Eir)
+	buf.EncodeUint64(m.Infos.Cb)
+	buf.EncodeUint64(m.Infos.Eb)
+	buf.EncodeUint8(uint8(m.Infos.RateType))
+	buf.EncodeUint8(uint8(m.Infos.RoundType))
+	buf.EncodeUint8(uint8(m.Infos.Type))
+	buf.EncodeBool(m.Infos.ColorAware)
+	buf.EncodeUint8(uint8(m.Infos.ConformAction.Type))
+	buf.EncodeUint8(m.Infos.ConformAction.Dscp)
+	buf.EncodeUint8(uint8(m.Infos.ExceedAction.Type))
+	buf.EncodeUint8(m.Infos.ExceedAction.Dscp)
+	buf.EncodeUint8(uint8(m.Infos.ViolateAction.Type))
+	buf.EncodeUint8(m.Infos.ViolateAction.Dscp)
+	return buf.Bytes(), nil
+}
+func (m *PolicerAdd) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Name = buf.DecodeString(64)
+	m.Infos.Cir = buf.DecodeUint32()
+	m.Infos.Eir = buf.DecodeUint32()
+	m.Infos.Cb = buf.DecodeUint64()
+	m.Infos.Eb = buf.DecodeUint64()
+	m.Infos.RateType = policer_types.Sse2QosRateType(buf.DecodeUint8())
+	m.Infos.RoundType = policer_types.Sse2QosRoundType(buf.DecodeUint8())
+	m.Infos.Type = policer_types.Sse2QosPolicerType(buf.DecodeUint8())
+	m.Infos.ColorAware = buf.DecodeBool()
+	m.Infos.ConformAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.Infos.ConformAction.Dscp = buf.DecodeUint8()
+	m.Infos.ExceedAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.Infos.ExceedAction.Dscp = buf.DecodeUint8()
+	m.Infos.ViolateAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.Infos.ViolateAction.Dscp = buf.DecodeUint8()
+	return nil
+}
+
+// Add/del policer
+//   - is_add - add policer if non-zero, else delete
+//   - name - policer name
+//   - cir - CIR
+//   - eir - EIR
+//   - cb - Committed Burst
+//   - eb - Excess or Peak Burst
+//   - rate_type - rate type
+//   - round_type - rounding type
+//   - type - policer algorithm
+//   - color_aware - 0=color-blind, 1=color-aware
+//   - conform_action - conform action
+//   - exceed_action - exceed action type
+//   - violate_action - violate action type
+//
+// PolicerAddDel defines message 'policer_add_del'.
+type PolicerAddDel struct {
+	IsAdd         bool                             `binapi:"bool,name=is_add" json:"is_add,omitempty"`
+	Name          string                           `binapi:"string[64],name=name" json:"name,omitempty"`
+	Cir           uint32                           `binapi:"u32,name=cir" json:"cir,omitempty"`
+	Eir           uint32                           `binapi:"u32,name=eir" json:"eir,omitempty"`
+	Cb            uint64                           `binapi:"u64,name=cb" json:"cb,omitempty"`
+	Eb            uint64                           `binapi:"u64,name=eb" json:"eb,omitempty"`
+	RateType      policer_types.Sse2QosRateType    `binapi:"sse2_qos_rate_type,name=rate_type" json:"rate_type,omitempty"`
+	RoundType     policer_types.Sse2QosRoundType   `binapi:"sse2_qos_round_type,name=round_type" json:"round_type,omitempty"`
+	Type          policer_types.Sse2QosPolicerType `binapi:"sse2_qos_policer_type,name=type" json:"type,omitempty"`
+	ColorAware    bool                             `binapi:"bool,name=color_aware" json:"color_aware,omitempty"`
+	ConformAction policer_types.Sse2QosAction      `binapi:"sse2_qos_action,name=conform_action" json:"conform_action,omitempty"`
+	ExceedAction  policer_types.Sse2QosAction      `binapi:"sse2_qos_action,name=exceed_action" json:"exceed_action,omitempty"`
+	ViolateAction policer_types.Sse2QosAction      `binapi:"sse2_qos_action,name=violate_action" json:"violate_action,omitempty"`
+}
+
+func (m *PolicerAddDel) Reset()               { *m = PolicerAddDel{} }
+func (*PolicerAddDel) GetMessageName() string { return "policer_add_del" }
+func (*PolicerAddDel) GetCrcString() string   { return "2b31dd38" }
+func (*PolicerAddDel) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerAddDel) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 1  // m.IsAdd
+	size += 64 // m.Name
+	size += 4  // m.Cir
+	size += 4  // m.Eir
+	size += 8  // m.Cb
+	size += 8  // m.Eb
+	size += 1  // m.RateType
+	size += 1  // m.RoundType
+	size += 1  // m.Type
+	size += 1  // m.ColorAware
+	size += 1  // m.ConformAction.Type
+	size += 1  // m.ConformAction.Dscp
+	size += 1  // m.ExceedAction.Type
+	size += 1  // m.ExceedAction.Dscp
+	size += 1  // m.ViolateAction.Type
+	size += 1  // m.ViolateAction.Dscp
+	return size
+}
+func (m *PolicerAddDel) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeBool(m.IsAdd)
+	buf.EncodeString(m.Name, 64)
+	buf.EncodeUint32(m.Cir)
+	buf.EncodeUint32(m.Eir)
+	buf.EncodeUint64(m.Cb)
+	buf.EncodeUint64(m.Eb)
+	buf.EncodeUint8(uint8(m.RateType))
+	buf.EncodeUint8(uint8(m.RoundType))
+	buf.EncodeUint8(uint8(m.Type))
+	buf.EncodeBool(m.ColorAware)
+	buf.EncodeUint8(uint8(m.ConformAction.Type))
+	buf.EncodeUint8(m.ConformAction.Dscp)
+	buf.EncodeUint8(uint8(m.ExceedAction.Type))
+	buf.EncodeUint8(m.ExceedAction.Dscp)
+	buf.EncodeUint8(uint8(m.ViolateAction.Type))
+	buf.EncodeUint8(m.ViolateAction.Dscp)
+	return buf.Bytes(), nil
+}
+func (m *PolicerAddDel) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.IsAdd = buf.DecodeBool()
+	m.Name = buf.DecodeString(64)
+	m.Cir = buf.DecodeUint32()
+	m.Eir = buf.DecodeUint32()
+	m.Cb = buf.DecodeUint64()
+	m.Eb = buf.DecodeUint64()
+	m.RateType = policer_types.Sse2QosRateType(buf.DecodeUint8())
+	m.RoundType = policer_types.Sse2QosRoundType(buf.DecodeUint8())
+	m.Type = policer_types.Sse2QosPolicerType(buf.DecodeUint8())
+	m.ColorAware = buf.DecodeBool()
+	m.ConformAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.ConformAction.Dscp = buf.DecodeUint8()
+	m.ExceedAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.ExceedAction.Dscp = buf.DecodeUint8()
+	m.ViolateAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.ViolateAction.Dscp = buf.DecodeUint8()
+	return nil
+}
+
+// Add/del policer response
+//   - retval - return value for request
+//   - policer_index - for add, returned index of the new policer
+//
+// PolicerAddDelReply defines message 'policer_add_del_reply'.
+type PolicerAddDelReply struct {
+	Retval       int32  `binapi:"i32,name=retval" json:"retval,omitempty"`
+	PolicerIndex uint32 `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+}
+
+func (m *PolicerAddDelReply) Reset()               { *m = PolicerAddDelReply{} }
+func (*PolicerAddDelReply) GetMessageName() string { return "policer_add_del_reply" }
+func (*PolicerAddDelReply) GetCrcString() string   { return "a177cef2" }
+func (*PolicerAddDelReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerAddDelReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	size += 4 // m.PolicerIndex
+	return size
+}
+func (m *PolicerAddDelReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	buf.EncodeUint32(m.PolicerIndex)
+	return buf.Bytes(), nil
+}
+func (m *PolicerAddDelReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	m.PolicerIndex = buf.DecodeUint32()
+	return nil
+}
+
+// PolicerAddReply defines message 'policer_add_reply'.
+type PolicerAddReply struct {
+	Retval       int32  `binapi:"i32,name=retval" json:"retval,omitempty"`
+	PolicerIndex uint32 `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+}
+
+func (m *PolicerAddReply) Reset()               { *m = PolicerAddReply{} }
+func (*PolicerAddReply) GetMessageName() string { return "policer_add_reply" }
+func (*PolicerAddReply) GetCrcString() string   { return "a177cef2" }
+func (*PolicerAddReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerAddReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	size += 4 // m.PolicerIndex
+	return size
+}
+func (m *PolicerAddReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	buf.EncodeUint32(m.PolicerIndex)
+	return buf.Bytes(), nil
+}
+func (m *PolicerAddReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	m.PolicerIndex = buf.DecodeUint32()
+	return nil
+}
+
+// policer bind: Associate/disassociate a policer with a worker thread.
+//   - name - policer name to bind
+//   - worker_index - the worker thread to bind to
+//   - bind_enable - Associate/disassociate
+//
+// PolicerBind defines message 'policer_bind'.
+type PolicerBind struct {
+	Name        string `binapi:"string[64],name=name" json:"name,omitempty"`
+	WorkerIndex uint32 `binapi:"u32,name=worker_index" json:"worker_index,omitempty"`
+	BindEnable  bool   `binapi:"bool,name=bind_enable" json:"bind_enable,omitempty"`
+}
+
+func (m *PolicerBind) Reset()               { *m = PolicerBind{} }
+func (*PolicerBind) GetMessageName() string { return "policer_bind" }
+func (*PolicerBind) GetCrcString() string   { return "dcf516f9" }
+func (*PolicerBind) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerBind) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 64 // m.Name
+	size += 4  // m.WorkerIndex
+	size += 1  // m.BindEnable
+	return size
+}
+func (m *PolicerBind) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeString(m.Name, 64)
+	buf.EncodeUint32(m.WorkerIndex)
+	buf.EncodeBool(m.BindEnable)
+	return buf.Bytes(), nil
+}
+func (m *PolicerBind) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Name = buf.DecodeString(64)
+	m.WorkerIndex = buf.DecodeUint32()
+	m.BindEnable = buf.DecodeBool()
+	return nil
+}
+
+// PolicerBindReply defines message 'policer_bind_reply'.
+type PolicerBindReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerBindReply) Reset()               { *m = PolicerBindReply{} }
+func (*PolicerBindReply) GetMessageName() string { return "policer_bind_reply" }
+func (*PolicerBindReply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerBindReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerBindReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerBindReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerBindReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// PolicerBindV2 defines message 'policer_bind_v2'.
+type PolicerBindV2 struct {
+	PolicerIndex uint32 `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+	WorkerIndex  uint32 `binapi:"u32,name=worker_index" json:"worker_index,omitempty"`
+	BindEnable   bool   `binapi:"bool,name=bind_enable" json:"bind_enable,omitempty"`
+}
+
+func (m *PolicerBindV2) Reset()               { *m = PolicerBindV2{} }
+func (*PolicerBindV2) GetMessageName() string { return "policer_bind_v2" }
+func (*PolicerBindV2) GetCrcString() string   { return "f87bd3c0" }
+func (*PolicerBindV2) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerBindV2) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	size += 4 // m.WorkerIndex
+	size += 1 // m.BindEnable
+	return size
+}
+func (m *PolicerBindV2) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	buf.EncodeUint32(m.WorkerIndex)
+	buf.EncodeBool(m.BindEnable)
+	return buf.Bytes(), nil
+}
+func (m *PolicerBindV2) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	m.WorkerIndex = buf.DecodeUint32()
+	m.BindEnable = buf.DecodeBool()
+	return nil
+}
+
+// PolicerBindV2Reply defines message 'policer_bind_v2_reply'.
+type PolicerBindV2Reply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerBindV2Reply) Reset()               { *m = PolicerBindV2Reply{} }
+func (*PolicerBindV2Reply) GetMessageName() string { return "policer_bind_v2_reply" }
+func (*PolicerBindV2Reply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerBindV2Reply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerBindV2Reply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerBindV2Reply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerBindV2Reply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// PolicerDel defines message 'policer_del'.
+type PolicerDel struct {
+	PolicerIndex uint32 `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+}
+
+func (m *PolicerDel) Reset()               { *m = PolicerDel{} }
+func (*PolicerDel) GetMessageName() string { return "policer_del" }
+func (*PolicerDel) GetCrcString() string   { return "7ff7912e" }
+func (*PolicerDel) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerDel) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	return size
+}
+func (m *PolicerDel) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	return buf.Bytes(), nil
+}
+func (m *PolicerDel) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	return nil
+}
+
+// PolicerDelReply defines message 'policer_del_reply'.
+type PolicerDelReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerDelReply) Reset()               { *m = PolicerDelReply{} }
+func (*PolicerDelReply) GetMessageName() string { return "policer_del_reply" }
+func (*PolicerDelReply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerDelReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerDelReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerDelReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerDelReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// Policer operational state response.
+//   - name - policer name
+//   - cir - CIR
+//   - eir - EIR
+//   - cb - Committed Burst
+//   - eb - Excess or Peak Burst
+//   - rate_type - rate type
+//   - round_type - rounding type
+//   - type - policer algorithm
+//   - conform_action - conform action
+//   - exceed_action - exceed action
+//   - violate_action - violate action
+//   - single_rate - 1 = single rate policer, 0 = two rate policer
+//   - color_aware - for hierarchical policing
+//   - scale - power-of-2 shift amount for lower rates
+//   - cir_tokens_per_period - number of tokens for each period
+//   - pir_tokens_per_period - number of tokens for each period for 2-rate policer
+//   - current_limit - current limit
+//   - current_bucket - current bucket
+//   - extended_limit - extended limit
+//   - extended_bucket - extended bucket
+//   - last_update_time - last update time
+//
+// PolicerDetails defines message 'policer_details'.
+type PolicerDetails struct {
+	Name               string                           `binapi:"string[64],name=name" json:"name,omitempty"`
+	Cir                uint32                           `binapi:"u32,name=cir" json:"cir,omitempty"`
+	Eir                uint32                           `binapi:"u32,name=eir" json:"eir,omitempty"`
+	Cb                 uint64                           `binapi:"u64,name=cb" json:"cb,omitempty"`
+	Eb                 uint64                           `binapi:"u64,name=eb" json:"eb,omitempty"`
+	RateType           policer_types.Sse2QosRateType    `binapi:"sse2_qos_rate_type,name=rate_type" json:"rate_type,omitempty"`
+	RoundType          policer_types.Sse2QosRoundType   `binapi:"sse2_qos_round_type,name=round_type" json:"round_type,omitempty"`
+	Type               policer_types.Sse2QosPolicerType `binapi:"sse2_qos_policer_type,name=type" json:"type,omitempty"`
+	ConformAction      policer_types.Sse2QosAction      `binapi:"sse2_qos_action,name=conform_action" json:"conform_action,omitempty"`
+	ExceedAction       policer_types.Sse2QosAction      `binapi:"sse2_qos_action,name=exceed_action" json:"exceed_action,omitempty"`
+	ViolateAction      policer_types.Sse2QosAction      `binapi:"sse2_qos_action,name=violate_action" json:"violate_action,omitempty"`
+	SingleRate         bool                             `binapi:"bool,name=single_rate" json:"single_rate,omitempty"`
+	ColorAware         bool                             `binapi:"bool,name=color_aware" json:"color_aware,omitempty"`
+	Scale              uint32                           `binapi:"u32,name=scale" json:"scale,omitempty"`
+	CirTokensPerPeriod uint32                           `binapi:"u32,name=cir_tokens_per_period" json:"cir_tokens_per_period,omitempty"`
+	PirTokensPerPeriod uint32                           `binapi:"u32,name=pir_tokens_per_period" json:"pir_tokens_per_period,omitempty"`
+	CurrentLimit       uint32                           `binapi:"u32,name=current_limit" json:"current_limit,omitempty"`
+	CurrentBucket      uint32                           `binapi:"u32,name=current_bucket" json:"current_bucket,omitempty"`
+	ExtendedLimit      uint32                           `binapi:"u32,name=extended_limit" json:"extended_limit,omitempty"`
+	ExtendedBucket     uint32                           `binapi:"u32,name=extended_bucket" json:"extended_bucket,omitempty"`
+	LastUpdateTime     uint64                           `binapi:"u64,name=last_update_time" json:"last_update_time,omitempty"`
+}
+
+func (m *PolicerDetails) Reset()               { *m = PolicerDetails{} }
+func (*PolicerDetails) GetMessageName() string { return "policer_details" }
+func (*PolicerDetails) GetCrcString() string   { return "72d0e248" }
+func (*PolicerDetails) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerDetails) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 64 // m.Name
+	size += 4  // m.Cir
+	size += 4  // m.Eir
+	size += 8  // m.Cb
+	size += 8  // m.Eb
+	size += 1  // m.RateType
+	size += 1  // m.RoundType
+	size += 1  // m.Type
+	size += 1  // m.ConformAction.Type
+	size += 1  // m.ConformAction.Dscp
+	size += 1  // m.ExceedAction.Type
+	size += 1  // m.ExceedAction.Dscp
+	size += 1  // m.ViolateAction.Type
+	size += 1  // m.ViolateAction.Dscp
+	size += 1  // m.SingleRate
+	size += 1  // m.ColorAware
+	size += 4  // m.Scale
+	size += 4  // m.CirTokensPerPeriod
+	size += 4  // m.PirTokensPerPeriod
+	size += 4  // m.CurrentLimit
+	size += 4  // m.CurrentBucket
+	size += 4  // m.ExtendedLimit
+	size += 4  // m.ExtendedBucket
+	size += 8  // m.LastUpdateTime
+	return size
+}
+func (m *PolicerDetails) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeString(m.Name, 64)
+	buf.EncodeUint32(m.Cir)
+	buf.EncodeUint32(m.Eir)
+	buf.EncodeUint64(m.Cb)
+	buf.EncodeUint64(m.Eb)
+	buf.EncodeUint8(uint8(m.RateType))
+	buf.EncodeUint8(uint8(m.RoundType))
+	buf.EncodeUint8(uint8(m.Type))
+	buf.EncodeUint8(uint8(m.ConformAction.Type))
+	buf.EncodeUint8(m.ConformAction.Dscp)
+	buf.EncodeUint8(uint8(m.ExceedAction.Type))
+	buf.EncodeUint8(m.ExceedAction.Dscp)
+	buf.EncodeUint8(uint8(m.ViolateAction.Type))
+	buf.EncodeUint8(m.ViolateAction.Dscp)
+	buf.EncodeBool(m.SingleRate)
+	buf.EncodeBool(m.ColorAware)
+	buf.EncodeUint32(m.Scale)
+	buf.EncodeUint32(m.CirTokensPerPeriod)
+	buf.EncodeUint32(m.PirTokensPerPeriod)
+	buf.EncodeUint32(m.CurrentLimit)
+	buf.EncodeUint32(m.CurrentBucket)
+	buf.EncodeUint32(m.ExtendedLimit)
+	buf.EncodeUint32(m.ExtendedBucket)
+	buf.EncodeUint64(m.LastUpdateTime)
+	return buf.Bytes(), nil
+}
+func (m *PolicerDetails) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Name = buf.DecodeString(64)
+	m.Cir = buf.DecodeUint32()
+	m.Eir = buf.DecodeUint32()
+	m.Cb = buf.DecodeUint64()
+	m.Eb = buf.DecodeUint64()
+	m.RateType = policer_types.Sse2QosRateType(buf.DecodeUint8())
+	m.RoundType = policer_types.Sse2QosRoundType(buf.DecodeUint8())
+	m.Type = policer_types.Sse2QosPolicerType(buf.DecodeUint8())
+	m.ConformAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.ConformAction.Dscp = buf.DecodeUint8()
+	m.ExceedAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.ExceedAction.Dscp = buf.DecodeUint8()
+	m.ViolateAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.ViolateAction.Dscp = buf.DecodeUint8()
+	m.SingleRate = buf.DecodeBool()
+	m.ColorAware = buf.DecodeBool()
+	m.Scale = buf.DecodeUint32()
+	m.CirTokensPerPeriod = buf.DecodeUint32()
+	m.PirTokensPerPeriod = buf.DecodeUint32()
+	m.CurrentLimit = buf.DecodeUint32()
+	m.CurrentBucket = buf.DecodeUint32()
+	m.ExtendedLimit = buf.DecodeUint32()
+	m.ExtendedBucket = buf.DecodeUint32()
+	m.LastUpdateTime = buf.DecodeUint64()
+	return nil
+}
+
+// Get list of policers
+//   - match_name_valid - if 0 request all policers otherwise use match_name
+//   - match_name - policer name
+//
+// PolicerDump defines message 'policer_dump'.
+type PolicerDump struct {
+	MatchNameValid bool   `binapi:"bool,name=match_name_valid" json:"match_name_valid,omitempty"`
+	MatchName      string `binapi:"string[64],name=match_name" json:"match_name,omitempty"`
+}
+
+func (m *PolicerDump) Reset()               { *m = PolicerDump{} }
+func (*PolicerDump) GetMessageName() string { return "policer_dump" }
+func (*PolicerDump) GetCrcString() string   { return "35f1ae0f" }
+func (*PolicerDump) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerDump) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 1  // m.MatchNameValid
+	size += 64 // m.MatchName
+	return size
+}
+func (m *PolicerDump) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeBool(m.MatchNameValid)
+	buf.EncodeString(m.MatchName, 64)
+	return buf.Bytes(), nil
+}
+func (m *PolicerDump) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.MatchNameValid = buf.DecodeBool()
+	m.MatchName = buf.DecodeString(64)
+	return nil
+}
+
+// Get list of policers
+//   - policer_index - index of policer in the pool, ~0 to request all
+//
+// PolicerDumpV2 defines message 'policer_dump_v2'.
+type PolicerDumpV2 struct {
+	PolicerIndex uint32 `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+}
+
+func (m *PolicerDumpV2) Reset()               { *m = PolicerDumpV2{} }
+func (*PolicerDumpV2) GetMessageName() string { return "policer_dump_v2" }
+func (*PolicerDumpV2) GetCrcString() string   { return "7ff7912e" }
+func (*PolicerDumpV2) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerDumpV2) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	return size
+}
+func (m *PolicerDumpV2) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	return buf.Bytes(), nil
+}
+func (m *PolicerDumpV2) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	return nil
+}
+
+// policer input: Apply policer as an input feature.
+//   - name - policer name
+//   - sw_if_index - interface to apply the policer
+//   - apply - Apply/remove
+//
+// PolicerInput defines message 'policer_input'.
+type PolicerInput struct {
+	Name      string                         `binapi:"string[64],name=name" json:"name,omitempty"`
+	SwIfIndex interface_types.InterfaceIndex `binapi:"interface_index,name=sw_if_index" json:"sw_if_index,omitempty"`
+	Apply     bool                           `binapi:"bool,name=apply" json:"apply,omitempty"`
+}
+
+func (m *PolicerInput) Reset()               { *m = PolicerInput{} }
+func (*PolicerInput) GetMessageName() string { return "policer_input" }
+func (*PolicerInput) GetCrcString() string   { return "233f0ef5" }
+func (*PolicerInput) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerInput) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 64 // m.Name
+	size += 4  // m.SwIfIndex
+	size += 1  // m.Apply
+	return size
+}
+func (m *PolicerInput) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeString(m.Name, 64)
+	buf.EncodeUint32(uint32(m.SwIfIndex))
+	buf.EncodeBool(m.Apply)
+	return buf.Bytes(), nil
+}
+func (m *PolicerInput) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Name = buf.DecodeString(64)
+	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
+	m.Apply = buf.DecodeBool()
+	return nil
+}
+
+// PolicerInputReply defines message 'policer_input_reply'.
+type PolicerInputReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerInputReply) Reset()               { *m = PolicerInputReply{} }
+func (*PolicerInputReply) GetMessageName() string { return "policer_input_reply" }
+func (*PolicerInputReply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerInputReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerInputReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerInputReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerInputReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// PolicerInputV2 defines message 'policer_input_v2'.
+type PolicerInputV2 struct {
+	PolicerIndex uint32                         `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+	SwIfIndex    interface_types.InterfaceIndex `binapi:"interface_index,name=sw_if_index" json:"sw_if_index,omitempty"`
+	Apply        bool                           `binapi:"bool,name=apply" json:"apply,omitempty"`
+}
+
+func (m *PolicerInputV2) Reset()               { *m = PolicerInputV2{} }
+func (*PolicerInputV2) GetMessageName() string { return "policer_input_v2" }
+func (*PolicerInputV2) GetCrcString() string   { return "8388eb84" }
+func (*PolicerInputV2) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerInputV2) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	size += 4 // m.SwIfIndex
+	size += 1 // m.Apply
+	return size
+}
+func (m *PolicerInputV2) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	buf.EncodeUint32(uint32(m.SwIfIndex))
+	buf.EncodeBool(m.Apply)
+	return buf.Bytes(), nil
+}
+func (m *PolicerInputV2) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
+	m.Apply = buf.DecodeBool()
+	return nil
+}
+
+// PolicerInputV2Reply defines message 'policer_input_v2_reply'.
+type PolicerInputV2Reply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerInputV2Reply) Reset()               { *m = PolicerInputV2Reply{} }
+func (*PolicerInputV2Reply) GetMessageName() string { return "policer_input_v2_reply" }
+func (*PolicerInputV2Reply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerInputV2Reply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerInputV2Reply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerInputV2Reply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerInputV2Reply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// policer output: Apply policer as an output feature.
+//   - name - policer name
+//   - sw_if_index - interface to apply the policer
+//   - apply - Apply/remove
+//
+// PolicerOutput defines message 'policer_output'.
+type PolicerOutput struct {
+	Name      string                         `binapi:"string[64],name=name" json:"name,omitempty"`
+	SwIfIndex interface_types.InterfaceIndex `binapi:"interface_index,name=sw_if_index" json:"sw_if_index,omitempty"`
+	Apply     bool                           `binapi:"bool,name=apply" json:"apply,omitempty"`
+}
+
+func (m *PolicerOutput) Reset()               { *m = PolicerOutput{} }
+func (*PolicerOutput) GetMessageName() string { return "policer_output" }
+func (*PolicerOutput) GetCrcString() string   { return "233f0ef5" }
+func (*PolicerOutput) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerOutput) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 64 // m.Name
+	size += 4  // m.SwIfIndex
+	size += 1  // m.Apply
+	return size
+}
+func (m *PolicerOutput) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeString(m.Name, 64)
+	buf.EncodeUint32(uint32(m.SwIfIndex))
+	buf.EncodeBool(m.Apply)
+	return buf.Bytes(), nil
+}
+func (m *PolicerOutput) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Name = buf.DecodeString(64)
+	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
+	m.Apply = buf.DecodeBool()
+	return nil
+}
+
+// PolicerOutputReply defines message 'policer_output_reply'.
+type PolicerOutputReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerOutputReply) Reset()               { *m = PolicerOutputReply{} }
+func (*PolicerOutputReply) GetMessageName() string { return "policer_output_reply" }
+func (*PolicerOutputReply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerOutputReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerOutputReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerOutputReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerOutputReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// PolicerOutputV2 defines message 'policer_output_v2'.
+type PolicerOutputV2 struct {
+	PolicerIndex uint32                         `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+	SwIfIndex    interface_types.InterfaceIndex `binapi:"interface_index,name=sw_if_index" json:"sw_if_index,omitempty"`
+	Apply        bool                           `binapi:"bool,name=apply" json:"apply,omitempty"`
+}
+
+func (m *PolicerOutputV2) Reset()               { *m = PolicerOutputV2{} }
+func (*PolicerOutputV2) GetMessageName() string { return "policer_output_v2" }
+func (*PolicerOutputV2) GetCrcString() string   { return "8388eb84" }
+func (*PolicerOutputV2) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerOutputV2) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	size += 4 // m.SwIfIndex
+	size += 1 // m.Apply
+	return size
+}
+func (m *PolicerOutputV2) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	buf.EncodeUint32(uint32(m.SwIfIndex))
+	buf.EncodeBool(m.Apply)
+	return buf.Bytes(), nil
+}
+func (m *PolicerOutputV2) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	m.SwIfIndex = interface_types.InterfaceIndex(buf.DecodeUint32())
+	m.Apply = buf.DecodeBool()
+	return nil
+}
+
+// PolicerOutputV2Reply defines message 'policer_output_v2_reply'.
+type PolicerOutputV2Reply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerOutputV2Reply) Reset()               { *m = PolicerOutputV2Reply{} }
+func (*PolicerOutputV2Reply) GetMessageName() string { return "policer_output_v2_reply" }
+func (*PolicerOutputV2Reply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerOutputV2Reply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerOutputV2Reply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerOutputV2Reply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerOutputV2Reply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// PolicerReset defines message 'policer_reset'.
+type PolicerReset struct {
+	PolicerIndex uint32 `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+}
+
+func (m *PolicerReset) Reset()               { *m = PolicerReset{} }
+func (*PolicerReset) GetMessageName() string { return "policer_reset" }
+func (*PolicerReset) GetCrcString() string   { return "7ff7912e" }
+func (*PolicerReset) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerReset) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	return size
+}
+func (m *PolicerReset) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	return buf.Bytes(), nil
+}
+func (m *PolicerReset) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	return nil
+}
+
+// PolicerResetReply defines message 'policer_reset_reply'.
+type PolicerResetReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerResetReply) Reset()               { *m = PolicerResetReply{} }
+func (*PolicerResetReply) GetMessageName() string { return "policer_reset_reply" }
+func (*PolicerResetReply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerResetReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerResetReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerResetReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerResetReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+// PolicerUpdate defines message 'policer_update'.
+type PolicerUpdate struct {
+	PolicerIndex uint32                      `binapi:"u32,name=policer_index" json:"policer_index,omitempty"`
+	Infos        policer_types.PolicerConfig `binapi:"policer_config,name=infos" json:"infos,omitempty"`
+}
+
+func (m *PolicerUpdate) Reset()               { *m = PolicerUpdate{} }
+func (*PolicerUpdate) GetMessageName() string { return "policer_update" }
+func (*PolicerUpdate) GetCrcString() string   { return "fd039ef0" }
+func (*PolicerUpdate) GetMessageType() api.MessageType {
+	return api.RequestMessage
+}
+
+func (m *PolicerUpdate) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.PolicerIndex
+	size += 4 // m.Infos.Cir
+	size += 4 // m.Infos.Eir
+	size += 8 // m.Infos.Cb
+	size += 8 // m.Infos.Eb
+	size += 1 // m.Infos.RateType
+	size += 1 // m.Infos.RoundType
+	size += 1 // m.Infos.Type
+	size += 1 // m.Infos.ColorAware
+	size += 1 // m.Infos.ConformAction.Type
+	size += 1 // m.Infos.ConformAction.Dscp
+	size += 1 // m.Infos.ExceedAction.Type
+	size += 1 // m.Infos.ExceedAction.Dscp
+	size += 1 // m.Infos.ViolateAction.Type
+	size += 1 // m.Infos.ViolateAction.Dscp
+	return size
+}
+func (m *PolicerUpdate) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeUint32(m.PolicerIndex)
+	buf.EncodeUint32(m.Infos.Cir)
+	buf.EncodeUint32(m.Infos.Eir)
+	buf.EncodeUint64(m.Infos.Cb)
+	buf.EncodeUint64(m.Infos.Eb)
+	buf.EncodeUint8(uint8(m.Infos.RateType))
+	buf.EncodeUint8(uint8(m.Infos.RoundType))
+	buf.EncodeUint8(uint8(m.Infos.Type))
+	buf.EncodeBool(m.Infos.ColorAware)
+	buf.EncodeUint8(uint8(m.Infos.ConformAction.Type))
+	buf.EncodeUint8(m.Infos.ConformAction.Dscp)
+	buf.EncodeUint8(uint8(m.Infos.ExceedAction.Type))
+	buf.EncodeUint8(m.Infos.ExceedAction.Dscp)
+	buf.EncodeUint8(uint8(m.Infos.ViolateAction.Type))
+	buf.EncodeUint8(m.Infos.ViolateAction.Dscp)
+	return buf.Bytes(), nil
+}
+func (m *PolicerUpdate) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.PolicerIndex = buf.DecodeUint32()
+	m.Infos.Cir = buf.DecodeUint32()
+	m.Infos.Eir = buf.DecodeUint32()
+	m.Infos.Cb = buf.DecodeUint64()
+	m.Infos.Eb = buf.DecodeUint64()
+	m.Infos.RateType = policer_types.Sse2QosRateType(buf.DecodeUint8())
+	m.Infos.RoundType = policer_types.Sse2QosRoundType(buf.DecodeUint8())
+	m.Infos.Type = policer_types.Sse2QosPolicerType(buf.DecodeUint8())
+	m.Infos.ColorAware = buf.DecodeBool()
+	m.Infos.ConformAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.Infos.ConformAction.Dscp = buf.DecodeUint8()
+	m.Infos.ExceedAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.Infos.ExceedAction.Dscp = buf.DecodeUint8()
+	m.Infos.ViolateAction.Type = policer_types.Sse2QosActionType(buf.DecodeUint8())
+	m.Infos.ViolateAction.Dscp = buf.DecodeUint8()
+	return nil
+}
+
+// PolicerUpdateReply defines message 'policer_update_reply'.
+type PolicerUpdateReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *PolicerUpdateReply) Reset()               { *m = PolicerUpdateReply{} }
+func (*PolicerUpdateReply) GetMessageName() string { return "policer_update_reply" }
+func (*PolicerUpdateReply) GetCrcString() string   { return "e8d4e804" }
+func (*PolicerUpdateReply) GetMessageType() api.MessageType {
+	return api.ReplyMessage
+}
+
+func (m *PolicerUpdateReply) Size() (size int) {
+	if m == nil {
+		return 0
+	}
+	size += 4 // m.Retval
+	return size
+}
+func (m *PolicerUpdateReply) Marshal(b []byte) ([]byte, error) {
+	if b == nil {
+		b = make([]byte, m.Size())
+	}
+	buf := codec.NewBuffer(b)
+	buf.EncodeInt32(m.Retval)
+	return buf.Bytes(), nil
+}
+func (m *PolicerUpdateReply) Unmarshal(b []byte) error {
+	buf := codec.NewBuffer(b)
+	m.Retval = buf.DecodeInt32()
+	return nil
+}
+
+func init() { file_policer_binapi_init() }
+func file_policer_binapi_init() {
+	api.RegisterMessage((*PolicerAdd)(nil), "policer_add_4d949e35")
+	api.RegisterMessage((*PolicerAddDel)(nil), "policer_add_del_2b31dd38")
+	api.RegisterMessage((*PolicerAddDelReply)(nil), "policer_add_del_reply_a177cef2")
+	api.RegisterMessage((*PolicerAddReply)(nil), "policer_add_reply_a177cef2")
+	api.RegisterMessage((*PolicerBind)(nil), "policer_bind_dcf516f9")
+	api.RegisterMessage((*PolicerBindReply)(nil), "policer_bind_reply_e8d4e804")
+	api.RegisterMessage((*PolicerBindV2)(nil), "policer_bind_v2_f87bd3c0")
+	api.RegisterMessage((*PolicerBindV2Reply)(nil), "policer_bind_v2_reply_e8d4e804")
+	api.RegisterMessage((*PolicerDel)(nil), "policer_del_7ff7912e")
+	api.RegisterMessage((*PolicerDelReply)(nil), "policer_del_reply_e8d4e804")
+	api.RegisterMessage((*PolicerDetails)(nil), "policer_details_72d0e248")
+	api.RegisterMessage((*PolicerDump)(nil), "policer_dump_35f1ae0f")
+	api.RegisterMessage((*PolicerDumpV2)(nil), "policer_dump_v2_7ff7912e")
+	api.RegisterMessage((*PolicerInput)(nil), "policer_input_233f0ef5")
+	api.RegisterMessage((*PolicerInputReply)(nil), "policer_input_reply_e8d4e804")
+	api.RegisterMessage((*PolicerInputV2)(nil), "policer_input_v2_8388eb84")
+	api.RegisterMessage((*PolicerInputV2Reply)(nil), "policer_input_v2_reply_e8d4e804")
+	api.RegisterMessage((*PolicerOutput)(nil), "policer_output_233f0ef5")
+	api.RegisterMessage((*PolicerOutputReply)(nil), "policer_output_reply_e8d4e804")
+	api.RegisterMessage((*PolicerOutputV2)(nil), "policer_output_v2_8388eb84")
+	api.RegisterMessage((*PolicerOutputV2Reply)(nil), "policer_output_v2_reply_e8d4e804")
+	api.RegisterMessage((*PolicerReset)(nil), "policer_reset_7ff7912e")
+	api.RegisterMessage((*PolicerResetReply)(nil), "policer_reset_reply_e8d4e804")
+	api.RegisterMessage((*PolicerUpdate)(nil), "policer_update_fd039ef0")
+	api.RegisterMessage((*PolicerUpdateReply)(nil), "policer_update_reply_e8d4e804")
+}
+
+// Messages returns list of all messages in this module.
+func AllMessages() []api.Message {
+	return []api.Message{
+		(*PolicerAdd)(nil),
+		(*PolicerAddDel)(nil),
+		(*PolicerAddDelReply)(nil),
+		(*PolicerAddReply)(nil),
+		(*PolicerBind)(nil),
+		(*PolicerBindReply)(nil),
+		(*PolicerBindV2)(nil),
+		(*PolicerBindV2Reply)(nil),
+		(*PolicerDel)(nil),
+		(*PolicerDelReply)(nil),
+		(*PolicerDetails)(nil),
+		(*PolicerDump)(nil),
+		(*PolicerDumpV2)(nil),
+		(*PolicerInput)(nil),
+		(*PolicerInputReply)(nil),
+		(*PolicerInputV2)(nil),
+		(*PolicerInputV2Reply)(nil),
+		(*PolicerOutput)(nil),
+		(*PolicerOutputReply)(nil),
+		(*PolicerOutputV2)(nil),
+		(*PolicerOutputV2Reply)(nil),
+		(*PolicerReset)(nil),
+		(*PolicerResetReply)(nil),
+		(*PolicerUpdate)(nil),
+		(*PolicerUpdateReply)(nil),
+	}
+}
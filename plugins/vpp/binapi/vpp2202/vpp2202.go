@@ -21,12 +21,18 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/acl"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/arp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bfd"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/crypto"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/flowprobe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gtpu"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ikev2"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip6_nd"
@@ -35,19 +41,29 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ipip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2tp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l3xc"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/mpls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat44_ed"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat44_ei"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat66"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/pppoe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/punt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/qos"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rdma"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/session"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/span"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/sr"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/stn"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/tapv2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/teib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/tracedump"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vlib"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vpe"
@@ -65,7 +81,10 @@ func init() {
 		Core: vpp.Messages(
 			af_packet.AllMessages,
 			arp.AllMessages,
+			bfd.AllMessages,
 			bond.AllMessages,
+			classify.AllMessages,
+			crypto.AllMessages,
 			gre.AllMessages,
 			interfaces.AllMessages,
 			ip.AllMessages,
@@ -76,8 +95,12 @@ func init() {
 			ipsec.AllMessages,
 			l2.AllMessages,
 			memclnt.AllMessages,
+			mpls.AllMessages,
+			policer.AllMessages,
 			punt.AllMessages,
+			qos.AllMessages,
 			rd_cp.AllMessages,
+			session.AllMessages,
 			span.AllMessages,
 			sr.AllMessages,
 			tapv2.AllMessages,
@@ -90,16 +113,25 @@ func init() {
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			cnat.AllMessages,
 			dhcp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
+			geneve.AllMessages,
 			gtpu.AllMessages,
+			ikev2.AllMessages,
+			l2tp.AllMessages,
 			l3xc.AllMessages,
+			lcp.AllMessages,
 			memif.AllMessages,
 			nat44_ed.AllMessages,
 			nat44_ei.AllMessages,
+			nat64.AllMessages,
+			nat66.AllMessages,
+			pppoe.AllMessages,
 			rdma.AllMessages,
 			stn.AllMessages,
+			tracedump.AllMessages,
 			vmxnet3.AllMessages,
 			vrrp.AllMessages,
 			wireguard.AllMessages,
@@ -21,12 +21,18 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/acl"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/arp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/bfd"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/crypto"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/flowprobe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/gtpu"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip6_nd"
@@ -35,19 +41,29 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ipip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/l2tp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/l3xc"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/lcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/mpls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ed"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ei"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat66"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/pppoe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/punt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/qos"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/rdma"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/session"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/span"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/sr"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/stn"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/tapv2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/teib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/tracedump"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vlib"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vpe"
@@ -65,7 +81,10 @@ func init() {
 		Core: vpp.Messages(
 			af_packet.AllMessages,
 			arp.AllMessages,
+			bfd.AllMessages,
 			bond.AllMessages,
+			classify.AllMessages,
+			crypto.AllMessages,
 			gre.AllMessages,
 			interfaces.AllMessages,
 			ip.AllMessages,
@@ -76,8 +95,12 @@ func init() {
 			ipsec.AllMessages,
 			l2.AllMessages,
 			memclnt.AllMessages,
+			mpls.AllMessages,
+			policer.AllMessages,
 			punt.AllMessages,
+			qos.AllMessages,
 			rd_cp.AllMessages,
+			session.AllMessages,
 			span.AllMessages,
 			sr.AllMessages,
 			tapv2.AllMessages,
@@ -90,16 +113,25 @@ func init() {
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			cnat.AllMessages,
 			dhcp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
+			geneve.AllMessages,
 			gtpu.AllMessages,
+			ikev2.AllMessages,
+			l2tp.AllMessages,
 			l3xc.AllMessages,
+			lcp.AllMessages,
 			memif.AllMessages,
 			nat44_ed.AllMessages,
 			nat44_ei.AllMessages,
+			nat64.AllMessages,
+			nat66.AllMessages,
+			pppoe.AllMessages,
 			rdma.AllMessages,
 			stn.AllMessages,
+			tracedump.AllMessages,
 			vmxnet3.AllMessages,
 			vrrp.AllMessages,
 			wireguard.AllMessages,
@@ -21,12 +21,19 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/acl"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/arp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/bfd"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/crypto"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp6_pd_client_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/flowprobe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/gtpu"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ikev2"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip6_nd"
@@ -35,19 +42,29 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ipip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2tp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l3xc"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mpls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/nat44_ed"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/nat44_ei"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/nat66"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/pppoe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/punt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/qos"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/rdma"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/session"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/span"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/sr"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/stn"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/tapv2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/teib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/tracedump"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vlib"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vpe"
@@ -65,7 +82,10 @@ func init() {
 		Core: vpp.Messages(
 			af_packet.AllMessages,
 			arp.AllMessages,
+			bfd.AllMessages,
 			bond.AllMessages,
+			classify.AllMessages,
+			crypto.AllMessages,
 			gre.AllMessages,
 			interfaces.AllMessages,
 			ip.AllMessages,
@@ -76,8 +96,12 @@ func init() {
 			ipsec.AllMessages,
 			l2.AllMessages,
 			memclnt.AllMessages,
+			mpls.AllMessages,
+			policer.AllMessages,
 			punt.AllMessages,
+			qos.AllMessages,
 			rd_cp.AllMessages,
+			session.AllMessages,
 			span.AllMessages,
 			sr.AllMessages,
 			tapv2.AllMessages,
@@ -90,16 +114,26 @@ func init() {
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			cnat.AllMessages,
 			dhcp.AllMessages,
+			dhcp6_pd_client_cp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
+			geneve.AllMessages,
 			gtpu.AllMessages,
+			ikev2.AllMessages,
+			l2tp.AllMessages,
 			l3xc.AllMessages,
+			lcp.AllMessages,
 			memif.AllMessages,
 			nat44_ed.AllMessages,
 			nat44_ei.AllMessages,
+			nat64.AllMessages,
+			nat66.AllMessages,
+			pppoe.AllMessages,
 			rdma.AllMessages,
 			stn.AllMessages,
+			tracedump.AllMessages,
 			vmxnet3.AllMessages,
 			vrrp.AllMessages,
 			wireguard.AllMessages,
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name ClassifyTable --value-type *vpp_classifier.ClassifyTable --meta-type *idxvpp.OnlyIndex --import "go.ligato.io/vpp-agent/v3/pkg/idxvpp" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ClassifySession --value-type *vpp_classifier.ClassifySession --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name PolicerClassify --value-type *vpp_classifier.PolicerClassify --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier" --output-dir "descriptor"
+
+package classifyplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2306"
+)
+
+// ClassifyPlugin is a plugin that manages VPP classify tables and sessions.
+type ClassifyPlugin struct {
+	Deps
+
+	classifyHandler vppcalls.ClassifyVppAPI
+
+	// index maps
+	tableIndex idxvpp.NameToIndex
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler   kvs.KVScheduler
+	VPP           govppmux.API
+	IfPlugin      ifplugin.API
+	PolicerPlugin PolicerIndexProvider           // optional
+	StatusCheck   statuscheck.PluginStatusWriter // optional
+}
+
+// PolicerIndexProvider provides index of VPP policers.
+type PolicerIndexProvider interface {
+	// GetPolicerIndex gives read-only access to map with indexes of all
+	// configured VPP policers.
+	GetPolicerIndex() idxvpp.NameToIndex
+}
+
+// Init initializes classify plugin.
+func (p *ClassifyPlugin) Init() (err error) {
+	// init handler
+	p.classifyHandler = vppcalls.CompatibleClassifyVppHandler(p.VPP, p.Log)
+	if p.classifyHandler == nil {
+		p.Log.Warnf("Classify handler is not available for this VPP version, classifier is disabled")
+		return nil
+	}
+
+	// init & register table descriptor
+	tableDescriptor, tableDescrCtx := descriptor.NewClassifyTableDescriptor(p.classifyHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(tableDescriptor); err != nil {
+		return err
+	}
+
+	// obtain read-only reference to index map
+	var withIndex bool
+	metadataMap := p.KVScheduler.GetMetadataMap(descriptor.ClassifyTableDescriptorName)
+	p.tableIndex, withIndex = metadataMap.(idxvpp.NameToIndex)
+	if !withIndex {
+		return errors.New("missing index with classify table metadata")
+	}
+	tableDescrCtx.SetTableIndex(p.tableIndex)
+
+	// policers referenced by sessions are optional
+	var policerIndex idxvpp.NameToIndex
+	if p.PolicerPlugin != nil {
+		policerIndex = p.PolicerPlugin.GetPolicerIndex()
+	}
+
+	// init & register descriptors depending on the table index
+	sessionDescriptor := descriptor.NewClassifySessionDescriptor(p.classifyHandler, p.tableIndex, policerIndex, p.Log)
+	tableToIfDescriptor := descriptor.NewClassifyTableToInterfaceDescriptor(p.classifyHandler, p.tableIndex,
+		p.IfPlugin, p.Log)
+	policerClassifyDescriptor := descriptor.NewPolicerClassifyDescriptor(p.classifyHandler, p.tableIndex,
+		p.IfPlugin, p.Log)
+	return p.KVScheduler.RegisterKVDescriptor(sessionDescriptor, tableToIfDescriptor, policerClassifyDescriptor)
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *ClassifyPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// GetClassifyTableIndex returns read-only reference to the index map with
+// classify table indexes assigned by VPP (nil if classifier is not supported).
+func (p *ClassifyPlugin) GetClassifyTableIndex() idxvpp.NameToIndex {
+	return p.tableIndex
+}
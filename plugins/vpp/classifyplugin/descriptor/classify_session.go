@@ -0,0 +1,258 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// ClassifySessionDescriptorName is the name of the descriptor for VPP classify sessions.
+	ClassifySessionDescriptorName = "vpp-classify-session"
+
+	// dependency labels
+	tableDep   = "classify-table-exists"
+	vrfDep     = "vrf-table-exists"
+	policerDep = "policer-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassifySessionWithoutTable is returned when VPP classify session
+	// configuration has undefined Table attribute.
+	ErrClassifySessionWithoutTable = errors.New("VPP classify session defined without table")
+
+	// ErrClassifySessionInvalidMatch is returned when the match is not a valid
+	// hexadecimal string.
+	ErrClassifySessionInvalidMatch = errors.New("VPP classify session match is not a valid non-empty hexadecimal string")
+)
+
+// ClassifySessionDescriptor teaches KVScheduler how to configure VPP classify sessions.
+type ClassifySessionDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+	tableIndex      idxvpp.NameToIndex
+	policerIndex    idxvpp.NameToIndex // nil if policers are not supported
+}
+
+// NewClassifySessionDescriptor creates a new instance of the ClassifySession descriptor.
+func NewClassifySessionDescriptor(classifyHandler vppcalls.ClassifyVppAPI, tableIndex, policerIndex idxvpp.NameToIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &ClassifySessionDescriptor{
+		log:             log.NewLogger("classify-session-descriptor"),
+		classifyHandler: classifyHandler,
+		tableIndex:      tableIndex,
+		policerIndex:    policerIndex,
+	}
+	typedDescr := &adapter.ClassifySessionDescriptor{
+		Name:                 ClassifySessionDescriptorName,
+		NBKeyPrefix:          classifier.ModelClassifySession.KeyPrefix(),
+		ValueTypeName:        classifier.ModelClassifySession.ProtoName(),
+		KeySelector:          classifier.ModelClassifySession.IsKeyValid,
+		KeyLabel:             classifier.ModelClassifySession.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentClassifySessions,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ClassifyTableDescriptorName},
+	}
+	return adapter.NewClassifySessionDescriptor(typedDescr)
+}
+
+// EquivalentClassifySessions compares classify sessions, matches are compared
+// ignoring the letter case and zero padding. Hit action is ignored for sessions
+// with policer.
+func (d *ClassifySessionDescriptor) EquivalentClassifySessions(key string, oldSession, newSession *classifier.ClassifySession) bool {
+	if oldSession.Policer != newSession.Policer {
+		return false
+	}
+	if oldSession.Policer == "" && oldSession.HitAction != newSession.HitAction {
+		return false
+	}
+	return oldSession.Table == newSession.Table &&
+		equivalentHex(oldSession.Match, newSession.Match) &&
+		oldSession.OpaqueIndex == newSession.OpaqueIndex &&
+		oldSession.Advance == newSession.Advance &&
+		oldSession.MetadataAction == newSession.MetadataAction &&
+		oldSession.Metadata == newSession.Metadata
+}
+
+// Validate validates VPP classify session configuration.
+func (d *ClassifySessionDescriptor) Validate(key string, session *classifier.ClassifySession) error {
+	if session.Table == "" {
+		return kvs.NewInvalidValueError(ErrClassifySessionWithoutTable, "table")
+	}
+	if match, err := hex.DecodeString(session.Match); err != nil || len(match) == 0 {
+		return kvs.NewInvalidValueError(ErrClassifySessionInvalidMatch, "match")
+	}
+	return nil
+}
+
+// Create adds new session into VPP classify table.
+func (d *ClassifySessionDescriptor) Create(key string, session *classifier.ClassifySession) (metadata interface{}, err error) {
+	tableIdx, err := d.lookupTable(session.Table)
+	if err != nil {
+		return nil, err
+	}
+	policerIdx := ^uint32(0)
+	if session.Policer != "" {
+		if policerIdx, err = d.lookupPolicer(session.Policer); err != nil {
+			return nil, err
+		}
+	}
+	if err = d.classifyHandler.AddClassifySession(session, tableIdx, policerIdx); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes session from VPP classify table.
+func (d *ClassifySessionDescriptor) Delete(key string, session *classifier.ClassifySession, metadata interface{}) error {
+	tableIdx, err := d.lookupTable(session.Table)
+	if err != nil {
+		return err
+	}
+	if err = d.classifyHandler.DeleteClassifySession(session, tableIdx); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns sessions of all classify tables configured from NB.
+// Metadata actions cannot be dumped, they are therefore taken from NB.
+// Policer is taken from NB if its index matches the dumped hit next index.
+func (d *ClassifySessionDescriptor) Retrieve(correlate []adapter.ClassifySessionKVWithMetadata) (
+	retrieved []adapter.ClassifySessionKVWithMetadata, err error) {
+
+	nbSessions := make(map[string][]*classifier.ClassifySession)
+	for _, kv := range correlate {
+		nbSessions[kv.Value.Table] = append(nbSessions[kv.Value.Table], kv.Value)
+	}
+
+	tables, err := d.classifyHandler.DumpClassifyTables()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump classify tables: %v", err)
+	}
+	for _, table := range tables {
+		tableName, _, exists := d.tableIndex.LookupByIndex(table.Meta.TableIndex)
+		if !exists {
+			// table not configured from NB
+			continue
+		}
+		sessions, err := d.classifyHandler.DumpClassifySessions(table.Meta.TableIndex)
+		if err != nil {
+			return nil, errors.Errorf("failed to dump sessions of classify table %s: %v", tableName, err)
+		}
+		for _, details := range sessions {
+			session := details.Session
+			session.Table = tableName
+			for _, nbSession := range nbSessions[tableName] {
+				if equivalentHex(nbSession.Match, session.Match) {
+					// keep NB formatting of the match
+					session.Match = nbSession.Match
+					session.MetadataAction = nbSession.MetadataAction
+					session.Metadata = nbSession.Metadata
+					if nbSession.Policer != "" && d.policerIndex != nil {
+						policer, exists := d.policerIndex.LookupByName(nbSession.Policer)
+						if exists && policer.GetIndex() == details.Meta.HitNextIndex {
+							session.Policer = nbSession.Policer
+							session.HitAction = nbSession.HitAction
+						}
+					}
+					break
+				}
+			}
+			retrieved = append(retrieved, adapter.ClassifySessionKVWithMetadata{
+				Key:    classifier.SessionKey(session.Table, session.Match),
+				Value:  session,
+				Origin: kvs.FromNB,
+			})
+		}
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the classify table, policer and VRF used by the metadata
+// action as dependencies.
+func (d *ClassifySessionDescriptor) Dependencies(key string, session *classifier.ClassifySession) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: tableDep,
+		Key:   classifier.TableKey(session.Table),
+	})
+	if session.Policer != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: policerDep,
+			Key:   vpp_policer.Key(session.Policer),
+		})
+	}
+	if session.Metadata != 0 {
+		switch session.MetadataAction {
+		case classifier.ClassifySession_SET_IP4_FIB_INDEX:
+			deps = append(deps, kvs.Dependency{
+				Label: vrfDep,
+				Key:   l3.VrfTableKey(session.Metadata, l3.VrfTable_IPV4),
+			})
+		case classifier.ClassifySession_SET_IP6_FIB_INDEX:
+			deps = append(deps, kvs.Dependency{
+				Label: vrfDep,
+				Key:   l3.VrfTableKey(session.Metadata, l3.VrfTable_IPV6),
+			})
+		}
+	}
+	return deps
+}
+
+// lookupTable returns index of the classify table with the given name.
+func (d *ClassifySessionDescriptor) lookupTable(name string) (uint32, error) {
+	table, exists := d.tableIndex.LookupByName(name)
+	if !exists {
+		err := errors.Errorf("failed to obtain index of the classify table %s", name)
+		d.log.Error(err)
+		return 0, err
+	}
+	return table.GetIndex(), nil
+}
+
+// lookupPolicer returns index of the policer with the given name.
+func (d *ClassifySessionDescriptor) lookupPolicer(name string) (uint32, error) {
+	if d.policerIndex == nil {
+		err := errors.Errorf("policers are not supported, cannot apply policer %s", name)
+		d.log.Error(err)
+		return 0, err
+	}
+	policer, exists := d.policerIndex.LookupByName(name)
+	if !exists {
+		err := errors.Errorf("failed to obtain index of the policer %s", name)
+		d.log.Error(err)
+		return 0, err
+	}
+	return policer.GetIndex(), nil
+}
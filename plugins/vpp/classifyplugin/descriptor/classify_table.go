@@ -0,0 +1,298 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+const (
+	// ClassifyTableDescriptorName is the name of the descriptor for VPP classify tables.
+	ClassifyTableDescriptorName = "vpp-classify-table"
+
+	// dependency labels
+	nextTableDep = "next-classify-table-exists"
+
+	// defaults used by VPP for undefined table attributes
+	defaultNBuckets   = 2
+	defaultMemorySize = 2 << 20
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassifyTableWithoutName is returned when VPP classify table configuration
+	// has undefined Name attribute.
+	ErrClassifyTableWithoutName = errors.New("VPP classify table defined without name")
+
+	// ErrClassifyTableInvalidName is returned when VPP classify table name cannot
+	// be used as part of the key.
+	ErrClassifyTableInvalidName = errors.New("VPP classify table name contains forward slash")
+
+	// ErrClassifyTableInvalidMask is returned when the mask is not a valid
+	// hexadecimal string or does not match anything.
+	ErrClassifyTableInvalidMask = errors.New("VPP classify table mask is not a valid non-zero hexadecimal string")
+
+	// ErrClassifyTableChainedToItself is returned when the table references
+	// itself as the next table.
+	ErrClassifyTableChainedToItself = errors.New("VPP classify table cannot be chained to itself")
+
+	// ErrClassifyTableInterfaceWithoutName is returned when classify table
+	// is applied on interface with undefined name.
+	ErrClassifyTableInterfaceWithoutName = errors.New("VPP classify table applied on interface without name")
+)
+
+// ClassifyTableDescriptor teaches KVScheduler how to configure VPP classify tables.
+type ClassifyTableDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+	tableIndex      idxvpp.NameToIndex
+}
+
+// NewClassifyTableDescriptor creates a new instance of the ClassifyTable descriptor.
+func NewClassifyTableDescriptor(classifyHandler vppcalls.ClassifyVppAPI, log logging.PluginLogger) (
+	*kvs.KVDescriptor, *ClassifyTableDescriptor) {
+
+	ctx := &ClassifyTableDescriptor{
+		log:             log.NewLogger("classify-table-descriptor"),
+		classifyHandler: classifyHandler,
+	}
+	typedDescr := &adapter.ClassifyTableDescriptor{
+		Name:                 ClassifyTableDescriptorName,
+		NBKeyPrefix:          classifier.ModelClassifyTable.KeyPrefix(),
+		ValueTypeName:        classifier.ModelClassifyTable.ProtoName(),
+		KeySelector:          classifier.ModelClassifyTable.IsKeyValid,
+		KeyLabel:             classifier.ModelClassifyTable.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentClassifyTables,
+		WithMetadata:         true,
+		MetadataMapFactory:   ctx.MetadataFactory,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		DerivedValues:        ctx.DerivedValues,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewClassifyTableDescriptor(typedDescr), ctx
+}
+
+// SetTableIndex should be used to provide classify table index immediately
+// after the descriptor registration.
+func (d *ClassifyTableDescriptor) SetTableIndex(tableIndex idxvpp.NameToIndex) {
+	d.tableIndex = tableIndex
+}
+
+// EquivalentClassifyTables compares classify table attributes, undefined values
+// are treated as equal to the VPP defaults. Interfaces are compared via derived
+// values.
+func (d *ClassifyTableDescriptor) EquivalentClassifyTables(key string, oldTable, newTable *classifier.ClassifyTable) bool {
+	return equivalentTableAttrs(oldTable, newTable) &&
+		withDefault(oldTable.MemorySize, defaultMemorySize) == withDefault(newTable.MemorySize, defaultMemorySize) &&
+		oldTable.NextTable == newTable.NextTable
+}
+
+// MetadataFactory is a factory for index-map customized for VPP classify tables.
+func (d *ClassifyTableDescriptor) MetadataFactory() idxmap.NamedMappingRW {
+	return idxvpp.NewNameToIndex(d.log, "vpp-classify-table-index", nil)
+}
+
+// Validate validates VPP classify table configuration.
+func (d *ClassifyTableDescriptor) Validate(key string, table *classifier.ClassifyTable) error {
+	if table.Name == "" {
+		return kvs.NewInvalidValueError(ErrClassifyTableWithoutName, "name")
+	}
+	if strings.Contains(table.Name, "/") {
+		return kvs.NewInvalidValueError(ErrClassifyTableInvalidName, "name")
+	}
+	if mask, err := hex.DecodeString(table.Mask); err != nil || len(trimTrailingZeros(mask)) == 0 {
+		return kvs.NewInvalidValueError(ErrClassifyTableInvalidMask, "mask")
+	}
+	if table.NextTable == table.Name {
+		return kvs.NewInvalidValueError(ErrClassifyTableChainedToItself, "next_table")
+	}
+	for _, iface := range table.Interfaces {
+		if iface.Name == "" {
+			return kvs.NewInvalidValueError(ErrClassifyTableInterfaceWithoutName, "interfaces.name")
+		}
+	}
+	return nil
+}
+
+// Create adds new VPP classify table.
+func (d *ClassifyTableDescriptor) Create(key string, table *classifier.ClassifyTable) (metadata *idxvpp.OnlyIndex, err error) {
+	nextTableIndex := ^uint32(0)
+	if table.NextTable != "" {
+		nextTable, exists := d.tableIndex.LookupByName(table.NextTable)
+		if !exists {
+			err = errors.Errorf("failed to obtain index of the next classify table %s", table.NextTable)
+			d.log.Error(err)
+			return nil, err
+		}
+		nextTableIndex = nextTable.GetIndex()
+	}
+	tableIdx, err := d.classifyHandler.AddClassifyTable(table, nextTableIndex)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return &idxvpp.OnlyIndex{Index: tableIdx}, nil
+}
+
+// Delete removes VPP classify table.
+func (d *ClassifyTableDescriptor) Delete(key string, table *classifier.ClassifyTable, metadata *idxvpp.OnlyIndex) error {
+	if err := d.classifyHandler.DeleteClassifyTable(table, metadata.GetIndex()); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns classify tables configured in VPP. VPP does not store table
+// names, therefore tables are correlated with NB by the index known from the
+// previous run or by the table attributes. Tables not matching any NB table
+// are not retrieved.
+func (d *ClassifyTableDescriptor) Retrieve(correlate []adapter.ClassifyTableKVWithMetadata) (
+	retrieved []adapter.ClassifyTableKVWithMetadata, err error) {
+
+	tables, err := d.classifyHandler.DumpClassifyTables()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump classify tables: %v", err)
+	}
+
+	// correlate by the index first, then by the table attributes
+	sbTables := make(map[uint32]*vppcalls.ClassifyTableDetails, len(tables))
+	for _, table := range tables {
+		sbTables[table.Meta.TableIndex] = table
+	}
+	nbTables := make(map[uint32]*classifier.ClassifyTable)
+	var uncorrelated []*classifier.ClassifyTable
+	for _, kv := range correlate {
+		if kv.Metadata != nil {
+			if sbTable, ok := sbTables[kv.Metadata.GetIndex()]; ok && equivalentTableAttrs(kv.Value, sbTable.Table) {
+				nbTables[kv.Metadata.GetIndex()] = kv.Value
+				continue
+			}
+		}
+		uncorrelated = append(uncorrelated, kv.Value)
+	}
+	for _, nbTable := range uncorrelated {
+		for _, sbTable := range tables {
+			if _, used := nbTables[sbTable.Meta.TableIndex]; used {
+				continue
+			}
+			if equivalentTableAttrs(nbTable, sbTable.Table) {
+				nbTables[sbTable.Meta.TableIndex] = nbTable
+				break
+			}
+		}
+	}
+
+	for _, sbTable := range tables {
+		nbTable, ok := nbTables[sbTable.Meta.TableIndex]
+		if !ok {
+			d.log.Debugf("classify table with index %d is not configured from NB, skipping",
+				sbTable.Meta.TableIndex)
+			continue
+		}
+		table := proto.Clone(sbTable.Table).(*classifier.ClassifyTable)
+		table.Name = nbTable.Name
+		table.Mask = nbTable.Mask
+		table.Nbuckets = nbTable.Nbuckets
+		table.MemorySize = nbTable.MemorySize
+		// interfaces with applied classify table are taken from NB
+		table.Interfaces = nbTable.Interfaces
+		if nextTable, ok := nbTables[sbTable.Meta.NextTableIndex]; ok {
+			table.NextTable = nextTable.Name
+		}
+		retrieved = append(retrieved, adapter.ClassifyTableKVWithMetadata{
+			Key:      classifier.TableKey(table.Name),
+			Value:    table,
+			Metadata: &idxvpp.OnlyIndex{Index: sbTable.Meta.TableIndex},
+			Origin:   kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the next classify table (if defined) as dependency.
+func (d *ClassifyTableDescriptor) Dependencies(key string, table *classifier.ClassifyTable) (deps []kvs.Dependency) {
+	if table.NextTable != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: nextTableDep,
+			Key:   classifier.TableKey(table.NextTable),
+		})
+	}
+	return deps
+}
+
+// DerivedValues derives one empty value for every interface with the classify table applied.
+func (d *ClassifyTableDescriptor) DerivedValues(key string, table *classifier.ClassifyTable) (derValues []kvs.KeyValuePair) {
+	for _, iface := range table.Interfaces {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   classifier.ToInterfaceKey(table.Name, iface.Name, iface.Direction, iface.Type),
+			Value: &emptypb.Empty{},
+		})
+	}
+	return derValues
+}
+
+// equivalentTableAttrs compares classify table attributes that can be dumped
+// from VPP (name, memory size and next table are not included).
+func equivalentTableAttrs(oldTable, newTable *classifier.ClassifyTable) bool {
+	return equivalentHex(oldTable.Mask, newTable.Mask) &&
+		withDefault(oldTable.Nbuckets, defaultNBuckets) == withDefault(newTable.Nbuckets, defaultNBuckets) &&
+		oldTable.MissAction == newTable.MissAction
+}
+
+// equivalentHex compares hexadecimal strings (mask or match) ignoring
+// the letter case and trailing zeros.
+func equivalentHex(hex1, hex2 string) bool {
+	data1, err1 := hex.DecodeString(hex1)
+	data2, err2 := hex.DecodeString(hex2)
+	if err1 != nil || err2 != nil {
+		return hex1 == hex2
+	}
+	return bytes.Equal(trimTrailingZeros(data1), trimTrailingZeros(data2))
+}
+
+func trimTrailingZeros(data []byte) []byte {
+	end := len(data)
+	for end > 0 && data[end-1] == 0 {
+		end--
+	}
+	return data[:end]
+}
+
+func withDefault(value, defaultValue uint32) uint32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// ClassifyTableToInterfaceDescriptorName is the name of the descriptor for
+	// applying classify tables on interfaces.
+	ClassifyTableToInterfaceDescriptorName = "vpp-classify-table-to-interface"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+)
+
+// ClassifyTableToInterfaceDescriptor applies classify tables on interface
+// input or output.
+type ClassifyTableToInterfaceDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+	tableIndex      idxvpp.NameToIndex
+	ifPlugin        ifplugin.API
+}
+
+// NewClassifyTableToInterfaceDescriptor creates a new instance of the ClassifyTableToInterface descriptor.
+func NewClassifyTableToInterfaceDescriptor(classifyHandler vppcalls.ClassifyVppAPI, tableIndex idxvpp.NameToIndex,
+	ifPlugin ifplugin.API, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &ClassifyTableToInterfaceDescriptor{
+		log:             log.NewLogger("classify-table-to-interface-descriptor"),
+		classifyHandler: classifyHandler,
+		tableIndex:      tableIndex,
+		ifPlugin:        ifPlugin,
+	}
+	return &kvs.KVDescriptor{
+		Name:         ClassifyTableToInterfaceDescriptorName,
+		KeySelector:  ctx.IsClassifyTableToInterfaceKey,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Dependencies: ctx.Dependencies,
+	}
+}
+
+// IsClassifyTableToInterfaceKey returns true if the key identifies classify
+// table applied on interface (derived value).
+func (d *ClassifyTableToInterfaceDescriptor) IsClassifyTableToInterfaceKey(key string) bool {
+	_, _, _, _, isTableToInterfaceKey := classifier.ParseToInterfaceKey(key)
+	return isTableToInterfaceKey
+}
+
+// Create applies classify table on interface.
+func (d *ClassifyTableToInterfaceDescriptor) Create(key string, emptyVal proto.Message) (metadata kvs.Metadata, err error) {
+	return nil, d.setTableOnInterface(key, true)
+}
+
+// Delete removes classify table from interface.
+func (d *ClassifyTableToInterfaceDescriptor) Delete(key string, emptyVal proto.Message, metadata kvs.Metadata) error {
+	return d.setTableOnInterface(key, false)
+}
+
+// Dependencies lists the interface as the only dependency for the binding.
+func (d *ClassifyTableToInterfaceDescriptor) Dependencies(key string, emptyVal proto.Message) []kvs.Dependency {
+	_, ifName, _, _, _ := classifier.ParseToInterfaceKey(key)
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   vpp_interfaces.InterfaceKey(ifName),
+		},
+	}
+}
+
+// setTableOnInterface applies or removes classify table identified by the key.
+func (d *ClassifyTableToInterfaceDescriptor) setTableOnInterface(key string, apply bool) error {
+	tableName, ifName, direction, tableType, isValid := classifier.ParseToInterfaceKey(key)
+	if !isValid {
+		return errors.Errorf("classify table to interface key %s is not valid", key)
+	}
+	table, exists := d.tableIndex.LookupByName(tableName)
+	if !exists {
+		err := errors.Errorf("failed to obtain index of the classify table %s", tableName)
+		d.log.Error(err)
+		return err
+	}
+	ifMeta, exists := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !exists {
+		err := errors.Errorf("failed to obtain metadata for interface %s", ifName)
+		d.log.Error(err)
+		return err
+	}
+	err := d.classifyHandler.SetClassifyTableOnInterface(table.GetIndex(), ifMeta.SwIfIndex, direction, tableType, apply)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
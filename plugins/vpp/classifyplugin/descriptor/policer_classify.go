@@ -0,0 +1,217 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// PolicerClassifyDescriptorName is the name of the descriptor for applying
+	// classify tables on interfaces for policer classification.
+	PolicerClassifyDescriptorName = "vpp-policer-classify"
+
+	// dependency labels
+	ip4TableDep = "ip4-classify-table-exists"
+	ip6TableDep = "ip6-classify-table-exists"
+	l2TableDep  = "l2-classify-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrPolicerClassifyWithoutInterface is returned when VPP policer classify
+	// configuration has undefined Interface attribute.
+	ErrPolicerClassifyWithoutInterface = errors.New("VPP policer classify defined without interface")
+
+	// ErrPolicerClassifyWithoutTable is returned when VPP policer classify
+	// configuration has no classify table defined.
+	ErrPolicerClassifyWithoutTable = errors.New("VPP policer classify defined without classify table")
+)
+
+// PolicerClassifyDescriptor teaches KVScheduler how to apply classify tables
+// on interfaces for policer classification.
+type PolicerClassifyDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+	tableIndex      idxvpp.NameToIndex
+	ifPlugin        ifplugin.API
+}
+
+// NewPolicerClassifyDescriptor creates a new instance of the PolicerClassify descriptor.
+func NewPolicerClassifyDescriptor(classifyHandler vppcalls.ClassifyVppAPI, tableIndex idxvpp.NameToIndex,
+	ifPlugin ifplugin.API, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &PolicerClassifyDescriptor{
+		log:             log.NewLogger("policer-classify-descriptor"),
+		classifyHandler: classifyHandler,
+		tableIndex:      tableIndex,
+		ifPlugin:        ifPlugin,
+	}
+	typedDescr := &adapter.PolicerClassifyDescriptor{
+		Name:                 PolicerClassifyDescriptorName,
+		NBKeyPrefix:          classifier.ModelPolicerClassify.KeyPrefix(),
+		ValueTypeName:        classifier.ModelPolicerClassify.ProtoName(),
+		KeySelector:          classifier.ModelPolicerClassify.IsKeyValid,
+		KeyLabel:             classifier.ModelPolicerClassify.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName, ClassifyTableDescriptorName},
+	}
+	return adapter.NewPolicerClassifyDescriptor(typedDescr)
+}
+
+// Validate validates VPP policer classify configuration.
+func (d *PolicerClassifyDescriptor) Validate(key string, policerClassify *classifier.PolicerClassify) error {
+	if policerClassify.Interface == "" {
+		return kvs.NewInvalidValueError(ErrPolicerClassifyWithoutInterface, "interface")
+	}
+	if policerClassify.Ip4Table == "" && policerClassify.Ip6Table == "" && policerClassify.L2Table == "" {
+		return kvs.NewInvalidValueError(ErrPolicerClassifyWithoutTable, "ip4_table", "ip6_table", "l2_table")
+	}
+	return nil
+}
+
+// Create applies classify tables on interface for policer classification.
+func (d *PolicerClassifyDescriptor) Create(key string, policerClassify *classifier.PolicerClassify) (metadata interface{}, err error) {
+	return nil, d.setPolicerClassify(policerClassify, true)
+}
+
+// Delete removes classify tables used for policer classification from interface.
+func (d *PolicerClassifyDescriptor) Delete(key string, policerClassify *classifier.PolicerClassify, metadata interface{}) error {
+	return d.setPolicerClassify(policerClassify, false)
+}
+
+// Retrieve returns classify tables applied on interfaces for policer classification.
+func (d *PolicerClassifyDescriptor) Retrieve(correlate []adapter.PolicerClassifyKVWithMetadata) (
+	retrieved []adapter.PolicerClassifyKVWithMetadata, err error) {
+
+	ifaces, err := d.classifyHandler.DumpPolicerClassify()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump policer classify interfaces: %v", err)
+	}
+	for _, details := range ifaces {
+		ifName, _, exists := d.ifPlugin.GetInterfaceIndex().LookupBySwIfIndex(details.SwIfIndex)
+		if !exists {
+			d.log.Warnf("failed to find interface with sw_if_index %d", details.SwIfIndex)
+			continue
+		}
+		policerClassify := &classifier.PolicerClassify{
+			Interface: ifName,
+			Ip4Table:  d.lookupTableName(details.IP4TableIndex),
+			Ip6Table:  d.lookupTableName(details.IP6TableIndex),
+			L2Table:   d.lookupTableName(details.L2TableIndex),
+		}
+		retrieved = append(retrieved, adapter.PolicerClassifyKVWithMetadata{
+			Key:    classifier.PolicerClassifyKey(ifName),
+			Value:  policerClassify,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and the classify tables as dependencies.
+func (d *PolicerClassifyDescriptor) Dependencies(key string, policerClassify *classifier.PolicerClassify) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: interfaceDep,
+		Key:   vpp_interfaces.InterfaceKey(policerClassify.Interface),
+	})
+	if policerClassify.Ip4Table != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: ip4TableDep,
+			Key:   classifier.TableKey(policerClassify.Ip4Table),
+		})
+	}
+	if policerClassify.Ip6Table != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: ip6TableDep,
+			Key:   classifier.TableKey(policerClassify.Ip6Table),
+		})
+	}
+	if policerClassify.L2Table != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: l2TableDep,
+			Key:   classifier.TableKey(policerClassify.L2Table),
+		})
+	}
+	return deps
+}
+
+// setPolicerClassify applies or removes classify tables used for policer
+// classification on interface.
+func (d *PolicerClassifyDescriptor) setPolicerClassify(policerClassify *classifier.PolicerClassify, apply bool) error {
+	ifMeta, exists := d.ifPlugin.GetInterfaceIndex().LookupByName(policerClassify.Interface)
+	if !exists {
+		err := errors.Errorf("failed to obtain metadata for interface %s", policerClassify.Interface)
+		d.log.Error(err)
+		return err
+	}
+	ip4Table, err := d.lookupTableIndex(policerClassify.Ip4Table)
+	if err != nil {
+		return err
+	}
+	ip6Table, err := d.lookupTableIndex(policerClassify.Ip6Table)
+	if err != nil {
+		return err
+	}
+	l2Table, err := d.lookupTableIndex(policerClassify.L2Table)
+	if err != nil {
+		return err
+	}
+	err = d.classifyHandler.SetPolicerClassifyOnInterface(ifMeta.SwIfIndex, ip4Table, ip6Table, l2Table, apply)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// lookupTableIndex returns index of the classify table with the given name
+// (^uint32(0) if the name is empty).
+func (d *PolicerClassifyDescriptor) lookupTableIndex(name string) (uint32, error) {
+	if name == "" {
+		return ^uint32(0), nil
+	}
+	table, exists := d.tableIndex.LookupByName(name)
+	if !exists {
+		err := errors.Errorf("failed to obtain index of the classify table %s", name)
+		d.log.Error(err)
+		return 0, err
+	}
+	return table.GetIndex(), nil
+}
+
+// lookupTableName returns name of the classify table with the given index
+// (empty if the table is not applied or not configured from NB).
+func (d *PolicerClassifyDescriptor) lookupTableName(index uint32) string {
+	if index == ^uint32(0) {
+		return ""
+	}
+	name, _, _ := d.tableIndex.LookupByIndex(index)
+	return name
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+// ClassifyTableDetails contains proto-modeled classify table data together
+// with VPP-related metadata.
+type ClassifyTableDetails struct {
+	Table *classifier.ClassifyTable `json:"classify_table"`
+	Meta  *ClassifyTableMeta        `json:"classify_table_meta"`
+}
+
+// ClassifyTableMeta contains table indexes assigned by VPP and the number
+// of active sessions.
+type ClassifyTableMeta struct {
+	TableIndex     uint32 `json:"table_index"`
+	NextTableIndex uint32 `json:"next_table_index"`
+	ActiveSessions uint32 `json:"active_sessions"`
+}
+
+// ClassifySessionDetails contains proto-modeled classify session data together
+// with VPP-related metadata.
+type ClassifySessionDetails struct {
+	Session *classifier.ClassifySession `json:"classify_session"`
+	Meta    *ClassifySessionMeta        `json:"classify_session_meta"`
+}
+
+// ClassifySessionMeta contains index of the table the session belongs to
+// and the hit next index (policer index for policer classification).
+type ClassifySessionMeta struct {
+	TableIndex   uint32 `json:"table_index"`
+	HitNextIndex uint32 `json:"hit_next_index"`
+}
+
+// PolicerClassifyDetails contains indexes of the classify tables applied
+// on interface for policer classification (^uint32(0) if not applied).
+type PolicerClassifyDetails struct {
+	SwIfIndex     uint32 `json:"sw_if_index"`
+	IP4TableIndex uint32 `json:"ip4_table_index"`
+	IP6TableIndex uint32 `json:"ip6_table_index"`
+	L2TableIndex  uint32 `json:"l2_table_index"`
+}
+
+// ClassifyVppAPI provides read/write methods required to handle VPP classifier.
+type ClassifyVppAPI interface {
+	ClassifyVppRead
+
+	// AddClassifyTable creates new classify table and returns its index.
+	// Use ^uint32(0) as the next table index if the table is not chained.
+	AddClassifyTable(table *classifier.ClassifyTable, nextTableIndex uint32) (tableIndex uint32, err error)
+	// DeleteClassifyTable removes existing classify table (including its sessions).
+	DeleteClassifyTable(table *classifier.ClassifyTable, tableIndex uint32) error
+	// AddClassifySession adds new session into the classify table. Policer index
+	// is used as the hit next index if the session refers to a policer.
+	AddClassifySession(session *classifier.ClassifySession, tableIndex, policerIndex uint32) error
+	// DeleteClassifySession removes session from the classify table.
+	DeleteClassifySession(session *classifier.ClassifySession, tableIndex uint32) error
+	// SetClassifyTableOnInterface applies (or removes) classify table on interface
+	// input or output for the given type of traffic.
+	SetClassifyTableOnInterface(tableIndex, swIfIndex uint32, direction classifier.ClassifyTable_Interface_Direction,
+		tableType classifier.ClassifyTable_Interface_Type, apply bool) error
+	// SetPolicerClassifyOnInterface applies (or removes) classify tables on interface
+	// input for policer classification. Use ^uint32(0) for tables not applied.
+	SetPolicerClassifyOnInterface(swIfIndex, ip4TableIndex, ip6TableIndex, l2TableIndex uint32, apply bool) error
+}
+
+// ClassifyVppRead provides read methods for VPP classifier.
+type ClassifyVppRead interface {
+	// DumpClassifyTables retrieves all classify tables configured in VPP.
+	// Table names, memory sizes and interfaces with the table applied
+	// are not dumped (not supported by VPP API).
+	DumpClassifyTables() ([]*ClassifyTableDetails, error)
+	// DumpClassifySessions retrieves all sessions of the given classify table.
+	// Metadata actions are not dumped (not supported by VPP API).
+	DumpClassifySessions(tableIndex uint32) ([]*ClassifySessionDetails, error)
+	// DumpPolicerClassify retrieves classify tables applied on interfaces
+	// for policer classification.
+	DumpPolicerClassify() ([]*PolicerClassifyDetails, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "classify",
+	HandlerAPI: (*ClassifyVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, log logging.Logger) ClassifyVppAPI
+
+func AddClassifyHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleClassifyVppHandler(c vpp.Client, log logging.Logger) ClassifyVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, log).(ClassifyVppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+const (
+	// size of the classifier vector (u32x4) in bytes
+	vectorSize = 16
+
+	// defaults used for undefined table attributes
+	defaultNBuckets   = 2
+	defaultMemorySize = 2 << 20
+
+	// next index used to drop the packet
+	denyNextIndex = 0
+	// next index used to continue with the packet processing
+	permitNextIndex = ^uint32(0)
+)
+
+// AddClassifyTable implements classifier handler.
+func (h *ClassifyVppHandler) AddClassifyTable(table *classifier.ClassifyTable, nextTableIndex uint32) (uint32, error) {
+	skip, match, mask, err := toVppMask(table.Mask)
+	if err != nil {
+		return 0, err
+	}
+	req := &vpp_classify.ClassifyAddDelTable{
+		IsAdd:          true,
+		TableIndex:     ^uint32(0),
+		Nbuckets:       table.Nbuckets,
+		MemorySize:     table.MemorySize,
+		SkipNVectors:   skip,
+		MatchNVectors:  match,
+		NextTableIndex: nextTableIndex,
+		MissNextIndex:  toVppNextIndex(table.MissAction),
+		MaskLen:        uint32(len(mask)),
+		Mask:           mask,
+	}
+	if req.Nbuckets == 0 {
+		req.Nbuckets = defaultNBuckets
+	}
+	if req.MemorySize == 0 {
+		req.MemorySize = defaultMemorySize
+	}
+	reply := &vpp_classify.ClassifyAddDelTableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.NewTableIndex, nil
+}
+
+// DeleteClassifyTable implements classifier handler.
+func (h *ClassifyVppHandler) DeleteClassifyTable(table *classifier.ClassifyTable, tableIndex uint32) error {
+	skip, match, mask, err := toVppMask(table.Mask)
+	if err != nil {
+		return err
+	}
+	req := &vpp_classify.ClassifyAddDelTable{
+		IsAdd:         false,
+		TableIndex:    tableIndex,
+		SkipNVectors:  skip,
+		MatchNVectors: match,
+		MaskLen:       uint32(len(mask)),
+		Mask:          mask,
+	}
+	reply := &vpp_classify.ClassifyAddDelTableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// AddClassifySession implements classifier handler.
+func (h *ClassifyVppHandler) AddClassifySession(session *classifier.ClassifySession, tableIndex, policerIndex uint32) error {
+	return h.addDelClassifySession(session, tableIndex, policerIndex, true)
+}
+
+// DeleteClassifySession implements classifier handler.
+func (h *ClassifyVppHandler) DeleteClassifySession(session *classifier.ClassifySession, tableIndex uint32) error {
+	return h.addDelClassifySession(session, tableIndex, ^uint32(0), false)
+}
+
+func (h *ClassifyVppHandler) addDelClassifySession(session *classifier.ClassifySession,
+	tableIndex, policerIndex uint32, isAdd bool) error {
+	// match has to cover both skipped and matched vectors of the table
+	info, err := h.getTableInfo(tableIndex)
+	if err != nil {
+		return err
+	}
+	match, err := toVppMatch(session.Match, info.SkipNVectors+info.MatchNVectors)
+	if err != nil {
+		return err
+	}
+	req := &vpp_classify.ClassifyAddDelSession{
+		IsAdd:        isAdd,
+		TableIndex:   tableIndex,
+		HitNextIndex: toVppNextIndex(session.HitAction),
+		OpaqueIndex:  session.OpaqueIndex,
+		Advance:      session.Advance,
+		Action:       vpp_classify.ClassifyAction(session.MetadataAction),
+		Metadata:     session.Metadata,
+		MatchLen:     uint32(len(match)),
+		Match:        match,
+	}
+	if session.Policer != "" {
+		// policer classification passes the packet to the policer
+		// with the hit next index
+		req.HitNextIndex = policerIndex
+	}
+	reply := &vpp_classify.ClassifyAddDelSessionReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetClassifyTableOnInterface implements classifier handler.
+func (h *ClassifyVppHandler) SetClassifyTableOnInterface(tableIndex, swIfIndex uint32,
+	direction classifier.ClassifyTable_Interface_Direction, tableType classifier.ClassifyTable_Interface_Type,
+	apply bool) error {
+
+	ip4Table, ip6Table, l2Table := ^uint32(0), ^uint32(0), ^uint32(0)
+	switch tableType {
+	case classifier.ClassifyTable_Interface_IP4:
+		ip4Table = tableIndex
+	case classifier.ClassifyTable_Interface_IP6:
+		ip6Table = tableIndex
+	case classifier.ClassifyTable_Interface_L2:
+		l2Table = tableIndex
+	}
+
+	if direction == classifier.ClassifyTable_Interface_OUTPUT {
+		req := &vpp_classify.OutputACLSetInterface{
+			SwIfIndex:     interface_types.InterfaceIndex(swIfIndex),
+			IP4TableIndex: ip4Table,
+			IP6TableIndex: ip6Table,
+			L2TableIndex:  l2Table,
+			IsAdd:         apply,
+		}
+		reply := &vpp_classify.OutputACLSetInterfaceReply{}
+		return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	}
+	req := &vpp_classify.InputACLSetInterface{
+		SwIfIndex:     interface_types.InterfaceIndex(swIfIndex),
+		IP4TableIndex: ip4Table,
+		IP6TableIndex: ip6Table,
+		L2TableIndex:  l2Table,
+		IsAdd:         apply,
+	}
+	reply := &vpp_classify.InputACLSetInterfaceReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetPolicerClassifyOnInterface implements classifier handler.
+func (h *ClassifyVppHandler) SetPolicerClassifyOnInterface(swIfIndex, ip4TableIndex, ip6TableIndex,
+	l2TableIndex uint32, apply bool) error {
+
+	req := &vpp_classify.PolicerClassifySetInterface{
+		SwIfIndex:     interface_types.InterfaceIndex(swIfIndex),
+		IP4TableIndex: ip4TableIndex,
+		IP6TableIndex: ip6TableIndex,
+		L2TableIndex:  l2TableIndex,
+		IsAdd:         apply,
+	}
+	reply := &vpp_classify.PolicerClassifySetInterfaceReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// toVppMask converts hexadecimal mask into its VPP representation. Leading zero
+// vectors are skipped and the mask is padded with zeros to whole vectors.
+func toVppMask(hexMask string) (skipNVectors, matchNVectors uint32, mask []byte, err error) {
+	mask, err = hex.DecodeString(hexMask)
+	if err != nil {
+		return 0, 0, nil, errors.Errorf("invalid classify table mask %q: %v", hexMask, err)
+	}
+	mask = padToVectors(mask, 0)
+	for len(mask) > vectorSize && isZero(mask[:vectorSize]) {
+		mask = mask[vectorSize:]
+		skipNVectors++
+	}
+	return skipNVectors, uint32(len(mask) / vectorSize), mask, nil
+}
+
+// toVppMatch converts hexadecimal match into its VPP representation padded
+// with zeros to the given number of vectors.
+func toVppMatch(hexMatch string, nVectors uint32) ([]byte, error) {
+	match, err := hex.DecodeString(hexMatch)
+	if err != nil {
+		return nil, errors.Errorf("invalid classify session match %q: %v", hexMatch, err)
+	}
+	if len(match) > int(nVectors)*vectorSize {
+		return nil, errors.Errorf("classify session match %q is longer than the table mask (%d bytes)",
+			hexMatch, nVectors*vectorSize)
+	}
+	return padToVectors(match, nVectors), nil
+}
+
+// padToVectors pads data with zeros to whole vectors (at least minVectors).
+func padToVectors(data []byte, minVectors uint32) []byte {
+	size := (len(data) + vectorSize - 1) / vectorSize * vectorSize
+	if min := int(minVectors) * vectorSize; size < min {
+		size = min
+	}
+	padded := make([]byte, size)
+	copy(padded, data)
+	return padded
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func toVppNextIndex(action classifier.Action) uint32 {
+	if action == classifier.Action_DENY {
+		return denyNextIndex
+	}
+	return permitNextIndex
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"encoding/hex"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+// DumpClassifyTables implements classifier handler.
+func (h *ClassifyVppHandler) DumpClassifyTables() (tables []*vppcalls.ClassifyTableDetails, err error) {
+	req := &vpp_classify.ClassifyTableIds{}
+	reply := &vpp_classify.ClassifyTableIdsReply{}
+	if err = h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, err
+	}
+	for _, tableIndex := range reply.Ids {
+		info, err := h.getTableInfo(tableIndex)
+		if err != nil {
+			return nil, err
+		}
+		// mask of the skipped vectors is zero
+		mask := append(make([]byte, info.SkipNVectors*vectorSize), info.Mask...)
+		tables = append(tables, &vppcalls.ClassifyTableDetails{
+			Table: &classifier.ClassifyTable{
+				Mask:       hex.EncodeToString(trimTrailingZeros(mask)),
+				Nbuckets:   info.Nbuckets,
+				MissAction: fromVppNextIndex(info.MissNextIndex),
+			},
+			Meta: &vppcalls.ClassifyTableMeta{
+				TableIndex:     info.TableID,
+				NextTableIndex: info.NextTableIndex,
+				ActiveSessions: info.ActiveSessions,
+			},
+		})
+	}
+	return tables, nil
+}
+
+// DumpClassifySessions implements classifier handler.
+func (h *ClassifyVppHandler) DumpClassifySessions(tableIndex uint32) (sessions []*vppcalls.ClassifySessionDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_classify.ClassifySessionDump{
+		TableID: tableIndex,
+	})
+	for {
+		details := &vpp_classify.ClassifySessionDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &vppcalls.ClassifySessionDetails{
+			Session: &classifier.ClassifySession{
+				Match:       hex.EncodeToString(trimTrailingZeros(details.Match)),
+				HitAction:   fromVppNextIndex(details.HitNextIndex),
+				OpaqueIndex: details.OpaqueIndex,
+				Advance:     details.Advance,
+			},
+			Meta: &vppcalls.ClassifySessionMeta{
+				TableIndex:   details.TableID,
+				HitNextIndex: details.HitNextIndex,
+			},
+		})
+	}
+	return sessions, nil
+}
+
+// DumpPolicerClassify implements classifier handler.
+func (h *ClassifyVppHandler) DumpPolicerClassify() (ifaces []*vppcalls.PolicerClassifyDetails, err error) {
+	ifaceTables := make(map[uint32]*vppcalls.PolicerClassifyDetails)
+	for _, tableType := range []vpp_classify.PolicerClassifyTable{
+		vpp_classify.POLICER_CLASSIFY_API_TABLE_IP4,
+		vpp_classify.POLICER_CLASSIFY_API_TABLE_IP6,
+		vpp_classify.POLICER_CLASSIFY_API_TABLE_L2,
+	} {
+		reqCtx := h.callsChannel.SendMultiRequest(&vpp_classify.PolicerClassifyDump{
+			Type:      tableType,
+			SwIfIndex: ^interface_types.InterfaceIndex(0),
+		})
+		for {
+			details := &vpp_classify.PolicerClassifyDetails{}
+			stop, err := reqCtx.ReceiveReply(details)
+			if stop {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			iface, ok := ifaceTables[uint32(details.SwIfIndex)]
+			if !ok {
+				iface = &vppcalls.PolicerClassifyDetails{
+					SwIfIndex:     uint32(details.SwIfIndex),
+					IP4TableIndex: ^uint32(0),
+					IP6TableIndex: ^uint32(0),
+					L2TableIndex:  ^uint32(0),
+				}
+				ifaceTables[iface.SwIfIndex] = iface
+				ifaces = append(ifaces, iface)
+			}
+			switch tableType {
+			case vpp_classify.POLICER_CLASSIFY_API_TABLE_IP4:
+				iface.IP4TableIndex = details.TableIndex
+			case vpp_classify.POLICER_CLASSIFY_API_TABLE_IP6:
+				iface.IP6TableIndex = details.TableIndex
+			case vpp_classify.POLICER_CLASSIFY_API_TABLE_L2:
+				iface.L2TableIndex = details.TableIndex
+			}
+		}
+	}
+	return ifaces, nil
+}
+
+// getTableInfo retrieves details of the classify table with the given index.
+func (h *ClassifyVppHandler) getTableInfo(tableIndex uint32) (*vpp_classify.ClassifyTableInfoReply, error) {
+	req := &vpp_classify.ClassifyTableInfo{
+		TableID: tableIndex,
+	}
+	reply := &vpp_classify.ClassifyTableInfoReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func trimTrailingZeros(data []byte) []byte {
+	end := len(data)
+	for end > 0 && data[end-1] == 0 {
+		end--
+	}
+	return data[:end]
+}
+
+func fromVppNextIndex(nextIndex uint32) classifier.Action {
+	if nextIndex == denyNextIndex {
+		return classifier.Action_DENY
+	}
+	return classifier.Action_PERMIT
+}
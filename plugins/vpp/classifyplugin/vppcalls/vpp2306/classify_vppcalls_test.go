@@ -0,0 +1,401 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+func TestAddClassifyTable(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{
+		NewTableIndex: 4,
+	})
+
+	// IPv4 source address (offset 12 in the IP header)
+	index, err := classifyHandler.AddClassifyTable(&classifier.ClassifyTable{
+		Name:       "table1",
+		Mask:       "000000000000000000000000ffffffff",
+		MissAction: classifier.Action_DENY,
+	}, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(index).To(BeEquivalentTo(4))
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.TableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.Nbuckets).To(BeEquivalentTo(2))
+	Expect(vppMsg.MemorySize).To(BeEquivalentTo(2 << 20))
+	Expect(vppMsg.SkipNVectors).To(BeEquivalentTo(0))
+	Expect(vppMsg.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(vppMsg.NextTableIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.MissNextIndex).To(BeEquivalentTo(0))
+	Expect(vppMsg.MaskLen).To(BeEquivalentTo(16))
+	Expect(vppMsg.Mask[12:]).To(Equal([]byte{0xff, 0xff, 0xff, 0xff}))
+}
+
+func TestAddClassifyTableWithSkip(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{})
+
+	// IPv6 destination address (offset 24 in the IP header)
+	_, err := classifyHandler.AddClassifyTable(&classifier.ClassifyTable{
+		Name:     "table1",
+		Mask:     "00000000000000000000000000000000" + "00000000000000000000000000000000" + "ffffffffffffffff",
+		Nbuckets: 32,
+	}, ^uint32(0))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Nbuckets).To(BeEquivalentTo(32))
+	Expect(vppMsg.SkipNVectors).To(BeEquivalentTo(2))
+	Expect(vppMsg.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(vppMsg.MissNextIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.Mask).To(HaveLen(16))
+	Expect(vppMsg.Mask[:8]).To(Equal([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+}
+
+func TestAddClassifyTableInvalidMask(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := classifyHandler.AddClassifyTable(&classifier.ClassifyTable{
+		Name: "table1",
+		Mask: "xyz",
+	}, ^uint32(0))
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDeleteClassifyTable(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{})
+
+	err := classifyHandler.DeleteClassifyTable(&classifier.ClassifyTable{
+		Name: "table1",
+		Mask: "ffff",
+	}, 4)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.TableIndex).To(BeEquivalentTo(4))
+	Expect(vppMsg.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(vppMsg.MaskLen).To(BeEquivalentTo(16))
+}
+
+func TestAddClassifySession(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:       4,
+		SkipNVectors:  1,
+		MatchNVectors: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelSessionReply{})
+
+	err := classifyHandler.AddClassifySession(&classifier.ClassifySession{
+		Table:          "table1",
+		Match:          "000000000000000000000000000000000a000001",
+		HitAction:      classifier.Action_DENY,
+		OpaqueIndex:    7,
+		MetadataAction: classifier.ClassifySession_SET_IP4_FIB_INDEX,
+		Metadata:       10,
+	}, 4, ^uint32(0))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	infoMsg, ok := ctx.MockChannel.Msgs[0].(*vpp_classify.ClassifyTableInfo)
+	Expect(ok).To(BeTrue())
+	Expect(infoMsg.TableID).To(BeEquivalentTo(4))
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelSession)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.TableIndex).To(BeEquivalentTo(4))
+	Expect(vppMsg.HitNextIndex).To(BeEquivalentTo(0))
+	Expect(vppMsg.OpaqueIndex).To(BeEquivalentTo(7))
+	Expect(vppMsg.Action).To(Equal(vpp_classify.CLASSIFY_API_ACTION_SET_IP4_FIB_INDEX))
+	Expect(vppMsg.Metadata).To(BeEquivalentTo(10))
+	Expect(vppMsg.MatchLen).To(BeEquivalentTo(32))
+	Expect(vppMsg.Match[16:20]).To(Equal([]byte{10, 0, 0, 1}))
+}
+
+func TestAddClassifySessionTooLong(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:       4,
+		MatchNVectors: 1,
+	})
+
+	err := classifyHandler.AddClassifySession(&classifier.ClassifySession{
+		Table: "table1",
+		Match: "000000000000000000000000000000000a000001",
+	}, 4, ^uint32(0))
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddClassifySessionWithPolicer(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:       4,
+		MatchNVectors: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelSessionReply{})
+
+	err := classifyHandler.AddClassifySession(&classifier.ClassifySession{
+		Table:       "table1",
+		Match:       "0a000001",
+		HitAction:   classifier.Action_DENY,
+		OpaqueIndex: 1,
+		Policer:     "policer1",
+	}, 4, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelSession)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.HitNextIndex).To(BeEquivalentTo(3))
+	Expect(vppMsg.OpaqueIndex).To(BeEquivalentTo(1))
+}
+
+func TestDeleteClassifySession(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:       4,
+		MatchNVectors: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelSessionReply{})
+
+	err := classifyHandler.DeleteClassifySession(&classifier.ClassifySession{
+		Table: "table1",
+		Match: "0a000001",
+	}, 4)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelSession)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.HitNextIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.MatchLen).To(BeEquivalentTo(16))
+}
+
+func TestSetClassifyTableOnInterface(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.InputACLSetInterfaceReply{})
+	err := classifyHandler.SetClassifyTableOnInterface(4, 2, classifier.ClassifyTable_Interface_INPUT,
+		classifier.ClassifyTable_Interface_IP6, true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	inputMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.InputACLSetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(inputMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(inputMsg.IP4TableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(inputMsg.IP6TableIndex).To(BeEquivalentTo(4))
+	Expect(inputMsg.L2TableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(inputMsg.IsAdd).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_classify.OutputACLSetInterfaceReply{})
+	err = classifyHandler.SetClassifyTableOnInterface(4, 2, classifier.ClassifyTable_Interface_OUTPUT,
+		classifier.ClassifyTable_Interface_L2, false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	outputMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.OutputACLSetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(outputMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(outputMsg.IP4TableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(outputMsg.IP6TableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(outputMsg.L2TableIndex).To(BeEquivalentTo(4))
+	Expect(outputMsg.IsAdd).To(BeFalse())
+}
+
+func TestSetPolicerClassifyOnInterface(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.PolicerClassifySetInterfaceReply{})
+	err := classifyHandler.SetPolicerClassifyOnInterface(2, 4, ^uint32(0), 5, true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.PolicerClassifySetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.IP4TableIndex).To(BeEquivalentTo(4))
+	Expect(vppMsg.IP6TableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.L2TableIndex).To(BeEquivalentTo(5))
+	Expect(vppMsg.IsAdd).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_classify.PolicerClassifySetInterfaceReply{
+		Retval: 1,
+	})
+	err = classifyHandler.SetPolicerClassifyOnInterface(2, 4, ^uint32(0), 5, false)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDumpPolicerClassify(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// IPv4 tables
+	ctx.MockVpp.MockReply(
+		&vpp_classify.PolicerClassifyDetails{SwIfIndex: 1, TableIndex: 4},
+		&vpp_classify.PolicerClassifyDetails{SwIfIndex: 2, TableIndex: 6},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	// IPv6 tables
+	ctx.MockVpp.MockReply()
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	// L2 tables
+	ctx.MockVpp.MockReply(&vpp_classify.PolicerClassifyDetails{SwIfIndex: 1, TableIndex: 5})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	ifaces, err := classifyHandler.DumpPolicerClassify()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ifaces).To(HaveLen(2))
+
+	Expect(ifaces[0]).To(Equal(&vppcalls.PolicerClassifyDetails{
+		SwIfIndex:     1,
+		IP4TableIndex: 4,
+		IP6TableIndex: ^uint32(0),
+		L2TableIndex:  5,
+	}))
+	Expect(ifaces[1]).To(Equal(&vppcalls.PolicerClassifyDetails{
+		SwIfIndex:     2,
+		IP4TableIndex: 6,
+		IP6TableIndex: ^uint32(0),
+		L2TableIndex:  ^uint32(0),
+	}))
+}
+
+func TestDumpClassifyTables(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableIdsReply{
+		Count: 2,
+		Ids:   []uint32{0, 1},
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:        0,
+		Nbuckets:       2,
+		MatchNVectors:  1,
+		NextTableIndex: ^uint32(0),
+		MissNextIndex:  0,
+		MaskLength:     16,
+		Mask:           []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff},
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:        1,
+		Nbuckets:       8,
+		SkipNVectors:   1,
+		MatchNVectors:  1,
+		ActiveSessions: 3,
+		NextTableIndex: 0,
+		MissNextIndex:  ^uint32(0),
+		MaskLength:     16,
+		Mask:           []byte{0xff, 0xff, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	})
+
+	tables, err := classifyHandler.DumpClassifyTables()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(tables).To(HaveLen(2))
+
+	Expect(tables[0].Meta.TableIndex).To(BeEquivalentTo(0))
+	Expect(tables[0].Meta.NextTableIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(tables[0].Table.Mask).To(Equal("000000000000000000000000ffffffff"))
+	Expect(tables[0].Table.Nbuckets).To(BeEquivalentTo(2))
+	Expect(tables[0].Table.MissAction).To(Equal(classifier.Action_DENY))
+
+	Expect(tables[1].Meta.TableIndex).To(BeEquivalentTo(1))
+	Expect(tables[1].Meta.NextTableIndex).To(BeEquivalentTo(0))
+	Expect(tables[1].Meta.ActiveSessions).To(BeEquivalentTo(3))
+	Expect(tables[1].Table.Mask).To(Equal("00000000000000000000000000000000ffff"))
+	Expect(tables[1].Table.Nbuckets).To(BeEquivalentTo(8))
+	Expect(tables[1].Table.MissAction).To(Equal(classifier.Action_PERMIT))
+}
+
+func TestDumpClassifySessions(t *testing.T) {
+	ctx, classifyHandler := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_classify.ClassifySessionDetails{
+			TableID:      4,
+			HitNextIndex: 0,
+			OpaqueIndex:  ^uint32(0),
+			MatchLength:  16,
+			Match:        []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 1},
+		},
+		&vpp_classify.ClassifySessionDetails{
+			TableID:      4,
+			HitNextIndex: ^uint32(0),
+			Advance:      -14,
+			MatchLength:  16,
+			Match:        []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 2},
+		},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := classifyHandler.DumpClassifySessions(4)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(sessions).To(HaveLen(2))
+
+	dumpMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifySessionDump)
+	Expect(ok).To(BeTrue())
+	Expect(dumpMsg.TableID).To(BeEquivalentTo(4))
+
+	Expect(sessions[0].Meta.TableIndex).To(BeEquivalentTo(4))
+	Expect(sessions[0].Meta.HitNextIndex).To(BeEquivalentTo(0))
+	Expect(sessions[0].Session.Match).To(Equal("0000000000000000000000000a000001"))
+	Expect(sessions[0].Session.HitAction).To(Equal(classifier.Action_DENY))
+	Expect(sessions[0].Session.OpaqueIndex).To(BeEquivalentTo(^uint32(0)))
+
+	Expect(sessions[1].Session.Match).To(Equal("0000000000000000000000000a000002"))
+	Expect(sessions[1].Session.HitAction).To(Equal(classifier.Action_PERMIT))
+	Expect(sessions[1].Session.Advance).To(BeEquivalentTo(-14))
+}
+
+func classifyTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.ClassifyVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	classifyHandler := vpp2306.NewClassifyVppHandler(ctx.MockChannel, log)
+	return ctx, classifyHandler
+}
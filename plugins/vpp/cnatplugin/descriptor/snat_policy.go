@@ -0,0 +1,218 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// SnatPolicyDescriptorName is the name of the descriptor for cnat source NAT policy.
+	SnatPolicyDescriptorName = "vpp-cnat-snat-policy"
+
+	// dependency labels
+	snatInterfaceDep = "snat-interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrSnatPolicyInvalidAddress is returned when source NAT address is not valid.
+	ErrSnatPolicyInvalidAddress = errors.New("cnat source NAT address is not valid")
+
+	// ErrSnatPolicyInvalidPrefix is returned when excluded prefix is not valid.
+	ErrSnatPolicyInvalidPrefix = errors.New("cnat source NAT exclude prefix is not valid")
+)
+
+// SnatPolicyDescriptor teaches KVScheduler how to configure source NAT
+// of the VPP cnat plugin.
+type SnatPolicyDescriptor struct {
+	log         logging.Logger
+	cnatHandler vppcalls.CnatVppAPI
+}
+
+// NewSnatPolicyDescriptor creates a new instance of the SnatPolicy descriptor.
+func NewSnatPolicyDescriptor(cnatHandler vppcalls.CnatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &SnatPolicyDescriptor{
+		log:         log.NewLogger("cnat-snat-policy-descriptor"),
+		cnatHandler: cnatHandler,
+	}
+	typedDescr := &adapter.SnatPolicyDescriptor{
+		Name:                 SnatPolicyDescriptorName,
+		NBKeyPrefix:          cnat.ModelSnatPolicy.KeyPrefix(),
+		ValueTypeName:        cnat.ModelSnatPolicy.ProtoName(),
+		KeySelector:          cnat.ModelSnatPolicy.IsKeyValid,
+		ValueComparator:      ctx.EquivalentSnatPolicies,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Update:               ctx.Update,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewSnatPolicyDescriptor(typedDescr)
+}
+
+// EquivalentSnatPolicies compares policies with addresses in the canonical form
+// and exclude prefixes in any order.
+func (d *SnatPolicyDescriptor) EquivalentSnatPolicies(key string, oldPolicy, newPolicy *cnat.SnatPolicy) bool {
+	if oldPolicy.Policy != newPolicy.Policy ||
+		oldPolicy.SnatInterface != newPolicy.SnatInterface ||
+		!equalAddresses(oldPolicy.SnatIpv4, newPolicy.SnatIpv4) ||
+		!equalAddresses(oldPolicy.SnatIpv6, newPolicy.SnatIpv6) {
+		return false
+	}
+	oldPrefixes, newPrefixes := prefixSet(oldPolicy.ExcludePrefixes), prefixSet(newPolicy.ExcludePrefixes)
+	if len(oldPrefixes) != len(newPrefixes) {
+		return false
+	}
+	for prefix := range oldPrefixes {
+		if _, found := newPrefixes[prefix]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates addresses and prefixes of the source NAT policy.
+func (d *SnatPolicyDescriptor) Validate(key string, policy *cnat.SnatPolicy) error {
+	if policy.SnatIpv4 != "" {
+		if ip := net.ParseIP(policy.SnatIpv4); ip == nil || ip.To4() == nil {
+			return kvs.NewInvalidValueError(ErrSnatPolicyInvalidAddress, "snat_ipv4")
+		}
+	}
+	if policy.SnatIpv6 != "" {
+		if ip := net.ParseIP(policy.SnatIpv6); ip == nil || ip.To4() != nil {
+			return kvs.NewInvalidValueError(ErrSnatPolicyInvalidAddress, "snat_ipv6")
+		}
+	}
+	for _, prefix := range policy.ExcludePrefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return kvs.NewInvalidValueError(ErrSnatPolicyInvalidPrefix, "exclude_prefixes")
+		}
+	}
+	return nil
+}
+
+// Create applies the source NAT policy.
+func (d *SnatPolicyDescriptor) Create(key string, policy *cnat.SnatPolicy) (metadata interface{}, err error) {
+	return d.Update(key, &cnat.SnatPolicy{}, policy, nil)
+}
+
+// Update re-applies the source NAT policy and updates the list of excluded prefixes.
+func (d *SnatPolicyDescriptor) Update(key string, oldPolicy, newPolicy *cnat.SnatPolicy, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	ctx := context.TODO()
+	if err = d.cnatHandler.SetSnatPolicy(ctx, newPolicy.Policy); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.cnatHandler.SetSnatAddresses(ctx, newPolicy.SnatIpv4, newPolicy.SnatIpv6, newPolicy.SnatInterface); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	oldPrefixes, newPrefixes := prefixSet(oldPolicy.ExcludePrefixes), prefixSet(newPolicy.ExcludePrefixes)
+	for prefix := range oldPrefixes {
+		if _, found := newPrefixes[prefix]; !found {
+			if err = d.cnatHandler.DelSnatExcludePrefix(ctx, prefix); err != nil {
+				d.log.Error(err)
+				return nil, err
+			}
+		}
+	}
+	for prefix := range newPrefixes {
+		if _, found := oldPrefixes[prefix]; !found {
+			if err = d.cnatHandler.AddSnatExcludePrefix(ctx, prefix); err != nil {
+				d.log.Error(err)
+				return nil, err
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Delete reverts the source NAT policy to defaults.
+func (d *SnatPolicyDescriptor) Delete(key string, policy *cnat.SnatPolicy, metadata interface{}) error {
+	_, err := d.Update(key, policy, &cnat.SnatPolicy{}, metadata)
+	return err
+}
+
+// Retrieve returns the source NAT policy. Only addresses can be dumped from VPP,
+// the policy and excluded prefixes are taken from NB.
+func (d *SnatPolicyDescriptor) Retrieve(correlate []adapter.SnatPolicyKVWithMetadata) (
+	retrieved []adapter.SnatPolicyKVWithMetadata, err error) {
+	policy, err := d.cnatHandler.DumpSnatAddresses(context.TODO())
+	if err != nil {
+		return nil, errors.Errorf("failed to dump cnat source NAT addresses: %v", err)
+	}
+	for _, kv := range correlate {
+		policy.Policy = kv.Value.Policy
+		policy.ExcludePrefixes = kv.Value.ExcludePrefixes
+	}
+
+	origin := kvs.FromNB
+	if proto.Equal(policy, &cnat.SnatPolicy{}) {
+		origin = kvs.FromSB
+	}
+	retrieved = append(retrieved, adapter.SnatPolicyKVWithMetadata{
+		Key:    cnat.SnatPolicyKey(),
+		Value:  policy,
+		Origin: origin,
+	})
+	return retrieved, nil
+}
+
+// Dependencies lists the interface providing source NAT addresses as the dependency.
+func (d *SnatPolicyDescriptor) Dependencies(key string, policy *cnat.SnatPolicy) (deps []kvs.Dependency) {
+	if policy.SnatInterface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: snatInterfaceDep,
+			Key:   interfaces.InterfaceKey(policy.SnatInterface),
+		})
+	}
+	return deps
+}
+
+// equalAddresses compares IP addresses in the canonical form.
+func equalAddresses(addr1, addr2 string) bool {
+	if addr1 == "" || addr2 == "" {
+		return addr1 == addr2
+	}
+	return net.ParseIP(addr1).Equal(net.ParseIP(addr2))
+}
+
+// prefixSet returns set of prefixes in the canonical form.
+func prefixSet(prefixes []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(prefixes))
+	for _, prefix := range prefixes {
+		if _, network, err := net.ParseCIDR(prefix); err == nil {
+			prefix = network.String()
+		}
+		set[prefix] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// SnatPolicyInterfaceDescriptorName is the name of the descriptor for interfaces
+	// enabled in the cnat source NAT policy.
+	SnatPolicyInterfaceDescriptorName = "vpp-cnat-snat-policy-interface"
+)
+
+// ErrSnatPolicyInterfaceWithoutName is returned when source NAT policy interface
+// is defined without interface name.
+var ErrSnatPolicyInterfaceWithoutName = errors.New("cnat source NAT policy interface defined without interface name")
+
+// SnatPolicyInterfaceDescriptor teaches KVScheduler how to enable interfaces
+// in the source NAT policy of the VPP cnat plugin.
+// The interfaces cannot be dumped from VPP, Retrieve is therefore not implemented
+// and the configuration is re-applied on resync.
+type SnatPolicyInterfaceDescriptor struct {
+	log         logging.Logger
+	cnatHandler vppcalls.CnatVppAPI
+}
+
+// NewSnatPolicyInterfaceDescriptor creates a new instance of the SnatPolicyInterface descriptor.
+func NewSnatPolicyInterfaceDescriptor(cnatHandler vppcalls.CnatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &SnatPolicyInterfaceDescriptor{
+		log:         log.NewLogger("cnat-snat-policy-interface-descriptor"),
+		cnatHandler: cnatHandler,
+	}
+	typedDescr := &adapter.SnatPolicyInterfaceDescriptor{
+		Name:          SnatPolicyInterfaceDescriptorName,
+		NBKeyPrefix:   cnat.ModelSnatPolicyInterface.KeyPrefix(),
+		ValueTypeName: cnat.ModelSnatPolicyInterface.ProtoName(),
+		KeySelector:   cnat.ModelSnatPolicyInterface.IsKeyValid,
+		KeyLabel:      cnat.ModelSnatPolicyInterface.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewSnatPolicyInterfaceDescriptor(typedDescr)
+}
+
+// Validate validates source NAT policy interface.
+func (d *SnatPolicyInterfaceDescriptor) Validate(key string, snatIf *cnat.SnatPolicyInterface) error {
+	if snatIf.Interface == "" {
+		return kvs.NewInvalidValueError(ErrSnatPolicyInterfaceWithoutName, "interface")
+	}
+	return nil
+}
+
+// Create enables interface in the source NAT policy.
+func (d *SnatPolicyInterfaceDescriptor) Create(key string, snatIf *cnat.SnatPolicyInterface) (metadata interface{}, err error) {
+	if err = d.cnatHandler.EnableSnatPolicyInterface(context.TODO(), snatIf.Interface, snatIf.Table); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete disables interface in the source NAT policy.
+func (d *SnatPolicyInterfaceDescriptor) Delete(key string, snatIf *cnat.SnatPolicyInterface, metadata interface{}) error {
+	if err := d.cnatHandler.DisableSnatPolicyInterface(context.TODO(), snatIf.Interface, snatIf.Table); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Dependencies lists the interface as the only dependency.
+func (d *SnatPolicyInterfaceDescriptor) Dependencies(key string, snatIf *cnat.SnatPolicyInterface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: cnatInterfaceDep,
+			Key:   interfaces.InterfaceKey(snatIf.Interface),
+		},
+	}
+}
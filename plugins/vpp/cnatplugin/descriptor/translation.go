@@ -0,0 +1,277 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/cnatidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// TranslationDescriptorName is the name of the descriptor for cnat translations.
+	TranslationDescriptorName = "vpp-cnat-translation"
+
+	// maximum L4 port number
+	maxPort = 0xFFFF
+
+	// dependency labels
+	cnatInterfaceDep = "interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrTranslationWithoutVIP is returned when cnat translation has undefined VIP.
+	ErrTranslationWithoutVIP = errors.New("cnat translation defined without VIP")
+
+	// ErrBackendWithoutDst is returned when backend of cnat translation has
+	// undefined destination.
+	ErrBackendWithoutDst = errors.New("cnat backend defined without destination")
+
+	// ErrEndpointInvalidAddress is returned when cnat endpoint address is not
+	// a valid IP address.
+	ErrEndpointInvalidAddress = errors.New("cnat endpoint address is not valid")
+
+	// ErrEndpointInvalidPort is returned when cnat endpoint port exceeds the maximum.
+	ErrEndpointInvalidPort = errors.New("cnat endpoint port is not valid")
+)
+
+// TranslationDescriptor teaches KVScheduler how to configure VPP cnat translations.
+type TranslationDescriptor struct {
+	log         logging.Logger
+	cnatHandler vppcalls.CnatVppAPI
+}
+
+// NewTranslationDescriptor creates a new instance of the Translation descriptor.
+func NewTranslationDescriptor(cnatHandler vppcalls.CnatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &TranslationDescriptor{
+		log:         log.NewLogger("cnat-translation-descriptor"),
+		cnatHandler: cnatHandler,
+	}
+	typedDescr := &adapter.TranslationDescriptor{
+		Name:                 TranslationDescriptorName,
+		NBKeyPrefix:          cnat.ModelTranslation.KeyPrefix(),
+		ValueTypeName:        cnat.ModelTranslation.ProtoName(),
+		KeySelector:          cnat.ModelTranslation.IsKeyValid,
+		KeyLabel:             cnat.ModelTranslation.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentTranslations,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+		WithMetadata:         true,
+	}
+	return adapter.NewTranslationDescriptor(typedDescr)
+}
+
+// EquivalentTranslations compares translations with backend weights normalized.
+func (d *TranslationDescriptor) EquivalentTranslations(key string, oldTranslation, newTranslation *cnat.Translation) bool {
+	return proto.Equal(normalizeTranslation(oldTranslation), normalizeTranslation(newTranslation))
+}
+
+// Validate validates VPP cnat translation configuration.
+func (d *TranslationDescriptor) Validate(key string, translation *cnat.Translation) error {
+	if translation.GetVip() == nil ||
+		(translation.Vip.Address == "" && translation.Vip.Interface == "") {
+		return kvs.NewInvalidValueError(ErrTranslationWithoutVIP, "vip")
+	}
+	if err := validateEndpoint(translation.Vip, "vip"); err != nil {
+		return err
+	}
+	for _, backend := range translation.Backends {
+		if backend.GetDst() == nil ||
+			(backend.Dst.Address == "" && backend.Dst.Interface == "") {
+			return kvs.NewInvalidValueError(ErrBackendWithoutDst, "backends.dst")
+		}
+		if err := validateEndpoint(backend.Dst, "backends.dst"); err != nil {
+			return err
+		}
+		if err := validateEndpoint(backend.Src, "backends.src"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create adds new cnat translation.
+func (d *TranslationDescriptor) Create(key string, translation *cnat.Translation) (*cnatidx.TranslationMetadata, error) {
+	id, err := d.cnatHandler.AddTranslation(context.TODO(), translation)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return &cnatidx.TranslationMetadata{ID: id}, nil
+}
+
+// Update updates backends and attributes of cnat translation in-place
+// (VPP identifies translations by VIP and protocol).
+func (d *TranslationDescriptor) Update(key string, oldTranslation, newTranslation *cnat.Translation,
+	oldMetadata *cnatidx.TranslationMetadata) (*cnatidx.TranslationMetadata, error) {
+	return d.Create(key, newTranslation)
+}
+
+// UpdateWithRecreate returns true if VIP or protocol of the translation changes.
+func (d *TranslationDescriptor) UpdateWithRecreate(key string, oldTranslation, newTranslation *cnat.Translation,
+	oldMetadata *cnatidx.TranslationMetadata) bool {
+	return oldTranslation.Protocol != newTranslation.Protocol ||
+		endpointID(oldTranslation.Vip) != endpointID(newTranslation.Vip)
+}
+
+// Delete removes cnat translation.
+func (d *TranslationDescriptor) Delete(key string, translation *cnat.Translation, metadata *cnatidx.TranslationMetadata) error {
+	if metadata == nil {
+		return errors.Errorf("failed to delete cnat translation %s - metadata is nil", translation.Label)
+	}
+	if err := d.cnatHandler.DeleteTranslation(context.TODO(), metadata.ID); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all cnat translations. Translations are not labeled in VPP,
+// they are therefore correlated with NB by VIP and protocol.
+func (d *TranslationDescriptor) Retrieve(correlate []adapter.TranslationKVWithMetadata) (
+	retrieved []adapter.TranslationKVWithMetadata, err error) {
+	labels := make(map[string]string)
+	for _, kv := range correlate {
+		labels[translationID(kv.Value)] = kv.Value.Label
+	}
+
+	translations, err := d.cnatHandler.DumpTranslations(context.TODO())
+	if err != nil {
+		return nil, errors.Errorf("failed to dump cnat translations: %v", err)
+	}
+	for _, details := range translations {
+		translation := details.Translation
+		label, found := labels[translationID(translation)]
+		if !found {
+			label = fmt.Sprintf("translation-%d", details.Meta.ID)
+		}
+		translation.Label = label
+		retrieved = append(retrieved, adapter.TranslationKVWithMetadata{
+			Key:      cnat.TranslationKey(label),
+			Value:    translation,
+			Metadata: &cnatidx.TranslationMetadata{ID: details.Meta.ID},
+			Origin:   kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists interfaces referenced by endpoints of the translation.
+func (d *TranslationDescriptor) Dependencies(key string, translation *cnat.Translation) (deps []kvs.Dependency) {
+	ifaces := make(map[string]struct{})
+	addIface := func(ep *cnat.Endpoint) {
+		if ep.GetAddress() == "" && ep.GetInterface() != "" {
+			ifaces[ep.Interface] = struct{}{}
+		}
+	}
+	addIface(translation.Vip)
+	for _, backend := range translation.Backends {
+		addIface(backend.Dst)
+		addIface(backend.Src)
+	}
+	for iface := range ifaces {
+		deps = append(deps, kvs.Dependency{
+			Label: cnatInterfaceDep + "-" + iface,
+			Key:   interfaces.InterfaceKey(iface),
+		})
+	}
+	return deps
+}
+
+// validateEndpoint validates address and port of (optional) endpoint.
+func validateEndpoint(ep *cnat.Endpoint, field string) error {
+	if ep.GetAddress() != "" && net.ParseIP(ep.Address) == nil {
+		return kvs.NewInvalidValueError(ErrEndpointInvalidAddress, field+".address")
+	}
+	if ep.GetPort() > maxPort {
+		return kvs.NewInvalidValueError(ErrEndpointInvalidPort, field+".port")
+	}
+	return nil
+}
+
+// translationID returns identifier of the translation used by VPP (VIP and protocol).
+func translationID(translation *cnat.Translation) string {
+	return translation.Protocol.String() + "/" + endpointID(translation.Vip)
+}
+
+// endpointID returns string uniquely identifying the endpoint, address
+// takes precedence over the interface.
+func endpointID(ep *cnat.Endpoint) string {
+	if ip := net.ParseIP(ep.GetAddress()); ip != nil {
+		return fmt.Sprintf("%s:%d", ip, ep.GetPort())
+	}
+	return fmt.Sprintf("%s(ipv6=%t):%d", ep.GetInterface(), ep.GetIsIpv6(), ep.GetPort())
+}
+
+// normalizeTranslation returns copy of the translation with addresses in the canonical
+// form and equal adjacent backends merged into a single backend with summed weights.
+func normalizeTranslation(translation *cnat.Translation) *cnat.Translation {
+	normalized := proto.Clone(translation).(*cnat.Translation)
+	normalized.Vip = normalizeEndpoint(normalized.Vip)
+	normalized.Backends = nil
+	var last *cnat.Translation_Backend
+	for _, backend := range translation.Backends {
+		weight := backend.GetWeight()
+		if weight == 0 {
+			weight = 1
+		}
+		nb := &cnat.Translation_Backend{
+			Dst: normalizeEndpoint(backend.GetDst()),
+			Src: normalizeEndpoint(backend.GetSrc()),
+		}
+		if last != nil && proto.Equal(last.Dst, nb.Dst) && proto.Equal(last.Src, nb.Src) {
+			last.Weight += weight
+			continue
+		}
+		nb.Weight = weight
+		normalized.Backends = append(normalized.Backends, nb)
+		last = nb
+	}
+	return normalized
+}
+
+// normalizeEndpoint returns copy of the endpoint with address in the canonical form.
+// Empty endpoint is normalized to nil.
+func normalizeEndpoint(ep *cnat.Endpoint) *cnat.Endpoint {
+	if ep == nil || proto.Equal(ep, &cnat.Endpoint{}) {
+		return nil
+	}
+	normalized := proto.Clone(ep).(*cnat.Endpoint)
+	if ip := net.ParseIP(ep.Address); ip != nil {
+		normalized.Address = ip.String()
+		normalized.Interface = ""
+		normalized.IsIpv6 = false
+	}
+	return normalized
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	"context"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+// TranslationDetails contains proto-modeled cnat translation together
+// with VPP-related metadata.
+type TranslationDetails struct {
+	Translation *cnat.Translation `json:"translation"`
+	Meta        *TranslationMeta  `json:"translation_meta"`
+}
+
+// TranslationMeta contains VPP-assigned identifier of the translation.
+type TranslationMeta struct {
+	ID uint32 `json:"id"`
+}
+
+// CnatVppAPI provides read/write methods required to handle VPP cnat plugin.
+type CnatVppAPI interface {
+	CnatVppRead
+
+	// AddTranslation creates cnat translation or updates translation with the same
+	// VIP and protocol. Returns identifier of the translation assigned by VPP.
+	AddTranslation(ctx context.Context, translation *cnat.Translation) (id uint32, err error)
+	// DeleteTranslation removes cnat translation with the given identifier.
+	DeleteTranslation(ctx context.Context, id uint32) error
+	// SetSnatPolicy selects traffic which is subject to the source NAT.
+	SetSnatPolicy(ctx context.Context, policy cnat.SnatPolicy_Policy) error
+	// SetSnatAddresses sets addresses (or interface to take the addresses from)
+	// used for the source NAT.
+	SetSnatAddresses(ctx context.Context, ipv4, ipv6, iface string) error
+	// AddSnatExcludePrefix excludes destination prefix from the source NAT.
+	AddSnatExcludePrefix(ctx context.Context, prefix string) error
+	// DelSnatExcludePrefix removes destination prefix from the list of prefixes
+	// excluded from the source NAT.
+	DelSnatExcludePrefix(ctx context.Context, prefix string) error
+	// EnableSnatPolicyInterface adds interface into the given table of the source NAT policy.
+	EnableSnatPolicyInterface(ctx context.Context, iface string, table cnat.SnatPolicyInterface_Table) error
+	// DisableSnatPolicyInterface removes interface from the given table of the source NAT policy.
+	DisableSnatPolicyInterface(ctx context.Context, iface string, table cnat.SnatPolicyInterface_Table) error
+}
+
+// CnatVppRead provides read methods for VPP cnat plugin.
+type CnatVppRead interface {
+	// DumpTranslations retrieves all cnat translations. Translations are not labeled
+	// in VPP, labels are therefore left empty.
+	DumpTranslations(ctx context.Context) ([]*TranslationDetails, error)
+	// DumpSnatAddresses retrieves addresses used for the source NAT. Only snat_ipv4,
+	// snat_ipv6 and snat_interface of the returned policy are filled.
+	DumpSnatAddresses(ctx context.Context) (*cnat.SnatPolicy, error)
+	// DumpSessions retrieves all cnat sessions.
+	DumpSessions(ctx context.Context) ([]*cnat.Session, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "cnat",
+	HandlerAPI: (*CnatVppAPI)(nil),
+})
+
+type NewHandlerFunc func(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) CnatVppAPI
+
+func AddCnatHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			return h(c, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleCnatVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) CnatVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(CnatVppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202_test
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+const noInterface = ^interface_types.InterfaceIndex(0)
+
+func TestAddTranslation(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationUpdateReply{
+		ID: 3,
+	})
+	id, err := cnatHandler.AddTranslation(ctx.Context, &cnat.Translation{
+		Label:     "svc1",
+		Protocol:  cnat.Translation_UDP,
+		Vip:       &cnat.Endpoint{Address: "10.96.0.10", Port: 53},
+		AllocPort: true,
+		LbType:    cnat.Translation_MAGLEV,
+		Backends: []*cnat.Translation_Backend{
+			{
+				Dst:    &cnat.Endpoint{Address: "10.1.0.2", Port: 5353},
+				Weight: 2,
+			},
+			{
+				Dst: &cnat.Endpoint{Address: "10.1.0.3", Port: 5353},
+				Src: &cnat.Endpoint{Interface: "if0"},
+			},
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(id).To(BeEquivalentTo(3))
+
+	vip := vpp_cnat.CnatEndpoint{
+		Addr:      ip_types.NewAddress(net.ParseIP("10.96.0.10").To4()),
+		SwIfIndex: noInterface,
+		Port:      53,
+	}
+	backend1 := vpp_cnat.CnatEndpointTuple{
+		DstEp: vpp_cnat.CnatEndpoint{
+			Addr:      ip_types.NewAddress(net.ParseIP("10.1.0.2").To4()),
+			SwIfIndex: noInterface,
+			Port:      5353,
+		},
+		SrcEp: vpp_cnat.CnatEndpoint{
+			SwIfIndex: noInterface,
+		},
+	}
+	backend2 := vpp_cnat.CnatEndpointTuple{
+		DstEp: vpp_cnat.CnatEndpoint{
+			Addr:      ip_types.NewAddress(net.ParseIP("10.1.0.3").To4()),
+			SwIfIndex: noInterface,
+			Port:      5353,
+		},
+		SrcEp: vpp_cnat.CnatEndpoint{
+			SwIfIndex: 1,
+		},
+	}
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatTranslationUpdate{
+		Translation: vpp_cnat.CnatTranslation{
+			Vip:     vip,
+			IPProto: ip_types.IP_API_PROTO_UDP,
+			Flags:   uint8(vpp_cnat.CNAT_TRANSLATION_ALLOC_PORT),
+			LbType:  vpp_cnat.CNAT_LB_TYPE_MAGLEV,
+			NPaths:  3,
+			Paths:   []vpp_cnat.CnatEndpointTuple{backend1, backend1, backend2},
+		},
+	}))
+}
+
+func TestAddTranslationMissingInterface(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := cnatHandler.AddTranslation(ctx.Context, &cnat.Translation{
+		Label: "svc1",
+		Vip:   &cnat.Endpoint{Interface: "if1", Port: 80},
+	})
+	Expect(err).Should(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationDelReply{})
+	err := cnatHandler.DeleteTranslation(ctx.Context, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatTranslationDel{
+		ID: 3,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationDelReply{
+		Retval: -1,
+	})
+	err = cnatHandler.DeleteTranslation(ctx.Context, 4)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestSetSnatPolicy(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSetSnatPolicyReply{})
+	err := cnatHandler.SetSnatPolicy(ctx.Context, cnat.SnatPolicy_K8S)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatSetSnatPolicy{
+		Policy: vpp_cnat.CNAT_POLICY_K8S,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSetSnatAddressesReply{})
+	err = cnatHandler.SetSnatAddresses(ctx.Context, "192.168.1.1", "", "if0")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatSetSnatAddresses{
+		SnatIP4:   ip_types.IP4Address{192, 168, 1, 1},
+		SwIfIndex: 1,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSnatPolicyAddDelExcludePfxReply{})
+	err = cnatHandler.AddSnatExcludePrefix(ctx.Context, "10.96.0.0/12")
+	Expect(err).ShouldNot(HaveOccurred())
+	prefix, _ := ip_types.ParsePrefix("10.96.0.0/12")
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatSnatPolicyAddDelExcludePfx{
+		IsAdd:  1,
+		Prefix: prefix,
+	}))
+}
+
+func TestSnatPolicyInterface(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSnatPolicyAddDelIfReply{})
+	err := cnatHandler.EnableSnatPolicyInterface(ctx.Context, "if0", cnat.SnatPolicyInterface_POD)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatSnatPolicyAddDelIf{
+		SwIfIndex: 1,
+		IsAdd:     1,
+		Table:     vpp_cnat.CNAT_POLICY_POD,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSnatPolicyAddDelIfReply{})
+	err = cnatHandler.DisableSnatPolicyInterface(ctx.Context, "if0", cnat.SnatPolicyInterface_INCLUDE_V6)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_cnat.CnatSnatPolicyAddDelIf{
+		SwIfIndex: 1,
+		Table:     vpp_cnat.CNAT_POLICY_INCLUDE_V6,
+	}))
+
+	err = cnatHandler.EnableSnatPolicyInterface(ctx.Context, "if1", cnat.SnatPolicyInterface_POD)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDumpTranslations(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	backend := vpp_cnat.CnatEndpointTuple{
+		DstEp: vpp_cnat.CnatEndpoint{
+			Addr:      ip_types.NewAddress(net.ParseIP("10.1.0.2").To4()),
+			SwIfIndex: noInterface,
+			Port:      8080,
+		},
+		SrcEp: vpp_cnat.CnatEndpoint{
+			SwIfIndex: noInterface,
+		},
+	}
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationDetails{
+		Translation: vpp_cnat.CnatTranslation{
+			Vip: vpp_cnat.CnatEndpoint{
+				SwIfIndex: 1,
+				IfAf:      ip_types.ADDRESS_IP4,
+				Port:      30080,
+			},
+			ID:       7,
+			IPProto:  ip_types.IP_API_PROTO_TCP,
+			IsRealIP: 1,
+			NPaths:   3,
+			Paths: []vpp_cnat.CnatEndpointTuple{backend, backend, {
+				DstEp: vpp_cnat.CnatEndpoint{
+					Addr:      ip_types.NewAddress(net.ParseIP("10.1.0.3").To4()),
+					SwIfIndex: noInterface,
+					Port:      8080,
+				},
+				SrcEp: vpp_cnat.CnatEndpoint{
+					Addr:      ip_types.NewAddress(net.ParseIP("192.168.1.1").To4()),
+					SwIfIndex: noInterface,
+				},
+			}},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	translations, err := cnatHandler.DumpTranslations(ctx.Context)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(translations).To(HaveLen(1))
+	Expect(translations[0].Meta).To(Equal(&vppcalls.TranslationMeta{ID: 7}))
+	Expect(translations[0].Translation).To(Equal(&cnat.Translation{
+		Protocol: cnat.Translation_TCP,
+		Vip:      &cnat.Endpoint{Interface: "if0", Port: 30080},
+		IsRealIp: true,
+		Backends: []*cnat.Translation_Backend{
+			{
+				Dst:    &cnat.Endpoint{Address: "10.1.0.2", Port: 8080},
+				Weight: 2,
+			},
+			{
+				Dst: &cnat.Endpoint{Address: "10.1.0.3", Port: 8080},
+				Src: &cnat.Endpoint{Address: "192.168.1.1"},
+			},
+		},
+	}))
+}
+
+func TestDumpSnatAddresses(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatGetSnatAddressesReply{
+		SnatIP4:   ip_types.IP4Address{192, 168, 1, 1},
+		SwIfIndex: noInterface,
+	})
+	policy, err := cnatHandler.DumpSnatAddresses(ctx.Context)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(policy).To(Equal(&cnat.SnatPolicy{
+		SnatIpv4: "192.168.1.1",
+	}))
+}
+
+func TestDumpSessions(t *testing.T) {
+	ctx, cnatHandler := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSessionDetails{
+		Session: vpp_cnat.CnatSession{
+			Src: vpp_cnat.CnatEndpoint{
+				Addr:      ip_types.NewAddress(net.ParseIP("10.1.0.5").To4()),
+				SwIfIndex: noInterface,
+				Port:      40000,
+			},
+			Dst: vpp_cnat.CnatEndpoint{
+				Addr:      ip_types.NewAddress(net.ParseIP("10.96.0.10").To4()),
+				SwIfIndex: noInterface,
+				Port:      53,
+			},
+			New: vpp_cnat.CnatEndpoint{
+				Addr:      ip_types.NewAddress(net.ParseIP("10.1.0.2").To4()),
+				SwIfIndex: noInterface,
+				Port:      5353,
+			},
+			IPProto:   ip_types.IP_API_PROTO_UDP,
+			Timestamp: 12.5,
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := cnatHandler.DumpSessions(ctx.Context)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(sessions).To(Equal([]*cnat.Session{{
+		Src:       &cnat.Endpoint{Address: "10.1.0.5", Port: 40000},
+		Dst:       &cnat.Endpoint{Address: "10.96.0.10", Port: 53},
+		New:       &cnat.Endpoint{Address: "10.1.0.2", Port: 5353},
+		IpProto:   17,
+		Timestamp: 12.5,
+	}}))
+}
+
+func cnatTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.CnatVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test")
+	ifIndexes := ifaceidx.NewIfaceIndex(log, "test")
+	ifIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	return ctx, vpp2202.NewCnatVppHandler(ctx.MockVPPClient, ifIndexes, log)
+}
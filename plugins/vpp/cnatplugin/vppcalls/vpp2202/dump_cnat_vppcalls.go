@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+// DumpTranslations implements cnat handler.
+func (h *CnatVppHandler) DumpTranslations(ctx context.Context) (translations []*vppcalls.TranslationDetails, err error) {
+	stream, err := h.cnat.CnatTranslationDump(ctx, &vpp_cnat.CnatTranslationDump{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dump cnat translations")
+	}
+	for {
+		details, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "failed to dump cnat translations")
+		}
+		vppTranslation := details.Translation
+		translation := &cnat.Translation{
+			Protocol:  protocolFromVpp(vppTranslation.IPProto),
+			Vip:       h.endpointFromVpp(vppTranslation.Vip),
+			IsRealIp:  vppTranslation.IsRealIP == 1,
+			AllocPort: vppTranslation.Flags&uint8(vpp_cnat.CNAT_TRANSLATION_ALLOC_PORT) != 0,
+			LbType:    lbTypeFromVpp(vppTranslation.LbType),
+		}
+		// backend with weight is configured as repeated path
+		var lastPath *vpp_cnat.CnatEndpointTuple
+		var lastBackend *cnat.Translation_Backend
+		for i := range vppTranslation.Paths {
+			path := &vppTranslation.Paths[i]
+			if lastPath != nil && *lastPath == *path {
+				lastBackend.Weight = backendWeight(lastBackend) + 1
+				continue
+			}
+			lastPath = path
+			lastBackend = &cnat.Translation_Backend{
+				Dst: h.endpointFromVpp(path.DstEp),
+			}
+			if src := h.endpointFromVpp(path.SrcEp); src.Address != "" || src.Interface != "" || src.Port != 0 {
+				lastBackend.Src = src
+			}
+			translation.Backends = append(translation.Backends, lastBackend)
+		}
+		translations = append(translations, &vppcalls.TranslationDetails{
+			Translation: translation,
+			Meta: &vppcalls.TranslationMeta{
+				ID: vppTranslation.ID,
+			},
+		})
+	}
+	return translations, nil
+}
+
+// DumpSnatAddresses implements cnat handler.
+func (h *CnatVppHandler) DumpSnatAddresses(ctx context.Context) (*cnat.SnatPolicy, error) {
+	reply, err := h.cnat.CnatGetSnatAddresses(ctx, &vpp_cnat.CnatGetSnatAddresses{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cnat source NAT addresses")
+	}
+	policy := &cnat.SnatPolicy{}
+	if !reply.SnatIP4.ToIP().IsUnspecified() {
+		policy.SnatIpv4 = reply.SnatIP4.String()
+	}
+	if !reply.SnatIP6.ToIP().IsUnspecified() {
+		policy.SnatIpv6 = reply.SnatIP6.String()
+	}
+	if reply.SwIfIndex != ^interface_types.InterfaceIndex(0) {
+		if ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(reply.SwIfIndex)); found {
+			policy.SnatInterface = ifName
+		}
+	}
+	return policy, nil
+}
+
+// DumpSessions implements cnat handler.
+func (h *CnatVppHandler) DumpSessions(ctx context.Context) (sessions []*cnat.Session, err error) {
+	stream, err := h.cnat.CnatSessionDump(ctx, &vpp_cnat.CnatSessionDump{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dump cnat sessions")
+	}
+	for {
+		details, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "failed to dump cnat sessions")
+		}
+		session := details.Session
+		sessions = append(sessions, &cnat.Session{
+			Src:       h.endpointFromVpp(session.Src),
+			Dst:       h.endpointFromVpp(session.Dst),
+			New:       h.endpointFromVpp(session.New),
+			IpProto:   uint32(session.IPProto),
+			Location:  uint32(session.Location),
+			Timestamp: session.Timestamp,
+		})
+	}
+	return sessions, nil
+}
+
+// endpointFromVpp converts VPP endpoint to the NB format. Endpoint resolved
+// from interface is returned with the interface only.
+func (h *CnatVppHandler) endpointFromVpp(vppEp vpp_cnat.CnatEndpoint) *cnat.Endpoint {
+	ep := &cnat.Endpoint{
+		Port: uint32(vppEp.Port),
+	}
+	if vppEp.SwIfIndex != ^interface_types.InterfaceIndex(0) {
+		if ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(vppEp.SwIfIndex)); found {
+			ep.Interface = ifName
+			ep.IsIpv6 = vppEp.IfAf == ip_types.ADDRESS_IP6
+			return ep
+		}
+		h.log.Debugf("cnat dump: interface with index %d not found", vppEp.SwIfIndex)
+	}
+	if ip := vppEp.Addr.ToIP(); !ip.IsUnspecified() {
+		ep.Address = ip.String()
+	}
+	return ep
+}
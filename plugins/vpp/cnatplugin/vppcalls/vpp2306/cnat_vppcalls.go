@@ -0,0 +1,268 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+// AddTranslation implements cnat handler.
+func (h *CnatVppHandler) AddTranslation(ctx context.Context, translation *cnat.Translation) (uint32, error) {
+	vip, err := h.endpointToVpp(translation.GetVip())
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid VIP of cnat translation %s", translation.Label)
+	}
+	vppTranslation := vpp_cnat.CnatTranslation{
+		Vip:      vip,
+		IPProto:  protocolToVpp(translation.Protocol),
+		IsRealIP: boolToUint(translation.IsRealIp),
+		LbType:   lbTypeToVpp(translation.LbType),
+	}
+	if translation.AllocPort {
+		vppTranslation.Flags = uint8(vpp_cnat.CNAT_TRANSLATION_ALLOC_PORT)
+	}
+	for _, backend := range translation.Backends {
+		dst, err := h.endpointToVpp(backend.GetDst())
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid backend of cnat translation %s", translation.Label)
+		}
+		src, err := h.endpointToVpp(backend.GetSrc())
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid backend of cnat translation %s", translation.Label)
+		}
+		if backend.GetSrc() == nil {
+			// keep the source untranslated in the address family of the backend
+			src.Addr.Af = dst.Addr.Af
+		}
+		// weights are implemented by repeating the backend as a path
+		for i := uint32(0); i < backendWeight(backend); i++ {
+			vppTranslation.Paths = append(vppTranslation.Paths, vpp_cnat.CnatEndpointTuple{
+				DstEp: dst,
+				SrcEp: src,
+			})
+		}
+	}
+	vppTranslation.NPaths = uint32(len(vppTranslation.Paths))
+
+	reply, err := h.cnat.CnatTranslationUpdate(ctx, &vpp_cnat.CnatTranslationUpdate{
+		Translation: vppTranslation,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to add cnat translation %s", translation.Label)
+	}
+	return reply.ID, nil
+}
+
+// DeleteTranslation implements cnat handler.
+func (h *CnatVppHandler) DeleteTranslation(ctx context.Context, id uint32) error {
+	if _, err := h.cnat.CnatTranslationDel(ctx, &vpp_cnat.CnatTranslationDel{
+		ID: id,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete cnat translation with ID %d", id)
+	}
+	return nil
+}
+
+// SetSnatPolicy implements cnat handler.
+func (h *CnatVppHandler) SetSnatPolicy(ctx context.Context, policy cnat.SnatPolicy_Policy) error {
+	if _, err := h.cnat.CnatSetSnatPolicy(ctx, &vpp_cnat.CnatSetSnatPolicy{
+		Policy: snatPolicyToVpp(policy),
+	}); err != nil {
+		return errors.Wrap(err, "failed to set cnat source NAT policy")
+	}
+	return nil
+}
+
+// SetSnatAddresses implements cnat handler.
+func (h *CnatVppHandler) SetSnatAddresses(ctx context.Context, ipv4, ipv6, iface string) (err error) {
+	req := &vpp_cnat.CnatSetSnatAddresses{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	}
+	if ipv4 != "" {
+		if req.SnatIP4, err = ip_types.ParseIP4Address(ipv4); err != nil {
+			return err
+		}
+	}
+	if ipv6 != "" {
+		if req.SnatIP6, err = ip_types.ParseIP6Address(ipv6); err != nil {
+			return err
+		}
+	}
+	if iface != "" {
+		ifMeta, found := h.ifIndexes.LookupByName(iface)
+		if !found {
+			return errors.Errorf("failed to set cnat source NAT addresses: interface %s not found", iface)
+		}
+		req.SwIfIndex = interface_types.InterfaceIndex(ifMeta.SwIfIndex)
+	}
+	if _, err = h.cnat.CnatSetSnatAddresses(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to set cnat source NAT addresses")
+	}
+	return nil
+}
+
+// AddSnatExcludePrefix implements cnat handler.
+func (h *CnatVppHandler) AddSnatExcludePrefix(ctx context.Context, prefix string) error {
+	return h.addDelSnatExcludePrefix(ctx, prefix, true)
+}
+
+// DelSnatExcludePrefix implements cnat handler.
+func (h *CnatVppHandler) DelSnatExcludePrefix(ctx context.Context, prefix string) error {
+	return h.addDelSnatExcludePrefix(ctx, prefix, false)
+}
+
+func (h *CnatVppHandler) addDelSnatExcludePrefix(ctx context.Context, prefix string, isAdd bool) error {
+	vppPrefix, err := ip_types.ParsePrefix(prefix)
+	if err != nil {
+		return err
+	}
+	if _, err = h.cnat.CnatSnatPolicyAddDelExcludePfx(ctx, &vpp_cnat.CnatSnatPolicyAddDelExcludePfx{
+		IsAdd:  boolToUint(isAdd),
+		Prefix: vppPrefix,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update cnat source NAT exclude prefix %s", prefix)
+	}
+	return nil
+}
+
+// EnableSnatPolicyInterface implements cnat handler.
+func (h *CnatVppHandler) EnableSnatPolicyInterface(ctx context.Context, iface string, table cnat.SnatPolicyInterface_Table) error {
+	return h.addDelSnatPolicyInterface(ctx, iface, table, true)
+}
+
+// DisableSnatPolicyInterface implements cnat handler.
+func (h *CnatVppHandler) DisableSnatPolicyInterface(ctx context.Context, iface string, table cnat.SnatPolicyInterface_Table) error {
+	return h.addDelSnatPolicyInterface(ctx, iface, table, false)
+}
+
+func (h *CnatVppHandler) addDelSnatPolicyInterface(ctx context.Context, iface string,
+	table cnat.SnatPolicyInterface_Table, isAdd bool) error {
+	ifMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.Errorf("failed to update cnat source NAT policy: interface %s not found", iface)
+	}
+	if _, err := h.cnat.CnatSnatPolicyAddDelIf(ctx, &vpp_cnat.CnatSnatPolicyAddDelIf{
+		SwIfIndex: interface_types.InterfaceIndex(ifMeta.SwIfIndex),
+		IsAdd:     boolToUint(isAdd),
+		Table:     snatPolicyTableToVpp(table),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update cnat source NAT policy of interface %s", iface)
+	}
+	return nil
+}
+
+// endpointToVpp converts endpoint to VPP format. Endpoint with interface and without
+// address is resolved by VPP.
+func (h *CnatVppHandler) endpointToVpp(ep *cnat.Endpoint) (vppEp vpp_cnat.CnatEndpoint, err error) {
+	vppEp.SwIfIndex = ^interface_types.InterfaceIndex(0)
+	vppEp.Port = uint16(ep.GetPort())
+	if ep.GetAddress() != "" {
+		if vppEp.Addr, err = ip_types.ParseAddress(ep.Address); err != nil {
+			return vppEp, err
+		}
+		return vppEp, nil
+	}
+	if ep.GetInterface() != "" {
+		ifMeta, found := h.ifIndexes.LookupByName(ep.Interface)
+		if !found {
+			return vppEp, errors.Errorf("interface %s not found", ep.Interface)
+		}
+		vppEp.SwIfIndex = interface_types.InterfaceIndex(ifMeta.SwIfIndex)
+		if ep.IsIpv6 {
+			vppEp.IfAf = ip_types.ADDRESS_IP6
+		}
+	}
+	return vppEp, nil
+}
+
+// backendWeight returns weight of the backend, unset weight is treated as 1.
+func backendWeight(backend *cnat.Translation_Backend) uint32 {
+	if backend.GetWeight() == 0 {
+		return 1
+	}
+	return backend.GetWeight()
+}
+
+func boolToUint(input bool) uint8 {
+	if input {
+		return 1
+	}
+	return 0
+}
+
+func protocolToVpp(protocol cnat.Translation_Protocol) ip_types.IPProto {
+	switch protocol {
+	case cnat.Translation_UDP:
+		return ip_types.IP_API_PROTO_UDP
+	case cnat.Translation_SCTP:
+		return ip_types.IP_API_PROTO_SCTP
+	default:
+		return ip_types.IP_API_PROTO_TCP
+	}
+}
+
+func protocolFromVpp(protocol ip_types.IPProto) cnat.Translation_Protocol {
+	switch protocol {
+	case ip_types.IP_API_PROTO_UDP:
+		return cnat.Translation_UDP
+	case ip_types.IP_API_PROTO_SCTP:
+		return cnat.Translation_SCTP
+	default:
+		return cnat.Translation_TCP
+	}
+}
+
+func lbTypeToVpp(lbType cnat.Translation_LBType) vpp_cnat.CnatLbType {
+	if lbType == cnat.Translation_MAGLEV {
+		return vpp_cnat.CNAT_LB_TYPE_MAGLEV
+	}
+	return vpp_cnat.CNAT_LB_TYPE_DEFAULT
+}
+
+func lbTypeFromVpp(lbType vpp_cnat.CnatLbType) cnat.Translation_LBType {
+	if lbType == vpp_cnat.CNAT_LB_TYPE_MAGLEV {
+		return cnat.Translation_MAGLEV
+	}
+	return cnat.Translation_DEFAULT
+}
+
+func snatPolicyToVpp(policy cnat.SnatPolicy_Policy) vpp_cnat.CnatSnatPolicies {
+	switch policy {
+	case cnat.SnatPolicy_IF_PFX:
+		return vpp_cnat.CNAT_POLICY_IF_PFX
+	case cnat.SnatPolicy_K8S:
+		return vpp_cnat.CNAT_POLICY_K8S
+	default:
+		return vpp_cnat.CNAT_POLICY_NONE
+	}
+}
+
+func snatPolicyTableToVpp(table cnat.SnatPolicyInterface_Table) vpp_cnat.CnatSnatPolicyTable {
+	switch table {
+	case cnat.SnatPolicyInterface_INCLUDE_V6:
+		return vpp_cnat.CNAT_POLICY_INCLUDE_V6
+	case cnat.SnatPolicyInterface_POD:
+		return vpp_cnat.CNAT_POLICY_POD
+	default:
+		return vpp_cnat.CNAT_POLICY_INCLUDE_V4
+	}
+}
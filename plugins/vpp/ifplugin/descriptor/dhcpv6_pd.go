@@ -0,0 +1,154 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/go-errors/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// DHCPv6PDClientDescriptorName is the name of the descriptor configuring
+	// DHCPv6 prefix delegation clients for VPP interfaces.
+	DHCPv6PDClientDescriptorName = "vpp-dhcpv6-pd-client"
+
+	// maximum length of the prefix group name (including terminating null character)
+	prefixGroupMaxLen = 64
+)
+
+// A list of validation errors for DHCPv6 prefix delegation.
+var (
+	// ErrPrefixGroupWithoutName is returned when prefix group is not defined.
+	ErrPrefixGroupWithoutName = errors.New("prefix group name is not defined")
+
+	// ErrPrefixGroupNameTooLong is returned when prefix group name exceeds the VPP limit.
+	ErrPrefixGroupNameTooLong = errors.Errorf("prefix group name exceeds %d characters", prefixGroupMaxLen-1)
+
+	// ErrPrefixGroupInvalidName is returned when prefix group name contains forward slash.
+	ErrPrefixGroupInvalidName = errors.New("prefix group name must not contain forward slash")
+)
+
+// DHCPv6PDClientDescriptor enables/disables DHCPv6 client requesting prefix
+// delegation for VPP interfaces.
+type DHCPv6PDClientDescriptor struct {
+	log       logging.Logger
+	ifHandler vppcalls.InterfaceVppAPI
+	ifIndex   ifaceidx.IfaceMetadataIndex
+}
+
+// NewDHCPv6PDClientDescriptor creates a new instance of DHCPv6PDClientDescriptor.
+func NewDHCPv6PDClientDescriptor(ifHandler vppcalls.InterfaceVppAPI,
+	ifIndex ifaceidx.IfaceMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &DHCPv6PDClientDescriptor{
+		ifHandler: ifHandler,
+		ifIndex:   ifIndex,
+		log:       log.NewLogger("dhcpv6-pd-client-descriptor"),
+	}
+
+	typedDescr := &adapter.DHCPv6PDClientDescriptor{
+		Name:                 DHCPv6PDClientDescriptorName,
+		KeySelector:          ctx.IsDHCPv6PDClientKey,
+		KeyLabel:             ctx.InterfaceNameFromKey,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		RetrieveDependencies: []string{InterfaceDescriptorName},
+	}
+	return adapter.NewDHCPv6PDClientDescriptor(typedDescr)
+}
+
+// IsDHCPv6PDClientKey returns true if the key is identifying DHCPv6 prefix
+// delegation client (derived value).
+func (d *DHCPv6PDClientDescriptor) IsDHCPv6PDClientKey(key string) bool {
+	_, isValid := interfaces.ParseNameFromDHCPv6PDClientKey(key)
+	return isValid
+}
+
+// InterfaceNameFromKey returns interface name from DHCPv6 PD client key.
+func (d *DHCPv6PDClientDescriptor) InterfaceNameFromKey(key string) string {
+	if iface, isValid := interfaces.ParseNameFromDHCPv6PDClientKey(key); isValid {
+		return iface
+	}
+	return key
+}
+
+// Validate validates name of the prefix group.
+func (d *DHCPv6PDClientDescriptor) Validate(key string, client *interfaces.Interface_DHCPv6PDClient) error {
+	if err := validatePrefixGroup(client.GetPrefixGroup()); err != nil {
+		return kvs.NewInvalidValueError(err, "prefix_group")
+	}
+	return nil
+}
+
+// Create enables DHCPv6 prefix delegation client.
+func (d *DHCPv6PDClientDescriptor) Create(key string, client *interfaces.Interface_DHCPv6PDClient) (metadata interface{}, err error) {
+	ifName, _ := interfaces.ParseNameFromDHCPv6PDClientKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err = errors.Errorf("failed to find DHCPv6 PD client interface %s", ifName)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	if err := d.ifHandler.SetDHCPv6PDClient(ifMeta.SwIfIndex, client.GetPrefixGroup()); err != nil {
+		err = errors.Errorf("failed to enable DHCPv6 PD client for interface %s: %v", ifName, err)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// Delete disables DHCPv6 prefix delegation client.
+func (d *DHCPv6PDClientDescriptor) Delete(key string, client *interfaces.Interface_DHCPv6PDClient, metadata interface{}) error {
+	ifName, _ := interfaces.ParseNameFromDHCPv6PDClientKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err := errors.Errorf("failed to find DHCPv6 PD client interface %s", ifName)
+		d.log.Error(err)
+		return err
+	}
+
+	if err := d.ifHandler.UnsetDHCPv6PDClient(ifMeta.SwIfIndex, client.GetPrefixGroup()); err != nil {
+		err = errors.Errorf("failed to disable DHCPv6 PD client for interface %s: %v", ifName, err)
+		d.log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// validatePrefixGroup checks if the prefix group name can be used in VPP
+// and in the keys of the derived values.
+func validatePrefixGroup(prefixGroup string) error {
+	switch {
+	case prefixGroup == "":
+		return ErrPrefixGroupWithoutName
+	case len(prefixGroup) >= prefixGroupMaxLen:
+		return ErrPrefixGroupNameTooLong
+	case strings.Contains(prefixGroup, "/"):
+		return ErrPrefixGroupInvalidName
+	}
+	return nil
+}
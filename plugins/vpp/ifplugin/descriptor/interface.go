@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -54,11 +55,21 @@ const (
 	vxlanGpeVrfTableDep      = "vrf-table-for-vxlan-gpe-exists"
 	gtpuMulticastDep         = "gtpu-multicast-interface-exists"
 	gtpuVrfTableDep          = "vrf-table-for-gtpu-exists"
+	geneveMulticastDep       = "geneve-multicast-interface-exists"
+	geneveVrfTableDep        = "vrf-table-for-geneve-exists"
+	mplsTunnelOutIfDep       = "mpls-tunnel-outgoing-interface-exists"
 	ipipVrfTableDep          = "vrf-table-for-ipip-exists"
+	pppoeVrfTableDep         = "vrf-table-for-pppoe-exists"
+	l2tpv3VrfTableDep        = "vrf-table-for-l2tpv3-exists"
 	microserviceDep          = "microservice-available"
 	parentInterfaceDep       = "parent-interface-exists"
 	rdmaHostInterfaceDep     = "rdma-host-interface-exists"
 
+	// prefixes of dependency labels for resources allocated via netalloc
+	allocatedMACDepPrefix    = "allocated-mac-"
+	allocatedVlanIDDepPrefix = "allocated-vlan-id-"
+	allocatedVNIDepPrefix    = "allocated-vni-"
+
 	// how many characters a logical interface name is allowed to have
 	//  - determined by much fits into the VPP interface tag (64 null-terminated character string)
 	logicalNameLengthLimit = 63
@@ -82,6 +93,11 @@ const (
 	// default RDMA attributes
 	defaultRdmaQueueNum  = 1
 	defaultRdmaQueueSize = 1024
+
+	// MPLS tunnel limits and defaults
+	mplsLabelMax                = 1<<20 - 1
+	mplsLabelStackLimit         = 16
+	defaultMplsTunnelPathWeight = 1
 )
 
 // A list of non-retriable errors:
@@ -150,6 +166,35 @@ var (
 	// ErrVxLanMulticastIntfMissing is returned when interface for multicast was not specified.
 	ErrVxLanMulticastIntfMissing = errors.Errorf("missing multicast interface name for VxLAN tunnel")
 
+	// ErrGeneveSrcAddrMissing is returned when source address was not set or set to an empty string.
+	ErrGeneveSrcAddrMissing = errors.Errorf("missing source address for Geneve tunnel")
+
+	// ErrGeneveDstAddrMissing is returned when destination address was not set or set to an empty string.
+	ErrGeneveDstAddrMissing = errors.Errorf("missing destination address for Geneve tunnel")
+
+	// ErrGeneveSrcAddrBad is returned when source address was not set to valid IP address.
+	ErrGeneveSrcAddrBad = errors.Errorf("bad source address for Geneve tunnel")
+
+	// ErrGeneveDstAddrBad is returned when destination address was not set to valid IP address.
+	ErrGeneveDstAddrBad = errors.Errorf("bad destination address for Geneve tunnel")
+
+	// ErrGeneveMulticastIntfMissing is returned when interface for multicast was not specified.
+	ErrGeneveMulticastIntfMissing = errors.Errorf("missing multicast interface name for Geneve tunnel")
+
+	// ErrMplsTunnelWithoutPaths is returned when MPLS tunnel has no paths defined.
+	ErrMplsTunnelWithoutPaths = errors.Errorf("missing paths for MPLS tunnel")
+
+	// ErrMplsTunnelPathWithoutNextHop is returned when MPLS tunnel path has neither
+	// next hop nor outgoing interface defined.
+	ErrMplsTunnelPathWithoutNextHop = errors.Errorf("missing next hop and outgoing interface for MPLS tunnel path")
+
+	// ErrMplsTunnelNextHopBad is returned when next hop of MPLS tunnel path is not valid IP address.
+	ErrMplsTunnelNextHopBad = errors.Errorf("bad next hop address for MPLS tunnel path")
+
+	// ErrMplsTunnelLabelsBad is returned when MPLS tunnel path imposes too many labels
+	// or a label out of range.
+	ErrMplsTunnelLabelsBad = errors.Errorf("bad out labels for MPLS tunnel path (at most 16 labels of value 0-1048575)")
+
 	// ErrGtpuSrcAddrMissing is returned when source address was not set or set to an empty string.
 	ErrGtpuSrcAddrMissing = errors.Errorf("missing source address for GTPU tunnel")
 
@@ -186,6 +231,30 @@ var (
 	// ErrWgPort is returned when udp-port exceeds max value.
 	ErrWgPort = errors.New("invalid wireguard port")
 
+	// ErrPPPoEClientMacMissing is returned when client MAC address was not set or set to an empty string.
+	ErrPPPoEClientMacMissing = errors.Errorf("missing client MAC address for PPPoE session")
+
+	// ErrPPPoEClientMacBad is returned when client MAC address was not set to valid MAC address.
+	ErrPPPoEClientMacBad = errors.Errorf("bad client MAC address for PPPoE session")
+
+	// ErrPPPoEClientIPMissing is returned when client IP address was not set or set to an empty string.
+	ErrPPPoEClientIPMissing = errors.Errorf("missing client IP address for PPPoE session")
+
+	// ErrPPPoEClientIPBad is returned when client IP address was not set to valid IP address.
+	ErrPPPoEClientIPBad = errors.Errorf("bad client IP address for PPPoE session")
+
+	// ErrL2tpv3SrcAddrMissing is returned when source address was not set or set to an empty string.
+	ErrL2tpv3SrcAddrMissing = errors.Errorf("missing source address for L2TPv3 tunnel")
+
+	// ErrL2tpv3DstAddrMissing is returned when destination address was not set or set to an empty string.
+	ErrL2tpv3DstAddrMissing = errors.Errorf("missing destination address for L2TPv3 tunnel")
+
+	// ErrL2tpv3SrcAddrBad is returned when source address was not set to valid IPv6 address.
+	ErrL2tpv3SrcAddrBad = errors.Errorf("bad source address for L2TPv3 tunnel (only IPv6 is supported)")
+
+	// ErrL2tpv3DstAddrBad is returned when destination address was not set to valid IPv6 address.
+	ErrL2tpv3DstAddrBad = errors.Errorf("bad destination address for L2TPv3 tunnel (only IPv6 is supported)")
+
 	// ErrRdmaHostInterfaceMissing is returned when host_if_name is not configured for RDMA link.
 	ErrRdmaHostInterfaceMissing = errors.Errorf("missing the host interface name for RDMA")
 
@@ -197,6 +266,10 @@ var (
 
 	// ErrRdmaQueueNumTooLarge is returned when the number of configured Rx/Tx queues for RDMA driver exceeds the limit.
 	ErrRdmaQueueNumTooLarge = errors.Errorf("Number of RDMA queues is too large (more than 16bits)")
+
+	// ErrInvalidAllocRef is returned when a field expecting reference to a resource allocated
+	// via netalloc contains something else.
+	ErrInvalidAllocRef = errors.Errorf("expected reference to a resource allocated via netalloc")
 )
 
 // InterfaceDescriptor teaches KVScheduler how to configure VPP interfaces.
@@ -208,6 +281,7 @@ type InterfaceDescriptor struct {
 	log       logging.Logger
 	ifHandler vppcalls.InterfaceVppAPI
 	addrAlloc netalloc.AddressAllocator
+	resAlloc  netalloc.ResourceAllocator
 
 	// optional dependencies, provide if AFPacket and/or TAP+TAP_TO_VPP interfaces are used
 	linuxIfPlugin  LinuxPluginAPI
@@ -241,6 +315,7 @@ type NetlinkAPI interface {
 func NewInterfaceDescriptor(
 	ifHandler vppcalls.InterfaceVppAPI,
 	addrAlloc netalloc.AddressAllocator,
+	resAlloc netalloc.ResourceAllocator,
 	defaultMtu uint32,
 	linuxIfHandler NetlinkAPI,
 	linuxIfPlugin LinuxPluginAPI,
@@ -250,6 +325,7 @@ func NewInterfaceDescriptor(
 	ctx := &InterfaceDescriptor{
 		ifHandler:       ifHandler,
 		addrAlloc:       addrAlloc,
+		resAlloc:        resAlloc,
 		defaultMtu:      defaultMtu,
 		linuxIfPlugin:   linuxIfPlugin,
 		linuxIfHandler:  linuxIfHandler,
@@ -277,8 +353,9 @@ func NewInterfaceDescriptor(
 		Dependencies:       ctx.Dependencies,
 		DerivedValues:      ctx.DerivedValues,
 		RetrieveDependencies: []string{
-			// refresh the pool of allocated IP addresses first
+			// refresh the pool of allocated IP addresses and other resources first
 			netalloc_descr.IPAllocDescriptorName,
+			netalloc_descr.ResourceAllocDescriptorName,
 			// If Linux-IfPlugin is loaded, dump it first.
 			linux_ifdescriptor.InterfaceDescriptorName,
 		},
@@ -309,6 +386,12 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 	if !proto.Equal(oldIntf.Ip6Nd, newIntf.Ip6Nd) {
 		return false
 	}
+	if !proto.Equal(oldIntf.Dhcpv6PdClient, newIntf.Dhcpv6PdClient) {
+		return false
+	}
+	if !equivalentIP6PrefixAddresses(oldIntf.Ip6PrefixAddresses, newIntf.Ip6PrefixAddresses) {
+		return false
+	}
 
 	// type-specific (defaults considered)
 	if !d.equivalentTypeSpecificConfig(oldIntf, newIntf) {
@@ -321,8 +404,9 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		}
 	}
 
-	// handle default/unspecified MTU (except VxLAN and IPSec tunnel)
-	if newIntf.Type != interfaces.Interface_VXLAN_TUNNEL && newIntf.Type != interfaces.Interface_IPSEC_TUNNEL {
+	// handle default/unspecified MTU (except VxLAN, Geneve and IPSec tunnel)
+	if newIntf.Type != interfaces.Interface_VXLAN_TUNNEL && newIntf.Type != interfaces.Interface_IPSEC_TUNNEL &&
+		newIntf.Type != interfaces.Interface_GENEVE_TUNNEL {
 		if d.getInterfaceMTU(newIntf) != 0 && d.getInterfaceMTU(oldIntf) != d.getInterfaceMTU(newIntf) {
 			return false
 		}
@@ -341,6 +425,27 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 	return true
 }
 
+// equivalentIP6PrefixAddresses compares IPv6 addresses from delegated prefixes
+// while ignoring the order.
+func equivalentIP6PrefixAddresses(oldAddrs, newAddrs []*interfaces.Interface_IP6PrefixAddress) bool {
+	if len(oldAddrs) != len(newAddrs) {
+		return false
+	}
+	for _, oldAddr := range oldAddrs {
+		found := false
+		for _, newAddr := range newAddrs {
+			if proto.Equal(oldAddr, newAddr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // equivalentTypeSpecificConfig compares type-specific sections of two interface configurations.
 func (d *InterfaceDescriptor) equivalentTypeSpecificConfig(oldIntf, newIntf *interfaces.Interface) bool {
 	switch oldIntf.Type {
@@ -386,6 +491,14 @@ func (d *InterfaceDescriptor) equivalentTypeSpecificConfig(oldIntf, newIntf *int
 		if !proto.Equal(oldIntf.GetGtpu(), newIntf.GetGtpu()) {
 			return false
 		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		if !proto.Equal(oldIntf.GetGeneve(), newIntf.GetGeneve()) {
+			return false
+		}
+	case interfaces.Interface_MPLS_TUNNEL:
+		if !d.equivalentMplsTunnels(oldIntf.GetMplsTunnel(), newIntf.GetMplsTunnel()) {
+			return false
+		}
 	case interfaces.Interface_IPIP_TUNNEL:
 		if !proto.Equal(oldIntf.GetIpip(), newIntf.GetIpip()) {
 			return false
@@ -394,6 +507,14 @@ func (d *InterfaceDescriptor) equivalentTypeSpecificConfig(oldIntf, newIntf *int
 		if !proto.Equal(oldIntf.GetWireguard(), newIntf.GetWireguard()) {
 			return false
 		}
+	case interfaces.Interface_PPPOE_SESSION:
+		if !proto.Equal(oldIntf.GetPppoe(), newIntf.GetPppoe()) {
+			return false
+		}
+	case interfaces.Interface_L2TPV3_TUNNEL:
+		if !proto.Equal(oldIntf.GetL2Tpv3(), newIntf.GetL2Tpv3()) {
+			return false
+		}
 	case interfaces.Interface_RDMA:
 		if !d.equivalentRdma(oldIntf.GetRdma(), newIntf.GetRdma()) {
 			return false
@@ -475,6 +596,26 @@ func (d *InterfaceDescriptor) equivalentRdma(oldRdma, newRdma *interfaces.RDMALi
 		d.getRdmaTxQueueSize(oldRdma) == d.getRdmaTxQueueSize(newRdma)
 }
 
+// equivalentMplsTunnels compares MPLS tunnels, undefined path weight is considered
+// to be equal to the default weight 1.
+func (d *InterfaceDescriptor) equivalentMplsTunnels(oldTunnel, newTunnel *interfaces.MplsTunnelLink) bool {
+	if oldTunnel.GetL2Only() != newTunnel.GetL2Only() ||
+		len(oldTunnel.GetPaths()) != len(newTunnel.GetPaths()) {
+		return false
+	}
+	for i, oldPath := range oldTunnel.GetPaths() {
+		newPath := newTunnel.GetPaths()[i]
+		if oldPath.GetOutgoingInterface() != newPath.GetOutgoingInterface() ||
+			!net.ParseIP(oldPath.GetNextHopAddr()).Equal(net.ParseIP(newPath.GetNextHopAddr())) ||
+			getMplsTunnelPathWeight(oldPath) != getMplsTunnelPathWeight(newPath) ||
+			oldPath.GetPreference() != newPath.GetPreference() ||
+			!equalUint32Slices(oldPath.GetOutLabels(), newPath.GetOutLabels()) {
+			return false
+		}
+	}
+	return true
+}
+
 // MetadataFactory is a factory for index-map customized for VPP interfaces.
 func (d *InterfaceDescriptor) MetadataFactory() idxmap.NamedMappingRW {
 	return ifaceidx.NewIfaceIndex(d.log, "vpp-interface-index")
@@ -549,6 +690,22 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if intf.Type != interfaces.Interface_RDMA {
 			return linkMismatchErr
 		}
+	case *interfaces.Interface_Pppoe:
+		if intf.Type != interfaces.Interface_PPPOE_SESSION {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_L2Tpv3:
+		if intf.Type != interfaces.Interface_L2TPV3_TUNNEL {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_Geneve:
+		if intf.Type != interfaces.Interface_GENEVE_TUNNEL {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_MplsTunnel:
+		if intf.Type != interfaces.Interface_MPLS_TUNNEL {
+			return linkMismatchErr
+		}
 	case nil:
 		if intf.Type != interfaces.Interface_SOFTWARE_LOOPBACK &&
 			intf.Type != interfaces.Interface_DPDK {
@@ -630,6 +787,46 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if net.ParseIP(intf.GetGtpu().DstAddr) == nil {
 			return kvs.NewInvalidValueError(ErrGtpuDstAddrBad, "link.gtpu.dst_addr")
 		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		geneve := intf.GetGeneve()
+		if geneve.GetSrcAddress() == "" {
+			return kvs.NewInvalidValueError(ErrGeneveSrcAddrMissing, "link.geneve.src_address")
+		}
+		if net.ParseIP(geneve.GetSrcAddress()) == nil {
+			return kvs.NewInvalidValueError(ErrGeneveSrcAddrBad, "link.geneve.src_address")
+		}
+		if geneve.GetDstAddress() == "" {
+			return kvs.NewInvalidValueError(ErrGeneveDstAddrMissing, "link.geneve.dst_address")
+		}
+		if dst := net.ParseIP(geneve.GetDstAddress()); dst != nil {
+			// if destination address is multicast then `Multicast` field must contain interface name.
+			if dst.IsMulticast() && geneve.GetMulticast() == "" {
+				return kvs.NewInvalidValueError(ErrGeneveMulticastIntfMissing, "link.geneve.multicast")
+			}
+		} else {
+			return kvs.NewInvalidValueError(ErrGeneveDstAddrBad, "link.geneve.dst_address")
+		}
+	case interfaces.Interface_MPLS_TUNNEL:
+		if len(intf.GetMplsTunnel().GetPaths()) == 0 {
+			return kvs.NewInvalidValueError(ErrMplsTunnelWithoutPaths, "link.mpls_tunnel.paths")
+		}
+		for _, path := range intf.GetMplsTunnel().GetPaths() {
+			if path.GetNextHopAddr() == "" && path.GetOutgoingInterface() == "" {
+				return kvs.NewInvalidValueError(ErrMplsTunnelPathWithoutNextHop,
+					"link.mpls_tunnel.paths.next_hop_addr", "link.mpls_tunnel.paths.outgoing_interface")
+			}
+			if path.GetNextHopAddr() != "" && net.ParseIP(path.GetNextHopAddr()) == nil {
+				return kvs.NewInvalidValueError(ErrMplsTunnelNextHopBad, "link.mpls_tunnel.paths.next_hop_addr")
+			}
+			if len(path.GetOutLabels()) > mplsLabelStackLimit {
+				return kvs.NewInvalidValueError(ErrMplsTunnelLabelsBad, "link.mpls_tunnel.paths.out_labels")
+			}
+			for _, label := range path.GetOutLabels() {
+				if label > mplsLabelMax {
+					return kvs.NewInvalidValueError(ErrMplsTunnelLabelsBad, "link.mpls_tunnel.paths.out_labels")
+				}
+			}
+		}
 	case interfaces.Interface_IPIP_TUNNEL:
 		if intf.GetIpip().SrcAddr == "" {
 			return kvs.NewInvalidValueError(ErrIpipSrcAddrMissing, "link.ipip.src_addr")
@@ -661,6 +858,32 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if intf.GetWireguard().Port > 0xFFFF {
 			return kvs.NewInvalidValueError(ErrWgPort, "link.wireguard.port")
 		}
+	case interfaces.Interface_PPPOE_SESSION:
+		if intf.GetPppoe().GetClientMac() == "" {
+			return kvs.NewInvalidValueError(ErrPPPoEClientMacMissing, "link.pppoe.client_mac")
+		}
+		if _, err := net.ParseMAC(intf.GetPppoe().GetClientMac()); err != nil {
+			return kvs.NewInvalidValueError(ErrPPPoEClientMacBad, "link.pppoe.client_mac")
+		}
+		if intf.GetPppoe().GetClientIp() == "" {
+			return kvs.NewInvalidValueError(ErrPPPoEClientIPMissing, "link.pppoe.client_ip")
+		}
+		if net.ParseIP(intf.GetPppoe().GetClientIp()) == nil {
+			return kvs.NewInvalidValueError(ErrPPPoEClientIPBad, "link.pppoe.client_ip")
+		}
+	case interfaces.Interface_L2TPV3_TUNNEL:
+		if intf.GetL2Tpv3().GetSrcAddr() == "" {
+			return kvs.NewInvalidValueError(ErrL2tpv3SrcAddrMissing, "link.l2tpv3.src_addr")
+		}
+		if srcAddr := net.ParseIP(intf.GetL2Tpv3().GetSrcAddr()); srcAddr == nil || srcAddr.To4() != nil {
+			return kvs.NewInvalidValueError(ErrL2tpv3SrcAddrBad, "link.l2tpv3.src_addr")
+		}
+		if intf.GetL2Tpv3().GetDstAddr() == "" {
+			return kvs.NewInvalidValueError(ErrL2tpv3DstAddrMissing, "link.l2tpv3.dst_addr")
+		}
+		if dstAddr := net.ParseIP(intf.GetL2Tpv3().GetDstAddr()); dstAddr == nil || dstAddr.To4() != nil {
+			return kvs.NewInvalidValueError(ErrL2tpv3DstAddrBad, "link.l2tpv3.dst_addr")
+		}
 	case interfaces.Interface_RDMA:
 		if intf.GetRdma().GetHostIfName() == "" {
 			return kvs.NewInvalidValueError(ErrRdmaHostInterfaceMissing, "link.rdma.host_if_name")
@@ -686,6 +909,35 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		}
 	}
 
+	// validate references to resources allocated via netalloc
+	if strings.HasPrefix(intf.GetPhysAddress(), netalloc_api.AllocRefPrefix) {
+		err := d.resAlloc.ValidateResourceRef(intf.GetPhysAddress(), intf.GetName(),
+			netalloc_api.ResourceType_MAC_ADDRESS, "phys_address")
+		if err != nil {
+			return err
+		}
+	}
+	if ref := intf.GetSub().GetSubIdAlloc(); ref != "" {
+		if !strings.HasPrefix(ref, netalloc_api.AllocRefPrefix) {
+			return kvs.NewInvalidValueError(ErrInvalidAllocRef, "link.sub.sub_id_alloc")
+		}
+		err := d.resAlloc.ValidateResourceRef(ref, intf.GetName(),
+			netalloc_api.ResourceType_VLAN_ID, "link.sub.sub_id_alloc")
+		if err != nil {
+			return err
+		}
+	}
+	if ref := intf.GetVxlan().GetVniAlloc(); ref != "" {
+		if !strings.HasPrefix(ref, netalloc_api.AllocRefPrefix) {
+			return kvs.NewInvalidValueError(ErrInvalidAllocRef, "link.vxlan.vni_alloc")
+		}
+		err := d.resAlloc.ValidateResourceRef(ref, intf.GetName(),
+			netalloc_api.ResourceType_VXLAN_VNI, "link.vxlan.vni_alloc")
+		if err != nil {
+			return err
+		}
+	}
+
 	// validate unnumbered
 	if intf.GetUnnumbered() != nil {
 		if len(intf.GetIpAddresses()) > 0 {
@@ -719,10 +971,11 @@ func (d *InterfaceDescriptor) UpdateWithRecreate(key string, oldIntf, newIntf *i
 	}
 
 	if (oldIntf.GetType() == interfaces.Interface_VXLAN_TUNNEL ||
+		oldIntf.GetType() == interfaces.Interface_GENEVE_TUNNEL ||
 		oldIntf.GetType() == interfaces.Interface_GTPU_TUNNEL ||
 		oldIntf.GetType() == interfaces.Interface_IPIP_TUNNEL) &&
 		oldIntf.Vrf != newIntf.Vrf {
-		// for VXLAN, GTPU and IPIP interfaces a change in the VRF assignment requires full re-creation
+		// for VXLAN, Geneve, GTPU and IPIP interfaces a change in the VRF assignment requires full re-creation
 		return true
 	}
 
@@ -761,22 +1014,7 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 	case interfaces.Interface_VXLAN_TUNNEL:
 		// VXLAN referencing an interface with Multicast IP address
 		if vxlanMulticast := intf.GetVxlan().GetMulticast(); vxlanMulticast != "" {
-			dependencies = append(dependencies, kvs.Dependency{
-				Label: vxlanMulticastDep,
-				AnyOf: kvs.AnyOfDependency{
-					KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(vxlanMulticast)},
-					KeySelector: func(key string) bool {
-						_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
-						if source != netalloc_api.IPAddressSource_ALLOC_REF {
-							ip, _, err := net.ParseCIDR(ifaceAddr)
-							return err == nil && ip.IsMulticast()
-						}
-						// TODO: handle the case when multicast IP address is allocated
-						// via netalloc (too specific to bother until really needed)
-						return false
-					},
-				},
-			})
+			dependencies = append(dependencies, multicastInterfaceDep(vxlanMulticastDep, vxlanMulticast))
 		}
 		if intf.GetVrf() != 0 {
 			// binary API for creating VXLAN tunnel requires the VRF table
@@ -809,22 +1047,7 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 	case interfaces.Interface_GTPU_TUNNEL:
 		// GTPU referencing an interface with Multicast IP address
 		if gtpuMulticast := intf.GetGtpu().GetMulticast(); gtpuMulticast != "" {
-			dependencies = append(dependencies, kvs.Dependency{
-				Label: gtpuMulticastDep,
-				AnyOf: kvs.AnyOfDependency{
-					KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(gtpuMulticast)},
-					KeySelector: func(key string) bool {
-						_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
-						if source != netalloc_api.IPAddressSource_ALLOC_REF {
-							ip, _, err := net.ParseCIDR(ifaceAddr)
-							return err == nil && ip.IsMulticast()
-						}
-						// TODO: handle the case when multicast IP address is allocated
-						// via netalloc (too specific to bother until really needed)
-						return false
-					},
-				},
-			})
+			dependencies = append(dependencies, multicastInterfaceDep(gtpuMulticastDep, gtpuMulticast))
 		}
 		if intf.GetGtpu().GetEncapVrfId() != 0 {
 			// binary API for creating GTPU tunnel requires the VRF table
@@ -841,6 +1064,60 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 			})
 		}
 
+	case interfaces.Interface_PPPOE_SESSION:
+		if intf.GetPppoe().GetDecapVrf() != 0 {
+			// binary API for creating PPPoE session requires the VRF table
+			// to be already created
+			var protocol l3.VrfTable_Protocol
+			if net.ParseIP(intf.GetPppoe().GetClientIp()).To4() == nil {
+				protocol = l3.VrfTable_IPV6
+			}
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: pppoeVrfTableDep,
+				Key:   l3.VrfTableKey(intf.GetPppoe().GetDecapVrf(), protocol),
+			})
+		}
+
+	case interfaces.Interface_L2TPV3_TUNNEL:
+		if intf.GetL2Tpv3().GetEncapVrf() != 0 {
+			// L2TPv3 is supported only over IPv6
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: l2tpv3VrfTableDep,
+				Key:   l3.VrfTableKey(intf.GetL2Tpv3().GetEncapVrf(), l3.VrfTable_IPV6),
+			})
+		}
+
+	case interfaces.Interface_GENEVE_TUNNEL:
+		// Geneve referencing an interface with Multicast IP address
+		if geneveMulticast := intf.GetGeneve().GetMulticast(); geneveMulticast != "" {
+			dependencies = append(dependencies, multicastInterfaceDep(geneveMulticastDep, geneveMulticast))
+		}
+		if intf.GetVrf() != 0 {
+			// binary API for creating Geneve tunnel requires the VRF table
+			// to be already created
+			var protocol l3.VrfTable_Protocol
+			srcAddr := net.ParseIP(intf.GetGeneve().GetSrcAddress()).To4()
+			dstAddr := net.ParseIP(intf.GetGeneve().GetDstAddress()).To4()
+			if srcAddr == nil && dstAddr == nil {
+				protocol = l3.VrfTable_IPV6
+			}
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: geneveVrfTableDep,
+				Key:   l3.VrfTableKey(intf.GetVrf(), protocol),
+			})
+		}
+
+	case interfaces.Interface_MPLS_TUNNEL:
+		// outgoing interfaces of the tunnel paths must exist
+		for _, path := range intf.GetMplsTunnel().GetPaths() {
+			if path.GetOutgoingInterface() != "" {
+				dependencies = append(dependencies, kvs.Dependency{
+					Label: mplsTunnelOutIfDep + "-" + path.GetOutgoingInterface(),
+					Key:   interfaces.InterfaceKey(path.GetOutgoingInterface()),
+				})
+			}
+		}
+
 	case interfaces.Interface_IPIP_TUNNEL:
 		if intf.GetVrf() != 0 {
 			// binary API for creating IPIP tunnel requires the VRF table to be already created
@@ -873,12 +1150,29 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 		})
 	}
 
+	// resources allocated via netalloc
+	if dep, hasAllocDep := d.resAlloc.GetResourceAllocDep(intf.GetPhysAddress(), intf.GetName(),
+		netalloc_api.ResourceType_MAC_ADDRESS, allocatedMACDepPrefix); hasAllocDep {
+		dependencies = append(dependencies, dep)
+	}
+	if dep, hasAllocDep := d.resAlloc.GetResourceAllocDep(intf.GetSub().GetSubIdAlloc(), intf.GetName(),
+		netalloc_api.ResourceType_VLAN_ID, allocatedVlanIDDepPrefix); hasAllocDep {
+		dependencies = append(dependencies, dep)
+	}
+	if dep, hasAllocDep := d.resAlloc.GetResourceAllocDep(intf.GetVxlan().GetVniAlloc(), intf.GetName(),
+		netalloc_api.ResourceType_VXLAN_VNI, allocatedVNIDepPrefix); hasAllocDep {
+		dependencies = append(dependencies, dep)
+	}
+
 	return dependencies
 }
 
 // DerivedValues derives:
 //   - key-value for unnumbered configuration sub-section
 //   - empty value for enabled DHCP client
+//   - IP6ND configuration if set
+//   - DHCPv6 prefix delegation client if enabled
+//   - one value for every IPv6 address constructed from a delegated prefix
 //   - configuration for every slave of a bonded interface
 //   - one empty value for every IP address to be assigned to the interface
 //   - one empty value for VRF table to put the interface into
@@ -921,6 +1215,22 @@ func (d *InterfaceDescriptor) DerivedValues(key string, intf *interfaces.Interfa
 		})
 	}
 
+	// DHCPv6 prefix delegation client
+	if intf.GetDhcpv6PdClient() != nil {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   interfaces.DHCPv6PDClientKey(intf.Name),
+			Value: intf.GetDhcpv6PdClient(),
+		})
+	}
+
+	// IPv6 addresses from delegated prefixes
+	for _, prefixAddr := range intf.GetIp6PrefixAddresses() {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   interfaces.IP6PrefixAddressKey(intf.Name, prefixAddr.GetPrefixGroup(), prefixAddr.GetAddress()),
+			Value: prefixAddr,
+		})
+	}
+
 	// IP addresses
 	for _, ipAddr := range intf.IpAddresses {
 		derValues = append(derValues, kvs.KeyValuePair{
@@ -948,6 +1258,14 @@ func (d *InterfaceDescriptor) DerivedValues(key string, intf *interfaces.Interfa
 			} else {
 				hasIPv4 = true
 			}
+		case interfaces.Interface_GENEVE_TUNNEL:
+			srcAddr := net.ParseIP(intf.GetGeneve().GetSrcAddress()).To4()
+			dstAddr := net.ParseIP(intf.GetGeneve().GetDstAddress()).To4()
+			if srcAddr == nil && dstAddr == nil {
+				hasIPv6 = true
+			} else {
+				hasIPv4 = true
+			}
 		case interfaces.Interface_GTPU_TUNNEL:
 			srcAddr := net.ParseIP(intf.GetGtpu().GetSrcAddr()).To4()
 			dstAddr := net.ParseIP(intf.GetGtpu().GetDstAddr()).To4()
@@ -1008,6 +1326,134 @@ func (d *InterfaceDescriptor) DerivedValues(key string, intf *interfaces.Interfa
 	return derValues
 }
 
+// resolveAllocatedResources returns interface configuration with references
+// to MAC address, VLAN ID and VNI allocated via netalloc replaced by the actual
+// values. The input configuration is not modified.
+func (d *InterfaceDescriptor) resolveAllocatedResources(intf *interfaces.Interface) (*interfaces.Interface, error) {
+	macRef := strings.HasPrefix(intf.GetPhysAddress(), netalloc_api.AllocRefPrefix)
+	subIDRef := intf.GetSub().GetSubIdAlloc()
+	vniRef := intf.GetVxlan().GetVniAlloc()
+	if !macRef && subIDRef == "" && vniRef == "" {
+		return intf, nil
+	}
+
+	var err error
+	resolved := proto.Clone(intf).(*interfaces.Interface)
+	if macRef {
+		resolved.PhysAddress, err = d.resAlloc.GetOrParseMACAddress(intf.GetPhysAddress(), intf.GetName())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if subIDRef != "" {
+		resolved.GetSub().SubId, err = d.resAlloc.GetAllocatedID(subIDRef, intf.GetName(),
+			netalloc_api.ResourceType_VLAN_ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if vniRef != "" {
+		resolved.GetVxlan().Vni, err = d.resAlloc.GetAllocatedID(vniRef, intf.GetName(),
+			netalloc_api.ResourceType_VXLAN_VNI)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// correlateAllocatedResources replaces retrieved MAC address, VLAN ID and VNI
+// with references to resources allocated via netalloc from the expected
+// configuration, if the references resolve to the retrieved values.
+func (d *InterfaceDescriptor) correlateAllocatedResources(expCfg, retrieved *interfaces.Interface) {
+	if strings.HasPrefix(expCfg.GetPhysAddress(), netalloc_api.AllocRefPrefix) {
+		retrieved.PhysAddress = d.resAlloc.CorrelateRetrievedResource(expCfg.GetPhysAddress(),
+			retrieved.GetPhysAddress(), retrieved.GetName(), netalloc_api.ResourceType_MAC_ADDRESS)
+	}
+	if ref := expCfg.GetSub().GetSubIdAlloc(); ref != "" && retrieved.GetSub() != nil {
+		subID := strconv.FormatUint(uint64(retrieved.GetSub().GetSubId()), 10)
+		if d.resAlloc.CorrelateRetrievedResource(ref, subID, retrieved.GetName(),
+			netalloc_api.ResourceType_VLAN_ID) == ref {
+			retrieved.GetSub().SubIdAlloc = ref
+			retrieved.GetSub().SubId = expCfg.GetSub().GetSubId()
+		}
+	}
+	if ref := expCfg.GetVxlan().GetVniAlloc(); ref != "" && retrieved.GetVxlan() != nil {
+		vni := strconv.FormatUint(uint64(retrieved.GetVxlan().GetVni()), 10)
+		if d.resAlloc.CorrelateRetrievedResource(ref, vni, retrieved.GetName(),
+			netalloc_api.ResourceType_VXLAN_VNI) == ref {
+			retrieved.GetVxlan().VniAlloc = ref
+			retrieved.GetVxlan().Vni = expCfg.GetVxlan().GetVni()
+		}
+	}
+}
+
+// staticResource is MAC address, VLAN ID or VNI configured statically, i.e.
+// not a reference to a resource allocated via netalloc.
+type staticResource struct {
+	resType netalloc_api.ResourceType
+	value   string
+}
+
+// getStaticResources returns MAC address, VLAN ID and VNI configured statically
+// for the interface.
+func getStaticResources(intf *interfaces.Interface) (resources []staticResource) {
+	if mac := intf.GetPhysAddress(); mac != "" && !strings.HasPrefix(mac, netalloc_api.AllocRefPrefix) {
+		resources = append(resources, staticResource{
+			resType: netalloc_api.ResourceType_MAC_ADDRESS,
+			value:   mac,
+		})
+	}
+	if sub := intf.GetSub(); sub != nil && sub.GetSubIdAlloc() == "" {
+		resources = append(resources, staticResource{
+			resType: netalloc_api.ResourceType_VLAN_ID,
+			value:   strconv.FormatUint(uint64(sub.GetSubId()), 10),
+		})
+	}
+	if vxlan := intf.GetVxlan(); vxlan != nil && vxlan.GetVniAlloc() == "" {
+		resources = append(resources, staticResource{
+			resType: netalloc_api.ResourceType_VXLAN_VNI,
+			value:   strconv.FormatUint(uint64(vxlan.GetVni()), 10),
+		})
+	}
+	return resources
+}
+
+// reserveStaticResources reserves MAC address, VLAN ID and VNI configured
+// statically for the interface, so that netalloc never assigns them from pools.
+// Error is returned if any of them is already allocated via netalloc.
+func (d *InterfaceDescriptor) reserveStaticResources(intf *interfaces.Interface) error {
+	owner := interfaces.InterfaceKey(intf.GetName())
+	resources := getStaticResources(intf)
+	for i, res := range resources {
+		if err := d.resAlloc.ReserveResource(owner, res.value, res.resType); err != nil {
+			for _, reserved := range resources[:i] {
+				d.resAlloc.ReleaseResource(owner, reserved.value, reserved.resType)
+			}
+			return errors.Errorf("failed to reserve %v of the interface %s: %v",
+				res.resType, intf.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// releaseStaticResources releases MAC address, VLAN ID and VNI configured
+// statically for the old interface configuration but not for the new one
+// (nil if the interface was removed).
+func (d *InterfaceDescriptor) releaseStaticResources(oldIntf, newIntf *interfaces.Interface) {
+	owner := interfaces.InterfaceKey(oldIntf.GetName())
+	newResources := getStaticResources(newIntf)
+	for _, res := range getStaticResources(oldIntf) {
+		var kept bool
+		for _, newRes := range newResources {
+			kept = kept || newRes == res
+		}
+		if !kept {
+			d.resAlloc.ReleaseResource(owner, res.value, res.resType)
+		}
+	}
+}
+
 // getInterfaceMTU returns the interface MTU.
 func (d *InterfaceDescriptor) getInterfaceMTU(intf *interfaces.Interface) uint32 {
 	if mtu := intf.GetMtu(); mtu != 0 {
@@ -1166,6 +1612,49 @@ func equalStringSets(set1, set2 []string) bool {
 	return true
 }
 
+// equalUint32Slices compares two slices of uint32 (the order is significant).
+func equalUint32Slices(slice1, slice2 []uint32) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	for i := range slice1 {
+		if slice1[i] != slice2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getMplsTunnelPathWeight returns weight of MPLS tunnel path, handling the case
+// when it is left undefined.
+func getMplsTunnelPathWeight(path *interfaces.MplsTunnelLink_Path) uint32 {
+	if path.GetWeight() == 0 {
+		return defaultMplsTunnelPathWeight
+	}
+	return path.GetWeight()
+}
+
+// multicastInterfaceDep returns dependency on an interface with a multicast IP address
+// assigned, referenced by a tunnel.
+func multicastInterfaceDep(label, multicastIf string) kvs.Dependency {
+	return kvs.Dependency{
+		Label: label,
+		AnyOf: kvs.AnyOfDependency{
+			KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(multicastIf)},
+			KeySelector: func(key string) bool {
+				_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
+				if source != netalloc_api.IPAddressSource_ALLOC_REF {
+					ip, _, err := net.ParseCIDR(ifaceAddr)
+					return err == nil && ip.IsMulticast()
+				}
+				// TODO: handle the case when multicast IP address is allocated
+				// via netalloc (too specific to bother until really needed)
+				return false
+			},
+		},
+	}
+}
+
 // getIPAddressVersions returns two flags to tell whether the provided list of addresses
 // contains IPv4 and/or IPv6 type addresses
 func getIPAddressVersions(ipAddrs []string) (hasIPv4, hasIPv6 bool) {
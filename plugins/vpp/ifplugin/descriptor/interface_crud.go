@@ -23,6 +23,24 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 
 	ctx := context.TODO()
 
+	// reserve statically configured MAC address, VLAN ID and VNI to prevent
+	// netalloc from assigning them from pools
+	if err = d.reserveStaticResources(intf); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	defer func(staticIntf *interfaces.Interface) {
+		if err != nil {
+			d.releaseStaticResources(staticIntf, nil)
+		}
+	}(intf)
+
+	// resolve references to MAC address, VLAN ID and VNI allocated via netalloc
+	if intf, err = d.resolveAllocatedResources(intf); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
 	// create the interface of the given type
 	switch intf.Type {
 	case interfaces.Interface_TAP:
@@ -96,6 +114,42 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			return nil, err
 		}
 
+	case interfaces.Interface_GENEVE_TUNNEL:
+		var multicastIfIdx uint32
+		multicastIf := intf.GetGeneve().GetMulticast()
+		if multicastIf != "" {
+			multicastMeta, found := d.intfIndex.LookupByName(multicastIf)
+			if !found {
+				err = errors.Errorf("failed to find multicast interface %s referenced by Geneve %s",
+					multicastIf, intf.Name)
+				d.log.Error(err)
+				return nil, err
+			}
+			multicastIfIdx = multicastMeta.SwIfIndex
+		} else {
+			// not a multicast tunnel
+			multicastIfIdx = 0xFFFFFFFF
+		}
+
+		ifIdx, err = d.ifHandler.AddGeneveTunnel(intf.Name, intf.GetVrf(), multicastIfIdx, intf.GetGeneve())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
+	case interfaces.Interface_MPLS_TUNNEL:
+		var pathIfIdxs []uint32
+		pathIfIdxs, err = d.getMplsTunnelPathIfIdxs(intf)
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+		ifIdx, err = d.ifHandler.AddMplsTunnel(intf.Name, intf.GetMplsTunnel(), pathIfIdxs)
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
 	case interfaces.Interface_SOFTWARE_LOOPBACK:
 		ifIdx, err = d.ifHandler.AddLoopbackInterface(intf.Name)
 		if err != nil {
@@ -211,6 +265,20 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			d.log.Error(err)
 			return nil, err
 		}
+
+	case interfaces.Interface_PPPOE_SESSION:
+		ifIdx, err = d.ifHandler.AddPPPoESession(intf.Name, intf.GetPppoe())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
+	case interfaces.Interface_L2TPV3_TUNNEL:
+		ifIdx, err = d.ifHandler.AddL2tpv3Tunnel(intf.Name, intf.GetL2Tpv3())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
 	}
 
 	// MAC address. Note: physical interfaces cannot have the MAC address changed. The bond interface uses its own
@@ -298,6 +366,14 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		} else {
 			err = d.ifHandler.DeleteVxLanGpeTunnel(intf.Name, intf.GetVxlan())
 		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		err = d.ifHandler.DeleteGeneveTunnel(intf.Name, ifIdx, intf.Vrf, intf.GetGeneve())
+	case interfaces.Interface_MPLS_TUNNEL:
+		var pathIfIdxs []uint32
+		pathIfIdxs, err = d.getMplsTunnelPathIfIdxs(intf)
+		if err == nil {
+			err = d.ifHandler.DeleteMplsTunnel(intf.Name, ifIdx, intf.GetMplsTunnel(), pathIfIdxs)
+		}
 	case interfaces.Interface_SOFTWARE_LOOPBACK:
 		err = d.ifHandler.DeleteLoopbackInterface(intf.Name, ifIdx)
 	case interfaces.Interface_DPDK:
@@ -339,6 +415,12 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		err = d.ifHandler.DelIpipTunnel(intf.Name, ifIdx)
 	case interfaces.Interface_RDMA:
 		err = d.ifHandler.DeleteRdmaInterface(ctx, intf.Name, ifIdx)
+	case interfaces.Interface_PPPOE_SESSION:
+		err = d.ifHandler.DeletePPPoESession(intf.Name, ifIdx, intf.GetPppoe())
+	case interfaces.Interface_L2TPV3_TUNNEL:
+		// VPP does not support removal of L2TPv3 tunnels, the interface is only
+		// set down and un-tagged (it gets re-used when configured again)
+		err = d.ifHandler.DeleteL2tpv3Tunnel(intf.Name, ifIdx)
 	}
 	if err != nil {
 		err = errors.Errorf("failed to remove interface %s, index %d: %v", intf.Name, ifIdx, err)
@@ -346,6 +428,7 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		return err
 	}
 
+	d.releaseStaticResources(intf, nil)
 	return nil
 }
 
@@ -355,6 +438,23 @@ func (d *InterfaceDescriptor) Update(key string, oldIntf, newIntf *interfaces.In
 
 	ctx := context.TODO()
 
+	// update reservations of statically configured MAC address, VLAN ID and VNI
+	if err = d.reserveStaticResources(newIntf); err != nil {
+		d.log.Error(err)
+		return oldMetadata, err
+	}
+	d.releaseStaticResources(oldIntf, newIntf)
+
+	// resolve references to MAC address, VLAN ID and VNI allocated via netalloc
+	// (previous allocation may be already gone, which only means that the values differ)
+	if resolved, err := d.resolveAllocatedResources(oldIntf); err == nil {
+		oldIntf = resolved
+	}
+	if newIntf, err = d.resolveAllocatedResources(newIntf); err != nil {
+		d.log.Error(err)
+		return oldMetadata, err
+	}
+
 	// admin status
 	if newIntf.Enabled != oldIntf.Enabled {
 		if newIntf.Enabled {
@@ -477,6 +577,10 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 			// local0 is created automatically
 			origin = kvs.FromSB
 		}
+		if intf.Meta.LinuxCPHost {
+			// created by the linux-cp plugin together with the interface pair
+			origin = kvs.FromSB
+		}
 		if intf.Interface.Type == interfaces.Interface_DPDK {
 			d.ethernetIfs[intf.Interface.Name] = ifIdx
 			if !intf.Interface.Enabled && len(intf.Interface.IpAddresses) == 0 {
@@ -528,6 +632,17 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 					intf.Interface.GetMemif().BufferSize = expCfg.GetMemif().GetBufferSize()
 				}
 			}
+			if expCfg.Type == interfaces.Interface_GENEVE_TUNNEL && intf.Interface.GetGeneve() != nil {
+				// L3 mode is not dumped and decap-next is not relevant for L3 tunnels
+				if expCfg.GetGeneve().GetL3Mode() {
+					intf.Interface.GetGeneve().L3Mode = true
+					intf.Interface.GetGeneve().DecapNextNode = expCfg.GetGeneve().GetDecapNextNode()
+				}
+			}
+			if expCfg.Type == interfaces.Interface_L2TPV3_TUNNEL && intf.Interface.GetL2Tpv3() != nil {
+				// encapsulation VRF is not dumped
+				intf.Interface.GetL2Tpv3().EncapVrf = expCfg.GetL2Tpv3().GetEncapVrf()
+			}
 			//nolint:staticcheck
 			if expCfg.Type == interfaces.Interface_AF_PACKET && intf.Interface.GetAfpacket() != nil {
 				hostIfName, err := d.getAfPacketTargetHostIfName(expCfg.GetAfpacket())
@@ -562,10 +677,27 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 				intf.Interface.RxModes = []*interfaces.Interface_RxMode{}
 			}
 
+			// DHCPv6 prefix delegation is not dumped, addresses assigned by VPP
+			// from the delegated prefixes are not part of the static addresses
+			intf.Interface.Dhcpv6PdClient = expCfg.GetDhcpv6PdClient()
+			intf.Interface.Ip6PrefixAddresses = expCfg.GetIp6PrefixAddresses()
+			if len(expCfg.GetIp6PrefixAddresses()) > 0 {
+				intf.Interface.IpAddresses = filterIP6PrefixAddresses(
+					intf.Interface.IpAddresses, expCfg.IpAddresses, expCfg.GetIp6PrefixAddresses())
+			}
+
 			// correlate references to allocated IP addresses
 			intf.Interface.IpAddresses = d.addrAlloc.CorrelateRetrievedIPs(
 				expCfg.IpAddresses, intf.Interface.IpAddresses,
 				intf.Interface.Name, netalloc.IPAddressForm_ADDR_WITH_MASK)
+
+			// correlate references to other allocated resources
+			d.correlateAllocatedResources(expCfg, intf.Interface)
+
+			// reservations of statically configured resources are lost with the agent restart
+			if err := d.reserveStaticResources(expCfg); err != nil {
+				d.log.Warn(err)
+			}
 		}
 
 		// verify links between VPP and Linux side
@@ -582,7 +714,7 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 					intf.Interface.Name += afPacketMissingAttachedIfSuffix
 				}
 			}
-			if intf.Interface.Type == interfaces.Interface_TAP {
+			if intf.Interface.Type == interfaces.Interface_TAP && !intf.Meta.LinuxCPHost {
 				exists, _ := d.linuxIfHandler.InterfaceExists(tapHostIfName)
 				if !exists {
 					// check if it was "stolen" by the Linux plugin
@@ -618,9 +750,29 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 	return retrieved, nil
 }
 
+// getMplsTunnelPathIfIdxs returns sw_if_index of the outgoing interface for every
+// path of the MPLS tunnel (^uint32(0) for paths without outgoing interface).
+func (d *InterfaceDescriptor) getMplsTunnelPathIfIdxs(intf *interfaces.Interface) ([]uint32, error) {
+	var pathIfIdxs []uint32
+	for _, path := range intf.GetMplsTunnel().GetPaths() {
+		if path.GetOutgoingInterface() == "" {
+			pathIfIdxs = append(pathIfIdxs, ^uint32(0))
+			continue
+		}
+		outIfMeta, found := d.intfIndex.LookupByName(path.GetOutgoingInterface())
+		if !found {
+			return nil, errors.Errorf("failed to find outgoing interface %s referenced by MPLS tunnel %s",
+				path.GetOutgoingInterface(), intf.Name)
+		}
+		pathIfIdxs = append(pathIfIdxs, outIfMeta.SwIfIndex)
+	}
+	return pathIfIdxs, nil
+}
+
 func ifaceSupportsSetMTU(intf *interfaces.Interface) bool {
 	switch intf.Type {
 	case interfaces.Interface_VXLAN_TUNNEL,
+		interfaces.Interface_GENEVE_TUNNEL,
 		interfaces.Interface_IPSEC_TUNNEL,
 		interfaces.Interface_WIREGUARD_TUNNEL,
 		interfaces.Interface_SUB_INTERFACE:
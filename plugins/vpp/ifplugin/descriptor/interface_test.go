@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ethernet_types"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface_types"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// loopbackIdx is the index assigned by the mocked VPP to every created loopback.
+	loopbackIdx = 1
+	// vppDefaultMtu is the MTU of a newly created loopback.
+	vppDefaultMtu = 9216
+)
+
+// loopbackMac is the MAC address assigned by the mocked VPP to every created loopback.
+var loopbackMac = ethernet_types.MacAddress{0xde, 0xad, 0x00, 0x00, 0x00, 0x01}
+
+func TestInterfaceDescriptorConformance(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	log := logrus.NewLogger("test-log")
+	ifHandler := vpp2306.NewInterfaceVppHandler(ctx.MockVPPClient, log)
+	addrAlloc := netalloc_mock.NewMockNetAlloc()
+
+	ifDescriptor, ifCtx := descriptor.NewInterfaceDescriptor(ifHandler, addrAlloc, addrAlloc, 0,
+		nil, nil, nil, log)
+	ifCtx.SetInterfaceIndex(ifaceidx.NewIfaceIndex(log, "if-idx"))
+
+	suite := &descriptortest.Suite{
+		Descriptor: ifDescriptor,
+		Southbound: vppmock.NewSouthbound(ctx, loopbackReplies),
+		Samples: []descriptortest.Sample{
+			{
+				Value: &interfaces.Interface{
+					Name:        "loop1",
+					Type:        interfaces.Interface_SOFTWARE_LOOPBACK,
+					Enabled:     true,
+					PhysAddress: "02:00:00:00:00:01",
+					Mtu:         1500,
+				},
+				Updates: []proto.Message{
+					&interfaces.Interface{
+						Name:        "loop1",
+						Type:        interfaces.Interface_SOFTWARE_LOOPBACK,
+						PhysAddress: "02:00:00:00:00:01",
+						Mtu:         1500,
+					},
+					&interfaces.Interface{
+						Name:        "loop1",
+						Type:        interfaces.Interface_SOFTWARE_LOOPBACK,
+						Enabled:     true,
+						PhysAddress: "02:00:00:00:00:02",
+						Mtu:         9000,
+					},
+				},
+			},
+			{
+				Value: &interfaces.Interface{
+					Name: "loop2",
+					Type: interfaces.Interface_SOFTWARE_LOOPBACK,
+				},
+				Updates: []proto.Message{
+					&interfaces.Interface{
+						Name:    "loop2",
+						Type:    interfaces.Interface_SOFTWARE_LOOPBACK,
+						Enabled: true,
+					},
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
+
+// loopbackReplies replays the configuration of a single loopback interface
+// sent via the mocked channel and builds the reply for the interface dump.
+func loopbackReplies(sent []govppapi.Message) []*vppmock.HandleReplies {
+	var loop *vpp_ifs.SwInterfaceDetails
+	for _, msg := range sent {
+		switch req := msg.(type) {
+		case *vpp_ifs.CreateLoopback:
+			loop = &vpp_ifs.SwInterfaceDetails{
+				SwIfIndex:     loopbackIdx,
+				SupSwIfIndex:  loopbackIdx,
+				InterfaceName: "loop0",
+				L2Address:     loopbackMac,
+				LinkMtu:       vppDefaultMtu,
+			}
+		case *vpp_ifs.DeleteLoopback:
+			loop = nil
+		case *vpp_ifs.SwInterfaceTagAddDel:
+			if loop != nil && req.IsAdd && req.SwIfIndex == loopbackIdx {
+				loop.Tag = req.Tag
+			}
+		case *vpp_ifs.SwInterfaceSetFlags:
+			if loop != nil && req.SwIfIndex == loopbackIdx {
+				loop.Flags = req.Flags & interface_types.IF_STATUS_API_FLAG_ADMIN_UP
+			}
+		case *vpp_ifs.SwInterfaceSetMacAddress:
+			if loop != nil && req.SwIfIndex == loopbackIdx {
+				loop.L2Address = req.MacAddress
+			}
+		case *vpp_ifs.HwInterfaceSetMtu:
+			if loop != nil && req.SwIfIndex == loopbackIdx {
+				loop.LinkMtu = req.Mtu
+			}
+		}
+	}
+	replies := []*vppmock.HandleReplies{
+		{
+			Name:    (&vpp_ifs.CreateLoopback{}).GetMessageName(),
+			Message: &vpp_ifs.CreateLoopbackReply{SwIfIndex: loopbackIdx},
+		},
+		{
+			Name:    (&vpp_ifs.DeleteLoopback{}).GetMessageName(),
+			Message: &vpp_ifs.DeleteLoopbackReply{},
+		},
+		{
+			Name:    (&vpp_ifs.SwInterfaceTagAddDel{}).GetMessageName(),
+			Message: &vpp_ifs.SwInterfaceTagAddDelReply{},
+		},
+		{
+			Name:    (&vpp_ifs.SwInterfaceSetFlags{}).GetMessageName(),
+			Message: &vpp_ifs.SwInterfaceSetFlagsReply{},
+		},
+		{
+			Name:    (&vpp_ifs.SwInterfaceSetMacAddress{}).GetMessageName(),
+			Message: &vpp_ifs.SwInterfaceSetMacAddressReply{},
+		},
+		{
+			Name:    (&vpp_ifs.HwInterfaceSetMtu{}).GetMessageName(),
+			Message: &vpp_ifs.HwInterfaceSetMtuReply{},
+		},
+		{
+			Name:    (&vpp_ifs.SwInterfaceGetTable{}).GetMessageName(),
+			Message: &vpp_ifs.SwInterfaceGetTableReply{},
+		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
+	}
+	if loop == nil {
+		// empty dump is answered by control ping only
+		return replies
+	}
+	return append(replies, &vppmock.HandleReplies{
+		Name:     (&vpp_ifs.SwInterfaceDump{}).GetMessageName(),
+		Ping:     true,
+		Messages: []govppapi.Message{loop},
+	})
+}
@@ -0,0 +1,197 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"net"
+
+	"github.com/go-errors/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// IP6PrefixAddressDescriptorName is the name of the descriptor assigning
+	// IPv6 addresses from delegated prefixes to VPP interfaces.
+	IP6PrefixAddressDescriptorName = "vpp-ip6-prefix-address"
+)
+
+// ErrInvalidIP6PrefixAddress is returned when address used with delegated
+// prefix is not a valid IPv6 address with prefix length.
+var ErrInvalidIP6PrefixAddress = errors.New("address must be IPv6 address with prefix length")
+
+// IP6PrefixAddressDescriptor assigns IPv6 addresses constructed from
+// the prefixes delegated by DHCPv6 server to VPP interfaces.
+type IP6PrefixAddressDescriptor struct {
+	log       logging.Logger
+	ifHandler vppcalls.InterfaceVppAPI
+	ifIndex   ifaceidx.IfaceMetadataIndex
+}
+
+// NewIP6PrefixAddressDescriptor creates a new instance of IP6PrefixAddressDescriptor.
+func NewIP6PrefixAddressDescriptor(ifHandler vppcalls.InterfaceVppAPI,
+	ifIndex ifaceidx.IfaceMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &IP6PrefixAddressDescriptor{
+		ifHandler: ifHandler,
+		ifIndex:   ifIndex,
+		log:       log.NewLogger("ip6-prefix-address-descriptor"),
+	}
+
+	typedDescr := &adapter.IP6PrefixAddressDescriptor{
+		Name:                 IP6PrefixAddressDescriptorName,
+		KeySelector:          ctx.IsIP6PrefixAddressKey,
+		KeyLabel:             ctx.InterfaceNameFromKey,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		RetrieveDependencies: []string{InterfaceDescriptorName},
+	}
+	return adapter.NewIP6PrefixAddressDescriptor(typedDescr)
+}
+
+// IsIP6PrefixAddressKey returns true if the key is identifying IPv6 address
+// from delegated prefix (derived value).
+func (d *IP6PrefixAddressDescriptor) IsIP6PrefixAddressKey(key string) bool {
+	_, _, _, isValid := interfaces.ParseIP6PrefixAddressKey(key)
+	return isValid
+}
+
+// InterfaceNameFromKey returns interface name from the key.
+func (d *IP6PrefixAddressDescriptor) InterfaceNameFromKey(key string) string {
+	if iface, _, _, isValid := interfaces.ParseIP6PrefixAddressKey(key); isValid {
+		return iface
+	}
+	return key
+}
+
+// Validate validates prefix group and the address.
+func (d *IP6PrefixAddressDescriptor) Validate(key string, addr *interfaces.Interface_IP6PrefixAddress) error {
+	if err := validatePrefixGroup(addr.GetPrefixGroup()); err != nil {
+		return kvs.NewInvalidValueError(err, "prefix_group")
+	}
+	if ip, _, err := net.ParseCIDR(addr.GetAddress()); err != nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(ErrInvalidIP6PrefixAddress, "address")
+	}
+	return nil
+}
+
+// Create assigns IPv6 address from the delegated prefix to the interface.
+func (d *IP6PrefixAddressDescriptor) Create(key string, addr *interfaces.Interface_IP6PrefixAddress) (metadata interface{}, err error) {
+	ifName, _, _, _ := interfaces.ParseIP6PrefixAddressKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ifName)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	err = d.ifHandler.AddIP6AddressUsingPrefix(ifMeta.SwIfIndex, addr.GetPrefixGroup(), addr.GetAddress())
+	if err != nil {
+		err = errors.Errorf("failed to add address %s from prefix group %s to interface %s: %v",
+			addr.GetAddress(), addr.GetPrefixGroup(), ifName, err)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// Delete removes IPv6 address from the delegated prefix from the interface.
+func (d *IP6PrefixAddressDescriptor) Delete(key string, addr *interfaces.Interface_IP6PrefixAddress, metadata interface{}) error {
+	ifName, _, _, _ := interfaces.ParseIP6PrefixAddressKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err := errors.Errorf("failed to find interface %s", ifName)
+		d.log.Error(err)
+		return err
+	}
+
+	err := d.ifHandler.DelIP6AddressUsingPrefix(ifMeta.SwIfIndex, addr.GetPrefixGroup(), addr.GetAddress())
+	if err != nil {
+		err = errors.Errorf("failed to remove address %s from prefix group %s from interface %s: %v",
+			addr.GetAddress(), addr.GetPrefixGroup(), ifName, err)
+		d.log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// filterIP6PrefixAddresses removes retrieved IPv6 addresses which were most
+// likely assigned by VPP from the delegated prefixes, i.e. addresses which are
+// not configured as static and which match some of the prefix addresses.
+func filterIP6PrefixAddresses(retrieved, static []string, prefixAddrs []*interfaces.Interface_IP6PrefixAddress) (filtered []string) {
+	for _, addr := range retrieved {
+		if !isStaticAddress(addr, static) && isAddressFromPrefix(addr, prefixAddrs) {
+			continue
+		}
+		filtered = append(filtered, addr)
+	}
+	return filtered
+}
+
+// isStaticAddress returns true if the address is among the statically configured ones.
+func isStaticAddress(addr string, static []string) bool {
+	ip, ipNet, err := net.ParseCIDR(addr)
+	if err != nil {
+		return true
+	}
+	for _, staticAddr := range static {
+		staticIP, staticNet, err := net.ParseCIDR(staticAddr)
+		if err != nil {
+			continue
+		}
+		if ip.Equal(staticIP) && ipNet.Mask.String() == staticNet.Mask.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// isAddressFromPrefix returns true if the address could be constructed by VPP
+// from some delegated prefix and the given prefix address. VPP takes bits
+// covered by the delegated prefix from the prefix and the remaining bits from
+// the prefix address, which (unlike the delegated prefix) must therefore match.
+func isAddressFromPrefix(addr string, prefixAddrs []*interfaces.Interface_IP6PrefixAddress) bool {
+	ip, ipNet, err := net.ParseCIDR(addr)
+	if err != nil || ip.To4() != nil {
+		return false
+	}
+	ip = ip.To16()
+	for _, prefixAddr := range prefixAddrs {
+		tmplIP, tmplNet, err := net.ParseCIDR(prefixAddr.GetAddress())
+		if err != nil || tmplIP.To4() != nil || ipNet.Mask.String() != tmplNet.Mask.String() {
+			continue
+		}
+		tmplIP = tmplIP.To16()
+		// skip leading zero bytes of the prefix address (taken from the delegated prefix)
+		first := 0
+		for first < net.IPv6len-1 && tmplIP[first] == 0 {
+			first++
+		}
+		// the first non-zero byte may be shared with the delegated prefix
+		if ip[first]&tmplIP[first] == tmplIP[first] && bytes.Equal(ip[first+1:], tmplIP[first+1:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -16,6 +16,7 @@ package descriptor
 
 import (
 	"context"
+	"net"
 
 	"github.com/go-errors/errors"
 	"go.ligato.io/cn-infra/v2/logging"
@@ -30,6 +31,32 @@ import (
 const (
 	// IP6ndDescriptorName is the name of the descriptor.
 	IP6ndDescriptorName = "vpp-ip6nd"
+
+	// VPP limits and defaults for router advertisement intervals (in seconds)
+	minRaMaxInterval     = 4
+	minRaMinInterval     = 3
+	defaultRaMaxInterval = 200
+)
+
+// A list of validation errors for IP6 ND configuration.
+var (
+	// ErrRaMaxIntervalTooSmall is returned when RA max_interval is below the VPP limit.
+	ErrRaMaxIntervalTooSmall = errors.Errorf("router advertisement max_interval must be at least %d seconds", minRaMaxInterval)
+
+	// ErrRaInvalidMinInterval is returned when RA min_interval is out of range given by max_interval.
+	ErrRaInvalidMinInterval = errors.Errorf("router advertisement min_interval must be between %d seconds and 0.75 * max_interval", minRaMinInterval)
+
+	// ErrRaInvalidRouterLifetime is returned when RA router_lifetime is smaller than max_interval.
+	ErrRaInvalidRouterLifetime = errors.New("router advertisement router_lifetime must not be smaller than max_interval")
+
+	// ErrRaInvalidPrefix is returned when RA prefix is not a valid IPv6 prefix.
+	ErrRaInvalidPrefix = errors.New("router advertisement prefix must be a valid IPv6 prefix")
+
+	// ErrRaDuplicatePrefix is returned when the same RA prefix is defined more than once.
+	ErrRaDuplicatePrefix = errors.New("router advertisement prefix is defined more than once")
+
+	// ErrRaInvalidPrefixLifetime is returned when RA prefix preferred lifetime exceeds valid lifetime.
+	ErrRaInvalidPrefixLifetime = errors.New("router advertisement prefix preferred_lifetime must not exceed valid_lifetime")
 )
 
 // IP6ndDescriptor instructs KVScheduler how to configure VPP IP6ND entries.
@@ -55,6 +82,7 @@ func NewIP6ndDescriptor(scheduler kvs.KVScheduler, handler vppcalls.IP6ndVppAPI,
 		Name:        IP6ndDescriptorName,
 		KeySelector: ctx.IsIP6NDRelatedKey,
 		KeyLabel:    ctx.InterfaceNameFromKey,
+		Validate:    ctx.Validate,
 		Create:      ctx.Create,
 		Delete:      ctx.Delete,
 		//Retrieve:             ctx.Retrieve,
@@ -79,6 +107,45 @@ func (d *IP6ndDescriptor) InterfaceNameFromKey(key string) string {
 	return key
 }
 
+// Validate validates router advertisement configuration.
+func (d *IP6ndDescriptor) Validate(key string, entry *interfaces.Interface_IP6ND) error {
+	ra := entry.GetRa()
+	if ra == nil {
+		return nil
+	}
+
+	maxInterval := ra.GetMaxInterval()
+	if maxInterval == 0 {
+		maxInterval = defaultRaMaxInterval
+	} else if maxInterval < minRaMaxInterval {
+		return kvs.NewInvalidValueError(ErrRaMaxIntervalTooSmall, "ra.max_interval")
+	}
+	if minInterval := ra.GetMinInterval(); minInterval != 0 {
+		if minInterval < minRaMinInterval || 4*minInterval > 3*maxInterval {
+			return kvs.NewInvalidValueError(ErrRaInvalidMinInterval, "ra.min_interval")
+		}
+	}
+	if lifetime := ra.GetRouterLifetime(); lifetime != 0 && lifetime < maxInterval {
+		return kvs.NewInvalidValueError(ErrRaInvalidRouterLifetime, "ra.router_lifetime")
+	}
+
+	prefixes := make(map[string]struct{})
+	for _, prefix := range ra.GetPrefixes() {
+		ip, ipNet, err := net.ParseCIDR(prefix.GetPrefix())
+		if err != nil || ip.To4() != nil {
+			return kvs.NewInvalidValueError(ErrRaInvalidPrefix, "ra.prefixes.prefix")
+		}
+		if _, duplicate := prefixes[ipNet.String()]; duplicate {
+			return kvs.NewInvalidValueError(ErrRaDuplicatePrefix, "ra.prefixes.prefix")
+		}
+		prefixes[ipNet.String()] = struct{}{}
+		if prefix.GetPreferredLifetime() > prefix.GetValidLifetime() {
+			return kvs.NewInvalidValueError(ErrRaInvalidPrefixLifetime, "ra.prefixes.preferred_lifetime")
+		}
+	}
+	return nil
+}
+
 // Create adds a VPP IP6ND entry.
 func (d *IP6ndDescriptor) Create(key string, entry *interfaces.Interface_IP6ND) (metadata interface{}, err error) {
 	ifName, _ := interfaces.ParseNameFromIP6NDKey(key)
@@ -95,6 +162,22 @@ func (d *IP6ndDescriptor) Create(key string, entry *interfaces.Interface_IP6ND)
 		return nil, err
 	}
 
+	if ra := entry.GetRa(); ra != nil {
+		if err := d.handler.SetIP6ndRouterAdvertisement(context.Background(), ifMeta.SwIfIndex, ra); err != nil {
+			err = errors.Errorf("failed to configure router advertisement for interface %s: %v", ifName, err)
+			d.log.Error(err)
+			return nil, err
+		}
+		for _, prefix := range ra.GetPrefixes() {
+			if err := d.handler.AddIP6ndRaPrefix(context.Background(), ifMeta.SwIfIndex, prefix); err != nil {
+				err = errors.Errorf("failed to add router advertisement prefix %s for interface %s: %v",
+					prefix.GetPrefix(), ifName, err)
+				d.log.Error(err)
+				return nil, err
+			}
+		}
+	}
+
 	return nil, err
 }
 
@@ -108,6 +191,22 @@ func (d *IP6ndDescriptor) Delete(key string, entry *interfaces.Interface_IP6ND,
 		return err
 	}
 
+	if ra := entry.GetRa(); ra != nil {
+		for _, prefix := range ra.GetPrefixes() {
+			if err := d.handler.DelIP6ndRaPrefix(context.Background(), ifMeta.SwIfIndex, prefix); err != nil {
+				err = errors.Errorf("failed to remove router advertisement prefix %s for interface %s: %v",
+					prefix.GetPrefix(), ifName, err)
+				d.log.Error(err)
+				return err
+			}
+		}
+		if err := d.handler.ResetIP6ndRouterAdvertisement(context.Background(), ifMeta.SwIfIndex); err != nil {
+			err = errors.Errorf("failed to reset router advertisement for interface %s: %v", ifName, err)
+			d.log.Error(err)
+			return err
+		}
+	}
+
 	if err := d.handler.SetIP6ndAutoconfig(context.Background(), ifMeta.SwIfIndex, false, false); err != nil {
 		err = errors.Errorf("failed to disable IP6ND for interface %s", ifName)
 		d.log.Error(err)
@@ -19,6 +19,8 @@
 //go:generate descriptor-adapter --descriptor-name BondedInterface  --value-type *vpp_interfaces.BondLink_BondedInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name Span  --value-type *vpp_interfaces.Span --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name IP6ND --value-type *vpp_interfaces.Interface_IP6ND --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name DHCPv6PDClient --value-type *vpp_interfaces.Interface_DHCPv6PDClient --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IP6PrefixAddress --value-type *vpp_interfaces.Interface_IP6PrefixAddress --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 
 package ifplugin
 
@@ -102,6 +104,7 @@ type Deps struct {
 	VPP          govppmux.API
 	ServiceLabel servicelabel.ReaderAPI
 	AddrAlloc    netalloc.AddressAllocator
+	ResAlloc     netalloc.ResourceAllocator
 	/*	LinuxIfPlugin and NsPlugin deps are optional,
 		but they are required if AFPacket or TAP+TAP_TO_VPP interfaces are used. */
 	LinuxIfPlugin descriptor.LinuxPluginAPI
@@ -149,7 +152,7 @@ func (p *IfPlugin) Init() (err error) {
 
 	//   -> base interface descriptor
 	ifaceDescriptor, ifaceDescrCtx := descriptor.NewInterfaceDescriptor(p.ifHandler,
-		p.AddrAlloc, p.defaultMtu, p.linuxIfHandler, p.LinuxIfPlugin, p.NsPlugin, p.Log)
+		p.AddrAlloc, p.ResAlloc, p.defaultMtu, p.linuxIfHandler, p.LinuxIfPlugin, p.NsPlugin, p.Log)
 	err = p.KVScheduler.RegisterKVDescriptor(ifaceDescriptor)
 	if err != nil {
 		return err
@@ -182,6 +185,8 @@ func (p *IfPlugin) Init() (err error) {
 	spanDescriptor, spanDescriptorCtx := descriptor.NewSpanDescriptor(p.ifHandler, p.Log)
 	spanDescriptorCtx.SetInterfaceIndex(p.intfIndex)
 	ip6ndDescriptor := descriptor.NewIP6ndDescriptor(p.KVScheduler, p.ifHandler, p.intfIndex, p.Log)
+	dhcpv6PDClientDescriptor := descriptor.NewDHCPv6PDClientDescriptor(p.ifHandler, p.intfIndex, p.Log)
+	ip6PrefixAddrDescriptor := descriptor.NewIP6PrefixAddressDescriptor(p.ifHandler, p.intfIndex, p.Log)
 
 	err = p.KVScheduler.RegisterKVDescriptor(
 		dhcpDescriptor,
@@ -195,6 +200,8 @@ func (p *IfPlugin) Init() (err error) {
 		withAddrDescriptor,
 		spanDescriptor,
 		ip6ndDescriptor,
+		dhcpv6PDClientDescriptor,
+		ip6PrefixAddrDescriptor,
 	)
 	if err != nil {
 		return err
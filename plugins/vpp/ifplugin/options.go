@@ -38,6 +38,7 @@ func NewPlugin(opts ...Option) *IfPlugin {
 	p.VPP = &govppmux.DefaultPlugin
 	p.ServiceLabel = &servicelabel.DefaultPlugin
 	p.AddrAlloc = &netalloc.DefaultPlugin
+	p.ResAlloc = &netalloc.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
@@ -34,6 +34,9 @@ var (
 
 	// ErrRdmaUnsupported error is returned if RDMA interface is not supported on given VPP version.
 	ErrRdmaUnsupported = errors.New("RDMA interface not supported")
+
+	// ErrDHCPv6PDUnsupported error is returned if DHCPv6 prefix delegation is not supported on given VPP version.
+	ErrDHCPv6PDUnsupported = errors.New("DHCPv6 prefix delegation not supported")
 )
 
 // InterfaceDetails is the wrapper structure for the interface northbound API structure.
@@ -67,6 +70,9 @@ type InterfaceMeta struct {
 
 	// wmxnet3
 	Pci uint32 `json:"pci"`
+
+	// linux-cp (VPP side of the host interface created by the linux_cp plugin)
+	LinuxCPHost bool `json:"linux_cp_host"`
 }
 
 // InterfaceEvent represents interface event from VPP.
@@ -160,6 +166,29 @@ type InterfaceVppAPI interface {
 	// DeleteVxLanGpeTunnel removes VxLAN-GPE tunnel.
 	DeleteVxLanGpeTunnel(ifName string, vxLan *interfaces.VxlanLink) error
 
+	// AddGeneveTunnel creates new Geneve tunnel interface.
+	AddGeneveTunnel(ifName string, vrf, multicastIf uint32, geneve *interfaces.GeneveLink) (uint32, error)
+	// DeleteGeneveTunnel removes Geneve tunnel interface.
+	DeleteGeneveTunnel(ifName string, idx, vrf uint32, geneve *interfaces.GeneveLink) error
+
+	// AddMplsTunnel creates new MPLS tunnel interface. Outgoing interfaces of the tunnel
+	// paths are given by their sw_if_index (one per path, in the same order).
+	AddMplsTunnel(ifName string, mplsTunnel *interfaces.MplsTunnelLink, pathIfIdxs []uint32) (uint32, error)
+	// DeleteMplsTunnel removes MPLS tunnel interface (all its paths have to be given).
+	DeleteMplsTunnel(ifName string, idx uint32, mplsTunnel *interfaces.MplsTunnelLink, pathIfIdxs []uint32) error
+
+	// AddPPPoESession creates new PPPoE session interface.
+	AddPPPoESession(ifName string, pppoeLink *interfaces.PPPoELink) (uint32, error)
+	// DeletePPPoESession removes PPPoE session interface.
+	DeletePPPoESession(ifName string, idx uint32, pppoeLink *interfaces.PPPoELink) error
+
+	// AddL2tpv3Tunnel creates new L2TPv3 tunnel interface. Existing tunnel with the same
+	// addresses and session IDs (left behind by DeleteL2tpv3Tunnel) is re-used.
+	AddL2tpv3Tunnel(ifName string, l2tpv3Link *interfaces.L2TPv3Link) (uint32, error)
+	// DeleteL2tpv3Tunnel un-tags L2TPv3 tunnel interface, VPP does not support removal
+	// of L2TPv3 tunnels.
+	DeleteL2tpv3Tunnel(ifName string, idx uint32) error
+
 	// AddIPSecTunnelInterface adds a new IPSec tunnel interface
 	AddIPSecTunnelInterface(ctx context.Context, ifName string, ipSecLink *interfaces.IPSecLink) (uint32, error)
 	// DeleteIPSecTunnelInterface removes existing IPSec tunnel interface
@@ -220,6 +249,14 @@ type InterfaceVppAPI interface {
 	SetInterfaceAsDHCPClient(ifIdx uint32, hostName string) error
 	// UnsetInterfaceAsDHCPClient un-sets interface as DHCP client
 	UnsetInterfaceAsDHCPClient(ifIdx uint32, hostName string) error
+	// SetDHCPv6PDClient enables DHCPv6 client requesting prefix delegation on the interface.
+	SetDHCPv6PDClient(ifIdx uint32, prefixGroup string) error
+	// UnsetDHCPv6PDClient disables DHCPv6 client requesting prefix delegation on the interface.
+	UnsetDHCPv6PDClient(ifIdx uint32, prefixGroup string) error
+	// AddIP6AddressUsingPrefix assigns IPv6 address constructed from the delegated prefix to the interface.
+	AddIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string) error
+	// DelIP6AddressUsingPrefix removes IPv6 address constructed from the delegated prefix from the interface.
+	DelIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string) error
 	// AddContainerIP calls IPContainerProxyAddDel VPP API with IsAdd=1
 	AddContainerIP(ifIdx uint32, addr string) error
 	// DelContainerIP calls IPContainerProxyAddDel VPP API with IsAdd=0
@@ -301,6 +338,15 @@ type InterfaceVppRead interface {
 // IP6ndVppAPI provides methods for managing IPv6 ND configuration.
 type IP6ndVppAPI interface {
 	SetIP6ndAutoconfig(ctx context.Context, ifIdx uint32, enable, installDefaultRoutes bool) error
+	// SetIP6ndRouterAdvertisement configures router advertisements sent on the interface.
+	// IPv6 is enabled on the interface if needed.
+	SetIP6ndRouterAdvertisement(ctx context.Context, ifIdx uint32, ra *interfaces.Interface_IP6ND_RouterAdvertisement) error
+	// ResetIP6ndRouterAdvertisement restores default router advertisement configuration of the interface.
+	ResetIP6ndRouterAdvertisement(ctx context.Context, ifIdx uint32) error
+	// AddIP6ndRaPrefix adds prefix information option into router advertisements sent on the interface.
+	AddIP6ndRaPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_RouterAdvertisement_Prefix) error
+	// DelIP6ndRaPrefix removes prefix information option from router advertisements sent on the interface.
+	DelIP6ndRaPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_RouterAdvertisement_Prefix) error
 }
 
 var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
@@ -17,6 +17,7 @@ package vpp2202
 import (
 	vpp_dhcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 )
 
 func (h *InterfaceVppHandler) handleInterfaceDHCP(ifIdx uint32, hostName string, isAdd bool) error {
@@ -44,3 +45,19 @@ func (h *InterfaceVppHandler) SetInterfaceAsDHCPClient(ifIdx uint32, hostName st
 func (h *InterfaceVppHandler) UnsetInterfaceAsDHCPClient(ifIdx uint32, hostName string) error {
 	return h.handleInterfaceDHCP(ifIdx, hostName, false)
 }
+
+func (h *InterfaceVppHandler) SetDHCPv6PDClient(ifIdx uint32, prefixGroup string) error {
+	return vppcalls.ErrDHCPv6PDUnsupported
+}
+
+func (h *InterfaceVppHandler) UnsetDHCPv6PDClient(ifIdx uint32, prefixGroup string) error {
+	return vppcalls.ErrDHCPv6PDUnsupported
+}
+
+func (h *InterfaceVppHandler) AddIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string) error {
+	return vppcalls.ErrDHCPv6PDUnsupported
+}
+
+func (h *InterfaceVppHandler) DelIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string) error {
+	return vppcalls.ErrDHCPv6PDUnsupported
+}
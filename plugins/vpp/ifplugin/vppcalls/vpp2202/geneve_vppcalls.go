@@ -0,0 +1,152 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/geneve"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// index of the l2-input next node of the geneve-input node, used when decap-next is not set
+const geneveDecapNextL2Input = 1
+
+func (h *InterfaceVppHandler) addDelGeneveTunnel(geneveLink *ifs.GeneveLink, vrf, multicastIf uint32, isAdd bool) (uint32, error) {
+	decapNext := geneveLink.DecapNextNode
+	if decapNext == 0 {
+		decapNext = geneveDecapNextL2Input
+	}
+	req := &geneve.GeneveAddDelTunnel2{
+		IsAdd:          isAdd,
+		Vni:            geneveLink.Vni,
+		DecapNextIndex: decapNext,
+		EncapVrfID:     vrf,
+		McastSwIfIndex: interface_types.InterfaceIndex(multicastIf),
+		L3Mode:         geneveLink.L3Mode,
+	}
+
+	srcAddr := net.ParseIP(geneveLink.SrcAddress)
+	dstAddr := net.ParseIP(geneveLink.DstAddress)
+	if srcAddr == nil || dstAddr == nil {
+		return 0, fmt.Errorf("invalid Geneve address, src: %s, dst: %s", geneveLink.SrcAddress, geneveLink.DstAddress)
+	}
+	if (srcAddr.To4() == nil) != (dstAddr.To4() == nil) {
+		return 0, fmt.Errorf("IP version mismatch for Geneve destination and source IP addresses")
+	}
+	req.LocalAddress, _ = IPToAddress(srcAddr.String())
+	req.RemoteAddress, _ = IPToAddress(dstAddr.String())
+
+	reply := &geneve.GeneveAddDelTunnel2Reply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return uint32(reply.SwIfIndex), nil
+}
+
+// AddGeneveTunnel creates new Geneve tunnel interface.
+func (h *InterfaceVppHandler) AddGeneveTunnel(ifName string, vrf, multicastIf uint32, geneveLink *ifs.GeneveLink) (uint32, error) {
+	if h.geneve == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "geneve")
+	}
+	if geneveLink == nil {
+		return 0, errors.New("missing Geneve tunnel information")
+	}
+
+	swIfIdx, err := h.addDelGeneveTunnel(geneveLink, vrf, multicastIf, true)
+	if err != nil {
+		return 0, err
+	}
+	return swIfIdx, h.SetInterfaceTag(ifName, swIfIdx)
+}
+
+// DeleteGeneveTunnel removes Geneve tunnel interface.
+func (h *InterfaceVppHandler) DeleteGeneveTunnel(ifName string, idx, vrf uint32, geneveLink *ifs.GeneveLink) error {
+	if h.geneve == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "geneve")
+	}
+	if geneveLink == nil {
+		return errors.New("missing Geneve tunnel information")
+	}
+
+	// Multicast does not need to be set
+	if _, err := h.addDelGeneveTunnel(geneveLink, vrf, 0, false); err != nil {
+		return err
+	}
+	return h.RemoveInterfaceTag(ifName, idx)
+}
+
+// dumpGeneveDetails dumps Geneve interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpGeneveDetails(ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.geneve == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	reqCtx := h.callsChannel.SendMultiRequest(&geneve.GeneveTunnelDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		geneveDetails := &geneve.GeneveTunnelDetails{}
+		stop, err := reqCtx.ReceiveReply(geneveDetails)
+		if stop {
+			break // Break from the loop.
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump Geneve tunnel interface details: %v", err)
+		}
+		_, ifIdxExists := ifc[uint32(geneveDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		// Multicast interface
+		var multicastIfName string
+		_, exists := ifc[uint32(geneveDetails.McastSwIfIndex)]
+		if exists {
+			multicastIfName = ifc[uint32(geneveDetails.McastSwIfIndex)].Interface.Name
+		}
+
+		geneveLink := &ifs.GeneveLink{
+			Multicast: multicastIfName,
+			Vni:       geneveDetails.Vni,
+		}
+		if geneveDetails.DecapNextIndex != geneveDecapNextL2Input {
+			geneveLink.DecapNextNode = geneveDetails.DecapNextIndex
+		}
+		if geneveDetails.SrcAddress.Af == ip_types.ADDRESS_IP6 {
+			srcIP := geneveDetails.SrcAddress.Un.GetIP6()
+			dstIP := geneveDetails.DstAddress.Un.GetIP6()
+			geneveLink.SrcAddress = net.IP(srcIP[:]).To16().String()
+			geneveLink.DstAddress = net.IP(dstIP[:]).To16().String()
+		} else {
+			srcIP := geneveDetails.SrcAddress.Un.GetIP4()
+			dstIP := geneveDetails.DstAddress.Un.GetIP4()
+			geneveLink.SrcAddress = net.IP(srcIP[:4]).To4().String()
+			geneveLink.DstAddress = net.IP(dstIP[:4]).To4().String()
+		}
+
+		ifc[uint32(geneveDetails.SwIfIndex)].Interface.Link = &ifs.Interface_Geneve{Geneve: geneveLink}
+		ifc[uint32(geneveDetails.SwIfIndex)].Interface.Type = ifs.Interface_GENEVE_TUNNEL
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2tp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// AddL2tpv3Tunnel creates new L2TPv3 tunnel interface.
+func (h *InterfaceVppHandler) AddL2tpv3Tunnel(ifName string, l2tpv3Link *interfaces.L2TPv3Link) (uint32, error) {
+	if h.l2tp == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "l2tp")
+	}
+	if l2tpv3Link == nil {
+		return 0, errors.New("missing L2TPv3 tunnel information")
+	}
+	srcAddr, err := ip_types.ParseAddress(l2tpv3Link.SrcAddr)
+	if err != nil {
+		return 0, errors.Errorf("bad source address for L2TPv3 tunnel: %v", err)
+	}
+	dstAddr, err := ip_types.ParseAddress(l2tpv3Link.DstAddr)
+	if err != nil {
+		return 0, errors.Errorf("bad destination address for L2TPv3 tunnel: %v", err)
+	}
+	if srcAddr.Af != ip_types.ADDRESS_IP6 || dstAddr.Af != ip_types.ADDRESS_IP6 {
+		return 0, errors.New("L2TPv3 tunnel supports only IPv6 addresses")
+	}
+
+	// VPP cannot remove L2TPv3 tunnel, re-use the tunnel left behind by delete
+	swIfIndex, exists, err := h.findL2tpv3Tunnel(srcAddr, dstAddr, l2tpv3Link)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		req := &l2tp.L2tpv3SetTunnelCookies{
+			SwIfIndex:       interface_types.InterfaceIndex(swIfIndex),
+			NewLocalCookie:  l2tpv3Link.LocalCookie,
+			NewRemoteCookie: l2tpv3Link.RemoteCookie,
+		}
+		reply := &l2tp.L2tpv3SetTunnelCookiesReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return 0, err
+		}
+	} else {
+		req := &l2tp.L2tpv3CreateTunnel{
+			ClientAddress:     dstAddr,
+			OurAddress:        srcAddr,
+			LocalSessionID:    l2tpv3Link.LocalSessionId,
+			RemoteSessionID:   l2tpv3Link.RemoteSessionId,
+			LocalCookie:       l2tpv3Link.LocalCookie,
+			RemoteCookie:      l2tpv3Link.RemoteCookie,
+			L2SublayerPresent: l2tpv3Link.L2SublayerPresent,
+			EncapVrfID:        l2tpv3Link.EncapVrf,
+		}
+		reply := &l2tp.L2tpv3CreateTunnelReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return 0, err
+		}
+		swIfIndex = uint32(reply.SwIfIndex)
+	}
+	return swIfIndex, h.SetInterfaceTag(ifName, swIfIndex)
+}
+
+// DeleteL2tpv3Tunnel un-tags L2TPv3 tunnel interface.
+func (h *InterfaceVppHandler) DeleteL2tpv3Tunnel(ifName string, idx uint32) error {
+	if h.l2tp == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "l2tp")
+	}
+	return h.RemoveInterfaceTag(ifName, idx)
+}
+
+// findL2tpv3Tunnel looks for existing L2TPv3 tunnel with the given addresses and session IDs.
+func (h *InterfaceVppHandler) findL2tpv3Tunnel(srcAddr, dstAddr ip_types.Address, l2tpv3Link *interfaces.L2TPv3Link) (
+	swIfIndex uint32, exists bool, err error) {
+
+	reqCtx := h.callsChannel.SendMultiRequest(&l2tp.SwIfL2tpv3TunnelDump{})
+	for {
+		details := &l2tp.SwIfL2tpv3TunnelDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to dump L2TPv3 tunnels: %v", err)
+		}
+		if !exists &&
+			details.OurAddress == srcAddr &&
+			details.ClientAddress == dstAddr &&
+			details.LocalSessionID == l2tpv3Link.LocalSessionId &&
+			details.RemoteSessionID == l2tpv3Link.RemoteSessionId {
+			swIfIndex, exists = uint32(details.SwIfIndex), true
+		}
+	}
+	return swIfIndex, exists, nil
+}
+
+// dumpL2tpv3Details dumps L2TPv3 tunnel interface details from VPP and fills them into the provided interface map.
+// Encapsulation VRF is not dumped by VPP.
+func (h *InterfaceVppHandler) dumpL2tpv3Details(ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.l2tp == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	reqCtx := h.callsChannel.SendMultiRequest(&l2tp.SwIfL2tpv3TunnelDump{})
+	for {
+		l2tpDetails := &l2tp.SwIfL2tpv3TunnelDetails{}
+		stop, err := reqCtx.ReceiveReply(l2tpDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump L2TPv3 tunnel interface details: %v", err)
+		}
+		_, ifIdxExists := ifc[uint32(l2tpDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		l2tpv3Link := &interfaces.L2TPv3Link{
+			SrcAddr:           l2tpDetails.OurAddress.String(),
+			DstAddr:           l2tpDetails.ClientAddress.String(),
+			LocalSessionId:    l2tpDetails.LocalSessionID,
+			RemoteSessionId:   l2tpDetails.RemoteSessionID,
+			RemoteCookie:      l2tpDetails.RemoteCookie,
+			L2SublayerPresent: l2tpDetails.L2SublayerPresent,
+		}
+		// the first local cookie is the one currently in use
+		if len(l2tpDetails.LocalCookie) > 0 {
+			l2tpv3Link.LocalCookie = l2tpDetails.LocalCookie[0]
+		}
+		ifc[uint32(l2tpDetails.SwIfIndex)].Interface.Link = &interfaces.Interface_L2Tpv3{L2Tpv3: l2tpv3Link}
+		ifc[uint32(l2tpDetails.SwIfIndex)].Interface.Type = interfaces.Interface_L2TPV3_TUNNEL
+	}
+	return nil
+}
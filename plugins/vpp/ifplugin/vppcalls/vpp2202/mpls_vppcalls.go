@@ -0,0 +1,195 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/mpls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// mplsLabelInvalid is used as via-label of the path with no via-label defined
+	mplsLabelInvalid = 0xfffff + 1
+	// mplsPathIfUnset is used as sw_if_index of the path with no outgoing interface
+	mplsPathIfUnset = ^uint32(0)
+)
+
+// AddMplsTunnel creates new MPLS tunnel interface.
+func (h *InterfaceVppHandler) AddMplsTunnel(ifName string, mplsTunnel *ifs.MplsTunnelLink, pathIfIdxs []uint32) (uint32, error) {
+	if mplsTunnel == nil {
+		return 0, errors.New("missing MPLS tunnel information")
+	}
+	paths, err := mplsTunnelPathsToFibPaths(mplsTunnel.Paths, pathIfIdxs)
+	if err != nil {
+		return 0, err
+	}
+
+	req := &mpls.MplsTunnelAddDel{
+		MtIsAdd: true,
+		MtTunnel: mpls.MplsTunnel{
+			// create new tunnel
+			MtSwIfIndex: ^interface_types.InterfaceIndex(0),
+			MtL2Only:    mplsTunnel.L2Only,
+			MtTag:       ifName,
+			MtNPaths:    uint8(len(paths)),
+			MtPaths:     paths,
+		},
+	}
+	reply := &mpls.MplsTunnelAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	swIfIdx := uint32(reply.SwIfIndex)
+
+	return swIfIdx, h.SetInterfaceTag(ifName, swIfIdx)
+}
+
+// DeleteMplsTunnel removes MPLS tunnel interface. VPP removes the tunnel
+// once all its paths are removed.
+func (h *InterfaceVppHandler) DeleteMplsTunnel(ifName string, idx uint32, mplsTunnel *ifs.MplsTunnelLink, pathIfIdxs []uint32) error {
+	if mplsTunnel == nil {
+		return errors.New("missing MPLS tunnel information")
+	}
+	paths, err := mplsTunnelPathsToFibPaths(mplsTunnel.Paths, pathIfIdxs)
+	if err != nil {
+		return err
+	}
+
+	req := &mpls.MplsTunnelAddDel{
+		MtIsAdd: false,
+		MtTunnel: mpls.MplsTunnel{
+			MtSwIfIndex: interface_types.InterfaceIndex(idx),
+			MtNPaths:    uint8(len(paths)),
+			MtPaths:     paths,
+		},
+	}
+	reply := &mpls.MplsTunnelAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return h.RemoveInterfaceTag(ifName, idx)
+}
+
+func mplsTunnelPathsToFibPaths(tunnelPaths []*ifs.MplsTunnelLink_Path, pathIfIdxs []uint32) ([]fib_types.FibPath, error) {
+	if len(tunnelPaths) != len(pathIfIdxs) {
+		return nil, fmt.Errorf("MPLS tunnel has %d paths, but %d outgoing interfaces were given",
+			len(tunnelPaths), len(pathIfIdxs))
+	}
+	var paths []fib_types.FibPath
+	for i, tunnelPath := range tunnelPaths {
+		fibPath := fib_types.FibPath{
+			SwIfIndex:  pathIfIdxs[i],
+			Weight:     uint8(tunnelPath.Weight),
+			Preference: uint8(tunnelPath.Preference),
+			Proto:      fib_types.FIB_API_PATH_NH_PROTO_IP4,
+			Nh: fib_types.FibPathNh{
+				ViaLabel:           mplsLabelInvalid,
+				ClassifyTableIndex: ^uint32(0),
+			},
+		}
+		if tunnelPath.NextHopAddr != "" {
+			nextHop := net.ParseIP(tunnelPath.NextHopAddr)
+			if nextHop == nil {
+				return nil, fmt.Errorf("invalid MPLS tunnel next hop address: %s", tunnelPath.NextHopAddr)
+			}
+			if nextHop.To4() == nil {
+				fibPath.Proto = fib_types.FIB_API_PATH_NH_PROTO_IP6
+				var ip6Addr [16]uint8
+				copy(ip6Addr[:], nextHop.To16())
+				fibPath.Nh.Address.SetIP6(ip6Addr)
+			} else {
+				var ip4Addr [4]uint8
+				copy(ip4Addr[:], nextHop.To4())
+				fibPath.Nh.Address.SetIP4(ip4Addr)
+			}
+		}
+		if len(tunnelPath.OutLabels) > len(fibPath.LabelStack) {
+			return nil, fmt.Errorf("too many MPLS tunnel labels (%d), at most %d are supported",
+				len(tunnelPath.OutLabels), len(fibPath.LabelStack))
+		}
+		fibPath.NLabels = uint8(len(tunnelPath.OutLabels))
+		for j, label := range tunnelPath.OutLabels {
+			fibPath.LabelStack[j] = fib_types.FibMplsLabel{Label: label}
+		}
+		paths = append(paths, fibPath)
+	}
+	return paths, nil
+}
+
+// dumpMplsTunnelDetails dumps MPLS tunnel interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpMplsTunnelDetails(ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	reqCtx := h.callsChannel.SendMultiRequest(&mpls.MplsTunnelDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		mplsDetails := &mpls.MplsTunnelDetails{}
+		stop, err := reqCtx.ReceiveReply(mplsDetails)
+		if stop {
+			break // Break from the loop.
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump MPLS tunnel interface details: %v", err)
+		}
+		tunnel := mplsDetails.MtTunnel
+		_, ifIdxExists := ifc[uint32(tunnel.MtSwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+
+		mplsLink := &ifs.MplsTunnelLink{
+			L2Only: tunnel.MtL2Only,
+		}
+		for _, fibPath := range tunnel.MtPaths {
+			tunnelPath := &ifs.MplsTunnelLink_Path{
+				Weight:     uint32(fibPath.Weight),
+				Preference: uint32(fibPath.Preference),
+			}
+			var nextHop net.IP
+			if fibPath.Proto == fib_types.FIB_API_PATH_NH_PROTO_IP6 {
+				ip6Addr := fibPath.Nh.Address.GetIP6()
+				nextHop = net.IP(ip6Addr[:]).To16()
+			} else {
+				ip4Addr := fibPath.Nh.Address.GetIP4()
+				nextHop = net.IP(ip4Addr[:]).To4()
+			}
+			if !nextHop.IsUnspecified() {
+				tunnelPath.NextHopAddr = nextHop.String()
+			}
+			if fibPath.SwIfIndex != mplsPathIfUnset {
+				if outIf, exists := ifc[fibPath.SwIfIndex]; exists {
+					tunnelPath.OutgoingInterface = outIf.Interface.Name
+				}
+			}
+			for i := 0; i < int(fibPath.NLabels) && i < len(fibPath.LabelStack); i++ {
+				tunnelPath.OutLabels = append(tunnelPath.OutLabels, fibPath.LabelStack[i].Label)
+			}
+			mplsLink.Paths = append(mplsLink.Paths, tunnelPath)
+		}
+
+		ifc[uint32(tunnel.MtSwIfIndex)].Interface.Link = &ifs.Interface_MplsTunnel{MplsTunnel: mplsLink}
+		ifc[uint32(tunnel.MtSwIfIndex)].Interface.Type = ifs.Interface_MPLS_TUNNEL
+	}
+
+	return nil
+}
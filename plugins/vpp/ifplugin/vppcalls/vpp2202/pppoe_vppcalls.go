@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ethernet_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/pppoe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func (h *InterfaceVppHandler) pppoeAddDelSession(isAdd bool, pppoeLink *interfaces.PPPoELink) (uint32, error) {
+	clientIP, err := ip_types.ParseAddress(pppoeLink.ClientIp)
+	if err != nil {
+		return 0, errors.Errorf("bad client address for PPPoE session: %v", err)
+	}
+	clientMac, err := ethernet_types.ParseMacAddress(pppoeLink.ClientMac)
+	if err != nil {
+		return 0, errors.Errorf("bad client MAC address for PPPoE session: %v", err)
+	}
+	req := &pppoe.PppoeAddDelSession{
+		IsAdd:      isAdd,
+		SessionID:  uint16(pppoeLink.SessionId),
+		ClientIP:   clientIP,
+		DecapVrfID: pppoeLink.DecapVrf,
+		ClientMac:  clientMac,
+	}
+	reply := &pppoe.PppoeAddDelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return uint32(reply.SwIfIndex), nil
+}
+
+// AddPPPoESession creates new PPPoE session interface.
+func (h *InterfaceVppHandler) AddPPPoESession(ifName string, pppoeLink *interfaces.PPPoELink) (uint32, error) {
+	if h.pppoe == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "pppoe")
+	}
+	if pppoeLink == nil {
+		return 0, errors.New("missing PPPoE session information")
+	}
+
+	swIfIndex, err := h.pppoeAddDelSession(true, pppoeLink)
+	if err != nil {
+		return 0, err
+	}
+	return swIfIndex, h.SetInterfaceTag(ifName, swIfIndex)
+}
+
+// DeletePPPoESession removes PPPoE session interface.
+func (h *InterfaceVppHandler) DeletePPPoESession(ifName string, idx uint32, pppoeLink *interfaces.PPPoELink) error {
+	if h.pppoe == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "pppoe")
+	}
+	if pppoeLink == nil {
+		return errors.New("missing PPPoE session information")
+	}
+
+	if _, err := h.pppoeAddDelSession(false, pppoeLink); err != nil {
+		return err
+	}
+	return h.RemoveInterfaceTag(ifName, idx)
+}
+
+// dumpPPPoEDetails dumps PPPoE session interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpPPPoEDetails(ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.pppoe == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	reqCtx := h.callsChannel.SendMultiRequest(&pppoe.PppoeSessionDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		pppoeDetails := &pppoe.PppoeSessionDetails{}
+		stop, err := reqCtx.ReceiveReply(pppoeDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump PPPoE session interface details: %v", err)
+		}
+		_, ifIdxExists := ifc[uint32(pppoeDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		ifc[uint32(pppoeDetails.SwIfIndex)].Interface.Link = &interfaces.Interface_Pppoe{
+			Pppoe: &interfaces.PPPoELink{
+				SessionId: uint32(pppoeDetails.SessionID),
+				ClientMac: pppoeDetails.ClientMac.String(),
+				ClientIp:  pppoeDetails.ClientIP.String(),
+				DecapVrf:  pppoeDetails.DecapVrfID,
+			},
+		}
+		ifc[uint32(pppoeDetails.SwIfIndex)].Interface.Type = interfaces.Interface_PPPOE_SESSION
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bond"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dhcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface"
@@ -30,7 +31,9 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip6_nd"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2tp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/pppoe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rdma"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/span"
@@ -57,6 +60,9 @@ var HandlerVersion = vpp.HandlerVersion{
 			tapv2.AllMessages,
 			vxlan.AllMessages,
 		)
+		if c.IsPluginLoaded(geneve.APIFile) {
+			msgs.Add(geneve.AllMessages)
+		}
 		if c.IsPluginLoaded(gtpu.APIFile) {
 			msgs.Add(gtpu.AllMessages)
 		}
@@ -72,6 +78,12 @@ var HandlerVersion = vpp.HandlerVersion{
 		if c.IsPluginLoaded(rdma.APIFile) {
 			msgs.Add(rdma.AllMessages)
 		}
+		if c.IsPluginLoaded(pppoe.APIFile) {
+			msgs.Add(pppoe.AllMessages)
+		}
+		if c.IsPluginLoaded(l2tp.APIFile) {
+			msgs.Add(l2tp.AllMessages)
+		}
 		return c.CheckCompatiblity(msgs.AllMessages()...)
 	},
 	NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
@@ -88,6 +100,7 @@ type InterfaceVppHandler struct {
 	callsChannel govppapi.Channel
 	interfaces   interfaces.RPCService
 	ipsec        ipsec.RPCService
+	geneve       geneve.RPCService
 	gtpu         gtpu.RPCService
 	memif        memif.RPCService
 	vmxnet3      vmxnet3.RPCService
@@ -95,6 +108,8 @@ type InterfaceVppHandler struct {
 	rpcRdCp      rd_cp.RPCService
 	wireguard    wireguard.RPCService
 	rdma         rdma.RPCService
+	pppoe        pppoe.RPCService
+	l2tp         l2tp.RPCService
 	log          logging.Logger
 }
 
@@ -112,6 +127,9 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 		rpcRdCp:      rd_cp.NewServiceClient(c),
 		log:          log,
 	}
+	if c.IsPluginLoaded(geneve.APIFile) {
+		h.geneve = geneve.NewServiceClient(c)
+	}
 	if c.IsPluginLoaded(gtpu.APIFile) {
 		h.gtpu = gtpu.NewServiceClient(c)
 	}
@@ -127,5 +145,11 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 	if c.IsPluginLoaded(rdma.APIFile) {
 		h.rdma = rdma.NewServiceClient(c)
 	}
+	if c.IsPluginLoaded(pppoe.APIFile) {
+		h.pppoe = pppoe.NewServiceClient(c)
+	}
+	if c.IsPluginLoaded(l2tp.APIFile) {
+		h.l2tp = l2tp.NewServiceClient(c)
+	}
 	return h
 }
@@ -17,8 +17,15 @@ package vpp2210
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/api"
+
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip6_nd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/rd_cp"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 )
 
 func (h *InterfaceVppHandler) SetIP6ndAutoconfig(ctx context.Context, ifIdx uint32, enable, installDefaultRoutes bool) error {
@@ -32,3 +39,81 @@ func (h *InterfaceVppHandler) SetIP6ndAutoconfig(ctx context.Context, ifIdx uint
 	}
 	return nil
 }
+
+func (h *InterfaceVppHandler) SetIP6ndRouterAdvertisement(ctx context.Context, ifIdx uint32, ra *ifs.Interface_IP6ND_RouterAdvertisement) error {
+	// router advertisements can be configured only with IPv6 enabled
+	req := &vpp_ip.SwInterfaceIP6EnableDisable{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+		Enable:    true,
+	}
+	reply := &vpp_ip.SwInterfaceIP6EnableDisableReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil && err != api.VALUE_EXIST {
+		return errors.Wrap(err, "failed to enable IPv6")
+	}
+
+	// flags are applied only if set, is_no=false sets them
+	_, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:       interface_types.InterfaceIndex(ifIdx),
+		Suppress:        boolToUint(ra.GetSuppress()),
+		Managed:         boolToUint(ra.GetManaged()),
+		Other:           boolToUint(ra.GetOther()),
+		LlOption:        boolToUint(ra.GetSuppressLinkLayerOption()),
+		SendUnicast:     boolToUint(ra.GetSendUnicast()),
+		Cease:           boolToUint(ra.GetCease()),
+		DefaultRouter:   boolToUint(ra.GetRouterLifetime() != 0),
+		MaxInterval:     ra.GetMaxInterval(),
+		MinInterval:     ra.GetMinInterval(),
+		Lifetime:        ra.GetRouterLifetime(),
+		InitialCount:    ra.GetInitialCount(),
+		InitialInterval: ra.GetInitialInterval(),
+	})
+	return err
+}
+
+func (h *InterfaceVppHandler) ResetIP6ndRouterAdvertisement(ctx context.Context, ifIdx uint32) error {
+	// with is_no=true every set flag and non-zero value is restored to default
+	_, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:       interface_types.InterfaceIndex(ifIdx),
+		IsNo:            true,
+		Suppress:        1,
+		Managed:         1,
+		Other:           1,
+		LlOption:        1,
+		SendUnicast:     1,
+		Cease:           1,
+		DefaultRouter:   1,
+		MaxInterval:     1,
+		MinInterval:     1,
+		Lifetime:        1,
+		InitialCount:    1,
+		InitialInterval: 1,
+	})
+	return err
+}
+
+func (h *InterfaceVppHandler) AddIP6ndRaPrefix(ctx context.Context, ifIdx uint32, prefix *ifs.Interface_IP6ND_RouterAdvertisement_Prefix) error {
+	return h.handleIP6ndRaPrefix(ctx, ifIdx, prefix, true)
+}
+
+func (h *InterfaceVppHandler) DelIP6ndRaPrefix(ctx context.Context, ifIdx uint32, prefix *ifs.Interface_IP6ND_RouterAdvertisement_Prefix) error {
+	return h.handleIP6ndRaPrefix(ctx, ifIdx, prefix, false)
+}
+
+func (h *InterfaceVppHandler) handleIP6ndRaPrefix(ctx context.Context, ifIdx uint32, prefix *ifs.Interface_IP6ND_RouterAdvertisement_Prefix, isAdd bool) error {
+	pfx, err := ip_types.ParsePrefix(prefix.GetPrefix())
+	if err != nil {
+		return err
+	}
+	_, err = h.rpcIP6nd.SwInterfaceIP6ndRaPrefix(ctx, &ip6_nd.SwInterfaceIP6ndRaPrefix{
+		SwIfIndex:    interface_types.InterfaceIndex(ifIdx),
+		Prefix:       pfx,
+		UseDefault:   prefix.GetValidLifetime() == 0 && prefix.GetPreferredLifetime() == 0,
+		NoAdvertise:  prefix.GetNoAdvertise(),
+		OffLink:      prefix.GetOffLink(),
+		NoAutoconfig: prefix.GetNoAutoconfig(),
+		IsNo:         !isAdd,
+		ValLifetime:  prefix.GetValidLifetime(),
+		PrefLifetime: prefix.GetPreferredLifetime(),
+	})
+	return err
+}
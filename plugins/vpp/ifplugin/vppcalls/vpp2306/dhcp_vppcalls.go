@@ -16,7 +16,9 @@ package vpp2306
 
 import (
 	vpp_dhcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp6_pd_client_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
 )
 
 func (h *InterfaceVppHandler) handleInterfaceDHCP(ifIdx uint32, hostName string, isAdd bool) error {
@@ -44,3 +46,54 @@ func (h *InterfaceVppHandler) SetInterfaceAsDHCPClient(ifIdx uint32, hostName st
 func (h *InterfaceVppHandler) UnsetInterfaceAsDHCPClient(ifIdx uint32, hostName string) error {
 	return h.handleInterfaceDHCP(ifIdx, hostName, false)
 }
+
+func (h *InterfaceVppHandler) handleDHCPv6PDClient(ifIdx uint32, prefixGroup string, enable bool) error {
+	req := &dhcp6_pd_client_cp.DHCP6PdClientEnableDisable{
+		SwIfIndex:   interface_types.InterfaceIndex(ifIdx),
+		PrefixGroup: prefixGroup,
+		Enable:      enable,
+	}
+	reply := &dhcp6_pd_client_cp.DHCP6PdClientEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *InterfaceVppHandler) SetDHCPv6PDClient(ifIdx uint32, prefixGroup string) error {
+	return h.handleDHCPv6PDClient(ifIdx, prefixGroup, true)
+}
+
+func (h *InterfaceVppHandler) UnsetDHCPv6PDClient(ifIdx uint32, prefixGroup string) error {
+	return h.handleDHCPv6PDClient(ifIdx, prefixGroup, false)
+}
+
+func (h *InterfaceVppHandler) handleIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string, isAdd bool) error {
+	addr, err := ip_types.ParseIP6Prefix(address)
+	if err != nil {
+		return err
+	}
+	req := &dhcp6_pd_client_cp.IP6AddDelAddressUsingPrefix{
+		SwIfIndex:         interface_types.InterfaceIndex(ifIdx),
+		PrefixGroup:       prefixGroup,
+		AddressWithPrefix: ip_types.IP6AddressWithPrefix(addr),
+		IsAdd:             isAdd,
+	}
+	reply := &dhcp6_pd_client_cp.IP6AddDelAddressUsingPrefixReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *InterfaceVppHandler) AddIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string) error {
+	return h.handleIP6AddressUsingPrefix(ifIdx, prefixGroup, address, true)
+}
+
+func (h *InterfaceVppHandler) DelIP6AddressUsingPrefix(ifIdx uint32, prefixGroup, address string) error {
+	return h.handleIP6AddressUsingPrefix(ifIdx, prefixGroup, address, false)
+}
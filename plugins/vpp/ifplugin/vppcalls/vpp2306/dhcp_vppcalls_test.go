@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	vpp_dhcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp"
+	vpp_pd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp6_pd_client_cp"
 )
 
 func TestSetInterfaceAsDHCPClient(t *testing.T) {
@@ -103,3 +104,89 @@ func TestUnsetInterfaceAsDHCPClientRetval(t *testing.T) {
 
 	Expect(err).ToNot(BeNil())
 }
+
+func TestSetDHCPv6PDClient(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pd.DHCP6PdClientEnableDisableReply{})
+
+	err := ifHandler.SetDHCPv6PDClient(1, "wan")
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_pd.DHCP6PdClientEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.PrefixGroup).To(Equal("wan"))
+	Expect(vppMsg.Enable).To(BeTrue())
+}
+
+func TestUnsetDHCPv6PDClient(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pd.DHCP6PdClientEnableDisableReply{})
+
+	err := ifHandler.UnsetDHCPv6PDClient(1, "wan")
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_pd.DHCP6PdClientEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.PrefixGroup).To(Equal("wan"))
+	Expect(vppMsg.Enable).To(BeFalse())
+}
+
+func TestSetDHCPv6PDClientRetval(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pd.DHCP6PdClientEnableDisableReply{
+		Retval: 1,
+	})
+
+	err := ifHandler.SetDHCPv6PDClient(1, "wan")
+
+	Expect(err).ToNot(BeNil())
+}
+
+func TestAddIP6AddressUsingPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pd.IP6AddDelAddressUsingPrefixReply{})
+
+	err := ifHandler.AddIP6AddressUsingPrefix(2, "wan", "::1:0:0:0:1/64")
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_pd.IP6AddDelAddressUsingPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.PrefixGroup).To(Equal("wan"))
+	Expect(vppMsg.AddressWithPrefix.Len).To(BeEquivalentTo(64))
+	Expect(vppMsg.AddressWithPrefix.Address.String()).To(Equal("::1:0:0:0:1"))
+	Expect(vppMsg.IsAdd).To(BeTrue())
+}
+
+func TestDelIP6AddressUsingPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pd.IP6AddDelAddressUsingPrefixReply{})
+
+	err := ifHandler.DelIP6AddressUsingPrefix(2, "wan", "::1/64")
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_pd.IP6AddDelAddressUsingPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+}
+
+func TestAddIP6AddressUsingPrefixInvalidAddress(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := ifHandler.AddIP6AddressUsingPrefix(2, "wan", "not-an-address")
+
+	Expect(err).ToNot(BeNil())
+}
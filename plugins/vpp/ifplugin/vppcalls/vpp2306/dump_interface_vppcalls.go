@@ -210,6 +210,16 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		return nil, err
 	}
 
+	err = h.dumpGeneveDetails(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.dumpMplsTunnelDetails(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get interface VRF for every IP family, fill DHCP if set and resolve unnumbered interface setup
 	for _, ifData := range interfaces {
 		// VRF is stored in metadata for both, IPv4 and IPv6. If the interface is an IPv6 interface (it contains at least
@@ -293,6 +303,16 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		return nil, err
 	}
 
+	err = h.dumpPPPoEDetails(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.dumpL2tpv3Details(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	err = h.dumpIpipDetails(interfaces)
 	if err != nil {
 		return nil, err
@@ -303,6 +323,11 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		return nil, err
 	}
 
+	err = h.dumpLinuxCPHostDetails(ctx, interfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	// Rx-placement dump is last since it uses interface type-specific data
 	err = h.dumpRxPlacement(interfaces)
 	if err != nil {
@@ -434,8 +459,8 @@ func toLinkSpeed(speed uint32) uint64 {
 	}
 }
 
-// Returns true if given interface contains at least one IPv6 address. For VxLAN, source and destination
-// addresses are also checked
+// Returns true if given interface contains at least one IPv6 address. For VxLAN and Geneve, source
+// and destination addresses are also checked
 func isIpv6Interface(iface *ifs.Interface) (bool, error) {
 	if iface.Type == ifs.Interface_VXLAN_TUNNEL && iface.GetVxlan() != nil {
 		if ipAddress := net.ParseIP(iface.GetVxlan().SrcAddress); ipAddress.To4() == nil {
@@ -445,6 +470,14 @@ func isIpv6Interface(iface *ifs.Interface) (bool, error) {
 			return true, nil
 		}
 	}
+	if iface.Type == ifs.Interface_GENEVE_TUNNEL && iface.GetGeneve() != nil {
+		if ipAddress := net.ParseIP(iface.GetGeneve().SrcAddress); ipAddress.To4() == nil {
+			return true, nil
+		}
+		if ipAddress := net.ParseIP(iface.GetGeneve().DstAddress); ipAddress.To4() == nil {
+			return true, nil
+		}
+	}
 	for _, ifAddress := range iface.IpAddresses {
 		if ipAddress, _, err := net.ParseCIDR(ifAddress); err != nil {
 			return false, err
@@ -957,6 +990,9 @@ func guessInterfaceType(ifDevType, ifName string) ifs.Interface_Type {
 	case strings.HasPrefix(ifName, "vxlan"):
 		return ifs.Interface_VXLAN_TUNNEL
 
+	case strings.HasPrefix(ifName, "geneve"):
+		return ifs.Interface_GENEVE_TUNNEL
+
 	case strings.HasPrefix(ifName, "ipsec"):
 		return ifs.Interface_IPSEC_TUNNEL
 
@@ -975,6 +1011,15 @@ func guessInterfaceType(ifDevType, ifName string) ifs.Interface_Type {
 	case strings.HasPrefix(ifName, "wg"):
 		return ifs.Interface_WIREGUARD_TUNNEL
 
+	case strings.HasPrefix(ifName, "mpls-tunnel"):
+		return ifs.Interface_MPLS_TUNNEL
+
+	case strings.HasPrefix(ifName, "pppoe_session"):
+		return ifs.Interface_PPPOE_SESSION
+
+	case strings.HasPrefix(ifName, "l2tpv3_tunnel"):
+		return ifs.Interface_L2TPV3_TUNNEL
+
 	default:
 		return ifs.Interface_DPDK
 	}
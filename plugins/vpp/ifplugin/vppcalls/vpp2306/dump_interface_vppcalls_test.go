@@ -28,6 +28,7 @@ import (
 	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
 	vpp_ipip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ipip"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
 	vpp_memif "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memif"
 	vpp_tapv2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/tapv2"
@@ -105,6 +106,10 @@ func TestDumpInterfacesVxLan(t *testing.T) {
 			Name: (&vpp_dhcp.DHCPClientDump{}).GetMessageName(),
 			Ping: true,
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
@@ -176,6 +181,10 @@ func TestDumpInterfacesHost(t *testing.T) {
 			Name: (&vpp_vxlangpe.VxlanGpeTunnelDump{}).GetMessageName(),
 			Ping: true,
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
@@ -247,6 +256,10 @@ func TestDumpInterfacesMemif(t *testing.T) {
 			Name: (&vpp_ipip.IpipTunnelDump{}).GetMessageName(),
 			Ping: true,
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
@@ -335,6 +348,10 @@ func TestDumpInterfacesTap2(t *testing.T) {
 			Name: (&vpp_memif.MemifSocketFilenameDump{}).GetMessageName(),
 			Ping: true,
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
@@ -358,6 +375,61 @@ func TestDumpInterfacesTap2(t *testing.T) {
 	Expect(intMeta.VrfIPv6).To(Equal(uint32(42)))
 }
 
+// Test dump of TAP interface created by the linux-cp plugin
+func TestDumpInterfacesLinuxCPHost(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockReplies([]*vppmock.HandleReplies{
+		{
+			Name: (&vpp_interfaces.SwInterfaceDump{}).GetMessageName(),
+			Ping: true,
+			Messages: []govppapi.Message{
+				&vpp_interfaces.SwInterfaceDetails{
+					SwIfIndex:     1,
+					SupSwIfIndex:  1,
+					InterfaceName: "GigabitEthernet0/8/0",
+				},
+				&vpp_interfaces.SwInterfaceDetails{
+					SwIfIndex:     2,
+					SupSwIfIndex:  2,
+					InterfaceName: "tap4096",
+				},
+			},
+		},
+		{
+			Name:    (&vpp_interfaces.SwInterfaceGetTable{}).GetMessageName(),
+			Message: &vpp_interfaces.SwInterfaceGetTableReply{},
+		},
+		{
+			Name: (&vpp_tapv2.SwInterfaceTapV2Dump{}).GetMessageName(),
+			Ping: true,
+			Message: &vpp_tapv2.SwInterfaceTapV2Details{
+				SwIfIndex:  2,
+				HostIfName: "host-ge0",
+			},
+		},
+		{
+			Name: (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Messages: []govppapi.Message{
+				&vpp_lcp.LcpItfPairDetails{
+					PhySwIfIndex:  1,
+					HostSwIfIndex: 2,
+					HostIfName:    "host-ge0",
+				},
+				&vpp_lcp.LcpItfPairGetReply{},
+			},
+		},
+	})
+
+	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
+	Expect(err).To(BeNil())
+	Expect(intfs).To(HaveLen(2))
+	Expect(intfs[1].Meta.LinuxCPHost).To(BeFalse())
+	Expect(intfs[2].Meta.LinuxCPHost).To(BeTrue())
+	Expect(intfs[2].Interface.Type).To(Equal(ifs.Interface_TAP))
+}
+
 // Test dump of memif socket details using standard reply mocking
 func TestDumpMemifSocketDetails(t *testing.T) {
 	ctx, ifHandler := ifTestSetup(t)
@@ -474,6 +546,10 @@ func TestDumpInterfacesRxPlacement(t *testing.T) {
 			Name: (&vpp_vxlan.VxlanTunnelDump{}).GetMessageName(),
 			Ping: true,
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
@@ -576,6 +652,10 @@ func TestDumpInterfacesGtpu(t *testing.T) {
 			Name: (&vpp_ipip.IpipTunnelDump{}).GetMessageName(),
 			Ping: true,
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
@@ -652,6 +732,10 @@ func TestDumpInterfacesIPIP(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    (&vpp_lcp.LcpItfPairGet{}).GetMessageName(),
+			Message: &vpp_lcp.LcpItfPairGetReply{},
+		},
 	})
 
 	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
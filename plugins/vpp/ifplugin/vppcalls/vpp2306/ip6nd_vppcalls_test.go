@@ -0,0 +1,150 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.fd.io/govpp/api"
+
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
+	vpp_ip6nd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip6_nd"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestSetIP6ndRouterAdvertisement(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.SwInterfaceIP6EnableDisableReply{})
+	ctx.MockVpp.MockReply(&vpp_ip6nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(ctx.Context, 1, &ifs.Interface_IP6ND_RouterAdvertisement{
+		Managed:        true,
+		Other:          true,
+		MaxInterval:    60,
+		MinInterval:    20,
+		RouterLifetime: 600,
+	})
+	Expect(err).To(BeNil())
+
+	var enableCheck, raCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		switch vppMsg := msg.(type) {
+		case *vpp_ip.SwInterfaceIP6EnableDisable:
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+			Expect(vppMsg.Enable).To(BeTrue())
+			enableCheck = true
+		case *vpp_ip6nd.SwInterfaceIP6ndRaConfig:
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+			Expect(vppMsg.IsNo).To(BeFalse())
+			Expect(vppMsg.Suppress).To(BeEquivalentTo(0))
+			Expect(vppMsg.Managed).To(BeEquivalentTo(1))
+			Expect(vppMsg.Other).To(BeEquivalentTo(1))
+			Expect(vppMsg.DefaultRouter).To(BeEquivalentTo(1))
+			Expect(vppMsg.MaxInterval).To(BeEquivalentTo(60))
+			Expect(vppMsg.MinInterval).To(BeEquivalentTo(20))
+			Expect(vppMsg.Lifetime).To(BeEquivalentTo(600))
+			raCheck = true
+		}
+	}
+	Expect(enableCheck).To(BeTrue())
+	Expect(raCheck).To(BeTrue())
+}
+
+func TestSetIP6ndRouterAdvertisementIP6Enabled(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.SwInterfaceIP6EnableDisableReply{
+		Retval: int32(api.VALUE_EXIST),
+	})
+	ctx.MockVpp.MockReply(&vpp_ip6nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(ctx.Context, 1, &ifs.Interface_IP6ND_RouterAdvertisement{
+		Suppress: true,
+	})
+	Expect(err).To(BeNil())
+}
+
+func TestSetIP6ndRouterAdvertisementError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.SwInterfaceIP6EnableDisableReply{})
+	ctx.MockVpp.MockReply(&vpp_ip6nd.SwInterfaceIP6ndRaConfigReply{
+		Retval: 1,
+	})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(ctx.Context, 1, &ifs.Interface_IP6ND_RouterAdvertisement{})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestResetIP6ndRouterAdvertisement(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip6nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.ResetIP6ndRouterAdvertisement(ctx.Context, 1)
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_ip6nd.SwInterfaceIP6ndRaConfig)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsNo).To(BeTrue())
+	Expect(vppMsg.Suppress).To(BeEquivalentTo(1))
+	Expect(vppMsg.DefaultRouter).To(BeEquivalentTo(1))
+	Expect(vppMsg.MaxInterval).ToNot(BeZero())
+}
+
+func TestAddIP6ndRaPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip6nd.SwInterfaceIP6ndRaPrefixReply{})
+
+	err := ifHandler.AddIP6ndRaPrefix(ctx.Context, 1, &ifs.Interface_IP6ND_RouterAdvertisement_Prefix{
+		Prefix:            "2001:db8::/64",
+		ValidLifetime:     3600,
+		PreferredLifetime: 1800,
+		NoAutoconfig:      true,
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_ip6nd.SwInterfaceIP6ndRaPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Prefix.String()).To(Equal("2001:db8::/64"))
+	Expect(vppMsg.UseDefault).To(BeFalse())
+	Expect(vppMsg.NoAutoconfig).To(BeTrue())
+	Expect(vppMsg.IsNo).To(BeFalse())
+	Expect(vppMsg.ValLifetime).To(BeEquivalentTo(3600))
+	Expect(vppMsg.PrefLifetime).To(BeEquivalentTo(1800))
+}
+
+func TestDelIP6ndRaPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip6nd.SwInterfaceIP6ndRaPrefixReply{})
+
+	err := ifHandler.DelIP6ndRaPrefix(ctx.Context, 1, &ifs.Interface_IP6ND_RouterAdvertisement_Prefix{
+		Prefix: "2001:db8::/64",
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_ip6nd.SwInterfaceIP6ndRaPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.UseDefault).To(BeTrue())
+	Expect(vppMsg.IsNo).To(BeTrue())
+}
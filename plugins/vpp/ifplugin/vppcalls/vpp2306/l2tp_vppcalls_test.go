@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	vpp_l2tp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2tp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddL2tpv3Tunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	srcAddr, _ := ip_types.ParseAddress("2001:db8::1")
+	dstAddr, _ := ip_types.ParseAddress("2001:db8::2")
+	// existing tunnel with different session IDs
+	ctx.MockVpp.MockReply(&vpp_l2tp.SwIfL2tpv3TunnelDetails{
+		SwIfIndex:       2,
+		OurAddress:      srcAddr,
+		ClientAddress:   dstAddr,
+		LocalSessionID:  10,
+		RemoteSessionID: 20,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vpp_l2tp.L2tpv3CreateTunnelReply{
+		SwIfIndex: 3,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddL2tpv3Tunnel("ifName", &ifs.L2TPv3Link{
+		SrcAddr:           "2001:db8::1",
+		DstAddr:           "2001:db8::2",
+		LocalSessionId:    1,
+		RemoteSessionId:   2,
+		LocalCookie:       100,
+		RemoteCookie:      200,
+		L2SublayerPresent: true,
+		EncapVrf:          4,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(3))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_l2tp.L2tpv3CreateTunnel)
+		if ok {
+			Expect(vppMsg.OurAddress.String()).To(Equal("2001:db8::1"))
+			Expect(vppMsg.ClientAddress.String()).To(Equal("2001:db8::2"))
+			Expect(vppMsg.LocalSessionID).To(BeEquivalentTo(1))
+			Expect(vppMsg.RemoteSessionID).To(BeEquivalentTo(2))
+			Expect(vppMsg.LocalCookie).To(BeEquivalentTo(100))
+			Expect(vppMsg.RemoteCookie).To(BeEquivalentTo(200))
+			Expect(vppMsg.L2SublayerPresent).To(BeTrue())
+			Expect(vppMsg.EncapVrfID).To(BeEquivalentTo(4))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddL2tpv3TunnelReuse(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	srcAddr, _ := ip_types.ParseAddress("2001:db8::1")
+	dstAddr, _ := ip_types.ParseAddress("2001:db8::2")
+	// tunnel left behind by the previous delete
+	ctx.MockVpp.MockReply(&vpp_l2tp.SwIfL2tpv3TunnelDetails{
+		SwIfIndex:       5,
+		OurAddress:      srcAddr,
+		ClientAddress:   dstAddr,
+		LocalSessionID:  1,
+		RemoteSessionID: 2,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vpp_l2tp.L2tpv3SetTunnelCookiesReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddL2tpv3Tunnel("ifName", &ifs.L2TPv3Link{
+		SrcAddr:         "2001:db8::1",
+		DstAddr:         "2001:db8::2",
+		LocalSessionId:  1,
+		RemoteSessionId: 2,
+		LocalCookie:     300,
+		RemoteCookie:    400,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(5))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		_, ok := msg.(*vpp_l2tp.L2tpv3CreateTunnel)
+		Expect(ok).To(BeFalse())
+		vppMsg, ok := msg.(*vpp_l2tp.L2tpv3SetTunnelCookies)
+		if ok {
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(5))
+			Expect(vppMsg.NewLocalCookie).To(BeEquivalentTo(300))
+			Expect(vppMsg.NewRemoteCookie).To(BeEquivalentTo(400))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddL2tpv3TunnelIPv4(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := ifHandler.AddL2tpv3Tunnel("ifName", &ifs.L2TPv3Link{
+		SrcAddr: "10.0.0.1",
+		DstAddr: "10.0.0.2",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteL2tpv3Tunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteL2tpv3Tunnel("ifName", 3)
+	Expect(err).To(BeNil())
+	for _, msg := range ctx.MockChannel.Msgs {
+		_, ok := msg.(*vpp_l2tp.L2tpv3CreateTunnel)
+		Expect(ok).To(BeFalse())
+	}
+}
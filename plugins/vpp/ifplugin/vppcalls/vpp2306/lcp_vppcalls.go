@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+)
+
+// dumpLinuxCPHostDetails marks interfaces created by the VPP linux_cp plugin
+// as the VPP side of the paired host interfaces.
+func (h *InterfaceVppHandler) dumpLinuxCPHostDetails(ctx context.Context, ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.lcp == nil {
+		return nil
+	}
+
+	// pairs are sent in batches, VPP asks to continue from the returned
+	// cursor by EAGAIN
+	var cursor uint32
+	for {
+		stream, err := h.lcp.LcpItfPairGet(ctx, &lcp.LcpItfPairGet{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to dump linux-cp pairs")
+		}
+		var more bool
+		for {
+			details, reply, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if errors.Is(err, api.EAGAIN) {
+				cursor, more = reply.Cursor, true
+				break
+			} else if err != nil {
+				return errors.Wrap(err, "failed to dump linux-cp pairs")
+			}
+			if iface, ok := ifc[uint32(details.HostSwIfIndex)]; ok {
+				iface.Meta.LinuxCPHost = true
+			}
+		}
+		if !more {
+			return nil
+		}
+	}
+}
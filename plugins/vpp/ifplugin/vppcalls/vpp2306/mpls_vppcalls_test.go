@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/fib_types"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mpls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+var mplsTunnelLink = &ifs.MplsTunnelLink{
+	Paths: []*ifs.MplsTunnelLink_Path{
+		{
+			NextHopAddr:       "10.0.0.2",
+			OutgoingInterface: "if1",
+			OutLabels:         []uint32{100, 200},
+		},
+		{
+			NextHopAddr: "2001:db8::2",
+			OutLabels:   []uint32{300},
+			Weight:      2,
+		},
+	},
+}
+
+func TestAddMplsTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTunnelAddDelReply{
+		SwIfIndex: 3,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddMplsTunnel("tunnel", mplsTunnelLink, []uint32{1, ^uint32(0)})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(3))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_mpls.MplsTunnelAddDel)
+		if ok {
+			Expect(vppMsg.MtIsAdd).To(BeTrue())
+			Expect(vppMsg.MtTunnel.MtSwIfIndex).To(BeEquivalentTo(^uint32(0)))
+			Expect(vppMsg.MtTunnel.MtL2Only).To(BeFalse())
+			Expect(vppMsg.MtTunnel.MtNPaths).To(BeEquivalentTo(2))
+
+			path := vppMsg.MtTunnel.MtPaths[0]
+			Expect(path.SwIfIndex).To(BeEquivalentTo(1))
+			Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP4))
+			Expect(path.Nh.Address.GetIP4()).To(BeEquivalentTo([4]uint8{10, 0, 0, 2}))
+			Expect(path.NLabels).To(BeEquivalentTo(2))
+			Expect(path.LabelStack[0].Label).To(BeEquivalentTo(100))
+			Expect(path.LabelStack[1].Label).To(BeEquivalentTo(200))
+
+			path = vppMsg.MtTunnel.MtPaths[1]
+			Expect(path.SwIfIndex).To(BeEquivalentTo(^uint32(0)))
+			Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP6))
+			Expect(path.Weight).To(BeEquivalentTo(2))
+			Expect(path.NLabels).To(BeEquivalentTo(1))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddMplsTunnelError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// path count mismatch
+	_, err := ifHandler.AddMplsTunnel("tunnel", mplsTunnelLink, []uint32{1})
+	Expect(err).ToNot(BeNil())
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTunnelAddDelReply{
+		Retval: 1,
+	})
+	_, err = ifHandler.AddMplsTunnel("tunnel", mplsTunnelLink, []uint32{1, ^uint32(0)})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteMplsTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTunnelAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteMplsTunnel("tunnel", 3, mplsTunnelLink, []uint32{1, ^uint32(0)})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_mpls.MplsTunnelAddDel)
+		if ok {
+			Expect(vppMsg.MtIsAdd).To(BeFalse())
+			Expect(vppMsg.MtTunnel.MtSwIfIndex).To(BeEquivalentTo(3))
+			Expect(vppMsg.MtTunnel.MtNPaths).To(BeEquivalentTo(2))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ethernet_types"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	vpp_pppoe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/pppoe"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddPPPoESession(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pppoe.PppoeAddDelSessionReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddPPPoESession("ifName", &ifs.PPPoELink{
+		SessionId: 10,
+		ClientMac: "aa:bb:cc:dd:ee:ff",
+		ClientIp:  "10.0.0.1",
+		DecapVrf:  5,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(2))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_pppoe.PppoeAddDelSession)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeTrue())
+			Expect(vppMsg.SessionID).To(BeEquivalentTo(10))
+			Expect(vppMsg.ClientMac).To(Equal(ethernet_types.MacAddress{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}))
+			Expect(vppMsg.ClientIP).To(Equal(ip_types.Address{
+				Af: ip_types.ADDRESS_IP4,
+				Un: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1}),
+			}))
+			Expect(vppMsg.DecapVrfID).To(BeEquivalentTo(5))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddPPPoESessionError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pppoe.PppoeAddDelSessionReply{
+		Retval: -1,
+	})
+
+	_, err := ifHandler.AddPPPoESession("ifName", &ifs.PPPoELink{
+		SessionId: 10,
+		ClientMac: "aa:bb:cc:dd:ee:ff",
+		ClientIp:  "10.0.0.1",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestAddPPPoESessionBadMac(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := ifHandler.AddPPPoESession("ifName", &ifs.PPPoELink{
+		SessionId: 10,
+		ClientMac: "aa:bb:cc",
+		ClientIp:  "10.0.0.1",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeletePPPoESession(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_pppoe.PppoeAddDelSessionReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeletePPPoESession("ifName", 2, &ifs.PPPoELink{
+		SessionId: 10,
+		ClientMac: "aa:bb:cc:dd:ee:ff",
+		ClientIp:  "2001:db8::1",
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_pppoe.PppoeAddDelSession)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeFalse())
+			Expect(vppMsg.ClientIP.Af).To(Equal(ip_types.ADDRESS_IP6))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
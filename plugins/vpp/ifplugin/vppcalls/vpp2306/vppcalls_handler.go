@@ -23,6 +23,8 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/bond"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/dhcp6_pd_client_cp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface"
@@ -30,7 +32,10 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip6_nd"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2tp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/pppoe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/rdma"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/span"
@@ -41,6 +46,9 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 )
 
+// name of the VPP plugin implementing the lcp API
+const linuxCPPlugin = "linux_cp"
+
 var HandlerVersion = vpp.HandlerVersion{
 	Version: vpp2306.Version,
 	Check: func(c vpp.Client) error {
@@ -48,6 +56,7 @@ var HandlerVersion = vpp.HandlerVersion{
 			af_packet.AllMessages,
 			bond.AllMessages,
 			dhcp.AllMessages,
+			dhcp6_pd_client_cp.AllMessages,
 			interfaces.AllMessages,
 			ip.AllMessages,
 			ipsec.AllMessages,
@@ -57,6 +66,9 @@ var HandlerVersion = vpp.HandlerVersion{
 			tapv2.AllMessages,
 			vxlan.AllMessages,
 		)
+		if c.IsPluginLoaded(geneve.APIFile) {
+			msgs.Add(geneve.AllMessages)
+		}
 		if c.IsPluginLoaded(gtpu.APIFile) {
 			msgs.Add(gtpu.AllMessages)
 		}
@@ -72,6 +84,12 @@ var HandlerVersion = vpp.HandlerVersion{
 		if c.IsPluginLoaded(rdma.APIFile) {
 			msgs.Add(rdma.AllMessages)
 		}
+		if c.IsPluginLoaded(pppoe.APIFile) {
+			msgs.Add(pppoe.AllMessages)
+		}
+		if c.IsPluginLoaded(l2tp.APIFile) {
+			msgs.Add(l2tp.AllMessages)
+		}
 		return c.CheckCompatiblity(msgs.AllMessages()...)
 	},
 	NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
@@ -88,6 +106,7 @@ type InterfaceVppHandler struct {
 	callsChannel govppapi.Channel
 	interfaces   interfaces.RPCService
 	ipsec        ipsec.RPCService
+	geneve       geneve.RPCService
 	gtpu         gtpu.RPCService
 	memif        memif.RPCService
 	vmxnet3      vmxnet3.RPCService
@@ -95,6 +114,9 @@ type InterfaceVppHandler struct {
 	rpcRdCp      rd_cp.RPCService
 	wireguard    wireguard.RPCService
 	rdma         rdma.RPCService
+	pppoe        pppoe.RPCService
+	l2tp         l2tp.RPCService
+	lcp          lcp.RPCService
 	log          logging.Logger
 }
 
@@ -112,6 +134,9 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 		rpcRdCp:      rd_cp.NewServiceClient(c),
 		log:          log,
 	}
+	if c.IsPluginLoaded(geneve.APIFile) {
+		h.geneve = geneve.NewServiceClient(c)
+	}
 	if c.IsPluginLoaded(gtpu.APIFile) {
 		h.gtpu = gtpu.NewServiceClient(c)
 	}
@@ -127,5 +152,14 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 	if c.IsPluginLoaded(rdma.APIFile) {
 		h.rdma = rdma.NewServiceClient(c)
 	}
+	if c.IsPluginLoaded(pppoe.APIFile) {
+		h.pppoe = pppoe.NewServiceClient(c)
+	}
+	if c.IsPluginLoaded(l2tp.APIFile) {
+		h.l2tp = l2tp.NewServiceClient(c)
+	}
+	if c.IsPluginLoaded(linuxCPPlugin) {
+		h.lcp = lcp.NewServiceClient(c)
+	}
 	return h
 }
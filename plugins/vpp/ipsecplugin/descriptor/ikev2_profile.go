@@ -0,0 +1,224 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+const (
+	// IKEv2ProfileDescriptorName is the name of the descriptor for VPP IKEv2 profiles.
+	IKEv2ProfileDescriptorName = "vpp-ipsec-ikev2-profile"
+
+	// dependency labels
+	tunnelInterfaceDep    = "tunnel-interface-exists"
+	responderInterfaceDep = "responder-interface-exists"
+
+	// maximum length of the IKEv2 profile name supported by VPP
+	ikev2ProfileNameMaxLen = 63
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrIKEv2ProfileWithoutName is returned when IKEv2 profile was defined without name.
+	ErrIKEv2ProfileWithoutName = errors.New("IKEv2 profile defined without name")
+	// ErrIKEv2ProfileNameTooLong is returned when IKEv2 profile name exceeds the VPP limit.
+	ErrIKEv2ProfileNameTooLong = errors.New("IKEv2 profile name is too long")
+	// ErrIKEv2ProfileNoSharedKey is returned when shared key authentication is used without the key.
+	ErrIKEv2ProfileNoSharedKey = errors.New("IKEv2 profile with shared key authentication defined without key")
+	// ErrIKEv2ProfileNoPeerCert is returned when RSA signature authentication is used without the peer certificate.
+	ErrIKEv2ProfileNoPeerCert = errors.New("IKEv2 profile with RSA signature authentication defined without peer certificate")
+	// ErrIKEv2ProfileInvalidTs is returned when IKEv2 traffic selector has invalid address range.
+	ErrIKEv2ProfileInvalidTs = errors.New("IKEv2 traffic selector requires start and end address of the same IP version")
+	// ErrIKEv2ProfileInvalidPorts is returned when IKEv2 traffic selector has invalid port range.
+	ErrIKEv2ProfileInvalidPorts = errors.New("IKEv2 traffic selector start port is greater than end port")
+	// ErrIKEv2ProfileInvalidResponder is returned when IKEv2 responder is defined without interface or address.
+	ErrIKEv2ProfileInvalidResponder = errors.New("IKEv2 responder requires interface and IP address")
+	// ErrIKEv2ProfileInitiateWithoutResponder is returned when IKEv2 negotiation should be initiated
+	// without responder.
+	ErrIKEv2ProfileInitiateWithoutResponder = errors.New("IKEv2 negotiation cannot be initiated without responder")
+)
+
+// IKEv2ProfileDescriptor teaches KVScheduler how to configure VPP IKEv2 profiles.
+type IKEv2ProfileDescriptor struct {
+	// dependencies
+	log          logging.Logger
+	ipSecHandler vppcalls.IPSecVppAPI
+}
+
+// NewIKEv2ProfileDescriptor creates a new instance of the IKEv2 profile descriptor.
+func NewIKEv2ProfileDescriptor(ipSecHandler vppcalls.IPSecVppAPI, log logging.PluginLogger) *IKEv2ProfileDescriptor {
+	return &IKEv2ProfileDescriptor{
+		ipSecHandler: ipSecHandler,
+		log:          log.NewLogger("ikev2-profile-descriptor"),
+	}
+}
+
+// GetDescriptor returns descriptor suitable for registration (via adapter) with
+// the KVScheduler.
+func (d *IKEv2ProfileDescriptor) GetDescriptor() *adapter.IKEv2ProfileDescriptor {
+	return &adapter.IKEv2ProfileDescriptor{
+		Name:          IKEv2ProfileDescriptorName,
+		NBKeyPrefix:   ipsec.ModelIKEv2Profile.KeyPrefix(),
+		ValueTypeName: ipsec.ModelIKEv2Profile.ProtoName(),
+		KeySelector:   ipsec.ModelIKEv2Profile.IsKeyValid,
+		KeyLabel:      ipsec.ModelIKEv2Profile.StripKeyPrefix,
+		Validate:      d.Validate,
+		Create:        d.Create,
+		Delete:        d.Delete,
+		Retrieve:      d.Retrieve,
+		Dependencies:  d.Dependencies,
+	}
+}
+
+// Validate validates VPP IKEv2 profile configuration.
+func (d *IKEv2ProfileDescriptor) Validate(key string, profile *ipsec.IKEv2Profile) error {
+	if profile.Name == "" {
+		return kvs.NewInvalidValueError(ErrIKEv2ProfileWithoutName, "name")
+	}
+	if len(profile.Name) > ikev2ProfileNameMaxLen {
+		return kvs.NewInvalidValueError(ErrIKEv2ProfileNameTooLong, "name")
+	}
+	if auth := profile.GetAuth(); auth != nil {
+		switch auth.Method {
+		case ipsec.IKEv2Profile_Authentication_SHARED_KEY:
+			if auth.SharedKey == "" {
+				return kvs.NewInvalidValueError(ErrIKEv2ProfileNoSharedKey, "auth.shared_key")
+			}
+		case ipsec.IKEv2Profile_Authentication_RSA_SIGNATURE:
+			if auth.PeerCertFile == "" {
+				return kvs.NewInvalidValueError(ErrIKEv2ProfileNoPeerCert, "auth.peer_cert_file")
+			}
+		}
+	}
+	if err := validateIKEv2Ts(profile.LocalTs, "local_ts"); err != nil {
+		return err
+	}
+	if err := validateIKEv2Ts(profile.RemoteTs, "remote_ts"); err != nil {
+		return err
+	}
+	if responder := profile.GetResponder(); responder != nil {
+		if responder.Interface == "" || net.ParseIP(responder.Address) == nil {
+			return kvs.NewInvalidValueError(ErrIKEv2ProfileInvalidResponder,
+				"responder.interface", "responder.address")
+		}
+	} else if profile.Initiate {
+		return kvs.NewInvalidValueError(ErrIKEv2ProfileInitiateWithoutResponder, "initiate", "responder")
+	}
+	return nil
+}
+
+func validateIKEv2Ts(ts *ipsec.IKEv2Profile_TrafficSelector, field string) error {
+	if ts == nil {
+		return nil
+	}
+	startAddr, endAddr := net.ParseIP(ts.StartAddr), net.ParseIP(ts.EndAddr)
+	if startAddr == nil || endAddr == nil || (startAddr.To4() == nil) != (endAddr.To4() == nil) {
+		return kvs.NewInvalidValueError(ErrIKEv2ProfileInvalidTs, field+".start_addr", field+".end_addr")
+	}
+	if ts.EndPort != 0 && ts.StartPort > ts.EndPort {
+		return kvs.NewInvalidValueError(ErrIKEv2ProfileInvalidPorts, field+".start_port", field+".end_port")
+	}
+	return nil
+}
+
+// Create adds a new IKEv2 profile.
+func (d *IKEv2ProfileDescriptor) Create(key string, profile *ipsec.IKEv2Profile) (metadata interface{}, err error) {
+	err = d.ipSecHandler.AddIKEv2Profile(profile)
+	if err != nil {
+		d.log.Error(err)
+	}
+	return nil, err
+}
+
+// Delete removes VPP IKEv2 profile.
+func (d *IKEv2ProfileDescriptor) Delete(key string, profile *ipsec.IKEv2Profile, metadata interface{}) error {
+	err := d.ipSecHandler.DeleteIKEv2Profile(profile)
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Retrieve returns all configured VPP IKEv2 profiles.
+func (d *IKEv2ProfileDescriptor) Retrieve(correlate []adapter.IKEv2ProfileKVWithMetadata) (dump []adapter.IKEv2ProfileKVWithMetadata, err error) {
+	profiles, err := d.ipSecHandler.DumpIKEv2Profiles()
+	if err != nil {
+		d.log.Error(err)
+		return dump, err
+	}
+
+	nbProfiles := make(map[string]*ipsec.IKEv2Profile)
+	for _, kv := range correlate {
+		nbProfiles[kv.Value.Name] = kv.Value
+	}
+
+	for _, profile := range profiles {
+		if nbProfile, ok := nbProfiles[profile.Name]; ok {
+			correlateIKEv2Profile(profile, nbProfile)
+		}
+		dump = append(dump, adapter.IKEv2ProfileKVWithMetadata{
+			Key:    ipsec.IKEv2ProfileKey(profile.Name),
+			Value:  profile,
+			Origin: kvs.FromNB,
+		})
+	}
+
+	return dump, nil
+}
+
+// correlateIKEv2Profile fills attributes of the retrieved profile which cannot
+// be read back from VPP with values from the NB configuration.
+func correlateIKEv2Profile(profile, nbProfile *ipsec.IKEv2Profile) {
+	// local key is global in VPP and negotiation is a one-time action
+	if profile.GetAuth() != nil && nbProfile.GetAuth() != nil {
+		profile.Auth.LocalKeyFile = nbProfile.Auth.LocalKeyFile
+	}
+	profile.Initiate = nbProfile.Initiate
+	// IP address identity may be truncated by the dump
+	if profile.GetLocalId().GetData() == "" && nbProfile.GetLocalId() != nil {
+		profile.LocalId = proto.Clone(nbProfile.LocalId).(*ipsec.IKEv2Profile_Identity)
+	}
+	if profile.GetRemoteId().GetData() == "" && nbProfile.GetRemoteId() != nil {
+		profile.RemoteId = proto.Clone(nbProfile.RemoteId).(*ipsec.IKEv2Profile_Identity)
+	}
+}
+
+// Dependencies lists the tunnel and responder interfaces as dependencies of the profile.
+func (d *IKEv2ProfileDescriptor) Dependencies(key string, profile *ipsec.IKEv2Profile) (deps []kvs.Dependency) {
+	if profile.TunnelInterface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: tunnelInterfaceDep,
+			Key:   interfaces.InterfaceKey(profile.TunnelInterface),
+		})
+	}
+	if profile.GetResponder().GetInterface() != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: responderInterfaceDep,
+			Key:   interfaces.InterfaceKey(profile.Responder.Interface),
+		})
+	}
+	return deps
+}
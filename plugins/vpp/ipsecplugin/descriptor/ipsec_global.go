@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+const (
+	// IPSecGlobalDescriptorName is the name of the descriptor for the global
+	// IPSec settings.
+	IPSecGlobalDescriptorName = "vpp-ipsec-global"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrCryptoHandlerWithoutAlg is returned when crypto handler is defined
+	// without algorithm name.
+	ErrCryptoHandlerWithoutAlg = errors.New("crypto handler defined without algorithm")
+
+	// ErrCryptoHandlerWithoutEngine is returned when crypto handler is defined
+	// without engine name.
+	ErrCryptoHandlerWithoutEngine = errors.New("crypto handler defined without engine")
+
+	// ErrCryptoHandlerDuplicate is returned when crypto handler is defined more than once
+	// for the same algorithm, operation class and mode.
+	ErrCryptoHandlerDuplicate = errors.New("duplicate crypto handler")
+)
+
+// IPSecGlobalDescriptor teaches KVScheduler how to configure crypto engine
+// selection and asynchronous crypto for IPSec.
+type IPSecGlobalDescriptor struct {
+	// dependencies
+	log          logging.Logger
+	ipSecHandler vppcalls.IPSecVppAPI
+}
+
+// NewIPSecGlobalDescriptor creates a new instance of the IPSecGlobal descriptor.
+func NewIPSecGlobalDescriptor(ipSecHandler vppcalls.IPSecVppAPI, log logging.PluginLogger) *IPSecGlobalDescriptor {
+	return &IPSecGlobalDescriptor{
+		ipSecHandler: ipSecHandler,
+		log:          log.NewLogger("ipsec-global-descriptor"),
+	}
+}
+
+// GetDescriptor returns descriptor suitable for registration (via adapter) with
+// the KVScheduler.
+func (d *IPSecGlobalDescriptor) GetDescriptor() *adapter.IPSecGlobalDescriptor {
+	return &adapter.IPSecGlobalDescriptor{
+		Name:          IPSecGlobalDescriptorName,
+		NBKeyPrefix:   ipsec.ModelIPSecGlobal.KeyPrefix(),
+		ValueTypeName: ipsec.ModelIPSecGlobal.ProtoName(),
+		KeySelector:   ipsec.ModelIPSecGlobal.IsKeyValid,
+		Validate:      d.Validate,
+		Create:        d.Create,
+		Update:        d.Update,
+		Delete:        d.Delete,
+	}
+}
+
+// Validate validates the global IPSec settings.
+func (d *IPSecGlobalDescriptor) Validate(key string, value *ipsec.IPSecGlobal) error {
+	handlers := make(map[string]struct{})
+	for _, handler := range value.GetCryptoHandlers() {
+		if handler.GetAlgorithm() == "" {
+			return kvs.NewInvalidValueError(ErrCryptoHandlerWithoutAlg, "crypto_handlers.algorithm")
+		}
+		if handler.GetEngine() == "" {
+			return kvs.NewInvalidValueError(ErrCryptoHandlerWithoutEngine, "crypto_handlers.engine")
+		}
+		id := cryptoHandlerID(handler)
+		if _, duplicate := handlers[id]; duplicate {
+			return kvs.NewInvalidValueError(ErrCryptoHandlerDuplicate, "crypto_handlers")
+		}
+		handlers[id] = struct{}{}
+	}
+	return nil
+}
+
+// Create applies the global IPSec settings.
+func (d *IPSecGlobalDescriptor) Create(key string, value *ipsec.IPSecGlobal) (metadata interface{}, err error) {
+	return d.Update(key, &ipsec.IPSecGlobal{}, value, nil)
+}
+
+// Update changes the global IPSec settings. Crypto handlers are (re)applied only
+// if they are new or changed.
+func (d *IPSecGlobalDescriptor) Update(key string, oldValue, newValue *ipsec.IPSecGlobal, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	oldHandlers := make(map[string]*ipsec.IPSecGlobal_CryptoHandler)
+	for _, handler := range oldValue.GetCryptoHandlers() {
+		oldHandlers[cryptoHandlerID(handler)] = handler
+	}
+	for _, handler := range newValue.GetCryptoHandlers() {
+		if proto.Equal(oldHandlers[cryptoHandlerID(handler)], handler) {
+			continue
+		}
+		if err = d.ipSecHandler.SetCryptoHandler(handler); err != nil {
+			err = errors.Errorf("failed to set crypto engine %s for %s: %v",
+				handler.GetEngine(), handler.GetAlgorithm(), err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	if newValue.GetAsyncDispatchMode() != oldValue.GetAsyncDispatchMode() {
+		if err = d.ipSecHandler.SetCryptoAsyncDispatchMode(newValue.GetAsyncDispatchMode()); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	if newValue.GetAsyncMode() != oldValue.GetAsyncMode() {
+		if err = d.ipSecHandler.SetAsyncMode(newValue.GetAsyncMode()); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Delete disables asynchronous crypto and sets the default dispatch mode.
+// VPP cannot revert the crypto engine selection, the crypto handlers are left as they are.
+func (d *IPSecGlobalDescriptor) Delete(key string, value *ipsec.IPSecGlobal, metadata interface{}) error {
+	_, err := d.Update(key, value, &ipsec.IPSecGlobal{CryptoHandlers: value.GetCryptoHandlers()}, metadata)
+	return err
+}
+
+// cryptoHandlerID identifies crypto handler by algorithm, operation class and mode.
+func cryptoHandlerID(handler *ipsec.IPSecGlobal_CryptoHandler) string {
+	id := handler.GetAlgorithm() + "/" + handler.GetOpClass().String()
+	if handler.GetAsync() {
+		id += "/async"
+	}
+	return id
+}
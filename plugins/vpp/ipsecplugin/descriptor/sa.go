@@ -17,6 +17,7 @@ package descriptor
 import (
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
 
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/descriptor/adapter"
@@ -38,19 +39,41 @@ var (
 	// ErrSAInvalidIndex is returned when VPP security association was defined
 	// with non-numerical index.
 	ErrSAInvalidIndex = errors.New("VPP security association defined with invalid index")
+
+	// ErrSAReplayWindowWithoutAntiReplay is returned when anti-replay window size
+	// is set while anti-replay is not enabled.
+	ErrSAReplayWindowWithoutAntiReplay = errors.New("anti-replay window size set without anti-replay enabled")
+
+	// ErrSASoftLifetimeExceedsHard is returned when soft lifetime limit is greater
+	// than the corresponding hard lifetime limit.
+	ErrSASoftLifetimeExceedsHard = errors.New("soft lifetime limit exceeds hard lifetime limit")
 )
 
+// SALifetimeTracker is notified about security associations with lifetime limits,
+// which are enforced by the agent rather than by VPP.
+type SALifetimeTracker interface {
+	// TrackSA starts (or updates) tracking of the SA lifetime. StatIndex
+	// is the index of the SA in the stats segment counters.
+	TrackSA(sa *ipsec.SecurityAssociation, statIndex uint32)
+	// UntrackSA stops tracking of the SA lifetime.
+	UntrackSA(saIndex uint32)
+}
+
 // IPSecSADescriptor teaches KVScheduler how to configure VPP IPSec security associations.
 type IPSecSADescriptor struct {
 	// dependencies
 	log          logging.Logger
 	ipSecHandler vppcalls.IPSecVppAPI
+	tracker      SALifetimeTracker // optional
 }
 
 // NewIPSecSADescriptor creates a new instance of the IPSec SA descriptor.
-func NewIPSecSADescriptor(ipSecHandler vppcalls.IPSecVppAPI, log logging.PluginLogger) *IPSecSADescriptor {
+// Lifetime tracker is optional.
+func NewIPSecSADescriptor(ipSecHandler vppcalls.IPSecVppAPI, tracker SALifetimeTracker,
+	log logging.PluginLogger) *IPSecSADescriptor {
 	return &IPSecSADescriptor{
 		ipSecHandler: ipSecHandler,
+		tracker:      tracker,
 		log:          log.NewLogger("ipsec-sa-descriptor"),
 	}
 }
@@ -59,15 +82,18 @@ func NewIPSecSADescriptor(ipSecHandler vppcalls.IPSecVppAPI, log logging.PluginL
 // the KVScheduler.
 func (d *IPSecSADescriptor) GetDescriptor() *adapter.SADescriptor {
 	return &adapter.SADescriptor{
-		Name:            SADescriptorName,
-		NBKeyPrefix:     ipsec.ModelSecurityAssociation.KeyPrefix(),
-		ValueTypeName:   ipsec.ModelSecurityAssociation.ProtoName(),
-		KeySelector:     ipsec.ModelSecurityAssociation.IsKeyValid,
-		KeyLabel:        ipsec.ModelSecurityAssociation.StripKeyPrefix,
-		ValueComparator: d.EquivalentIPSecSAs,
-		Create:          d.Create,
-		Delete:          d.Delete,
-		Retrieve:        d.Retrieve,
+		Name:               SADescriptorName,
+		NBKeyPrefix:        ipsec.ModelSecurityAssociation.KeyPrefix(),
+		ValueTypeName:      ipsec.ModelSecurityAssociation.ProtoName(),
+		KeySelector:        ipsec.ModelSecurityAssociation.IsKeyValid,
+		KeyLabel:           ipsec.ModelSecurityAssociation.StripKeyPrefix,
+		ValueComparator:    d.EquivalentIPSecSAs,
+		Validate:           d.Validate,
+		Create:             d.Create,
+		Delete:             d.Delete,
+		Update:             d.Update,
+		UpdateWithRecreate: d.UpdateWithRecreate,
+		Retrieve:           d.Retrieve,
 	}
 }
 
@@ -85,7 +111,27 @@ func (d *IPSecSADescriptor) EquivalentIPSecSAs(key string, oldSA, newSA *ipsec.S
 		oldSA.UseAntiReplay == newSA.UseAntiReplay &&
 		oldSA.TunnelSrcAddr == newSA.TunnelSrcAddr &&
 		oldSA.TunnelDstAddr == newSA.TunnelDstAddr &&
-		oldSA.EnableUdpEncap == newSA.EnableUdpEncap
+		oldSA.EnableUdpEncap == newSA.EnableUdpEncap &&
+		antiReplayWindowSize(oldSA) == antiReplayWindowSize(newSA) &&
+		equivalentLifetimes(oldSA, newSA)
+}
+
+// Validate validates VPP security association configuration.
+func (d *IPSecSADescriptor) Validate(key string, sa *ipsec.SecurityAssociation) error {
+	if sa.GetAntiReplayWindowSize() != 0 && !sa.GetUseAntiReplay() {
+		return kvs.NewInvalidValueError(ErrSAReplayWindowWithoutAntiReplay, "anti_replay_window_size")
+	}
+	soft, hard := sa.GetSoftLifetime(), sa.GetHardLifetime()
+	if exceedsLimit(soft.GetBytes(), hard.GetBytes()) {
+		return kvs.NewInvalidValueError(ErrSASoftLifetimeExceedsHard, "soft_lifetime.bytes")
+	}
+	if exceedsLimit(soft.GetPackets(), hard.GetPackets()) {
+		return kvs.NewInvalidValueError(ErrSASoftLifetimeExceedsHard, "soft_lifetime.packets")
+	}
+	if exceedsLimit(soft.GetSeconds(), hard.GetSeconds()) {
+		return kvs.NewInvalidValueError(ErrSASoftLifetimeExceedsHard, "soft_lifetime.seconds")
+	}
+	return nil
 }
 
 // Create adds a new security association pair.
@@ -94,9 +140,46 @@ func (d *IPSecSADescriptor) Create(key string, sa *ipsec.SecurityAssociation) (m
 	err = d.ipSecHandler.AddSA(sa)
 	if err != nil {
 		d.log.Error(err)
+		return nil, err
+	}
+
+	if d.tracker != nil && hasLifetime(sa) {
+		// stats index is needed to read the SA counters
+		sas, err := d.ipSecHandler.DumpIPSecSAWithIndex(sa.Index)
+		if err != nil || len(sas) == 0 {
+			d.log.Warnf("failed to get stats index of SA %d, lifetime will not be tracked: %v", sa.Index, err)
+			return nil, nil
+		}
+		d.tracker.TrackSA(sa, sas[0].Meta.StatIndex)
+	}
+	return nil, nil
+}
+
+// Update updates lifetime limits of the security association, other changes
+// require re-creation.
+func (d *IPSecSADescriptor) Update(key string, oldSA, newSA *ipsec.SecurityAssociation, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if d.tracker == nil {
+		return oldMetadata, nil
+	}
+	if !hasLifetime(newSA) {
+		d.tracker.UntrackSA(newSA.Index)
+		return oldMetadata, nil
+	}
+	sas, err := d.ipSecHandler.DumpIPSecSAWithIndex(newSA.Index)
+	if err != nil || len(sas) == 0 {
+		d.log.Warnf("failed to get stats index of SA %d, lifetime will not be tracked: %v", newSA.Index, err)
+		return oldMetadata, nil
 	}
+	d.tracker.TrackSA(newSA, sas[0].Meta.StatIndex)
+	return oldMetadata, nil
+}
 
-	return nil, err
+// UpdateWithRecreate returns true if anything else than lifetime limits has changed.
+func (d *IPSecSADescriptor) UpdateWithRecreate(key string, oldSA, newSA *ipsec.SecurityAssociation, metadata interface{}) bool {
+	oldCopy := proto.Clone(oldSA).(*ipsec.SecurityAssociation)
+	oldCopy.SoftLifetime = newSA.SoftLifetime
+	oldCopy.HardLifetime = newSA.HardLifetime
+	return !d.EquivalentIPSecSAs(key, oldCopy, newSA)
 }
 
 // Delete removes VPP security association.
@@ -104,12 +187,22 @@ func (d *IPSecSADescriptor) Delete(key string, sa *ipsec.SecurityAssociation, me
 	err := d.ipSecHandler.DeleteSA(sa)
 	if err != nil {
 		d.log.Error(err)
+		return err
+	}
+	if d.tracker != nil {
+		d.tracker.UntrackSA(sa.Index)
 	}
-	return err
+	return nil
 }
 
 // Retrieve returns all configured VPP security associations.
 func (d *IPSecSADescriptor) Retrieve(correlate []adapter.SAKVWithMetadata) (dump []adapter.SAKVWithMetadata, err error) {
+	// SA index -> expected configuration
+	expCfg := make(map[uint32]*ipsec.SecurityAssociation)
+	for _, kv := range correlate {
+		expCfg[kv.Value.Index] = kv.Value
+	}
+
 	// dump security associations
 	sas, err := d.ipSecHandler.DumpIPSecSA()
 	if err != nil {
@@ -117,13 +210,65 @@ func (d *IPSecSADescriptor) Retrieve(correlate []adapter.SAKVWithMetadata) (dump
 		return dump, err
 	}
 	for _, sa := range sas {
+		origin := kvs.FromNB
+		if sa.Meta.IKEv2 {
+			// SAs negotiated by IKEv2 are not managed by the agent
+			origin = kvs.FromSB
+		}
+		if exp, ok := expCfg[sa.Sa.Index]; ok {
+			// lifetime limits are handled by the agent and the window size is not dumped
+			sa.Sa.SoftLifetime = exp.GetSoftLifetime()
+			sa.Sa.HardLifetime = exp.GetHardLifetime()
+			if sa.Sa.UseAntiReplay {
+				sa.Sa.AntiReplayWindowSize = exp.GetAntiReplayWindowSize()
+			}
+			if d.tracker != nil && hasLifetime(exp) {
+				d.tracker.TrackSA(sa.Sa, sa.Meta.StatIndex)
+			}
+		}
 		dump = append(dump, adapter.SAKVWithMetadata{
 			Key:      ipsec.SAKey(sa.Sa.Index),
 			Value:    sa.Sa,
 			Metadata: sa.Meta,
-			Origin:   kvs.FromNB,
+			Origin:   origin,
 		})
 	}
 
 	return dump, nil
 }
+
+// antiReplayWindowSize returns the anti-replay window size with the default applied.
+func antiReplayWindowSize(sa *ipsec.SecurityAssociation) uint32 {
+	if !sa.GetUseAntiReplay() {
+		return 0
+	}
+	if sa.GetAntiReplayWindowSize() == 0 {
+		return vppcalls.DefaultAntiReplayWindowSize
+	}
+	return sa.GetAntiReplayWindowSize()
+}
+
+// equivalentLifetimes compares soft and hard lifetime limits of two SAs.
+func equivalentLifetimes(oldSA, newSA *ipsec.SecurityAssociation) bool {
+	return proto.Equal(normalizeLifetime(oldSA.GetSoftLifetime()), normalizeLifetime(newSA.GetSoftLifetime())) &&
+		proto.Equal(normalizeLifetime(oldSA.GetHardLifetime()), normalizeLifetime(newSA.GetHardLifetime()))
+}
+
+// normalizeLifetime returns nil for a lifetime without any limit set.
+func normalizeLifetime(lifetime *ipsec.SecurityAssociation_Lifetime) *ipsec.SecurityAssociation_Lifetime {
+	if lifetime.GetBytes() == 0 && lifetime.GetPackets() == 0 && lifetime.GetSeconds() == 0 {
+		return nil
+	}
+	return lifetime
+}
+
+// hasLifetime returns true if the SA has some lifetime limit set.
+func hasLifetime(sa *ipsec.SecurityAssociation) bool {
+	return normalizeLifetime(sa.GetSoftLifetime()) != nil || normalizeLifetime(sa.GetHardLifetime()) != nil
+}
+
+// exceedsLimit returns true if the soft limit is greater than the hard limit
+// (zero means unlimited).
+func exceedsLimit(soft, hard uint64) bool {
+	return soft != 0 && hard != 0 && soft > hard
+}
@@ -117,10 +117,15 @@ func (d *TunnelProtectDescriptor) Delete(key string, tp *ipsec.TunnelProtection,
 func (d *TunnelProtectDescriptor) Retrieve(correlate []adapter.TunProtectKVWithMetadata) (dump []adapter.TunProtectKVWithMetadata, err error) {
 	tps, err := d.ipSecHandler.DumpTunnelProtections()
 	for _, tp := range tps {
+		origin := kvs.FromNB
+		if isIKEv2TunnelProtection(tp) {
+			// tunnel protection installed by IKEv2 is not managed by the agent
+			origin = kvs.FromSB
+		}
 		dump = append(dump, adapter.TunProtectKVWithMetadata{
 			Key:    models.Key(tp),
 			Value:  tp,
-			Origin: kvs.FromNB,
+			Origin: origin,
 		})
 	}
 	return
@@ -148,3 +153,19 @@ func (d *TunnelProtectDescriptor) Dependencies(key string, value *ipsec.TunnelPr
 	}
 	return deps
 }
+
+// isIKEv2TunnelProtection returns true if the tunnel protection refers
+// to SAs negotiated by IKEv2.
+func isIKEv2TunnelProtection(tp *ipsec.TunnelProtection) bool {
+	for _, sa := range tp.SaOut {
+		if vppcalls.IsIKEv2SaID(sa) {
+			return true
+		}
+	}
+	for _, sa := range tp.SaIn {
+		if vppcalls.IsIKEv2SaID(sa) {
+			return true
+		}
+	}
+	return false
+}
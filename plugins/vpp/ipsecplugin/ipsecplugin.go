@@ -17,10 +17,15 @@
 //go:generate descriptor-adapter --descriptor-name SP --value-type *vpp_ipsec.SecurityPolicy --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name SA  --value-type *vpp_ipsec.SecurityAssociation --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name TunProtect --value-type *vpp_ipsec.TunnelProtection --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IKEv2Profile --value-type *vpp_ipsec.IKEv2Profile --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IPSecGlobal --value-type *vpp_ipsec.IPSecGlobal --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
 
 package ipsecplugin
 
 import (
+	"context"
+	"sync"
+
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/infra"
@@ -33,6 +38,8 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/descriptor"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2202"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2210"
@@ -41,9 +48,12 @@ import (
 
 func init() {
 	kvscheduler.AddNonRetryableError(vppcalls.ErrTunnelProtectionUnsupported)
+	kvscheduler.AddNonRetryableError(vppcalls.ErrIKEv2Unsupported)
+	kvscheduler.AddNonRetryableError(vppcalls.ErrAntiReplayWindowUnsupported)
 }
 
-// IPSecPlugin configures VPP security policy databases and security associations using GoVPP.
+// IPSecPlugin configures VPP security policy databases and security associations using GoVPP
+// and publishes notifications about expired security association lifetimes.
 type IPSecPlugin struct {
 	Deps
 
@@ -55,6 +65,18 @@ type IPSecPlugin struct {
 	saDescriptor         *descriptor.IPSecSADescriptor
 	spdIfDescriptor      *descriptor.SPDInterfaceDescriptor
 	tunProtectDescriptor *descriptor.TunnelProtectDescriptor
+	ikev2Descriptor      *descriptor.IKEv2ProfileDescriptor
+	globalDescriptor     *descriptor.IPSecGlobalDescriptor
+
+	// SA lifetime watcher
+	lifetimeWatcher *saLifetimeWatcher
+
+	// notification callback, set by SetNotifyService
+	notifyMu         sync.Mutex
+	pushNotification func(notification *vpp.Notification)
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // Deps lists dependencies of the IPSec plugin.
@@ -68,6 +90,8 @@ type Deps struct {
 
 // Init registers IPSec-related descriptors.
 func (p *IPSecPlugin) Init() (err error) {
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
 	// init IPSec handler
 	p.ipSecHandler = vppcalls.CompatibleIPSecVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
 	if p.ipSecHandler == nil {
@@ -90,7 +114,8 @@ func (p *IPSecPlugin) Init() (err error) {
 	}
 
 	// init and register security association descriptor
-	p.saDescriptor = descriptor.NewIPSecSADescriptor(p.ipSecHandler, p.Log)
+	p.lifetimeWatcher = newSALifetimeWatcher(p.VPP, p.publishSANotification, p.Log)
+	p.saDescriptor = descriptor.NewIPSecSADescriptor(p.ipSecHandler, p.lifetimeWatcher, p.Log)
 	saDescriptor := adapter.NewSADescriptor(p.saDescriptor.GetDescriptor())
 	err = p.KVScheduler.RegisterKVDescriptor(saDescriptor)
 	if err != nil {
@@ -105,6 +130,22 @@ func (p *IPSecPlugin) Init() (err error) {
 		return err
 	}
 
+	// init and register IKEv2 profile descriptor
+	p.ikev2Descriptor = descriptor.NewIKEv2ProfileDescriptor(p.ipSecHandler, p.Log)
+	ikev2Descriptor := adapter.NewIKEv2ProfileDescriptor(p.ikev2Descriptor.GetDescriptor())
+	err = p.KVScheduler.RegisterKVDescriptor(ikev2Descriptor)
+	if err != nil {
+		return err
+	}
+
+	// init and register global IPSec settings descriptor
+	p.globalDescriptor = descriptor.NewIPSecGlobalDescriptor(p.ipSecHandler, p.Log)
+	globalDescriptor := adapter.NewIPSecGlobalDescriptor(p.globalDescriptor.GetDescriptor())
+	err = p.KVScheduler.RegisterKVDescriptor(globalDescriptor)
+	if err != nil {
+		return err
+	}
+
 	// init & register other descriptors for derived types
 	p.spdIfDescriptor = descriptor.NewSPDInterfaceDescriptor(p.ipSecHandler, p.Log)
 	spdIfDescriptor := adapter.NewSPDInterfaceDescriptor(p.spdIfDescriptor.GetDescriptor())
@@ -113,6 +154,7 @@ func (p *IPSecPlugin) Init() (err error) {
 		return err
 	}
 
+	p.lifetimeWatcher.start(p.ctx)
 	return nil
 }
 
@@ -123,3 +165,32 @@ func (p *IPSecPlugin) AfterInit() error {
 	}
 	return nil
 }
+
+// Close stops watching SA lifetimes.
+func (p *IPSecPlugin) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	if p.lifetimeWatcher != nil {
+		p.lifetimeWatcher.wait()
+	}
+	return nil
+}
+
+// SetNotifyService sets notification callback for processing VPP notifications.
+func (p *IPSecPlugin) SetNotifyService(notify func(notification *vpp.Notification)) {
+	p.notifyMu.Lock()
+	defer p.notifyMu.Unlock()
+	p.pushNotification = notify
+}
+
+// publishSANotification sends notification about expired SA lifetime.
+func (p *IPSecPlugin) publishSANotification(notification *ipsec.SecurityAssociationNotification) {
+	p.notifyMu.Lock()
+	defer p.notifyMu.Unlock()
+	if p.pushNotification != nil {
+		p.pushNotification(&vpp.Notification{
+			IpsecSa: notification,
+		})
+	}
+}
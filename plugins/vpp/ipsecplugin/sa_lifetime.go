@@ -0,0 +1,223 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsecplugin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.fd.io/govpp/adapter"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+const (
+	// saStatsName is the name of the combined counter with per-SA packets and bytes.
+	saStatsName = "/net/ipsec/sa"
+
+	// saLifetimePollPeriod is the period of checking SA counters against the lifetime limits.
+	saLifetimePollPeriod = 5 * time.Second
+)
+
+// saLifetimeWatcher enforces soft and hard lifetime of security associations.
+// VPP does not support SA lifetime, therefore the watcher periodically reads
+// SA counters from the stats segment, measures SA age and publishes notification
+// once a lifetime limit is reached. Expired SAs are not removed, it is left up
+// to the control plane to re-key.
+type saLifetimeWatcher struct {
+	log     logging.Logger
+	stats   govppmux.API
+	publish func(notification *ipsec.SecurityAssociationNotification)
+
+	// access guards access to sas map
+	access sync.Mutex
+	sas    map[uint32]*trackedSA // SA index -> tracked SA
+
+	wg sync.WaitGroup
+}
+
+// trackedSA is a security association with lifetime limits.
+type trackedSA struct {
+	sa          *ipsec.SecurityAssociation
+	statIndex   uint32
+	since       time.Time
+	softExpired bool
+	hardExpired bool
+}
+
+func newSALifetimeWatcher(
+	stats govppmux.API,
+	publish func(notification *ipsec.SecurityAssociationNotification),
+	logger logging.PluginLogger,
+) *saLifetimeWatcher {
+	return &saLifetimeWatcher{
+		log:     logger.NewLogger("ipsec-sa-lifetime"),
+		stats:   stats,
+		publish: publish,
+		sas:     make(map[uint32]*trackedSA),
+	}
+}
+
+// TrackSA starts (or updates) tracking of the SA lifetime. The age of an already
+// tracked SA is preserved, expiry is re-evaluated if the limits have changed.
+func (w *saLifetimeWatcher) TrackSA(sa *ipsec.SecurityAssociation, statIndex uint32) {
+	w.access.Lock()
+	defer w.access.Unlock()
+
+	tracked, ok := w.sas[sa.GetIndex()]
+	if !ok {
+		w.sas[sa.GetIndex()] = &trackedSA{
+			sa:        proto.Clone(sa).(*ipsec.SecurityAssociation),
+			statIndex: statIndex,
+			since:     time.Now(),
+		}
+		return
+	}
+	if !proto.Equal(tracked.sa.GetSoftLifetime(), sa.GetSoftLifetime()) {
+		tracked.softExpired = false
+	}
+	if !proto.Equal(tracked.sa.GetHardLifetime(), sa.GetHardLifetime()) {
+		tracked.hardExpired = false
+	}
+	tracked.sa = proto.Clone(sa).(*ipsec.SecurityAssociation)
+	tracked.statIndex = statIndex
+}
+
+// UntrackSA stops tracking of the SA lifetime.
+func (w *saLifetimeWatcher) UntrackSA(saIndex uint32) {
+	w.access.Lock()
+	defer w.access.Unlock()
+	delete(w.sas, saIndex)
+}
+
+// start starts periodic checking of SA lifetimes.
+func (w *saLifetimeWatcher) start(ctx context.Context) {
+	w.wg.Add(1)
+	go w.watchLifetimes(ctx)
+}
+
+// wait waits until the watcher has finished.
+func (w *saLifetimeWatcher) wait() {
+	w.wg.Wait()
+}
+
+func (w *saLifetimeWatcher) watchLifetimes(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(saLifetimePollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.checkLifetimes()
+		case <-ctx.Done():
+			w.log.Debug("IPSec SA lifetime watcher stopped")
+			return
+		}
+	}
+}
+
+// checkLifetimes compares SA counters and age with the lifetime limits.
+func (w *saLifetimeWatcher) checkLifetimes() {
+	w.access.Lock()
+	empty := len(w.sas) == 0
+	w.access.Unlock()
+	if empty {
+		return
+	}
+
+	entries, err := w.stats.DumpStats(saStatsName)
+	if err != nil {
+		w.log.Warnf("failed to read IPSec SA counters: %v", err)
+		return
+	}
+	var counters adapter.CombinedCounterStat
+	for _, entry := range entries {
+		if string(entry.Name) != saStatsName {
+			continue
+		}
+		if stat, ok := entry.Data.(adapter.CombinedCounterStat); ok {
+			counters = stat
+		}
+	}
+
+	var notifications []*ipsec.SecurityAssociationNotification
+	now := time.Now()
+	w.access.Lock()
+	for _, tracked := range w.sas {
+		packets, bytes := sumSACounters(counters, tracked.statIndex)
+		age := uint64(now.Sub(tracked.since).Seconds())
+		if notif := tracked.checkExpiry(packets, bytes, age); notif != nil {
+			notifications = append(notifications, notif)
+		}
+	}
+	w.access.Unlock()
+
+	for _, notif := range notifications {
+		w.log.Infof("IPSec SA %d (SPI %d): %v", notif.Index, notif.Spi, notif.Event)
+		w.publish(notif)
+	}
+}
+
+// checkExpiry returns notification if the SA has just reached its hard
+// or soft lifetime, nil otherwise.
+func (t *trackedSA) checkExpiry(packets, bytes, age uint64) *ipsec.SecurityAssociationNotification {
+	var event ipsec.SecurityAssociationNotification_Event
+	switch {
+	case !t.hardExpired && lifetimeReached(t.sa.GetHardLifetime(), packets, bytes, age):
+		t.hardExpired = true
+		t.softExpired = true
+		event = ipsec.SecurityAssociationNotification_HARD_LIFETIME_EXPIRED
+	case !t.softExpired && lifetimeReached(t.sa.GetSoftLifetime(), packets, bytes, age):
+		t.softExpired = true
+		event = ipsec.SecurityAssociationNotification_SOFT_LIFETIME_EXPIRED
+	default:
+		return nil
+	}
+	return &ipsec.SecurityAssociationNotification{
+		Index:      t.sa.GetIndex(),
+		Spi:        t.sa.GetSpi(),
+		Event:      event,
+		Bytes:      bytes,
+		Packets:    packets,
+		AgeSeconds: age,
+	}
+}
+
+// lifetimeReached returns true if any of the (non-zero) lifetime limits is reached.
+func lifetimeReached(lifetime *ipsec.SecurityAssociation_Lifetime, packets, bytes, age uint64) bool {
+	if lifetime == nil {
+		return false
+	}
+	return (lifetime.Bytes != 0 && bytes >= lifetime.Bytes) ||
+		(lifetime.Packets != 0 && packets >= lifetime.Packets) ||
+		(lifetime.Seconds != 0 && age >= lifetime.Seconds)
+}
+
+// sumSACounters sums packets and bytes of the SA across all workers.
+func sumSACounters(counters adapter.CombinedCounterStat, statIndex uint32) (packets, bytes uint64) {
+	for _, perWorker := range counters {
+		if int(statIndex) < len(perWorker) {
+			packets += perWorker[statIndex].Packets()
+			bytes += perWorker[statIndex].Bytes()
+		}
+	}
+	return packets, bytes
+}
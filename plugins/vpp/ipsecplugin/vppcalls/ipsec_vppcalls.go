@@ -28,8 +28,29 @@ import (
 var (
 	// ErrTunnelProtectionUnsupported error is returned if IPSec tunnel protection is not supported on given VPP version.
 	ErrTunnelProtectionUnsupported = errors.New("IPSec tunnel protection is not supported")
+
+	// ErrIKEv2Unsupported error is returned if IKEv2 is not supported on given VPP version.
+	ErrIKEv2Unsupported = errors.New("IKEv2 is not supported")
+
+	// ErrAntiReplayWindowUnsupported error is returned if the requested anti-replay window
+	// size is not supported on given VPP version.
+	ErrAntiReplayWindowUnsupported = errors.New("anti-replay window size is not supported")
 )
 
+// DefaultAntiReplayWindowSize is the size of the anti-replay window (in packets)
+// used by VPP if not configured otherwise.
+const DefaultAntiReplayWindowSize = 64
+
+// ikev2SaIDFlag is set in IDs of security associations installed by the VPP
+// IKEv2 plugin for negotiated child SAs.
+const ikev2SaIDFlag = 1 << 31
+
+// IsIKEv2SaID returns true if the given SA ID belongs to the range used by VPP
+// for security associations negotiated by IKEv2.
+func IsIKEv2SaID(saID uint32) bool {
+	return saID&ikev2SaIDFlag != 0
+}
+
 // IPSecSaDetails holds security association with VPP metadata
 type IPSecSaDetails struct {
 	Sa   *ipsec.SecurityAssociation
@@ -48,6 +69,31 @@ type IPSecSaMeta struct {
 	LastSeqInbound uint64
 	ReplayWindow   uint64
 	TotalDataSize  uint64
+	IKEv2          bool   // SA was negotiated by IKEv2
+	StatIndex      uint32 // index of the SA in the stats segment counters
+}
+
+// IKEv2SaDetails holds IKE security association negotiated by VPP
+// together with its child SAs.
+type IKEv2SaDetails struct {
+	SaIndex       uint32
+	ProfileIndex  uint32
+	InitiatorSpi  uint64
+	ResponderSpi  uint64
+	InitiatorAddr string
+	ResponderAddr string
+	InitiatorID   string
+	ResponderID   string
+	ChildSAs      []*IKEv2ChildSaDetails
+}
+
+// IKEv2ChildSaDetails holds child security association negotiated by IKEv2.
+type IKEv2ChildSaDetails struct {
+	ChildSaIndex uint32
+	InitiatorSpi uint32
+	ResponderSpi uint32
+	Encryption   uint16 // IKEv2 encryption transform ID
+	Integrity    uint16 // IKEv2 integrity transform ID
 }
 
 // IPSecVppAPI provides methods for creating and managing of a IPsec configuration
@@ -76,6 +122,16 @@ type IPSecVppAPI interface {
 	UpdateTunnelProtection(tp *ipsec.TunnelProtection) error
 	// DeleteTunnelProtection deletes a tunnel protection from VPP via binary API
 	DeleteTunnelProtection(tp *ipsec.TunnelProtection) error
+	// AddIKEv2Profile adds IKEv2 profile to VPP via binary API
+	AddIKEv2Profile(profile *ipsec.IKEv2Profile) error
+	// DeleteIKEv2Profile deletes IKEv2 profile from VPP via binary API
+	DeleteIKEv2Profile(profile *ipsec.IKEv2Profile) error
+	// SetCryptoHandler selects crypto engine for the given algorithm via binary API
+	SetCryptoHandler(handler *ipsec.IPSecGlobal_CryptoHandler) error
+	// SetAsyncMode enables or disables asynchronous crypto for IPSec via binary API
+	SetAsyncMode(enable bool) error
+	// SetCryptoAsyncDispatchMode sets dispatch mode of the asynchronous crypto via binary API
+	SetCryptoAsyncDispatchMode(mode ipsec.IPSecGlobal_AsyncDispatchMode) error
 }
 
 // IPSecVPPRead provides read methods for IPSec
@@ -90,6 +146,11 @@ type IPSecVPPRead interface {
 	DumpIPSecSAWithIndex(saID uint32) (saList []*IPSecSaDetails, err error)
 	// DumpTunnelProtections returns configured IPSec tunnel protections
 	DumpTunnelProtections() (tpList []*ipsec.TunnelProtection, err error)
+	// DumpIKEv2Profiles returns configured IKEv2 profiles
+	DumpIKEv2Profiles() (profiles []*ipsec.IKEv2Profile, err error)
+	// DumpIKEv2SAs returns IKE security associations negotiated by VPP
+	// together with their child SAs
+	DumpIKEv2SAs() (saList []*IKEv2SaDetails, err error)
 }
 
 var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
@@ -0,0 +1,576 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"bytes"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ikev2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ikev2_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+// IKEv2 authentication methods and transform IDs as used by the VPP ikev2 plugin
+// (numbers assigned by IANA).
+const (
+	ikev2AuthMethodRSASig       = 1
+	ikev2AuthMethodSharedKeyMIC = 2
+
+	ikev2EncrAESCBC   = 12
+	ikev2EncrAESGCM16 = 20
+
+	ikev2IntegNone         = 0
+	ikev2IntegSHA1_96      = 2
+	ikev2IntegSHA2_256_128 = 12
+	ikev2IntegSHA2_384_192 = 13
+	ikev2IntegSHA2_512_256 = 14
+)
+
+// ikev2ProfileNameMaxSize is the maximum length of IKEv2 profile name accepted by VPP.
+const ikev2ProfileNameMaxSize = 63
+
+// AddIKEv2Profile implements IPSec handler.
+func (h *IPSecVppHandler) AddIKEv2Profile(profile *ipsec.IKEv2Profile) error {
+	if !h.ikev2Enabled {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "ikev2")
+	}
+	if len(profile.Name) > ikev2ProfileNameMaxSize {
+		return errors.Errorf("IKEv2 profile name %q is longer than %d characters",
+			profile.Name, ikev2ProfileNameMaxSize)
+	}
+	if err := h.ikev2ProfileAddDel(profile.Name, true); err != nil {
+		return err
+	}
+	if err := h.configureIKEv2Profile(profile); err != nil {
+		// do not leave partially configured profile behind
+		if delErr := h.ikev2ProfileAddDel(profile.Name, false); delErr != nil {
+			h.log.Warnf("failed to remove partially configured IKEv2 profile %s: %v", profile.Name, delErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteIKEv2Profile implements IPSec handler.
+func (h *IPSecVppHandler) DeleteIKEv2Profile(profile *ipsec.IKEv2Profile) error {
+	if !h.ikev2Enabled {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "ikev2")
+	}
+	return h.ikev2ProfileAddDel(profile.Name, false)
+}
+
+// DumpIKEv2Profiles implements IPSec handler.
+func (h *IPSecVppHandler) DumpIKEv2Profiles() (profiles []*ipsec.IKEv2Profile, err error) {
+	if !h.ikev2Enabled {
+		// no-op when disabled
+		return nil, nil
+	}
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ikev2.Ikev2ProfileDump{})
+	for {
+		details := &vpp_ikev2.Ikev2ProfileDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, h.ikev2ProfileFromDetails(&details.Profile))
+	}
+	return profiles, nil
+}
+
+// DumpIKEv2SAs implements IPSec handler.
+func (h *IPSecVppHandler) DumpIKEv2SAs() (saList []*vppcalls.IKEv2SaDetails, err error) {
+	if !h.ikev2Enabled {
+		// no-op when disabled
+		return nil, nil
+	}
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ikev2.Ikev2SaDump{})
+	for {
+		details := &vpp_ikev2.Ikev2SaDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		saList = append(saList, &vppcalls.IKEv2SaDetails{
+			SaIndex:       details.Sa.SaIndex,
+			ProfileIndex:  details.Sa.ProfileIndex,
+			InitiatorSpi:  details.Sa.Ispi,
+			ResponderSpi:  details.Sa.Rspi,
+			InitiatorAddr: ipsecAddrToIP(details.Sa.Iaddr).String(),
+			ResponderAddr: ipsecAddrToIP(details.Sa.Raddr).String(),
+			InitiatorID:   ikev2IDToString(details.Sa.IID),
+			ResponderID:   ikev2IDToString(details.Sa.RID),
+		})
+	}
+	// child SAs are dumped per IKE SA, once the IKE SA dump is finished
+	for _, sa := range saList {
+		if sa.ChildSAs, err = h.dumpIKEv2ChildSAs(sa.SaIndex); err != nil {
+			return nil, err
+		}
+	}
+	return saList, nil
+}
+
+func (h *IPSecVppHandler) dumpIKEv2ChildSAs(saIndex uint32) (childSAs []*vppcalls.IKEv2ChildSaDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ikev2.Ikev2ChildSaDump{
+		SaIndex: saIndex,
+	})
+	for {
+		details := &vpp_ikev2.Ikev2ChildSaDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		childSAs = append(childSAs, &vppcalls.IKEv2ChildSaDetails{
+			ChildSaIndex: details.ChildSa.ChildSaIndex,
+			InitiatorSpi: details.ChildSa.ISpi,
+			ResponderSpi: details.ChildSa.RSpi,
+			Encryption:   details.ChildSa.Encryption.TransformID,
+			Integrity:    details.ChildSa.Integrity.TransformID,
+		})
+	}
+	return childSAs, nil
+}
+
+func (h *IPSecVppHandler) ikev2ProfileAddDel(name string, isAdd bool) error {
+	req := &vpp_ikev2.Ikev2ProfileAddDel{
+		Name:  name,
+		IsAdd: isAdd,
+	}
+	reply := &vpp_ikev2.Ikev2ProfileAddDelReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// configureIKEv2Profile sets all attributes of already created IKEv2 profile.
+func (h *IPSecVppHandler) configureIKEv2Profile(profile *ipsec.IKEv2Profile) error {
+	if auth := profile.GetAuth(); auth != nil {
+		if err := h.ikev2SetAuth(profile.Name, auth); err != nil {
+			return errors.Errorf("failed to set IKEv2 authentication: %v", err)
+		}
+	}
+	if id := profile.GetLocalId(); id != nil {
+		if err := h.ikev2SetID(profile.Name, id, true); err != nil {
+			return errors.Errorf("failed to set IKEv2 local identity: %v", err)
+		}
+	}
+	if id := profile.GetRemoteId(); id != nil {
+		if err := h.ikev2SetID(profile.Name, id, false); err != nil {
+			return errors.Errorf("failed to set IKEv2 remote identity: %v", err)
+		}
+	}
+	if ts := profile.GetLocalTs(); ts != nil {
+		if err := h.ikev2SetTs(profile.Name, ts, true); err != nil {
+			return errors.Errorf("failed to set IKEv2 local traffic selector: %v", err)
+		}
+	}
+	if ts := profile.GetRemoteTs(); ts != nil {
+		if err := h.ikev2SetTs(profile.Name, ts, false); err != nil {
+			return errors.Errorf("failed to set IKEv2 remote traffic selector: %v", err)
+		}
+	}
+	if responder := profile.GetResponder(); responder != nil {
+		if err := h.ikev2SetResponder(profile.Name, responder); err != nil {
+			return errors.Errorf("failed to set IKEv2 responder: %v", err)
+		}
+	}
+	if tr := profile.GetIkeTransforms(); tr != nil {
+		if err := h.ikev2SetIkeTransforms(profile.Name, tr); err != nil {
+			return errors.Errorf("failed to set IKE transforms: %v", err)
+		}
+	}
+	if tr := profile.GetEspTransforms(); tr != nil {
+		if err := h.ikev2SetEspTransforms(profile.Name, tr); err != nil {
+			return errors.Errorf("failed to set ESP transforms: %v", err)
+		}
+	}
+	if lifetime := profile.GetSaLifetime(); lifetime != nil {
+		req := &vpp_ikev2.Ikev2SetSaLifetime{
+			Name:            profile.Name,
+			Lifetime:        lifetime.Lifetime,
+			LifetimeJitter:  lifetime.Jitter,
+			Handover:        lifetime.Handover,
+			LifetimeMaxdata: lifetime.MaxData,
+		}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2SetSaLifetimeReply{}); err != nil {
+			return errors.Errorf("failed to set IKEv2 SA lifetime: %v", err)
+		}
+	}
+	if profile.TunnelInterface != "" {
+		ifaceMeta, found := h.ifIndexes.LookupByName(profile.TunnelInterface)
+		if !found {
+			return errors.Errorf("tunnel interface %s not found", profile.TunnelInterface)
+		}
+		req := &vpp_ikev2.Ikev2SetTunnelInterface{
+			Name:      profile.Name,
+			SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2SetTunnelInterfaceReply{}); err != nil {
+			return errors.Errorf("failed to set IKEv2 tunnel interface: %v", err)
+		}
+	}
+	if profile.UdpEncap {
+		req := &vpp_ikev2.Ikev2ProfileSetUDPEncap{
+			Name: profile.Name,
+		}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2ProfileSetUDPEncapReply{}); err != nil {
+			return errors.Errorf("failed to enable UDP encapsulation: %v", err)
+		}
+	}
+	if profile.DisableNatTraversal {
+		req := &vpp_ikev2.Ikev2ProfileDisableNatt{
+			Name: profile.Name,
+		}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2ProfileDisableNattReply{}); err != nil {
+			return errors.Errorf("failed to disable NAT traversal: %v", err)
+		}
+	}
+	if profile.Initiate {
+		req := &vpp_ikev2.Ikev2InitiateSaInit{
+			Name: profile.Name,
+		}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2InitiateSaInitReply{}); err != nil {
+			return errors.Errorf("failed to initiate IKE SA: %v", err)
+		}
+	}
+	return nil
+}
+
+func (h *IPSecVppHandler) ikev2SetAuth(name string, auth *ipsec.IKEv2Profile_Authentication) error {
+	req := &vpp_ikev2.Ikev2ProfileSetAuth{
+		Name: name,
+	}
+	switch auth.Method {
+	case ipsec.IKEv2Profile_Authentication_SHARED_KEY:
+		req.AuthMethod = ikev2AuthMethodSharedKeyMIC
+		req.IsHex = auth.SharedKeyIsHex
+		req.Data = []byte(auth.SharedKey)
+	case ipsec.IKEv2Profile_Authentication_RSA_SIGNATURE:
+		req.AuthMethod = ikev2AuthMethodRSASig
+		req.Data = []byte(auth.PeerCertFile)
+	default:
+		return errors.Errorf("unknown authentication method: %v", auth.Method)
+	}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2ProfileSetAuthReply{}); err != nil {
+		return err
+	}
+	if auth.Method == ipsec.IKEv2Profile_Authentication_RSA_SIGNATURE && auth.LocalKeyFile != "" {
+		keyReq := &vpp_ikev2.Ikev2SetLocalKey{
+			KeyFile: auth.LocalKeyFile,
+		}
+		if err := h.callsChannel.SendRequest(keyReq).ReceiveReply(&vpp_ikev2.Ikev2SetLocalKeyReply{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *IPSecVppHandler) ikev2SetID(name string, id *ipsec.IKEv2Profile_Identity, isLocal bool) error {
+	data := []byte(id.Data)
+	switch id.Type {
+	case ipsec.IKEv2Profile_Identity_IPV4_ADDR:
+		ip := net.ParseIP(id.Data).To4()
+		if ip == nil {
+			return errors.Errorf("invalid IPv4 address: %q", id.Data)
+		}
+		data = ip
+	case ipsec.IKEv2Profile_Identity_IPV6_ADDR:
+		ip := net.ParseIP(id.Data)
+		if ip == nil || ip.To4() != nil {
+			return errors.Errorf("invalid IPv6 address: %q", id.Data)
+		}
+		data = ip.To16()
+	}
+	req := &vpp_ikev2.Ikev2ProfileSetID{
+		Name:    name,
+		IsLocal: isLocal,
+		IDType:  uint8(id.Type),
+		Data:    data,
+	}
+	return h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2ProfileSetIDReply{})
+}
+
+func (h *IPSecVppHandler) ikev2SetTs(name string, ts *ipsec.IKEv2Profile_TrafficSelector, isLocal bool) error {
+	req := &vpp_ikev2.Ikev2ProfileSetTs{
+		Name: name,
+		Ts: ikev2_types.Ikev2Ts{
+			IsLocal:    isLocal,
+			ProtocolID: uint8(ts.Protocol),
+			StartPort:  uint16(ts.StartPort),
+			EndPort:    uint16(ts.EndPort),
+		},
+	}
+	if req.Ts.EndPort == 0 {
+		req.Ts.EndPort = ^req.Ts.EndPort
+	}
+	var err error
+	req.Ts.StartAddr, err = IPToAddress(ipOr(ts.StartAddr, "0.0.0.0"))
+	if err != nil {
+		return err
+	}
+	req.Ts.EndAddr, err = IPToAddress(ipOr(ts.EndAddr, "255.255.255.255"))
+	if err != nil {
+		return err
+	}
+	return h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2ProfileSetTsReply{})
+}
+
+func (h *IPSecVppHandler) ikev2SetResponder(name string, responder *ipsec.IKEv2Profile_Responder) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(responder.Interface)
+	if !found {
+		return errors.Errorf("interface %s not found", responder.Interface)
+	}
+	addr, err := IPToAddress(responder.Address)
+	if err != nil {
+		return err
+	}
+	req := &vpp_ikev2.Ikev2SetResponder{
+		Name: name,
+		Responder: ikev2_types.Ikev2Responder{
+			SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+			Addr:      addr,
+		},
+	}
+	return h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2SetResponderReply{})
+}
+
+func (h *IPSecVppHandler) ikev2SetIkeTransforms(name string, tr *ipsec.IKEv2Profile_IKETransforms) error {
+	cryptoAlg, keySize, err := ikev2EncrTransform(tr.CryptoAlg)
+	if err != nil {
+		return err
+	}
+	integAlg, err := ikev2IntegTransform(tr.IntegAlg)
+	if err != nil {
+		return err
+	}
+	req := &vpp_ikev2.Ikev2SetIkeTransforms{
+		Name: name,
+		Tr: ikev2_types.Ikev2IkeTransforms{
+			CryptoAlg:     cryptoAlg,
+			CryptoKeySize: keySize,
+			IntegAlg:      integAlg,
+			DhGroup:       uint8(tr.DhGroup),
+		},
+	}
+	return h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2SetIkeTransformsReply{})
+}
+
+func (h *IPSecVppHandler) ikev2SetEspTransforms(name string, tr *ipsec.IKEv2Profile_ESPTransforms) error {
+	cryptoAlg, keySize, err := ikev2EncrTransform(tr.CryptoAlg)
+	if err != nil {
+		return err
+	}
+	integAlg, err := ikev2IntegTransform(tr.IntegAlg)
+	if err != nil {
+		return err
+	}
+	req := &vpp_ikev2.Ikev2SetEspTransforms{
+		Name: name,
+		Tr: ikev2_types.Ikev2EspTransforms{
+			CryptoAlg:     cryptoAlg,
+			CryptoKeySize: keySize,
+			IntegAlg:      integAlg,
+		},
+	}
+	return h.callsChannel.SendRequest(req).ReceiveReply(&vpp_ikev2.Ikev2SetEspTransformsReply{})
+}
+
+func (h *IPSecVppHandler) ikev2ProfileFromDetails(p *ikev2_types.Ikev2Profile) *ipsec.IKEv2Profile {
+	profile := &ipsec.IKEv2Profile{
+		Name:                p.Name,
+		LocalId:             ikev2IdentityFromDetails(p.LocID),
+		RemoteId:            ikev2IdentityFromDetails(p.RemID),
+		LocalTs:             ikev2TsFromDetails(p.LocTs),
+		RemoteTs:            ikev2TsFromDetails(p.RemTs),
+		UdpEncap:            p.UDPEncap,
+		DisableNatTraversal: p.NattDisabled,
+	}
+	auth := bytes.TrimRight(p.Auth.Data, "\x00")
+	switch p.Auth.Method {
+	case ikev2AuthMethodSharedKeyMIC:
+		profile.Auth = &ipsec.IKEv2Profile_Authentication{
+			Method:         ipsec.IKEv2Profile_Authentication_SHARED_KEY,
+			SharedKey:      string(auth),
+			SharedKeyIsHex: p.Auth.Hex != 0,
+		}
+	case ikev2AuthMethodRSASig:
+		profile.Auth = &ipsec.IKEv2Profile_Authentication{
+			Method:       ipsec.IKEv2Profile_Authentication_RSA_SIGNATURE,
+			PeerCertFile: string(auth),
+		}
+	}
+	if uint32(p.Responder.SwIfIndex) != ^uint32(0) {
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(p.Responder.SwIfIndex))
+		if !found {
+			h.log.Warnf("IKEv2 profile %s dump: responder interface with index %d not found",
+				p.Name, p.Responder.SwIfIndex)
+		}
+		profile.Responder = &ipsec.IKEv2Profile_Responder{
+			Interface: ifName,
+			Address:   ipsecAddrToIP(p.Responder.Addr).String(),
+		}
+	}
+	if p.IkeTs != (ikev2_types.Ikev2IkeTransforms{}) {
+		profile.IkeTransforms = &ipsec.IKEv2Profile_IKETransforms{
+			CryptoAlg: ikev2CryptoAlg(p.IkeTs.CryptoAlg, p.IkeTs.CryptoKeySize),
+			IntegAlg:  ikev2IntegAlg(p.IkeTs.IntegAlg),
+			DhGroup:   ipsec.IKEv2Profile_DHGroup(p.IkeTs.DhGroup),
+		}
+	}
+	if p.EspTs != (ikev2_types.Ikev2EspTransforms{}) {
+		profile.EspTransforms = &ipsec.IKEv2Profile_ESPTransforms{
+			CryptoAlg: ikev2CryptoAlg(p.EspTs.CryptoAlg, p.EspTs.CryptoKeySize),
+			IntegAlg:  ikev2IntegAlg(p.EspTs.IntegAlg),
+		}
+	}
+	if p.Lifetime != 0 || p.LifetimeMaxdata != 0 || p.LifetimeJitter != 0 || p.Handover != 0 {
+		profile.SaLifetime = &ipsec.IKEv2Profile_SALifetime{
+			Lifetime: p.Lifetime,
+			Jitter:   p.LifetimeJitter,
+			Handover: p.Handover,
+			MaxData:  p.LifetimeMaxdata,
+		}
+	}
+	if p.TunItf != ^uint32(0) {
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(p.TunItf)
+		if !found {
+			h.log.Warnf("IKEv2 profile %s dump: tunnel interface with index %d not found", p.Name, p.TunItf)
+		}
+		profile.TunnelInterface = ifName
+	}
+	return profile
+}
+
+func ikev2IdentityFromDetails(id ikev2_types.Ikev2ID) *ipsec.IKEv2Profile_Identity {
+	if id.Type == 0 {
+		return nil
+	}
+	return &ipsec.IKEv2Profile_Identity{
+		Type: ipsec.IKEv2Profile_Identity_Type(id.Type),
+		Data: ikev2IDToString(id),
+	}
+}
+
+func ikev2IDToString(id ikev2_types.Ikev2ID) string {
+	data := id.Data
+	if int(id.DataLen) < len(data) {
+		data = data[:id.DataLen]
+	}
+	switch ipsec.IKEv2Profile_Identity_Type(id.Type) {
+	case ipsec.IKEv2Profile_Identity_IPV4_ADDR, ipsec.IKEv2Profile_Identity_IPV6_ADDR:
+		// identity data is decoded as NUL-terminated string, thus raw IP
+		// address containing zero byte cannot be fully recovered
+		if len(data) != int(id.DataLen) {
+			return ""
+		}
+		return net.IP(data).String()
+	}
+	return data
+}
+
+func ikev2TsFromDetails(ts ikev2_types.Ikev2Ts) *ipsec.IKEv2Profile_TrafficSelector {
+	startAddr, endAddr := ipsecAddrToIP(ts.StartAddr), ipsecAddrToIP(ts.EndAddr)
+	if ts.ProtocolID == 0 && ts.StartPort == 0 && ts.EndPort == 0 &&
+		startAddr.IsUnspecified() && endAddr.IsUnspecified() {
+		// traffic selector not set
+		return nil
+	}
+	return &ipsec.IKEv2Profile_TrafficSelector{
+		Protocol:  uint32(ts.ProtocolID),
+		StartPort: uint32(ts.StartPort),
+		EndPort:   resetPort(ts.EndPort),
+		StartAddr: startAddr.String(),
+		EndAddr:   endAddr.String(),
+	}
+}
+
+func ikev2EncrTransform(alg ipsec.CryptoAlg) (transformID uint8, keySize uint32, err error) {
+	switch alg {
+	case ipsec.CryptoAlg_AES_CBC_128:
+		return ikev2EncrAESCBC, 128, nil
+	case ipsec.CryptoAlg_AES_CBC_192:
+		return ikev2EncrAESCBC, 192, nil
+	case ipsec.CryptoAlg_AES_CBC_256:
+		return ikev2EncrAESCBC, 256, nil
+	case ipsec.CryptoAlg_AES_GCM_128:
+		return ikev2EncrAESGCM16, 128, nil
+	case ipsec.CryptoAlg_AES_GCM_192:
+		return ikev2EncrAESGCM16, 192, nil
+	case ipsec.CryptoAlg_AES_GCM_256:
+		return ikev2EncrAESGCM16, 256, nil
+	}
+	return 0, 0, errors.Errorf("crypto algorithm %v is not supported by IKEv2", alg)
+}
+
+func ikev2CryptoAlg(transformID uint8, keySize uint32) ipsec.CryptoAlg {
+	switch {
+	case transformID == ikev2EncrAESCBC && keySize == 128:
+		return ipsec.CryptoAlg_AES_CBC_128
+	case transformID == ikev2EncrAESCBC && keySize == 192:
+		return ipsec.CryptoAlg_AES_CBC_192
+	case transformID == ikev2EncrAESCBC && keySize == 256:
+		return ipsec.CryptoAlg_AES_CBC_256
+	case transformID == ikev2EncrAESGCM16 && keySize == 128:
+		return ipsec.CryptoAlg_AES_GCM_128
+	case transformID == ikev2EncrAESGCM16 && keySize == 192:
+		return ipsec.CryptoAlg_AES_GCM_192
+	case transformID == ikev2EncrAESGCM16 && keySize == 256:
+		return ipsec.CryptoAlg_AES_GCM_256
+	}
+	return ipsec.CryptoAlg_NONE_CRYPTO
+}
+
+func ikev2IntegTransform(alg ipsec.IntegAlg) (transformID uint8, err error) {
+	switch alg {
+	case ipsec.IntegAlg_NONE_INTEG:
+		return ikev2IntegNone, nil
+	case ipsec.IntegAlg_SHA1_96:
+		return ikev2IntegSHA1_96, nil
+	case ipsec.IntegAlg_SHA_256_128:
+		return ikev2IntegSHA2_256_128, nil
+	case ipsec.IntegAlg_SHA_384_192:
+		return ikev2IntegSHA2_384_192, nil
+	case ipsec.IntegAlg_SHA_512_256:
+		return ikev2IntegSHA2_512_256, nil
+	}
+	return 0, errors.Errorf("integrity algorithm %v is not supported by IKEv2", alg)
+}
+
+func ikev2IntegAlg(transformID uint8) ipsec.IntegAlg {
+	switch transformID {
+	case ikev2IntegSHA1_96:
+		return ipsec.IntegAlg_SHA1_96
+	case ikev2IntegSHA2_256_128:
+		return ipsec.IntegAlg_SHA_256_128
+	case ikev2IntegSHA2_384_192:
+		return ipsec.IntegAlg_SHA_384_192
+	case ikev2IntegSHA2_512_256:
+		return ipsec.IntegAlg_SHA_512_256
+	}
+	return ipsec.IntegAlg_NONE_INTEG
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	vpp_crypto "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/crypto"
+	vpp_ipsec "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ipsec"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+// SetCryptoHandler implements IPSec handler.
+func (h *IPSecVppHandler) SetCryptoHandler(handler *ipsec.IPSecGlobal_CryptoHandler) error {
+	req := &vpp_crypto.CryptoSetHandler{
+		AlgName: handler.Algorithm,
+		Engine:  handler.Engine,
+		Oct:     vpp_crypto.CryptoOpClassType(handler.OpClass),
+	}
+	if handler.Async {
+		req.IsAsync = 1
+	}
+	reply := &vpp_crypto.CryptoSetHandlerReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetAsyncMode implements IPSec handler.
+func (h *IPSecVppHandler) SetAsyncMode(enable bool) error {
+	req := &vpp_ipsec.IpsecSetAsyncMode{
+		AsyncEnable: enable,
+	}
+	reply := &vpp_ipsec.IpsecSetAsyncModeReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetCryptoAsyncDispatchMode implements IPSec handler.
+func (h *IPSecVppHandler) SetCryptoAsyncDispatchMode(mode ipsec.IPSecGlobal_AsyncDispatchMode) error {
+	req := &vpp_crypto.CryptoSetAsyncDispatch{
+		Mode: vpp_crypto.CryptoDispatchMode(mode),
+	}
+	reply := &vpp_crypto.CryptoSetAsyncDispatchReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
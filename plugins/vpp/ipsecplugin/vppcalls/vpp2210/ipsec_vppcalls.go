@@ -25,6 +25,7 @@ import (
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ipsec_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/tunnel_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
 	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 )
 
@@ -191,6 +192,10 @@ func (h *IPSecVppHandler) interfaceAddDelSpd(spdID, swIfIdx uint32, isAdd bool)
 }
 
 func (h *IPSecVppHandler) sadAddDelEntry(sa *ipsec.SecurityAssociation, isAdd bool) error {
+	if sa.AntiReplayWindowSize != 0 && sa.AntiReplayWindowSize != vppcalls.DefaultAntiReplayWindowSize {
+		return errors.WithMessagef(vppcalls.ErrAntiReplayWindowUnsupported,
+			"size %d (VPP supports only %d)", sa.AntiReplayWindowSize, vppcalls.DefaultAntiReplayWindowSize)
+	}
 	cryptoKey, err := hex.DecodeString(sa.CryptoKey)
 	if err != nil {
 		return err
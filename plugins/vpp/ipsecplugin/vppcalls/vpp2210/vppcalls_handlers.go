@@ -22,6 +22,7 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 
 	vpp2210 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210"
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
@@ -40,10 +41,17 @@ type IPSecVppHandler struct {
 	callsChannel govppapi.Channel
 	ifIndexes    ifaceidx.IfaceMetadataIndex
 	log          logging.Logger
+	ikev2Enabled bool
 }
 
 func NewIPSecVppHandler(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.IPSecVppAPI {
-	return &IPSecVppHandler{ch, ifIdx, log}
+	return &IPSecVppHandler{
+		callsChannel: ch,
+		ifIndexes:    ifIdx,
+		log:          log,
+		// IKEv2 is implemented by a VPP plugin which may not be loaded
+		ikev2Enabled: ch.CheckCompatiblity(vpp_ikev2.AllMessages()...) == nil,
+	}
 }
 
 func ipsecAddrToIP(addr ip_types.Address) net.IP {
@@ -84,6 +84,8 @@ func (h *IPSecVppHandler) DumpIPSecSAWithIndex(saID uint32) (saList []*vppcalls.
 			SeqOutbound:    saData.SeqOutbound,
 			LastSeqInbound: saData.LastSeqInbound,
 			ReplayWindow:   saData.ReplayWindow,
+			IKEv2:          vppcalls.IsIKEv2SaID(saData.Entry.SadID),
+			StatIndex:      saData.StatIndex,
 		}
 		saList = append(saList, &vppcalls.IPSecSaDetails{
 			Sa:   sa,
@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_crypto "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/crypto"
+	vpp_ipsec "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ipsec"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+func TestVppSetCryptoHandler(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_crypto.CryptoSetHandlerReply{})
+
+	err := ipSecHandler.SetCryptoHandler(&ipsec.IPSecGlobal_CryptoHandler{
+		Algorithm: "aes-128-gcm",
+		Engine:    "ipsecmb",
+		OpClass:   ipsec.IPSecGlobal_CryptoHandler_BOTH,
+		Async:     true,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(BeEquivalentTo(&vpp_crypto.CryptoSetHandler{
+		AlgName: "aes-128-gcm",
+		Engine:  "ipsecmb",
+		Oct:     vpp_crypto.CRYPTO_API_OP_BOTH,
+		IsAsync: 1,
+	}))
+}
+
+func TestVppSetAsyncMode(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ipsec.IpsecSetAsyncModeReply{})
+
+	err := ipSecHandler.SetAsyncMode(true)
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(BeEquivalentTo(&vpp_ipsec.IpsecSetAsyncMode{
+		AsyncEnable: true,
+	}))
+}
+
+func TestVppSetCryptoAsyncDispatchMode(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_crypto.CryptoSetAsyncDispatchReply{})
+
+	err := ipSecHandler.SetCryptoAsyncDispatchMode(ipsec.IPSecGlobal_INTERRUPT)
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(BeEquivalentTo(&vpp_crypto.CryptoSetAsyncDispatch{
+		Mode: vpp_crypto.CRYPTO_ASYNC_DISPATCH_INTERRUPT,
+	}))
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ikev2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ikev2_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+func TestAddIKEv2Profile(t *testing.T) {
+	ctx, ipSecHandler, ifIndex := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("eth0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndex.Put("ipip0", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetAuthReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetIDReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetIDReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetTsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetTsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetResponderReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetIkeTransformsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetEspTransformsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetSaLifetimeReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetTunnelInterfaceReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2InitiateSaInitReply{})
+
+	err := ipSecHandler.AddIKEv2Profile(&ipsec.IKEv2Profile{
+		Name: "pr1",
+		Auth: &ipsec.IKEv2Profile_Authentication{
+			Method:    ipsec.IKEv2Profile_Authentication_SHARED_KEY,
+			SharedKey: "secret",
+		},
+		LocalId: &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_FQDN,
+			Data: "vpp.home",
+		},
+		RemoteId: &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_IPV4_ADDR,
+			Data: "10.0.0.2",
+		},
+		LocalTs: &ipsec.IKEv2Profile_TrafficSelector{
+			StartAddr: "192.168.1.0",
+			EndAddr:   "192.168.1.255",
+		},
+		RemoteTs: &ipsec.IKEv2Profile_TrafficSelector{
+			Protocol:  17,
+			StartPort: 500,
+			EndPort:   500,
+			StartAddr: "192.168.2.0",
+			EndAddr:   "192.168.2.255",
+		},
+		Responder: &ipsec.IKEv2Profile_Responder{
+			Interface: "eth0",
+			Address:   "10.0.0.2",
+		},
+		IkeTransforms: &ipsec.IKEv2Profile_IKETransforms{
+			CryptoAlg: ipsec.CryptoAlg_AES_CBC_256,
+			IntegAlg:  ipsec.IntegAlg_SHA_256_128,
+			DhGroup:   ipsec.IKEv2Profile_MODP_2048,
+		},
+		EspTransforms: &ipsec.IKEv2Profile_ESPTransforms{
+			CryptoAlg: ipsec.CryptoAlg_AES_GCM_128,
+		},
+		SaLifetime: &ipsec.IKEv2Profile_SALifetime{
+			Lifetime: 3600,
+			Jitter:   10,
+			Handover: 5,
+		},
+		TunnelInterface: "ipip0",
+		Initiate:        true,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(12))
+	Expect(ctx.MockChannel.Msgs[0]).To(Equal(&vpp_ikev2.Ikev2ProfileAddDel{
+		Name:  "pr1",
+		IsAdd: true,
+	}))
+	Expect(ctx.MockChannel.Msgs[1]).To(Equal(&vpp_ikev2.Ikev2ProfileSetAuth{
+		Name:       "pr1",
+		AuthMethod: 2,
+		Data:       []byte("secret"),
+	}))
+	Expect(ctx.MockChannel.Msgs[3]).To(Equal(&vpp_ikev2.Ikev2ProfileSetID{
+		Name:   "pr1",
+		IDType: uint8(ipsec.IKEv2Profile_Identity_IPV4_ADDR),
+		Data:   []byte{10, 0, 0, 2},
+	}))
+	Expect(ctx.MockChannel.Msgs[4]).To(Equal(&vpp_ikev2.Ikev2ProfileSetTs{
+		Name: "pr1",
+		Ts: ikev2_types.Ikev2Ts{
+			IsLocal:   true,
+			EndPort:   65535,
+			StartAddr: ipToAddr("192.168.1.0"),
+			EndAddr:   ipToAddr("192.168.1.255"),
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[6]).To(Equal(&vpp_ikev2.Ikev2SetResponder{
+		Name: "pr1",
+		Responder: ikev2_types.Ikev2Responder{
+			SwIfIndex: 1,
+			Addr:      ipToAddr("10.0.0.2"),
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[7]).To(Equal(&vpp_ikev2.Ikev2SetIkeTransforms{
+		Name: "pr1",
+		Tr: ikev2_types.Ikev2IkeTransforms{
+			CryptoAlg:     12,
+			CryptoKeySize: 256,
+			IntegAlg:      12,
+			DhGroup:       14,
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[8]).To(Equal(&vpp_ikev2.Ikev2SetEspTransforms{
+		Name: "pr1",
+		Tr: ikev2_types.Ikev2EspTransforms{
+			CryptoAlg:     20,
+			CryptoKeySize: 128,
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[10]).To(Equal(&vpp_ikev2.Ikev2SetTunnelInterface{
+		Name:      "pr1",
+		SwIfIndex: 2,
+	}))
+	Expect(ctx.MockChannel.Msgs[11]).To(Equal(&vpp_ikev2.Ikev2InitiateSaInit{
+		Name: "pr1",
+	}))
+}
+
+func TestAddIKEv2ProfileUnsupportedTransform(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+
+	err := ipSecHandler.AddIKEv2Profile(&ipsec.IKEv2Profile{
+		Name: "pr1",
+		IkeTransforms: &ipsec.IKEv2Profile_IKETransforms{
+			CryptoAlg: ipsec.CryptoAlg_DES_CBC,
+		},
+	})
+	Expect(err).Should(HaveOccurred())
+	// partially configured profile is removed
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_ikev2.Ikev2ProfileAddDel{
+		Name: "pr1",
+	}))
+}
+
+func TestDeleteIKEv2Profile(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+
+	err := ipSecHandler.DeleteIKEv2Profile(&ipsec.IKEv2Profile{
+		Name: "pr1",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_ikev2.Ikev2ProfileAddDel{
+		Name: "pr1",
+	}))
+}
+
+func TestDumpIKEv2Profiles(t *testing.T) {
+	ctx, ipSecHandler, ifIndex := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("ipip0", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileDetails{
+		Profile: ikev2_types.Ikev2Profile{
+			Name: "pr1",
+			LocID: ikev2_types.Ikev2ID{
+				Type:    uint8(ipsec.IKEv2Profile_Identity_IPV4_ADDR),
+				DataLen: 4,
+				Data:    string([]byte{10, 1, 1, 1}),
+			},
+			RemTs: ikev2_types.Ikev2Ts{
+				EndPort:   65535,
+				StartAddr: ipToAddr("192.168.2.0"),
+				EndAddr:   ipToAddr("192.168.2.255"),
+			},
+			Responder: ikev2_types.Ikev2Responder{
+				SwIfIndex: ^interface_types.InterfaceIndex(0),
+			},
+			EspTs: ikev2_types.Ikev2EspTransforms{
+				CryptoAlg:     12,
+				CryptoKeySize: 128,
+				IntegAlg:      2,
+			},
+			TunItf: 2,
+			Auth: ikev2_types.Ikev2Auth{
+				Method: 1,
+				Data:   []byte("/etc/ipsec/peer.pem\x00"),
+			},
+		},
+	}, &memclnt.ControlPingReply{})
+
+	profiles, err := ipSecHandler.DumpIKEv2Profiles()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(profiles).To(HaveLen(1))
+	Expect(profiles[0]).To(Equal(&ipsec.IKEv2Profile{
+		Name: "pr1",
+		Auth: &ipsec.IKEv2Profile_Authentication{
+			Method:       ipsec.IKEv2Profile_Authentication_RSA_SIGNATURE,
+			PeerCertFile: "/etc/ipsec/peer.pem",
+		},
+		LocalId: &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_IPV4_ADDR,
+			Data: "10.1.1.1",
+		},
+		RemoteTs: &ipsec.IKEv2Profile_TrafficSelector{
+			StartAddr: "192.168.2.0",
+			EndAddr:   "192.168.2.255",
+		},
+		EspTransforms: &ipsec.IKEv2Profile_ESPTransforms{
+			CryptoAlg: ipsec.CryptoAlg_AES_CBC_128,
+			IntegAlg:  ipsec.IntegAlg_SHA1_96,
+		},
+		TunnelInterface: "ipip0",
+	}))
+}
+
+func TestDumpIKEv2SAs(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SaDetails{
+		Sa: ikev2_types.Ikev2Sa{
+			SaIndex: 3,
+			Ispi:    0x1111,
+			Rspi:    0x2222,
+			Iaddr:   ipToAddr("10.0.0.1"),
+			Raddr:   ipToAddr("10.0.0.2"),
+			IID: ikev2_types.Ikev2ID{
+				Type:    uint8(ipsec.IKEv2Profile_Identity_FQDN),
+				DataLen: 8,
+				Data:    "vpp.home",
+			},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ChildSaDetails{
+		ChildSa: ikev2_types.Ikev2ChildSa{
+			SaIndex:      3,
+			ChildSaIndex: 0,
+			ISpi:         0xaaaa,
+			RSpi:         0xbbbb,
+			Encryption: ikev2_types.Ikev2SaTransform{
+				TransformID: 20,
+			},
+		},
+	}, &memclnt.ControlPingReply{})
+
+	saList, err := ipSecHandler.DumpIKEv2SAs()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(saList).To(HaveLen(1))
+	Expect(saList[0].SaIndex).To(BeEquivalentTo(3))
+	Expect(saList[0].InitiatorSpi).To(BeEquivalentTo(0x1111))
+	Expect(saList[0].InitiatorAddr).To(Equal("10.0.0.1"))
+	Expect(saList[0].InitiatorID).To(Equal("vpp.home"))
+	Expect(saList[0].ChildSAs).To(HaveLen(1))
+	Expect(saList[0].ChildSAs[0].InitiatorSpi).To(BeEquivalentTo(0xaaaa))
+	Expect(saList[0].ChildSAs[0].ResponderSpi).To(BeEquivalentTo(0xbbbb))
+	Expect(saList[0].ChildSAs[0].Encryption).To(BeEquivalentTo(20))
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_ikev2.Ikev2ChildSaDump{
+		SaIndex: 3,
+	}))
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/logging/logrus"
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
@@ -220,6 +221,22 @@ func TestVppAddSA(t *testing.T) {
 	}))
 }
 
+func TestVppAddSAUnsupportedReplayWindow(t *testing.T) {
+	ctx, ipSecHandler, _ := ipSecTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := ipSecHandler.AddSA(&ipsec.SecurityAssociation{
+		Index:                1,
+		Spi:                  uint32(1001),
+		UseAntiReplay:        true,
+		AntiReplayWindowSize: 1024,
+		Protocol:             ipsec.SecurityAssociation_ESP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+	Expect(errors.Is(err, vppcalls.ErrAntiReplayWindowUnsupported)).To(BeTrue())
+}
+
 func TestVppDelSA(t *testing.T) {
 	ctx, ipSecHandler, _ := ipSecTestSetup(t)
 	defer ctx.TeardownTestCtx()
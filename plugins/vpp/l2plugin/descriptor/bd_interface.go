@@ -59,12 +59,14 @@ func NewBDInterfaceDescriptor(bdIndex idxvpp.NameToIndex, bdHandler vppcalls.Bri
 // the KVScheduler.
 func (d *BDInterfaceDescriptor) GetDescriptor() *adapter.BDInterfaceDescriptor {
 	return &adapter.BDInterfaceDescriptor{
-		Name:          BDInterfaceDescriptorName,
-		KeySelector:   d.IsBDInterfaceKey,
-		ValueTypeName: string(proto.MessageName(&l2.BridgeDomain_Interface{})),
-		Create:        d.Create,
-		Delete:        d.Delete,
-		Dependencies:  d.Dependencies,
+		Name:               BDInterfaceDescriptorName,
+		KeySelector:        d.IsBDInterfaceKey,
+		ValueTypeName:      string(proto.MessageName(&l2.BridgeDomain_Interface{})),
+		Create:             d.Create,
+		Delete:             d.Delete,
+		Update:             d.Update,
+		UpdateWithRecreate: d.UpdateWithRecreate,
+		Dependencies:       d.Dependencies,
 	}
 }
 
@@ -116,6 +118,29 @@ func (d *BDInterfaceDescriptor) Delete(key string, bdIface *l2.BridgeDomain_Inte
 	return nil
 }
 
+// UpdateWithRecreate returns true if the interface is put into the bridge domain
+// with different parameters or if a feature override is reverted to inherit,
+// since the BD-wide flags are applied only when the interface is put into
+// the bridge domain.
+func (d *BDInterfaceDescriptor) UpdateWithRecreate(key string, oldBDIface, newBDIface *l2.BridgeDomain_Interface, metadata interface{}) bool {
+	if oldBDIface.SplitHorizonGroup != newBDIface.SplitHorizonGroup ||
+		oldBDIface.BridgedVirtualInterface != newBDIface.BridgedVirtualInterface {
+		return true
+	}
+	return revertedToInherit(oldBDIface.Flood, newBDIface.Flood) ||
+		revertedToInherit(oldBDIface.UnknownUnicastFlood, newBDIface.UnknownUnicastFlood) ||
+		revertedToInherit(oldBDIface.Learn, newBDIface.Learn)
+}
+
+// Update re-applies the interface feature overrides and VLAN tag rewrite.
+func (d *BDInterfaceDescriptor) Update(key string, oldBDIface, newBDIface *l2.BridgeDomain_Interface, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if err := d.bdHandler.SetBridgeDomainInterfaceFeatures(newBDIface); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
 // Dependencies lists the interface as the only dependency for the binding.
 func (d *BDInterfaceDescriptor) Dependencies(key string, value *l2.BridgeDomain_Interface) []kvs.Dependency {
 	return []kvs.Dependency{
@@ -125,3 +150,9 @@ func (d *BDInterfaceDescriptor) Dependencies(key string, value *l2.BridgeDomain_
 		},
 	}
 }
+
+// revertedToInherit returns true if the feature override changed to inherit
+// the BD-wide setting.
+func revertedToInherit(oldOverride, newOverride l2.BridgeDomain_Interface_FeatureOverride) bool {
+	return oldOverride != l2.BridgeDomain_Interface_INHERIT && newOverride == l2.BridgeDomain_Interface_INHERIT
+}
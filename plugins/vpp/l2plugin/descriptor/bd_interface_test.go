@@ -0,0 +1,120 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/descriptor"
+	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
+)
+
+func TestBDInterfaceUpdateWithRecreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		oldIface    *l2.BridgeDomain_Interface
+		newIface    *l2.BridgeDomain_Interface
+		expRecreate bool
+	}{
+		{name: "split horizon group",
+			oldIface:    &l2.BridgeDomain_Interface{Name: "if1"},
+			newIface:    &l2.BridgeDomain_Interface{Name: "if1", SplitHorizonGroup: 1},
+			expRecreate: true,
+		},
+		{name: "BVI",
+			oldIface:    &l2.BridgeDomain_Interface{Name: "if1"},
+			newIface:    &l2.BridgeDomain_Interface{Name: "if1", BridgedVirtualInterface: true},
+			expRecreate: true,
+		},
+		{name: "override reverted to inherit",
+			oldIface:    &l2.BridgeDomain_Interface{Name: "if1", Flood: l2.BridgeDomain_Interface_DISABLE},
+			newIface:    &l2.BridgeDomain_Interface{Name: "if1"},
+			expRecreate: true,
+		},
+		{name: "override set",
+			oldIface:    &l2.BridgeDomain_Interface{Name: "if1"},
+			newIface:    &l2.BridgeDomain_Interface{Name: "if1", Learn: l2.BridgeDomain_Interface_DISABLE},
+			expRecreate: false,
+		},
+		{name: "override changed",
+			oldIface:    &l2.BridgeDomain_Interface{Name: "if1", Learn: l2.BridgeDomain_Interface_ENABLE},
+			newIface:    &l2.BridgeDomain_Interface{Name: "if1", Learn: l2.BridgeDomain_Interface_DISABLE},
+			expRecreate: false,
+		},
+		{name: "VLAN tag rewrite removed",
+			oldIface: &l2.BridgeDomain_Interface{Name: "if1",
+				VlanTagRewrite: &l2.BridgeDomain_Interface_VlanTagRewrite{
+					Operation: l2.BridgeDomain_Interface_VlanTagRewrite_POP1,
+				},
+			},
+			newIface:    &l2.BridgeDomain_Interface{Name: "if1"},
+			expRecreate: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			bdIfDescriptor := descriptor.NewBDInterfaceDescriptor(nil, nil, logging.ForPlugin("l2-test"))
+			recreate := bdIfDescriptor.UpdateWithRecreate("", test.oldIface, test.newIface, nil)
+			Expect(recreate).To(Equal(test.expRecreate))
+		})
+	}
+}
+
+func TestBDInterfaceUpdate(t *testing.T) {
+	ctx, handler := l2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+	RegisterTestingT(t)
+
+	log := logging.ForPlugin("l2-test")
+	bdIndex := idxvpp.NewNameToIndex(log, "bd-index", nil)
+	bdIndex.Put("bd1", &idxvpp.OnlyIndex{Index: 5})
+	bdIfDescriptor := descriptor.NewBDInterfaceDescriptor(bdIndex, handler, log)
+
+	oldIface := &l2.BridgeDomain_Interface{
+		Name:  "if1",
+		Learn: l2.BridgeDomain_Interface_ENABLE,
+	}
+	newIface := &l2.BridgeDomain_Interface{
+		Name:  "if1",
+		Learn: l2.BridgeDomain_Interface_DISABLE,
+		VlanTagRewrite: &l2.BridgeDomain_Interface_VlanTagRewrite{
+			Operation: l2.BridgeDomain_Interface_VlanTagRewrite_POP1,
+		},
+	}
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2FlagsReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2InterfaceVlanTagRewriteReply{})
+	_, err := bdIfDescriptor.Update(l2.BDInterfaceKey("bd1", "if1"), oldIface, newIface, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	// the interface stays in the bridge domain
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	Expect(ctx.MockChannel.Msgs[0]).To(BeEquivalentTo(&vpp_l2.L2Flags{
+		SwIfIndex:     1,
+		IsSet:         false,
+		FeatureBitmap: uint32(vpp_l2.BRIDGE_API_FLAG_LEARN),
+	}))
+	Expect(ctx.MockChannel.Msgs[1]).To(BeEquivalentTo(&vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: 1,
+		VtrOp:     uint32(l2.BridgeDomain_Interface_VlanTagRewrite_POP1),
+	}))
+}
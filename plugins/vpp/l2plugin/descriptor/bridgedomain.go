@@ -49,6 +49,10 @@ var (
 	// ErrBridgeDomainWithMultipleBVI is returned when bridge domain is defined with
 	// multiple BVI interfaces.
 	ErrBridgeDomainWithMultipleBVI = errors.New("VPP bridge domain defined with mutliple BVIs")
+
+	// ErrBridgeDomainNDEntryNotIPv6 is returned when IPv6 ND termination entry
+	// is defined with non-IPv6 address.
+	ErrBridgeDomainNDEntryNotIPv6 = errors.New("VPP bridge domain ND termination entry defined without IPv6 address")
 )
 
 // BridgeDomainDescriptor teaches KVScheduler how to configure VPP bridge domains.
@@ -95,16 +99,22 @@ func (d *BridgeDomainDescriptor) GetDescriptor() *adapter.BridgeDomainDescriptor
 }
 
 // EquivalentBridgeDomains is case-insensitive comparison function for
-// l2.BridgeDomain, also ignoring the order of assigned ARP termination entries.
+// l2.BridgeDomain, also ignoring the order of assigned ARP and ND termination entries.
 func (d *BridgeDomainDescriptor) EquivalentBridgeDomains(key string, oldBD, newBD *l2.BridgeDomain) bool {
 	// BD parameters
-	if !equalBDParameters(oldBD, newBD) {
+	if !equalBDParameters(oldBD, newBD) || oldBD.MacLearnLimit != newBD.MacLearnLimit {
 		return false
 	}
 
 	// ARP termination entries
 	obsoleteARPs, newARPs := calculateARPDiff(oldBD.GetArpTerminationTable(), newBD.GetArpTerminationTable())
-	return len(obsoleteARPs) == 0 && len(newARPs) == 0
+	if len(obsoleteARPs) != 0 || len(newARPs) != 0 {
+		return false
+	}
+
+	// ND termination entries
+	obsoleteNDs, newNDs := calculateARPDiff(oldBD.GetNdTerminationTable(), newBD.GetNdTerminationTable())
+	return len(obsoleteNDs) == 0 && len(newNDs) == 0
 }
 
 // MetadataFactory is a factory for index-map customized for VPP bridge domains.
@@ -129,6 +139,14 @@ func (d *BridgeDomainDescriptor) Validate(key string, bd *l2.BridgeDomain) error
 			hasBVI = true
 		}
 	}
+
+	// ND termination entries are IPv6 only
+	for _, nd := range bd.NdTerminationTable {
+		if ip := net.ParseIP(nd.IpAddress); ip == nil || ip.To4() != nil {
+			return kvs.NewInvalidValueError(ErrBridgeDomainNDEntryNotIPv6,
+				"nd_termination_table.ip_address")
+		}
+	}
 	return nil
 }
 
@@ -146,8 +164,16 @@ func (d *BridgeDomainDescriptor) Create(key string, bd *l2.BridgeDomain) (metada
 		return nil, err
 	}
 
-	// add ARP termination entries
-	for _, arp := range bd.ArpTerminationTable {
+	// set MAC learn limit
+	if bd.MacLearnLimit != 0 {
+		if err := d.bdHandler.SetBridgeDomainMacLearnLimit(bdIdx, bd.MacLearnLimit); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	// add ARP and ND termination entries
+	for _, arp := range append(bd.ArpTerminationTable, bd.NdTerminationTable...) {
 		if err := d.bdHandler.AddArpTerminationTableEntry(bdIdx, arp.PhysAddress, arp.IpAddress); err != nil {
 			d.log.Error(err)
 			return nil, err
@@ -170,16 +196,29 @@ func (d *BridgeDomainDescriptor) Delete(key string, bd *l2.BridgeDomain, metadat
 	return err
 }
 
-// UpdateWithRecreate returns true if bridge domain base parameters are different.
+// UpdateWithRecreate returns true if bridge domain base parameters are different
+// or if the MAC learn limit should be reverted to the VPP default.
 func (d *BridgeDomainDescriptor) UpdateWithRecreate(key string, oldBD, newBD *l2.BridgeDomain, metadata *idxvpp.OnlyIndex) bool {
-	return !equalBDParameters(oldBD, newBD)
+	return !equalBDParameters(oldBD, newBD) || (oldBD.MacLearnLimit != 0 && newBD.MacLearnLimit == 0)
 }
 
-// Update is able to change ARP termination entries.
+// Update is able to change MAC learn limit and ARP and ND termination entries.
 func (d *BridgeDomainDescriptor) Update(key string, oldBD, newBD *l2.BridgeDomain, oldMetadata *idxvpp.OnlyIndex) (newMetadata *idxvpp.OnlyIndex, err error) {
-	// update ARP termination entries
 	bdIdx := oldMetadata.Index
+
+	// update MAC learn limit
+	if oldBD.MacLearnLimit != newBD.MacLearnLimit {
+		if err := d.bdHandler.SetBridgeDomainMacLearnLimit(bdIdx, newBD.MacLearnLimit); err != nil {
+			d.log.Error(err)
+			return oldMetadata, err
+		}
+	}
+
+	// update ARP and ND termination entries
 	obsoleteARPs, newARPs := calculateARPDiff(oldBD.GetArpTerminationTable(), newBD.GetArpTerminationTable())
+	obsoleteNDs, newNDs := calculateARPDiff(oldBD.GetNdTerminationTable(), newBD.GetNdTerminationTable())
+	obsoleteARPs = append(obsoleteARPs, obsoleteNDs...)
+	newARPs = append(newARPs, newNDs...)
 	for _, arp := range obsoleteARPs { // remove obsolete first to avoid collisions
 		if err := d.bdHandler.RemoveArpTerminationTableEntry(bdIdx, arp.PhysAddress, arp.IpAddress); err != nil {
 			d.log.Error(err)
@@ -204,6 +243,12 @@ func (d *BridgeDomainDescriptor) Retrieve(correlate []adapter.BridgeDomainKVWith
 	// sequence number for untagged interfaces
 	var untaggedSeq int
 
+	// expected configuration by BD name
+	expCfg := make(map[string]*l2.BridgeDomain)
+	for _, kv := range correlate {
+		expCfg[kv.Value.Name] = kv.Value
+	}
+
 	// dump bridge domains
 	bridgeDomains, err := d.bdHandler.DumpBridgeDomains()
 	if err != nil {
@@ -223,6 +268,11 @@ func (d *BridgeDomainDescriptor) Retrieve(correlate []adapter.BridgeDomainKVWith
 			untaggedSeq++
 		}
 
+		// the MAC learn limit and interface overrides cannot be dumped
+		if expBD, hasExpCfg := expCfg[bd.Bd.Name]; hasExpCfg {
+			correlateBridgeDomain(bd.Bd, expBD)
+		}
+
 		retrieved = append(retrieved, adapter.BridgeDomainKVWithMetadata{
 			Key:      l2.BridgeDomainKey(bd.Bd.Name),
 			Value:    bd.Bd,
@@ -250,6 +300,43 @@ func (d *BridgeDomainDescriptor) DerivedValues(key string, bd *l2.BridgeDomain)
 	return derValues
 }
 
+// correlateBridgeDomain copies configuration that cannot be dumped from the expected
+// bridge domain and keeps IPv6 entries where they are expected (ARP termination
+// table may also contain IPv6 entries).
+func correlateBridgeDomain(bd, expBD *l2.BridgeDomain) {
+	bd.MacLearnLimit = expBD.MacLearnLimit
+
+	expIfaces := make(map[string]*l2.BridgeDomain_Interface)
+	for _, iface := range expBD.Interfaces {
+		expIfaces[iface.Name] = iface
+	}
+	for _, iface := range bd.Interfaces {
+		if expIface, ok := expIfaces[iface.Name]; ok {
+			iface.Flood = expIface.Flood
+			iface.UnknownUnicastFlood = expIface.UnknownUnicastFlood
+			iface.Learn = expIface.Learn
+			iface.VlanTagRewrite = expIface.VlanTagRewrite
+		}
+	}
+
+	var nds []*l2.BridgeDomain_ArpTerminationEntry
+	for _, nd := range bd.NdTerminationTable {
+		var inARPTable bool
+		for _, expARP := range expBD.ArpTerminationTable {
+			if equalTerminationARPs(nd, expARP) {
+				inARPTable = true
+				break
+			}
+		}
+		if inARPTable {
+			bd.ArpTerminationTable = append(bd.ArpTerminationTable, nd)
+		} else {
+			nds = append(nds, nd)
+		}
+	}
+	bd.NdTerminationTable = nds
+}
+
 // equalBDParameters compares all base bridge domain parameters for equality.
 func equalBDParameters(bd1, bd2 *l2.BridgeDomain) bool {
 	return bd1.ArpTermination == bd2.ArpTermination && bd1.Flood == bd2.Flood &&
@@ -286,7 +373,7 @@ func calculateARPDiff(oldARPs, newARPs []*l2.BridgeDomain_ArpTerminationEntry) (
 		}
 	}
 
-	return toAdd, toRemove
+	return toRemove, toAdd
 }
 
 // equalTerminationARPs compares two termination ARP entries for equality.
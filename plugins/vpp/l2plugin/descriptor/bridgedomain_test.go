@@ -0,0 +1,132 @@
+//  Copyright (c) 2023 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
+)
+
+func l2TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.L2VppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logging.ForPlugin("l2-test")
+	ifIndexes := ifaceidx.NewIfaceIndex(log, "if-index")
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	return ctx, vpp2306.NewL2VppHandler(ctx.MockChannel, ifIndexes, nil, log)
+}
+
+// termEntryOps lists the sent ARP/ND termination entry requests.
+func termEntryOps(msgs []govppapi.Message) []string {
+	var ops []string
+	for _, msg := range msgs {
+		req, ok := msg.(*vpp_l2.BdIPMacAddDel)
+		if !ok {
+			continue
+		}
+		op := "del"
+		if req.IsAdd {
+			op = "add"
+		}
+		ops = append(ops, fmt.Sprintf("%s %s %s", op, req.Entry.IP, req.Entry.Mac))
+	}
+	return ops
+}
+
+func TestBridgeDomainUpdateTerminationTables(t *testing.T) {
+	ctx, handler := l2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+	RegisterTestingT(t)
+
+	bdDescriptor := descriptor.NewBridgeDomainDescriptor(handler, logging.ForPlugin("l2-test"))
+
+	oldBD := &l2.BridgeDomain{
+		Name: "bd1",
+		ArpTerminationTable: []*l2.BridgeDomain_ArpTerminationEntry{
+			{IpAddress: "10.0.0.1", PhysAddress: "02:00:00:00:00:01"},
+			{IpAddress: "10.0.0.2", PhysAddress: "02:00:00:00:00:aa"},
+		},
+		NdTerminationTable: []*l2.BridgeDomain_ArpTerminationEntry{
+			{IpAddress: "2001:db8::1", PhysAddress: "02:00:00:00:00:11"},
+		},
+	}
+	newBD := &l2.BridgeDomain{
+		Name: "bd1",
+		ArpTerminationTable: []*l2.BridgeDomain_ArpTerminationEntry{
+			// unchanged entry, MAC in different case
+			{IpAddress: "10.0.0.2", PhysAddress: "02:00:00:00:00:AA"},
+			{IpAddress: "10.0.0.3", PhysAddress: "02:00:00:00:00:03"},
+		},
+		NdTerminationTable: []*l2.BridgeDomain_ArpTerminationEntry{
+			{IpAddress: "2001:db8::2", PhysAddress: "02:00:00:00:00:12"},
+		},
+	}
+	Expect(bdDescriptor.UpdateWithRecreate("", oldBD, newBD, nil)).To(BeFalse())
+
+	for i := 0; i < 4; i++ {
+		ctx.MockVpp.MockReply(&vpp_l2.BdIPMacAddDelReply{})
+	}
+	_, err := bdDescriptor.Update("", oldBD, newBD, &idxvpp.OnlyIndex{Index: 5})
+	Expect(err).ToNot(HaveOccurred())
+
+	// obsolete entries are removed before the new ones are added
+	Expect(termEntryOps(ctx.MockChannel.Msgs)).To(Equal([]string{
+		"del 10.0.0.1 02:00:00:00:00:01",
+		"del 2001:db8::1 02:00:00:00:00:11",
+		"add 10.0.0.3 02:00:00:00:00:03",
+		"add 2001:db8::2 02:00:00:00:00:12",
+	}))
+}
+
+func TestEquivalentBridgeDomainsTerminationTables(t *testing.T) {
+	RegisterTestingT(t)
+
+	bdDescriptor := descriptor.NewBridgeDomainDescriptor(nil, logging.ForPlugin("l2-test"))
+
+	bd := &l2.BridgeDomain{
+		Name: "bd1",
+		ArpTerminationTable: []*l2.BridgeDomain_ArpTerminationEntry{
+			{IpAddress: "10.0.0.1", PhysAddress: "02:00:00:00:00:01"},
+			{IpAddress: "10.0.0.2", PhysAddress: "02:00:00:00:00:02"},
+		},
+	}
+	reordered := &l2.BridgeDomain{
+		Name: "bd1",
+		ArpTerminationTable: []*l2.BridgeDomain_ArpTerminationEntry{
+			{IpAddress: "10.0.0.2", PhysAddress: "02:00:00:00:00:02"},
+			{IpAddress: "10.0.0.1", PhysAddress: "02:00:00:00:00:01"},
+		},
+	}
+	Expect(bdDescriptor.EquivalentBridgeDomains("", bd, reordered)).To(BeTrue())
+
+	reduced := &l2.BridgeDomain{
+		Name:                "bd1",
+		ArpTerminationTable: bd.ArpTerminationTable[:1],
+	}
+	Expect(bdDescriptor.EquivalentBridgeDomains("", bd, reduced)).To(BeFalse())
+	Expect(bdDescriptor.EquivalentBridgeDomains("", reduced, bd)).To(BeFalse())
+}
@@ -51,13 +51,20 @@ type BridgeDomainVppAPI interface {
 	AddBridgeDomain(bdIdx uint32, bd *l2.BridgeDomain) error
 	// DeleteBridgeDomain removes existing bridge domain.
 	DeleteBridgeDomain(bdIdx uint32) error
-	// AddInterfaceToBridgeDomain puts interface into bridge domain.
+	// SetBridgeDomainMacLearnLimit sets the maximum number of MAC addresses
+	// learned in the bridge domain.
+	SetBridgeDomainMacLearnLimit(bdIdx uint32, limit uint32) error
+	// AddInterfaceToBridgeDomain puts interface into bridge domain and applies
+	// the interface feature overrides and VLAN tag rewrite.
 	AddInterfaceToBridgeDomain(bdIdx uint32, ifaceCfg *l2.BridgeDomain_Interface) error
+	// SetBridgeDomainInterfaceFeatures re-applies the interface feature overrides
+	// and VLAN tag rewrite of an interface already put into bridge domain.
+	SetBridgeDomainInterfaceFeatures(ifaceCfg *l2.BridgeDomain_Interface) error
 	// DeleteInterfaceFromBridgeDomain removes interface from bridge domain.
 	DeleteInterfaceFromBridgeDomain(bdIdx uint32, ifaceCfg *l2.BridgeDomain_Interface) error
-	// AddArpTerminationTableEntry creates ARP termination entry for bridge domain.
+	// AddArpTerminationTableEntry creates ARP (or IPv6 ND) termination entry for bridge domain.
 	AddArpTerminationTableEntry(bdID uint32, mac string, ip string) error
-	// RemoveArpTerminationTableEntry removes ARP termination entry from bridge domain.
+	// RemoveArpTerminationTableEntry removes ARP (or IPv6 ND) termination entry from bridge domain.
 	RemoveArpTerminationTableEntry(bdID uint32, mac string, ip string) error
 }
 
@@ -33,15 +33,46 @@ func (h *BridgeDomainVppHandler) AddInterfaceToBridgeDomain(bdIdx uint32, ifaceC
 	if err := h.addDelInterfaceToBridgeDomain(bdIdx, ifaceCfg, ifaceMeta.GetIndex(), true); err != nil {
 		return err
 	}
+	// BD-wide flags are applied to the interface when it is put into the bridge domain,
+	// the overrides must follow
+	if err := h.setInterfaceL2Flags(ifaceCfg, ifaceMeta.GetIndex()); err != nil {
+		return err
+	}
+	if vtr := ifaceCfg.GetVlanTagRewrite(); vtr != nil {
+		if err := h.setVlanTagRewrite(ifaceMeta.GetIndex(), vtr); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// SetBridgeDomainInterfaceFeatures re-applies the interface feature overrides
+// and VLAN tag rewrite of an interface already put into bridge domain.
+// Overrides set back to inherit are not reverted here, the BD-wide flags are
+// applied only when the interface is put into the bridge domain.
+func (h *BridgeDomainVppHandler) SetBridgeDomainInterfaceFeatures(ifaceCfg *l2.BridgeDomain_Interface) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(ifaceCfg.Name)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+	if err := h.setInterfaceL2Flags(ifaceCfg, ifaceMeta.GetIndex()); err != nil {
+		return err
+	}
+	// nil rewrite disables the rewrite
+	return h.setVlanTagRewrite(ifaceMeta.GetIndex(), ifaceCfg.GetVlanTagRewrite())
+}
+
 // DeleteInterfaceFromBridgeDomain removes interface from bridge domain.
 func (h *BridgeDomainVppHandler) DeleteInterfaceFromBridgeDomain(bdIdx uint32, ifaceCfg *l2.BridgeDomain_Interface) error {
 	ifaceMeta, found := h.ifIndexes.LookupByName(ifaceCfg.Name)
 	if !found {
 		return errors.New("failed to get interface metadata")
 	}
+	if ifaceCfg.GetVlanTagRewrite().GetOperation() != l2.BridgeDomain_Interface_VlanTagRewrite_DISABLED {
+		if err := h.setVlanTagRewrite(ifaceMeta.GetIndex(), nil); err != nil {
+			return err
+		}
+	}
 	if err := h.addDelInterfaceToBridgeDomain(bdIdx, ifaceCfg, ifaceMeta.GetIndex(), false); err != nil {
 		return err
 	}
@@ -68,3 +99,65 @@ func (h *BridgeDomainVppHandler) addDelInterfaceToBridgeDomain(bdIdx uint32, ifa
 
 	return nil
 }
+
+// setInterfaceL2Flags overrides BD-wide flooding and learning for the interface.
+func (h *BridgeDomainVppHandler) setInterfaceL2Flags(ifaceCfg *l2.BridgeDomain_Interface, ifIdx uint32) error {
+	var enable, disable uint32
+	for flag, override := range map[vpp_l2.BdFlags]l2.BridgeDomain_Interface_FeatureOverride{
+		vpp_l2.BRIDGE_API_FLAG_FLOOD:    ifaceCfg.GetFlood(),
+		vpp_l2.BRIDGE_API_FLAG_UU_FLOOD: ifaceCfg.GetUnknownUnicastFlood(),
+		vpp_l2.BRIDGE_API_FLAG_LEARN:    ifaceCfg.GetLearn(),
+	} {
+		switch override {
+		case l2.BridgeDomain_Interface_ENABLE:
+			enable |= uint32(flag)
+		case l2.BridgeDomain_Interface_DISABLE:
+			disable |= uint32(flag)
+		}
+	}
+	if enable != 0 {
+		if err := h.callL2Flags(ifIdx, enable, true); err != nil {
+			return err
+		}
+	}
+	if disable != 0 {
+		if err := h.callL2Flags(ifIdx, disable, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *BridgeDomainVppHandler) callL2Flags(ifIdx uint32, flags uint32, isSet bool) error {
+	req := &vpp_l2.L2Flags{
+		SwIfIndex:     interface_types.InterfaceIndex(ifIdx),
+		IsSet:         isSet,
+		FeatureBitmap: flags,
+	}
+	reply := &vpp_l2.L2FlagsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("%s returned error: %v", reply.GetMessageName(), err)
+	}
+	return nil
+}
+
+// setVlanTagRewrite configures VLAN tag rewrite on the bridge domain port
+// (nil disables the rewrite).
+func (h *BridgeDomainVppHandler) setVlanTagRewrite(ifIdx uint32, vtr *l2.BridgeDomain_Interface_VlanTagRewrite) error {
+	req := &vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+		VtrOp:     uint32(vtr.GetOperation()),
+		Tag1:      vtr.GetTag1(),
+		Tag2:      vtr.GetTag2(),
+	}
+	if vtr.GetPushDot1Q() {
+		req.PushDot1q = 1
+	}
+	reply := &vpp_l2.L2InterfaceVlanTagRewriteReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("%s returned error: %v", reply.GetMessageName(), err)
+	}
+	return nil
+}
@@ -55,3 +55,18 @@ func (h *BridgeDomainVppHandler) DeleteBridgeDomain(bdIdx uint32) error {
 
 	return nil
 }
+
+// SetBridgeDomainMacLearnLimit sets the maximum number of learned MAC addresses.
+func (h *BridgeDomainVppHandler) SetBridgeDomainMacLearnLimit(bdIdx uint32, limit uint32) error {
+	req := &vpp_l2.BridgeDomainSetLearnLimit{
+		BdID:       bdIdx,
+		LearnLimit: limit,
+	}
+	reply := &vpp_l2.BridgeDomainSetLearnLimitReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
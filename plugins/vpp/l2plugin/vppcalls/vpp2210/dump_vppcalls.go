@@ -90,10 +90,13 @@ func (h *BridgeDomainVppHandler) DumpBridgeDomains() ([]*vppcalls.BridgeDomainDe
 			})
 		}
 
-		// Add ARP termination entries.
-		arpTable, ok := bdArpTab[bdDetails.BdID]
-		if ok {
-			bdData.Bd.ArpTerminationTable = arpTable
+		// Add ARP and IPv6 ND termination entries.
+		for _, entry := range bdArpTab[bdDetails.BdID] {
+			if ip := net.ParseIP(entry.IpAddress); ip != nil && ip.To4() == nil {
+				bdData.Bd.NdTerminationTable = append(bdData.Bd.NdTerminationTable, entry)
+			} else {
+				bdData.Bd.ArpTerminationTable = append(bdData.Bd.ArpTerminationTable, entry)
+			}
 		}
 
 		bds = append(bds, bdData)
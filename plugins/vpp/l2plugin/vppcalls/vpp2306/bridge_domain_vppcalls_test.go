@@ -113,6 +113,20 @@ func TestVppDeleteBridgeDomainError(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 }
 
+func TestVppSetBridgeDomainMacLearnLimit(t *testing.T) {
+	ctx, bdHandler, _ := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_l2.BridgeDomainSetLearnLimitReply{})
+	err := bdHandler.SetBridgeDomainMacLearnLimit(dummyBridgeDomain, 1000)
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_l2.BridgeDomainSetLearnLimit{
+		BdID:       dummyBridgeDomain,
+		LearnLimit: 1000,
+	}))
+}
+
 func bdTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.BridgeDomainVppAPI, ifaceidx.IfaceMetadataIndexRW) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
@@ -176,6 +176,48 @@ func TestDumpBridgeDomainsWithARP(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 }
 
+// TestDumpBridgeDomainsWithND tests DumpBridgeDomains method with IPv6 termination entry
+func TestDumpBridgeDomainsWithND(t *testing.T) {
+	ctx, bdHandler, _ := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockReplies([]*vppmock.HandleReplies{
+		{
+			Name: (&vpp_l2.BdIPMacDump{}).GetMessageName(),
+			Ping: true,
+			Message: &vpp_l2.BdIPMacDetails{
+				Entry: vpp_l2.BdIPMac{
+					BdID: 6,
+					IP: ip_types.Address{
+						Af: ip_types.ADDRESS_IP6,
+						Un: ip_types.AddressUnionIP6(
+							ip_types.IP6Address{0x20, 0x01, 0x0d, 0xb8, 15: 0x01},
+						),
+					},
+					Mac: ethernet_types.MacAddress{0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA},
+				},
+			},
+		},
+		{
+			Name:    (&vpp_l2.BridgeDomainDump{}).GetMessageName(),
+			Ping:    true,
+			Message: &vpp_l2.BridgeDomainDetails{BdID: 6, ArpTerm: true},
+		},
+	})
+
+	bridgeDomains, err := bdHandler.DumpBridgeDomains()
+
+	Expect(err).To(BeNil())
+	Expect(bridgeDomains).To(HaveLen(1))
+	Expect(bridgeDomains[0].Bd.ArpTerminationTable).To(BeEmpty())
+	Expect(bridgeDomains[0].Bd.NdTerminationTable).To(Equal([]*l2.BridgeDomain_ArpTerminationEntry{
+		{
+			IpAddress:   "2001:db8::1",
+			PhysAddress: "aa:aa:aa:aa:aa:aa",
+		},
+	}))
+}
+
 var testDataInMessagesFIBs = []govppapi.Message{
 	&vpp_l2.L2FibTableDetails{
 		BdID:   10,
@@ -50,6 +50,87 @@ func TestAddInterfaceToBridgeDomain(t *testing.T) {
 	}))
 }
 
+func TestAddInterfaceToBridgeDomainWithOverrides(t *testing.T) {
+	ctx, bdHandler, ifaceIdx := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifaceIdx.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_l2.SwInterfaceSetL2BridgeReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2FlagsReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2FlagsReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2InterfaceVlanTagRewriteReply{})
+	err := bdHandler.AddInterfaceToBridgeDomain(1, &l2.BridgeDomain_Interface{
+		Name:                "if1",
+		Flood:               l2.BridgeDomain_Interface_ENABLE,
+		UnknownUnicastFlood: l2.BridgeDomain_Interface_DISABLE,
+		Learn:               l2.BridgeDomain_Interface_DISABLE,
+		VlanTagRewrite: &l2.BridgeDomain_Interface_VlanTagRewrite{
+			Operation: l2.BridgeDomain_Interface_VlanTagRewrite_PUSH1,
+			PushDot1Q: true,
+			Tag1:      100,
+		},
+	})
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(4))
+	Expect(ctx.MockChannel.Msgs[1]).To(BeEquivalentTo(&vpp_l2.L2Flags{
+		SwIfIndex:     1,
+		IsSet:         true,
+		FeatureBitmap: uint32(vpp_l2.BRIDGE_API_FLAG_FLOOD),
+	}))
+	Expect(ctx.MockChannel.Msgs[2]).To(BeEquivalentTo(&vpp_l2.L2Flags{
+		SwIfIndex:     1,
+		IsSet:         false,
+		FeatureBitmap: uint32(vpp_l2.BRIDGE_API_FLAG_UU_FLOOD | vpp_l2.BRIDGE_API_FLAG_LEARN),
+	}))
+	Expect(ctx.MockChannel.Msgs[3]).To(BeEquivalentTo(&vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: 1,
+		VtrOp:     1,
+		PushDot1q: 1,
+		Tag1:      100,
+	}))
+}
+
+func TestSetBridgeDomainInterfaceFeatures(t *testing.T) {
+	ctx, bdHandler, ifaceIdx := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifaceIdx.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2FlagsReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2InterfaceVlanTagRewriteReply{})
+	err := bdHandler.SetBridgeDomainInterfaceFeatures(&l2.BridgeDomain_Interface{
+		Name:  "if1",
+		Learn: l2.BridgeDomain_Interface_DISABLE,
+	})
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	Expect(ctx.MockChannel.Msgs[0]).To(BeEquivalentTo(&vpp_l2.L2Flags{
+		SwIfIndex:     1,
+		IsSet:         false,
+		FeatureBitmap: uint32(vpp_l2.BRIDGE_API_FLAG_LEARN),
+	}))
+	// removed rewrite is disabled
+	Expect(ctx.MockChannel.Msgs[1]).To(BeEquivalentTo(&vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: 1,
+	}))
+}
+
+func TestSetMissingBridgeDomainInterfaceFeatures(t *testing.T) {
+	ctx, bdHandler, _ := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := bdHandler.SetBridgeDomainInterfaceFeatures(&l2.BridgeDomain_Interface{
+		Name:  "if1",
+		Learn: l2.BridgeDomain_Interface_DISABLE,
+	})
+
+	Expect(err).Should(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
+}
+
 func TestAddMissingInterfaceToBridgeDomain(t *testing.T) {
 	ctx, bdHandler, _ := bdTestSetup(t)
 	defer ctx.TeardownTestCtx()
@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	vpp_ip_neighbor "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_neighbor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+func TestArpDescriptorConformance(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	log := logrus.NewLogger("test-log")
+	handler := vpp2306.NewArpVppHandler(ctx.MockChannel, testIfIndexes(), log)
+
+	suite := &descriptortest.Suite{
+		Descriptor: descriptor.NewArpDescriptor(nil, handler, log),
+		Southbound: vppmock.NewSouthbound(ctx, arpReplies),
+		Samples: []descriptortest.Sample{
+			{
+				Value: &l3.ARPEntry{
+					Interface:   "if1",
+					IpAddress:   "10.0.0.2",
+					PhysAddress: "02:00:00:00:00:01",
+					Static:      true,
+				},
+			},
+			{
+				Value: &l3.ARPEntry{
+					Interface:   "if2",
+					IpAddress:   "2001:db8::2",
+					PhysAddress: "02:00:00:00:00:02",
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
+
+// arpReplies replays ARP entries added/deleted via the mocked channel
+// and builds the reply for the neighbor dump. Note that the same reply is
+// returned for both the IPv4 and the IPv6 dump.
+func arpReplies(sent []govppapi.Message) []*vppmock.HandleReplies {
+	var arps []*vpp_ip_neighbor.IPNeighborAddDel
+	for _, msg := range sent {
+		req, ok := msg.(*vpp_ip_neighbor.IPNeighborAddDel)
+		if !ok {
+			continue
+		}
+		for i, arp := range arps {
+			if arp.Neighbor.SwIfIndex == req.Neighbor.SwIfIndex &&
+				arp.Neighbor.IPAddress == req.Neighbor.IPAddress {
+				arps = append(arps[:i], arps[i+1:]...)
+				break
+			}
+		}
+		if req.IsAdd {
+			arps = append(arps, req)
+		}
+	}
+	replies := []*vppmock.HandleReplies{
+		{
+			Name:    (&vpp_ip_neighbor.IPNeighborAddDel{}).GetMessageName(),
+			Message: &vpp_ip_neighbor.IPNeighborAddDelReply{},
+		},
+	}
+	if len(arps) == 0 {
+		// empty dump is answered by control ping only
+		return replies
+	}
+	var details []govppapi.Message
+	for _, arp := range arps {
+		details = append(details, &vpp_ip_neighbor.IPNeighborDetails{Neighbor: arp.Neighbor})
+	}
+	return append(replies, &vppmock.HandleReplies{
+		Name:     (&vpp_ip_neighbor.IPNeighborDump{}).GetMessageName(),
+		Ping:     true,
+		Messages: details,
+	})
+}
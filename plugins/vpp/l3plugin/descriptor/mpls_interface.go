@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MplsInterfaceDescriptorName is the name of the descriptor for MPLS-enabled interfaces.
+	MplsInterfaceDescriptorName = "vpp-mpls-interface"
+
+	// dependency labels
+	mplsInterfaceDep    = "interface-exists"
+	mplsDefaultTableDep = "default-mpls-table-exists"
+	mplsDefaultTableID  = 0
+)
+
+// MplsInterfaceDescriptor teaches KVScheduler how to enable MPLS on VPP interfaces.
+type MplsInterfaceDescriptor struct {
+	log         logging.Logger
+	mplsHandler vppcalls.MplsVppAPI
+}
+
+// NewMplsInterfaceDescriptor creates a new instance of the MplsInterface descriptor.
+func NewMplsInterfaceDescriptor(
+	mplsHandler vppcalls.MplsVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &MplsInterfaceDescriptor{
+		mplsHandler: mplsHandler,
+		log:         log.NewLogger("mpls-interface-descriptor"),
+	}
+	typedDescr := &adapter.MplsInterfaceDescriptor{
+		Name:          MplsInterfaceDescriptorName,
+		NBKeyPrefix:   l3.ModelMplsInterface.KeyPrefix(),
+		ValueTypeName: l3.ModelMplsInterface.ProtoName(),
+		KeySelector:   l3.ModelMplsInterface.IsKeyValid,
+		KeyLabel:      l3.ModelMplsInterface.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewMplsInterfaceDescriptor(typedDescr)
+}
+
+// Validate validates MPLS interface configuration.
+func (d *MplsInterfaceDescriptor) Validate(key string, mplsIf *l3.MplsInterface) error {
+	if mplsIf.Interface == "" {
+		return kvs.NewInvalidValueError(errors.New("no interface defined"), "interface")
+	}
+	return nil
+}
+
+// Create enables MPLS on the interface.
+func (d *MplsInterfaceDescriptor) Create(key string, mplsIf *l3.MplsInterface) (metadata interface{}, err error) {
+	return nil, d.mplsHandler.EnableMplsInterface(mplsIf.Interface)
+}
+
+// Delete disables MPLS on the interface.
+func (d *MplsInterfaceDescriptor) Delete(key string, mplsIf *l3.MplsInterface, metadata interface{}) error {
+	return d.mplsHandler.DisableMplsInterface(mplsIf.Interface)
+}
+
+// Dependencies lists the interface and the default MPLS table as dependencies.
+func (d *MplsInterfaceDescriptor) Dependencies(key string, mplsIf *l3.MplsInterface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: mplsInterfaceDep,
+			Key:   interfaces.InterfaceKey(mplsIf.Interface),
+		},
+		{
+			// VPP refuses to enable MPLS on interface without the default MPLS table
+			Label: mplsDefaultTableDep,
+			Key:   l3.MplsTableKey(mplsDefaultTableID),
+		},
+	}
+}
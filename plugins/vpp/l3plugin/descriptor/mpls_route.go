@@ -0,0 +1,324 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MplsRouteDescriptorName is the name of the descriptor for MPLS label routes.
+	MplsRouteDescriptorName = "vpp-mpls-route"
+
+	// dependency labels
+	mplsRouteTableDep        = "mpls-table-exists"
+	mplsRouteOutInterfaceDep = "outgoing-interface-exists"
+	mplsRouteViaTableDep     = "via-table-exists"
+
+	// maximum value of MPLS label (20 bits)
+	mplsLabelMax = 1<<20 - 1
+	// maximum number of labels imposed by a single path
+	mplsLabelStackLimit = 16
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrMplsRouteWithoutPaths is returned when MPLS route has no paths defined.
+	ErrMplsRouteWithoutPaths = errors.New("MPLS route has no paths defined")
+	// ErrMplsLabelOutOfRange is returned when MPLS label does not fit into 20 bits.
+	ErrMplsLabelOutOfRange = errors.New("MPLS label is out of range (0-1048575)")
+	// ErrMplsLabelStackTooLong is returned when too many labels are imposed by a single path.
+	ErrMplsLabelStackTooLong = errors.New("MPLS label stack exceeds the limit (16 labels)")
+	// ErrMplsPathWithoutNextHop is returned when normal MPLS path has neither next hop
+	// nor outgoing interface defined.
+	ErrMplsPathWithoutNextHop = errors.New("MPLS path has neither next hop nor outgoing interface defined")
+	// ErrMplsPathInvalidNextHop is returned when next hop address of MPLS path is not a valid IP.
+	ErrMplsPathInvalidNextHop = errors.New("MPLS path has invalid next hop address")
+	// ErrMplsPathUnexpectedLabels is returned when out labels are defined for LOOKUP or DROP path.
+	ErrMplsPathUnexpectedLabels = errors.New("out labels are only allowed for NORMAL MPLS path")
+)
+
+// MplsRouteDescriptor teaches KVScheduler how to configure VPP MPLS label routes.
+type MplsRouteDescriptor struct {
+	log         logging.Logger
+	mplsHandler vppcalls.MplsVppAPI
+}
+
+// NewMplsRouteDescriptor creates a new instance of the MplsRoute descriptor.
+func NewMplsRouteDescriptor(
+	mplsHandler vppcalls.MplsVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &MplsRouteDescriptor{
+		mplsHandler: mplsHandler,
+		log:         log.NewLogger("mpls-route-descriptor"),
+	}
+	typedDescr := &adapter.MplsRouteDescriptor{
+		Name:               MplsRouteDescriptorName,
+		NBKeyPrefix:        l3.ModelMplsRoute.KeyPrefix(),
+		ValueTypeName:      l3.ModelMplsRoute.ProtoName(),
+		KeySelector:        l3.ModelMplsRoute.IsKeyValid,
+		KeyLabel:           l3.ModelMplsRoute.StripKeyPrefix,
+		ValueComparator:    ctx.EquivalentMplsRoutes,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Delete:             ctx.Delete,
+		Retrieve:           ctx.Retrieve,
+		Dependencies:       ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			VrfTableDescriptorName,
+			MplsTableDescriptorName},
+	}
+	return adapter.NewMplsRouteDescriptor(typedDescr)
+}
+
+// EquivalentMplsRoutes compares MPLS routes, the order of paths is not significant.
+func (d *MplsRouteDescriptor) EquivalentMplsRoutes(key string, oldRoute, newRoute *l3.MplsRoute) bool {
+	if oldRoute.GetTableId() != newRoute.GetTableId() ||
+		oldRoute.GetLabel() != newRoute.GetLabel() ||
+		oldRoute.GetEos() != newRoute.GetEos() ||
+		getMplsPayloadProtocol(oldRoute) != getMplsPayloadProtocol(newRoute) ||
+		len(oldRoute.GetPaths()) != len(newRoute.GetPaths()) {
+		return false
+	}
+	matched := make([]bool, len(newRoute.GetPaths()))
+	for _, oldPath := range oldRoute.GetPaths() {
+		var found bool
+		for i, newPath := range newRoute.GetPaths() {
+			if !matched[i] && equivalentMplsPaths(oldPath, newPath) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates MPLS route configuration.
+func (d *MplsRouteDescriptor) Validate(key string, route *l3.MplsRoute) error {
+	if route.Label > mplsLabelMax {
+		return kvs.NewInvalidValueError(ErrMplsLabelOutOfRange, "label")
+	}
+	if len(route.Paths) == 0 {
+		return kvs.NewInvalidValueError(ErrMplsRouteWithoutPaths, "paths")
+	}
+	for _, path := range route.Paths {
+		if path.Type != l3.MplsPath_NORMAL {
+			if len(path.OutLabels) > 0 {
+				return kvs.NewInvalidValueError(ErrMplsPathUnexpectedLabels, "paths.out_labels")
+			}
+			continue
+		}
+		if path.NextHopAddr == "" && path.OutgoingInterface == "" {
+			return kvs.NewInvalidValueError(ErrMplsPathWithoutNextHop,
+				"paths.next_hop_addr", "paths.outgoing_interface")
+		}
+		if path.NextHopAddr != "" && net.ParseIP(path.NextHopAddr) == nil {
+			return kvs.NewInvalidValueError(ErrMplsPathInvalidNextHop, "paths.next_hop_addr")
+		}
+		if err := validateMplsLabelStack(path.OutLabels); err != nil {
+			return kvs.NewInvalidValueError(err, "paths.out_labels")
+		}
+	}
+	return nil
+}
+
+// Create adds VPP MPLS route.
+func (d *MplsRouteDescriptor) Create(key string, route *l3.MplsRoute) (metadata interface{}, err error) {
+	return nil, d.mplsHandler.VppAddMplsRoute(context.TODO(), route)
+}
+
+// Update replaces the set of paths of VPP MPLS route in one step.
+func (d *MplsRouteDescriptor) Update(key string, oldRoute, newRoute *l3.MplsRoute, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	return nil, d.mplsHandler.VppAddMplsRoute(context.TODO(), newRoute)
+}
+
+// UpdateWithRecreate returns true if the payload protocol has changed.
+func (d *MplsRouteDescriptor) UpdateWithRecreate(key string, oldRoute, newRoute *l3.MplsRoute, metadata interface{}) bool {
+	return getMplsPayloadProtocol(oldRoute) != getMplsPayloadProtocol(newRoute)
+}
+
+// Delete removes VPP MPLS route.
+func (d *MplsRouteDescriptor) Delete(key string, route *l3.MplsRoute, metadata interface{}) error {
+	return d.mplsHandler.VppDelMplsRoute(context.TODO(), route)
+}
+
+// Retrieve returns all MPLS routes configured in VPP.
+func (d *MplsRouteDescriptor) Retrieve(correlate []adapter.MplsRouteKVWithMetadata) (
+	retrieved []adapter.MplsRouteKVWithMetadata, err error,
+) {
+	nbCfg := make(map[string]*l3.MplsRoute)
+	for _, kv := range correlate {
+		nbCfg[kv.Key] = kv.Value
+	}
+
+	routes, err := d.mplsHandler.DumpMplsRoutes()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP MPLS routes: %v", err)
+	}
+
+	for _, route := range routes {
+		key := models.Key(route)
+		value := route
+		origin := kvs.UnknownOrigin
+
+		// correlate with the expected configuration
+		if nbRoute, hasNbCfg := nbCfg[key]; hasNbCfg {
+			if d.EquivalentMplsRoutes(key, route, nbRoute) {
+				value = nbRoute
+				origin = kvs.FromNB
+			}
+		}
+
+		retrieved = append(retrieved, adapter.MplsRouteKVWithMetadata{
+			Key:    key,
+			Value:  value,
+			Origin: origin,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the MPLS table, the outgoing interfaces and the tables
+// used for lookup as dependencies of the MPLS route.
+func (d *MplsRouteDescriptor) Dependencies(key string, route *l3.MplsRoute) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: mplsRouteTableDep,
+		Key:   l3.MplsTableKey(route.TableId),
+	})
+	for _, path := range route.Paths {
+		switch path.Type {
+		case l3.MplsPath_NORMAL:
+			if path.OutgoingInterface != "" {
+				deps = append(deps, kvs.Dependency{
+					Label: mplsRouteOutInterfaceDep + "-" + path.OutgoingInterface,
+					Key:   interfaces.InterfaceKey(path.OutgoingInterface),
+				})
+			}
+		case l3.MplsPath_LOOKUP:
+			if viaTableKey := getMplsLookupTableKey(route, path); viaTableKey != "" {
+				deps = append(deps, kvs.Dependency{
+					Label: mplsRouteViaTableDep + "-" + viaTableKey,
+					Key:   viaTableKey,
+				})
+			}
+		}
+	}
+	return deps
+}
+
+// getMplsLookupTableKey returns key of the table used for lookup by the given path
+// or empty string if the table is created implicitly by VPP.
+func getMplsLookupTableKey(route *l3.MplsRoute, path *l3.MplsPath) string {
+	if !route.Eos || route.PayloadProtocol == l3.MplsRoute_MPLS {
+		if path.ViaVrfId == route.TableId {
+			return ""
+		}
+		return l3.MplsTableKey(path.ViaVrfId)
+	}
+	if path.ViaVrfId == 0 {
+		return ""
+	}
+	switch route.PayloadProtocol {
+	case l3.MplsRoute_IPV4:
+		return l3.VrfTableKey(path.ViaVrfId, l3.VrfTable_IPV4)
+	case l3.MplsRoute_IPV6:
+		return l3.VrfTableKey(path.ViaVrfId, l3.VrfTable_IPV6)
+	}
+	return ""
+}
+
+// getMplsPayloadProtocol returns payload protocol, which is relevant
+// only for end-of-stack entries.
+func getMplsPayloadProtocol(route *l3.MplsRoute) l3.MplsRoute_PayloadProtocol {
+	if !route.GetEos() {
+		return l3.MplsRoute_IPV4
+	}
+	return route.GetPayloadProtocol()
+}
+
+// equivalentMplsPaths compares two MPLS paths, undefined weight is considered
+// to be equal to the default weight.
+func equivalentMplsPaths(oldPath, newPath *l3.MplsPath) bool {
+	if oldPath.GetType() != newPath.GetType() ||
+		getMplsPathWeight(oldPath) != getMplsPathWeight(newPath) ||
+		oldPath.GetPreference() != newPath.GetPreference() {
+		return false
+	}
+	switch oldPath.GetType() {
+	case l3.MplsPath_LOOKUP:
+		return oldPath.GetViaVrfId() == newPath.GetViaVrfId()
+	case l3.MplsPath_DROP:
+		return true
+	}
+	if oldPath.GetOutgoingInterface() != newPath.GetOutgoingInterface() ||
+		!equalAddrs(oldPath.GetNextHopAddr(), newPath.GetNextHopAddr()) {
+		return false
+	}
+	return equalMplsLabelStacks(oldPath.GetOutLabels(), newPath.GetOutLabels())
+}
+
+func getMplsPathWeight(path *l3.MplsPath) uint32 {
+	if path.GetWeight() == 0 {
+		return defaultWeight
+	}
+	return path.GetWeight()
+}
+
+// validateMplsLabelStack validates labels imposed by a single path.
+func validateMplsLabelStack(labels []*l3.MplsLabel) error {
+	if len(labels) > mplsLabelStackLimit {
+		return ErrMplsLabelStackTooLong
+	}
+	for _, label := range labels {
+		if label.GetLabel() > mplsLabelMax {
+			return ErrMplsLabelOutOfRange
+		}
+	}
+	return nil
+}
+
+// equalMplsLabelStacks compares two label stacks (the order is significant).
+func equalMplsLabelStacks(labels1, labels2 []*l3.MplsLabel) bool {
+	if len(labels1) != len(labels2) {
+		return false
+	}
+	for i := range labels1 {
+		if !proto.Equal(labels1[i], labels2[i]) {
+			return false
+		}
+	}
+	return true
+}
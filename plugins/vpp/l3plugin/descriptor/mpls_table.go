@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MplsTableDescriptorName is the name of the descriptor for MPLS tables.
+	MplsTableDescriptorName = "vpp-mpls-table"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrMplsTableLabelTooLong is returned when MPLS table label exceeds the length limit.
+	ErrMplsTableLabelTooLong = errors.New("VPP MPLS table label exceeds the length limit (63 characters)")
+)
+
+// MplsTableDescriptor teaches KVScheduler how to configure VPP MPLS tables.
+type MplsTableDescriptor struct {
+	log         logging.Logger
+	mplsHandler vppcalls.MplsVppAPI
+}
+
+// NewMplsTableDescriptor creates a new instance of the MplsTable descriptor.
+func NewMplsTableDescriptor(
+	mplsHandler vppcalls.MplsVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &MplsTableDescriptor{
+		mplsHandler: mplsHandler,
+		log:         log.NewLogger("mpls-table-descriptor"),
+	}
+	typedDescr := &adapter.MplsTableDescriptor{
+		Name:            MplsTableDescriptorName,
+		NBKeyPrefix:     l3.ModelMplsTable.KeyPrefix(),
+		ValueTypeName:   l3.ModelMplsTable.ProtoName(),
+		KeySelector:     l3.ModelMplsTable.IsKeyValid,
+		KeyLabel:        l3.ModelMplsTable.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentMplsTables,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+	}
+	return adapter.NewMplsTableDescriptor(typedDescr)
+}
+
+// EquivalentMplsTables is a comparison function for l3.MplsTable.
+func (d *MplsTableDescriptor) EquivalentMplsTables(key string, oldTable, newTable *l3.MplsTable) bool {
+	return getMplsTableLabel(oldTable) == getMplsTableLabel(newTable)
+}
+
+// Validate validates configuration of VPP MPLS table.
+func (d *MplsTableDescriptor) Validate(key string, table *l3.MplsTable) error {
+	if len(table.Label) > labelLengthLimit {
+		return kvs.NewInvalidValueError(ErrMplsTableLabelTooLong, "label")
+	}
+	return nil
+}
+
+// Create adds VPP MPLS table.
+func (d *MplsTableDescriptor) Create(key string, table *l3.MplsTable) (metadata interface{}, err error) {
+	return nil, d.mplsHandler.AddMplsTable(table)
+}
+
+// Delete removes VPP MPLS table.
+func (d *MplsTableDescriptor) Delete(key string, table *l3.MplsTable, metadata interface{}) error {
+	return d.mplsHandler.DelMplsTable(table)
+}
+
+// Retrieve returns all configured VPP MPLS tables.
+func (d *MplsTableDescriptor) Retrieve(correlate []adapter.MplsTableKVWithMetadata) (
+	retrieved []adapter.MplsTableKVWithMetadata, err error,
+) {
+	tables, err := d.mplsHandler.DumpMplsTables()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP MPLS tables: %v", err)
+	}
+
+	for _, table := range tables {
+		// unlike IP VRF 0, the default MPLS table does not exist
+		// until created, therefore all tables are considered to be from NB
+		retrieved = append(retrieved, adapter.MplsTableKVWithMetadata{
+			Key:    l3.MplsTableKey(table.Id),
+			Value:  table,
+			Origin: kvs.FromNB,
+		})
+	}
+
+	return retrieved, nil
+}
+
+func getMplsTableLabel(table *l3.MplsTable) string {
+	if table.Label == "" {
+		// label generated by VPP
+		return fmt.Sprintf("MPLS-VRF:%d", table.Id)
+	}
+	return table.Label
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MRouteDescriptorName is the name of the descriptor for IP multicast routes.
+	MRouteDescriptorName = "vpp-mroute"
+
+	// dependency labels
+	mrouteInterfaceDep = "interface-exists"
+	mrouteVrfTableDep  = "vrf-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrMRouteWithoutInterfaces is returned when IP multicast route has no interfaces defined.
+	ErrMRouteWithoutInterfaces = errors.New("multicast route has no interfaces defined")
+	// ErrMRouteInvalidGroup is returned when group address is not a valid multicast address.
+	ErrMRouteInvalidGroup = errors.New("group address is not a valid multicast address or prefix")
+	// ErrMRouteInvalidSource is returned when source address is not a valid unicast address
+	// of the same IP version as the group.
+	ErrMRouteInvalidSource = errors.New("source address is not a valid unicast address of the group IP version")
+	// ErrMRouteGroupPrefixWithSource is returned when (S,G) route is defined with a group prefix.
+	ErrMRouteGroupPrefixWithSource = errors.New("group prefix cannot be combined with source address")
+	// ErrMRouteInterfaceWithoutFlags is returned when interface neither accepts nor forwards.
+	ErrMRouteInterfaceWithoutFlags = errors.New("multicast route interface must accept or forward traffic")
+	// ErrMRouteDuplicateInterface is returned when interface is listed more than once.
+	ErrMRouteDuplicateInterface = errors.New("multicast route interface is defined more than once")
+)
+
+// MRouteDescriptor teaches KVScheduler how to configure VPP IP multicast routes.
+type MRouteDescriptor struct {
+	log           logging.Logger
+	mrouteHandler vppcalls.MRouteVppAPI
+}
+
+// NewMRouteDescriptor creates a new instance of the MRoute descriptor.
+func NewMRouteDescriptor(
+	mrouteHandler vppcalls.MRouteVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &MRouteDescriptor{
+		mrouteHandler: mrouteHandler,
+		log:           log.NewLogger("mroute-descriptor"),
+	}
+	typedDescr := &adapter.MRouteDescriptor{
+		Name:            MRouteDescriptorName,
+		NBKeyPrefix:     l3.ModelMRoute.KeyPrefix(),
+		ValueTypeName:   l3.ModelMRoute.ProtoName(),
+		KeySelector:     l3.ModelMRoute.IsKeyValid,
+		KeyLabel:        l3.ModelMRoute.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentMRoutes,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Update:          ctx.Update,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+		Dependencies:    ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			VrfTableDescriptorName},
+	}
+	return adapter.NewMRouteDescriptor(typedDescr)
+}
+
+// EquivalentMRoutes compares IP multicast routes, the order of interfaces
+// is not significant.
+func (d *MRouteDescriptor) EquivalentMRoutes(key string, oldRoute, newRoute *l3.MRoute) bool {
+	if oldRoute.GetVrfId() != newRoute.GetVrfId() ||
+		oldRoute.GetSignal() != newRoute.GetSignal() ||
+		oldRoute.GetDrop() != newRoute.GetDrop() ||
+		oldRoute.GetConnected() != newRoute.GetConnected() ||
+		oldRoute.GetAcceptAllInterfaces() != newRoute.GetAcceptAllInterfaces() ||
+		!equalAddrs(oldRoute.GetSourceAddress(), newRoute.GetSourceAddress()) ||
+		len(oldRoute.GetInterfaces()) != len(newRoute.GetInterfaces()) {
+		return false
+	}
+	if strings.Contains(oldRoute.GetGroupAddress(), "/") {
+		if !equalNetworks(oldRoute.GetGroupAddress(), newRoute.GetGroupAddress()) {
+			return false
+		}
+	} else if !equalAddrs(oldRoute.GetGroupAddress(), newRoute.GetGroupAddress()) {
+		return false
+	}
+	oldIfaces := mrouteInterfacesByName(oldRoute)
+	for _, newIface := range newRoute.GetInterfaces() {
+		oldIface, found := oldIfaces[newIface.GetName()]
+		if !found ||
+			oldIface.GetAccept() != newIface.GetAccept() ||
+			oldIface.GetForward() != newIface.GetForward() {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates IP multicast route configuration.
+func (d *MRouteDescriptor) Validate(key string, route *l3.MRoute) error {
+	var group net.IP
+	if strings.Contains(route.GroupAddress, "/") {
+		_, groupNet, err := net.ParseCIDR(route.GroupAddress)
+		if err != nil {
+			return kvs.NewInvalidValueError(ErrMRouteInvalidGroup, "group_address")
+		}
+		// host prefix is represented by the address alone (as dumped from VPP)
+		if ones, bits := groupNet.Mask.Size(); ones == bits || groupNet.String() != route.GroupAddress {
+			return kvs.NewInvalidValueError(ErrMRouteInvalidGroup, "group_address")
+		}
+		if route.SourceAddress != "" {
+			return kvs.NewInvalidValueError(ErrMRouteGroupPrefixWithSource,
+				"group_address", "source_address")
+		}
+		group = groupNet.IP
+	} else {
+		group = net.ParseIP(route.GroupAddress)
+	}
+	if group == nil || !group.IsMulticast() {
+		return kvs.NewInvalidValueError(ErrMRouteInvalidGroup, "group_address")
+	}
+
+	if route.SourceAddress != "" {
+		source := net.ParseIP(route.SourceAddress)
+		if source == nil || source.IsMulticast() || (source.To4() == nil) != (group.To4() == nil) {
+			return kvs.NewInvalidValueError(ErrMRouteInvalidSource, "source_address")
+		}
+	}
+
+	if len(route.Interfaces) == 0 {
+		return kvs.NewInvalidValueError(ErrMRouteWithoutInterfaces, "interfaces")
+	}
+	ifaces := make(map[string]struct{})
+	for _, iface := range route.Interfaces {
+		if !iface.Accept && !iface.Forward {
+			return kvs.NewInvalidValueError(ErrMRouteInterfaceWithoutFlags,
+				"interfaces.accept", "interfaces.forward")
+		}
+		if _, duplicate := ifaces[iface.Name]; duplicate {
+			return kvs.NewInvalidValueError(ErrMRouteDuplicateInterface, "interfaces.name")
+		}
+		ifaces[iface.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Create adds VPP IP multicast route.
+func (d *MRouteDescriptor) Create(key string, route *l3.MRoute) (metadata interface{}, err error) {
+	return nil, d.mrouteHandler.VppAddMRoute(context.TODO(), route)
+}
+
+// Update updates flags of the multicast route and its interfaces. Interfaces
+// are added or updated first, removed interfaces are deleted afterwards,
+// so that the replication is not interrupted for the others.
+func (d *MRouteDescriptor) Update(key string, oldRoute, newRoute *l3.MRoute, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	if err = d.mrouteHandler.VppAddMRoute(context.TODO(), newRoute); err != nil {
+		return nil, err
+	}
+
+	newIfaces := mrouteInterfacesByName(newRoute)
+	var removed []*l3.MRoute_Interface
+	for _, iface := range oldRoute.Interfaces {
+		if _, kept := newIfaces[iface.Name]; !kept {
+			removed = append(removed, iface)
+		}
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	return nil, d.mrouteHandler.VppDelMRoute(context.TODO(), &l3.MRoute{
+		VrfId:         newRoute.VrfId,
+		GroupAddress:  newRoute.GroupAddress,
+		SourceAddress: newRoute.SourceAddress,
+		Interfaces:    removed,
+	})
+}
+
+// Delete removes VPP IP multicast route.
+func (d *MRouteDescriptor) Delete(key string, route *l3.MRoute, metadata interface{}) error {
+	return d.mrouteHandler.VppDelMRoute(context.TODO(), route)
+}
+
+// Retrieve returns IP multicast routes configured in VPP.
+func (d *MRouteDescriptor) Retrieve(correlate []adapter.MRouteKVWithMetadata) (
+	retrieved []adapter.MRouteKVWithMetadata, err error,
+) {
+	nbCfg := make(map[string]*l3.MRoute)
+	for _, kv := range correlate {
+		nbCfg[kv.Key] = kv.Value
+	}
+
+	routes, err := d.mrouteHandler.DumpMRoutes()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP multicast routes: %v", err)
+	}
+
+	for _, route := range routes {
+		key := models.Key(route)
+		value := route
+		origin := kvs.UnknownOrigin
+
+		// correlate with the expected configuration
+		if nbRoute, hasNbCfg := nbCfg[key]; hasNbCfg {
+			if d.EquivalentMRoutes(key, route, nbRoute) {
+				value = nbRoute
+				origin = kvs.FromNB
+			}
+		}
+
+		retrieved = append(retrieved, adapter.MRouteKVWithMetadata{
+			Key:    key,
+			Value:  value,
+			Origin: origin,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the VRF table and all the interfaces as dependencies
+// of the IP multicast route.
+func (d *MRouteDescriptor) Dependencies(key string, route *l3.MRoute) (deps []kvs.Dependency) {
+	if route.VrfId != 0 {
+		protocol := l3.VrfTable_IPV4
+		if strings.Contains(route.GroupAddress, ":") {
+			protocol = l3.VrfTable_IPV6
+		}
+		deps = append(deps, kvs.Dependency{
+			Label: mrouteVrfTableDep,
+			Key:   l3.VrfTableKey(route.VrfId, protocol),
+		})
+	}
+	for _, iface := range route.Interfaces {
+		deps = append(deps, kvs.Dependency{
+			Label: mrouteInterfaceDep + "-" + iface.Name,
+			Key:   interfaces.InterfaceKey(iface.Name),
+		})
+	}
+	return deps
+}
+
+// mrouteInterfacesByName returns interfaces of the multicast route indexed by name.
+func mrouteInterfacesByName(route *l3.MRoute) map[string]*l3.MRoute_Interface {
+	ifaces := make(map[string]*l3.MRoute_Interface, len(route.GetInterfaces()))
+	for _, iface := range route.GetInterfaces() {
+		ifaces[iface.GetName()] = iface
+	}
+	return ifaces
+}
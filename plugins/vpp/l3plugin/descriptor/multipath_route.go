@@ -0,0 +1,314 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/cn-infra/v2/utils/addrs"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+	netalloc_descr "go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MultipathRouteDescriptorName is the name of the descriptor for multipath routes.
+	MultipathRouteDescriptorName = "vpp-multipath-route"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrMultipathRouteWithoutPaths is returned when multipath route has no paths defined.
+	ErrMultipathRouteWithoutPaths = errors.New("multipath route has no paths defined")
+	// ErrMultipathRouteDropPathNotAlone is returned when DROP path is combined with other paths.
+	ErrMultipathRouteDropPathNotAlone = errors.New("DROP path cannot be combined with other paths")
+	// ErrMultipathRouteResolveConflict is returned when the next hop of a path is requested
+	// to be resolved both via host and via attached routes.
+	ErrMultipathRouteResolveConflict = errors.New("resolve_via_host and resolve_via_attached cannot be both enabled")
+)
+
+// MultipathRouteDescriptor teaches KVScheduler how to configure VPP routes
+// with multiple paths.
+type MultipathRouteDescriptor struct {
+	log          logging.Logger
+	routeHandler vppcalls.RouteVppAPI
+	addrAlloc    netalloc.AddressAllocator
+}
+
+// NewMultipathRouteDescriptor creates a new instance of the MultipathRoute descriptor.
+func NewMultipathRouteDescriptor(
+	routeHandler vppcalls.RouteVppAPI, addrAlloc netalloc.AddressAllocator,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &MultipathRouteDescriptor{
+		routeHandler: routeHandler,
+		addrAlloc:    addrAlloc,
+		log:          log.NewLogger("multipath-route-descriptor"),
+	}
+
+	typedDescr := &adapter.MultipathRouteDescriptor{
+		Name:            MultipathRouteDescriptorName,
+		NBKeyPrefix:     l3.ModelMultipathRoute.KeyPrefix(),
+		ValueTypeName:   l3.ModelMultipathRoute.ProtoName(),
+		KeySelector:     l3.ModelMultipathRoute.IsKeyValid,
+		KeyLabel:        l3.ModelMultipathRoute.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentMultipathRoutes,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Update:          ctx.Update,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+		Dependencies:    ctx.Dependencies,
+		RetrieveDependencies: []string{
+			netalloc_descr.IPAllocDescriptorName,
+			ifdescriptor.InterfaceDescriptorName,
+			VrfTableDescriptorName},
+	}
+	return adapter.NewMultipathRouteDescriptor(typedDescr)
+}
+
+// EquivalentMultipathRoutes compares multipath routes, the order of paths
+// is not significant.
+func (d *MultipathRouteDescriptor) EquivalentMultipathRoutes(key string, oldRoute, newRoute *l3.MultipathRoute) bool {
+	if oldRoute.GetVrfId() != newRoute.GetVrfId() ||
+		!equalNetworks(oldRoute.GetDstNetwork(), newRoute.GetDstNetwork()) ||
+		len(oldRoute.GetPaths()) != len(newRoute.GetPaths()) {
+		return false
+	}
+	matched := make([]bool, len(newRoute.GetPaths()))
+	for _, oldPath := range oldRoute.GetPaths() {
+		var found bool
+		for i, newPath := range newRoute.GetPaths() {
+			if !matched[i] && equivalentMultipathRoutePaths(oldRoute, oldPath, newRoute, newPath) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates VPP multipath route configuration.
+func (d *MultipathRouteDescriptor) Validate(key string, route *l3.MultipathRoute) error {
+	// validate destination network
+	err := d.addrAlloc.ValidateIPAddress(route.DstNetwork, "", "dst_network",
+		netalloc.GWRefAllowed)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(route.DstNetwork, netalloc_api.AllocRefPrefix) {
+		_, ipNet, _ := net.ParseCIDR(route.DstNetwork)
+		if !strings.EqualFold(ipNet.String(), route.DstNetwork) {
+			e := fmt.Errorf("DstNetwork (%s) must represent IP network (%s)",
+				route.DstNetwork, ipNet.String())
+			return kvs.NewInvalidValueError(e, "dst_network")
+		}
+	}
+
+	// validate paths
+	if len(route.Paths) == 0 {
+		return kvs.NewInvalidValueError(ErrMultipathRouteWithoutPaths, "paths")
+	}
+	for _, path := range route.Paths {
+		if path.Type == l3.Route_DROP && len(route.Paths) > 1 {
+			return kvs.NewInvalidValueError(ErrMultipathRouteDropPathNotAlone, "paths.type")
+		}
+		if path.ResolveViaHost && path.ResolveViaAttached {
+			return kvs.NewInvalidValueError(ErrMultipathRouteResolveConflict,
+				"paths.resolve_via_host", "paths.resolve_via_attached")
+		}
+		if path.NextHopAddr != "" {
+			err = d.addrAlloc.ValidateIPAddress(path.NextHopAddr, path.OutgoingInterface,
+				"paths.next_hop_addr", netalloc.GWRefRequired)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Create adds VPP route with all its paths.
+func (d *MultipathRouteDescriptor) Create(key string, route *l3.MultipathRoute) (metadata interface{}, err error) {
+	return nil, d.routeHandler.VppAddMultipathRoute(context.TODO(), route)
+}
+
+// Update replaces the set of paths of VPP route in one step.
+func (d *MultipathRouteDescriptor) Update(key string, oldRoute, newRoute *l3.MultipathRoute, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	return nil, d.routeHandler.VppAddMultipathRoute(context.TODO(), newRoute)
+}
+
+// Delete removes VPP route with all its paths.
+func (d *MultipathRouteDescriptor) Delete(key string, route *l3.MultipathRoute, metadata interface{}) error {
+	return d.routeHandler.VppDelMultipathRoute(context.TODO(), route)
+}
+
+// Retrieve returns multipath routes configured by the agent. Other VPP routes
+// are left to be retrieved by the route descriptor.
+func (d *MultipathRouteDescriptor) Retrieve(correlate []adapter.MultipathRouteKVWithMetadata) (
+	retrieved []adapter.MultipathRouteKVWithMetadata, err error,
+) {
+	// prepare expected configuration with de-referenced netalloc links
+	nbCfg := make(map[string]*l3.MultipathRoute)
+	expCfg := make(map[string]*l3.MultipathRoute)
+	for _, kv := range correlate {
+		route := proto.Clone(kv.Value).(*l3.MultipathRoute)
+		parsed, err := d.addrAlloc.GetOrParseIPAddress(route.DstNetwork,
+			"", netalloc_api.IPAddressForm_ADDR_NET)
+		if err == nil {
+			route.DstNetwork = parsed.String()
+		}
+		for _, path := range route.Paths {
+			if path.NextHopAddr == "" {
+				continue
+			}
+			parsed, err = d.addrAlloc.GetOrParseIPAddress(path.NextHopAddr,
+				path.OutgoingInterface, netalloc_api.IPAddressForm_ADDR_ONLY)
+			if err == nil {
+				path.NextHopAddr = parsed.IP.String()
+			}
+		}
+		key := models.Key(route)
+		expCfg[key] = route
+		nbCfg[key] = kv.Value
+	}
+
+	routes, err := d.routeHandler.DumpMultipathRoutes()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP multipath routes: %v", err)
+	}
+
+	for _, route := range routes {
+		key := models.Key(route)
+		expRoute, hasExpCfg := expCfg[key]
+		if !hasExpCfg {
+			continue
+		}
+		value := route
+		if d.EquivalentMultipathRoutes(key, route, expRoute) {
+			value = nbCfg[key]
+			// recreate the key in case the dest. IP was replaced with netalloc link
+			key = models.Key(value)
+		}
+		retrieved = append(retrieved, adapter.MultipathRouteKVWithMetadata{
+			Key:    key,
+			Value:  value,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists dependencies for a VPP multipath route.
+func (d *MultipathRouteDescriptor) Dependencies(key string, route *l3.MultipathRoute) []kvs.Dependency {
+	var dependencies []kvs.Dependency
+
+	// non-zero VRFs
+	var protocol l3.VrfTable_Protocol
+	_, isIPv6, _ := addrs.ParseIPWithPrefix(route.DstNetwork)
+	if isIPv6 {
+		protocol = l3.VrfTable_IPV6
+	}
+	if route.VrfId != 0 {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: vrfTableDep,
+			Key:   l3.VrfTableKey(route.VrfId, protocol),
+		})
+	}
+
+	// if destination network is netalloc reference, then the address must be allocated first
+	allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(route.DstNetwork,
+		"", "dst_network-")
+	if hasAllocDep {
+		dependencies = append(dependencies, allocDep)
+	}
+
+	for _, path := range route.Paths {
+		// the outgoing interface must exist
+		if path.OutgoingInterface != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: routeOutInterfaceDep + "-" + path.OutgoingInterface,
+				Key:   interfaces.InterfaceKey(path.OutgoingInterface),
+			})
+		}
+		if path.Type == l3.Route_INTER_VRF && path.ViaVrfId != 0 {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: fmt.Sprintf("%s-%d", viaVrfTableDep, path.ViaVrfId),
+				Key:   l3.VrfTableKey(path.ViaVrfId, protocol),
+			})
+		}
+		// if GW is netalloc reference, then the address must be allocated first
+		allocDep, hasAllocDep = d.addrAlloc.GetAddressAllocDep(path.NextHopAddr,
+			path.OutgoingInterface, "gw_addr-")
+		if hasAllocDep {
+			dependencies = append(dependencies, allocDep)
+		}
+	}
+	return dependencies
+}
+
+// equivalentMultipathRoutePaths compares two paths of multipath routes.
+func equivalentMultipathRoutePaths(oldRoute *l3.MultipathRoute, oldPath *l3.MultipathRoute_Path,
+	newRoute *l3.MultipathRoute, newPath *l3.MultipathRoute_Path) bool {
+	if oldPath.GetType() != newPath.GetType() ||
+		oldPath.GetOutgoingInterface() != newPath.GetOutgoingInterface() ||
+		getMultipathRoutePathWeight(oldPath) != getMultipathRoutePathWeight(newPath) ||
+		oldPath.GetPreference() != newPath.GetPreference() ||
+		oldPath.GetResolveViaHost() != newPath.GetResolveViaHost() ||
+		oldPath.GetResolveViaAttached() != newPath.GetResolveViaAttached() {
+		return false
+	}
+	if oldPath.GetType() == l3.Route_INTER_VRF && oldPath.GetViaVrfId() != newPath.GetViaVrfId() {
+		return false
+	}
+	return equalAddrs(getMultipathRoutePathGwAddr(oldRoute, oldPath),
+		getMultipathRoutePathGwAddr(newRoute, newPath))
+}
+
+// getMultipathRoutePathGwAddr returns the GW address of the path, handling
+// the cases when it is left undefined.
+func getMultipathRoutePathGwAddr(route *l3.MultipathRoute, path *l3.MultipathRoute_Path) string {
+	return getGwAddr(&l3.Route{
+		DstNetwork:  route.GetDstNetwork(),
+		NextHopAddr: path.GetNextHopAddr(),
+	})
+}
+
+// getMultipathRoutePathWeight returns path weight, handling the cases when it is left undefined.
+func getMultipathRoutePathWeight(path *l3.MultipathRoute_Path) uint32 {
+	if path.GetWeight() == 0 {
+		return defaultWeight
+	}
+	return path.GetWeight()
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"strings"
 
@@ -34,6 +35,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
 	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 )
@@ -46,6 +48,8 @@ const (
 	routeOutInterfaceDep = "interface-exists"
 	vrfTableDep          = "vrf-table-exists"
 	viaVrfTableDep       = "via-vrf-table-exists"
+	bfdSessionUpDep      = "bfd-session-is-up"
+	vrrpMasterDep        = "vrrp-is-master"
 
 	// static route weight by default
 	defaultWeight = 1
@@ -95,7 +99,9 @@ func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *l3.Ro
 		oldRoute.GetViaVrfId() != newRoute.GetViaVrfId() ||
 		oldRoute.GetOutgoingInterface() != newRoute.GetOutgoingInterface() ||
 		getWeight(oldRoute) != getWeight(newRoute) ||
-		oldRoute.GetPreference() != newRoute.GetPreference() {
+		oldRoute.GetPreference() != newRoute.GetPreference() ||
+		oldRoute.GetRequireBfdSession() != newRoute.GetRequireBfdSession() ||
+		!proto.Equal(oldRoute.GetRequireVrrpMaster(), newRoute.GetRequireVrrpMaster()) {
 		return false
 	}
 
@@ -109,6 +115,11 @@ func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *l3.Ro
 		return false
 	}
 
+	// compare imposed labels
+	if !equalMplsLabelStacks(oldRoute.GetLabelStack(), newRoute.GetLabelStack()) {
+		return false
+	}
+
 	return true
 }
 
@@ -138,6 +149,37 @@ func (d *RouteDescriptor) Validate(key string, route *l3.Route) (err error) {
 		}
 	}
 
+	// BFD session is identified by the outgoing interface and the next hop
+	if route.RequireBfdSession {
+		if route.OutgoingInterface == "" || net.ParseIP(route.NextHopAddr) == nil {
+			e := errors.New("route requiring BFD session must have outgoing interface " +
+				"and next hop IP address (not a netalloc reference) defined")
+			return kvs.NewInvalidValueError(e, "require_bfd_session",
+				"outgoing_interface", "next_hop_addr")
+		}
+	}
+
+	// VRRP virtual router is identified by the interface and VR ID
+	if vrrpMaster := route.RequireVrrpMaster; vrrpMaster != nil {
+		if vrrpMaster.Interface == "" {
+			return kvs.NewInvalidValueError(ErrMissingInterface, "require_vrrp_master.interface")
+		}
+		if vrrpMaster.VrId > math.MaxUint8 || vrrpMaster.VrId == 0 {
+			return kvs.NewInvalidValueError(ErrInvalidVrID, "require_vrrp_master.vr_id")
+		}
+	}
+
+	// labels can be imposed only when the traffic is forwarded
+	if len(route.LabelStack) > 0 {
+		if route.Type == l3.Route_DROP {
+			return kvs.NewInvalidValueError(errors.New("label stack cannot be defined for DROP route"),
+				"label_stack")
+		}
+		if err := validateMplsLabelStack(route.LabelStack); err != nil {
+			return kvs.NewInvalidValueError(err, "label_stack")
+		}
+	}
+
 	// TODO: validate mix of IP versions?
 
 	return nil
@@ -186,6 +228,10 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) (
 		route := proto.Clone(kv.Value).(*l3.Route)
 		route.DstNetwork = dstNetwork
 		route.NextHopAddr = nextHop
+		// BFD requirement is not reflected in VPP
+		route.RequireBfdSession = false
+		// neither is VRRP requirement
+		route.RequireVrrpMaster = nil
 		key := models.Key(route)
 		expCfg[key] = route
 		nbCfg[key] = kv.Value
@@ -252,6 +298,22 @@ func (d *RouteDescriptor) Dependencies(key string, route *l3.Route) []kvs.Depend
 		})
 	}
 
+	// the BFD session with the next hop must be up
+	if route.RequireBfdSession {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: bfdSessionUpDep,
+			Key:   vpp_bfd.SessionStateKey(route.OutgoingInterface, route.NextHopAddr, true),
+		})
+	}
+
+	// the VRRP virtual router must be the master
+	if vrrpMaster := route.RequireVrrpMaster; vrrpMaster != nil {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: vrrpMasterDep,
+			Key:   l3.VrrpMasterKey(vrrpMaster.Interface, vrrpMaster.VrId),
+		})
+	}
+
 	// if destination network is netalloc reference, then the address must be allocated first
 	allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(route.DstNetwork,
 		"", "dst_network-")
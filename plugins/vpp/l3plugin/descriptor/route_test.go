@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"fmt"
+	"testing"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+func TestRouteDescriptorConformance(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	log := logrus.NewLogger("test-log")
+	vrfIndexes := vrfidx.NewVRFIndex(log, "vrf-idx")
+	vrfIndexes.Put("vrf0-ipv4", &vrfidx.VRFMetadata{Index: 0, Protocol: l3.VrfTable_IPV4})
+
+	addrAlloc := netalloc_mock.NewMockNetAlloc()
+	handler := vpp2306.NewRouteVppHandler(ctx.MockChannel, testIfIndexes(), vrfIndexes, addrAlloc, log)
+
+	suite := &descriptortest.Suite{
+		Descriptor: descriptor.NewRouteDescriptor(handler, addrAlloc, log),
+		Southbound: vppmock.NewSouthbound(ctx, routeReplies),
+		Samples: []descriptortest.Sample{
+			{
+				Value: &l3.Route{
+					Type:              l3.Route_INTRA_VRF,
+					DstNetwork:        "10.1.0.0/24",
+					NextHopAddr:       "10.0.0.1",
+					OutgoingInterface: "if1",
+					Weight:            1,
+				},
+			},
+			{
+				Value: &l3.Route{
+					Type:              l3.Route_INTRA_VRF,
+					DstNetwork:        "10.2.0.0/16",
+					NextHopAddr:       "10.0.1.1",
+					OutgoingInterface: "if2",
+					Weight:            5,
+					Preference:        2,
+				},
+			},
+			{
+				Value: &l3.Route{
+					Type:       l3.Route_DROP,
+					DstNetwork: "192.168.0.0/16",
+					Weight:     1,
+				},
+			},
+		},
+	}
+	suite.Run(t)
+}
+
+// routeReplies replays routes added/deleted via the mocked channel
+// and builds the reply for the route dump.
+func routeReplies(sent []govppapi.Message) []*vppmock.HandleReplies {
+	var routes []*vpp_ip.IPRouteAddDel
+	routeID := func(route vpp_ip.IPRoute) string {
+		return fmt.Sprintf("%d/%v", route.TableID, route.Prefix)
+	}
+	for _, msg := range sent {
+		req, ok := msg.(*vpp_ip.IPRouteAddDel)
+		if !ok {
+			continue
+		}
+		for i, route := range routes {
+			if routeID(route.Route) == routeID(req.Route) {
+				routes = append(routes[:i], routes[i+1:]...)
+				break
+			}
+		}
+		if req.IsAdd {
+			routes = append(routes, req)
+		}
+	}
+	replies := []*vppmock.HandleReplies{
+		{
+			Name:    (&vpp_ip.IPRouteAddDel{}).GetMessageName(),
+			Message: &vpp_ip.IPRouteAddDelReply{},
+		},
+	}
+	if len(routes) == 0 {
+		// empty dump is answered by control ping only
+		return replies
+	}
+	var details []govppapi.Message
+	for _, route := range routes {
+		details = append(details, &vpp_ip.IPRouteDetails{Route: route.Route})
+	}
+	return append(replies, &vppmock.HandleReplies{
+		Name:     (&vpp_ip.IPRouteDump{}).GetMessageName(),
+		Ping:     true,
+		Messages: details,
+	})
+}
+
+// testIfIndexes returns index with VPP interfaces referenced by the samples.
+func testIfIndexes() ifaceidx.IfaceMetadataIndexRW {
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test-log"), "if-idx")
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	return ifIndexes
+}
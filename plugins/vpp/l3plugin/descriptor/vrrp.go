@@ -37,6 +37,7 @@ const (
 
 	// Dependency labels.
 	vrrpEntryInterfaceDep    = "interface-exists"
+	vrrpTrackedInterfaceDep  = "tracked-interface-exists-"
 	vrrpDescriptorLoggerName = "vrrp-descriptor"
 
 	// The minimum value in milliseconds that can be used as interval.
@@ -53,20 +54,27 @@ var (
 	ErrInvalidVrrpIP    = errors.New("invalid IP address")
 	ErrInvalidIPVer     = errors.New("ipv6_flag does not correspond to IP version of the provided address")
 	ErrInvalidInterface = errors.New("interface does not exist")
+
+	ErrMissingTrackedInterface   = errors.New("missing tracked interface")
+	ErrInvalidTrackedPriority    = errors.New("tracked interface priority should be > 0 && <= 255")
+	ErrDuplicateTrackedInterface = errors.New("interface is tracked more than once")
 )
 
 // VrrpDescriptor teaches KVScheduler how to configure VPP VRRPs.
 type VrrpDescriptor struct {
 	log         logging.Logger
 	vrrpHandler vppcalls.VrrpVppAPI
+	vrrpRemoved func(iface string, vrID uint32)
 }
 
 // NewVrrpDescriptor creates a new instance of the VrrpDescriptor.
+// Callback <vrrpRemoved> is called for every VRRP entry removed from VPP.
 func NewVrrpDescriptor(vrrpHandler vppcalls.VrrpVppAPI,
-	log logging.PluginLogger) *kvs.KVDescriptor {
+	vrrpRemoved func(iface string, vrID uint32), log logging.PluginLogger) *kvs.KVDescriptor {
 	ctx := &VrrpDescriptor{
 		log:         log.NewLogger(vrrpDescriptorLoggerName),
 		vrrpHandler: vrrpHandler,
+		vrrpRemoved: vrrpRemoved,
 	}
 
 	typedDescr := &adapter.VRRPEntryDescriptor{
@@ -127,6 +135,20 @@ func (d *VrrpDescriptor) Validate(key string, vrrp *l3.VRRPEntry) error {
 			return kvs.NewInvalidValueError(ErrInvalidIPVer, "ip_addresses")
 		}
 	}
+
+	tracked := make(map[string]struct{}, len(vrrp.TrackedInterfaces))
+	for _, trackedIf := range vrrp.TrackedInterfaces {
+		if trackedIf.GetInterface() == "" {
+			return kvs.NewInvalidValueError(ErrMissingTrackedInterface, "tracked_interfaces.interface")
+		}
+		if trackedIf.GetPriority() > math.MaxUint8 || trackedIf.GetPriority() == 0 {
+			return kvs.NewInvalidValueError(ErrInvalidTrackedPriority, "tracked_interfaces.priority")
+		}
+		if _, duplicate := tracked[trackedIf.Interface]; duplicate {
+			return kvs.NewInvalidValueError(ErrDuplicateTrackedInterface, "tracked_interfaces.interface")
+		}
+		tracked[trackedIf.Interface] = struct{}{}
+	}
 	return nil
 }
 
@@ -139,6 +161,12 @@ func (d *VrrpDescriptor) Create(key string, vrrp *l3.VRRPEntry) (interface{}, er
 		return nil, err
 	}
 
+	if len(vrrp.TrackedInterfaces) > 0 {
+		if err := d.vrrpHandler.VppAddVrrpTrackedInterfaces(vrrp, vrrp.TrackedInterfaces); err != nil {
+			return nil, err
+		}
+	}
+
 	if vrrp.Enabled {
 		if err := d.vrrpHandler.VppStartVrrp(vrrp); err != nil {
 			return nil, err
@@ -160,16 +188,15 @@ func (d *VrrpDescriptor) Delete(key string, vrrp *l3.VRRPEntry, metadata interfa
 	if err := d.vrrpHandler.VppDelVrrp(vrrp); err != nil {
 		return err
 	}
+	if d.vrrpRemoved != nil {
+		d.vrrpRemoved(vrrp.Interface, vrrp.VrId)
+	}
 	return nil
 }
 
 // UpdateWithRecreate returns true if a VRRP update needs to be performed via re-crate.
+// Changes of the enabled flag and of the tracked interfaces are applied without re-creation.
 func (d *VrrpDescriptor) UpdateWithRecreate(_ string, oldVRRPEntry, newVRRPEntry *l3.VRRPEntry, _ interface{}) bool {
-	if oldVRRPEntry.Enabled == newVRRPEntry.Enabled {
-		// Something has changed, but it is not about enabled/disabled.
-		return true
-	}
-
 	return !allFieldsWhithoutEnabledEquals(oldVRRPEntry, newVRRPEntry)
 }
 
@@ -177,6 +204,22 @@ func (d *VrrpDescriptor) UpdateWithRecreate(_ string, oldVRRPEntry, newVRRPEntry
 func (d *VrrpDescriptor) Update(_ string, oldVRRPEntry, newVRRPEntry *l3.VRRPEntry, _ interface{}) (
 	_ interface{}, err error) {
 
+	// tracked interfaces with changed priority are removed and added again
+	toDel, toAdd := diffTrackedInterfaces(oldVRRPEntry.TrackedInterfaces, newVRRPEntry.TrackedInterfaces)
+	if len(toDel) > 0 {
+		if err = d.vrrpHandler.VppDelVrrpTrackedInterfaces(newVRRPEntry, toDel); err != nil {
+			return nil, err
+		}
+	}
+	if len(toAdd) > 0 {
+		if err = d.vrrpHandler.VppAddVrrpTrackedInterfaces(newVRRPEntry, toAdd); err != nil {
+			return nil, err
+		}
+	}
+
+	if oldVRRPEntry.Enabled == newVRRPEntry.Enabled {
+		return nil, nil
+	}
 	if newVRRPEntry.Enabled {
 		err = d.vrrpHandler.VppStartVrrp(newVRRPEntry)
 	} else {
@@ -194,6 +237,13 @@ func (d *VrrpDescriptor) Dependencies(key string, vrrp *l3.VRRPEntry) (deps []kv
 			Key:   interfaces.InterfaceKey(vrrp.Interface),
 		})
 	}
+	// tracked interfaces must exist
+	for _, trackedIf := range vrrp.TrackedInterfaces {
+		deps = append(deps, kvs.Dependency{
+			Label: vrrpTrackedInterfaceDep + trackedIf.Interface,
+			Key:   interfaces.InterfaceKey(trackedIf.Interface),
+		})
+	}
 	return deps
 }
 
@@ -224,11 +274,15 @@ func (d *VrrpDescriptor) EquivalentVRRPs(_ string, oldVRRPEntry, newVRRPEntry *l
 	if oldVRRPEntry.Enabled != newVRRPEntry.Enabled {
 		return false
 	}
+	toDel, toAdd := diffTrackedInterfaces(oldVRRPEntry.TrackedInterfaces, newVRRPEntry.TrackedInterfaces)
+	if len(toDel) > 0 || len(toAdd) > 0 {
+		return false
+	}
 	return allFieldsWhithoutEnabledEquals(oldVRRPEntry, newVRRPEntry)
 }
 
 // allFieldsWhithoutEnabledEquals returns true if all entrys' fields are equal,
-// without checking the Enabled field.
+// without checking the Enabled field and the tracked interfaces.
 func allFieldsWhithoutEnabledEquals(entry1, entry2 *l3.VRRPEntry) bool {
 	if entry1.Interface != entry2.Interface ||
 		!intervalEquals(entry1.Interval, entry2.Interval) ||
@@ -253,3 +307,26 @@ func allFieldsWhithoutEnabledEquals(entry1, entry2 *l3.VRRPEntry) bool {
 func intervalEquals(i1, i2 uint32) bool {
 	return i1/centisecondInMilliseconds == i2/centisecondInMilliseconds
 }
+
+// diffTrackedInterfaces returns tracked interfaces to remove and to add
+// (regardless of the order) to get from the old list to the new one.
+func diffTrackedInterfaces(oldTracked, newTracked []*l3.VRRPEntry_TrackedInterface) (
+	toDel, toAdd []*l3.VRRPEntry_TrackedInterface) {
+	oldPriorities := make(map[string]uint32, len(oldTracked))
+	for _, trackedIf := range oldTracked {
+		oldPriorities[trackedIf.Interface] = trackedIf.Priority
+	}
+	newPriorities := make(map[string]uint32, len(newTracked))
+	for _, trackedIf := range newTracked {
+		newPriorities[trackedIf.Interface] = trackedIf.Priority
+		if priority, ok := oldPriorities[trackedIf.Interface]; !ok || priority != trackedIf.Priority {
+			toAdd = append(toAdd, trackedIf)
+		}
+	}
+	for _, trackedIf := range oldTracked {
+		if priority, ok := newPriorities[trackedIf.Interface]; !ok || priority != trackedIf.Priority {
+			toDel = append(toDel, trackedIf)
+		}
+	}
+	return toDel, toAdd
+}
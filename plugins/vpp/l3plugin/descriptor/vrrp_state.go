@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// VrrpStateDescriptorName is the name of the descriptor notifying
+	// about the VPP VRRP virtual routers in the master role.
+	VrrpStateDescriptorName = "vpp-vrrp-state"
+)
+
+// vrrpID identifies VRRP virtual router.
+type vrrpID struct {
+	iface string
+	vrID  uint32
+}
+
+// VrrpStateDescriptor notifies kvscheduler about VPP VRRP virtual routers
+// becoming or ceasing to be the master.
+type VrrpStateDescriptor struct {
+	// input arguments
+	log         logging.Logger
+	kvscheduler kvs.KVScheduler
+	vrrpHandler vppcalls.VrrpVppAPI
+
+	mastersMx sync.Mutex
+	masters   map[vrrpID]struct{}
+}
+
+// NewVrrpStateDescriptor creates a new instance of the VRRP state descriptor.
+func NewVrrpStateDescriptor(kvscheduler kvs.KVScheduler, vrrpHandler vppcalls.VrrpVppAPI,
+	log logging.PluginLogger) (descr *kvs.KVDescriptor, ctx *VrrpStateDescriptor) {
+
+	descrCtx := &VrrpStateDescriptor{
+		log:         log.NewLogger("vrrp-state"),
+		kvscheduler: kvscheduler,
+		vrrpHandler: vrrpHandler,
+		masters:     make(map[vrrpID]struct{}),
+	}
+	return &kvs.KVDescriptor{
+		Name:        VrrpStateDescriptorName,
+		KeySelector: descrCtx.IsVrrpMasterKey,
+		Retrieve:    descrCtx.Retrieve,
+		// Retrieve depends on the VRRP descriptor: entries are dumped
+		// with interface names taken from the interface index
+		RetrieveDependencies: []string{VrrpDescriptorName},
+	}, descrCtx
+}
+
+// IsVrrpMasterKey returns <true> for keys representing VRRP virtual routers in the master role.
+func (d *VrrpStateDescriptor) IsVrrpMasterKey(key string) bool {
+	_, _, isVrrpMasterKey := l3.ParseVrrpMasterKey(key)
+	return isVrrpMasterKey
+}
+
+// Retrieve returns key for every VPP VRRP virtual router which is currently
+// the master (value is empty).
+func (d *VrrpStateDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (values []kvs.KVWithMetadata, err error) {
+	entries, err := d.vrrpHandler.DumpVrrpEntries()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	d.mastersMx.Lock()
+	defer d.mastersMx.Unlock()
+	d.masters = make(map[vrrpID]struct{}) // clear the map
+
+	for _, details := range entries {
+		if details.Meta == nil || details.Meta.Role != l3.VRRPState_MASTER {
+			continue
+		}
+		d.masters[vrrpID{iface: details.Vrrp.Interface, vrID: details.Vrrp.VrId}] = struct{}{}
+		values = append(values, kvs.KVWithMetadata{
+			Key:    l3.VrrpMasterKey(details.Vrrp.Interface, details.Vrrp.VrId),
+			Value:  &emptypb.Empty{},
+			Origin: kvs.FromSB,
+		})
+	}
+
+	return values, nil
+}
+
+// UpdateRole notifies scheduler about a change in the role of a VRRP virtual router.
+func (d *VrrpStateDescriptor) UpdateRole(iface string, vrID uint32, role l3.VRRPState_Role) {
+	d.mastersMx.Lock()
+	defer d.mastersMx.Unlock()
+
+	id := vrrpID{iface: iface, vrID: vrID}
+	_, wasMaster := d.masters[id]
+	isMaster := role == l3.VRRPState_MASTER
+	if wasMaster == isMaster {
+		return
+	}
+
+	notif := kvs.KVWithMetadata{Key: l3.VrrpMasterKey(iface, vrID)}
+	if isMaster {
+		d.masters[id] = struct{}{}
+		notif.Value = &emptypb.Empty{}
+	} else {
+		delete(d.masters, id)
+	}
+	d.pushNotifications([]kvs.KVWithMetadata{notif})
+}
+
+// RemoveState notifies scheduler that a VRRP virtual router was removed.
+func (d *VrrpStateDescriptor) RemoveState(iface string, vrID uint32) {
+	d.UpdateRole(iface, vrID, l3.VRRPState_INIT)
+}
+
+func (d *VrrpStateDescriptor) pushNotifications(notifs []kvs.KVWithMetadata) {
+	if err := d.kvscheduler.PushSBNotification(notifs...); err != nil {
+		d.log.Errorf("failed to send notifications to KVScheduler: %v", err)
+	}
+}
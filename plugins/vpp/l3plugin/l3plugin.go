@@ -13,6 +13,8 @@
 // limitations under the License.
 
 //go:generate descriptor-adapter --descriptor-name Route --value-type *vpp_l3.Route --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MultipathRoute --value-type *vpp_l3.MultipathRoute --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MRoute --value-type *vpp_l3.MRoute --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name ARPEntry --value-type *vpp_l3.ARPEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name ProxyARP --value-type *vpp_l3.ProxyARP --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name ProxyARPInterface --value-type *vpp_l3.ProxyARP_Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
@@ -22,10 +24,16 @@
 //go:generate descriptor-adapter --descriptor-name L3XC --value-type *vpp_l3.L3XConnect --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name TeibEntry --value-type *vpp_l3.TeibEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name VRRPEntry --value-type *vpp_l3.VRRPEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MplsTable --value-type *vpp_l3.MplsTable --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MplsInterface --value-type *vpp_l3.MplsInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MplsRoute --value-type *vpp_l3.MplsRoute --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 
 package l3plugin
 
 import (
+	"context"
+	"sync"
+
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/infra"
@@ -38,6 +46,8 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2202"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2210"
@@ -61,6 +71,16 @@ type L3Plugin struct {
 
 	// index maps
 	vrfIndex vrfidx.VRFMetadataIndex
+
+	// VRRP state updater
+	vrrpStateUpdater *vrrpStateUpdater
+
+	// notification callback, set by SetNotifyService
+	notifyMu         sync.Mutex
+	pushNotification func(notification *vpp.Notification)
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 type Deps struct {
@@ -74,6 +94,8 @@ type Deps struct {
 
 // Init initializes and registers descriptors for Linux ARPs and Routes.
 func (p *L3Plugin) Init() (err error) {
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
 	// init handlers
 	p.l3Handler = vppcalls.CompatibleL3VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(),
 		p.vrfIndex, p.AddrAlloc, p.Log)
@@ -99,6 +121,8 @@ func (p *L3Plugin) Init() (err error) {
 
 	// init & register descriptors
 	routeDescriptor := descriptor.NewRouteDescriptor(p.l3Handler, p.AddrAlloc, p.Log)
+	multipathRouteDescriptor := descriptor.NewMultipathRouteDescriptor(p.l3Handler, p.AddrAlloc, p.Log)
+	mrouteDescriptor := descriptor.NewMRouteDescriptor(p.l3Handler, p.Log)
 	arpDescriptor := descriptor.NewArpDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	proxyArpDescriptor := descriptor.NewProxyArpDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	proxyArpIfaceDescriptor := descriptor.NewProxyArpInterfaceDescriptor(p.KVScheduler, p.l3Handler, p.Log)
@@ -106,10 +130,18 @@ func (p *L3Plugin) Init() (err error) {
 	dhcpProxyDescriptor := descriptor.NewDHCPProxyDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	l3xcDescriptor := descriptor.NewL3XCDescriptor(p.l3Handler, p.IfPlugin.GetInterfaceIndex(), p.Log)
 	teibDescriptor := descriptor.NewTeibDescriptor(p.KVScheduler, p.l3Handler, p.Log)
-	vrrpDescriptor := descriptor.NewVrrpDescriptor(p.l3Handler, p.Log)
+	vrrpStateDescriptor, vrrpStateCtx := descriptor.NewVrrpStateDescriptor(p.KVScheduler, p.l3Handler, p.Log)
+	p.vrrpStateUpdater = newVrrpStateUpdater(p.KVScheduler, p.l3Handler, p.IfPlugin.GetInterfaceIndex(),
+		vrrpStateCtx, p.publishVrrpState, p.Log)
+	vrrpDescriptor := descriptor.NewVrrpDescriptor(p.l3Handler, p.vrrpStateUpdater.removeVrrp, p.Log)
+	mplsTableDescriptor := descriptor.NewMplsTableDescriptor(p.l3Handler, p.Log)
+	mplsInterfaceDescriptor := descriptor.NewMplsInterfaceDescriptor(p.l3Handler, p.Log)
+	mplsRouteDescriptor := descriptor.NewMplsRouteDescriptor(p.l3Handler, p.Log)
 
 	err = p.Deps.KVScheduler.RegisterKVDescriptor(
 		routeDescriptor,
+		multipathRouteDescriptor,
+		mrouteDescriptor,
 		arpDescriptor,
 		proxyArpDescriptor,
 		proxyArpIfaceDescriptor,
@@ -118,23 +150,67 @@ func (p *L3Plugin) Init() (err error) {
 		l3xcDescriptor,
 		teibDescriptor,
 		vrrpDescriptor,
+		vrrpStateDescriptor,
+		mplsTableDescriptor,
+		mplsInterfaceDescriptor,
+		mplsRouteDescriptor,
 	)
 	if err != nil {
 		return err
 	}
 
+	registerMetrics()
+	p.vrrpStateUpdater.start(p.ctx)
 	return nil
 }
 
-// AfterInit registers plugin with StatusCheck.
+// AfterInit subscribes for VRRP events and registers plugin with StatusCheck.
 func (p *L3Plugin) AfterInit() error {
+	// VRRP plugin may not be loaded in VPP, L3 plugin works without the VRRP events
+	if err := p.vrrpStateUpdater.subscribeVPPEvents(p.ctx); err != nil {
+		p.Log.Warnf("WatchVrrpEvents failed: %v", err)
+	}
+	p.VPP.OnReconnect(func() {
+		if err := p.vrrpStateUpdater.subscribeVPPEvents(p.ctx); err != nil {
+			p.Log.Warnf("WatchVrrpEvents failed: %v", err)
+		}
+	})
 	if p.StatusCheck != nil {
 		p.StatusCheck.Register(p.PluginName, nil)
 	}
 	return nil
 }
 
+// Close stops watching VRRP events.
+func (p *L3Plugin) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	if p.vrrpStateUpdater != nil {
+		p.vrrpStateUpdater.wait()
+	}
+	return nil
+}
+
 // GetVRFIndex gives read-only access to map with metadata of all configured VPP VRFs.
 func (p *L3Plugin) GetVRFIndex() vrfidx.VRFMetadataIndex {
 	return p.vrfIndex
 }
+
+// SetNotifyService sets notification callback for processing VPP notifications.
+func (p *L3Plugin) SetNotifyService(notify func(notification *vpp.Notification)) {
+	p.notifyMu.Lock()
+	defer p.notifyMu.Unlock()
+	p.pushNotification = notify
+}
+
+// publishVrrpState sends notification about VRRP role change.
+func (p *L3Plugin) publishVrrpState(notification *l3.VRRPNotification) {
+	p.notifyMu.Lock()
+	defer p.notifyMu.Unlock()
+	if p.pushNotification != nil {
+		p.pushNotification(&vpp.Notification{
+			Vrrp: notification,
+		})
+	}
+}
@@ -14,10 +14,17 @@
 
 package l3plugin
 
-import "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+)
 
 // API defines methods exposed by VPP-L3Plugin.
 type API interface {
 	// GetVRFIndex gives read-only access to map with metadata of all configured VPP VRFs.
 	GetVRFIndex() vrfidx.VRFMetadataIndex
+
+	// SetNotifyService sets notification callback for processing VPP notifications
+	// about VRRP role changes.
+	SetNotifyService(notify func(notification *vpp.Notification))
 }
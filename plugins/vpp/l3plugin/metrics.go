@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l3plugin
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var vrrpRoles = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "ligato",
+	Subsystem: "l3plugin",
+	Name:      "vrrp_role",
+	Help:      "The role of VRRP virtual routers (0 - init, 1 - backup, 2 - master, 3 - interface down).",
+}, []string{"interface", "vr_id"})
+
+func registerMetrics() {
+	prometheus.MustRegister(vrrpRoles)
+}
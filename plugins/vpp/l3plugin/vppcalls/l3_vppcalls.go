@@ -51,6 +51,8 @@ type L3VppAPI interface {
 	L3XCVppAPI
 	TeibVppAPI
 	VrrpVppAPI
+	MplsVppAPI
+	MRouteVppAPI
 }
 
 // ArpDetails holds info about ARP entry as a proto model
@@ -180,7 +182,21 @@ type RouteMeta struct {
 }
 
 // VrrpMeta holds fields returned from the VPP as details which are not in the model
-type VrrpMeta struct{}
+type VrrpMeta struct {
+	// Role is the current role of the virtual router.
+	Role l3.VRRPState_Role
+	// Priority is the current priority, decremented by tracked interfaces which are down.
+	Priority uint32
+}
+
+// VrrpEvent represents a role change of a VRRP virtual router reported by VPP.
+type VrrpEvent struct {
+	SwIfIndex uint32
+	VrID      uint32
+	IsIPv6    bool
+	OldRole   l3.VRRPState_Role
+	NewRole   l3.VRRPState_Role
+}
 
 // RouteVppAPI provides methods for managing routes
 type RouteVppAPI interface {
@@ -192,6 +208,11 @@ type RouteVppAPI interface {
 	// VppDelRoute removes old route, according to provided input.
 	// Every route has to contain VRF ID (default is 0).
 	VppDelRoute(ctx context.Context, route *l3.Route) error
+	// VppAddMultipathRoute adds new multipath route or replaces all paths
+	// of the existing one in one step.
+	VppAddMultipathRoute(ctx context.Context, route *l3.MultipathRoute) error
+	// VppDelMultipathRoute removes multipath route with all its paths.
+	VppDelMultipathRoute(ctx context.Context, route *l3.MultipathRoute) error
 }
 
 // RouteVppRead provides read methods for routes
@@ -199,6 +220,9 @@ type RouteVppRead interface {
 	// DumpRoutes dumps l3 routes from VPP and fills them
 	// into the provided static route map.
 	DumpRoutes() ([]*RouteDetails, error)
+	// DumpMultipathRoutes dumps l3 routes from VPP, each with the complete
+	// list of its paths.
+	DumpMultipathRoutes() ([]*l3.MultipathRoute, error)
 }
 
 // VrfTableVppAPI provides methods for managing VRF tables.
@@ -251,9 +275,42 @@ type VrrpVppAPI interface {
 	VppDelVrrp(entry *l3.VRRPEntry) error
 	VppStartVrrp(entry *l3.VRRPEntry) error
 	VppStopVrrp(entry *l3.VRRPEntry) error
+	// VppAddVrrpTrackedInterfaces starts tracking of the given interfaces by the virtual router.
+	VppAddVrrpTrackedInterfaces(entry *l3.VRRPEntry, tracked []*l3.VRRPEntry_TrackedInterface) error
+	// VppDelVrrpTrackedInterfaces stops tracking of the given interfaces by the virtual router.
+	VppDelVrrpTrackedInterfaces(entry *l3.VRRPEntry, tracked []*l3.VRRPEntry_TrackedInterface) error
+	// WatchVrrpEvents subscribes to role changes of VRRP virtual routers.
+	WatchVrrpEvents(ctx context.Context, eventsCh chan<- *VrrpEvent) error
 	DumpVrrpEntries() ([]*VrrpDetails, error)
 }
 
+// MplsVppAPI provides methods for managing MPLS tables, MPLS-enabled interfaces
+// and MPLS label routes.
+type MplsVppAPI interface {
+	MplsVppRead
+
+	// AddMplsTable adds new MPLS table.
+	AddMplsTable(table *l3.MplsTable) error
+	// DelMplsTable deletes existing MPLS table.
+	DelMplsTable(table *l3.MplsTable) error
+	// EnableMplsInterface enables MPLS forwarding on the given interface.
+	EnableMplsInterface(ifName string) error
+	// DisableMplsInterface disables MPLS forwarding on the given interface.
+	DisableMplsInterface(ifName string) error
+	// VppAddMplsRoute adds new MPLS label route.
+	VppAddMplsRoute(ctx context.Context, route *l3.MplsRoute) error
+	// VppDelMplsRoute removes existing MPLS label route.
+	VppDelMplsRoute(ctx context.Context, route *l3.MplsRoute) error
+}
+
+// MplsVppRead provides read methods for MPLS configuration.
+type MplsVppRead interface {
+	// DumpMplsTables dumps all configured MPLS tables.
+	DumpMplsTables() ([]*l3.MplsTable, error)
+	// DumpMplsRoutes dumps MPLS label routes from all MPLS tables.
+	DumpMplsRoutes() ([]*l3.MplsRoute, error)
+}
+
 // Path represents FIB path entry.
 type Path struct {
 	SwIfIndex  uint32
@@ -322,3 +379,22 @@ func CompatibleL3VppHandler(
 	}
 	return nil
 }
+
+// MRouteVppAPI provides methods for managing IP multicast routes
+type MRouteVppAPI interface {
+	MRouteVppRead
+
+	// VppAddMRoute adds IP multicast route or updates flags
+	// of the existing route and of its interfaces.
+	VppAddMRoute(ctx context.Context, route *l3.MRoute) error
+	// VppDelMRoute removes given interfaces from IP multicast route,
+	// the route itself is removed together with the last interface.
+	VppDelMRoute(ctx context.Context, route *l3.MRoute) error
+}
+
+// MRouteVppRead provides read methods for IP multicast routes
+type MRouteVppRead interface {
+	// DumpMRoutes dumps IP multicast routes with at least one interface
+	// from all VRFs.
+	DumpMRoutes() ([]*l3.MRoute, error)
+}
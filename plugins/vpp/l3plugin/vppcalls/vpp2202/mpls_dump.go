@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"net"
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/fib_types"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/mpls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// DumpMplsTables implements MPLS handler.
+func (h *MplsHandler) DumpMplsTables() (tables []*l3.MplsTable, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_mpls.MplsTableDump{})
+	for {
+		details := &vpp_mpls.MplsTableDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, &l3.MplsTable{
+			Id:    details.MtTable.MtTableID,
+			Label: strings.Trim(details.MtTable.MtName, "\x00"),
+		})
+	}
+	return tables, nil
+}
+
+// DumpMplsRoutes implements MPLS handler.
+func (h *MplsHandler) DumpMplsRoutes() (routes []*l3.MplsRoute, err error) {
+	tables, err := h.DumpMplsTables()
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		reqCtx := h.callsChannel.SendMultiRequest(&vpp_mpls.MplsRouteDump{
+			Table: vpp_mpls.MplsTable{
+				MtTableID: table.Id,
+			},
+		})
+		for {
+			details := &vpp_mpls.MplsRouteDetails{}
+			stop, err := reqCtx.ReceiveReply(details)
+			if stop {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, h.dumpMplsRouteDetails(details.MrRoute))
+		}
+	}
+	return routes, nil
+}
+
+func (h *MplsHandler) dumpMplsRouteDetails(mplsRoute vpp_mpls.MplsRoute) *l3.MplsRoute {
+	route := &l3.MplsRoute{
+		TableId:         mplsRoute.MrTableID,
+		Label:           mplsRoute.MrLabel,
+		Eos:             uintToBool(mplsRoute.MrEos),
+		PayloadProtocol: fibProtoToPayloadProto(fib_types.FibPathNhProto(mplsRoute.MrEosProto)),
+	}
+	for _, fibPath := range mplsRoute.MrPaths {
+		route.Paths = append(route.Paths, h.fibPathToMplsPath(fibPath))
+	}
+	return route
+}
+
+// fibPathToMplsPath converts FIB path into the MPLS path from the model.
+func (h *MplsHandler) fibPathToMplsPath(fibPath fib_types.FibPath) *l3.MplsPath {
+	path := &l3.MplsPath{
+		Weight:     uint32(fibPath.Weight),
+		Preference: uint32(fibPath.Preference),
+	}
+	if fibPath.Type == fib_types.FIB_API_PATH_TYPE_DROP {
+		path.Type = l3.MplsPath_DROP
+		return path
+	}
+
+	var nextHop net.IP
+	switch fibPath.Proto {
+	case fib_types.FIB_API_PATH_NH_PROTO_IP4:
+		ip4Addr := fibPath.Nh.Address.GetIP4()
+		nextHop = net.IP(ip4Addr[:]).To4()
+	case fib_types.FIB_API_PATH_NH_PROTO_IP6:
+		ip6Addr := fibPath.Nh.Address.GetIP6()
+		nextHop = net.IP(ip6Addr[:]).To16()
+	}
+	hasNextHop := nextHop != nil && !nextHop.IsUnspecified()
+
+	if !hasNextHop && fibPath.SwIfIndex == NextHopOutgoingIfUnset && fibPath.NLabels == 0 {
+		path.Type = l3.MplsPath_LOOKUP
+		path.ViaVrfId = fibPath.TableID
+		return path
+	}
+
+	if hasNextHop {
+		path.NextHopAddr = nextHop.String()
+	}
+	if fibPath.SwIfIndex != NextHopOutgoingIfUnset {
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(fibPath.SwIfIndex)
+		if !exists {
+			h.log.Warnf("MPLS route dump: interface name for index %d not found", fibPath.SwIfIndex)
+		}
+		path.OutgoingInterface = ifName
+	}
+	for i := 0; i < int(fibPath.NLabels) && i < len(fibPath.LabelStack); i++ {
+		path.OutLabels = append(path.OutLabels, fibMplsLabelToLabel(fibPath.LabelStack[i]))
+	}
+	return path
+}
+
+func fibProtoToPayloadProto(proto fib_types.FibPathNhProto) l3.MplsRoute_PayloadProtocol {
+	switch proto {
+	case fib_types.FIB_API_PATH_NH_PROTO_IP6:
+		return l3.MplsRoute_IPV6
+	case fib_types.FIB_API_PATH_NH_PROTO_MPLS:
+		return l3.MplsRoute_MPLS
+	case fib_types.FIB_API_PATH_NH_PROTO_ETHERNET:
+		return l3.MplsRoute_ETHERNET
+	default:
+		return l3.MplsRoute_IPV4
+	}
+}
+
+func fibMplsLabelToLabel(label fib_types.FibMplsLabel) *l3.MplsLabel {
+	return &l3.MplsLabel{
+		Label:     label.Label,
+		Ttl:       uint32(label.TTL),
+		Exp:       uint32(label.Exp),
+		IsUniform: uintToBool(label.IsUniform),
+	}
+}
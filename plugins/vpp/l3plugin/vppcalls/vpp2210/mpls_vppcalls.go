@@ -0,0 +1,189 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/mpls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// AddMplsTable implements MPLS handler.
+func (h *MplsHandler) AddMplsTable(table *l3.MplsTable) error {
+	return h.addDelMplsTable(table, true)
+}
+
+// DelMplsTable implements MPLS handler.
+func (h *MplsHandler) DelMplsTable(table *l3.MplsTable) error {
+	return h.addDelMplsTable(table, false)
+}
+
+func (h *MplsHandler) addDelMplsTable(table *l3.MplsTable, isAdd bool) error {
+	req := &vpp_mpls.MplsTableAddDel{
+		MtIsAdd: isAdd,
+		MtTable: vpp_mpls.MplsTable{
+			MtTableID: table.Id,
+			MtName:    table.Label,
+		},
+	}
+	reply := &vpp_mpls.MplsTableAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// EnableMplsInterface implements MPLS handler.
+func (h *MplsHandler) EnableMplsInterface(ifName string) error {
+	return h.setMplsInterface(ifName, true)
+}
+
+// DisableMplsInterface implements MPLS handler.
+func (h *MplsHandler) DisableMplsInterface(ifName string) error {
+	return h.setMplsInterface(ifName, false)
+}
+
+func (h *MplsHandler) setMplsInterface(ifName string, enable bool) error {
+	meta, found := h.ifIndexes.LookupByName(ifName)
+	if !found {
+		return errors.Errorf("interface %s not found", ifName)
+	}
+	req := &vpp_mpls.SwInterfaceSetMplsEnable{
+		SwIfIndex: interface_types.InterfaceIndex(meta.SwIfIndex),
+		Enable:    enable,
+	}
+	reply := &vpp_mpls.SwInterfaceSetMplsEnableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// VppAddMplsRoute implements MPLS handler.
+// If the route already exists, its paths are replaced atomically.
+func (h *MplsHandler) VppAddMplsRoute(ctx context.Context, route *l3.MplsRoute) error {
+	return h.vppAddDelMplsRoute(route, true)
+}
+
+// VppDelMplsRoute implements MPLS handler.
+func (h *MplsHandler) VppDelMplsRoute(ctx context.Context, route *l3.MplsRoute) error {
+	return h.vppAddDelMplsRoute(route, false)
+}
+
+func (h *MplsHandler) vppAddDelMplsRoute(route *l3.MplsRoute, isAdd bool) error {
+	paths := make([]fib_types.FibPath, 0, len(route.Paths))
+	for _, path := range route.Paths {
+		fibPath, err := h.mplsPathToFibPath(route, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, fibPath)
+	}
+
+	req := &vpp_mpls.MplsRouteAddDel{
+		MrIsAdd: isAdd,
+		// without multipath flag the whole path set is replaced (or the entry is removed)
+		MrIsMultipath: false,
+		MrRoute: vpp_mpls.MplsRoute{
+			MrTableID:  route.TableId,
+			MrLabel:    route.Label,
+			MrEos:      boolToUint(route.Eos),
+			MrEosProto: uint8(payloadProtoToFibProto(route.PayloadProtocol)),
+			MrNPaths:   uint8(len(paths)),
+			MrPaths:    paths,
+		},
+	}
+	reply := &vpp_mpls.MplsRouteAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// mplsPathToFibPath converts MPLS path from the model into the FIB path.
+func (h *MplsHandler) mplsPathToFibPath(route *l3.MplsRoute, path *l3.MplsPath) (fib_types.FibPath, error) {
+	fibPath := fib_types.FibPath{
+		SwIfIndex:  NextHopOutgoingIfUnset,
+		Weight:     uint8(path.Weight),
+		Preference: uint8(path.Preference),
+		Proto:      mplsRoutePathProto(route),
+		Nh: fib_types.FibPathNh{
+			ViaLabel:           NextHopViaLabelUnset,
+			ClassifyTableIndex: ClassifyTableIndexUnset,
+		},
+	}
+
+	switch path.Type {
+	case l3.MplsPath_DROP:
+		fibPath.Type = fib_types.FIB_API_PATH_TYPE_DROP
+	case l3.MplsPath_LOOKUP:
+		// no next hop and no interface = lookup in the table
+		fibPath.TableID = path.ViaVrfId
+	default:
+		if path.NextHopAddr != "" {
+			nextHop := net.ParseIP(path.NextHopAddr)
+			if nextHop == nil {
+				return fibPath, errors.Errorf("invalid next hop address %q", path.NextHopAddr)
+			}
+			fibPath.Nh, fibPath.Proto = setFibPathNhAndProto(nextHop)
+		}
+		if path.OutgoingInterface != "" {
+			meta, found := h.ifIndexes.LookupByName(path.OutgoingInterface)
+			if !found {
+				return fibPath, errors.Errorf("interface %s not found", path.OutgoingInterface)
+			}
+			fibPath.SwIfIndex = meta.SwIfIndex
+		}
+		if len(path.OutLabels) > len(fibPath.LabelStack) {
+			return fibPath, errors.Errorf("too many out labels (%d), at most %d are supported",
+				len(path.OutLabels), len(fibPath.LabelStack))
+		}
+		fibPath.NLabels = uint8(len(path.OutLabels))
+		for i, label := range path.OutLabels {
+			fibPath.LabelStack[i] = labelToFibMplsLabel(label)
+		}
+	}
+	return fibPath, nil
+}
+
+// mplsRoutePathProto returns protocol of the path without next hop address.
+func mplsRoutePathProto(route *l3.MplsRoute) fib_types.FibPathNhProto {
+	if !route.Eos {
+		return fib_types.FIB_API_PATH_NH_PROTO_MPLS
+	}
+	return payloadProtoToFibProto(route.PayloadProtocol)
+}
+
+func payloadProtoToFibProto(proto l3.MplsRoute_PayloadProtocol) fib_types.FibPathNhProto {
+	switch proto {
+	case l3.MplsRoute_IPV6:
+		return fib_types.FIB_API_PATH_NH_PROTO_IP6
+	case l3.MplsRoute_MPLS:
+		return fib_types.FIB_API_PATH_NH_PROTO_MPLS
+	case l3.MplsRoute_ETHERNET:
+		return fib_types.FIB_API_PATH_NH_PROTO_ETHERNET
+	default:
+		return fib_types.FIB_API_PATH_NH_PROTO_IP4
+	}
+}
+
+func labelToFibMplsLabel(label *l3.MplsLabel) fib_types.FibMplsLabel {
+	return fib_types.FibMplsLabel{
+		IsUniform: boolToUint(label.IsUniform),
+		Label:     label.Label,
+		TTL:       uint8(label.Ttl),
+		Exp:       uint8(label.Exp),
+	}
+}
@@ -65,6 +65,54 @@ func (h *RouteHandler) dumpRoutesForVrfAndIP(vrfID uint32, proto l3.VrfTable_Pro
 	return routes, nil
 }
 
+// DumpMultipathRoutes implements route handler.
+func (h *RouteHandler) DumpMultipathRoutes() (routes []*l3.MultipathRoute, err error) {
+	for _, vrfMeta := range h.vrfIndexes.ListAllVrfMetadata() {
+		reqCtx := h.callsChannel.SendMultiRequest(&vpp_ip.IPRouteDump{
+			Table: vpp_ip.IPTable{
+				TableID: vrfMeta.GetIndex(),
+				IsIP6:   vrfMeta.GetProtocol() == l3.VrfTable_IPV6,
+			},
+		})
+		for {
+			fibDetails := &vpp_ip.IPRouteDetails{}
+			stop, err := reqCtx.ReceiveReply(fibDetails)
+			if stop {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if fibDetails.Route.NPaths == 0 {
+				continue
+			}
+			// re-use conversion of single-path routes, one is returned for each path
+			pathDetails, err := h.dumpRouteIPDetails(fibDetails.Route)
+			if err != nil {
+				return nil, err
+			}
+			route := &l3.MultipathRoute{
+				VrfId: fibDetails.Route.TableID,
+			}
+			for _, details := range pathDetails {
+				route.DstNetwork = details.Route.DstNetwork
+				route.Paths = append(route.Paths, &l3.MultipathRoute_Path{
+					Type:               details.Route.Type,
+					NextHopAddr:        details.Route.NextHopAddr,
+					OutgoingInterface:  details.Route.OutgoingInterface,
+					Weight:             details.Route.Weight,
+					Preference:         details.Route.Preference,
+					ViaVrfId:           details.Route.ViaVrfId,
+					ResolveViaHost:     details.Meta.IsResolveHost,
+					ResolveViaAttached: details.Meta.IsResolveAttached,
+				})
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
 // dumpRouteIPDetails processes static route details and returns a route objects. Number of routes returned
 // depends on size of path list.
 func (h *RouteHandler) dumpRouteIPDetails(ipRoute vpp_ip.IPRoute) ([]*vppcalls.RouteDetails, error) {
@@ -130,6 +178,9 @@ func (h *RouteHandler) dumpRouteIPDetails(ipRoute vpp_ip.IPRoute) ([]*vppcalls.R
 				Preference:        uint32(path.Preference),
 				ViaVrfId:          viaVrfID,
 			}
+			for i := 0; i < int(path.NLabels) && i < len(path.LabelStack); i++ {
+				route.LabelStack = append(route.LabelStack, fibMplsLabelToLabel(path.LabelStack[i]))
+			}
 
 			labelStack := make([]vppcalls.FibMplsLabel, len(path.LabelStack))
 			for i, l := range path.LabelStack {
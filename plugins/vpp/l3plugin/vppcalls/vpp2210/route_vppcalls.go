@@ -73,6 +73,15 @@ func (h *RouteHandler) vppAddDelRoute(route *l3.Route, rtIfIdx uint32, delete bo
 		fibPath.SwIfIndex = rtIfIdx
 		fibPath.TableID = route.VrfId
 	}
+	// Labels imposed on the path
+	if len(route.LabelStack) > len(fibPath.LabelStack) {
+		return errors.Errorf("too many labels in the label stack (%d), at most %d are supported",
+			len(route.LabelStack), len(fibPath.LabelStack))
+	}
+	fibPath.NLabels = uint8(len(route.LabelStack))
+	for i, label := range route.LabelStack {
+		fibPath.LabelStack[i] = labelToFibMplsLabel(label)
+	}
 	// Destination address
 	dstNet, err := h.addrAlloc.GetOrParseIPAddress(route.DstNetwork,
 		"", netalloc.IPAddressForm_ADDR_NET)
@@ -116,6 +125,96 @@ func (h *RouteHandler) VppDelRoute(ctx context.Context, route *l3.Route) error {
 	return h.vppAddDelRoute(route, swIfIdx, true)
 }
 
+// VppAddMultipathRoute implements route handler.
+// If the route already exists, its paths are replaced in one step.
+func (h *RouteHandler) VppAddMultipathRoute(ctx context.Context, route *l3.MultipathRoute) error {
+	return h.vppAddDelMultipathRoute(route, false)
+}
+
+// VppDelMultipathRoute implements route handler.
+func (h *RouteHandler) VppDelMultipathRoute(ctx context.Context, route *l3.MultipathRoute) error {
+	return h.vppAddDelMultipathRoute(route, true)
+}
+
+// vppAddDelMultipathRoute programs the route with all its paths using a single request.
+func (h *RouteHandler) vppAddDelMultipathRoute(route *l3.MultipathRoute, delete bool) error {
+	dstNet, err := h.addrAlloc.GetOrParseIPAddress(route.DstNetwork,
+		"", netalloc.IPAddressForm_ADDR_NET)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]fib_types.FibPath, 0, len(route.Paths))
+	for _, path := range route.Paths {
+		fibPath, err := h.multipathRoutePathToFibPath(route, path, dstNet.IP.To4() == nil)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, fibPath)
+	}
+
+	req := &vpp_ip.IPRouteAddDel{
+		// without multipath flag the whole path set is replaced (or the entry is removed)
+		IsMultipath: false,
+		IsAdd:       !delete,
+		Route: vpp_ip.IPRoute{
+			TableID: route.VrfId,
+			Prefix:  networkToPrefix(dstNet),
+			NPaths:  uint8(len(paths)),
+			Paths:   paths,
+		},
+	}
+	reply := &vpp_ip.IPRouteAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// multipathRoutePathToFibPath converts path of the multipath route into the FIB path.
+func (h *RouteHandler) multipathRoutePathToFibPath(route *l3.MultipathRoute, path *l3.MultipathRoute_Path,
+	isIPv6 bool) (fib_types.FibPath, error) {
+	fibPath := fib_types.FibPath{
+		TableID:    route.VrfId,
+		Weight:     uint8(path.Weight),
+		Preference: uint8(path.Preference),
+		Proto:      fib_types.FIB_API_PATH_NH_PROTO_IP4,
+		Nh: fib_types.FibPathNh{
+			ViaLabel:           NextHopViaLabelUnset,
+			ClassifyTableIndex: ClassifyTableIndexUnset,
+		},
+	}
+	if isIPv6 {
+		fibPath.Proto = fib_types.FIB_API_PATH_NH_PROTO_IP6
+	}
+
+	swIfIdx, err := h.getRouteSwIfIndex(path.OutgoingInterface)
+	if err != nil {
+		return fibPath, err
+	}
+	fibPath.SwIfIndex = swIfIdx
+
+	if path.NextHopAddr != "" {
+		nextHop, err := h.addrAlloc.GetOrParseIPAddress(path.NextHopAddr,
+			path.OutgoingInterface, netalloc.IPAddressForm_ADDR_ONLY)
+		if err != nil {
+			return fibPath, err
+		}
+		fibPath.Nh, fibPath.Proto = setFibPathNhAndProto(nextHop.IP)
+	}
+
+	switch path.Type {
+	case l3.Route_INTER_VRF:
+		fibPath.TableID = path.ViaVrfId
+	case l3.Route_DROP:
+		fibPath.Type = fib_types.FIB_API_PATH_TYPE_DROP
+	}
+	if path.ResolveViaHost {
+		fibPath.Flags = fib_types.FIB_API_PATH_FLAG_RESOLVE_VIA_HOST
+	} else if path.ResolveViaAttached {
+		fibPath.Flags = fib_types.FIB_API_PATH_FLAG_RESOLVE_VIA_ATTACHED
+	}
+	return fibPath, nil
+}
+
 func setFibPathNhAndProto(netIP net.IP) (nh fib_types.FibPathNh, proto fib_types.FibPathNhProto) {
 	var addrUnion ip_types.AddressUnion
 	if netIP.To4() == nil {
@@ -134,3 +134,85 @@ func (h *VrrpVppHandler) VppStartVrrp(entry *l3.VRRPEntry) error {
 func (h *VrrpVppHandler) VppStopVrrp(entry *l3.VRRPEntry) error {
 	return h.vppStartStopVrrp(entry, 0)
 }
+
+func (h *VrrpVppHandler) vppAddDelTrackedInterfaces(entry *l3.VRRPEntry,
+	tracked []*l3.VRRPEntry_TrackedInterface, isAdd uint8) error {
+	if len(tracked) == 0 {
+		return nil
+	}
+
+	md, exist := h.ifIndexes.LookupByName(entry.Interface)
+	if !exist {
+		return fmt.Errorf("interface does not exist: %v", entry.Interface)
+	}
+
+	isIpv6, err := isIPv6Vrrp(entry)
+	if err != nil {
+		return err
+	}
+
+	ifs := make([]vrrp.VrrpVrTrackIf, 0, len(tracked))
+	for _, trackedIf := range tracked {
+		trackedMd, exist := h.ifIndexes.LookupByName(trackedIf.Interface)
+		if !exist {
+			return fmt.Errorf("tracked interface does not exist: %v", trackedIf.Interface)
+		}
+		ifs = append(ifs, vrrp.VrrpVrTrackIf{
+			SwIfIndex: interface_types.InterfaceIndex(trackedMd.SwIfIndex),
+			Priority:  uint8(trackedIf.Priority),
+		})
+	}
+
+	req := &vrrp.VrrpVrTrackIfAddDel{
+		SwIfIndex: interface_types.InterfaceIndex(md.SwIfIndex),
+		IsIPv6:    boolToUint(isIpv6),
+		VrID:      uint8(entry.VrId),
+		IsAdd:     isAdd,
+		NIfs:      uint8(len(ifs)),
+		Ifs:       ifs,
+	}
+
+	reply := &vrrp.VrrpVrTrackIfAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VppAddVrrpTrackedInterfaces implements VRRP handler.
+func (h *VrrpVppHandler) VppAddVrrpTrackedInterfaces(entry *l3.VRRPEntry,
+	tracked []*l3.VRRPEntry_TrackedInterface) error {
+	return h.vppAddDelTrackedInterfaces(entry, tracked, 1)
+}
+
+// VppDelVrrpTrackedInterfaces implements VRRP handler.
+func (h *VrrpVppHandler) VppDelVrrpTrackedInterfaces(entry *l3.VRRPEntry,
+	tracked []*l3.VRRPEntry_TrackedInterface) error {
+	return h.vppAddDelTrackedInterfaces(entry, tracked, 0)
+}
+
+// isIPv6Vrrp returns true if the virtual router uses IPv6 addresses.
+func isIPv6Vrrp(entry *l3.VRRPEntry) (bool, error) {
+	if len(entry.IpAddresses) == 0 {
+		return false, nil
+	}
+	ip, err := ipToAddress(entry.IpAddresses[0])
+	if err != nil {
+		return false, err
+	}
+	return ip.Af == ip_types.ADDRESS_IP6, nil
+}
+
+func fromVppVrrpState(state vrrp.VrrpVrState) l3.VRRPState_Role {
+	switch state {
+	case vrrp.VRRP_API_VR_STATE_BACKUP:
+		return l3.VRRPState_BACKUP
+	case vrrp.VRRP_API_VR_STATE_MASTER:
+		return l3.VRRPState_MASTER
+	case vrrp.VRRP_API_VR_STATE_INTF_DOWN:
+		return l3.VRRPState_INTERFACE_DOWN
+	default:
+		return l3.VRRPState_INIT
+	}
+}
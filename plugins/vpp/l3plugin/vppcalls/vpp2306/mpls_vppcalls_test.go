@@ -0,0 +1,227 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mpls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	vpp2306 "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+func TestAddMplsTable(t *testing.T) {
+	ctx, mplsHandler := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTableAddDelReply{})
+	err := mplsHandler.AddMplsTable(&l3.MplsTable{Id: 0, Label: "default"})
+	Expect(err).To(Succeed())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.MplsTableAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.MtIsAdd).To(BeTrue())
+	Expect(vppMsg.MtTable.MtTableID).To(BeEquivalentTo(0))
+	Expect(vppMsg.MtTable.MtName).To(Equal("default"))
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTableAddDelReply{Retval: 1})
+	err = mplsHandler.DelMplsTable(&l3.MplsTable{Id: 0})
+	Expect(err).ToNot(Succeed())
+}
+
+func TestEnableMplsInterface(t *testing.T) {
+	ctx, mplsHandler := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.SwInterfaceSetMplsEnableReply{})
+	err := mplsHandler.EnableMplsInterface("iface1")
+	Expect(err).To(Succeed())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.SwInterfaceSetMplsEnable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Enable).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_mpls.SwInterfaceSetMplsEnableReply{})
+	err = mplsHandler.DisableMplsInterface("iface1")
+	Expect(err).To(Succeed())
+
+	vppMsg, ok = ctx.MockChannel.Msg.(*vpp_mpls.SwInterfaceSetMplsEnable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeFalse())
+
+	err = mplsHandler.EnableMplsInterface("unknown")
+	Expect(err).ToNot(Succeed())
+}
+
+func TestAddMplsRouteSwap(t *testing.T) {
+	ctx, mplsHandler := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsRouteAddDelReply{})
+	err := mplsHandler.VppAddMplsRoute(ctx.Context, &l3.MplsRoute{
+		Label: 100,
+		Eos:   true,
+		Paths: []*l3.MplsPath{
+			{
+				NextHopAddr:       "10.0.0.2",
+				OutgoingInterface: "iface1",
+				OutLabels:         []*l3.MplsLabel{{Label: 200}, {Label: 300, Ttl: 64}},
+			},
+		},
+	})
+	Expect(err).To(Succeed())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.MplsRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.MrIsAdd).To(BeTrue())
+	Expect(vppMsg.MrIsMultipath).To(BeFalse())
+	Expect(vppMsg.MrRoute.MrLabel).To(BeEquivalentTo(100))
+	Expect(vppMsg.MrRoute.MrEos).To(BeEquivalentTo(1))
+	Expect(vppMsg.MrRoute.MrNPaths).To(BeEquivalentTo(1))
+	path := vppMsg.MrRoute.MrPaths[0]
+	Expect(path.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP4))
+	Expect(path.Nh.Address.GetIP4()).To(BeEquivalentTo([4]uint8{10, 0, 0, 2}))
+	Expect(path.NLabels).To(BeEquivalentTo(2))
+	Expect(path.LabelStack[0].Label).To(BeEquivalentTo(200))
+	Expect(path.LabelStack[1].Label).To(BeEquivalentTo(300))
+	Expect(path.LabelStack[1].TTL).To(BeEquivalentTo(64))
+}
+
+func TestAddMplsRouteLookup(t *testing.T) {
+	ctx, mplsHandler := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsRouteAddDelReply{})
+	err := mplsHandler.VppAddMplsRoute(ctx.Context, &l3.MplsRoute{
+		Label:           101,
+		Eos:             true,
+		PayloadProtocol: l3.MplsRoute_IPV6,
+		Paths: []*l3.MplsPath{
+			{
+				Type:     l3.MplsPath_LOOKUP,
+				ViaVrfId: 5,
+			},
+		},
+	})
+	Expect(err).To(Succeed())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.MplsRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.MrRoute.MrEosProto).To(BeEquivalentTo(fib_types.FIB_API_PATH_NH_PROTO_IP6))
+	path := vppMsg.MrRoute.MrPaths[0]
+	Expect(path.SwIfIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(path.TableID).To(BeEquivalentTo(5))
+	Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP6))
+	Expect(path.NLabels).To(BeEquivalentTo(0))
+}
+
+func TestAddMplsRouteErrors(t *testing.T) {
+	ctx, mplsHandler := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// unknown outgoing interface
+	err := mplsHandler.VppAddMplsRoute(ctx.Context, &l3.MplsRoute{
+		Label: 100,
+		Paths: []*l3.MplsPath{{OutgoingInterface: "unknown"}},
+	})
+	Expect(err).ToNot(Succeed())
+
+	// error reply
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsRouteAddDelReply{Retval: 1})
+	err = mplsHandler.VppDelMplsRoute(ctx.Context, &l3.MplsRoute{
+		Label: 100,
+		Paths: []*l3.MplsPath{{Type: l3.MplsPath_DROP}},
+	})
+	Expect(err).ToNot(Succeed())
+}
+
+func TestDumpMplsRoutes(t *testing.T) {
+	ctx, mplsHandler := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	nhPath := fib_types.FibPath{
+		SwIfIndex: 1,
+		Weight:    1,
+		Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+		NLabels:   1,
+	}
+	nhPath.Nh.Address.SetIP4([4]uint8{10, 0, 0, 2})
+	nhPath.LabelStack[0] = fib_types.FibMplsLabel{Label: 200}
+	lookupPath := fib_types.FibPath{
+		SwIfIndex: ^uint32(0),
+		TableID:   5,
+		Weight:    1,
+		Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+	}
+
+	ctx.MockVpp.MockReply(
+		&vpp_mpls.MplsTableDetails{
+			MtTable: vpp_mpls.MplsTable{MtTableID: 0},
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsRouteDetails{
+		MrRoute: vpp_mpls.MplsRoute{
+			MrLabel:  100,
+			MrEos:    1,
+			MrNPaths: 1,
+			MrPaths:  []fib_types.FibPath{nhPath},
+		},
+	}, &vpp_mpls.MplsRouteDetails{
+		MrRoute: vpp_mpls.MplsRoute{
+			MrLabel:  101,
+			MrEos:    1,
+			MrNPaths: 1,
+			MrPaths:  []fib_types.FibPath{lookupPath},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	routes, err := mplsHandler.DumpMplsRoutes()
+	Expect(err).To(Succeed())
+	Expect(routes).To(HaveLen(2))
+
+	Expect(routes[0].Label).To(BeEquivalentTo(100))
+	Expect(routes[0].Eos).To(BeTrue())
+	Expect(routes[0].Paths).To(HaveLen(1))
+	Expect(routes[0].Paths[0].Type).To(Equal(l3.MplsPath_NORMAL))
+	Expect(routes[0].Paths[0].NextHopAddr).To(Equal("10.0.0.2"))
+	Expect(routes[0].Paths[0].OutgoingInterface).To(Equal("iface1"))
+	Expect(routes[0].Paths[0].OutLabels).To(HaveLen(1))
+	Expect(routes[0].Paths[0].OutLabels[0].Label).To(BeEquivalentTo(200))
+
+	Expect(routes[1].Label).To(BeEquivalentTo(101))
+	Expect(routes[1].Paths[0].Type).To(Equal(l3.MplsPath_LOOKUP))
+	Expect(routes[1].Paths[0].ViaVrfId).To(BeEquivalentTo(5))
+}
+
+func mplsTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.MplsVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndexes.Put("iface1", &ifaceidx.IfaceMetadata{
+		SwIfIndex: 1,
+	})
+	mplsHandler := vpp2306.NewMplsVppHandler(ctx.MockChannel, ifIndexes, log)
+	return ctx, mplsHandler
+}
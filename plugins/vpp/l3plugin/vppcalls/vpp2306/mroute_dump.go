@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"fmt"
+	"net"
+
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mfib_types"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// DumpMRoutes implements multicast route handler.
+func (h *MRouteHandler) DumpMRoutes() (routes []*l3.MRoute, err error) {
+	for _, vrfMeta := range h.vrfIndexes.ListAllVrfMetadata() {
+		reqCtx := h.callsChannel.SendMultiRequest(&vpp_ip.IPMrouteDump{
+			Table: vpp_ip.IPTable{
+				TableID: vrfMeta.GetIndex(),
+				IsIP6:   vrfMeta.GetProtocol() == l3.VrfTable_IPV6,
+			},
+		})
+		for {
+			details := &vpp_ip.IPMrouteDetails{}
+			stop, err := reqCtx.ReceiveReply(details)
+			if stop {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if route := h.mrouteFromDetails(details.Route); route != nil {
+				routes = append(routes, route)
+			}
+		}
+	}
+	return routes, nil
+}
+
+// mrouteFromDetails converts dumped mfib entry into the multicast route.
+// Entries without interfaces (e.g. default entries created by VPP) are skipped.
+func (h *MRouteHandler) mrouteFromDetails(mroute vpp_ip.IPMroute) *l3.MRoute {
+	route := &l3.MRoute{
+		VrfId:               mroute.TableID,
+		Signal:              mroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL != 0,
+		Drop:                mroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_DROP != 0,
+		Connected:           mroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED != 0,
+		AcceptAllInterfaces: mroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF != 0,
+	}
+	for _, path := range mroute.Paths {
+		if path.Path.SwIfIndex == NextHopOutgoingIfUnset {
+			continue
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(path.Path.SwIfIndex)
+		if !exists {
+			h.log.Warnf("Multicast route dump: interface name for index %d not found", path.Path.SwIfIndex)
+			continue
+		}
+		route.Interfaces = append(route.Interfaces, &l3.MRoute_Interface{
+			Name:    ifName,
+			Accept:  path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_ACCEPT != 0,
+			Forward: path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_FORWARD != 0,
+		})
+	}
+	if len(route.Interfaces) == 0 {
+		return nil
+	}
+
+	var group, source net.IP
+	hostLen := uint16(net.IPv4len * 8)
+	if mroute.Prefix.Af == ip_types.ADDRESS_IP6 {
+		hostLen = net.IPv6len * 8
+		grp6, src6 := mroute.Prefix.GrpAddress.GetIP6(), mroute.Prefix.SrcAddress.GetIP6()
+		group, source = net.IP(grp6[:]).To16(), net.IP(src6[:]).To16()
+	} else {
+		grp4, src4 := mroute.Prefix.GrpAddress.GetIP4(), mroute.Prefix.SrcAddress.GetIP4()
+		group, source = net.IP(grp4[:]).To4(), net.IP(src4[:]).To4()
+	}
+	switch mroute.Prefix.GrpAddressLength {
+	case 2 * hostLen:
+		route.GroupAddress = group.String()
+		route.SourceAddress = source.String()
+	case hostLen:
+		route.GroupAddress = group.String()
+	default:
+		route.GroupAddress = fmt.Sprintf("%s/%d", group, mroute.Prefix.GrpAddressLength)
+	}
+	return route
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mfib_types"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// VppAddMRoute implements multicast route handler.
+func (h *MRouteHandler) VppAddMRoute(ctx context.Context, route *l3.MRoute) error {
+	prefix, err := mrouteToMprefix(route)
+	if err != nil {
+		return err
+	}
+	paths, err := h.mrouteInterfacesToPaths(route, prefix.Af)
+	if err != nil {
+		return err
+	}
+	entryFlags := mrouteEntryFlags(route)
+
+	// paths are added or updated, entry flags are applied only when the entry is created
+	if err := h.vppAddDelMRoute(route.VrfId, prefix, entryFlags, paths, true); err != nil {
+		return err
+	}
+	// update entry flags of the existing entry
+	return h.vppAddDelMRoute(route.VrfId, prefix, entryFlags, nil, true)
+}
+
+// VppDelMRoute implements multicast route handler.
+func (h *MRouteHandler) VppDelMRoute(ctx context.Context, route *l3.MRoute) error {
+	prefix, err := mrouteToMprefix(route)
+	if err != nil {
+		return err
+	}
+	paths, err := h.mrouteInterfacesToPaths(route, prefix.Af)
+	if err != nil {
+		return err
+	}
+
+	// entry with flags set is kept by VPP even without paths
+	if mrouteEntryFlags(route) != mfib_types.MFIB_API_ENTRY_FLAG_NONE {
+		err = h.vppAddDelMRoute(route.VrfId, prefix, mfib_types.MFIB_API_ENTRY_FLAG_NONE, nil, true)
+		if err != nil {
+			return err
+		}
+	}
+	return h.vppAddDelMRoute(route.VrfId, prefix, mfib_types.MFIB_API_ENTRY_FLAG_NONE, paths, false)
+}
+
+// vppAddDelMRoute adds or removes given paths. Without paths, only the entry
+// flags are updated.
+func (h *MRouteHandler) vppAddDelMRoute(vrfID uint32, prefix ip_types.Mprefix,
+	entryFlags mfib_types.MfibEntryFlags, paths []mfib_types.MfibPath, isAdd bool) error {
+	req := &vpp_ip.IPMrouteAddDel{
+		IsAdd: isAdd,
+		Route: vpp_ip.IPMroute{
+			TableID:    vrfID,
+			EntryFlags: entryFlags,
+			Prefix:     prefix,
+			NPaths:     uint8(len(paths)),
+			Paths:      paths,
+		},
+	}
+	reply := &vpp_ip.IPMrouteAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// mrouteInterfacesToPaths converts interfaces of the multicast route into mfib paths.
+func (h *MRouteHandler) mrouteInterfacesToPaths(route *l3.MRoute,
+	af ip_types.AddressFamily) ([]mfib_types.MfibPath, error) {
+	proto := fib_types.FIB_API_PATH_NH_PROTO_IP4
+	if af == ip_types.ADDRESS_IP6 {
+		proto = fib_types.FIB_API_PATH_NH_PROTO_IP6
+	}
+	paths := make([]mfib_types.MfibPath, 0, len(route.Interfaces))
+	for _, iface := range route.Interfaces {
+		meta, found := h.ifIndexes.LookupByName(iface.Name)
+		if !found {
+			return nil, errors.Errorf("interface %s not found", iface.Name)
+		}
+		var itfFlags mfib_types.MfibItfFlags
+		if iface.Accept {
+			itfFlags |= mfib_types.MFIB_API_ITF_FLAG_ACCEPT
+		}
+		if iface.Forward {
+			itfFlags |= mfib_types.MFIB_API_ITF_FLAG_FORWARD
+		}
+		paths = append(paths, mfib_types.MfibPath{
+			ItfFlags: itfFlags,
+			Path: fib_types.FibPath{
+				SwIfIndex: meta.SwIfIndex,
+				TableID:   route.VrfId,
+				Proto:     proto,
+				Nh: fib_types.FibPathNh{
+					ViaLabel:           NextHopViaLabelUnset,
+					ClassifyTableIndex: ClassifyTableIndexUnset,
+				},
+			},
+		})
+	}
+	return paths, nil
+}
+
+// mrouteEntryFlags returns entry flags of the multicast route.
+func mrouteEntryFlags(route *l3.MRoute) (flags mfib_types.MfibEntryFlags) {
+	if route.Signal {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL
+	}
+	if route.Drop {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_DROP
+	}
+	if route.Connected {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED
+	}
+	if route.AcceptAllInterfaces {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF
+	}
+	return flags
+}
+
+// mrouteToMprefix converts group and source address of the multicast route
+// into the mfib prefix. Length of (S,G) prefix covers both addresses
+// (64 for IPv4, 256 for IPv6).
+func mrouteToMprefix(route *l3.MRoute) (prefix ip_types.Mprefix, err error) {
+	var group net.IP
+	var groupLen int
+	if strings.Contains(route.GroupAddress, "/") {
+		var groupNet *net.IPNet
+		group, groupNet, err = net.ParseCIDR(route.GroupAddress)
+		if err != nil {
+			return prefix, errors.Errorf("invalid group address %q: %v", route.GroupAddress, err)
+		}
+		group = groupNet.IP
+		groupLen, _ = groupNet.Mask.Size()
+	} else {
+		group = net.ParseIP(route.GroupAddress)
+		if group == nil {
+			return prefix, errors.Errorf("invalid group address %q", route.GroupAddress)
+		}
+		groupLen = net.IPv6len * 8
+		if group.To4() != nil {
+			groupLen = net.IPv4len * 8
+		}
+	}
+	isIPv6 := group.To4() == nil
+
+	if isIPv6 {
+		prefix.Af = ip_types.ADDRESS_IP6
+		var ip6addr ip_types.IP6Address
+		copy(ip6addr[:], group.To16())
+		prefix.GrpAddress.SetIP6(ip6addr)
+	} else {
+		prefix.Af = ip_types.ADDRESS_IP4
+		var ip4addr ip_types.IP4Address
+		copy(ip4addr[:], group.To4())
+		prefix.GrpAddress.SetIP4(ip4addr)
+	}
+	prefix.GrpAddressLength = uint16(groupLen)
+
+	if route.SourceAddress != "" {
+		source := net.ParseIP(route.SourceAddress)
+		if source == nil || (source.To4() == nil) != isIPv6 {
+			return prefix, errors.Errorf("invalid source address %q", route.SourceAddress)
+		}
+		if isIPv6 {
+			var ip6addr ip_types.IP6Address
+			copy(ip6addr[:], source.To16())
+			prefix.SrcAddress.SetIP6(ip6addr)
+			prefix.GrpAddressLength = 2 * net.IPv6len * 8
+		} else {
+			var ip4addr ip_types.IP4Address
+			copy(ip4addr[:], source.To4())
+			prefix.SrcAddress.SetIP4(ip4addr)
+			prefix.GrpAddressLength = 2 * net.IPv4len * 8
+		}
+	}
+	return prefix, nil
+}
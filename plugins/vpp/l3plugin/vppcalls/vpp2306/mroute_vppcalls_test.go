@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mfib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	vpp2306 "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+func TestAddMRouteSourceGroup(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrouteHandler.VppAddMRoute(ctx.Context, &l3.MRoute{
+		VrfId:         1,
+		GroupAddress:  "232.1.1.1",
+		SourceAddress: "10.0.0.1",
+		Connected:     true,
+		Interfaces: []*l3.MRoute_Interface{
+			{Name: "iface1", Accept: true},
+			{Name: "iface2", Forward: true},
+		},
+	})
+	Expect(err).To(Succeed())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+
+	// paths
+	vppMsg, ok := ctx.MockChannel.Msgs[0].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.Route.TableID).To(BeEquivalentTo(1))
+	Expect(vppMsg.Route.EntryFlags).To(Equal(mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED))
+	Expect(vppMsg.Route.Prefix.Af).To(Equal(ip_types.ADDRESS_IP4))
+	Expect(vppMsg.Route.Prefix.GrpAddressLength).To(BeEquivalentTo(64))
+	Expect(vppMsg.Route.Prefix.GrpAddress.GetIP4()).To(Equal(ip_types.IP4Address{232, 1, 1, 1}))
+	Expect(vppMsg.Route.Prefix.SrcAddress.GetIP4()).To(Equal(ip_types.IP4Address{10, 0, 0, 1}))
+	Expect(vppMsg.Route.Paths).To(HaveLen(2))
+	Expect(vppMsg.Route.Paths[0].ItfFlags).To(Equal(mfib_types.MFIB_API_ITF_FLAG_ACCEPT))
+	Expect(vppMsg.Route.Paths[0].Path.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Route.Paths[1].ItfFlags).To(Equal(mfib_types.MFIB_API_ITF_FLAG_FORWARD))
+	Expect(vppMsg.Route.Paths[1].Path.SwIfIndex).To(BeEquivalentTo(2))
+
+	// entry flags
+	vppMsg, ok = ctx.MockChannel.Msgs[1].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Route.EntryFlags).To(Equal(mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED))
+	Expect(vppMsg.Route.Paths).To(BeEmpty())
+}
+
+func TestAddMRouteGroupPrefix(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrouteHandler.VppAddMRoute(ctx.Context, &l3.MRoute{
+		GroupAddress: "ff0e::/16",
+		Interfaces: []*l3.MRoute_Interface{
+			{Name: "iface1", Accept: true, Forward: true},
+		},
+	})
+	Expect(err).To(Succeed())
+	vppMsg, ok := ctx.MockChannel.Msgs[0].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Route.Prefix.Af).To(Equal(ip_types.ADDRESS_IP6))
+	Expect(vppMsg.Route.Prefix.GrpAddressLength).To(BeEquivalentTo(16))
+	Expect(vppMsg.Route.Paths[0].ItfFlags).To(Equal(
+		mfib_types.MFIB_API_ITF_FLAG_ACCEPT | mfib_types.MFIB_API_ITF_FLAG_FORWARD))
+	Expect(vppMsg.Route.Paths[0].Path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP6))
+}
+
+func TestAddMRouteErrors(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// unknown interface
+	err := mrouteHandler.VppAddMRoute(ctx.Context, &l3.MRoute{
+		GroupAddress: "232.1.1.1",
+		Interfaces:   []*l3.MRoute_Interface{{Name: "iface3", Accept: true}},
+	})
+	Expect(err).ToNot(Succeed())
+
+	// source of a different IP version
+	err = mrouteHandler.VppAddMRoute(ctx.Context, &l3.MRoute{
+		GroupAddress:  "232.1.1.1",
+		SourceAddress: "2001:db8::1",
+		Interfaces:    []*l3.MRoute_Interface{{Name: "iface1", Accept: true}},
+	})
+	Expect(err).ToNot(Succeed())
+}
+
+func TestDeleteMRoute(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrouteHandler.VppDelMRoute(ctx.Context, &l3.MRoute{
+		GroupAddress: "232.1.1.1",
+		Signal:       true,
+		Interfaces:   []*l3.MRoute_Interface{{Name: "iface1", Accept: true}},
+	})
+	Expect(err).To(Succeed())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+
+	// entry flags are cleared first
+	vppMsg, ok := ctx.MockChannel.Msgs[0].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Route.EntryFlags).To(Equal(mfib_types.MFIB_API_ENTRY_FLAG_NONE))
+	Expect(vppMsg.Route.Paths).To(BeEmpty())
+
+	vppMsg, ok = ctx.MockChannel.Msgs[1].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.Route.Prefix.GrpAddressLength).To(BeEquivalentTo(32))
+	Expect(vppMsg.Route.Paths).To(HaveLen(1))
+}
+
+func TestDumpMRoutes(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// default entry without interfaces
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteDetails{
+		Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_DROP,
+			Prefix:     ip_types.Mprefix{Af: ip_types.ADDRESS_IP4},
+		},
+	}, &vpp_ip.IPMrouteDetails{
+		Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL,
+			Prefix: ip_types.Mprefix{
+				Af:               ip_types.ADDRESS_IP4,
+				GrpAddressLength: 64,
+				GrpAddress:       ip_types.AddressUnionIP4(ip_types.IP4Address{232, 1, 1, 1}),
+				SrcAddress:       ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1}),
+			},
+			NPaths: 2,
+			Paths: []mfib_types.MfibPath{
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_ACCEPT,
+					Path:     fib_types.FibPath{SwIfIndex: 1},
+				},
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_FORWARD,
+					Path:     fib_types.FibPath{SwIfIndex: 2},
+				},
+			},
+		},
+	}, &vpp_ip.IPMrouteDetails{
+		Route: vpp_ip.IPMroute{
+			Prefix: ip_types.Mprefix{
+				Af:               ip_types.ADDRESS_IP4,
+				GrpAddressLength: 8,
+				GrpAddress:       ip_types.AddressUnionIP4(ip_types.IP4Address{232, 0, 0, 0}),
+			},
+			NPaths: 1,
+			Paths: []mfib_types.MfibPath{
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_ACCEPT | mfib_types.MFIB_API_ITF_FLAG_FORWARD,
+					Path:     fib_types.FibPath{SwIfIndex: 2},
+				},
+			},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	routes, err := mrouteHandler.DumpMRoutes()
+	Expect(err).To(Succeed())
+	Expect(routes).To(HaveLen(2))
+
+	Expect(routes[0].GroupAddress).To(Equal("232.1.1.1"))
+	Expect(routes[0].SourceAddress).To(Equal("10.0.0.1"))
+	Expect(routes[0].Signal).To(BeTrue())
+	Expect(routes[0].Interfaces).To(HaveLen(2))
+	Expect(routes[0].Interfaces[0].Name).To(Equal("iface1"))
+	Expect(routes[0].Interfaces[0].Accept).To(BeTrue())
+	Expect(routes[0].Interfaces[0].Forward).To(BeFalse())
+	Expect(routes[0].Interfaces[1].Name).To(Equal("iface2"))
+	Expect(routes[0].Interfaces[1].Forward).To(BeTrue())
+
+	Expect(routes[1].GroupAddress).To(Equal("232.0.0.0/8"))
+	Expect(routes[1].SourceAddress).To(BeEmpty())
+	Expect(routes[1].Interfaces[0].Accept).To(BeTrue())
+	Expect(routes[1].Interfaces[0].Forward).To(BeTrue())
+}
+
+func mrouteTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.MRouteVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndexes.Put("iface1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("iface2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	vrfIndexes := vrfidx.NewVRFIndex(logrus.NewLogger("test-vrf"), "test-vrf")
+	vrfIndexes.Put("vrf0-ipv4", &vrfidx.VRFMetadata{Index: 0, Protocol: l3.VrfTable_IPV4})
+	mrouteHandler := vpp2306.NewMRouteVppHandler(ctx.MockChannel, ifIndexes, vrfIndexes, log)
+	return ctx, mrouteHandler
+}
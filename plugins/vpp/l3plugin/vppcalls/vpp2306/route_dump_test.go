@@ -86,3 +86,66 @@ func TestDumpStaticRoutes(t *testing.T) {
 	Expect(rtDetails[0].Route.OutgoingInterface).To(Equal("if2"))
 	Expect(rtDetails[1].Route.OutgoingInterface).To(Equal("if1"))
 }
+
+// Test dumping routes with all their paths
+func TestDumpMultipathRoutes(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test-if"), "test-if")
+	vrfIndexes := vrfidx.NewVRFIndex(logrus.NewLogger("test-vrf"), "test-vrf")
+	l3handler := NewRouteVppHandler(ctx.MockChannel, ifIndexes, vrfIndexes, netallock_mock.NewMockNetAlloc(),
+		logrus.DefaultLogger())
+
+	vrfIndexes.Put("vrf1-ipv4", &vrfidx.VRFMetadata{Index: 1, Protocol: l3.VrfTable_IPV4})
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteDetails{
+		Route: vpp_ip.IPRoute{
+			TableID: 1,
+			Prefix: ip_types.Prefix{
+				Address: ip_types.Address{
+					Af: ip_types.ADDRESS_IP4,
+					Un: ip_types.AddressUnionIP4([4]uint8{10, 1, 0, 0}),
+				},
+				Len: 16,
+			},
+			NPaths: 2,
+			Paths: []fib_types.FibPath{
+				{
+					SwIfIndex: 1,
+					TableID:   1,
+					Weight:    1,
+					Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+					Nh: fib_types.FibPathNh{
+						Address: ip_types.AddressUnionIP4([4]uint8{192, 168, 1, 1}),
+					},
+				},
+				{
+					SwIfIndex: 2,
+					TableID:   1,
+					Weight:    3,
+					Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+					Flags:     fib_types.FIB_API_PATH_FLAG_RESOLVE_VIA_ATTACHED,
+					Nh: fib_types.FibPathNh{
+						Address: ip_types.AddressUnionIP4([4]uint8{192, 168, 2, 1}),
+					},
+				},
+			},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	routes, err := l3handler.DumpMultipathRoutes()
+	Expect(err).To(Succeed())
+	Expect(routes).To(HaveLen(1))
+	Expect(routes[0].VrfId).To(BeEquivalentTo(1))
+	Expect(routes[0].DstNetwork).To(Equal("10.1.0.0/16"))
+	Expect(routes[0].Paths).To(HaveLen(2))
+	Expect(routes[0].Paths[0].OutgoingInterface).To(Equal("if1"))
+	Expect(routes[0].Paths[0].NextHopAddr).To(Equal("192.168.1.1"))
+	Expect(routes[0].Paths[0].ResolveViaAttached).To(BeFalse())
+	Expect(routes[0].Paths[1].OutgoingInterface).To(Equal("if2"))
+	Expect(routes[0].Paths[1].Weight).To(BeEquivalentTo(3))
+	Expect(routes[0].Paths[1].ResolveViaAttached).To(BeTrue())
+}
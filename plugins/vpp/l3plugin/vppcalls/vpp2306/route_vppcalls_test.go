@@ -23,6 +23,7 @@ import (
 	"go.ligato.io/cn-infra/v2/logging/logrus"
 
 	netallock_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/fib_types"
 	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
@@ -85,6 +86,82 @@ func TestDeleteRoute(t *testing.T) {
 	Expect(err).To(Not(BeNil()))
 }
 
+// Test adding multipath route
+func TestAddMultipathRoute(t *testing.T) {
+	ctx, _, rtHandler := routeTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+	err := rtHandler.VppAddMultipathRoute(ctx.Context, &l3.MultipathRoute{
+		VrfId:      1,
+		DstNetwork: "10.1.0.0/16",
+		Paths: []*l3.MultipathRoute_Path{
+			{
+				NextHopAddr:       "192.168.30.1",
+				OutgoingInterface: "iface1",
+				Weight:            2,
+			},
+			{
+				NextHopAddr:    "192.168.40.1",
+				ResolveViaHost: true,
+			},
+			{
+				Type:     l3.Route_INTER_VRF,
+				ViaVrfId: 3,
+			},
+		},
+	})
+	Expect(err).To(Succeed())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_ip.IPRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.IsMultipath).To(BeFalse())
+	Expect(vppMsg.Route.TableID).To(BeEquivalentTo(1))
+	Expect(vppMsg.Route.NPaths).To(BeEquivalentTo(3))
+	Expect(vppMsg.Route.Paths).To(HaveLen(3))
+	Expect(vppMsg.Route.Paths[0].SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Route.Paths[0].Weight).To(BeEquivalentTo(2))
+	Expect(vppMsg.Route.Paths[0].TableID).To(BeEquivalentTo(1))
+	Expect(vppMsg.Route.Paths[1].SwIfIndex).To(BeEquivalentTo(vpp2306.NextHopOutgoingIfUnset))
+	Expect(vppMsg.Route.Paths[1].Flags).To(Equal(fib_types.FIB_API_PATH_FLAG_RESOLVE_VIA_HOST))
+	Expect(vppMsg.Route.Paths[2].TableID).To(BeEquivalentTo(3))
+
+	// unknown interface
+	err = rtHandler.VppAddMultipathRoute(ctx.Context, &l3.MultipathRoute{
+		DstNetwork: "10.1.0.0/16",
+		Paths: []*l3.MultipathRoute_Path{
+			{OutgoingInterface: "iface3"},
+		},
+	})
+	Expect(err).ToNot(Succeed())
+}
+
+// Test deleting multipath route
+func TestDeleteMultipathRoute(t *testing.T) {
+	ctx, _, rtHandler := routeTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	route := &l3.MultipathRoute{
+		DstNetwork: "10.1.0.0/16",
+		Paths: []*l3.MultipathRoute_Path{
+			{NextHopAddr: "192.168.30.1"},
+			{NextHopAddr: "192.168.40.1"},
+		},
+	}
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+	err := rtHandler.VppDelMultipathRoute(ctx.Context, route)
+	Expect(err).To(Succeed())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_ip.IPRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.IsMultipath).To(BeFalse())
+	Expect(vppMsg.Route.Paths).To(HaveLen(2))
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{Retval: 1})
+	err = rtHandler.VppDelMultipathRoute(ctx.Context, route)
+	Expect(err).ToNot(Succeed())
+}
+
 func routeTestSetup(t *testing.T) (*vppmock.TestCtx, ifvppcalls.InterfaceVppAPI, vppcalls.RouteVppAPI) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
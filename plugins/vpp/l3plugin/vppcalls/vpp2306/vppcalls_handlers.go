@@ -32,6 +32,7 @@ import (
 	vpp_ip_neighbor "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_neighbor"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/l3xc"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/mpls"
 	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vpe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
@@ -44,6 +45,7 @@ func init() {
 	msgs = append(msgs, vpp_ip_neighbor.AllMessages()...)
 	msgs = append(msgs, vpp_vpe.AllMessages()...)
 	msgs = append(msgs, vpp_dhcp.AllMessages()...)
+	msgs = append(msgs, vpp_mpls.AllMessages()...)
 
 	vppcalls.AddHandlerVersion(vpp2306.Version, msgs, NewL3VppHandler)
 }
@@ -58,6 +60,8 @@ type L3VppHandler struct {
 	*L3XCHandler
 	*TeibHandler
 	*VrrpVppHandler
+	*MplsHandler
+	*MRouteHandler
 }
 
 func NewL3VppHandler(
@@ -82,6 +86,8 @@ func NewL3VppHandler(
 		L3XCHandler:        NewL3XCHandler(c, ifIdx, log),
 		TeibHandler:        NewTeibVppHandler(ch, ifIdx, log),
 		VrrpVppHandler:     NewVrrpVppHandler(ch, ifIdx, log),
+		MplsHandler:        NewMplsVppHandler(ch, ifIdx, log),
+		MRouteHandler:      NewMRouteVppHandler(ch, ifIdx, vrfIdx, log),
 	}
 }
 
@@ -141,6 +147,21 @@ type VrrpVppHandler struct {
 	log          logging.Logger
 }
 
+// MplsHandler is accessor for MPLS-related vppcalls methods
+type MplsHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// MRouteHandler is accessor for IP multicast route-related vppcalls methods
+type MRouteHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	vrfIndexes   vrfidx.VRFMetadataIndex
+	log          logging.Logger
+}
+
 // NewArpVppHandler creates new instance of IPsec vppcalls handler
 func NewArpVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) *ArpVppHandler {
 	if log == nil {
@@ -259,6 +280,32 @@ func NewVrrpVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetad
 	}
 }
 
+// NewMplsVppHandler creates new instance of MPLS vppcalls handler
+func NewMplsVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) *MplsHandler {
+	if log == nil {
+		log = logrus.NewLogger("mpls-handler")
+	}
+	return &MplsHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
+
+// NewMRouteVppHandler creates new instance of IP multicast route vppcalls handler
+func NewMRouteVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex,
+	vrfIdx vrfidx.VRFMetadataIndex, log logging.Logger) *MRouteHandler {
+	if log == nil {
+		log = logrus.NewLogger("mroute-handler")
+	}
+	return &MRouteHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		vrfIndexes:   vrfIdx,
+		log:          log,
+	}
+}
+
 func ipToAddress(ipstr string) (addr ip_types.Address, err error) {
 	netIP := net.ParseIP(ipstr)
 	if netIP == nil {
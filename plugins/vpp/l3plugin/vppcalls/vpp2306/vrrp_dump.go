@@ -26,6 +26,7 @@ func (h *VrrpVppHandler) DumpVrrpEntries() (entries []*vppcalls.VrrpDetails, err
 		SwIfIndex: 0xffffffff, // Send multirequest to get all VRRP entries
 	}
 	reqCtx := h.callsChannel.SendMultiRequest(req)
+	var keys []vrrp.VrrpVrKey
 	for {
 		vrrpDetails := &vrrp.VrrpVrDetails{}
 		stop, err := reqCtx.ReceiveReply(vrrpDetails)
@@ -69,11 +70,67 @@ func (h *VrrpVppHandler) DumpVrrpEntries() (entries []*vppcalls.VrrpDetails, err
 				IpAddresses: ipStrs,
 				Enabled:     isEnabled,
 			},
-			Meta: &vppcalls.VrrpMeta{},
+			Meta: &vppcalls.VrrpMeta{
+				Role:     fromVppVrrpState(vrrpDetails.Runtime.State),
+				Priority: uint32(vrrpDetails.Runtime.Tracking.Priority),
+			},
 		}
 
 		entries = append(entries, vrrp)
+		keys = append(keys, vrrpKey(vrrpDetails.Config))
+	}
+
+	// tracked interfaces are dumped per virtual router
+	for i, entry := range entries {
+		tracked, err := h.dumpVrrpTrackedInterfaces(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		entry.Vrrp.TrackedInterfaces = tracked
 	}
 
 	return entries, nil
 }
+
+// dumpVrrpTrackedInterfaces dumps interfaces tracked by the given virtual router.
+func (h *VrrpVppHandler) dumpVrrpTrackedInterfaces(key vrrp.VrrpVrKey) (
+	tracked []*l3.VRRPEntry_TrackedInterface, err error) {
+	req := &vrrp.VrrpVrTrackIfDump{
+		SwIfIndex: key.SwIfIndex,
+		IsIPv6:    key.IsIPv6,
+		VrID:      key.VrID,
+	}
+	reqCtx := h.callsChannel.SendMultiRequest(req)
+	for {
+		details := &vrrp.VrrpVrTrackIfDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, trackedIf := range details.Ifs {
+			ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(trackedIf.SwIfIndex))
+			if !exists {
+				h.log.Warnf("VRRP dump: tracked interface name not found for index %d", trackedIf.SwIfIndex)
+				continue
+			}
+			tracked = append(tracked, &l3.VRRPEntry_TrackedInterface{
+				Interface: ifName,
+				Priority:  uint32(trackedIf.Priority),
+			})
+		}
+	}
+	return tracked, nil
+}
+
+// vrrpKey returns key identifying virtual router in VPP.
+func vrrpKey(config vrrp.VrrpVrConf) vrrp.VrrpVrKey {
+	isIPv6 := (config.Flags & vrrp.VRRP_API_VR_IPV6) == vrrp.VRRP_API_VR_IPV6
+	return vrrp.VrrpVrKey{
+		SwIfIndex: config.SwIfIndex,
+		VrID:      config.VrID,
+		IsIPv6:    boolToUint(isIPv6),
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vrrp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
@@ -127,6 +128,85 @@ func TestStopVrrp(t *testing.T) {
 	Expect(err).To(Succeed())
 }
 
+// Test an adding and deletion of the VRRP tracked interfaces
+func TestAddDelVrrpTrackedInterfaces(t *testing.T) {
+	ctx, ifIndexes, vrrpHandler := vrrpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	tracked := []*l3.VRRPEntry_TrackedInterface{
+		{Interface: "if2", Priority: 20},
+	}
+
+	ctx.MockVpp.MockReply(&vrrp.VrrpVrTrackIfAddDelReply{})
+	err := vrrpHandler.VppAddVrrpTrackedInterfaces(vrrpEntries[0], tracked)
+	Expect(err).To(Succeed())
+	msg, ok := ctx.MockChannel.Msg.(*vrrp.VrrpVrTrackIfAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeEquivalentTo(1))
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(msg.VrID).To(BeEquivalentTo(4))
+	Expect(msg.NIfs).To(BeEquivalentTo(1))
+	Expect(msg.Ifs[0].SwIfIndex).To(BeEquivalentTo(2))
+	Expect(msg.Ifs[0].Priority).To(BeEquivalentTo(20))
+
+	ctx.MockVpp.MockReply(&vrrp.VrrpVrTrackIfAddDelReply{})
+	err = vrrpHandler.VppDelVrrpTrackedInterfaces(vrrpEntries[0], tracked)
+	Expect(err).To(Succeed())
+	msg, ok = ctx.MockChannel.Msg.(*vrrp.VrrpVrTrackIfAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeEquivalentTo(0))
+
+	// unknown tracked interface
+	err = vrrpHandler.VppAddVrrpTrackedInterfaces(vrrpEntries[0], []*l3.VRRPEntry_TrackedInterface{
+		{Interface: "if3", Priority: 20},
+	})
+	Expect(err).ToNot(Succeed())
+}
+
+// Test a dump of the VRRP with role and tracked interfaces
+func TestDumpVrrp(t *testing.T) {
+	ctx, ifIndexes, vrrpHandler := vrrpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vrrp.VrrpVrDetails{
+		Config: vrrp.VrrpVrConf{
+			SwIfIndex: 1,
+			VrID:      4,
+			Priority:  100,
+			Interval:  10,
+		},
+		Runtime: vrrp.VrrpVrRuntime{
+			State:    vrrp.VRRP_API_VR_STATE_MASTER,
+			Tracking: vrrp.VrrpVrTracking{Priority: 80},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vrrp.VrrpVrTrackIfDetails{
+		SwIfIndex: 1,
+		VrID:      4,
+		NIfs:      1,
+		Ifs:       []vrrp.VrrpVrTrackIf{{SwIfIndex: 2, Priority: 20}},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	entries, err := vrrpHandler.DumpVrrpEntries()
+	Expect(err).To(Succeed())
+	Expect(entries).To(HaveLen(1))
+	Expect(entries[0].Vrrp.Interface).To(Equal("if1"))
+	Expect(entries[0].Vrrp.Enabled).To(BeTrue())
+	Expect(entries[0].Meta.Role).To(Equal(l3.VRRPState_MASTER))
+	Expect(entries[0].Meta.Priority).To(BeEquivalentTo(80))
+	Expect(entries[0].Vrrp.TrackedInterfaces).To(HaveLen(1))
+	Expect(entries[0].Vrrp.TrackedInterfaces[0].Interface).To(Equal("if2"))
+	Expect(entries[0].Vrrp.TrackedInterfaces[0].Priority).To(BeEquivalentTo(20))
+}
+
 func vrrpTestSetup(t *testing.T) (*vppmock.TestCtx, ifaceidx.IfaceMetadataIndexRW, vppcalls.VrrpVppAPI) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
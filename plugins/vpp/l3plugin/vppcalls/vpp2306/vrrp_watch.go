@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vrrp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+)
+
+var (
+	// VrrpEventDeliverTimeout defines maximum time to deliver VRRP event upstream.
+	VrrpEventDeliverTimeout = time.Second
+	// VrrpNotifChanBufferSize defines size of VRRP notification channel buffer.
+	VrrpNotifChanBufferSize = 10
+)
+
+// WatchVrrpEvents implements VRRP handler.
+func (h *VrrpVppHandler) WatchVrrpEvents(ctx context.Context, eventsCh chan<- *vppcalls.VrrpEvent) error {
+	notifChan := make(chan govppapi.Message, VrrpNotifChanBufferSize)
+
+	// subscribe to VrrpVrEvent notifications
+	sub, err := h.callsChannel.SubscribeNotification(notifChan, &vrrp.VrrpVrEvent{})
+	if err != nil {
+		return errors.Errorf("subscribing to VPP notification (vrrp_vr_event) failed: %v", err)
+	}
+	unsub := func() {
+		if err := sub.Unsubscribe(); err != nil {
+			h.log.Warnf("unsubscribing VPP notification (vrrp_vr_event) failed: %v", err)
+		}
+	}
+
+	go func() {
+		h.log.Debugf("start watching VRRP events")
+		defer h.log.Debugf("done watching VRRP events (%v)", ctx.Err())
+
+		for {
+			select {
+			case e, open := <-notifChan:
+				if !open {
+					h.log.Debugf("VRRP events channel was closed")
+					unsub()
+					return
+				}
+
+				vrrpEvent, ok := e.(*vrrp.VrrpVrEvent)
+				if !ok {
+					h.log.Debugf("unexpected notification type: %#v", e)
+					continue
+				}
+
+				// try to send event
+				select {
+				case eventsCh <- toVrrpEvent(vrrpEvent):
+					// sent ok
+				case <-ctx.Done():
+					unsub()
+					return
+				default:
+					// channel full send event in goroutine for later processing
+					go func() {
+						select {
+						case eventsCh <- toVrrpEvent(vrrpEvent):
+							// sent ok
+						case <-time.After(VrrpEventDeliverTimeout):
+							h.log.Warnf("unable to deliver VRRP event, dropping it: %+v", vrrpEvent)
+						}
+					}()
+				}
+			case <-ctx.Done():
+				unsub()
+				return
+			}
+		}
+	}()
+
+	// enable VRRP events from VPP
+	req := &vrrp.WantVrrpVrEvents{
+		EnableDisable: true,
+		PID:           uint32(os.Getpid()),
+	}
+	reply := &vrrp.WantVrrpVrEventsReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		if errors.Is(err, govppapi.VPPApiError(govppapi.INVALID_REGISTRATION)) {
+			h.log.Warnf("already subscribed to VRRP events: %v", err)
+			return nil
+		}
+		return errors.Errorf("failed to watch VRRP events: %v", err)
+	}
+
+	return nil
+}
+
+func toVrrpEvent(e *vrrp.VrrpVrEvent) *vppcalls.VrrpEvent {
+	return &vppcalls.VrrpEvent{
+		SwIfIndex: uint32(e.Vr.SwIfIndex),
+		VrID:      uint32(e.Vr.VrID),
+		IsIPv6:    e.Vr.IsIPv6 != 0,
+		OldRole:   fromVppVrrpState(e.OldState),
+		NewRole:   fromVppVrrpState(e.NewState),
+	}
+}
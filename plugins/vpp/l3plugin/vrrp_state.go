@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l3plugin
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// vrrpStateUpdater watches VRRP events from VPP, keeps the last known role
+// of every virtual router and publishes the role changes.
+type vrrpStateUpdater struct {
+	log logging.Logger
+
+	kvScheduler     kvs.KVScheduler
+	vrrpHandler     vppcalls.VrrpVppAPI
+	ifIndexes       ifaceidx.IfaceMetadataIndex
+	stateDescriptor *descriptor.VrrpStateDescriptor
+	publishState    func(notification *l3.VRRPNotification)
+
+	// access guards access to states map
+	access sync.Mutex
+	states map[string]*l3.VRRPState // interface + VR ID -> state
+
+	vrrpEvents       chan *vppcalls.VrrpEvent
+	cancelVrrpEvents func()
+
+	wg sync.WaitGroup
+}
+
+func newVrrpStateUpdater(
+	kvScheduler kvs.KVScheduler,
+	vrrpHandler vppcalls.VrrpVppAPI,
+	ifIndexes ifaceidx.IfaceMetadataIndex,
+	stateDescriptor *descriptor.VrrpStateDescriptor,
+	publishState func(notification *l3.VRRPNotification),
+	logger logging.PluginLogger,
+) *vrrpStateUpdater {
+	return &vrrpStateUpdater{
+		log:             logger.NewLogger("vrrp-state"),
+		kvScheduler:     kvScheduler,
+		vrrpHandler:     vrrpHandler,
+		ifIndexes:       ifIndexes,
+		stateDescriptor: stateDescriptor,
+		publishState:    publishState,
+		states:          make(map[string]*l3.VRRPState),
+		vrrpEvents:      make(chan *vppcalls.VrrpEvent, 100),
+	}
+}
+
+// start starts watching for delivery of VRRP events.
+func (u *vrrpStateUpdater) start(ctx context.Context) {
+	u.wg.Add(1)
+	go u.watchVPPEvents(ctx)
+}
+
+// wait waits until the watcher has finished.
+func (u *vrrpStateUpdater) wait() {
+	u.wg.Wait()
+}
+
+// subscribeVPPEvents (re)subscribes for VRRP events from VPP.
+func (u *vrrpStateUpdater) subscribeVPPEvents(ctx context.Context) error {
+	if u.cancelVrrpEvents != nil {
+		u.cancelVrrpEvents()
+	}
+	ctx, u.cancelVrrpEvents = context.WithCancel(ctx)
+	return u.vrrpHandler.WatchVrrpEvents(ctx, u.vrrpEvents)
+}
+
+// watchVPPEvents watches for delivery of VRRP events from VPP.
+func (u *vrrpStateUpdater) watchVPPEvents(ctx context.Context) {
+	defer u.wg.Done()
+
+	for {
+		select {
+		case event := <-u.vrrpEvents:
+			// if the event is a result of a configuration change,
+			// make sure the associated transaction has already finalized
+			u.kvScheduler.TransactionBarrier()
+
+			u.processVrrpEvent(event)
+
+		case <-ctx.Done():
+			u.log.Debug("VRRP event watcher stopped")
+			return
+		}
+	}
+}
+
+// processVrrpEvent updates the role of the virtual router and publishes
+// the change (events not changing the role are ignored).
+func (u *vrrpStateUpdater) processVrrpEvent(event *vppcalls.VrrpEvent) {
+	iface, _, found := u.ifIndexes.LookupBySwIfIndex(event.SwIfIndex)
+	if !found {
+		u.log.Debugf("VRRP event for unknown interface with index %d, ignoring", event.SwIfIndex)
+		return
+	}
+
+	u.access.Lock()
+	id := vrrpID(iface, event.VrID)
+	if prev, ok := u.states[id]; ok && prev.Role == event.NewRole {
+		u.access.Unlock()
+		return
+	}
+	state := &l3.VRRPState{
+		Interface:  iface,
+		VrId:       event.VrID,
+		Role:       event.NewRole,
+		LastChange: time.Now().Unix(),
+	}
+	u.states[id] = state
+	u.access.Unlock()
+
+	u.log.Debugf("VRRP virtual router %d on interface %s changed role: %v -> %v",
+		state.VrId, state.Interface, event.OldRole, state.Role)
+
+	vrrpRoles.WithLabelValues(iface, strconv.FormatUint(uint64(state.VrId), 10)).Set(float64(state.Role))
+	u.stateDescriptor.UpdateRole(iface, state.VrId, state.Role)
+	u.publishState(&l3.VRRPNotification{
+		State: proto.Clone(state).(*l3.VRRPState),
+	})
+}
+
+// removeVrrp forgets the role of a removed virtual router.
+func (u *vrrpStateUpdater) removeVrrp(iface string, vrID uint32) {
+	u.access.Lock()
+	id := vrrpID(iface, vrID)
+	_, ok := u.states[id]
+	delete(u.states, id)
+	u.access.Unlock()
+
+	if ok {
+		vrrpRoles.DeleteLabelValues(iface, strconv.FormatUint(uint64(vrID), 10))
+	}
+	u.stateDescriptor.RemoveState(iface, vrID)
+}
+
+// vrrpID returns identifier of the VRRP virtual router.
+func vrrpID(iface string, vrID uint32) string {
+	return iface + "/" + strconv.FormatUint(uint64(vrID), 10)
+}
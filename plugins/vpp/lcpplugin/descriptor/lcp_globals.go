@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+	lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+const (
+	// LCPGlobalsDescriptorName is the name of the descriptor for global
+	// linux-cp settings.
+	LCPGlobalsDescriptorName = "vpp-lcp-globals"
+)
+
+// LCPGlobalsDescriptor teaches KVScheduler how to configure global settings
+// of the VPP linux-cp plugin.
+type LCPGlobalsDescriptor struct {
+	log        logging.Logger
+	lcpHandler vppcalls.LCPVppAPI
+}
+
+// NewLCPGlobalsDescriptor creates a new instance of the LCPGlobals descriptor.
+func NewLCPGlobalsDescriptor(lcpHandler vppcalls.LCPVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &LCPGlobalsDescriptor{
+		log:        log.NewLogger("lcp-globals-descriptor"),
+		lcpHandler: lcpHandler,
+	}
+	typedDescr := &adapter.LCPGlobalsDescriptor{
+		Name:          LCPGlobalsDescriptorName,
+		NBKeyPrefix:   lcp.ModelLCPGlobals.KeyPrefix(),
+		ValueTypeName: lcp.ModelLCPGlobals.ProtoName(),
+		KeySelector:   lcp.ModelLCPGlobals.IsKeyValid,
+		Create:        ctx.Create,
+		Update:        ctx.Update,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+	}
+	return adapter.NewLCPGlobalsDescriptor(typedDescr)
+}
+
+// Create applies global linux-cp settings.
+func (d *LCPGlobalsDescriptor) Create(key string, globals *lcp.LCPGlobals) (metadata interface{}, err error) {
+	if err = d.lcpHandler.SetLCPGlobals(context.TODO(), globals); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Update re-applies global linux-cp settings.
+func (d *LCPGlobalsDescriptor) Update(key string, oldGlobals, newGlobals *lcp.LCPGlobals, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	return d.Create(key, newGlobals)
+}
+
+// Delete reverts global linux-cp settings to defaults.
+func (d *LCPGlobalsDescriptor) Delete(key string, globals *lcp.LCPGlobals, metadata interface{}) error {
+	_, err := d.Create(key, &lcp.LCPGlobals{})
+	return err
+}
+
+// Retrieve returns global linux-cp settings, defaults are reported as obtained from SB.
+func (d *LCPGlobalsDescriptor) Retrieve(correlate []adapter.LCPGlobalsKVWithMetadata) (retrieved []adapter.LCPGlobalsKVWithMetadata, err error) {
+	globals, err := d.lcpHandler.DumpLCPGlobals(context.TODO())
+	if err != nil {
+		return nil, errors.Errorf("failed to dump linux-cp globals: %v", err)
+	}
+	// netlink synchronization is enabled by loading the VPP plugin, not configured
+	for _, kv := range correlate {
+		globals.NetlinkSync = kv.Value.NetlinkSync
+	}
+
+	origin := kvs.FromNB
+	if proto.Equal(globals, &lcp.LCPGlobals{}) {
+		origin = kvs.FromSB
+	}
+	retrieved = append(retrieved, adapter.LCPGlobalsKVWithMetadata{
+		Key:    lcp.GlobalsKey(),
+		Value:  globals,
+		Origin: origin,
+	})
+	return retrieved, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+const (
+	// LCPInterfacePairDescriptorName is the name of the descriptor for linux-cp
+	// interface pairs.
+	LCPInterfacePairDescriptorName = "vpp-lcp-interface-pair"
+
+	// maximum length of the host interface name (IFNAMSIZ without terminating zero)
+	maxHostIfNameLen = 15
+
+	// dependency labels
+	lcpInterfaceDep = "interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrLCPPairWithoutInterface is returned when linux-cp pair has undefined
+	// VPP interface.
+	ErrLCPPairWithoutInterface = errors.New("linux-cp pair defined without VPP interface")
+
+	// ErrLCPPairWithoutHostName is returned when linux-cp pair has undefined
+	// host interface name.
+	ErrLCPPairWithoutHostName = errors.New("linux-cp pair defined without host interface name")
+
+	// ErrLCPPairHostNameTooLong is returned when host interface name exceeds
+	// the limit of the Linux kernel.
+	ErrLCPPairHostNameTooLong = errors.New("linux-cp host interface name is too long")
+
+	// ErrLCPPairUnsupportedNamespace is returned when host namespace is not
+	// referenced by name, which is the only type supported by VPP.
+	ErrLCPPairUnsupportedNamespace = errors.New("linux-cp supports only named network namespaces")
+)
+
+// LCPInterfacePairDescriptor teaches KVScheduler how to pair VPP interfaces
+// with host interfaces using VPP linux-cp plugin.
+type LCPInterfacePairDescriptor struct {
+	log        logging.Logger
+	lcpHandler vppcalls.LCPVppAPI
+}
+
+// NewLCPInterfacePairDescriptor creates a new instance of the LCPInterfacePair descriptor.
+func NewLCPInterfacePairDescriptor(lcpHandler vppcalls.LCPVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &LCPInterfacePairDescriptor{
+		log:        log.NewLogger("lcp-pair-descriptor"),
+		lcpHandler: lcpHandler,
+	}
+	typedDescr := &adapter.LCPInterfacePairDescriptor{
+		Name:                 LCPInterfacePairDescriptorName,
+		NBKeyPrefix:          lcp.ModelLCPInterfacePair.KeyPrefix(),
+		ValueTypeName:        lcp.ModelLCPInterfacePair.ProtoName(),
+		KeySelector:          lcp.ModelLCPInterfacePair.IsKeyValid,
+		KeyLabel:             lcp.ModelLCPInterfacePair.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentPairs,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewLCPInterfacePairDescriptor(typedDescr)
+}
+
+// EquivalentPairs compares linux-cp pairs, namespaces are compared by reference.
+func (d *LCPInterfacePairDescriptor) EquivalentPairs(key string, oldPair, newPair *lcp.LCPInterfacePair) bool {
+	return oldPair.Interface == newPair.Interface &&
+		oldPair.HostIfName == newPair.HostIfName &&
+		oldPair.HostIfType == newPair.HostIfType &&
+		oldPair.GetNamespace().GetReference() == newPair.GetNamespace().GetReference()
+}
+
+// Validate validates linux-cp pair configuration.
+func (d *LCPInterfacePairDescriptor) Validate(key string, pair *lcp.LCPInterfacePair) error {
+	if pair.Interface == "" {
+		return kvs.NewInvalidValueError(ErrLCPPairWithoutInterface, "interface")
+	}
+	if pair.HostIfName == "" {
+		return kvs.NewInvalidValueError(ErrLCPPairWithoutHostName, "host_if_name")
+	}
+	if len(pair.HostIfName) > maxHostIfNameLen {
+		return kvs.NewInvalidValueError(ErrLCPPairHostNameTooLong, "host_if_name")
+	}
+	if ns := pair.GetNamespace(); ns != nil {
+		if ns.Type != linux_namespace.NetNamespace_NSID || ns.Reference == "" {
+			return kvs.NewInvalidValueError(ErrLCPPairUnsupportedNamespace, "namespace")
+		}
+	}
+	return nil
+}
+
+// Create pairs VPP interface with a newly created host interface.
+func (d *LCPInterfacePairDescriptor) Create(key string, pair *lcp.LCPInterfacePair) (metadata interface{}, err error) {
+	if _, err = d.lcpHandler.AddLCPInterfacePair(context.TODO(), pair); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes linux-cp pair together with the host interface.
+func (d *LCPInterfacePairDescriptor) Delete(key string, pair *lcp.LCPInterfacePair, metadata interface{}) error {
+	if err := d.lcpHandler.DeleteLCPInterfacePair(context.TODO(), pair); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all linux-cp pairs configured in VPP.
+func (d *LCPInterfacePairDescriptor) Retrieve(correlate []adapter.LCPInterfacePairKVWithMetadata) (retrieved []adapter.LCPInterfacePairKVWithMetadata, err error) {
+	// VPP reports the default namespace for pairs created without one
+	nbPairs := make(map[string]*lcp.LCPInterfacePair, len(correlate))
+	for _, kv := range correlate {
+		nbPairs[kv.Value.Interface] = kv.Value
+	}
+
+	pairs, err := d.lcpHandler.DumpLCPInterfacePairs(context.TODO())
+	if err != nil {
+		return nil, errors.Errorf("failed to dump linux-cp pairs: %v", err)
+	}
+	for _, details := range pairs {
+		if nbPair, ok := nbPairs[details.Pair.Interface]; ok && nbPair.Namespace == nil {
+			details.Pair.Namespace = nil
+		}
+		retrieved = append(retrieved, adapter.LCPInterfacePairKVWithMetadata{
+			Key:    lcp.InterfacePairKey(details.Pair.Interface),
+			Value:  details.Pair,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the paired VPP interface as the only dependency.
+func (d *LCPInterfacePairDescriptor) Dependencies(key string, pair *lcp.LCPInterfacePair) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: lcpInterfaceDep,
+			Key:   interfaces.InterfaceKey(pair.Interface),
+		},
+	}
+}
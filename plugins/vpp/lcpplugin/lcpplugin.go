@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name LCPInterfacePair --value-type *vpp_lcp.LCPInterfacePair --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name LCPGlobals --value-type *vpp_lcp.LCPGlobals --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp" --output-dir "descriptor"
+
+package lcpplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls/vpp2306"
+)
+
+func init() {
+	kvscheduler.AddNonRetryableError(vppcalls.ErrNetlinkSyncUnavailable)
+}
+
+// LCPPlugin is a plugin that manages VPP linux-cp interface pairs and settings.
+type LCPPlugin struct {
+	Deps
+
+	lcpHandler vppcalls.LCPVppAPI
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes linux-cp plugin.
+func (p *LCPPlugin) Init() (err error) {
+	// init handler
+	p.lcpHandler = vppcalls.CompatibleLCPVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.lcpHandler == nil {
+		p.Log.Warnf("Linux-cp handler is not available, VPP linux_cp plugin is probably not loaded")
+		return nil
+	}
+
+	// init & register descriptors
+	pairDescriptor := descriptor.NewLCPInterfacePairDescriptor(p.lcpHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(pairDescriptor); err != nil {
+		return err
+	}
+	globalsDescriptor := descriptor.NewLCPGlobalsDescriptor(p.lcpHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(globalsDescriptor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *LCPPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
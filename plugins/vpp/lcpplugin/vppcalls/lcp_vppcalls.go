@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	"context"
+	"errors"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+// ErrNetlinkSyncUnavailable is returned when netlink synchronization is requested
+// but VPP linux_nl plugin is not loaded.
+var ErrNetlinkSyncUnavailable = errors.New("netlink synchronization requires VPP linux_nl plugin to be loaded")
+
+// LCPInterfacePairDetails contains proto-modeled linux-cp interface pair
+// together with VPP-related metadata.
+type LCPInterfacePairDetails struct {
+	Pair *lcp.LCPInterfacePair `json:"pair"`
+	Meta *LCPInterfacePairMeta `json:"pair_meta"`
+}
+
+// LCPInterfacePairMeta contains indexes of the paired interfaces.
+type LCPInterfacePairMeta struct {
+	PhySwIfIndex  uint32 `json:"phy_sw_if_index"`
+	HostSwIfIndex uint32 `json:"host_sw_if_index"` // VPP-side of the host TAP/TUN
+	VifIndex      uint32 `json:"vif_index"`        // Linux index of the host interface
+	Netns         string `json:"netns"`
+}
+
+// LCPVppAPI provides read/write methods required to handle VPP linux-cp plugin.
+type LCPVppAPI interface {
+	LCPVppRead
+
+	// AddLCPInterfacePair creates host interface paired with the given VPP interface.
+	AddLCPInterfacePair(ctx context.Context, pair *lcp.LCPInterfacePair) (hostSwIfIndex uint32, err error)
+	// DeleteLCPInterfacePair removes pair of the given VPP interface together
+	// with the host interface.
+	DeleteLCPInterfacePair(ctx context.Context, pair *lcp.LCPInterfacePair) error
+	// SetLCPGlobals applies global linux-cp settings.
+	SetLCPGlobals(ctx context.Context, globals *lcp.LCPGlobals) error
+}
+
+// LCPVppRead provides read methods for VPP linux-cp plugin.
+type LCPVppRead interface {
+	// DumpLCPInterfacePairs retrieves all linux-cp interface pairs.
+	DumpLCPInterfacePairs(ctx context.Context) ([]*LCPInterfacePairDetails, error)
+	// DumpLCPGlobals retrieves global linux-cp settings.
+	DumpLCPGlobals(ctx context.Context) (*lcp.LCPGlobals, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "lcp",
+	HandlerAPI: (*LCPVppAPI)(nil),
+})
+
+type NewHandlerFunc func(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) LCPVppAPI
+
+func AddLCPHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			return h(c, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleLCPVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) LCPVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(LCPVppAPI)
+	}
+	return nil
+}
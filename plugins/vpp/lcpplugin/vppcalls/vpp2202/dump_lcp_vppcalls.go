@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/api"
+
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+// DumpLCPInterfacePairs implements linux-cp handler.
+func (h *LCPVppHandler) DumpLCPInterfacePairs(ctx context.Context) (pairs []*vppcalls.LCPInterfacePairDetails, err error) {
+	// pairs are sent in batches, VPP asks to continue from the returned
+	// cursor by EAGAIN
+	var cursor uint32
+	for {
+		stream, err := h.lcp.LcpItfPairGet(ctx, &vpp_lcp.LcpItfPairGet{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to dump linux-cp pairs")
+		}
+		var more bool
+		for {
+			details, reply, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if errors.Is(err, api.EAGAIN) {
+				cursor, more = reply.Cursor, true
+				break
+			} else if err != nil {
+				return nil, errors.Wrap(err, "failed to dump linux-cp pairs")
+			}
+			ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(details.PhySwIfIndex))
+			if !found {
+				h.log.Debugf("linux-cp pair dump: interface with index %d not found", details.PhySwIfIndex)
+				continue
+			}
+			pair := &lcp.LCPInterfacePair{
+				Interface:  ifName,
+				HostIfName: details.HostIfName,
+				HostIfType: hostIfTypeFromVpp(details.HostIfType),
+			}
+			if details.Netns != "" {
+				pair.Namespace = &linux_namespace.NetNamespace{
+					Type:      linux_namespace.NetNamespace_NSID,
+					Reference: details.Netns,
+				}
+			}
+			pairs = append(pairs, &vppcalls.LCPInterfacePairDetails{
+				Pair: pair,
+				Meta: &vppcalls.LCPInterfacePairMeta{
+					PhySwIfIndex:  uint32(details.PhySwIfIndex),
+					HostSwIfIndex: uint32(details.HostSwIfIndex),
+					VifIndex:      details.VifIndex,
+					Netns:         details.Netns,
+				},
+			})
+		}
+		if !more {
+			return pairs, nil
+		}
+	}
+}
+
+// DumpLCPGlobals implements linux-cp handler.
+func (h *LCPVppHandler) DumpLCPGlobals(ctx context.Context) (*lcp.LCPGlobals, error) {
+	reply, err := h.lcp.LcpDefaultNsGet(ctx, &vpp_lcp.LcpDefaultNsGet{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get linux-cp default namespace")
+	}
+	globals := &lcp.LCPGlobals{
+		DefaultNamespace: reply.Netns,
+	}
+
+	// synchronization settings are available only via CLI
+	out, err := h.runCliWithReply(ctx, lcpShowCmd)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		switch strings.Join(fields[:2], " ") {
+		case lcpSyncCmd:
+			globals.InterfaceSync = fields[2] == "on"
+		case lcpAutoSubintCmd:
+			globals.AutoSubinterfaces = fields[2] == "on"
+		}
+	}
+	return globals, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+func TestAddLCPInterfacePair(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpItfPairAddDelV2Reply{
+		HostSwIfIndex: 5,
+	})
+	hostSwIfIndex, err := lcpHandler.AddLCPInterfacePair(ctx.Context, &lcp.LCPInterfacePair{
+		Interface:  "if0",
+		HostIfName: "host-if0",
+		HostIfType: lcp.LCPInterfacePair_TUN,
+		Namespace: &linux_namespace.NetNamespace{
+			Type:      linux_namespace.NetNamespace_NSID,
+			Reference: "ns1",
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(hostSwIfIndex).To(BeEquivalentTo(5))
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_lcp.LcpItfPairAddDelV2{
+		IsAdd:      true,
+		SwIfIndex:  1,
+		HostIfName: "host-if0",
+		HostIfType: vpp_lcp.LCP_API_ITF_HOST_TUN,
+		Netns:      "ns1",
+	}))
+}
+
+func TestAddLCPInterfacePairMissingInterface(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := lcpHandler.AddLCPInterfacePair(ctx.Context, &lcp.LCPInterfacePair{
+		Interface:  "if1",
+		HostIfName: "host-if1",
+	})
+	Expect(err).Should(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
+}
+
+func TestAddLCPInterfacePairRetval(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpItfPairAddDelV2Reply{
+		Retval: -1,
+	})
+	_, err := lcpHandler.AddLCPInterfacePair(ctx.Context, &lcp.LCPInterfacePair{
+		Interface:  "if0",
+		HostIfName: "host-if0",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDeleteLCPInterfacePair(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpItfPairAddDelV2Reply{})
+	err := lcpHandler.DeleteLCPInterfacePair(ctx.Context, &lcp.LCPInterfacePair{
+		Interface:  "if0",
+		HostIfName: "host-if0",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_lcp.LcpItfPairAddDelV2{
+		IsAdd:     false,
+		SwIfIndex: 1,
+	}))
+}
+
+func TestSetLCPGlobals(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpDefaultNsSetReply{})
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{})
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{})
+	err := lcpHandler.SetLCPGlobals(ctx.Context, &lcp.LCPGlobals{
+		DefaultNamespace: "dataplane",
+		InterfaceSync:    true,
+		NetlinkSync:      true,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(3))
+	Expect(ctx.MockChannel.Msgs[0]).To(Equal(&vpp_lcp.LcpDefaultNsSet{
+		Netns: "dataplane",
+	}))
+	Expect(ctx.MockChannel.Msgs[1]).To(Equal(&vlib.CliInband{
+		Cmd: "lcp lcp-sync on",
+	}))
+	Expect(ctx.MockChannel.Msgs[2]).To(Equal(&vlib.CliInband{
+		Cmd: "lcp lcp-auto-subint off",
+	}))
+}
+
+func TestDumpLCPInterfacePairs(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_lcp.LcpItfPairDetails{
+			PhySwIfIndex:  1,
+			HostSwIfIndex: 5,
+			VifIndex:      12,
+			HostIfName:    "host-if0",
+			HostIfType:    vpp_lcp.LCP_API_ITF_HOST_TAP,
+			Netns:         "ns1",
+		},
+		&vpp_lcp.LcpItfPairGetReply{
+			Retval: int32(api.EAGAIN),
+			Cursor: 1,
+		},
+	)
+	ctx.MockVpp.MockReply(
+		&vpp_lcp.LcpItfPairDetails{
+			PhySwIfIndex:  10,
+			HostSwIfIndex: 11,
+			HostIfName:    "unknown",
+		},
+		&vpp_lcp.LcpItfPairGetReply{},
+	)
+	pairs, err := lcpHandler.DumpLCPInterfacePairs(ctx.Context)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(pairs).To(HaveLen(1))
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	Expect(ctx.MockChannel.Msgs[1]).To(Equal(&vpp_lcp.LcpItfPairGet{
+		Cursor: 1,
+	}))
+	Expect(pairs[0].Pair).To(Equal(&lcp.LCPInterfacePair{
+		Interface:  "if0",
+		HostIfName: "host-if0",
+		HostIfType: lcp.LCPInterfacePair_TAP,
+		Namespace: &linux_namespace.NetNamespace{
+			Type:      linux_namespace.NetNamespace_NSID,
+			Reference: "ns1",
+		},
+	}))
+	Expect(pairs[0].Meta).To(Equal(&vppcalls.LCPInterfacePairMeta{
+		PhySwIfIndex:  1,
+		HostSwIfIndex: 5,
+		VifIndex:      12,
+		Netns:         "ns1",
+	}))
+}
+
+func TestDumpLCPGlobals(t *testing.T) {
+	ctx, lcpHandler := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpDefaultNsGetReply{
+		Netns: "dataplane",
+	})
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{
+		Reply: "lcp default netns 'dataplane'\nlcp lcp-auto-subint on\nlcp lcp-sync off\n",
+	})
+	globals, err := lcpHandler.DumpLCPGlobals(ctx.Context)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(globals).To(Equal(&lcp.LCPGlobals{
+		DefaultNamespace:  "dataplane",
+		AutoSubinterfaces: true,
+	}))
+}
+
+func lcpTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.LCPVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test")
+	ifIndexes := ifaceidx.NewIfaceIndex(log, "test")
+	ifIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	return ctx, vpp2202.NewLCPVppHandler(ctx.MockVPPClient, ifIndexes, log)
+}
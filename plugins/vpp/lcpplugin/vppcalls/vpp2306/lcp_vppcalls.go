@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface_types"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+	lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+// CLI commands for linux-cp settings not covered by the binary API
+const (
+	lcpSyncCmd       = "lcp lcp-sync"
+	lcpAutoSubintCmd = "lcp lcp-auto-subint"
+	lcpShowCmd       = "show lcp"
+)
+
+// AddLCPInterfacePair implements linux-cp handler.
+func (h *LCPVppHandler) AddLCPInterfacePair(ctx context.Context, pair *lcp.LCPInterfacePair) (uint32, error) {
+	ifMeta, found := h.ifIndexes.LookupByName(pair.Interface)
+	if !found {
+		return 0, errors.Errorf("failed to add linux-cp pair: interface %s not found", pair.Interface)
+	}
+	reply, err := h.lcp.LcpItfPairAddDelV2(ctx, &vpp_lcp.LcpItfPairAddDelV2{
+		IsAdd:      true,
+		SwIfIndex:  interface_types.InterfaceIndex(ifMeta.SwIfIndex),
+		HostIfName: pair.HostIfName,
+		HostIfType: hostIfTypeToVpp(pair.HostIfType),
+		Netns:      pair.GetNamespace().GetReference(),
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to add linux-cp pair for interface %s", pair.Interface)
+	}
+	return uint32(reply.HostSwIfIndex), nil
+}
+
+// DeleteLCPInterfacePair implements linux-cp handler.
+func (h *LCPVppHandler) DeleteLCPInterfacePair(ctx context.Context, pair *lcp.LCPInterfacePair) error {
+	ifMeta, found := h.ifIndexes.LookupByName(pair.Interface)
+	if !found {
+		return errors.Errorf("failed to delete linux-cp pair: interface %s not found", pair.Interface)
+	}
+	_, err := h.lcp.LcpItfPairAddDelV2(ctx, &vpp_lcp.LcpItfPairAddDelV2{
+		IsAdd:     false,
+		SwIfIndex: interface_types.InterfaceIndex(ifMeta.SwIfIndex),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete linux-cp pair for interface %s", pair.Interface)
+	}
+	return nil
+}
+
+// SetLCPGlobals implements linux-cp handler.
+func (h *LCPVppHandler) SetLCPGlobals(ctx context.Context, globals *lcp.LCPGlobals) error {
+	if globals.NetlinkSync && !h.netlinkSync {
+		return vppcalls.ErrNetlinkSyncUnavailable
+	}
+	if _, err := h.lcp.LcpDefaultNsSet(ctx, &vpp_lcp.LcpDefaultNsSet{
+		Netns: globals.DefaultNamespace,
+	}); err != nil {
+		return errors.Wrap(err, "failed to set linux-cp default namespace")
+	}
+	if err := h.runCli(ctx, fmt.Sprintf("%s %s", lcpSyncCmd, onOff(globals.InterfaceSync))); err != nil {
+		return err
+	}
+	return h.runCli(ctx, fmt.Sprintf("%s %s", lcpAutoSubintCmd, onOff(globals.AutoSubinterfaces)))
+}
+
+func (h *LCPVppHandler) runCli(ctx context.Context, cmd string) error {
+	_, err := h.runCliWithReply(ctx, cmd)
+	return err
+}
+
+func (h *LCPVppHandler) runCliWithReply(ctx context.Context, cmd string) (string, error) {
+	reply, err := h.vlib.CliInband(ctx, &vlib.CliInband{
+		Cmd: cmd,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "VPP CLI command '%s' failed", cmd)
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return "", err
+	}
+	return reply.Reply, nil
+}
+
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
+
+func hostIfTypeToVpp(hostIfType lcp.LCPInterfacePair_HostIfType) vpp_lcp.LcpItfHostType {
+	if hostIfType == lcp.LCPInterfacePair_TUN {
+		return vpp_lcp.LCP_API_ITF_HOST_TUN
+	}
+	return vpp_lcp.LCP_API_ITF_HOST_TAP
+}
+
+func hostIfTypeFromVpp(hostIfType vpp_lcp.LcpItfHostType) lcp.LCPInterfacePair_HostIfType {
+	if hostIfType == vpp_lcp.LCP_API_ITF_HOST_TUN {
+		return lcp.LCPInterfacePair_TUN
+	}
+	return lcp.LCPInterfacePair_TAP
+}
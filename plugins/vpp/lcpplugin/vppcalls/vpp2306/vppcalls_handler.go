@@ -0,0 +1,59 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lcpplugin/vppcalls"
+)
+
+// name of the VPP plugin synchronizing routes and neighbors from Linux
+const linuxNlPlugin = "linux_nl"
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_lcp.AllMessages()...)
+
+	vppcalls.AddLCPHandlerVersion(vpp2306.Version, msgs, NewLCPVppHandler)
+}
+
+// LCPVppHandler is accessor for linux-cp related vppcalls methods.
+type LCPVppHandler struct {
+	lcp       vpp_lcp.RPCService
+	vlib      vlib.RPCService
+	ifIndexes ifaceidx.IfaceMetadataIndex
+	log       logging.Logger
+
+	// netlink synchronization is available only with linux_nl plugin
+	netlinkSync bool
+}
+
+// NewLCPVppHandler creates new instance of linux-cp vppcalls handler.
+func NewLCPVppHandler(c vpp.Client, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.LCPVppAPI {
+	return &LCPVppHandler{
+		lcp:         vpp_lcp.NewServiceClient(c),
+		vlib:        vlib.NewServiceClient(c),
+		ifIndexes:   ifIndexes,
+		log:         log,
+		netlinkSync: c.IsPluginLoaded(linuxNlPlugin),
+	}
+}
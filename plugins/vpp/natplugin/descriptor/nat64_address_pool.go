@@ -0,0 +1,211 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"net"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64AddressPoolDescriptorName is the name of the descriptor for NAT64 IP address pools.
+	NAT64AddressPoolDescriptorName = "vpp-nat64-address-pool"
+)
+
+// NAT64AddressPoolDescriptor teaches KVScheduler how to add/remove VPP NAT64 IP addresses pools.
+type NAT64AddressPoolDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64AddressPoolDescriptor creates a new instance of the NAT64AddressPoolDescriptor.
+func NewNAT64AddressPoolDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64AddressPoolDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-address-pool-descriptor"),
+	}
+	typedDescr := &adapter.NAT64AddressPoolDescriptor{
+		Name:                 NAT64AddressPoolDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64AddressPool.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64AddressPool.ProtoName(),
+		KeySelector:          nat.ModelNat64AddressPool.IsKeyValid,
+		KeyLabel:             nat.ModelNat64AddressPool.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64AddressPoolDescriptor(typedDescr)
+}
+
+// Validate validates configuration for NAT64 IP addresses pool.
+func (d *NAT64AddressPoolDescriptor) Validate(key string, natAddr *nat.Nat64AddressPool) error {
+	firstIP, err := ParseIPv4(natAddr.FirstIp)
+	if err != nil {
+		return kvs.NewInvalidValueError(errInvalidIPAddress, "first_ip")
+	}
+	if natAddr.LastIp != "" {
+		lastIP, err := ParseIPv4(natAddr.LastIp)
+		if err != nil {
+			return kvs.NewInvalidValueError(errInvalidIPAddress, "last_ip")
+		}
+		if bytes.Compare(firstIP, lastIP) > 0 {
+			// last IP should be empty or higher than first IP
+			return kvs.NewInvalidValueError(errInvalidLastPoolAddress, "last_ip")
+		}
+	}
+	return nil
+}
+
+// Create adds IP address pool into VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Create(key string, natAddr *nat.Nat64AddressPool) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64AddressPool(natAddr.VrfId, natAddr.FirstIp, natAddr.LastIp)
+}
+
+// Delete removes IP address pool from VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Delete(key string, natAddr *nat.Nat64AddressPool, metadata interface{}) error {
+	return d.nat64Handler.DelNat64AddressPool(natAddr.VrfId, natAddr.FirstIp, natAddr.LastIp)
+}
+
+// Retrieve returns NAT64 address pools configured on VPP.
+// VPP dumps individual addresses which are merged into ranges, therefore
+// NB pools are correlated by the addresses they cover. Addresses not covered
+// by any NB pool are returned as single-address pools.
+func (d *NAT64AddressPoolDescriptor) Retrieve(correlate []adapter.NAT64AddressPoolKVWithMetadata) (
+	retrieved []adapter.NAT64AddressPoolKVWithMetadata, err error) {
+	sbPools, err := d.nat64Handler.Nat64AddressPoolsDump()
+	if err != nil {
+		return nil, err
+	}
+
+	// collect all addresses configured in VPP
+	sbAddrs := make(map[nat64PoolAddr]struct{})
+	for _, sbPool := range sbPools {
+		for _, addr := range nat64PoolAddresses(sbPool) {
+			sbAddrs[addr] = struct{}{}
+		}
+	}
+
+	// find NB pools with all addresses present in VPP
+	for _, nbPool := range correlate {
+		addrs := nat64PoolAddresses(nbPool.Value)
+		if len(addrs) == 0 {
+			continue
+		}
+		found := true
+		for _, addr := range addrs {
+			if _, ok := sbAddrs[addr]; !ok {
+				found = false
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		for _, addr := range addrs {
+			delete(sbAddrs, addr)
+		}
+		retrieved = append(retrieved, adapter.NAT64AddressPoolKVWithMetadata{
+			Key:    models.Key(nbPool.Value),
+			Value:  nbPool.Value,
+			Origin: kvs.FromNB,
+		})
+	}
+
+	// addresses without NB pool
+	for _, sbPool := range sbPools {
+		for _, addr := range nat64PoolAddresses(sbPool) {
+			if _, ok := sbAddrs[addr]; !ok {
+				continue
+			}
+			pool := &nat.Nat64AddressPool{
+				VrfId:   addr.vrf,
+				FirstIp: addr.ip,
+			}
+			retrieved = append(retrieved, adapter.NAT64AddressPoolKVWithMetadata{
+				Key:    models.Key(pool),
+				Value:  pool,
+				Origin: kvs.FromNB,
+			})
+		}
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the NAT64 global configuration and non-zero/non-all-ones VRF as dependencies.
+func (d *NAT64AddressPoolDescriptor) Dependencies(key string, natAddr *nat.Nat64AddressPool) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if natAddr.VrfId != 0 && natAddr.VrfId != ^uint32(0) {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(natAddr.VrfId, l3.VrfTable_IPV4),
+		})
+	}
+	return deps
+}
+
+// nat64PoolAddr is a single address of a NAT64 address pool.
+type nat64PoolAddr struct {
+	vrf uint32
+	ip  string
+}
+
+// nat64PoolAddresses returns all addresses of the given NAT64 address pool.
+func nat64PoolAddresses(pool *nat.Nat64AddressPool) (addrs []nat64PoolAddr) {
+	firstIP, err := ParseIPv4(pool.FirstIp)
+	if err != nil {
+		return nil
+	}
+	lastIP := firstIP
+	if pool.LastIp != "" {
+		if lastIP, err = ParseIPv4(pool.LastIp); err != nil {
+			return nil
+		}
+	}
+	for ip := firstIP; bytes.Compare(ip, lastIP) <= 0; ip = nextIPv4(ip) {
+		addrs = append(addrs, nat64PoolAddr{vrf: pool.VrfId, ip: ip.String()})
+		if ip.Equal(net.IPv4bcast) {
+			break
+		}
+	}
+	return addrs
+}
+
+// nextIPv4 returns the IPv4 address following the given one.
+func nextIPv4(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] > 0 {
+			break
+		}
+	}
+	return next
+}
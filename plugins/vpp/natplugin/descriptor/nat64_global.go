@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64GlobalDescriptorName is the name of the descriptor for VPP NAT64 global
+	// configuration.
+	NAT64GlobalDescriptorName = "vpp-nat64-global"
+
+	// dependency labels
+	nat64GlobalCfgDep = "nat64-global-config-exists"
+)
+
+// NAT64GlobalDescriptor teaches KVScheduler how to enable and disable VPP NAT64 plugin.
+type NAT64GlobalDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64GlobalDescriptor creates a new instance of the NAT64Global descriptor.
+func NewNAT64GlobalDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64GlobalDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-global-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64GlobalDescriptor{
+		Name:                 NAT64GlobalDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64Global.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64Global.ProtoName(),
+		KeySelector:          nat.ModelNat64Global.IsKeyValid,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewNAT64GlobalDescriptor(typedDescr)
+}
+
+// Create enables NAT64 plugin. Any change of the global configuration
+// is applied by re-enabling the plugin (descriptor does not implement Update).
+func (d *NAT64GlobalDescriptor) Create(key string, globalCfg *nat.Nat64Global) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.EnableNat64Plugin(globalCfg)
+}
+
+// Delete disables NAT64 plugin.
+func (d *NAT64GlobalDescriptor) Delete(key string, globalCfg *nat.Nat64Global, metadata interface{}) error {
+	return d.nat64Handler.DisableNat64Plugin()
+}
+
+// Retrieve returns the NAT64 global configuration if the plugin is enabled.
+// VPP does not provide a way to read the plugin state and its hash table sizes,
+// the plugin is therefore considered enabled when there is any NAT64
+// configuration in VPP and the configuration values are taken from NB.
+func (d *NAT64GlobalDescriptor) Retrieve(correlate []adapter.NAT64GlobalKVWithMetadata) (
+	retrieved []adapter.NAT64GlobalKVWithMetadata, err error) {
+	enabled, err := d.isEnabled()
+	if err != nil || !enabled {
+		return nil, err
+	}
+	globalCfg := &nat.Nat64Global{}
+	if len(correlate) > 0 {
+		globalCfg = correlate[0].Value
+	}
+	retrieved = append(retrieved, adapter.NAT64GlobalKVWithMetadata{
+		Key:    nat.GlobalNAT64Key(),
+		Value:  globalCfg,
+		Origin: kvs.FromNB,
+	})
+	return retrieved, nil
+}
+
+// isEnabled returns true if there is any NAT64 configuration in VPP.
+func (d *NAT64GlobalDescriptor) isEnabled() (bool, error) {
+	natIfs, err := d.nat64Handler.Nat64InterfacesDump()
+	if err != nil || len(natIfs) > 0 {
+		return len(natIfs) > 0, err
+	}
+	pools, err := d.nat64Handler.Nat64AddressPoolsDump()
+	if err != nil || len(pools) > 0 {
+		return len(pools) > 0, err
+	}
+	prefixes, err := d.nat64Handler.Nat64PrefixesDump()
+	if err != nil || len(prefixes) > 0 {
+		return len(prefixes) > 0, err
+	}
+	bibs, err := d.nat64Handler.Nat64StaticBibsDump()
+	return len(bibs) > 0, err
+}
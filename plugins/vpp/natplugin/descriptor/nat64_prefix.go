@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64PrefixDescriptorName is the name of the descriptor for NAT64 prefixes.
+	NAT64PrefixDescriptorName = "vpp-nat64-prefix"
+
+	// dependency labels
+	nat64VrfDep = "vrf-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidNAT64Prefix is returned when NAT64 prefix is not a valid IPv6 network.
+	ErrInvalidNAT64Prefix = errors.New("NAT64 prefix is not a valid IPv6 network")
+	// ErrInvalidNAT64PrefixLength is returned when NAT64 prefix length is not supported (RFC 6052).
+	ErrInvalidNAT64PrefixLength = errors.New("NAT64 prefix length must be one of 32, 40, 48, 56, 64 or 96")
+)
+
+// NAT64PrefixDescriptor teaches KVScheduler how to add/remove VPP NAT64 prefixes.
+type NAT64PrefixDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64PrefixDescriptor creates a new instance of the NAT64PrefixDescriptor.
+func NewNAT64PrefixDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64PrefixDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-prefix-descriptor"),
+	}
+	typedDescr := &adapter.NAT64PrefixDescriptor{
+		Name:                 NAT64PrefixDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64Prefix.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64Prefix.ProtoName(),
+		KeySelector:          nat.ModelNat64Prefix.IsKeyValid,
+		KeyLabel:             nat.ModelNat64Prefix.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64PrefixDescriptor(typedDescr)
+}
+
+// Validate validates NAT64 prefix.
+func (d *NAT64PrefixDescriptor) Validate(key string, prefix *nat.Nat64Prefix) error {
+	ip, ipNet, err := net.ParseCIDR(prefix.Prefix)
+	if err != nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(ErrInvalidNAT64Prefix, "prefix")
+	}
+	switch ones, _ := ipNet.Mask.Size(); ones {
+	case 32, 40, 48, 56, 64, 96:
+		return nil
+	default:
+		return kvs.NewInvalidValueError(ErrInvalidNAT64PrefixLength, "prefix")
+	}
+}
+
+// Create adds NAT64 prefix.
+func (d *NAT64PrefixDescriptor) Create(key string, prefix *nat.Nat64Prefix) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Delete removes NAT64 prefix.
+func (d *NAT64PrefixDescriptor) Delete(key string, prefix *nat.Nat64Prefix, metadata interface{}) error {
+	return d.nat64Handler.DelNat64Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Retrieve returns NAT64 prefixes configured on VPP.
+func (d *NAT64PrefixDescriptor) Retrieve(correlate []adapter.NAT64PrefixKVWithMetadata) (
+	retrieved []adapter.NAT64PrefixKVWithMetadata, err error) {
+	sbPrefixes, err := d.nat64Handler.Nat64PrefixesDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, sbPrefix := range sbPrefixes {
+		// keep NB formatting of the prefix
+		prefix := sbPrefix
+		for _, nbPrefix := range correlate {
+			if nbPrefix.Value.VrfId == sbPrefix.VrfId && equivalentIPNetworks(nbPrefix.Value.Prefix, sbPrefix.Prefix) {
+				prefix = nbPrefix.Value
+				break
+			}
+		}
+		retrieved = append(retrieved, adapter.NAT64PrefixKVWithMetadata{
+			Key:    models.Key(prefix),
+			Value:  prefix,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the NAT64 global configuration and non-zero VRF as dependencies.
+func (d *NAT64PrefixDescriptor) Dependencies(key string, prefix *nat.Nat64Prefix) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if prefix.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: nat64VrfDep,
+			Key:   l3.VrfTableKey(prefix.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
+
+// equivalentIPNetworks returns true if both strings represent the same IP network.
+func equivalentIPNetworks(net1, net2 string) bool {
+	_, ipNet1, err1 := net.ParseCIDR(net1)
+	_, ipNet2, err2 := net.ParseCIDR(net2)
+	if err1 != nil || err2 != nil {
+		return equivalentTrimmedLowered(net1, net2)
+	}
+	return ipNet1.String() == ipNet2.String()
+}
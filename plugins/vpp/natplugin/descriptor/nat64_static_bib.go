@@ -0,0 +1,158 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64StaticBibDescriptorName is the name of the descriptor for NAT64 static BIB entries.
+	NAT64StaticBibDescriptorName = "vpp-nat64-static-bib"
+)
+
+// A list of non-retriable errors:
+var (
+	// errInvalidIPv6Address is returned when IPv6 address cannot be parsed.
+	errInvalidIPv6Address = errors.New("invalid IPv6 address")
+	// errInvalidPort is returned when port number is out of range.
+	errInvalidPort = errors.New("port number must be lower than 65536")
+)
+
+// NAT64StaticBibDescriptor teaches KVScheduler how to add/remove VPP NAT64 static BIB entries.
+type NAT64StaticBibDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64StaticBibDescriptor creates a new instance of the NAT64StaticBibDescriptor.
+func NewNAT64StaticBibDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64StaticBibDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-static-bib-descriptor"),
+	}
+	typedDescr := &adapter.NAT64StaticBibDescriptor{
+		Name:                 NAT64StaticBibDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64StaticBib.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64StaticBib.ProtoName(),
+		KeySelector:          nat.ModelNat64StaticBib.IsKeyValid,
+		KeyLabel:             nat.ModelNat64StaticBib.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentStaticBibs,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64StaticBibDescriptor(typedDescr)
+}
+
+// EquivalentStaticBibs compares two NAT64 static BIB entries.
+func (d *NAT64StaticBibDescriptor) EquivalentStaticBibs(key string, oldBib, newBib *nat.Nat64StaticBib) bool {
+	return oldBib.VrfId == newBib.VrfId &&
+		oldBib.Protocol == newBib.Protocol &&
+		oldBib.InsidePort == newBib.InsidePort &&
+		oldBib.OutsidePort == newBib.OutsidePort &&
+		equivalentIPs(oldBib.InsideIp, newBib.InsideIp) &&
+		equivalentIPv4(oldBib.OutsideIp, newBib.OutsideIp)
+}
+
+// Validate validates NAT64 static BIB entry.
+func (d *NAT64StaticBibDescriptor) Validate(key string, bib *nat.Nat64StaticBib) error {
+	if ip := net.ParseIP(bib.InsideIp); ip == nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(errInvalidIPv6Address, "inside_ip")
+	}
+	if _, err := ParseIPv4(bib.OutsideIp); err != nil {
+		return kvs.NewInvalidValueError(errInvalidIPAddress, "outside_ip")
+	}
+	if bib.InsidePort > 65535 {
+		return kvs.NewInvalidValueError(errInvalidPort, "inside_port")
+	}
+	if bib.OutsidePort > 65535 {
+		return kvs.NewInvalidValueError(errInvalidPort, "outside_port")
+	}
+	return nil
+}
+
+// Create adds NAT64 static BIB entry.
+func (d *NAT64StaticBibDescriptor) Create(key string, bib *nat.Nat64StaticBib) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64StaticBib(bib)
+}
+
+// Delete removes NAT64 static BIB entry.
+func (d *NAT64StaticBibDescriptor) Delete(key string, bib *nat.Nat64StaticBib, metadata interface{}) error {
+	return d.nat64Handler.DelNat64StaticBib(bib)
+}
+
+// Retrieve returns NAT64 static BIB entries configured on VPP.
+func (d *NAT64StaticBibDescriptor) Retrieve(correlate []adapter.NAT64StaticBibKVWithMetadata) (
+	retrieved []adapter.NAT64StaticBibKVWithMetadata, err error) {
+	sbBibs, err := d.nat64Handler.Nat64StaticBibsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, sbBib := range sbBibs {
+		// keep NB formatting of IP addresses
+		bib := sbBib
+		for _, nbBib := range correlate {
+			if d.EquivalentStaticBibs("", nbBib.Value, sbBib) {
+				bib = nbBib.Value
+				break
+			}
+		}
+		retrieved = append(retrieved, adapter.NAT64StaticBibKVWithMetadata{
+			Key:    models.Key(bib),
+			Value:  bib,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the NAT64 global configuration and non-zero VRF as dependencies.
+func (d *NAT64StaticBibDescriptor) Dependencies(key string, bib *nat.Nat64StaticBib) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if bib.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: nat64VrfDep,
+			Key:   l3.VrfTableKey(bib.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
+
+// equivalentIPs returns true if both strings represent the same IP address.
+func equivalentIPs(ip1str, ip2str string) bool {
+	ip1 := net.ParseIP(ip1str)
+	ip2 := net.ParseIP(ip2str)
+	if ip1 == nil || ip2 == nil {
+		return equivalentTrimmedLowered(ip1str, ip2str)
+	}
+	return ip1.Equal(ip2)
+}
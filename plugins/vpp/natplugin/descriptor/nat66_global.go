@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT66GlobalDescriptorName is the name of the descriptor for VPP NAT66 global
+	// configuration.
+	NAT66GlobalDescriptorName = "vpp-nat66-global"
+
+	// dependency labels
+	nat66GlobalCfgDep = "nat66-global-config-exists"
+	nat66VrfDep       = "vrf-table-exists"
+)
+
+// NAT66GlobalDescriptor teaches KVScheduler how to enable and disable VPP NAT66 plugin.
+type NAT66GlobalDescriptor struct {
+	log          logging.Logger
+	nat66Handler vppcalls.Nat66VppAPI
+}
+
+// NewNAT66GlobalDescriptor creates a new instance of the NAT66Global descriptor.
+func NewNAT66GlobalDescriptor(nat66Handler vppcalls.Nat66VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT66GlobalDescriptor{
+		nat66Handler: nat66Handler,
+		log:          log.NewLogger("nat66-global-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT66GlobalDescriptor{
+		Name:                 NAT66GlobalDescriptorName,
+		NBKeyPrefix:          nat.ModelNat66Global.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat66Global.ProtoName(),
+		KeySelector:          nat.ModelNat66Global.IsKeyValid,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewNAT66GlobalDescriptor(typedDescr)
+}
+
+// Create enables NAT66 plugin. Any change of the global configuration
+// is applied by re-enabling the plugin (descriptor does not implement Update).
+func (d *NAT66GlobalDescriptor) Create(key string, globalCfg *nat.Nat66Global) (metadata interface{}, err error) {
+	return nil, d.nat66Handler.EnableNat66Plugin(globalCfg)
+}
+
+// Delete disables NAT66 plugin.
+func (d *NAT66GlobalDescriptor) Delete(key string, globalCfg *nat.Nat66Global, metadata interface{}) error {
+	return d.nat66Handler.DisableNat66Plugin()
+}
+
+// Retrieve returns the NAT66 global configuration if the plugin is enabled.
+// VPP does not provide a way to read the plugin state and the outside VRF,
+// the plugin is therefore considered enabled when there is any NAT66
+// configuration in VPP and the configuration values are taken from NB.
+func (d *NAT66GlobalDescriptor) Retrieve(correlate []adapter.NAT66GlobalKVWithMetadata) (
+	retrieved []adapter.NAT66GlobalKVWithMetadata, err error) {
+	enabled, err := d.isEnabled()
+	if err != nil || !enabled {
+		return nil, err
+	}
+	globalCfg := &nat.Nat66Global{}
+	if len(correlate) > 0 {
+		globalCfg = correlate[0].Value
+	}
+	retrieved = append(retrieved, adapter.NAT66GlobalKVWithMetadata{
+		Key:    nat.GlobalNAT66Key(),
+		Value:  globalCfg,
+		Origin: kvs.FromNB,
+	})
+	return retrieved, nil
+}
+
+// Dependencies lists non-zero outside VRF as dependency.
+func (d *NAT66GlobalDescriptor) Dependencies(key string, globalCfg *nat.Nat66Global) (deps []kvs.Dependency) {
+	if globalCfg.OutsideVrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: nat66VrfDep,
+			Key:   l3.VrfTableKey(globalCfg.OutsideVrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
+
+// isEnabled returns true if there is any NAT66 configuration in VPP.
+func (d *NAT66GlobalDescriptor) isEnabled() (bool, error) {
+	natIfs, err := d.nat66Handler.Nat66InterfacesDump()
+	if err != nil || len(natIfs) > 0 {
+		return len(natIfs) > 0, err
+	}
+	mappings, err := d.nat66Handler.Nat66StaticMappingsDump()
+	return len(mappings) > 0, err
+}
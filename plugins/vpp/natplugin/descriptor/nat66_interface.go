@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT66InterfaceDescriptorName is the name of the descriptor for VPP NAT66 features applied to interfaces.
+	NAT66InterfaceDescriptorName = "vpp-nat66-interface"
+)
+
+// NAT66InterfaceDescriptor teaches KVScheduler how to configure VPP NAT66 interface features.
+type NAT66InterfaceDescriptor struct {
+	log          logging.Logger
+	nat66Handler vppcalls.Nat66VppAPI
+}
+
+// NewNAT66InterfaceDescriptor creates a new instance of the NAT66Interface descriptor.
+func NewNAT66InterfaceDescriptor(nat66Handler vppcalls.Nat66VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT66InterfaceDescriptor{
+		nat66Handler: nat66Handler,
+		log:          log.NewLogger("nat66-iface-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT66InterfaceDescriptor{
+		Name:                 NAT66InterfaceDescriptorName,
+		NBKeyPrefix:          nat.ModelNat66Interface.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat66Interface.ProtoName(),
+		KeySelector:          nat.ModelNat66Interface.IsKeyValid,
+		KeyLabel:             nat.ModelNat66Interface.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT66GlobalDescriptorName},
+	}
+	return adapter.NewNAT66InterfaceDescriptor(typedDescr)
+}
+
+// Validate validates VPP NAT66 interface configuration.
+func (d *NAT66InterfaceDescriptor) Validate(key string, natIface *nat.Nat66Interface) error {
+	if natIface.NatInside && natIface.NatOutside {
+		// VPP NAT66 does not allow both features on a single interface
+		return kvs.NewInvalidValueError(ErrNATInterfaceFeatureCollision, "nat_inside", "nat_outside")
+	}
+	return nil
+}
+
+// Create enables NAT66 on an interface.
+func (d *NAT66InterfaceDescriptor) Create(key string, natIface *nat.Nat66Interface) (metadata interface{}, err error) {
+	if !natIface.NatInside && !natIface.NatOutside {
+		return nil, nil
+	}
+	return nil, d.nat66Handler.EnableNat66Interface(natIface.Name, natIface.NatInside)
+}
+
+// Delete disables NAT66 on an interface.
+func (d *NAT66InterfaceDescriptor) Delete(key string, natIface *nat.Nat66Interface, metadata interface{}) error {
+	if !natIface.NatInside && !natIface.NatOutside {
+		return nil
+	}
+	return d.nat66Handler.DisableNat66Interface(natIface.Name, natIface.NatInside)
+}
+
+// Retrieve returns the current NAT66 interface configuration.
+func (d *NAT66InterfaceDescriptor) Retrieve(correlate []adapter.NAT66InterfaceKVWithMetadata) (
+	retrieved []adapter.NAT66InterfaceKVWithMetadata, err error) {
+	natIfs, err := d.nat66Handler.Nat66InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, natIf := range natIfs {
+		retrieved = append(retrieved, adapter.NAT66InterfaceKVWithMetadata{
+			Key:    nat.Nat66InterfaceKey(natIf.Name),
+			Value:  natIf,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists the interface and the NAT66 global configuration as dependencies.
+func (d *NAT66InterfaceDescriptor) Dependencies(key string, natIface *nat.Nat66Interface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: nat66GlobalCfgDep,
+			Key:   nat.GlobalNAT66Key(),
+		},
+		{
+			Label: natInterfaceDep,
+			Key:   interfaces.InterfaceKey(natIface.Name),
+		},
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT66StaticMappingDescriptorName is the name of the descriptor for NAT66 static mappings.
+	NAT66StaticMappingDescriptorName = "vpp-nat66-static-mapping"
+)
+
+// NAT66StaticMappingDescriptor teaches KVScheduler how to add/remove VPP NAT66 static mappings.
+type NAT66StaticMappingDescriptor struct {
+	log          logging.Logger
+	nat66Handler vppcalls.Nat66VppAPI
+}
+
+// NewNAT66StaticMappingDescriptor creates a new instance of the NAT66StaticMappingDescriptor.
+func NewNAT66StaticMappingDescriptor(nat66Handler vppcalls.Nat66VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT66StaticMappingDescriptor{
+		nat66Handler: nat66Handler,
+		log:          log.NewLogger("nat66-static-mapping-descriptor"),
+	}
+	typedDescr := &adapter.NAT66StaticMappingDescriptor{
+		Name:                 NAT66StaticMappingDescriptorName,
+		NBKeyPrefix:          nat.ModelNat66StaticMapping.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat66StaticMapping.ProtoName(),
+		KeySelector:          nat.ModelNat66StaticMapping.IsKeyValid,
+		KeyLabel:             nat.ModelNat66StaticMapping.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentStaticMappings,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT66GlobalDescriptorName},
+	}
+	return adapter.NewNAT66StaticMappingDescriptor(typedDescr)
+}
+
+// EquivalentStaticMappings compares two NAT66 static mappings.
+func (d *NAT66StaticMappingDescriptor) EquivalentStaticMappings(key string, oldMapping, newMapping *nat.Nat66StaticMapping) bool {
+	return oldMapping.VrfId == newMapping.VrfId &&
+		equivalentIPs(oldMapping.LocalIp, newMapping.LocalIp) &&
+		equivalentIPs(oldMapping.ExternalIp, newMapping.ExternalIp)
+}
+
+// Validate validates NAT66 static mapping.
+func (d *NAT66StaticMappingDescriptor) Validate(key string, mapping *nat.Nat66StaticMapping) error {
+	if ip := net.ParseIP(mapping.LocalIp); ip == nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(errInvalidIPv6Address, "local_ip")
+	}
+	if ip := net.ParseIP(mapping.ExternalIp); ip == nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(errInvalidIPv6Address, "external_ip")
+	}
+	return nil
+}
+
+// Create adds NAT66 static mapping.
+func (d *NAT66StaticMappingDescriptor) Create(key string, mapping *nat.Nat66StaticMapping) (metadata interface{}, err error) {
+	return nil, d.nat66Handler.AddNat66StaticMapping(mapping)
+}
+
+// Delete removes NAT66 static mapping.
+func (d *NAT66StaticMappingDescriptor) Delete(key string, mapping *nat.Nat66StaticMapping, metadata interface{}) error {
+	return d.nat66Handler.DelNat66StaticMapping(mapping)
+}
+
+// Retrieve returns NAT66 static mappings configured on VPP.
+func (d *NAT66StaticMappingDescriptor) Retrieve(correlate []adapter.NAT66StaticMappingKVWithMetadata) (
+	retrieved []adapter.NAT66StaticMappingKVWithMetadata, err error) {
+	sbMappings, err := d.nat66Handler.Nat66StaticMappingsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, sbMapping := range sbMappings {
+		// keep NB formatting of IP addresses
+		mapping := sbMapping
+		for _, nbMapping := range correlate {
+			if d.EquivalentStaticMappings("", nbMapping.Value, sbMapping) {
+				mapping = nbMapping.Value
+				break
+			}
+		}
+		retrieved = append(retrieved, adapter.NAT66StaticMappingKVWithMetadata{
+			Key:    models.Key(mapping),
+			Value:  mapping,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the NAT66 global configuration and non-zero VRF as dependencies.
+func (d *NAT66StaticMappingDescriptor) Dependencies(key string, mapping *nat.Nat66StaticMapping) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat66GlobalCfgDep,
+		Key:   nat.GlobalNAT66Key(),
+	})
+	if mapping.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: nat66VrfDep,
+			Key:   l3.VrfTableKey(mapping.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
@@ -20,6 +20,14 @@
 //go:generate descriptor-adapter --descriptor-name NAT44AddressPool --value-type *vpp_nat.Nat44AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44Vrf --value-type *vpp_nat.Nat44VrfTable --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44VrfRoute --value-type *vpp_nat.Nat44VrfRoute --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Global --value-type *vpp_nat.Nat64Global --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Interface --value-type *vpp_nat.Nat64Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64AddressPool --value-type *vpp_nat.Nat64AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Prefix --value-type *vpp_nat.Nat64Prefix --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64StaticBib --value-type *vpp_nat.Nat64StaticBib --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT66Global --value-type *vpp_nat.Nat66Global --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT66Interface --value-type *vpp_nat.Nat66Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT66StaticMapping --value-type *vpp_nat.Nat66StaticMapping --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 
 package natplugin
 
@@ -45,7 +53,9 @@ type NATPlugin struct {
 	Deps
 
 	// handlers
-	natHandler vppcalls.NatVppAPI
+	natHandler   vppcalls.NatVppAPI
+	nat64Handler vppcalls.Nat64VppAPI
+	nat66Handler vppcalls.Nat66VppAPI
 }
 
 // Deps lists dependencies of the NAT plugin.
@@ -59,11 +69,35 @@ type Deps struct {
 
 // Init registers NAT-related descriptors.
 func (p *NATPlugin) Init() (err error) {
-	if !p.VPP.IsPluginLoaded("nat") {
+	if p.VPP.IsPluginLoaded("nat") {
+		if err = p.initNAT44(); err != nil {
+			return err
+		}
+	} else {
 		p.Log.Warnf("VPP plugin NAT was disabled by VPP")
-		return nil
 	}
 
+	if p.VPP.IsPluginLoaded("nat64") {
+		if err = p.initNAT64(); err != nil {
+			return err
+		}
+	} else {
+		p.Log.Warnf("VPP plugin NAT64 was disabled by VPP")
+	}
+
+	if p.VPP.IsPluginLoaded("nat66") {
+		if err = p.initNAT66(); err != nil {
+			return err
+		}
+	} else {
+		p.Log.Warnf("VPP plugin NAT66 was disabled by VPP")
+	}
+
+	return nil
+}
+
+// initNAT44 initializes NAT44 handler and registers NAT44 descriptors.
+func (p *NATPlugin) initNAT44() (err error) {
 	// init handlers
 	p.natHandler = vppcalls.CompatibleNatVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.IfPlugin.GetDHCPIndex(), p.Log)
 	if p.natHandler == nil {
@@ -80,7 +114,7 @@ func (p *NATPlugin) Init() (err error) {
 	nat44VrfDescriptor := descriptor.NewNAT44VrfDescriptor(p.natHandler, p.Log)
 	nat44VrfRouteDescriptor := descriptor.NewNAT44VrfRouteDescriptor(p.natHandler, p.Log)
 
-	err = p.KVScheduler.RegisterKVDescriptor(
+	return p.KVScheduler.RegisterKVDescriptor(
 		nat44GlobalDescriptor,
 		nat44GlobalIfaceDescriptor, // deprecated, kept for backward compatibility
 		nat44GlobalAddrDescriptor,  // deprecated, kept for backward compatibility
@@ -90,11 +124,36 @@ func (p *NATPlugin) Init() (err error) {
 		nat44VrfDescriptor,
 		nat44VrfRouteDescriptor,
 	)
-	if err != nil {
-		return err
+}
+
+// initNAT64 initializes NAT64 handler and registers NAT64 descriptors.
+func (p *NATPlugin) initNAT64() (err error) {
+	p.nat64Handler = vppcalls.CompatibleNat64VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.nat64Handler == nil {
+		return errors.New("nat64Handler is not available")
+	}
+
+	return p.KVScheduler.RegisterKVDescriptor(
+		descriptor.NewNAT64GlobalDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64InterfaceDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64AddressPoolDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64PrefixDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64StaticBibDescriptor(p.nat64Handler, p.Log),
+	)
+}
+
+// initNAT66 initializes NAT66 handler and registers NAT66 descriptors.
+func (p *NATPlugin) initNAT66() (err error) {
+	p.nat66Handler = vppcalls.CompatibleNat66VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.nat66Handler == nil {
+		return errors.New("nat66Handler is not available")
 	}
 
-	return nil
+	return p.KVScheduler.RegisterKVDescriptor(
+		descriptor.NewNAT66GlobalDescriptor(p.nat66Handler, p.Log),
+		descriptor.NewNAT66InterfaceDescriptor(p.nat66Handler, p.Log),
+		descriptor.NewNAT66StaticMappingDescriptor(p.nat66Handler, p.Log),
+	)
 }
 
 // AfterInit registers plugin with StatusCheck.
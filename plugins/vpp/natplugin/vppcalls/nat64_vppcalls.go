@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Nat64VppAPI provides methods for managing VPP NAT64 configuration.
+type Nat64VppAPI interface {
+	Nat64VppRead
+
+	// EnableNat64Plugin enables NAT64 plugin with the given global configuration.
+	EnableNat64Plugin(cfg *nat.Nat64Global) error
+	// DisableNat64Plugin disables NAT64 plugin.
+	DisableNat64Plugin() error
+	// EnableNat64Interface enables NAT64 feature for provided interface.
+	EnableNat64Interface(iface string, isInside bool) error
+	// DisableNat64Interface disables NAT64 feature for provided interface.
+	DisableNat64Interface(iface string, isInside bool) error
+	// AddNat64AddressPool adds new IPv4 address pool into the NAT64 pools.
+	AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// DelNat64AddressPool removes existing IPv4 address pool from the NAT64 pools.
+	DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// AddNat64Prefix adds NAT64 prefix for the given VRF.
+	AddNat64Prefix(vrf uint32, prefix string) error
+	// DelNat64Prefix removes NAT64 prefix of the given VRF.
+	DelNat64Prefix(vrf uint32, prefix string) error
+	// AddNat64StaticBib creates new NAT64 static BIB entry.
+	AddNat64StaticBib(bib *nat.Nat64StaticBib) error
+	// DelNat64StaticBib removes existing NAT64 static BIB entry.
+	DelNat64StaticBib(bib *nat.Nat64StaticBib) error
+}
+
+// Nat64VppRead provides read methods for VPP NAT64 configuration and state.
+type Nat64VppRead interface {
+	// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+	Nat64InterfacesDump() ([]*nat.Nat64Interface, error)
+	// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+	Nat64AddressPoolsDump() ([]*nat.Nat64AddressPool, error)
+	// Nat64PrefixesDump dumps all configured NAT64 prefixes.
+	Nat64PrefixesDump() ([]*nat.Nat64Prefix, error)
+	// Nat64StaticBibsDump dumps all static NAT64 BIB entries.
+	Nat64StaticBibsDump() ([]*nat.Nat64StaticBib, error)
+	// Nat64BibDump dumps all NAT64 BIB entries (both static and dynamic).
+	Nat64BibDump() ([]*Nat64BibEntry, error)
+	// Nat64SessionsDump dumps all NAT64 sessions.
+	Nat64SessionsDump() ([]*Nat64Session, error)
+}
+
+// Nat64BibEntry is a NAT64 binding information base entry as dumped from VPP.
+type Nat64BibEntry struct {
+	VrfID       uint32                      `json:"vrf_id"`
+	Protocol    nat.Nat64StaticBib_Protocol `json:"protocol"`
+	InsideIP    string                      `json:"inside_ip"`
+	InsidePort  uint16                      `json:"inside_port"`
+	OutsideIP   string                      `json:"outside_ip"`
+	OutsidePort uint16                      `json:"outside_port"`
+	IsStatic    bool                        `json:"is_static"`
+	Sessions    uint32                      `json:"sessions"`
+}
+
+// Nat64Session is a NAT64 session table entry as dumped from VPP.
+type Nat64Session struct {
+	VrfID            uint32                      `json:"vrf_id"`
+	Protocol         nat.Nat64StaticBib_Protocol `json:"protocol"`
+	InsideLocalIP    string                      `json:"inside_local_ip"`
+	InsideLocalPort  uint16                      `json:"inside_local_port"`
+	OutsideLocalIP   string                      `json:"outside_local_ip"`
+	OutsideLocalPort uint16                      `json:"outside_local_port"`
+	InsideRemoteIP   string                      `json:"inside_remote_ip"`
+	OutsideRemoteIP  string                      `json:"outside_remote_ip"`
+	RemotePort       uint16                      `json:"remote_port"`
+}
+
+var nat64Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "nat64",
+	HandlerAPI: (*Nat64VppAPI)(nil),
+})
+
+func AddNat64HandlerVersion(version vpp.Version, msgs []govppapi.Message,
+	h func(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat64VppAPI,
+) {
+	nat64Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			return h(c, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleNat64VppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat64VppAPI {
+	if v := nat64Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(Nat64VppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Nat66VppAPI provides methods for managing VPP NAT66 configuration.
+type Nat66VppAPI interface {
+	Nat66VppRead
+
+	// EnableNat66Plugin enables NAT66 plugin with the given global configuration.
+	EnableNat66Plugin(cfg *nat.Nat66Global) error
+	// DisableNat66Plugin disables NAT66 plugin.
+	DisableNat66Plugin() error
+	// EnableNat66Interface enables NAT66 feature for provided interface.
+	EnableNat66Interface(iface string, isInside bool) error
+	// DisableNat66Interface disables NAT66 feature for provided interface.
+	DisableNat66Interface(iface string, isInside bool) error
+	// AddNat66StaticMapping creates new NAT66 static mapping entry.
+	AddNat66StaticMapping(mapping *nat.Nat66StaticMapping) error
+	// DelNat66StaticMapping removes existing NAT66 static mapping entry.
+	DelNat66StaticMapping(mapping *nat.Nat66StaticMapping) error
+}
+
+// Nat66VppRead provides read methods for VPP NAT66 configuration and state.
+type Nat66VppRead interface {
+	// Nat66InterfacesDump dumps NAT66 config of all NAT66-enabled interfaces.
+	Nat66InterfacesDump() ([]*nat.Nat66Interface, error)
+	// Nat66StaticMappingsDump dumps all NAT66 static mappings.
+	Nat66StaticMappingsDump() ([]*nat.Nat66StaticMapping, error)
+	// Nat66StaticMappingsStatsDump dumps all NAT66 static mappings with their counters.
+	Nat66StaticMappingsStatsDump() ([]*Nat66StaticMappingStats, error)
+}
+
+// Nat66StaticMappingStats is a NAT66 static mapping with its counters as dumped from VPP.
+type Nat66StaticMappingStats struct {
+	Mapping      *nat.Nat66StaticMapping `json:"mapping"`
+	TotalPackets uint64                  `json:"total_packets"`
+	TotalBytes   uint64                  `json:"total_bytes"`
+}
+
+var nat66Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "nat66",
+	HandlerAPI: (*Nat66VppAPI)(nil),
+})
+
+func AddNat66HandlerVersion(version vpp.Version, msgs []govppapi.Message,
+	h func(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat66VppAPI,
+) {
+	nat66Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			return h(c, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleNat66VppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat66VppAPI {
+	if v := nat66Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(Nat66VppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+
+	vpp_nat66 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat66"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Nat66InterfacesDump dumps NAT66 config of all NAT66-enabled interfaces.
+func (h *Nat66VppHandler) Nat66InterfacesDump() (natIfs []*nat.Nat66Interface, err error) {
+	req := &vpp_nat66.Nat66InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat66.Nat66InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT66 interface: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIfs = append(natIfs, &nat.Nat66Interface{
+			Name:       ifName,
+			NatInside:  msg.Flags&nat_types.NAT_IS_INSIDE != 0,
+			NatOutside: msg.Flags&nat_types.NAT_IS_OUTSIDE != 0,
+		})
+	}
+	return natIfs, nil
+}
+
+// Nat66StaticMappingsDump dumps all NAT66 static mappings.
+func (h *Nat66VppHandler) Nat66StaticMappingsDump() (mappings []*nat.Nat66StaticMapping, err error) {
+	stats, err := h.Nat66StaticMappingsStatsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range stats {
+		mappings = append(mappings, entry.Mapping)
+	}
+	return mappings, nil
+}
+
+// Nat66StaticMappingsStatsDump dumps all NAT66 static mappings with their counters.
+func (h *Nat66VppHandler) Nat66StaticMappingsStatsDump() (stats []*vppcalls.Nat66StaticMappingStats, err error) {
+	req := &vpp_nat66.Nat66StaticMappingDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat66.Nat66StaticMappingDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT66 static mapping: %v", err)
+		}
+		if stop {
+			break
+		}
+		stats = append(stats, &vppcalls.Nat66StaticMappingStats{
+			Mapping: &nat.Nat66StaticMapping{
+				VrfId:      msg.VrfID,
+				LocalIp:    msg.LocalIPAddress.String(),
+				ExternalIp: msg.ExternalIPAddress.String(),
+			},
+			TotalPackets: msg.TotalPkts,
+			TotalBytes:   msg.TotalBytes,
+		})
+	}
+	return stats, nil
+}
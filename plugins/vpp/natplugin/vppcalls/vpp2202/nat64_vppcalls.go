@@ -0,0 +1,231 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// EnableNat64Plugin enables NAT64 plugin with the given global configuration.
+func (h *Nat64VppHandler) EnableNat64Plugin(cfg *nat.Nat64Global) error {
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		BibBuckets:    cfg.GetBibBuckets(),
+		BibMemorySize: cfg.GetBibMemorySize(),
+		StBuckets:     cfg.GetStBuckets(),
+		StMemorySize:  cfg.GetStMemorySize(),
+		Enable:        true,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+	err := h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	if err == api.VPPApiError(1) {
+		// already enabled, hash table sizes can only be changed by re-enabling the plugin
+		h.log.Warnf("NAT64 plugin is already enabled")
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DisableNat64Plugin disables NAT64 plugin.
+func (h *Nat64VppHandler) DisableNat64Plugin() error {
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		Enable: false,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+	err := h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	if err == api.VPPApiError(1) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EnableNat64Interface enables NAT64 feature for provided interface.
+func (h *Nat64VppHandler) EnableNat64Interface(iface string, isInside bool) error {
+	return h.handleNat64Interface(iface, isInside, true)
+}
+
+// DisableNat64Interface disables NAT64 feature for provided interface.
+func (h *Nat64VppHandler) DisableNat64Interface(iface string, isInside bool) error {
+	return h.handleNat64Interface(iface, isInside, false)
+}
+
+// AddNat64AddressPool adds new IPv4 address pool into the NAT64 pools.
+func (h *Nat64VppHandler) AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, true)
+}
+
+// DelNat64AddressPool removes existing IPv4 address pool from the NAT64 pools.
+func (h *Nat64VppHandler) DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, false)
+}
+
+// AddNat64Prefix adds NAT64 prefix for the given VRF.
+func (h *Nat64VppHandler) AddNat64Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64Prefix(vrf, prefix, true)
+}
+
+// DelNat64Prefix removes NAT64 prefix of the given VRF.
+func (h *Nat64VppHandler) DelNat64Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64Prefix(vrf, prefix, false)
+}
+
+// AddNat64StaticBib creates new NAT64 static BIB entry.
+func (h *Nat64VppHandler) AddNat64StaticBib(bib *nat.Nat64StaticBib) error {
+	return h.handleNat64StaticBib(bib, true)
+}
+
+// DelNat64StaticBib removes existing NAT64 static BIB entry.
+func (h *Nat64VppHandler) DelNat64StaticBib(bib *nat.Nat64StaticBib) error {
+	return h.handleNat64StaticBib(bib, false)
+}
+
+func (h *Nat64VppHandler) handleNat64Interface(iface string, isInside, isAdd bool) error {
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	flags := nat_types.NAT_IS_OUTSIDE
+	if isInside {
+		flags = nat_types.NAT_IS_INSIDE
+	}
+	req := &vpp_nat64.Nat64AddDelInterface{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		Flags:     flags,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelInterfaceReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *Nat64VppHandler) handleNat64AddressPool(vrf uint32, firstIP, lastIP string, isAdd bool) error {
+	firstAddr, err := ipTo4Address(firstIP)
+	if err != nil {
+		return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", firstIP, err)
+	}
+	lastAddr := firstAddr
+	if lastIP != "" {
+		lastAddr, err = ipTo4Address(lastIP)
+		if err != nil {
+			return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", lastIP, err)
+		}
+	}
+
+	req := &vpp_nat64.Nat64AddDelPoolAddrRange{
+		StartAddr: firstAddr,
+		EndAddr:   lastAddr,
+		VrfID:     vrf,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPoolAddrRangeReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *Nat64VppHandler) handleNat64Prefix(vrf uint32, prefix string, isAdd bool) error {
+	ip6Prefix, err := ip_types.ParseIP6Prefix(prefix)
+	if err != nil {
+		return errors.Errorf("unable to parse NAT64 prefix %s: %v", prefix, err)
+	}
+
+	req := &vpp_nat64.Nat64AddDelPrefix{
+		Prefix: ip6Prefix,
+		VrfID:  vrf,
+		IsAdd:  isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPrefixReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *Nat64VppHandler) handleNat64StaticBib(bib *nat.Nat64StaticBib, isAdd bool) error {
+	inAddr, err := ip_types.ParseIP6Address(bib.InsideIp)
+	if err != nil {
+		return errors.Errorf("unable to parse inside address %s of the NAT64 BIB: %v", bib.InsideIp, err)
+	}
+	outAddr, err := ipTo4Address(bib.OutsideIp)
+	if err != nil {
+		return errors.Errorf("unable to parse outside address %s of the NAT64 BIB: %v", bib.OutsideIp, err)
+	}
+
+	req := &vpp_nat64.Nat64AddDelStaticBib{
+		IAddr: inAddr,
+		OAddr: outAddr,
+		IPort: uint16(bib.InsidePort),
+		OPort: uint16(bib.OutsidePort),
+		VrfID: bib.VrfId,
+		Proto: nat64ProtocolToNumber(bib.Protocol),
+		IsAdd: isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelStaticBibReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// nat64ProtocolToNumber converts NAT64 protocol from the NB model into the corresponding
+// numeric representation.
+func nat64ProtocolToNumber(protocol nat.Nat64StaticBib_Protocol) uint8 {
+	switch protocol {
+	case nat.Nat64StaticBib_UDP:
+		return UDP
+	case nat.Nat64StaticBib_ICMP:
+		return ICMP
+	default:
+		return TCP
+	}
+}
+
+// nat64ProtocolFromNumber converts protocol numeric representation into the corresponding
+// NAT64 protocol from the NB model. Returns false for protocols not covered by the model.
+func nat64ProtocolFromNumber(protocol uint8) (nat.Nat64StaticBib_Protocol, bool) {
+	switch protocol {
+	case TCP:
+		return nat.Nat64StaticBib_TCP, true
+	case UDP:
+		return nat.Nat64StaticBib_UDP, true
+	case ICMP:
+		return nat.Nat64StaticBib_ICMP, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	vpp_nat66 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat66"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// EnableNat66Plugin enables NAT66 plugin with the given global configuration.
+func (h *Nat66VppHandler) EnableNat66Plugin(cfg *nat.Nat66Global) error {
+	req := &vpp_nat66.Nat66PluginEnableDisable{
+		OutsideVrf: cfg.GetOutsideVrfId(),
+		Enable:     true,
+	}
+	reply := &vpp_nat66.Nat66PluginEnableDisableReply{}
+	err := h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	if err == api.VPPApiError(1) {
+		// already enabled, outside VRF can only be changed by re-enabling the plugin
+		h.log.Warnf("NAT66 plugin is already enabled")
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DisableNat66Plugin disables NAT66 plugin.
+func (h *Nat66VppHandler) DisableNat66Plugin() error {
+	req := &vpp_nat66.Nat66PluginEnableDisable{
+		Enable: false,
+	}
+	reply := &vpp_nat66.Nat66PluginEnableDisableReply{}
+	err := h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	if err == api.VPPApiError(1) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EnableNat66Interface enables NAT66 feature for provided interface.
+func (h *Nat66VppHandler) EnableNat66Interface(iface string, isInside bool) error {
+	return h.handleNat66Interface(iface, isInside, true)
+}
+
+// DisableNat66Interface disables NAT66 feature for provided interface.
+func (h *Nat66VppHandler) DisableNat66Interface(iface string, isInside bool) error {
+	return h.handleNat66Interface(iface, isInside, false)
+}
+
+// AddNat66StaticMapping creates new NAT66 static mapping entry.
+func (h *Nat66VppHandler) AddNat66StaticMapping(mapping *nat.Nat66StaticMapping) error {
+	return h.handleNat66StaticMapping(mapping, true)
+}
+
+// DelNat66StaticMapping removes existing NAT66 static mapping entry.
+func (h *Nat66VppHandler) DelNat66StaticMapping(mapping *nat.Nat66StaticMapping) error {
+	return h.handleNat66StaticMapping(mapping, false)
+}
+
+func (h *Nat66VppHandler) handleNat66Interface(iface string, isInside, isAdd bool) error {
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	flags := nat_types.NAT_IS_OUTSIDE
+	if isInside {
+		flags = nat_types.NAT_IS_INSIDE
+	}
+	req := &vpp_nat66.Nat66AddDelInterface{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		Flags:     flags,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat66.Nat66AddDelInterfaceReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *Nat66VppHandler) handleNat66StaticMapping(mapping *nat.Nat66StaticMapping, isAdd bool) error {
+	localAddr, err := ip_types.ParseIP6Address(mapping.LocalIp)
+	if err != nil {
+		return errors.Errorf("unable to parse local address %s of the NAT66 mapping: %v", mapping.LocalIp, err)
+	}
+	externalAddr, err := ip_types.ParseIP6Address(mapping.ExternalIp)
+	if err != nil {
+		return errors.Errorf("unable to parse external address %s of the NAT66 mapping: %v", mapping.ExternalIp, err)
+	}
+
+	req := &vpp_nat66.Nat66AddDelStaticMapping{
+		LocalIPAddress:    localAddr,
+		ExternalIPAddress: externalAddr,
+		VrfID:             mapping.VrfId,
+		IsAdd:             isAdd,
+	}
+	reply := &vpp_nat66.Nat66AddDelStaticMappingReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
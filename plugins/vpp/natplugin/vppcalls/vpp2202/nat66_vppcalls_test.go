@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	vpp_nat66 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat66"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestEnableNat66Plugin(t *testing.T) {
+	ctx, nat66Handler, _ := nat66TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat66.Nat66PluginEnableDisableReply{})
+	err := nat66Handler.EnableNat66Plugin(&nat.Nat66Global{OutsideVrfId: 2})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat66.Nat66PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+	Expect(msg.OutsideVrf).To(BeEquivalentTo(2))
+
+	ctx.MockVpp.MockReply(&vpp_nat66.Nat66PluginEnableDisableReply{})
+	err = nat66Handler.DisableNat66Plugin()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat66.Nat66PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeFalse())
+}
+
+func TestEnableNat66Interface(t *testing.T) {
+	ctx, nat66Handler, swIfIndexes := nat66TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_nat66.Nat66AddDelInterfaceReply{})
+	err := nat66Handler.EnableNat66Interface("if0", false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat66.Nat66AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.Flags).To(BeEquivalentTo(nat_types.NAT_IS_OUTSIDE))
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+
+	// missing interface
+	err = nat66Handler.DisableNat66Interface("if1", true)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat66StaticMapping(t *testing.T) {
+	ctx, nat66Handler, _ := nat66TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat66.Nat66AddDelStaticMappingReply{})
+	err := nat66Handler.AddNat66StaticMapping(&nat.Nat66StaticMapping{
+		VrfId:      1,
+		LocalIp:    "fd00::1",
+		ExternalIp: "2001:db8::1",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat66.Nat66AddDelStaticMapping)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.LocalIPAddress.String()).To(Equal("fd00::1"))
+	Expect(msg.ExternalIPAddress.String()).To(Equal("2001:db8::1"))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+
+	err = nat66Handler.DelNat66StaticMapping(&nat.Nat66StaticMapping{
+		LocalIp:    "fd00::1",
+		ExternalIp: "invalid",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestNat66InterfacesDump(t *testing.T) {
+	ctx, nat66Handler, swIfIndexes := nat66TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_nat66.Nat66InterfaceDetails{
+		SwIfIndex: 1,
+		Flags:     nat_types.NAT_IS_INSIDE,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	ifaces, err := nat66Handler.Nat66InterfacesDump()
+	Expect(err).To(Succeed())
+	Expect(ifaces).To(HaveLen(1))
+	Expect(ifaces[0]).To(Equal(&nat.Nat66Interface{Name: "if0", NatInside: true}))
+}
+
+func TestNat66StaticMappingsDump(t *testing.T) {
+	ctx, nat66Handler, _ := nat66TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	localAddr, _ := ip_types.ParseIP6Address("fd00::1")
+	externalAddr, _ := ip_types.ParseIP6Address("2001:db8::1")
+	details := &vpp_nat66.Nat66StaticMappingDetails{
+		LocalIPAddress:    localAddr,
+		ExternalIPAddress: externalAddr,
+		VrfID:             1,
+		TotalBytes:        1500,
+		TotalPkts:         10,
+	}
+	ctx.MockVpp.MockReply(details)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	stats, err := nat66Handler.Nat66StaticMappingsStatsDump()
+	Expect(err).To(Succeed())
+	Expect(stats).To(HaveLen(1))
+	Expect(stats[0]).To(Equal(&vppcalls.Nat66StaticMappingStats{
+		Mapping: &nat.Nat66StaticMapping{
+			VrfId:      1,
+			LocalIp:    "fd00::1",
+			ExternalIp: "2001:db8::1",
+		},
+		TotalPackets: 10,
+		TotalBytes:   1500,
+	}))
+
+	ctx.MockVpp.MockReply(details)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	mappings, err := nat66Handler.Nat66StaticMappingsDump()
+	Expect(err).To(Succeed())
+	Expect(mappings).To(HaveLen(1))
+	Expect(mappings[0].LocalIp).To(Equal("fd00::1"))
+}
+
+func nat66TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.Nat66VppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	swIfIndexes := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-sw_if_indexes")
+	nat66Handler := vpp2202.NewNat66VppHandler(ctx.MockVPPClient, swIfIndexes, log)
+	return ctx, nat66Handler, swIfIndexes
+}
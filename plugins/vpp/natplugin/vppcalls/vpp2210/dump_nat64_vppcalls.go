@@ -0,0 +1,204 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"fmt"
+	"net"
+
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// allProtocols is used in NAT64 BIB and session dumps to select entries of all protocols.
+const allProtocols uint8 = 255
+
+// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+func (h *Nat64VppHandler) Nat64InterfacesDump() (natIfs []*nat.Nat64Interface, err error) {
+	req := &vpp_nat64.Nat64InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat64.Nat64InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 interface: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIfs = append(natIfs, &nat.Nat64Interface{
+			Name:       ifName,
+			NatInside:  msg.Flags&nat_types.NAT_IS_INSIDE != 0,
+			NatOutside: msg.Flags&nat_types.NAT_IS_OUTSIDE != 0,
+		})
+	}
+	return natIfs, nil
+}
+
+// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+// VPP returns every address of the pool separately, subsequent addresses
+// of the same VRF are merged into a single pool.
+func (h *Nat64VppHandler) Nat64AddressPoolsDump() (natPools []*nat.Nat64AddressPool, err error) {
+	var curPool *nat.Nat64AddressPool
+	var lastIP net.IP
+
+	req := &vpp_nat64.Nat64PoolAddrDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat64.Nat64PoolAddrDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 address pool: %v", err)
+		}
+		if stop {
+			break
+		}
+		ip := net.IP(msg.Address[:])
+		// merge subsequent IPs into a single pool
+		if curPool != nil && curPool.VrfId == msg.VrfID && ip.Equal(incIP(lastIP)) {
+			// update current pool
+			curPool.LastIp = ip.String()
+		} else {
+			// start a new pool
+			pool := &nat.Nat64AddressPool{
+				FirstIp: ip.String(),
+				VrfId:   msg.VrfID,
+			}
+			curPool = pool
+			natPools = append(natPools, pool)
+		}
+		lastIP = ip
+	}
+	return natPools, nil
+}
+
+// Nat64PrefixesDump dumps all configured NAT64 prefixes.
+func (h *Nat64VppHandler) Nat64PrefixesDump() (prefixes []*nat.Nat64Prefix, err error) {
+	req := &vpp_nat64.Nat64PrefixDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat64.Nat64PrefixDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 prefix: %v", err)
+		}
+		if stop {
+			break
+		}
+		prefixes = append(prefixes, &nat.Nat64Prefix{
+			VrfId:  msg.VrfID,
+			Prefix: msg.Prefix.String(),
+		})
+	}
+	return prefixes, nil
+}
+
+// Nat64StaticBibsDump dumps all static NAT64 BIB entries.
+func (h *Nat64VppHandler) Nat64StaticBibsDump() (bibs []*nat.Nat64StaticBib, err error) {
+	entries, err := h.Nat64BibDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsStatic {
+			continue
+		}
+		bibs = append(bibs, &nat.Nat64StaticBib{
+			VrfId:       entry.VrfID,
+			Protocol:    entry.Protocol,
+			InsideIp:    entry.InsideIP,
+			InsidePort:  uint32(entry.InsidePort),
+			OutsideIp:   entry.OutsideIP,
+			OutsidePort: uint32(entry.OutsidePort),
+		})
+	}
+	return bibs, nil
+}
+
+// Nat64BibDump dumps all NAT64 BIB entries (both static and dynamic).
+func (h *Nat64VppHandler) Nat64BibDump() (entries []*vppcalls.Nat64BibEntry, err error) {
+	req := &vpp_nat64.Nat64BibDump{
+		Proto: allProtocols,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat64.Nat64BibDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 BIB: %v", err)
+		}
+		if stop {
+			break
+		}
+		protocol, ok := nat64ProtocolFromNumber(msg.Proto)
+		if !ok {
+			h.log.Debugf("Skipping NAT64 BIB entry with unsupported protocol %d", msg.Proto)
+			continue
+		}
+		entries = append(entries, &vppcalls.Nat64BibEntry{
+			VrfID:       msg.VrfID,
+			Protocol:    protocol,
+			InsideIP:    msg.IAddr.String(),
+			InsidePort:  msg.IPort,
+			OutsideIP:   msg.OAddr.String(),
+			OutsidePort: msg.OPort,
+			IsStatic:    msg.Flags&nat_types.NAT_IS_STATIC != 0,
+			Sessions:    msg.SesNum,
+		})
+	}
+	return entries, nil
+}
+
+// Nat64SessionsDump dumps all NAT64 sessions.
+func (h *Nat64VppHandler) Nat64SessionsDump() (sessions []*vppcalls.Nat64Session, err error) {
+	req := &vpp_nat64.Nat64StDump{
+		Proto: allProtocols,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_nat64.Nat64StDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 sessions: %v", err)
+		}
+		if stop {
+			break
+		}
+		protocol, ok := nat64ProtocolFromNumber(msg.Proto)
+		if !ok {
+			h.log.Debugf("Skipping NAT64 session with unsupported protocol %d", msg.Proto)
+			continue
+		}
+		sessions = append(sessions, &vppcalls.Nat64Session{
+			VrfID:            msg.VrfID,
+			Protocol:         protocol,
+			InsideLocalIP:    msg.IlAddr.String(),
+			InsideLocalPort:  msg.IlPort,
+			OutsideLocalIP:   msg.OlAddr.String(),
+			OutsideLocalPort: msg.OlPort,
+			InsideRemoteIP:   msg.IrAddr.String(),
+			OutsideRemoteIP:  msg.OrAddr.String(),
+			RemotePort:       msg.RPort,
+		})
+	}
+	return sessions, nil
+}
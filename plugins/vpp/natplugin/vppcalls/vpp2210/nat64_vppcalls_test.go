@@ -0,0 +1,366 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestEnableNat64Plugin(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	err := nat64Handler.EnableNat64Plugin(&nat.Nat64Global{BibBuckets: 1024, StBuckets: 2048})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+	Expect(msg.BibBuckets).To(BeEquivalentTo(1024))
+	Expect(msg.StBuckets).To(BeEquivalentTo(2048))
+
+	// plugin already enabled
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{Retval: 1})
+	err = nat64Handler.EnableNat64Plugin(&nat.Nat64Global{})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	err = nat64Handler.DisableNat64Plugin()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeFalse())
+}
+
+func TestEnableNat64Interface(t *testing.T) {
+	ctx, nat64Handler, swIfIndexes := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err := nat64Handler.EnableNat64Interface("if0", true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.Flags).To(BeEquivalentTo(nat_types.NAT_IS_INSIDE))
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err = nat64Handler.DisableNat64Interface("if0", false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.Flags).To(BeEquivalentTo(nat_types.NAT_IS_OUTSIDE))
+
+	// missing interface
+	err = nat64Handler.EnableNat64Interface("if1", true)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat64AddressPool(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPoolAddrRangeReply{})
+	err := nat64Handler.AddNat64AddressPool(1, "10.0.0.1", "10.0.0.10")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPoolAddrRange)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.StartAddr.String()).To(Equal("10.0.0.1"))
+	Expect(msg.EndAddr.String()).To(Equal("10.0.0.10"))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+
+	// first IP only
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPoolAddrRangeReply{})
+	err = nat64Handler.DelNat64AddressPool(0, "10.0.0.1", "")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPoolAddrRange)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.EndAddr.String()).To(Equal("10.0.0.1"))
+
+	// invalid IP
+	err = nat64Handler.AddNat64AddressPool(0, "2001:db8::1", "")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat64Prefix(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err := nat64Handler.AddNat64Prefix(2, "64:ff9b::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.Prefix.String()).To(Equal("64:ff9b::/96"))
+	Expect(msg.VrfID).To(BeEquivalentTo(2))
+
+	err = nat64Handler.AddNat64Prefix(0, "invalid")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat64StaticBib(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelStaticBibReply{})
+	err := nat64Handler.AddNat64StaticBib(&nat.Nat64StaticBib{
+		VrfId:       1,
+		Protocol:    nat.Nat64StaticBib_UDP,
+		InsideIp:    "2001:db8::1",
+		InsidePort:  53,
+		OutsideIp:   "10.0.0.1",
+		OutsidePort: 5353,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelStaticBib)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.IAddr.String()).To(Equal("2001:db8::1"))
+	Expect(msg.IPort).To(BeEquivalentTo(53))
+	Expect(msg.OAddr.String()).To(Equal("10.0.0.1"))
+	Expect(msg.OPort).To(BeEquivalentTo(5353))
+	Expect(msg.Proto).To(BeEquivalentTo(vpp2210.UDP))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+
+	// inside address must be IPv6
+	err = nat64Handler.DelNat64StaticBib(&nat.Nat64StaticBib{
+		InsideIp:  "10.0.0.2",
+		OutsideIp: "10.0.0.1",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestNat64InterfacesDump(t *testing.T) {
+	ctx, nat64Handler, swIfIndexes := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64InterfaceDetails{
+			SwIfIndex: 1,
+			Flags:     nat_types.NAT_IS_INSIDE | nat_types.NAT_IS_OUTSIDE,
+		},
+		&vpp_nat64.Nat64InterfaceDetails{
+			SwIfIndex: 2,
+			Flags:     nat_types.NAT_IS_OUTSIDE,
+		},
+		&vpp_nat64.Nat64InterfaceDetails{
+			SwIfIndex: 3, // unknown interface
+			Flags:     nat_types.NAT_IS_INSIDE,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	ifaces, err := nat64Handler.Nat64InterfacesDump()
+	Expect(err).To(Succeed())
+	Expect(ifaces).To(HaveLen(2))
+	Expect(ifaces[0]).To(Equal(&nat.Nat64Interface{Name: "if0", NatInside: true, NatOutside: true}))
+	Expect(ifaces[1]).To(Equal(&nat.Nat64Interface{Name: "if1", NatOutside: true}))
+}
+
+func TestNat64AddressPoolsDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("192.168.10.1"),
+			VrfID:   1,
+		},
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("192.168.10.2"),
+			VrfID:   2,
+		},
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("192.168.10.3"),
+			VrfID:   2,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	pools, err := nat64Handler.Nat64AddressPoolsDump()
+	Expect(err).To(Succeed())
+	Expect(pools).To(HaveLen(2))
+	Expect(pools[0]).To(Equal(&nat.Nat64AddressPool{VrfId: 1, FirstIp: "192.168.10.1"}))
+	Expect(pools[1]).To(Equal(&nat.Nat64AddressPool{VrfId: 2, FirstIp: "192.168.10.2", LastIp: "192.168.10.3"}))
+}
+
+func TestNat64PrefixesDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	prefix, err := ip_types.ParseIP6Prefix("2001:db8:64::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PrefixDetails{
+		Prefix: prefix,
+		VrfID:  3,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	prefixes, err := nat64Handler.Nat64PrefixesDump()
+	Expect(err).To(Succeed())
+	Expect(prefixes).To(HaveLen(1))
+	Expect(prefixes[0]).To(Equal(&nat.Nat64Prefix{VrfId: 3, Prefix: "2001:db8:64::/96"}))
+}
+
+func TestNat64BibDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	inAddr, _ := ip_types.ParseIP6Address("2001:db8::1")
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64BibDetails{
+			IAddr:  inAddr,
+			OAddr:  ipTo4Address("10.0.0.1"),
+			IPort:  53,
+			OPort:  5353,
+			VrfID:  1,
+			Proto:  vpp2210.UDP,
+			Flags:  nat_types.NAT_IS_STATIC,
+			SesNum: 2,
+		},
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: inAddr,
+			OAddr: ipTo4Address("10.0.0.1"),
+			IPort: 1024,
+			OPort: 2048,
+			Proto: vpp2210.TCP,
+		},
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: inAddr,
+			OAddr: ipTo4Address("10.0.0.1"),
+			Proto: 47, // GRE is not supported by the model
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	bibs, err := nat64Handler.Nat64BibDump()
+	Expect(err).To(Succeed())
+	Expect(bibs).To(HaveLen(2))
+	Expect(bibs[0]).To(Equal(&vppcalls.Nat64BibEntry{
+		VrfID:       1,
+		Protocol:    nat.Nat64StaticBib_UDP,
+		InsideIP:    "2001:db8::1",
+		InsidePort:  53,
+		OutsideIP:   "10.0.0.1",
+		OutsidePort: 5353,
+		IsStatic:    true,
+		Sessions:    2,
+	}))
+	Expect(bibs[1].IsStatic).To(BeFalse())
+	Expect(bibs[1].Protocol).To(Equal(nat.Nat64StaticBib_TCP))
+
+	// static BIBs only
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: inAddr,
+			OAddr: ipTo4Address("10.0.0.1"),
+			IPort: 53,
+			OPort: 5353,
+			Proto: vpp2210.UDP,
+			Flags: nat_types.NAT_IS_STATIC,
+		},
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: inAddr,
+			OAddr: ipTo4Address("10.0.0.1"),
+			IPort: 1024,
+			OPort: 2048,
+			Proto: vpp2210.TCP,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	staticBibs, err := nat64Handler.Nat64StaticBibsDump()
+	Expect(err).To(Succeed())
+	Expect(staticBibs).To(HaveLen(1))
+	Expect(staticBibs[0]).To(Equal(&nat.Nat64StaticBib{
+		Protocol:    nat.Nat64StaticBib_UDP,
+		InsideIp:    "2001:db8::1",
+		InsidePort:  53,
+		OutsideIp:   "10.0.0.1",
+		OutsidePort: 5353,
+	}))
+}
+
+func TestNat64SessionsDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ilAddr, _ := ip_types.ParseIP6Address("2001:db8::1")
+	irAddr, _ := ip_types.ParseIP6Address("64:ff9b::808:808")
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64StDetails{
+		IlAddr: ilAddr,
+		IlPort: 40000,
+		OlAddr: ipTo4Address("10.0.0.1"),
+		OlPort: 50000,
+		IrAddr: irAddr,
+		OrAddr: ipTo4Address("8.8.8.8"),
+		RPort:  53,
+		Proto:  vpp2210.UDP,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := nat64Handler.Nat64SessionsDump()
+	Expect(err).To(Succeed())
+	Expect(sessions).To(HaveLen(1))
+	Expect(sessions[0]).To(Equal(&vppcalls.Nat64Session{
+		Protocol:         nat.Nat64StaticBib_UDP,
+		InsideLocalIP:    "2001:db8::1",
+		InsideLocalPort:  40000,
+		OutsideLocalIP:   "10.0.0.1",
+		OutsideLocalPort: 50000,
+		InsideRemoteIP:   "64:ff9b::808:808",
+		OutsideRemoteIP:  "8.8.8.8",
+		RemotePort:       53,
+	}))
+
+	req, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64StDump)
+	Expect(ok).To(BeTrue())
+	Expect(req.Proto).To(BeEquivalentTo(255))
+}
+
+func nat64TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.Nat64VppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	swIfIndexes := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-sw_if_indexes")
+	nat64Handler := vpp2210.NewNat64VppHandler(ctx.MockVPPClient, swIfIndexes, log)
+	return ctx, nat64Handler, swIfIndexes
+}
@@ -23,6 +23,8 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210"
 	vpp_nat_ed "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ed"
 	vpp_nat_ei "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ei"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
+	vpp_nat66 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat66"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 )
@@ -33,6 +35,8 @@ func init() {
 	msgs = append(msgs, vpp_nat_ei.AllMessages()...)
 
 	vppcalls.AddNatHandlerVersion(vpp2210.Version, msgs, NewNatVppHandler)
+	vppcalls.AddNat64HandlerVersion(vpp2210.Version, vpp_nat64.AllMessages(), NewNat64VppHandler)
+	vppcalls.AddNat66HandlerVersion(vpp2210.Version, vpp_nat66.AllMessages(), NewNat66VppHandler)
 }
 
 // NatVppHandler is accessor for NAT-related vppcalls methods.
@@ -61,3 +65,37 @@ func NewNatVppHandler(c vpp.Client,
 		ed:           true,
 	}
 }
+
+// Nat64VppHandler is accessor for NAT64-related vppcalls methods.
+type Nat64VppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewNat64VppHandler creates new instance of NAT64 vppcalls handler.
+func NewNat64VppHandler(c vpp.Client, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.Nat64VppAPI {
+	callsChan, _ := c.NewAPIChannel()
+	return &Nat64VppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
+
+// Nat66VppHandler is accessor for NAT66-related vppcalls methods.
+type Nat66VppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewNat66VppHandler creates new instance of NAT66 vppcalls handler.
+func NewNat66VppHandler(c vpp.Client, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.Nat66VppAPI {
+	callsChan, _ := c.NewAPIChannel()
+	return &Nat66VppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
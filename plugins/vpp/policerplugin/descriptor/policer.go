@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// PolicerDescriptorName is the name of the descriptor for VPP policers.
+	PolicerDescriptorName = "vpp-policer"
+
+	// maximum length of the policer name supported by VPP (including terminating zero)
+	maxPolicerNameLen = 64
+
+	// maximum value of DSCP
+	maxDSCP = 63
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrPolicerWithoutName is returned when VPP policer configuration has undefined
+	// Name attribute.
+	ErrPolicerWithoutName = errors.New("VPP policer defined without name")
+
+	// ErrPolicerInvalidName is returned when VPP policer name cannot be used
+	// as part of the key or is too long for VPP.
+	ErrPolicerInvalidName = errors.New("VPP policer name is too long or contains forward slash")
+
+	// ErrPolicerInvalidDSCP is returned when DSCP value of policer action is out of range.
+	ErrPolicerInvalidDSCP = errors.New("DSCP value of the policer action is out of range <0, 63>")
+
+	// ErrPolicerInterfaceWithoutName is returned when policer is applied on interface
+	// with undefined name.
+	ErrPolicerInterfaceWithoutName = errors.New("VPP policer applied on interface without name")
+)
+
+// PolicerDescriptor teaches KVScheduler how to configure VPP policers.
+type PolicerDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+}
+
+// NewPolicerDescriptor creates a new instance of the Policer descriptor.
+func NewPolicerDescriptor(policerHandler vppcalls.PolicerVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &PolicerDescriptor{
+		log:            log.NewLogger("policer-descriptor"),
+		policerHandler: policerHandler,
+	}
+	typedDescr := &adapter.PolicerDescriptor{
+		Name:                 PolicerDescriptorName,
+		NBKeyPrefix:          policer.ModelPolicer.KeyPrefix(),
+		ValueTypeName:        policer.ModelPolicer.ProtoName(),
+		KeySelector:          policer.ModelPolicer.IsKeyValid,
+		KeyLabel:             policer.ModelPolicer.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentPolicers,
+		WithMetadata:         true,
+		MetadataMapFactory:   ctx.MetadataFactory,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		DerivedValues:        ctx.DerivedValues,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewPolicerDescriptor(typedDescr)
+}
+
+// EquivalentPolicers compares policer parameters, undefined actions are treated
+// as equal to the default ones. Interfaces are compared via derived values.
+func (d *PolicerDescriptor) EquivalentPolicers(key string, oldPolicer, newPolicer *policer.Policer) bool {
+	if oldPolicer.Cir != newPolicer.Cir ||
+		oldPolicer.Eir != newPolicer.Eir ||
+		oldPolicer.Cb != newPolicer.Cb ||
+		oldPolicer.Eb != newPolicer.Eb ||
+		oldPolicer.RateType != newPolicer.RateType ||
+		oldPolicer.RoundType != newPolicer.RoundType ||
+		oldPolicer.Type != newPolicer.Type ||
+		oldPolicer.ColorAware != newPolicer.ColorAware {
+		return false
+	}
+	return equivalentActions(oldPolicer.ConformAction, newPolicer.ConformAction, policer.Policer_Action_TRANSMIT) &&
+		equivalentActions(oldPolicer.ExceedAction, newPolicer.ExceedAction, policer.Policer_Action_DROP) &&
+		equivalentActions(oldPolicer.ViolateAction, newPolicer.ViolateAction, policer.Policer_Action_DROP)
+}
+
+// MetadataFactory is a factory for index-map customized for VPP policers.
+func (d *PolicerDescriptor) MetadataFactory() idxmap.NamedMappingRW {
+	return idxvpp.NewNameToIndex(d.log, "vpp-policer-index", nil)
+}
+
+// Validate validates VPP policer configuration.
+func (d *PolicerDescriptor) Validate(key string, p *policer.Policer) error {
+	if p.Name == "" {
+		return kvs.NewInvalidValueError(ErrPolicerWithoutName, "name")
+	}
+	if len(p.Name) >= maxPolicerNameLen || strings.Contains(p.Name, "/") {
+		return kvs.NewInvalidValueError(ErrPolicerInvalidName, "name")
+	}
+	for field, action := range map[string]*policer.Policer_Action{
+		"conform_action.dscp": p.ConformAction,
+		"exceed_action.dscp":  p.ExceedAction,
+		"violate_action.dscp": p.ViolateAction,
+	} {
+		if action.GetDscp() > maxDSCP {
+			return kvs.NewInvalidValueError(ErrPolicerInvalidDSCP, field)
+		}
+	}
+	for _, iface := range p.Interfaces {
+		if iface.Name == "" {
+			return kvs.NewInvalidValueError(ErrPolicerInterfaceWithoutName, "interfaces.name")
+		}
+	}
+	return nil
+}
+
+// Create adds new VPP policer.
+func (d *PolicerDescriptor) Create(key string, p *policer.Policer) (metadata *idxvpp.OnlyIndex, err error) {
+	policerIdx, err := d.policerHandler.AddPolicer(p)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return &idxvpp.OnlyIndex{Index: policerIdx}, nil
+}
+
+// Delete removes VPP policer.
+func (d *PolicerDescriptor) Delete(key string, p *policer.Policer, metadata *idxvpp.OnlyIndex) error {
+	if err := d.policerHandler.DeletePolicer(p.Name); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all policers configured in VPP.
+func (d *PolicerDescriptor) Retrieve(correlate []adapter.PolicerKVWithMetadata) (retrieved []adapter.PolicerKVWithMetadata, err error) {
+	// interfaces with applied policer cannot be dumped, the expected
+	// configuration is therefore taken from NB
+	nbPolicers := make(map[string]*policer.Policer, len(correlate))
+	for _, kv := range correlate {
+		nbPolicers[kv.Value.Name] = kv.Value
+	}
+
+	policers, err := d.policerHandler.DumpPolicers()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump policers: %v", err)
+	}
+	for _, details := range policers {
+		if nbPolicer, ok := nbPolicers[details.Policer.Name]; ok {
+			details.Policer.Interfaces = nbPolicer.Interfaces
+		}
+		retrieved = append(retrieved, adapter.PolicerKVWithMetadata{
+			Key:      policer.Key(details.Policer.Name),
+			Value:    details.Policer,
+			Metadata: &idxvpp.OnlyIndex{Index: details.Meta.PolicerIndex},
+			Origin:   kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// DerivedValues derives one empty value for every interface with the policer applied.
+func (d *PolicerDescriptor) DerivedValues(key string, p *policer.Policer) (derValues []kvs.KeyValuePair) {
+	for _, iface := range p.Interfaces {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   policer.ToInterfaceKey(p.Name, iface.Name, iface.Direction),
+			Value: &emptypb.Empty{},
+		})
+	}
+	return derValues
+}
+
+// equivalentActions compares policer actions, undefined action is replaced
+// with the given default action type.
+func equivalentActions(oldAction, newAction *policer.Policer_Action, defaultType policer.Policer_Action_Type) bool {
+	if oldAction == nil {
+		oldAction = &policer.Policer_Action{Type: defaultType}
+	}
+	if newAction == nil {
+		newAction = &policer.Policer_Action{Type: defaultType}
+	}
+	if oldAction.Type != newAction.Type {
+		return false
+	}
+	// DSCP is used only to mark packets
+	return oldAction.Type != policer.Policer_Action_MARK_AND_TRANSMIT ||
+		proto.Equal(oldAction, newAction)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// PolicerToInterfaceDescriptorName is the name of the descriptor for applying
+	// policers on interfaces.
+	PolicerToInterfaceDescriptorName = "vpp-policer-to-interface"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+)
+
+// PolicerToInterfaceDescriptor applies policers on interface input or output.
+type PolicerToInterfaceDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+	ifPlugin       ifplugin.API
+}
+
+// NewPolicerToInterfaceDescriptor creates a new instance of the PolicerToInterface descriptor.
+func NewPolicerToInterfaceDescriptor(policerHandler vppcalls.PolicerVppAPI, ifPlugin ifplugin.API,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &PolicerToInterfaceDescriptor{
+		log:            log.NewLogger("policer-to-interface-descriptor"),
+		policerHandler: policerHandler,
+		ifPlugin:       ifPlugin,
+	}
+	return &kvs.KVDescriptor{
+		Name:         PolicerToInterfaceDescriptorName,
+		KeySelector:  ctx.IsPolicerToInterfaceKey,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Dependencies: ctx.Dependencies,
+	}
+}
+
+// IsPolicerToInterfaceKey returns true if the key identifies policer applied
+// on interface (derived value).
+func (d *PolicerToInterfaceDescriptor) IsPolicerToInterfaceKey(key string) bool {
+	_, _, _, isPolicerToInterfaceKey := policer.ParseToInterfaceKey(key)
+	return isPolicerToInterfaceKey
+}
+
+// Create applies policer on interface.
+func (d *PolicerToInterfaceDescriptor) Create(key string, emptyVal proto.Message) (metadata kvs.Metadata, err error) {
+	return nil, d.setPolicerOnInterface(key, true)
+}
+
+// Delete removes policer from interface.
+func (d *PolicerToInterfaceDescriptor) Delete(key string, emptyVal proto.Message, metadata kvs.Metadata) error {
+	return d.setPolicerOnInterface(key, false)
+}
+
+// Dependencies lists the interface as the only dependency for the binding.
+func (d *PolicerToInterfaceDescriptor) Dependencies(key string, emptyVal proto.Message) []kvs.Dependency {
+	_, ifName, _, _ := policer.ParseToInterfaceKey(key)
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   vpp_interfaces.InterfaceKey(ifName),
+		},
+	}
+}
+
+// setPolicerOnInterface applies or removes policer identified by the key.
+func (d *PolicerToInterfaceDescriptor) setPolicerOnInterface(key string, apply bool) error {
+	policerName, ifName, direction, isValid := policer.ParseToInterfaceKey(key)
+	if !isValid {
+		return errors.Errorf("policer to interface key %s is not valid", key)
+	}
+	ifMeta, exists := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !exists {
+		err := errors.Errorf("failed to obtain metadata for interface %s", ifName)
+		d.log.Error(err)
+		return err
+	}
+	if err := d.policerHandler.SetPolicerOnInterface(policerName, ifMeta.SwIfIndex, direction, apply); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
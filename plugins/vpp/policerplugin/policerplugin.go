@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Policer --value-type *vpp_policer.Policer --meta-type *idxvpp.OnlyIndex --import "go.ligato.io/vpp-agent/v3/pkg/idxvpp" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer" --output-dir "descriptor"
+
+package policerplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2306"
+)
+
+// PolicerPlugin is a plugin that manages VPP policers.
+type PolicerPlugin struct {
+	Deps
+
+	policerHandler vppcalls.PolicerVppAPI
+
+	// index maps
+	policerIndex idxvpp.NameToIndex
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes policer plugin.
+func (p *PolicerPlugin) Init() (err error) {
+	// init handler
+	p.policerHandler = vppcalls.CompatiblePolicerVppHandler(p.VPP, p.Log)
+	if p.policerHandler == nil {
+		p.Log.Warnf("Policer handler is not available for this VPP version, policers are disabled")
+		return nil
+	}
+
+	// init & register descriptors
+	policerDescriptor := descriptor.NewPolicerDescriptor(p.policerHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(policerDescriptor); err != nil {
+		return err
+	}
+	policerToIfDescriptor := descriptor.NewPolicerToInterfaceDescriptor(p.policerHandler, p.IfPlugin, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(policerToIfDescriptor); err != nil {
+		return err
+	}
+
+	// obtain read-only reference to index map
+	var withIndex bool
+	metadataMap := p.KVScheduler.GetMetadataMap(descriptor.PolicerDescriptorName)
+	p.policerIndex, withIndex = metadataMap.(idxvpp.NameToIndex)
+	if !withIndex {
+		return errors.New("missing index with policer metadata")
+	}
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *PolicerPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// GetPolicerIndex returns read-only reference to the index map with policer
+// indexes assigned by VPP (nil if policers are not supported).
+func (p *PolicerPlugin) GetPolicerIndex() idxvpp.NameToIndex {
+	return p.policerIndex
+}
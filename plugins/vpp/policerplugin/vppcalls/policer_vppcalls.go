@@ -0,0 +1,87 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// PolicerDetails contains proto-modeled policer data together with VPP-related metadata.
+type PolicerDetails struct {
+	Policer *policer.Policer `json:"policer"`
+	Meta    *PolicerMeta     `json:"policer_meta"`
+}
+
+// PolicerMeta contains policer index assigned by VPP.
+type PolicerMeta struct {
+	PolicerIndex uint32 `json:"policer_index"`
+}
+
+// PolicerVppAPI provides read/write methods required to handle VPP policers.
+type PolicerVppAPI interface {
+	PolicerVppRead
+
+	// AddPolicer creates new policer and returns its index.
+	AddPolicer(p *policer.Policer) (policerIndex uint32, err error)
+	// DeletePolicer removes existing policer.
+	DeletePolicer(name string) error
+	// SetPolicerOnInterface applies (or removes) policer on interface input or output.
+	SetPolicerOnInterface(name string, swIfIndex uint32, direction policer.Policer_Interface_Direction, apply bool) error
+}
+
+// PolicerVppRead provides read methods for policers.
+type PolicerVppRead interface {
+	// DumpPolicers retrieves all policers configured in VPP.
+	// Interfaces with the policer applied are not dumped (not supported by VPP API).
+	DumpPolicers() ([]*PolicerDetails, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "policer",
+	HandlerAPI: (*PolicerVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, log logging.Logger) PolicerVppAPI
+
+func AddPolicerHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(logging.Logger))
+		},
+	})
+}
+
+func CompatiblePolicerVppHandler(c vpp.Client, log logging.Logger) PolicerVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, log).(PolicerVppAPI)
+	}
+	return nil
+}
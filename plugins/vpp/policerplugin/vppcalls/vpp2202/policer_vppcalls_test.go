@@ -0,0 +1,181 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/policer_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+func TestAddPolicer(t *testing.T) {
+	ctx, policerHandler := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerAddDelReply{
+		PolicerIndex: 3,
+	})
+
+	index, err := policerHandler.AddPolicer(&policer.Policer{
+		Name:      "policer1",
+		Cir:       1000,
+		Eir:       2000,
+		Cb:        10000,
+		Eb:        20000,
+		RateType:  policer.Policer_PPS,
+		RoundType: policer.Policer_ROUND_TO_UP,
+		Type:      policer.Policer_TWO_RATE_3_COLOR,
+		ExceedAction: &policer.Policer_Action{
+			Type: policer.Policer_Action_MARK_AND_TRANSMIT,
+			Dscp: 10,
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(index).To(BeEquivalentTo(3))
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.Name).To(Equal("policer1"))
+	Expect(vppMsg.Cir).To(BeEquivalentTo(1000))
+	Expect(vppMsg.Eir).To(BeEquivalentTo(2000))
+	Expect(vppMsg.Cb).To(BeEquivalentTo(10000))
+	Expect(vppMsg.Eb).To(BeEquivalentTo(20000))
+	Expect(vppMsg.RateType).To(Equal(policer_types.SSE2_QOS_RATE_API_PPS))
+	Expect(vppMsg.RoundType).To(Equal(policer_types.SSE2_QOS_ROUND_API_TO_UP))
+	Expect(vppMsg.Type).To(Equal(policer_types.SSE2_QOS_POLICER_TYPE_API_2R3C_RFC_2698))
+	Expect(vppMsg.ConformAction.Type).To(Equal(policer_types.SSE2_QOS_ACTION_API_TRANSMIT))
+	Expect(vppMsg.ExceedAction.Type).To(Equal(policer_types.SSE2_QOS_ACTION_API_MARK_AND_TRANSMIT))
+	Expect(vppMsg.ExceedAction.Dscp).To(BeEquivalentTo(10))
+	Expect(vppMsg.ViolateAction.Type).To(Equal(policer_types.SSE2_QOS_ACTION_API_DROP))
+}
+
+func TestAddPolicerError(t *testing.T) {
+	ctx, policerHandler := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerAddDelReply{
+		Retval: 1,
+	})
+
+	_, err := policerHandler.AddPolicer(&policer.Policer{Name: "policer1"})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDeletePolicer(t *testing.T) {
+	ctx, policerHandler := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerAddDelReply{})
+
+	err := policerHandler.DeletePolicer("policer1")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.Name).To(Equal("policer1"))
+}
+
+func TestSetPolicerOnInterface(t *testing.T) {
+	ctx, policerHandler := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerInputReply{})
+	err := policerHandler.SetPolicerOnInterface("policer1", 2, policer.Policer_Interface_INPUT, true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	inputMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerInput)
+	Expect(ok).To(BeTrue())
+	Expect(inputMsg.Name).To(Equal("policer1"))
+	Expect(inputMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(inputMsg.Apply).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerOutputReply{})
+	err = policerHandler.SetPolicerOnInterface("policer1", 2, policer.Policer_Interface_OUTPUT, false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	outputMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerOutput)
+	Expect(ok).To(BeTrue())
+	Expect(outputMsg.Name).To(Equal("policer1"))
+	Expect(outputMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(outputMsg.Apply).To(BeFalse())
+}
+
+func TestDumpPolicers(t *testing.T) {
+	ctx, policerHandler := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	policer1 := &vpp_policer.PolicerDetails{
+		Name:          "policer1",
+		Cir:           1000,
+		Cb:            10000,
+		ConformAction: policer_types.Sse2QosAction{Type: policer_types.SSE2_QOS_ACTION_API_TRANSMIT},
+	}
+	policer2 := &vpp_policer.PolicerDetails{
+		Name:     "policer2",
+		Cir:      500,
+		RateType: policer_types.SSE2_QOS_RATE_API_PPS,
+		ExceedAction: policer_types.Sse2QosAction{
+			Type: policer_types.SSE2_QOS_ACTION_API_MARK_AND_TRANSMIT,
+			Dscp: 46,
+		},
+	}
+
+	// all policers
+	ctx.MockVpp.MockReply(policer1, policer2)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	// index 0 is free
+	ctx.MockVpp.MockReply()
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	// index 1
+	ctx.MockVpp.MockReply(policer1)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	// index 2
+	ctx.MockVpp.MockReply(policer2)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	policers, err := policerHandler.DumpPolicers()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(policers).To(HaveLen(2))
+
+	Expect(policers[0].Meta.PolicerIndex).To(BeEquivalentTo(1))
+	Expect(policers[0].Policer.Name).To(Equal("policer1"))
+	Expect(policers[0].Policer.Cir).To(BeEquivalentTo(1000))
+	Expect(policers[0].Policer.Cb).To(BeEquivalentTo(10000))
+	Expect(policers[0].Policer.ConformAction.Type).To(Equal(policer.Policer_Action_TRANSMIT))
+
+	Expect(policers[1].Meta.PolicerIndex).To(BeEquivalentTo(2))
+	Expect(policers[1].Policer.Name).To(Equal("policer2"))
+	Expect(policers[1].Policer.RateType).To(Equal(policer.Policer_PPS))
+	Expect(policers[1].Policer.ExceedAction.Type).To(Equal(policer.Policer_Action_MARK_AND_TRANSMIT))
+	Expect(policers[1].Policer.ExceedAction.Dscp).To(BeEquivalentTo(46))
+}
+
+func policerTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.PolicerVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	policerHandler := vpp2202.NewPolicerVppHandler(ctx.MockChannel, log)
+	return ctx, policerHandler
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// upper bound for the policer index probed while dumping policers
+const maxPolicerIndex = 1 << 16
+
+// DumpPolicers implements policer handler.
+func (h *PolicerVppHandler) DumpPolicers() (policers []*vppcalls.PolicerDetails, err error) {
+	// policer details do not contain policer index, therefore all policers
+	// are dumped first and then their indexes are found one by one
+	all, err := h.dumpPolicerDetails(^uint32(0))
+	if err != nil {
+		return nil, err
+	}
+	remaining := len(all)
+	for idx := uint32(0); remaining > 0 && idx < maxPolicerIndex; idx++ {
+		details, err := h.dumpPolicerDetails(idx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range details {
+			policers = append(policers, &vppcalls.PolicerDetails{
+				Policer: fromVppPolicer(d),
+				Meta: &vppcalls.PolicerMeta{
+					PolicerIndex: idx,
+				},
+			})
+			remaining--
+		}
+	}
+	if remaining > 0 {
+		h.log.Warnf("Policer dump: index not found for %d policers", remaining)
+	}
+	return policers, nil
+}
+
+// dumpPolicerDetails dumps policer with the given index (all policers for ~0).
+func (h *PolicerVppHandler) dumpPolicerDetails(policerIndex uint32) (details []*vpp_policer.PolicerDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_policer.PolicerDumpV2{
+		PolicerIndex: policerIndex,
+	})
+	for {
+		d := &vpp_policer.PolicerDetails{}
+		stop, err := reqCtx.ReceiveReply(d)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		details = append(details, d)
+	}
+	return details, nil
+}
+
+func fromVppPolicer(d *vpp_policer.PolicerDetails) *policer.Policer {
+	return &policer.Policer{
+		Name:          d.Name,
+		Cir:           d.Cir,
+		Eir:           d.Eir,
+		Cb:            d.Cb,
+		Eb:            d.Eb,
+		RateType:      policer.Policer_RateType(d.RateType),
+		RoundType:     policer.Policer_RoundType(d.RoundType),
+		Type:          policer.Policer_Type(d.Type),
+		ColorAware:    d.ColorAware,
+		ConformAction: fromVppAction(d.ConformAction),
+		ExceedAction:  fromVppAction(d.ExceedAction),
+		ViolateAction: fromVppAction(d.ViolateAction),
+	}
+}
+
+func fromVppAction(action policer_types.Sse2QosAction) *policer.Policer_Action {
+	return &policer.Policer_Action{
+		Type: policer.Policer_Action_Type(action.Type),
+		Dscp: uint32(action.Dscp),
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer_types"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// AddPolicer implements policer handler.
+func (h *PolicerVppHandler) AddPolicer(p *policer.Policer) (uint32, error) {
+	req := &vpp_policer.PolicerAddDel{
+		IsAdd:         true,
+		Name:          p.Name,
+		Cir:           p.Cir,
+		Eir:           p.Eir,
+		Cb:            p.Cb,
+		Eb:            p.Eb,
+		RateType:      policer_types.Sse2QosRateType(p.RateType),
+		RoundType:     policer_types.Sse2QosRoundType(p.RoundType),
+		Type:          policer_types.Sse2QosPolicerType(p.Type),
+		ColorAware:    p.ColorAware,
+		ConformAction: toVppAction(p.ConformAction, policer.Policer_Action_TRANSMIT),
+		ExceedAction:  toVppAction(p.ExceedAction, policer.Policer_Action_DROP),
+		ViolateAction: toVppAction(p.ViolateAction, policer.Policer_Action_DROP),
+	}
+	reply := &vpp_policer.PolicerAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.PolicerIndex, nil
+}
+
+// DeletePolicer implements policer handler.
+func (h *PolicerVppHandler) DeletePolicer(name string) error {
+	req := &vpp_policer.PolicerAddDel{
+		IsAdd: false,
+		Name:  name,
+	}
+	reply := &vpp_policer.PolicerAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetPolicerOnInterface implements policer handler.
+func (h *PolicerVppHandler) SetPolicerOnInterface(name string, swIfIndex uint32,
+	direction policer.Policer_Interface_Direction, apply bool) error {
+
+	if direction == policer.Policer_Interface_OUTPUT {
+		req := &vpp_policer.PolicerOutput{
+			Name:      name,
+			SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+			Apply:     apply,
+		}
+		reply := &vpp_policer.PolicerOutputReply{}
+		return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	}
+	req := &vpp_policer.PolicerInput{
+		Name:      name,
+		SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+		Apply:     apply,
+	}
+	reply := &vpp_policer.PolicerInputReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// toVppAction converts policer action to its VPP representation, undefined
+// action is replaced with the given default action type.
+func toVppAction(action *policer.Policer_Action, defaultType policer.Policer_Action_Type) policer_types.Sse2QosAction {
+	if action == nil {
+		return policer_types.Sse2QosAction{
+			Type: policer_types.Sse2QosActionType(defaultType),
+		}
+	}
+	return policer_types.Sse2QosAction{
+		Type: policer_types.Sse2QosActionType(action.Type),
+		Dscp: uint8(action.Dscp),
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+const (
+	// QosEgressMapDescriptorName is the name of the descriptor for VPP QoS
+	// egress maps.
+	QosEgressMapDescriptorName = "vpp-qos-egress-map"
+
+	// number of QoS sources, each with one row in the egress map
+	numQosSources = 4
+	// number of values in each row of the egress map (one per recorded value)
+	egressMapRowLen = 256
+	// maximum QoS value which can be written into packet
+	maxQosValue = 255
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrQosEgressMapInvalidSource is returned when egress map row has undefined
+	// source.
+	ErrQosEgressMapInvalidSource = errors.New("VPP QoS egress map row has invalid source")
+
+	// ErrQosEgressMapDuplicateSource is returned when egress map has multiple
+	// rows for the same source.
+	ErrQosEgressMapDuplicateSource = errors.New("VPP QoS egress map has multiple rows for the same source")
+
+	// ErrQosEgressMapTooManyOutputs is returned when egress map row has more
+	// outputs than there are possible input values.
+	ErrQosEgressMapTooManyOutputs = errors.New("VPP QoS egress map row has more than 256 outputs")
+
+	// ErrQosEgressMapInvalidOutput is returned when egress map row has output
+	// value which does not fit into single byte.
+	ErrQosEgressMapInvalidOutput = errors.New("VPP QoS egress map output value is out of range <0, 255>")
+)
+
+// QosEgressMapDescriptor teaches KVScheduler how to configure VPP QoS egress maps.
+type QosEgressMapDescriptor struct {
+	log        logging.Logger
+	qosHandler vppcalls.QosVppAPI
+}
+
+// NewQosEgressMapDescriptor creates a new instance of the QoS egress map descriptor.
+func NewQosEgressMapDescriptor(qosHandler vppcalls.QosVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &QosEgressMapDescriptor{
+		log:        log.NewLogger("qos-egress-map-descriptor"),
+		qosHandler: qosHandler,
+	}
+	typedDescr := &adapter.QosEgressMapDescriptor{
+		Name:            QosEgressMapDescriptorName,
+		NBKeyPrefix:     qos.ModelQosEgressMap.KeyPrefix(),
+		ValueTypeName:   qos.ModelQosEgressMap.ProtoName(),
+		KeySelector:     qos.ModelQosEgressMap.IsKeyValid,
+		KeyLabel:        qos.ModelQosEgressMap.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentQosEgressMaps,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Delete:          ctx.Delete,
+		Update:          ctx.Update,
+		Retrieve:        ctx.Retrieve,
+	}
+	return adapter.NewQosEgressMapDescriptor(typedDescr)
+}
+
+// EquivalentQosEgressMaps compares QoS egress maps. Rows are compared regardless
+// of their order, missing rows and missing trailing outputs are treated as zeros.
+func (d *QosEgressMapDescriptor) EquivalentQosEgressMaps(key string, oldMap, newMap *qos.QosEgressMap) bool {
+	return egressMapTable(oldMap) == egressMapTable(newMap)
+}
+
+// Validate validates VPP QoS egress map configuration.
+func (d *QosEgressMapDescriptor) Validate(key string, egressMap *qos.QosEgressMap) error {
+	sources := make(map[qos.Source]struct{})
+	for _, row := range egressMap.Rows {
+		if _, valid := qos.Source_name[int32(row.Source)]; !valid {
+			return kvs.NewInvalidValueError(ErrQosEgressMapInvalidSource, "rows.source")
+		}
+		if _, duplicate := sources[row.Source]; duplicate {
+			return kvs.NewInvalidValueError(ErrQosEgressMapDuplicateSource, "rows.source")
+		}
+		sources[row.Source] = struct{}{}
+		if len(row.Outputs) > egressMapRowLen {
+			return kvs.NewInvalidValueError(ErrQosEgressMapTooManyOutputs, "rows.outputs")
+		}
+		for _, output := range row.Outputs {
+			if output > maxQosValue {
+				return kvs.NewInvalidValueError(ErrQosEgressMapInvalidOutput, "rows.outputs")
+			}
+		}
+	}
+	return nil
+}
+
+// Create adds new QoS egress map.
+func (d *QosEgressMapDescriptor) Create(key string, egressMap *qos.QosEgressMap) (metadata interface{}, err error) {
+	if err = d.qosHandler.SetQosEgressMap(egressMap); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes QoS egress map.
+func (d *QosEgressMapDescriptor) Delete(key string, egressMap *qos.QosEgressMap, metadata interface{}) error {
+	if err := d.qosHandler.DeleteQosEgressMap(egressMap.Id); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Update overwrites rows of the QoS egress map. Marks using the map are not
+// affected and start using the new values immediately.
+func (d *QosEgressMapDescriptor) Update(key string, oldMap, newMap *qos.QosEgressMap, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if err = d.qosHandler.SetQosEgressMap(newMap); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Retrieve returns all QoS egress maps configured in VPP.
+func (d *QosEgressMapDescriptor) Retrieve(correlate []adapter.QosEgressMapKVWithMetadata) (retrieved []adapter.QosEgressMapKVWithMetadata, err error) {
+	egressMaps, err := d.qosHandler.DumpQosEgressMaps()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump QoS egress maps: %v", err)
+	}
+	for _, egressMap := range egressMaps {
+		retrieved = append(retrieved, adapter.QosEgressMapKVWithMetadata{
+			Key:    qos.EgressMapKey(egressMap.Id),
+			Value:  egressMap,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// egressMapTable expands egress map into a table of outputs indexed by
+// the source and the recorded value.
+func egressMapTable(egressMap *qos.QosEgressMap) (table [numQosSources][egressMapRowLen]uint32) {
+	for _, row := range egressMap.Rows {
+		if int(row.Source) >= len(table) {
+			continue
+		}
+		copy(table[row.Source][:], row.Outputs)
+	}
+	return table
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+const (
+	// QosMarkDescriptorName is the name of the descriptor for VPP QoS marks.
+	QosMarkDescriptorName = "vpp-qos-mark"
+
+	// dependency labels
+	qosEgressMapDep = "egress-map-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrQosMarkWithoutInterface is returned when QoS mark configuration
+	// has undefined interface.
+	ErrQosMarkWithoutInterface = errors.New("VPP QoS mark defined without interface")
+
+	// ErrQosMarkInvalidSource is returned when QoS mark output source
+	// cannot be written into packets.
+	ErrQosMarkInvalidSource = errors.New("VPP QoS mark output source must be one of VLAN, MPLS or IP")
+)
+
+// QosMarkDescriptor teaches KVScheduler how to configure VPP QoS marks.
+type QosMarkDescriptor struct {
+	log        logging.Logger
+	qosHandler vppcalls.QosVppAPI
+	ifIndex    ifaceidx.IfaceMetadataIndex
+}
+
+// NewQosMarkDescriptor creates a new instance of the QoS mark descriptor.
+func NewQosMarkDescriptor(qosHandler vppcalls.QosVppAPI, ifIndex ifaceidx.IfaceMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &QosMarkDescriptor{
+		log:        log.NewLogger("qos-mark-descriptor"),
+		qosHandler: qosHandler,
+		ifIndex:    ifIndex,
+	}
+	typedDescr := &adapter.QosMarkDescriptor{
+		Name:                 QosMarkDescriptorName,
+		NBKeyPrefix:          qos.ModelQosMark.KeyPrefix(),
+		ValueTypeName:        qos.ModelQosMark.ProtoName(),
+		KeySelector:          qos.ModelQosMark.IsKeyValid,
+		KeyLabel:             qos.ModelQosMark.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName, QosEgressMapDescriptorName},
+	}
+	return adapter.NewQosMarkDescriptor(typedDescr)
+}
+
+// Validate validates VPP QoS mark configuration.
+func (d *QosMarkDescriptor) Validate(key string, mark *qos.QosMark) error {
+	if mark.Interface == "" {
+		return kvs.NewInvalidValueError(ErrQosMarkWithoutInterface, "interface")
+	}
+	if !isPacketSource(mark.OutputSource) {
+		return kvs.NewInvalidValueError(ErrQosMarkInvalidSource, "output_source")
+	}
+	return nil
+}
+
+// Create enables QoS marking on the interface. Change of the egress map
+// is applied by re-creation.
+func (d *QosMarkDescriptor) Create(key string, mark *qos.QosMark) (metadata interface{}, err error) {
+	ifMeta, found := d.ifIndex.LookupByName(mark.Interface)
+	if !found {
+		err = errors.Errorf("failed to find QoS mark interface %s", mark.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.qosHandler.EnableQosMark(ifMeta.SwIfIndex, mark.MapId, mark.OutputSource); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete disables QoS marking on the interface.
+func (d *QosMarkDescriptor) Delete(key string, mark *qos.QosMark, metadata interface{}) error {
+	ifMeta, found := d.ifIndex.LookupByName(mark.Interface)
+	if !found {
+		err := errors.Errorf("failed to find QoS mark interface %s", mark.Interface)
+		d.log.Error(err)
+		return err
+	}
+	if err := d.qosHandler.DisableQosMark(ifMeta.SwIfIndex, mark.OutputSource); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all QoS marks configured in VPP.
+func (d *QosMarkDescriptor) Retrieve(correlate []adapter.QosMarkKVWithMetadata) (retrieved []adapter.QosMarkKVWithMetadata, err error) {
+	marks, err := d.qosHandler.DumpQosMarks()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump QoS marks: %v", err)
+	}
+	for _, mark := range marks {
+		retrieved = append(retrieved, adapter.QosMarkKVWithMetadata{
+			Key:    qos.MarkKey(mark.Interface, mark.OutputSource),
+			Value:  mark,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and the egress map as the dependencies
+// of the QoS mark.
+func (d *QosMarkDescriptor) Dependencies(key string, mark *qos.QosMark) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: qosInterfaceDep,
+			Key:   interfaces.InterfaceKey(mark.Interface),
+		},
+		{
+			Label: qosEgressMapDep,
+			Key:   qos.EgressMapKey(mark.MapId),
+		},
+	}
+}
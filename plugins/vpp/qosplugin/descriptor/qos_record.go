@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+const (
+	// QosRecordDescriptorName is the name of the descriptor for VPP QoS records.
+	QosRecordDescriptorName = "vpp-qos-record"
+
+	// dependency labels
+	qosInterfaceDep = "interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrQosRecordWithoutInterface is returned when QoS record configuration
+	// has undefined interface.
+	ErrQosRecordWithoutInterface = errors.New("VPP QoS record defined without interface")
+
+	// ErrQosRecordInvalidSource is returned when QoS record input source
+	// cannot be recorded from packets.
+	ErrQosRecordInvalidSource = errors.New("VPP QoS record input source must be one of VLAN, MPLS or IP")
+)
+
+// QosRecordDescriptor teaches KVScheduler how to configure VPP QoS records.
+type QosRecordDescriptor struct {
+	log        logging.Logger
+	qosHandler vppcalls.QosVppAPI
+	ifIndex    ifaceidx.IfaceMetadataIndex
+}
+
+// NewQosRecordDescriptor creates a new instance of the QoS record descriptor.
+func NewQosRecordDescriptor(qosHandler vppcalls.QosVppAPI, ifIndex ifaceidx.IfaceMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &QosRecordDescriptor{
+		log:        log.NewLogger("qos-record-descriptor"),
+		qosHandler: qosHandler,
+		ifIndex:    ifIndex,
+	}
+	typedDescr := &adapter.QosRecordDescriptor{
+		Name:                 QosRecordDescriptorName,
+		NBKeyPrefix:          qos.ModelQosRecord.KeyPrefix(),
+		ValueTypeName:        qos.ModelQosRecord.ProtoName(),
+		KeySelector:          qos.ModelQosRecord.IsKeyValid,
+		KeyLabel:             qos.ModelQosRecord.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewQosRecordDescriptor(typedDescr)
+}
+
+// Validate validates VPP QoS record configuration.
+func (d *QosRecordDescriptor) Validate(key string, record *qos.QosRecord) error {
+	if record.Interface == "" {
+		return kvs.NewInvalidValueError(ErrQosRecordWithoutInterface, "interface")
+	}
+	if !isPacketSource(record.InputSource) {
+		return kvs.NewInvalidValueError(ErrQosRecordInvalidSource, "input_source")
+	}
+	return nil
+}
+
+// Create enables QoS recording on the interface.
+func (d *QosRecordDescriptor) Create(key string, record *qos.QosRecord) (metadata interface{}, err error) {
+	ifMeta, found := d.ifIndex.LookupByName(record.Interface)
+	if !found {
+		err = errors.Errorf("failed to find QoS record interface %s", record.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.qosHandler.EnableQosRecord(ifMeta.SwIfIndex, record.InputSource); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete disables QoS recording on the interface.
+func (d *QosRecordDescriptor) Delete(key string, record *qos.QosRecord, metadata interface{}) error {
+	ifMeta, found := d.ifIndex.LookupByName(record.Interface)
+	if !found {
+		err := errors.Errorf("failed to find QoS record interface %s", record.Interface)
+		d.log.Error(err)
+		return err
+	}
+	if err := d.qosHandler.DisableQosRecord(ifMeta.SwIfIndex, record.InputSource); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all QoS records configured in VPP.
+func (d *QosRecordDescriptor) Retrieve(correlate []adapter.QosRecordKVWithMetadata) (retrieved []adapter.QosRecordKVWithMetadata, err error) {
+	records, err := d.qosHandler.DumpQosRecords()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump QoS records: %v", err)
+	}
+	for _, record := range records {
+		retrieved = append(retrieved, adapter.QosRecordKVWithMetadata{
+			Key:    qos.RecordKey(record.Interface, record.InputSource),
+			Value:  record,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface as the only dependency of the QoS record.
+func (d *QosRecordDescriptor) Dependencies(key string, record *qos.QosRecord) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: qosInterfaceDep,
+			Key:   interfaces.InterfaceKey(record.Interface),
+		},
+	}
+}
+
+// isPacketSource returns true if the QoS source refers to a packet header
+// field (external source can be neither recorded nor marked).
+func isPacketSource(source qos.Source) bool {
+	switch source {
+	case qos.Source_VLAN, qos.Source_MPLS, qos.Source_IP:
+		return true
+	}
+	return false
+}
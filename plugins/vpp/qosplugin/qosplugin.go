@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name QosEgressMap --value-type *vpp_qos.QosEgressMap --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name QosRecord --value-type *vpp_qos.QosRecord --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name QosMark --value-type *vpp_qos.QosMark --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos" --output-dir "descriptor"
+
+package qosplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls/vpp2306"
+)
+
+// QosPlugin is a plugin that manages VPP QoS recording, marking
+// and egress maps.
+type QosPlugin struct {
+	Deps
+
+	qosHandler vppcalls.QosVppAPI
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes QoS plugin.
+func (p *QosPlugin) Init() (err error) {
+	// init handler
+	p.qosHandler = vppcalls.CompatibleQosVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.qosHandler == nil {
+		p.Log.Warnf("QoS handler is not available for this VPP version, QoS is disabled")
+		return nil
+	}
+
+	// init & register descriptors
+	egressMapDescriptor := descriptor.NewQosEgressMapDescriptor(p.qosHandler, p.Log)
+	recordDescriptor := descriptor.NewQosRecordDescriptor(p.qosHandler, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	markDescriptor := descriptor.NewQosMarkDescriptor(p.qosHandler, p.IfPlugin.GetInterfaceIndex(), p.Log)
+
+	if err = p.KVScheduler.RegisterKVDescriptor(egressMapDescriptor); err != nil {
+		return err
+	}
+	if err = p.KVScheduler.RegisterKVDescriptor(recordDescriptor); err != nil {
+		return err
+	}
+	if err = p.KVScheduler.RegisterKVDescriptor(markDescriptor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *QosPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+// QosVppAPI provides read/write methods required to handle VPP QoS.
+type QosVppAPI interface {
+	QosVppRead
+
+	// SetQosEgressMap creates new QoS egress map or overwrites rows of the existing one.
+	SetQosEgressMap(egressMap *qos.QosEgressMap) error
+	// DeleteQosEgressMap removes existing QoS egress map.
+	DeleteQosEgressMap(id uint32) error
+	// EnableQosRecord enables recording of QoS bits from the given source
+	// on the interface input.
+	EnableQosRecord(swIfIndex uint32, source qos.Source) error
+	// DisableQosRecord disables recording of QoS bits from the given source
+	// on the interface input.
+	DisableQosRecord(swIfIndex uint32, source qos.Source) error
+	// EnableQosMark enables marking of the given source on the interface output
+	// using the egress map.
+	EnableQosMark(swIfIndex, mapID uint32, source qos.Source) error
+	// DisableQosMark disables marking of the given source on the interface output.
+	DisableQosMark(swIfIndex uint32, source qos.Source) error
+}
+
+// QosVppRead provides read methods for QoS.
+type QosVppRead interface {
+	// DumpQosEgressMaps retrieves all QoS egress maps configured in VPP.
+	DumpQosEgressMaps() ([]*qos.QosEgressMap, error)
+	// DumpQosRecords retrieves all interfaces with QoS recording enabled.
+	DumpQosRecords() ([]*qos.QosRecord, error)
+	// DumpQosMarks retrieves all interfaces with QoS marking enabled.
+	DumpQosMarks() ([]*qos.QosMark, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "qos",
+	HandlerAPI: (*QosVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) QosVppAPI
+
+func AddQosHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleQosVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) QosVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(QosVppAPI)
+	}
+	return nil
+}
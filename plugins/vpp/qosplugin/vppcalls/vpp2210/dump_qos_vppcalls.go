@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/qos"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+// DumpQosEgressMaps implements QoS handler.
+// Trailing zero outputs are not included and rows with all outputs set
+// to zero are omitted.
+func (h *QosVppHandler) DumpQosEgressMaps() (egressMaps []*qos.QosEgressMap, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_qos.QosEgressMapDump{})
+	for {
+		details := &vpp_qos.QosEgressMapDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		egressMap := &qos.QosEgressMap{
+			Id: details.Map.ID,
+		}
+		for source, row := range details.Map.Rows {
+			outputs := row.Outputs
+			for len(outputs) > 0 && outputs[len(outputs)-1] == 0 {
+				outputs = outputs[:len(outputs)-1]
+			}
+			if len(outputs) == 0 {
+				continue
+			}
+			mapRow := &qos.QosEgressMap_Row{
+				Source:  qos.Source(source),
+				Outputs: make([]uint32, len(outputs)),
+			}
+			for i, output := range outputs {
+				mapRow.Outputs[i] = uint32(output)
+			}
+			egressMap.Rows = append(egressMap.Rows, mapRow)
+		}
+		egressMaps = append(egressMaps, egressMap)
+	}
+	return egressMaps, nil
+}
+
+// DumpQosRecords implements QoS handler.
+func (h *QosVppHandler) DumpQosRecords() (records []*qos.QosRecord, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_qos.QosRecordDump{})
+	for {
+		details := &vpp_qos.QosRecordDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(details.Record.SwIfIndex))
+		if !exists {
+			h.log.Warnf("QoS record dump: interface name for index %d not found", details.Record.SwIfIndex)
+			continue
+		}
+		records = append(records, &qos.QosRecord{
+			Interface:   ifName,
+			InputSource: qos.Source(details.Record.InputSource),
+		})
+	}
+	return records, nil
+}
+
+// DumpQosMarks implements QoS handler.
+func (h *QosVppHandler) DumpQosMarks() (marks []*qos.QosMark, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_qos.QosMarkDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		details := &vpp_qos.QosMarkDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(details.Mark.SwIfIndex)
+		if !exists {
+			h.log.Warnf("QoS mark dump: interface name for index %d not found", details.Mark.SwIfIndex)
+			continue
+		}
+		marks = append(marks, &qos.QosMark{
+			Interface:    ifName,
+			MapId:        details.Mark.MapID,
+			OutputSource: qos.Source(details.Mark.OutputSource),
+		})
+	}
+	return marks, nil
+}
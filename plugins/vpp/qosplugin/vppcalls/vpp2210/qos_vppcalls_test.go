@@ -0,0 +1,227 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/qos"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/qosplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+func TestSetQosEgressMap(t *testing.T) {
+	ctx, qosHandler, _ := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosEgressMapUpdateReply{})
+
+	err := qosHandler.SetQosEgressMap(&qos.QosEgressMap{
+		Id: 3,
+		Rows: []*qos.QosEgressMap_Row{
+			{
+				Source:  qos.Source_IP,
+				Outputs: []uint32{0, 1, 2, 3},
+			},
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosEgressMapUpdate)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Map.ID).To(BeEquivalentTo(3))
+	for i, row := range vppMsg.Map.Rows {
+		Expect(row.Outputs).To(HaveLen(256))
+		if i != int(vpp_qos.QOS_API_SOURCE_IP) {
+			Expect(row.Outputs).To(Equal(make([]byte, 256)))
+		}
+	}
+	Expect(vppMsg.Map.Rows[vpp_qos.QOS_API_SOURCE_IP].Outputs[:5]).To(Equal([]byte{0, 1, 2, 3, 0}))
+}
+
+func TestSetQosEgressMapError(t *testing.T) {
+	ctx, qosHandler, _ := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := qosHandler.SetQosEgressMap(&qos.QosEgressMap{
+		Id: 3,
+		Rows: []*qos.QosEgressMap_Row{
+			{
+				Source:  qos.Source_VLAN,
+				Outputs: []uint32{256},
+			},
+		},
+	})
+	Expect(err).Should(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosEgressMapUpdateReply{
+		Retval: 1,
+	})
+	err = qosHandler.SetQosEgressMap(&qos.QosEgressMap{Id: 3})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDeleteQosEgressMap(t *testing.T) {
+	ctx, qosHandler, _ := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosEgressMapDeleteReply{})
+
+	err := qosHandler.DeleteQosEgressMap(3)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosEgressMapDelete)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.ID).To(BeEquivalentTo(3))
+}
+
+func TestEnableDisableQosRecord(t *testing.T) {
+	ctx, qosHandler, _ := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosRecordEnableDisableReply{})
+	err := qosHandler.EnableQosRecord(2, qos.Source_MPLS)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosRecordEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeTrue())
+	Expect(vppMsg.Record.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.Record.InputSource).To(Equal(vpp_qos.QOS_API_SOURCE_MPLS))
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosRecordEnableDisableReply{})
+	err = qosHandler.DisableQosRecord(2, qos.Source_MPLS)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok = ctx.MockChannel.Msg.(*vpp_qos.QosRecordEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeFalse())
+}
+
+func TestEnableDisableQosMark(t *testing.T) {
+	ctx, qosHandler, _ := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosMarkEnableDisableReply{})
+	err := qosHandler.EnableQosMark(2, 3, qos.Source_VLAN)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosMarkEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeTrue())
+	Expect(vppMsg.Mark.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.Mark.MapID).To(BeEquivalentTo(3))
+	Expect(vppMsg.Mark.OutputSource).To(Equal(vpp_qos.QOS_API_SOURCE_VLAN))
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosMarkEnableDisableReply{
+		Retval: 1,
+	})
+	err = qosHandler.DisableQosMark(2, qos.Source_VLAN)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDumpQosEgressMaps(t *testing.T) {
+	ctx, qosHandler, _ := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	vppMap := vpp_qos.QosEgressMap{ID: 3}
+	for i := range vppMap.Rows {
+		vppMap.Rows[i].Outputs = make([]byte, 256)
+	}
+	copy(vppMap.Rows[vpp_qos.QOS_API_SOURCE_IP].Outputs, []byte{0, 10, 0, 20})
+
+	ctx.MockVpp.MockReply(
+		&vpp_qos.QosEgressMapDetails{Map: vppMap},
+		&memclnt.ControlPingReply{},
+	)
+
+	egressMaps, err := qosHandler.DumpQosEgressMaps()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(egressMaps).To(HaveLen(1))
+	Expect(egressMaps[0].Id).To(BeEquivalentTo(3))
+	Expect(egressMaps[0].Rows).To(HaveLen(1))
+	Expect(egressMaps[0].Rows[0].Source).To(Equal(qos.Source_IP))
+	Expect(egressMaps[0].Rows[0].Outputs).To(Equal([]uint32{0, 10, 0, 20}))
+}
+
+func TestDumpQosRecords(t *testing.T) {
+	ctx, qosHandler, ifIndex := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(
+		&vpp_qos.QosRecordDetails{
+			Record: vpp_qos.QosRecord{
+				SwIfIndex:   2,
+				InputSource: vpp_qos.QOS_API_SOURCE_IP,
+			},
+		},
+		// record on unknown interface is skipped
+		&vpp_qos.QosRecordDetails{
+			Record: vpp_qos.QosRecord{
+				SwIfIndex:   5,
+				InputSource: vpp_qos.QOS_API_SOURCE_VLAN,
+			},
+		},
+		&memclnt.ControlPingReply{},
+	)
+
+	records, err := qosHandler.DumpQosRecords()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(records).To(HaveLen(1))
+	Expect(records[0].Interface).To(Equal("if1"))
+	Expect(records[0].InputSource).To(Equal(qos.Source_IP))
+}
+
+func TestDumpQosMarks(t *testing.T) {
+	ctx, qosHandler, ifIndex := qosTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(
+		&vpp_qos.QosMarkDetails{
+			Mark: vpp_qos.QosMark{
+				SwIfIndex:    2,
+				MapID:        3,
+				OutputSource: vpp_qos.QOS_API_SOURCE_MPLS,
+			},
+		},
+		&memclnt.ControlPingReply{},
+	)
+
+	marks, err := qosHandler.DumpQosMarks()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(marks).To(HaveLen(1))
+	Expect(marks[0].Interface).To(Equal("if1"))
+	Expect(marks[0].MapId).To(BeEquivalentTo(3))
+	Expect(marks[0].OutputSource).To(Equal(qos.Source_MPLS))
+}
+
+func qosTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.QosVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndex := ifaceidx.NewIfaceIndex(log, "qos-test-ifidx")
+	qosHandler := vpp2210.NewQosVppHandler(ctx.MockChannel, ifIndex, log)
+	return ctx, qosHandler, ifIndex
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/interface_types"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/qos"
+	qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+const (
+	// number of values in each row of the egress map (one per recorded value)
+	egressMapRowLen = 256
+	// maximum QoS value which can be written into packet
+	maxQosValue = 255
+)
+
+// SetQosEgressMap implements QoS handler.
+func (h *QosVppHandler) SetQosEgressMap(egressMap *qos.QosEgressMap) error {
+	vppMap := vpp_qos.QosEgressMap{
+		ID: egressMap.Id,
+	}
+	for i := range vppMap.Rows {
+		vppMap.Rows[i].Outputs = make([]byte, egressMapRowLen)
+	}
+	for _, row := range egressMap.Rows {
+		if int(row.Source) >= len(vppMap.Rows) {
+			return errors.Errorf("invalid QoS source %v in egress map %d", row.Source, egressMap.Id)
+		}
+		if len(row.Outputs) > egressMapRowLen {
+			return errors.Errorf("egress map %d row for source %v has %d outputs, maximum is %d",
+				egressMap.Id, row.Source, len(row.Outputs), egressMapRowLen)
+		}
+		for i, output := range row.Outputs {
+			if output > maxQosValue {
+				return errors.Errorf("egress map %d row for source %v has invalid output value %d",
+					egressMap.Id, row.Source, output)
+			}
+			vppMap.Rows[row.Source].Outputs[i] = byte(output)
+		}
+	}
+	req := &vpp_qos.QosEgressMapUpdate{
+		Map: vppMap,
+	}
+	reply := &vpp_qos.QosEgressMapUpdateReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// DeleteQosEgressMap implements QoS handler.
+func (h *QosVppHandler) DeleteQosEgressMap(id uint32) error {
+	req := &vpp_qos.QosEgressMapDelete{
+		ID: id,
+	}
+	reply := &vpp_qos.QosEgressMapDeleteReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// EnableQosRecord implements QoS handler.
+func (h *QosVppHandler) EnableQosRecord(swIfIndex uint32, source qos.Source) error {
+	return h.qosRecordEnableDisable(swIfIndex, source, true)
+}
+
+// DisableQosRecord implements QoS handler.
+func (h *QosVppHandler) DisableQosRecord(swIfIndex uint32, source qos.Source) error {
+	return h.qosRecordEnableDisable(swIfIndex, source, false)
+}
+
+// EnableQosMark implements QoS handler.
+func (h *QosVppHandler) EnableQosMark(swIfIndex, mapID uint32, source qos.Source) error {
+	return h.qosMarkEnableDisable(swIfIndex, mapID, source, true)
+}
+
+// DisableQosMark implements QoS handler.
+func (h *QosVppHandler) DisableQosMark(swIfIndex uint32, source qos.Source) error {
+	return h.qosMarkEnableDisable(swIfIndex, 0, source, false)
+}
+
+func (h *QosVppHandler) qosRecordEnableDisable(swIfIndex uint32, source qos.Source, enable bool) error {
+	req := &vpp_qos.QosRecordEnableDisable{
+		Enable: enable,
+		Record: vpp_qos.QosRecord{
+			SwIfIndex:   interface_types.InterfaceIndex(swIfIndex),
+			InputSource: vpp_qos.QosSource(source),
+		},
+	}
+	reply := &vpp_qos.QosRecordEnableDisableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *QosVppHandler) qosMarkEnableDisable(swIfIndex, mapID uint32, source qos.Source, enable bool) error {
+	req := &vpp_qos.QosMarkEnableDisable{
+		Enable: enable,
+		Mark: vpp_qos.QosMark{
+			SwIfIndex:    swIfIndex,
+			MapID:        mapID,
+			OutputSource: vpp_qos.QosSource(source),
+		},
+	}
+	reply := &vpp_qos.QosMarkEnableDisableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
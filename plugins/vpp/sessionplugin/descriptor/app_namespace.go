@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+const (
+	// AppNamespaceDescriptorName is the name of the descriptor for VPP app namespaces.
+	AppNamespaceDescriptorName = "vpp-app-namespace"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+	ip4VrfDep    = "ip4-vrf-table-exists"
+	ip6VrfDep    = "ip6-vrf-table-exists"
+
+	// maximum length of the namespace ID (and session rule tag) accepted by VPP
+	maxIDLength = 63
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrAppNamespaceWithoutID is returned when VPP app namespace configuration
+	// has undefined namespace ID.
+	ErrAppNamespaceWithoutID = errors.New("VPP app namespace defined without namespace ID")
+
+	// ErrAppNamespaceInvalidID is returned when the namespace ID is too long
+	// or cannot be used as part of the key.
+	ErrAppNamespaceInvalidID = errors.New("VPP app namespace ID is longer than 63 characters or contains forward slash")
+
+	// ErrAppNamespaceReservedID is returned when the configuration refers
+	// to the default app namespace created by VPP.
+	ErrAppNamespaceReservedID = errors.New("VPP app namespace ID 'default' is reserved")
+
+	// ErrAppNamespaceInterfaceWithVrf is returned when the app namespace
+	// is attached to both interface and VRF tables.
+	ErrAppNamespaceInterfaceWithVrf = errors.New("VPP app namespace cannot be attached to both interface and VRF")
+)
+
+// AppNamespaceDescriptor teaches KVScheduler how to configure VPP app namespaces.
+type AppNamespaceDescriptor struct {
+	log            logging.Logger
+	sessionHandler vppcalls.SessionVppAPI
+	ifPlugin       ifplugin.API
+}
+
+// NewAppNamespaceDescriptor creates a new instance of the AppNamespace descriptor.
+func NewAppNamespaceDescriptor(sessionHandler vppcalls.SessionVppAPI, ifPlugin ifplugin.API,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &AppNamespaceDescriptor{
+		log:            log.NewLogger("app-namespace-descriptor"),
+		sessionHandler: sessionHandler,
+		ifPlugin:       ifPlugin,
+	}
+	typedDescr := &adapter.AppNamespaceDescriptor{
+		Name:               AppNamespaceDescriptorName,
+		NBKeyPrefix:        session.ModelAppNamespace.KeyPrefix(),
+		ValueTypeName:      session.ModelAppNamespace.ProtoName(),
+		KeySelector:        session.ModelAppNamespace.IsKeyValid,
+		KeyLabel:           session.ModelAppNamespace.StripKeyPrefix,
+		WithMetadata:       true,
+		MetadataMapFactory: ctx.MetadataFactory,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Delete:             ctx.Delete,
+		Dependencies:       ctx.Dependencies,
+	}
+	return adapter.NewAppNamespaceDescriptor(typedDescr)
+}
+
+// MetadataFactory is a factory for index-map customized for VPP app namespaces.
+func (d *AppNamespaceDescriptor) MetadataFactory() idxmap.NamedMappingRW {
+	return idxvpp.NewNameToIndex(d.log, "vpp-app-namespace-index", nil)
+}
+
+// Validate validates VPP app namespace configuration.
+func (d *AppNamespaceDescriptor) Validate(key string, ns *session.AppNamespace) error {
+	if ns.NamespaceId == "" {
+		return kvs.NewInvalidValueError(ErrAppNamespaceWithoutID, "namespace_id")
+	}
+	if len(ns.NamespaceId) > maxIDLength || strings.Contains(ns.NamespaceId, "/") {
+		return kvs.NewInvalidValueError(ErrAppNamespaceInvalidID, "namespace_id")
+	}
+	if ns.NamespaceId == session.DefaultAppNamespace {
+		return kvs.NewInvalidValueError(ErrAppNamespaceReservedID, "namespace_id")
+	}
+	if ns.Interface != "" && (ns.Ip4Vrf != 0 || ns.Ip6Vrf != 0) {
+		return kvs.NewInvalidValueError(ErrAppNamespaceInterfaceWithVrf, "interface", "ip4_vrf", "ip6_vrf")
+	}
+	return nil
+}
+
+// Create adds new VPP app namespace.
+func (d *AppNamespaceDescriptor) Create(key string, ns *session.AppNamespace) (metadata *idxvpp.OnlyIndex, err error) {
+	swIfIndex, err := d.getSwIfIndex(ns)
+	if err != nil {
+		return nil, err
+	}
+	nsIndex, err := d.sessionHandler.AddAppNamespace(ns, swIfIndex)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return &idxvpp.OnlyIndex{Index: nsIndex}, nil
+}
+
+// Update re-applies the app namespace configuration, VPP updates existing
+// namespace in place (the index is preserved).
+func (d *AppNamespaceDescriptor) Update(key string, oldNs, newNs *session.AppNamespace, oldMetadata *idxvpp.OnlyIndex) (
+	newMetadata *idxvpp.OnlyIndex, err error) {
+	return d.Create(key, newNs)
+}
+
+// UpdateWithRecreate returns true if the socket path has changed, VPP does
+// not update the socket of an existing namespace.
+func (d *AppNamespaceDescriptor) UpdateWithRecreate(key string, oldNs, newNs *session.AppNamespace, metadata *idxvpp.OnlyIndex) bool {
+	return oldNs.SocketPath != newNs.SocketPath
+}
+
+// Delete removes VPP app namespace.
+func (d *AppNamespaceDescriptor) Delete(key string, ns *session.AppNamespace, metadata *idxvpp.OnlyIndex) error {
+	swIfIndex, err := d.getSwIfIndex(ns)
+	if err != nil {
+		return err
+	}
+	if err := d.sessionHandler.DeleteAppNamespace(ns, swIfIndex); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Dependencies lists the interface or VRF tables the namespace is attached to.
+func (d *AppNamespaceDescriptor) Dependencies(key string, ns *session.AppNamespace) (deps []kvs.Dependency) {
+	if ns.Interface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: interfaceDep,
+			Key:   vpp_interfaces.InterfaceKey(ns.Interface),
+		})
+	}
+	if ns.Ip4Vrf != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: ip4VrfDep,
+			Key:   l3.VrfTableKey(ns.Ip4Vrf, l3.VrfTable_IPV4),
+		})
+	}
+	if ns.Ip6Vrf != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: ip6VrfDep,
+			Key:   l3.VrfTableKey(ns.Ip6Vrf, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
+
+// getSwIfIndex returns index of the interface the namespace is attached to
+// or ^uint32(0) if the namespace is attached to VRF tables.
+func (d *AppNamespaceDescriptor) getSwIfIndex(ns *session.AppNamespace) (uint32, error) {
+	if ns.Interface == "" {
+		return ^uint32(0), nil
+	}
+	ifMeta, exists := d.ifPlugin.GetInterfaceIndex().LookupByName(ns.Interface)
+	if !exists {
+		err := errors.Errorf("failed to obtain metadata for interface %s", ns.Interface)
+		d.log.Error(err)
+		return 0, err
+	}
+	return ifMeta.SwIfIndex, nil
+}
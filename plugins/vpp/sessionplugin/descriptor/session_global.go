@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+const (
+	// SessionGlobalDescriptorName is the name of the descriptor for the global
+	// VPP session layer configuration.
+	SessionGlobalDescriptorName = "vpp-session-global"
+)
+
+// SessionGlobalDescriptor teaches KVScheduler how to enable/disable VPP session
+// layer and its socket API.
+type SessionGlobalDescriptor struct {
+	log            logging.Logger
+	sessionHandler vppcalls.SessionVppAPI
+}
+
+// NewSessionGlobalDescriptor creates a new instance of the SessionGlobal descriptor.
+func NewSessionGlobalDescriptor(sessionHandler vppcalls.SessionVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &SessionGlobalDescriptor{
+		log:            log.NewLogger("session-global-descriptor"),
+		sessionHandler: sessionHandler,
+	}
+	typedDescr := &adapter.SessionGlobalDescriptor{
+		Name:          SessionGlobalDescriptorName,
+		NBKeyPrefix:   session.ModelSessionGlobal.KeyPrefix(),
+		ValueTypeName: session.ModelSessionGlobal.ProtoName(),
+		KeySelector:   session.ModelSessionGlobal.IsKeyValid,
+		Create:        ctx.Create,
+		Update:        ctx.Update,
+		Delete:        ctx.Delete,
+	}
+	return adapter.NewSessionGlobalDescriptor(typedDescr)
+}
+
+// Create applies the global session layer configuration.
+func (d *SessionGlobalDescriptor) Create(key string, value *session.SessionGlobal) (metadata interface{}, err error) {
+	return d.Update(key, &session.SessionGlobal{}, value, nil)
+}
+
+// Update changes the global session layer configuration. The session layer
+// is enabled before the socket API and disabled after it.
+func (d *SessionGlobalDescriptor) Update(key string, oldValue, newValue *session.SessionGlobal, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if newValue.Enabled && !oldValue.Enabled {
+		if err = d.sessionHandler.EnableDisableSessionLayer(true); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	if newValue.SocketApiEnabled != oldValue.SocketApiEnabled {
+		if err = d.sessionHandler.EnableDisableSessionSocketAPI(newValue.SocketApiEnabled); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	if !newValue.Enabled && oldValue.Enabled {
+		if err = d.sessionHandler.EnableDisableSessionLayer(false); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Delete disables everything enabled by the removed configuration.
+func (d *SessionGlobalDescriptor) Delete(key string, value *session.SessionGlobal, metadata interface{}) error {
+	_, err := d.Update(key, value, &session.SessionGlobal{}, metadata)
+	return err
+}
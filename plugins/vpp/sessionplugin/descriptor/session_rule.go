@@ -0,0 +1,249 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+const (
+	// SessionRuleDescriptorName is the name of the descriptor for VPP session rules.
+	SessionRuleDescriptorName = "vpp-session-rule"
+
+	// dependency labels
+	appNamespaceDep = "app-namespace-exists"
+
+	// index of the default app namespace created by VPP
+	defaultAppNamespaceIndex = 0
+
+	maxPort = 65535
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrSessionRuleWithoutTag is returned when VPP session rule configuration
+	// has undefined tag.
+	ErrSessionRuleWithoutTag = errors.New("VPP session rule defined without tag")
+
+	// ErrSessionRuleInvalidTag is returned when the tag is too long or cannot
+	// be used as part of the key.
+	ErrSessionRuleInvalidTag = errors.New("VPP session rule tag is longer than 63 characters or contains forward slash")
+
+	// ErrSessionRuleInvalidNetwork is returned when local or remote network
+	// is not a valid IP network.
+	ErrSessionRuleInvalidNetwork = errors.New("VPP session rule network is not a valid IP network")
+
+	// ErrSessionRuleMixedNetworks is returned when local and remote networks
+	// are from different IP families.
+	ErrSessionRuleMixedNetworks = errors.New("VPP session rule networks must be from the same IP family")
+
+	// ErrSessionRuleInvalidPort is returned when the port number exceeds 65535.
+	ErrSessionRuleInvalidPort = errors.New("VPP session rule port is out of range")
+
+	// ErrSessionRuleAppIndexWithoutRedirect is returned when application index
+	// is defined for other action than redirect.
+	ErrSessionRuleAppIndexWithoutRedirect = errors.New("VPP session rule application index is valid only for redirect action")
+)
+
+// SessionRuleDescriptor teaches KVScheduler how to configure VPP session rules.
+type SessionRuleDescriptor struct {
+	log            logging.Logger
+	sessionHandler vppcalls.SessionVppAPI
+	nsIndex        idxvpp.NameToIndex
+}
+
+// NewSessionRuleDescriptor creates a new instance of the SessionRule descriptor.
+func NewSessionRuleDescriptor(sessionHandler vppcalls.SessionVppAPI, nsIndex idxvpp.NameToIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &SessionRuleDescriptor{
+		log:            log.NewLogger("session-rule-descriptor"),
+		sessionHandler: sessionHandler,
+		nsIndex:        nsIndex,
+	}
+	typedDescr := &adapter.SessionRuleDescriptor{
+		Name:                 SessionRuleDescriptorName,
+		NBKeyPrefix:          session.ModelSessionRule.KeyPrefix(),
+		ValueTypeName:        session.ModelSessionRule.ProtoName(),
+		KeySelector:          session.ModelSessionRule.IsKeyValid,
+		KeyLabel:             session.ModelSessionRule.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentSessionRules,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{AppNamespaceDescriptorName},
+	}
+	return adapter.NewSessionRuleDescriptor(typedDescr)
+}
+
+// EquivalentSessionRules compares session rules, networks are compared as
+// IP networks and undefined app namespace equals to the default one.
+func (d *SessionRuleDescriptor) EquivalentSessionRules(key string, oldRule, newRule *session.SessionRule) bool {
+	return oldRule.Tag == newRule.Tag &&
+		oldRule.TransportProto == newRule.TransportProto &&
+		equivalentNetworks(oldRule.LocalNetwork, newRule.LocalNetwork) &&
+		oldRule.LocalPort == newRule.LocalPort &&
+		equivalentNetworks(oldRule.RemoteNetwork, newRule.RemoteNetwork) &&
+		oldRule.RemotePort == newRule.RemotePort &&
+		oldRule.Action == newRule.Action &&
+		oldRule.AppIndex == newRule.AppIndex &&
+		oldRule.Scope == newRule.Scope &&
+		appNamespaceID(oldRule) == appNamespaceID(newRule)
+}
+
+// Validate validates VPP session rule configuration.
+func (d *SessionRuleDescriptor) Validate(key string, rule *session.SessionRule) error {
+	if rule.Tag == "" {
+		return kvs.NewInvalidValueError(ErrSessionRuleWithoutTag, "tag")
+	}
+	if len(rule.Tag) > maxIDLength || strings.Contains(rule.Tag, "/") {
+		return kvs.NewInvalidValueError(ErrSessionRuleInvalidTag, "tag")
+	}
+	_, lclNet, err := net.ParseCIDR(rule.LocalNetwork)
+	if err != nil {
+		return kvs.NewInvalidValueError(ErrSessionRuleInvalidNetwork, "local_network")
+	}
+	_, rmtNet, err := net.ParseCIDR(rule.RemoteNetwork)
+	if err != nil {
+		return kvs.NewInvalidValueError(ErrSessionRuleInvalidNetwork, "remote_network")
+	}
+	if (lclNet.IP.To4() == nil) != (rmtNet.IP.To4() == nil) {
+		return kvs.NewInvalidValueError(ErrSessionRuleMixedNetworks, "local_network", "remote_network")
+	}
+	if rule.LocalPort > maxPort {
+		return kvs.NewInvalidValueError(ErrSessionRuleInvalidPort, "local_port")
+	}
+	if rule.RemotePort > maxPort {
+		return kvs.NewInvalidValueError(ErrSessionRuleInvalidPort, "remote_port")
+	}
+	if rule.AppIndex != 0 && rule.Action != session.SessionRule_REDIRECT {
+		return kvs.NewInvalidValueError(ErrSessionRuleAppIndexWithoutRedirect, "app_index")
+	}
+	return nil
+}
+
+// Create adds new VPP session rule.
+func (d *SessionRuleDescriptor) Create(key string, rule *session.SessionRule) (metadata interface{}, err error) {
+	nsIndex, err := d.getAppNamespaceIndex(rule)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.sessionHandler.AddSessionRule(rule, nsIndex); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes VPP session rule.
+func (d *SessionRuleDescriptor) Delete(key string, rule *session.SessionRule, metadata interface{}) error {
+	nsIndex, err := d.getAppNamespaceIndex(rule)
+	if err != nil {
+		return err
+	}
+	if err := d.sessionHandler.DeleteSessionRule(rule, nsIndex); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns session rules configured in VPP. Rules without tag
+// or from namespaces unknown to the agent are not retrieved.
+func (d *SessionRuleDescriptor) Retrieve(correlate []adapter.SessionRuleKVWithMetadata) (
+	retrieved []adapter.SessionRuleKVWithMetadata, err error) {
+
+	rules, err := d.sessionHandler.DumpSessionRules()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump session rules: %v", err)
+	}
+	for _, rule := range rules {
+		if rule.Rule.Tag == "" {
+			continue
+		}
+		if rule.Meta.AppNamespaceIndex != defaultAppNamespaceIndex {
+			nsID, _, exists := d.nsIndex.LookupByIndex(rule.Meta.AppNamespaceIndex)
+			if !exists {
+				d.log.Debugf("session rule %s belongs to unknown app namespace with index %d, skipping",
+					rule.Rule.Tag, rule.Meta.AppNamespaceIndex)
+				continue
+			}
+			rule.Rule.AppNamespace = nsID
+		}
+		retrieved = append(retrieved, adapter.SessionRuleKVWithMetadata{
+			Key:    session.RuleKey(rule.Rule.Tag),
+			Value:  rule.Rule,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the app namespace as dependency (unless the rule
+// belongs to the default namespace).
+func (d *SessionRuleDescriptor) Dependencies(key string, rule *session.SessionRule) (deps []kvs.Dependency) {
+	if nsID := appNamespaceID(rule); nsID != session.DefaultAppNamespace {
+		deps = append(deps, kvs.Dependency{
+			Label: appNamespaceDep,
+			Key:   session.AppNamespaceKey(nsID),
+		})
+	}
+	return deps
+}
+
+// getAppNamespaceIndex returns index of the app namespace the rule belongs to.
+func (d *SessionRuleDescriptor) getAppNamespaceIndex(rule *session.SessionRule) (uint32, error) {
+	nsID := appNamespaceID(rule)
+	if nsID == session.DefaultAppNamespace {
+		return defaultAppNamespaceIndex, nil
+	}
+	ns, exists := d.nsIndex.LookupByName(nsID)
+	if !exists {
+		err := errors.Errorf("failed to obtain index of the app namespace %s", nsID)
+		d.log.Error(err)
+		return 0, err
+	}
+	return ns.GetIndex(), nil
+}
+
+// appNamespaceID returns ID of the app namespace the rule belongs to.
+func appNamespaceID(rule *session.SessionRule) string {
+	if rule.AppNamespace == "" {
+		return session.DefaultAppNamespace
+	}
+	return rule.AppNamespace
+}
+
+// equivalentNetworks compares IP networks ignoring the host bits.
+func equivalentNetworks(net1, net2 string) bool {
+	_, ipNet1, err1 := net.ParseCIDR(net1)
+	_, ipNet2, err2 := net.ParseCIDR(net2)
+	if err1 != nil || err2 != nil {
+		return net1 == net2
+	}
+	return ipNet1.String() == ipNet2.String()
+}
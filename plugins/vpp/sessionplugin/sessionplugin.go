@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name SessionGlobal --value-type *vpp_session.SessionGlobal --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name AppNamespace --value-type *vpp_session.AppNamespace --meta-type *idxvpp.OnlyIndex --import "go.ligato.io/vpp-agent/v3/pkg/idxvpp" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name SessionRule --value-type *vpp_session.SessionRule --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session" --output-dir "descriptor"
+
+package sessionplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls/vpp2306"
+)
+
+// SessionPlugin is a plugin that manages VPP session layer, app namespaces
+// and session rules.
+type SessionPlugin struct {
+	Deps
+
+	sessionHandler vppcalls.SessionVppAPI
+
+	// index maps
+	nsIndex idxvpp.NameToIndex
+}
+
+// Deps represents dependencies for the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init initializes session plugin.
+func (p *SessionPlugin) Init() (err error) {
+	// init handler
+	p.sessionHandler = vppcalls.CompatibleSessionVppHandler(p.VPP, p.Log)
+	if p.sessionHandler == nil {
+		p.Log.Warnf("Session handler is not available for this VPP version, session layer configuration is disabled")
+		return nil
+	}
+
+	// init & register global and app namespace descriptors
+	globalDescriptor := descriptor.NewSessionGlobalDescriptor(p.sessionHandler, p.Log)
+	nsDescriptor := descriptor.NewAppNamespaceDescriptor(p.sessionHandler, p.IfPlugin, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(globalDescriptor, nsDescriptor); err != nil {
+		return err
+	}
+
+	// obtain read-only reference to index map
+	var withIndex bool
+	metadataMap := p.KVScheduler.GetMetadataMap(descriptor.AppNamespaceDescriptorName)
+	p.nsIndex, withIndex = metadataMap.(idxvpp.NameToIndex)
+	if !withIndex {
+		return errors.New("missing index with app namespace metadata")
+	}
+
+	// init & register session rule descriptor
+	ruleDescriptor := descriptor.NewSessionRuleDescriptor(p.sessionHandler, p.nsIndex, p.Log)
+	return p.KVScheduler.RegisterKVDescriptor(ruleDescriptor)
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *SessionPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// GetAppNamespaceIndex returns read-only reference to the index map with
+// app namespace indexes assigned by VPP (nil if session layer is not supported).
+func (p *SessionPlugin) GetAppNamespaceIndex() idxvpp.NameToIndex {
+	return p.nsIndex
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+// SessionRuleDetails contains proto-modeled session rule data together
+// with VPP-related metadata.
+type SessionRuleDetails struct {
+	Rule *session.SessionRule `json:"session_rule"`
+	Meta *SessionRuleMeta     `json:"session_rule_meta"`
+}
+
+// SessionRuleMeta contains index of the app namespace the rule belongs to.
+type SessionRuleMeta struct {
+	AppNamespaceIndex uint32 `json:"app_namespace_index"`
+}
+
+// SessionVppAPI provides read/write methods required to handle VPP session layer.
+type SessionVppAPI interface {
+	SessionVppRead
+
+	// EnableDisableSessionLayer enables or disables the session layer.
+	EnableDisableSessionLayer(enable bool) error
+	// EnableDisableSessionSocketAPI enables or disables the session API socket.
+	EnableDisableSessionSocketAPI(enable bool) error
+	// AddAppNamespace creates new app namespace (or updates the existing one)
+	// and returns its index. Use ^uint32(0) as the interface index if the
+	// namespace is not attached to an interface.
+	AddAppNamespace(ns *session.AppNamespace, swIfIndex uint32) (nsIndex uint32, err error)
+	// DeleteAppNamespace removes existing app namespace.
+	DeleteAppNamespace(ns *session.AppNamespace, swIfIndex uint32) error
+	// AddSessionRule adds new rule into the session table.
+	AddSessionRule(rule *session.SessionRule, nsIndex uint32) error
+	// DeleteSessionRule removes rule from the session table.
+	DeleteSessionRule(rule *session.SessionRule, nsIndex uint32) error
+}
+
+// SessionVppRead provides read methods for VPP session layer.
+type SessionVppRead interface {
+	// DumpSessionRules retrieves all session rules configured in VPP.
+	// Rules are returned with the app namespace index only (namespace
+	// identifiers are not dumped).
+	DumpSessionRules() ([]*SessionRuleDetails, error)
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "session",
+	HandlerAPI: (*SessionVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, log logging.Logger) SessionVppAPI
+
+func AddSessionHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleSessionVppHandler(c vpp.Client, log logging.Logger) SessionVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, log).(SessionVppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202"
+	vpp_session "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/session"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+)
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_session.AllMessages()...)
+
+	vppcalls.AddSessionHandlerVersion(vpp2202.Version, msgs, NewSessionVppHandler)
+}
+
+// SessionVppHandler is accessor for session-related vppcalls methods.
+type SessionVppHandler struct {
+	callsChannel govppapi.Channel
+	log          logging.Logger
+}
+
+// NewSessionVppHandler creates new instance of session vppcalls handler.
+func NewSessionVppHandler(ch govppapi.Channel, log logging.Logger) vppcalls.SessionVppAPI {
+	return &SessionVppHandler{
+		callsChannel: ch,
+		log:          log,
+	}
+}
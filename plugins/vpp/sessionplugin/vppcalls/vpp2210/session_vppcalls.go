@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	vpp_session "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/session"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+const (
+	// action indexes reserved by VPP for drop and allow rules
+	// (SESSION_RULE_ACTION_DROP and SESSION_RULE_ACTION_ALLOW)
+	dropActionIndex  = ^uint32(0) - 1
+	allowActionIndex = ^uint32(0) - 2
+)
+
+// EnableDisableSessionLayer implements session handler.
+func (h *SessionVppHandler) EnableDisableSessionLayer(enable bool) error {
+	req := &vpp_session.SessionEnableDisable{
+		IsEnable: enable,
+	}
+	reply := &vpp_session.SessionEnableDisableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// EnableDisableSessionSocketAPI implements session handler.
+func (h *SessionVppHandler) EnableDisableSessionSocketAPI(enable bool) error {
+	req := &vpp_session.SessionSapiEnableDisable{
+		IsEnable: enable,
+	}
+	reply := &vpp_session.SessionSapiEnableDisableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// AddAppNamespace implements session handler.
+func (h *SessionVppHandler) AddAppNamespace(ns *session.AppNamespace, swIfIndex uint32) (uint32, error) {
+	return h.addDelAppNamespace(ns, swIfIndex, true)
+}
+
+// DeleteAppNamespace implements session handler.
+func (h *SessionVppHandler) DeleteAppNamespace(ns *session.AppNamespace, swIfIndex uint32) error {
+	_, err := h.addDelAppNamespace(ns, swIfIndex, false)
+	return err
+}
+
+func (h *SessionVppHandler) addDelAppNamespace(ns *session.AppNamespace, swIfIndex uint32, isAdd bool) (uint32, error) {
+	req := &vpp_session.AppNamespaceAddDelV4{
+		IsAdd:       isAdd,
+		Secret:      ns.Secret,
+		SwIfIndex:   interface_types.InterfaceIndex(swIfIndex),
+		IP4FibID:    ns.Ip4Vrf,
+		IP6FibID:    ns.Ip6Vrf,
+		NamespaceID: ns.NamespaceId,
+		SockName:    ns.SocketPath,
+	}
+	reply := &vpp_session.AppNamespaceAddDelV4Reply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.AppnsIndex, nil
+}
+
+// AddSessionRule implements session handler.
+func (h *SessionVppHandler) AddSessionRule(rule *session.SessionRule, nsIndex uint32) error {
+	return h.addDelSessionRule(rule, nsIndex, true)
+}
+
+// DeleteSessionRule implements session handler.
+func (h *SessionVppHandler) DeleteSessionRule(rule *session.SessionRule, nsIndex uint32) error {
+	return h.addDelSessionRule(rule, nsIndex, false)
+}
+
+func (h *SessionVppHandler) addDelSessionRule(rule *session.SessionRule, nsIndex uint32, isAdd bool) error {
+	lcl, err := ip_types.ParsePrefix(rule.LocalNetwork)
+	if err != nil {
+		return errors.Wrapf(err, "invalid local network")
+	}
+	rmt, err := ip_types.ParsePrefix(rule.RemoteNetwork)
+	if err != nil {
+		return errors.Wrapf(err, "invalid remote network")
+	}
+	req := &vpp_session.SessionRuleAddDel{
+		IsAdd:          isAdd,
+		TransportProto: toVppTransportProto(rule.TransportProto),
+		Lcl:            lcl,
+		Rmt:            rmt,
+		LclPort:        uint16(rule.LocalPort),
+		RmtPort:        uint16(rule.RemotePort),
+		ActionIndex:    toVppActionIndex(rule.Action, rule.AppIndex),
+		AppnsIndex:     nsIndex,
+		Scope:          vpp_session.SessionRuleScope(rule.Scope),
+		Tag:            rule.Tag,
+	}
+	reply := &vpp_session.SessionRuleAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func toVppTransportProto(proto session.SessionRule_TransportProto) vpp_session.TransportProto {
+	switch proto {
+	case session.SessionRule_UDP:
+		return vpp_session.TRANSPORT_PROTO_API_UDP
+	case session.SessionRule_TLS:
+		return vpp_session.TRANSPORT_PROTO_API_TLS
+	case session.SessionRule_QUIC:
+		return vpp_session.TRANSPORT_PROTO_API_QUIC
+	default:
+		return vpp_session.TRANSPORT_PROTO_API_TCP
+	}
+}
+
+func fromVppTransportProto(proto vpp_session.TransportProto) session.SessionRule_TransportProto {
+	switch proto {
+	case vpp_session.TRANSPORT_PROTO_API_UDP:
+		return session.SessionRule_UDP
+	case vpp_session.TRANSPORT_PROTO_API_TLS:
+		return session.SessionRule_TLS
+	case vpp_session.TRANSPORT_PROTO_API_QUIC:
+		return session.SessionRule_QUIC
+	default:
+		return session.SessionRule_TCP
+	}
+}
+
+func toVppActionIndex(action session.SessionRule_Action, appIndex uint32) uint32 {
+	switch action {
+	case session.SessionRule_ALLOW:
+		return allowActionIndex
+	case session.SessionRule_REDIRECT:
+		return appIndex
+	default:
+		return dropActionIndex
+	}
+}
+
+func fromVppActionIndex(actionIndex uint32) (session.SessionRule_Action, uint32) {
+	switch actionIndex {
+	case dropActionIndex:
+		return session.SessionRule_DROP, 0
+	case allowActionIndex:
+		return session.SessionRule_ALLOW, 0
+	default:
+		return session.SessionRule_REDIRECT, actionIndex
+	}
+}
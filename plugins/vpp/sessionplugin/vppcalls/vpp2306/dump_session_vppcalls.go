@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306
+
+import (
+	vpp_session "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/session"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+// DumpSessionRules implements session handler.
+func (h *SessionVppHandler) DumpSessionRules() (rules []*vppcalls.SessionRuleDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_session.SessionRulesDump{})
+	for {
+		details := &vpp_session.SessionRulesDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		action, appIndex := fromVppActionIndex(details.ActionIndex)
+		rules = append(rules, &vppcalls.SessionRuleDetails{
+			Rule: &session.SessionRule{
+				Tag:            details.Tag,
+				TransportProto: fromVppTransportProto(details.TransportProto),
+				LocalNetwork:   details.Lcl.String(),
+				LocalPort:      uint32(details.LclPort),
+				RemoteNetwork:  details.Rmt.String(),
+				RemotePort:     uint32(details.RmtPort),
+				Action:         action,
+				AppIndex:       appIndex,
+				Scope:          session.SessionRule_Scope(details.Scope),
+			},
+			Meta: &vppcalls.SessionRuleMeta{
+				AppNamespaceIndex: details.AppnsIndex,
+			},
+		})
+	}
+	return rules, nil
+}
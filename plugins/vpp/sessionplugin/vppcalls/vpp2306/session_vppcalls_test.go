@@ -0,0 +1,249 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2306_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/memclnt"
+	vpp_session "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/session"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/sessionplugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+func TestEnableDisableSessionLayer(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.SessionEnableDisableReply{})
+	err := sessionHandler.EnableDisableSessionLayer(true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_session.SessionEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsEnable).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_session.SessionSapiEnableDisableReply{})
+	err = sessionHandler.EnableDisableSessionSocketAPI(false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	sapiMsg, ok := ctx.MockChannel.Msg.(*vpp_session.SessionSapiEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(sapiMsg.IsEnable).To(BeFalse())
+}
+
+func TestEnableSessionLayerError(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.SessionEnableDisableReply{
+		Retval: -1,
+	})
+	err := sessionHandler.EnableDisableSessionLayer(true)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddAppNamespace(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.AppNamespaceAddDelV4Reply{
+		AppnsIndex: 3,
+	})
+	index, err := sessionHandler.AddAppNamespace(&session.AppNamespace{
+		NamespaceId: "proxy",
+		Secret:      42,
+		Ip4Vrf:      1,
+		Ip6Vrf:      2,
+		SocketPath:  "/run/vpp/proxy.sock",
+	}, ^uint32(0))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(index).To(BeEquivalentTo(3))
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_session.AppNamespaceAddDelV4)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.NamespaceID).To(Equal("proxy"))
+	Expect(vppMsg.Secret).To(BeEquivalentTo(42))
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.IP4FibID).To(BeEquivalentTo(1))
+	Expect(vppMsg.IP6FibID).To(BeEquivalentTo(2))
+	Expect(vppMsg.SockName).To(Equal("/run/vpp/proxy.sock"))
+}
+
+func TestDeleteAppNamespace(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.AppNamespaceAddDelV4Reply{})
+	err := sessionHandler.DeleteAppNamespace(&session.AppNamespace{
+		NamespaceId: "proxy",
+		Interface:   "loop0",
+	}, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_session.AppNamespaceAddDelV4)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.NamespaceID).To(Equal("proxy"))
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+}
+
+func TestAddSessionRule(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.SessionRuleAddDelReply{})
+	err := sessionHandler.AddSessionRule(&session.SessionRule{
+		Tag:            "deny-http",
+		TransportProto: session.SessionRule_TCP,
+		LocalNetwork:   "10.0.0.0/24",
+		LocalPort:      80,
+		RemoteNetwork:  "0.0.0.0/0",
+		Action:         session.SessionRule_DROP,
+		Scope:          session.SessionRule_LOCAL,
+	}, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_session.SessionRuleAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.Tag).To(Equal("deny-http"))
+	Expect(vppMsg.TransportProto).To(Equal(vpp_session.TRANSPORT_PROTO_API_TCP))
+	Expect(vppMsg.Lcl.String()).To(Equal("10.0.0.0/24"))
+	Expect(vppMsg.LclPort).To(BeEquivalentTo(80))
+	Expect(vppMsg.Rmt.String()).To(Equal("0.0.0.0/0"))
+	Expect(vppMsg.RmtPort).To(BeEquivalentTo(0))
+	Expect(vppMsg.ActionIndex).To(BeEquivalentTo(^uint32(0) - 1))
+	Expect(vppMsg.AppnsIndex).To(BeEquivalentTo(3))
+	Expect(vppMsg.Scope).To(Equal(vpp_session.SESSION_RULE_SCOPE_API_LOCAL))
+}
+
+func TestAddSessionRuleRedirect(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.SessionRuleAddDelReply{})
+	err := sessionHandler.AddSessionRule(&session.SessionRule{
+		Tag:            "to-proxy",
+		TransportProto: session.SessionRule_QUIC,
+		LocalNetwork:   "2001:db8::/64",
+		RemoteNetwork:  "::/0",
+		Action:         session.SessionRule_REDIRECT,
+		AppIndex:       7,
+	}, 0)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_session.SessionRuleAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.TransportProto).To(Equal(vpp_session.TRANSPORT_PROTO_API_QUIC))
+	Expect(vppMsg.Lcl.Address.Af).To(Equal(ip_types.ADDRESS_IP6))
+	Expect(vppMsg.ActionIndex).To(BeEquivalentTo(7))
+}
+
+func TestAddSessionRuleInvalidNetwork(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := sessionHandler.AddSessionRule(&session.SessionRule{
+		Tag:           "invalid",
+		LocalNetwork:  "10.0.0.0/33",
+		RemoteNetwork: "0.0.0.0/0",
+	}, 0)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDeleteSessionRule(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_session.SessionRuleAddDelReply{})
+	err := sessionHandler.DeleteSessionRule(&session.SessionRule{
+		Tag:            "allow-dns",
+		TransportProto: session.SessionRule_UDP,
+		LocalNetwork:   "10.0.0.1/32",
+		LocalPort:      53,
+		RemoteNetwork:  "0.0.0.0/0",
+		Action:         session.SessionRule_ALLOW,
+	}, 0)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_session.SessionRuleAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.TransportProto).To(Equal(vpp_session.TRANSPORT_PROTO_API_UDP))
+	Expect(vppMsg.ActionIndex).To(BeEquivalentTo(^uint32(0) - 2))
+}
+
+func TestDumpSessionRules(t *testing.T) {
+	ctx, sessionHandler := sessionTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	lcl, _ := ip_types.ParsePrefix("10.0.0.0/24")
+	rmt, _ := ip_types.ParsePrefix("0.0.0.0/0")
+	ctx.MockVpp.MockReply(
+		&vpp_session.SessionRulesDetails{
+			TransportProto: vpp_session.TRANSPORT_PROTO_API_TCP,
+			Lcl:            lcl,
+			Rmt:            rmt,
+			LclPort:        80,
+			ActionIndex:    ^uint32(0) - 1,
+			AppnsIndex:     3,
+			Scope:          vpp_session.SESSION_RULE_SCOPE_API_GLOBAL,
+			Tag:            "deny-http",
+		},
+		&vpp_session.SessionRulesDetails{
+			TransportProto: vpp_session.TRANSPORT_PROTO_API_TLS,
+			Lcl:            lcl,
+			Rmt:            rmt,
+			ActionIndex:    5,
+			Scope:          vpp_session.SESSION_RULE_SCOPE_API_BOTH,
+			Tag:            "to-proxy",
+		},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	rules, err := sessionHandler.DumpSessionRules()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(rules).To(HaveLen(2))
+
+	Expect(rules[0].Meta.AppNamespaceIndex).To(BeEquivalentTo(3))
+	Expect(rules[0].Rule.Tag).To(Equal("deny-http"))
+	Expect(rules[0].Rule.TransportProto).To(Equal(session.SessionRule_TCP))
+	Expect(rules[0].Rule.LocalNetwork).To(Equal("10.0.0.0/24"))
+	Expect(rules[0].Rule.LocalPort).To(BeEquivalentTo(80))
+	Expect(rules[0].Rule.RemoteNetwork).To(Equal("0.0.0.0/0"))
+	Expect(rules[0].Rule.Action).To(Equal(session.SessionRule_DROP))
+	Expect(rules[0].Rule.Scope).To(Equal(session.SessionRule_GLOBAL))
+
+	Expect(rules[1].Meta.AppNamespaceIndex).To(BeEquivalentTo(0))
+	Expect(rules[1].Rule.TransportProto).To(Equal(session.SessionRule_TLS))
+	Expect(rules[1].Rule.Action).To(Equal(session.SessionRule_REDIRECT))
+	Expect(rules[1].Rule.AppIndex).To(BeEquivalentTo(5))
+	Expect(rules[1].Rule.Scope).To(Equal(session.SessionRule_BOTH))
+}
+
+func sessionTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.SessionVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	sessionHandler := vpp2306.NewSessionVppHandler(ctx.MockChannel, log)
+	return ctx, sessionHandler
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor_test
+
+import (
+	"testing"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/descriptortest"
+	vpp_stn "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2306/stn"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/vppcalls/vpp2306"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	stn "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/stn"
+)
+
+func TestSTNDescriptorConformance(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	log := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(log, "stn-if-idx")
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	handler := vpp2306.NewStnVppHandler(ctx.MockChannel, ifIndexes, logrus.DefaultLogger())
+	stnDescriptor := descriptor.NewSTNDescriptor(handler, log)
+
+	suite := &descriptortest.Suite{
+		Descriptor: adapter.NewSTNDescriptor(stnDescriptor.GetDescriptor()),
+		Southbound: vppmock.NewSouthbound(ctx, stnReplies),
+		Samples: []descriptortest.Sample{
+			{Value: &stn.Rule{Interface: "if1", IpAddress: "10.0.0.1"}},
+			{Value: &stn.Rule{Interface: "if2", IpAddress: "2001:db8::1"}},
+		},
+	}
+	suite.Run(t)
+}
+
+// stnReplies replays STN rules added/deleted via the mocked channel
+// and builds the reply for the STN rules dump.
+func stnReplies(sent []govppapi.Message) []*vppmock.HandleReplies {
+	var rules []*vpp_stn.StnAddDelRule
+	for _, msg := range sent {
+		req, ok := msg.(*vpp_stn.StnAddDelRule)
+		if !ok {
+			continue
+		}
+		for i, rule := range rules {
+			if rule.SwIfIndex == req.SwIfIndex && rule.IPAddress == req.IPAddress {
+				rules = append(rules[:i], rules[i+1:]...)
+				break
+			}
+		}
+		if req.IsAdd {
+			rules = append(rules, req)
+		}
+	}
+	replies := []*vppmock.HandleReplies{
+		{
+			Name:    (&vpp_stn.StnAddDelRule{}).GetMessageName(),
+			Message: &vpp_stn.StnAddDelRuleReply{},
+		},
+	}
+	if len(rules) == 0 {
+		// empty dump is answered by control ping only
+		return replies
+	}
+	var details []govppapi.Message
+	for _, rule := range rules {
+		details = append(details, &vpp_stn.StnRulesDetails{
+			IPAddress: rule.IPAddress,
+			SwIfIndex: rule.SwIfIndex,
+		})
+	}
+	return append(replies, &vppmock.HandleReplies{
+		Name:     (&vpp_stn.StnRulesDump{}).GetMessageName(),
+		Ping:     true,
+		Messages: details,
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceplugin
+
+import "time"
+
+// Config file representation for trace plugin
+type Config struct {
+	// Directory where the pcap files written by VPP are accessible
+	// for the agent (VPP always writes pcap files into its /tmp directory).
+	PcapDir string `json:"pcap-dir"`
+	// Default and maximum number of packets traced on each input node.
+	DefaultTraceCount uint32 `json:"default-trace-count"`
+	MaxTraceCount     uint32 `json:"max-trace-count"`
+	// Default and maximum number of packets captured into the pcap file.
+	DefaultPcapPackets uint32 `json:"default-pcap-packets"`
+	MaxPcapPackets     uint32 `json:"max-pcap-packets"`
+	// Default and maximum number of bytes captured from each packet.
+	DefaultPcapBytesPerPacket uint32 `json:"default-pcap-bytes-per-packet"`
+	MaxPcapBytesPerPacket     uint32 `json:"max-pcap-bytes-per-packet"`
+	// Default and maximum duration of the pcap capture.
+	DefaultPcapDuration time.Duration `json:"default-pcap-duration"`
+	MaxPcapDuration     time.Duration `json:"max-pcap-duration"`
+}
+
+var DefaultConfig = func() *Config {
+	return &Config{
+		PcapDir:                   "/tmp",
+		DefaultTraceCount:         50,
+		MaxTraceCount:             1000,
+		DefaultPcapPackets:        1000,
+		MaxPcapPackets:            100000,
+		DefaultPcapBytesPerPacket: 512,
+		MaxPcapBytesPerPacket:     9000,
+		DefaultPcapDuration:       time.Second * 30,
+		MaxPcapDuration:           time.Minute * 10,
+	}
+}
+
+// loadConfig returns trace plugin file configuration if exists
+func (p *TracePlugin) loadConfig() (*Config, error) {
+	cfg := &Config{}
+	if DefaultConfig != nil {
+		cfg = DefaultConfig()
+	}
+
+	found, err := p.Cfg.LoadValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		p.Log.Debugf("Trace config not found. Using default config: %+v", cfg)
+	} else {
+		p.Log.Debugf("Trace config found: %+v", cfg)
+	}
+
+	return cfg, nil
+}
+
+// limit returns value if it is within (0, max], def if value is zero and
+// max otherwise.
+func limit(value, def, max uint32) uint32 {
+	if value == 0 {
+		value = def
+	}
+	if value > max {
+		value = max
+	}
+	return value
+}
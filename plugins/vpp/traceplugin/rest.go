@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceplugin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/rpc/rest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
+	vpp_trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
+)
+
+// registerHandlers registers REST handlers of the trace server.
+func (s *traceServer) registerHandlers(handlers rest.HTTPHandlers) {
+	handlers.RegisterHTTPHandler(resturl.Trace, s.getTraceHandler, "GET")
+	handlers.RegisterHTTPHandler(resturl.TraceStart, s.startTraceHandler, "POST")
+	handlers.RegisterHTTPHandler(resturl.TraceClear, s.clearTraceHandler, "POST")
+	handlers.RegisterHTTPHandler(resturl.Pcap, s.pcapStatusHandler, "GET")
+	handlers.RegisterHTTPHandler(resturl.PcapStart, s.startPcapHandler, "POST")
+	handlers.RegisterHTTPHandler(resturl.PcapStop, s.stopPcapHandler, "POST")
+	handlers.RegisterHTTPHandler(resturl.PcapDownload, s.downloadPcapHandler, "GET")
+}
+
+func (s *traceServer) startTraceHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var traceReq vpp_trace.StartTraceRequest
+		if err := json.NewDecoder(req.Body).Decode(&traceReq); err != nil {
+			s.errorReply(formatter, w, status.Errorf(codes.InvalidArgument, "failed to decode request body: %v", err))
+			return
+		}
+		resp, err := s.StartTrace(req.Context(), &traceReq)
+		s.reply(formatter, w, resp, err)
+	}
+}
+
+func (s *traceServer) getTraceHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var traceReq vpp_trace.GetTraceRequest
+		if clear := req.URL.Query().Get("clear"); clear != "" {
+			var err error
+			if traceReq.Clear, err = strconv.ParseBool(clear); err != nil {
+				s.errorReply(formatter, w, status.Errorf(codes.InvalidArgument, "invalid clear parameter: %v", err))
+				return
+			}
+		}
+		resp, err := s.GetTrace(req.Context(), &traceReq)
+		s.reply(formatter, w, resp, err)
+	}
+}
+
+func (s *traceServer) clearTraceHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp, err := s.ClearTrace(req.Context(), &vpp_trace.ClearTraceRequest{})
+		s.reply(formatter, w, resp, err)
+	}
+}
+
+func (s *traceServer) startPcapHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var pcapReq vpp_trace.StartPcapRequest
+		if err := json.NewDecoder(req.Body).Decode(&pcapReq); err != nil && err != io.EOF {
+			s.errorReply(formatter, w, status.Errorf(codes.InvalidArgument, "failed to decode request body: %v", err))
+			return
+		}
+		resp, err := s.StartPcap(req.Context(), &pcapReq)
+		s.reply(formatter, w, resp.GetStatus(), err)
+	}
+}
+
+func (s *traceServer) stopPcapHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp, err := s.StopPcap(req.Context(), &vpp_trace.StopPcapRequest{})
+		s.reply(formatter, w, resp.GetStatus(), err)
+	}
+}
+
+func (s *traceServer) pcapStatusHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp, err := s.GetPcapStatus(req.Context(), &vpp_trace.GetPcapStatusRequest{})
+		s.reply(formatter, w, resp.GetStatus(), err)
+	}
+}
+
+func (s *traceServer) downloadPcapHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		file, err := s.openPcap()
+		if err != nil {
+			s.errorReply(formatter, w, err)
+			return
+		}
+		defer file.Close()
+
+		w.Header().Set("Content-Type", "application/vnd.tcpdump.pcap")
+		w.Header().Set("Content-Disposition", "attachment; filename="+pcapFilename)
+		if _, err := io.Copy(w, file); err != nil {
+			s.log.Warnf("sending pcap file failed: %v", err)
+		}
+	}
+}
+
+// reply writes response of the gRPC method as JSON.
+func (s *traceServer) reply(formatter *render.Render, w http.ResponseWriter, resp interface{}, err error) {
+	if err != nil {
+		s.errorReply(formatter, w, err)
+		return
+	}
+	if err := formatter.JSON(w, http.StatusOK, resp); err != nil {
+		s.log.Warnf("trace handler errored: %v", err)
+	}
+}
+
+// errorReply writes error returned by the gRPC method with corresponding
+// HTTP status code.
+func (s *traceServer) errorReply(formatter *render.Render, w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	_ = formatter.JSON(w, httpStatus(st.Code()), struct{ Error string }{st.Message()})
+}
+
+// httpStatus translates gRPC status code into HTTP status code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
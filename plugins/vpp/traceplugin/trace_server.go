@@ -0,0 +1,321 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceplugin
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls"
+	vpp_trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
+)
+
+const (
+	// name of the pcap file written by VPP, every capture overwrites
+	// the file of the previous one
+	pcapFilename = "vpp-agent.pcap"
+	// directory where VPP writes pcap files
+	vppPcapDir = "/tmp"
+	// size of the pcap file chunks sent to the client
+	pcapChunkSize = 64 * 1024
+)
+
+type traceServer struct {
+	vpp_trace.UnimplementedTraceServiceServer
+
+	handler       vppcalls.TraceVppAPI
+	ifIndex       ifaceidx.IfaceMetadataIndex
+	classifyIndex idxvpp.NameToIndex
+	config        *Config
+
+	log logging.Logger
+
+	mu        sync.Mutex
+	pcap      *vpp_trace.PcapStatus // status of the last capture
+	pcapTimer *time.Timer
+}
+
+// StartTrace starts tracing of packets on the given input nodes.
+func (s *traceServer) StartTrace(_ context.Context, req *vpp_trace.StartTraceRequest) (*vpp_trace.StartTraceResponse, error) {
+	if s.handler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP trace handler not available")
+	}
+	if len(req.GetInputNodes()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one input node must be defined")
+	}
+
+	// resolve nodes before the tracer is touched
+	nodes := make([]uint32, 0, len(req.GetInputNodes()))
+	for _, name := range req.GetInputNodes() {
+		nodeIdx, err := s.handler.GetNodeIndex(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown input node %q: %v", name, err)
+		}
+		nodes = append(nodes, nodeIdx)
+	}
+
+	tableIdx := ^uint32(0)
+	if tableName := req.GetClassifyTable(); tableName != "" {
+		if s.classifyIndex == nil {
+			return nil, status.Error(codes.Unavailable, "classify tables not available")
+		}
+		table, found := s.classifyIndex.LookupByName(tableName)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "classify table %q not found", tableName)
+		}
+		tableIdx = table.GetIndex()
+	}
+	// filter is always set to remove the filter of the previous trace
+	if err := s.handler.SetTraceFilter(tableIdx); err != nil {
+		return nil, status.Errorf(codes.Internal, "setting trace filter failed: %v", err)
+	}
+
+	count := limit(req.GetCount(), s.config.DefaultTraceCount, s.config.MaxTraceCount)
+	useFilter := tableIdx != ^uint32(0)
+	for i, nodeIdx := range nodes {
+		if err := s.handler.StartTrace(nodeIdx, count, useFilter, req.GetVerbose()); err != nil {
+			return nil, status.Errorf(codes.Internal, "starting trace on node %s failed: %v",
+				req.GetInputNodes()[i], err)
+		}
+	}
+	s.log.Infof("trace of %d packets started on nodes %v", count, req.GetInputNodes())
+
+	return &vpp_trace.StartTraceResponse{}, nil
+}
+
+// GetTrace returns packets captured by the packet tracer.
+func (s *traceServer) GetTrace(_ context.Context, req *vpp_trace.GetTraceRequest) (*vpp_trace.GetTraceResponse, error) {
+	if s.handler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP trace handler not available")
+	}
+	packets, err := s.handler.DumpTrace()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "retrieving trace failed: %v", err)
+	}
+	if req.GetClear() {
+		if err := s.handler.ClearTrace(); err != nil {
+			return nil, status.Errorf(codes.Internal, "clearing trace failed: %v", err)
+		}
+	}
+	return &vpp_trace.GetTraceResponse{Packets: packets}, nil
+}
+
+// ClearTrace removes all packets captured by the packet tracer.
+func (s *traceServer) ClearTrace(context.Context, *vpp_trace.ClearTraceRequest) (*vpp_trace.ClearTraceResponse, error) {
+	if s.handler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP trace handler not available")
+	}
+	if err := s.handler.ClearTrace(); err != nil {
+		return nil, status.Errorf(codes.Internal, "clearing trace failed: %v", err)
+	}
+	return &vpp_trace.ClearTraceResponse{}, nil
+}
+
+// StartPcap starts pcap capture, which is stopped automatically after
+// the configured duration.
+func (s *traceServer) StartPcap(_ context.Context, req *vpp_trace.StartPcapRequest) (*vpp_trace.StartPcapResponse, error) {
+	if s.handler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP trace handler not available")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pcap.GetRunning() {
+		return nil, status.Error(codes.FailedPrecondition, "pcap capture is already running")
+	}
+
+	opts := &vppcalls.PcapOptions{
+		CaptureRx:         req.GetCaptureRx(),
+		CaptureTx:         req.GetCaptureTx(),
+		CaptureDrop:       req.GetCaptureDrop(),
+		MaxPackets:        limit(req.GetMaxPackets(), s.config.DefaultPcapPackets, s.config.MaxPcapPackets),
+		MaxBytesPerPacket: limit(req.GetMaxBytesPerPacket(), s.config.DefaultPcapBytesPerPacket, s.config.MaxPcapBytesPerPacket),
+		Filename:          pcapFilename,
+	}
+	if !opts.CaptureRx && !opts.CaptureTx && !opts.CaptureDrop {
+		opts.CaptureRx, opts.CaptureTx = true, true
+	}
+	if ifName := req.GetInterface(); ifName != "" {
+		meta, found := s.ifIndex.LookupByName(ifName)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "interface %q not found", ifName)
+		}
+		opts.SwIfIndex = meta.SwIfIndex
+	}
+
+	duration := s.config.DefaultPcapDuration
+	if req.GetDurationSec() > 0 {
+		duration = time.Duration(req.GetDurationSec()) * time.Second
+	}
+	if duration > s.config.MaxPcapDuration {
+		duration = s.config.MaxPcapDuration
+	}
+
+	// remove the file of the previous capture so that it cannot be
+	// mistaken for the result of this one
+	if err := os.Remove(s.pcapPath()); err != nil && !os.IsNotExist(err) {
+		s.log.Warnf("removing previous pcap file failed: %v", err)
+	}
+	if err := s.handler.StartPcap(opts); err != nil {
+		return nil, status.Errorf(codes.Internal, "starting pcap capture failed: %v", err)
+	}
+
+	now := time.Now()
+	s.pcap = &vpp_trace.PcapStatus{
+		Running:           true,
+		Filename:          path.Join(vppPcapDir, pcapFilename),
+		StartTime:         now.Unix(),
+		StopTime:          now.Add(duration).Unix(),
+		MaxPackets:        opts.MaxPackets,
+		MaxBytesPerPacket: opts.MaxBytesPerPacket,
+	}
+	s.pcapTimer = time.AfterFunc(duration, s.pcapTimeout)
+	s.log.Infof("pcap capture started (interface: %q, duration: %v, max packets: %d)",
+		req.GetInterface(), duration, opts.MaxPackets)
+
+	return &vpp_trace.StartPcapResponse{Status: proto.Clone(s.pcap).(*vpp_trace.PcapStatus)}, nil
+}
+
+// StopPcap stops running pcap capture.
+func (s *traceServer) StopPcap(context.Context, *vpp_trace.StopPcapRequest) (*vpp_trace.StopPcapResponse, error) {
+	if s.handler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP trace handler not available")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.pcap.GetRunning() {
+		return nil, status.Error(codes.FailedPrecondition, "pcap capture is not running")
+	}
+	if err := s.stopPcap(); err != nil {
+		return nil, status.Errorf(codes.Internal, "stopping pcap capture failed: %v", err)
+	}
+	return &vpp_trace.StopPcapResponse{Status: proto.Clone(s.pcap).(*vpp_trace.PcapStatus)}, nil
+}
+
+// GetPcapStatus returns status of the last pcap capture.
+func (s *traceServer) GetPcapStatus(context.Context, *vpp_trace.GetPcapStatusRequest) (*vpp_trace.GetPcapStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pcapStatus := &vpp_trace.PcapStatus{}
+	if s.pcap != nil {
+		pcapStatus = proto.Clone(s.pcap).(*vpp_trace.PcapStatus)
+	}
+	return &vpp_trace.GetPcapStatusResponse{Status: pcapStatus}, nil
+}
+
+// DownloadPcap streams content of the pcap file of the last capture.
+func (s *traceServer) DownloadPcap(_ *vpp_trace.DownloadPcapRequest, svr vpp_trace.TraceService_DownloadPcapServer) error {
+	file, err := s.openPcap()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	buf := make([]byte, pcapChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := svr.Send(&vpp_trace.DownloadPcapResponse{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "reading pcap file failed: %v", err)
+		}
+	}
+}
+
+// openPcap opens pcap file of the last (already stopped) capture.
+func (s *traceServer) openPcap() (*os.File, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pcap == nil {
+		return nil, status.Error(codes.NotFound, "no pcap capture was taken")
+	}
+	if s.pcap.GetRunning() {
+		return nil, status.Error(codes.FailedPrecondition, "pcap capture is still running")
+	}
+	file, err := os.Open(s.pcapPath())
+	if os.IsNotExist(err) {
+		return nil, status.Error(codes.NotFound, "pcap file not found (no packets captured?)")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "opening pcap file failed: %v", err)
+	}
+	return file, nil
+}
+
+// pcapTimeout stops the capture when its duration elapses.
+func (s *traceServer) pcapTimeout() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.pcap.GetRunning() {
+		return
+	}
+	s.log.Info("pcap capture duration elapsed, stopping capture")
+	if err := s.stopPcap(); err != nil {
+		s.log.Errorf("stopping pcap capture failed: %v", err)
+	}
+}
+
+// close stops running capture (if any).
+func (s *traceServer) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pcap.GetRunning() {
+		if err := s.stopPcap(); err != nil {
+			s.log.Warnf("stopping pcap capture failed: %v", err)
+		}
+	}
+}
+
+// stopPcap stops the running capture, it must be called with the lock held.
+// The capture is marked as stopped even if VPP fails to stop it, since VPP
+// may have already stopped it after reaching the packet limit.
+func (s *traceServer) stopPcap() error {
+	if s.pcapTimer != nil {
+		s.pcapTimer.Stop()
+		s.pcapTimer = nil
+	}
+	s.pcap.Running = false
+	s.pcap.StopTime = time.Now().Unix()
+	return s.handler.StopPcap()
+}
+
+// pcapPath returns path of the pcap file as seen by the agent.
+func (s *traceServer) pcapPath() string {
+	return filepath.Join(s.config.PcapDir, pcapFilename)
+}
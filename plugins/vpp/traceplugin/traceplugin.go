@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/rpc/grpc"
+	"go.ligato.io/cn-infra/v2/rpc/rest"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls"
+	vpp_trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls/vpp2210"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls/vpp2306"
+)
+
+// TracePlugin exposes VPP packet tracer and pcap capture via gRPC and REST,
+// so that the dataplane can be troubleshooted without access to VPP CLI.
+type TracePlugin struct {
+	Deps
+
+	traceServer
+}
+
+// InterfaceIndexProvider provides index of VPP interfaces.
+type InterfaceIndexProvider interface {
+	// GetInterfaceIndex gives read-only access to map with metadata of all configured
+	// VPP interfaces.
+	GetInterfaceIndex() ifaceidx.IfaceMetadataIndex
+}
+
+// ClassifyTableIndexProvider provides index of VPP classify tables.
+type ClassifyTableIndexProvider interface {
+	// GetClassifyTableIndex gives read-only access to map with indexes of all
+	// configured VPP classify tables.
+	GetClassifyTableIndex() idxvpp.NameToIndex
+}
+
+// Deps represents dependencies of Trace Plugin
+type Deps struct {
+	infra.PluginDeps
+	VPP            govppmux.API
+	IfPlugin       InterfaceIndexProvider
+	ClassifyPlugin ClassifyTableIndexProvider // optional
+	GRPC           grpc.Server                // optional
+	HTTPHandlers   rest.HTTPHandlers          // optional
+}
+
+// Init initializes Trace Plugin
+func (p *TracePlugin) Init() error {
+	config, err := p.loadConfig()
+	if err != nil {
+		return errors.WithMessage(err, "loading config failed")
+	}
+
+	p.traceServer.config = config
+	p.traceServer.log = p.Log
+	p.traceServer.ifIndex = p.IfPlugin.GetInterfaceIndex()
+	if p.ClassifyPlugin != nil {
+		p.traceServer.classifyIndex = p.ClassifyPlugin.GetClassifyTableIndex()
+	}
+	p.traceServer.handler = vppcalls.CompatibleTraceVppHandler(p.VPP, p.Log)
+	if p.traceServer.handler == nil {
+		p.Log.Warnf("VPP trace handler unavailable")
+	}
+
+	if p.GRPC != nil && p.GRPC.GetServer() != nil {
+		vpp_trace.RegisterTraceServiceServer(p.GRPC.GetServer(), &p.traceServer)
+	}
+	if p.HTTPHandlers != nil {
+		p.traceServer.registerHandlers(p.HTTPHandlers)
+	}
+
+	return nil
+}
+
+// Close stops running pcap capture.
+func (p *TracePlugin) Close() error {
+	p.traceServer.close()
+	return nil
+}
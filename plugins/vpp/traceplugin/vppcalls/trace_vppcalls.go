@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
+)
+
+// PcapOptions defines parameters of the pcap capture.
+type PcapOptions struct {
+	// SwIfIndex of the captured interface, 0 captures all interfaces.
+	SwIfIndex         uint32
+	CaptureRx         bool
+	CaptureTx         bool
+	CaptureDrop       bool
+	MaxPackets        uint32
+	MaxBytesPerPacket uint32
+	// Filename of the pcap file (VPP places it into /tmp).
+	Filename string
+}
+
+// TraceVppAPI provides methods for controlling VPP packet tracer and pcap
+// capture.
+type TraceVppAPI interface {
+	// GetNodeIndex returns index of the graph node with the given name.
+	GetNodeIndex(nodeName string) (nodeIndex uint32, err error)
+	// SetTraceFilter configures classify table selecting the traced packets.
+	// Filter is removed if classifyTableIndex is ^uint32(0).
+	SetTraceFilter(classifyTableIndex uint32) error
+	// StartTrace starts tracing of up to <count> packets arriving
+	// to the given input node.
+	StartTrace(nodeIndex, count uint32, useFilter, verbose bool) error
+	// ClearTrace removes all traced packets.
+	ClearTrace() error
+	// DumpTrace retrieves all traced packets.
+	DumpTrace() ([]*trace.TracedPacket, error)
+	// StartPcap starts pcap capture.
+	StartPcap(opts *PcapOptions) error
+	// StopPcap stops pcap capture and writes captured packets into the file.
+	StopPcap() error
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "trace",
+	HandlerAPI: (*TraceVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, log logging.Logger) TraceVppAPI
+
+func AddTraceHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleTraceVppHandler(c vpp.Client, log logging.Logger) TraceVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, log).(TraceVppAPI)
+	}
+	return nil
+}
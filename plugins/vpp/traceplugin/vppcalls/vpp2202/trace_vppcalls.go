@@ -0,0 +1,216 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	vpp_tracedump "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/tracedump"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls"
+	trace "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/trace"
+)
+
+// maximum length of the pcap filename supported by VPP
+const maxPcapFilenameLen = 64
+
+// traceNodeLine matches the first line of the trace record of a single
+// graph node (e.g. "00:00:36:327186: af-packet-input").
+var traceNodeLine = regexp.MustCompile(`^(\d+:\d+:\d+:\d+): (\S+)$`)
+
+// GetNodeIndex implements trace handler.
+func (h *TraceVppHandler) GetNodeIndex(nodeName string) (uint32, error) {
+	req := &vlib.GetNodeIndex{
+		NodeName: nodeName,
+	}
+	reply := &vlib.GetNodeIndexReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, errors.Errorf("failed to get index of node %s: %v", nodeName, err)
+	}
+	return reply.NodeIndex, nil
+}
+
+// SetTraceFilter implements trace handler.
+func (h *TraceVppHandler) SetTraceFilter(classifyTableIndex uint32) error {
+	req := &vpp_tracedump.TraceSetFilters{
+		Flag:                 vpp_tracedump.TRACE_FF_NONE,
+		NodeIndex:            ^uint32(0),
+		ClassifierTableIndex: ^uint32(0),
+	}
+	if classifyTableIndex != ^uint32(0) {
+		req.Flag = vpp_tracedump.TRACE_FF_INCLUDE_CLASSIFIER
+		req.ClassifierTableIndex = classifyTableIndex
+	}
+	reply := &vpp_tracedump.TraceSetFiltersReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// StartTrace implements trace handler.
+func (h *TraceVppHandler) StartTrace(nodeIndex, count uint32, useFilter, verbose bool) error {
+	req := &vpp_tracedump.TraceCapturePackets{
+		NodeIndex:  nodeIndex,
+		MaxPackets: count,
+		UseFilter:  useFilter,
+		Verbose:    verbose,
+	}
+	reply := &vpp_tracedump.TraceCapturePacketsReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// ClearTrace implements trace handler.
+func (h *TraceVppHandler) ClearTrace() error {
+	req := &vpp_tracedump.TraceClearCapture{}
+	reply := &vpp_tracedump.TraceClearCaptureReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// DumpTrace implements trace handler.
+func (h *TraceVppHandler) DumpTrace() (packets []*trace.TracedPacket, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_tracedump.TraceV2Dump{
+		ThreadID:   ^uint32(0),
+		Max:        ^uint32(0),
+		ClearCache: true,
+	})
+	for {
+		details := &vpp_tracedump.TraceV2Details{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, &trace.TracedPacket{
+			ThreadId: details.ThreadID,
+			Position: details.Position,
+			Nodes:    parseTraceData(details.TraceData),
+		})
+	}
+	return packets, nil
+}
+
+// StartPcap implements trace handler. The pcap_trace_on binary API is not
+// available in this VPP version, the capture is started using the CLI.
+func (h *TraceVppHandler) StartPcap(opts *vppcalls.PcapOptions) error {
+	if len(opts.Filename) >= maxPcapFilenameLen {
+		return errors.Errorf("pcap filename %q is too long", opts.Filename)
+	}
+	ifName := "any"
+	if opts.SwIfIndex != 0 {
+		var err error
+		if ifName, err = h.interfaceName(opts.SwIfIndex); err != nil {
+			return err
+		}
+	}
+	cmd := "pcap trace"
+	if opts.CaptureRx {
+		cmd += " rx"
+	}
+	if opts.CaptureTx {
+		cmd += " tx"
+	}
+	if opts.CaptureDrop {
+		cmd += " drop"
+	}
+	if opts.MaxPackets != 0 {
+		cmd += fmt.Sprintf(" max %d", opts.MaxPackets)
+	}
+	if opts.MaxBytesPerPacket != 0 {
+		cmd += fmt.Sprintf(" max-bytes-per-pkt %d", opts.MaxBytesPerPacket)
+	}
+	cmd += fmt.Sprintf(" intfc %s file %s", ifName, opts.Filename)
+
+	return h.runCli(cmd)
+}
+
+// StopPcap implements trace handler.
+func (h *TraceVppHandler) StopPcap() error {
+	return h.runCli("pcap trace off")
+}
+
+// interfaceName returns VPP internal name of the interface required
+// by the pcap CLI.
+func (h *TraceVppHandler) interfaceName(swIfIndex uint32) (string, error) {
+	req := &vpp_ifs.SwInterfaceDump{
+		SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+	}
+	reqCtx := h.callsChannel.SendMultiRequest(req)
+	for {
+		details := &vpp_ifs.SwInterfaceDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return "", errors.Errorf("failed to dump interface %d: %v", swIfIndex, err)
+		}
+		if uint32(details.SwIfIndex) == swIfIndex {
+			return details.InterfaceName, nil
+		}
+	}
+	return "", errors.Errorf("interface with index %d not found", swIfIndex)
+}
+
+func (h *TraceVppHandler) runCli(cmd string) error {
+	req := &vlib.CliInband{
+		Cmd: cmd,
+	}
+	reply := &vlib.CliInbandReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return errors.Errorf("VPP CLI command '%s' failed: %v", cmd, err)
+	}
+	return nil
+}
+
+// parseTraceData splits trace of a single packet as printed by VPP into
+// records of the traversed graph nodes.
+func parseTraceData(data string) (nodes []*trace.TracedPacket_Node) {
+	var node *trace.TracedPacket_Node
+	var nodeData []string
+	for _, line := range strings.Split(data, "\n") {
+		if match := traceNodeLine.FindStringSubmatch(strings.TrimRight(line, " \r")); match != nil {
+			if node != nil {
+				node.Data = strings.Join(nodeData, "\n")
+			}
+			node = &trace.TracedPacket_Node{
+				Timestamp: match[1],
+				Name:      match[2],
+			}
+			nodeData = nil
+			nodes = append(nodes, node)
+			continue
+		}
+		if node == nil || strings.TrimSpace(line) == "" {
+			// skip packet header and empty lines
+			continue
+		}
+		nodeData = append(nodeData, strings.TrimPrefix(line, "  "))
+	}
+	if node != nil {
+		node.Data = strings.Join(nodeData, "\n")
+	}
+	return nodes
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface"
+	vpp_tracedump "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/tracedump"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls"
+)
+
+func init() {
+	msgs := vpp.Messages(
+		vlib.AllMessages,
+		vpp_ifs.AllMessages,
+		vpp_tracedump.AllMessages,
+	)
+	vppcalls.AddTraceHandlerVersion(vpp2202.Version, msgs.AllMessages(), NewTraceVppHandler)
+}
+
+// TraceVppHandler is accessor for trace-related vppcalls methods.
+type TraceVppHandler struct {
+	callsChannel govppapi.Channel
+	log          logging.Logger
+}
+
+// NewTraceVppHandler creates new instance of trace vppcalls handler.
+func NewTraceVppHandler(ch govppapi.Channel, log logging.Logger) vppcalls.TraceVppAPI {
+	return &TraceVppHandler{
+		callsChannel: ch,
+		log:          log,
+	}
+}
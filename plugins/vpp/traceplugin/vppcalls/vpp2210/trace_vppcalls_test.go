@@ -0,0 +1,191 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	vpp_tracedump "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/tracedump"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vlib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/traceplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+)
+
+func TestGetNodeIndex(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vlib.GetNodeIndexReply{
+		NodeIndex: 42,
+	})
+
+	nodeIndex, err := traceHandler.GetNodeIndex("af-packet-input")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(nodeIndex).To(BeEquivalentTo(42))
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vlib.GetNodeIndex)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.NodeName).To(Equal("af-packet-input"))
+
+	ctx.MockVpp.MockReply(&vlib.GetNodeIndexReply{
+		Retval: -63,
+	})
+	_, err = traceHandler.GetNodeIndex("unknown-input")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestSetTraceFilter(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_tracedump.TraceSetFiltersReply{})
+	err := traceHandler.SetTraceFilter(3)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_tracedump.TraceSetFilters)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Flag).To(Equal(vpp_tracedump.TRACE_FF_INCLUDE_CLASSIFIER))
+	Expect(vppMsg.ClassifierTableIndex).To(BeEquivalentTo(3))
+
+	ctx.MockVpp.MockReply(&vpp_tracedump.TraceSetFiltersReply{})
+	err = traceHandler.SetTraceFilter(^uint32(0))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok = ctx.MockChannel.Msg.(*vpp_tracedump.TraceSetFilters)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Flag).To(Equal(vpp_tracedump.TRACE_FF_NONE))
+}
+
+func TestStartTrace(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_tracedump.TraceCapturePacketsReply{})
+	err := traceHandler.StartTrace(42, 10, true, false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_tracedump.TraceCapturePackets)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.NodeIndex).To(BeEquivalentTo(42))
+	Expect(vppMsg.MaxPackets).To(BeEquivalentTo(10))
+	Expect(vppMsg.UseFilter).To(BeTrue())
+	Expect(vppMsg.Verbose).To(BeFalse())
+}
+
+func TestDumpTrace(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_tracedump.TraceV2Details{
+			ThreadID: 1,
+			Position: 0,
+			TraceData: "Packet 1\n\n" +
+				"00:00:36:327186: af-packet-input\n" +
+				"  af_packet: hw_if_index 1 next-index 4\n" +
+				"    tpacketv2: len 98 snaplen 98\n" +
+				"00:00:36:327193: ethernet-input\n" +
+				"  IP4: 02:fe:3b:9a:11:2c -> 02:fe:59:12:ba:7e\n",
+		},
+		&memclnt.ControlPingReply{},
+	)
+
+	packets, err := traceHandler.DumpTrace()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(packets).To(HaveLen(1))
+	Expect(packets[0].ThreadId).To(BeEquivalentTo(1))
+	Expect(packets[0].Nodes).To(HaveLen(2))
+	Expect(packets[0].Nodes[0].Timestamp).To(Equal("00:00:36:327186"))
+	Expect(packets[0].Nodes[0].Name).To(Equal("af-packet-input"))
+	Expect(packets[0].Nodes[0].Data).To(Equal(
+		"af_packet: hw_if_index 1 next-index 4\n  tpacketv2: len 98 snaplen 98"))
+	Expect(packets[0].Nodes[1].Name).To(Equal("ethernet-input"))
+	Expect(packets[0].Nodes[1].Data).To(Equal("IP4: 02:fe:3b:9a:11:2c -> 02:fe:59:12:ba:7e"))
+}
+
+func TestStartStopPcap(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceDetails{
+		SwIfIndex:     2,
+		InterfaceName: "tap2",
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{})
+	err := traceHandler.StartPcap(&vppcalls.PcapOptions{
+		SwIfIndex:         2,
+		CaptureRx:         true,
+		MaxPackets:        100,
+		MaxBytesPerPacket: 128,
+		Filename:          "capture.pcap",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vlib.CliInband)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Cmd).To(Equal("pcap trace rx max 100 max-bytes-per-pkt 128 intfc tap2 file capture.pcap"))
+
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{})
+	err = traceHandler.StopPcap()
+	Expect(err).ShouldNot(HaveOccurred())
+	vppMsg, ok = ctx.MockChannel.Msg.(*vlib.CliInband)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Cmd).To(Equal("pcap trace off"))
+}
+
+func TestStartPcapAllInterfaces(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vlib.CliInbandReply{})
+	err := traceHandler.StartPcap(&vppcalls.PcapOptions{
+		CaptureTx:   true,
+		CaptureDrop: true,
+		Filename:    "capture.pcap",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	vppMsg, ok := ctx.MockChannel.Msg.(*vlib.CliInband)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Cmd).To(Equal("pcap trace tx drop intfc any file capture.pcap"))
+}
+
+func TestStartPcapUnknownInterface(t *testing.T) {
+	ctx, traceHandler := traceTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply()
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	err := traceHandler.StartPcap(&vppcalls.PcapOptions{
+		SwIfIndex: 5,
+		CaptureRx: true,
+		Filename:  "capture.pcap",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func traceTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.TraceVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	traceHandler := vpp2210.NewTraceVppHandler(ctx.MockChannel, log)
+	return ctx, traceHandler
+}
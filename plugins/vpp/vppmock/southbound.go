@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppmock
+
+import (
+	govppapi "go.fd.io/govpp/api"
+)
+
+// RepliesFunc builds replies for dump requests from the list of all messages
+// sent to the mocked VPP since the last reset.
+type RepliesFunc func(sent []govppapi.Message) []*HandleReplies
+
+// Southbound wraps TestCtx to be used as a fake southbound for descriptor
+// conformance tests (see kvscheduler/descriptortest). The mocked VPP state
+// is reconstructed from the sent requests by the given RepliesFunc.
+type Southbound struct {
+	ctx     *TestCtx
+	replies RepliesFunc
+}
+
+// NewSouthbound returns a new fake southbound backed by the given test context.
+func NewSouthbound(ctx *TestCtx, replies RepliesFunc) *Southbound {
+	sb := &Southbound{
+		ctx:     ctx,
+		replies: replies,
+	}
+	sb.Reset()
+	return sb
+}
+
+// Reset forgets all requests sent so far.
+func (sb *Southbound) Reset() {
+	sb.ctx.MockChannel.Msgs = nil
+	sb.ctx.MockChannel.Msg = nil
+	sb.Sync()
+}
+
+// Sync (re)installs reply handlers reflecting the current state.
+func (sb *Southbound) Sync() {
+	sb.ctx.MockVpp.MockClearReplyHandlers()
+	sb.ctx.MockReplies(sb.replies(sb.ctx.MockChannel.Msgs))
+}
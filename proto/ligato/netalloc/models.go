@@ -33,7 +33,12 @@ const (
 	AllocRefGWSuffix = "/GW"
 )
 
-var ModelIPAllocation models.KnownModel
+var (
+	ModelIPAllocation       models.KnownModel
+	ModelIPPool             models.KnownModel
+	ModelResourceAllocation models.KnownModel
+	ModelResourcePool       models.KnownModel
+)
 
 func init() {
 	// models.Register requires protoreflect capabilities, so we initialize them first
@@ -46,6 +51,26 @@ func init() {
 	}, models.WithNameTemplate(
 		"network/{{.NetworkName}}/interface/{{.InterfaceName}}",
 	))
+
+	ModelIPPool = models.Register(&IPPool{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "ip-pool",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelResourceAllocation = models.Register(&ResourceAllocation{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "resource",
+	}, models.WithNameTemplate(
+		"{{.Type}}/network/{{.NetworkName}}/interface/{{.InterfaceName}}",
+	))
+
+	ModelResourcePool = models.Register(&ResourcePool{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "resource-pool",
+	}, models.WithNameTemplate("{{.Name}}"))
 }
 
 const (
@@ -66,7 +91,42 @@ func NeighGwKey(network, iface string) string {
 }
 
 // IPAllocMetadata stores allocated IP address already parsed from string.
+// For allocations from a pool, Pool is the name of the pool and IfaceAddr
+// is the address assigned from it.
 type IPAllocMetadata struct {
 	IfaceAddr *net.IPNet
 	GwAddr    *net.IPNet
+	Pool      string
+}
+
+// IPPoolMetadata stores parsed IP pool configuration together with the state
+// of allocations made from the pool.
+type IPPoolMetadata struct {
+	Subnet   *net.IPNet
+	Gateway  *net.IPNet
+	Excluded []*net.IPNet
+
+	// Allocated maps allocation name to the address assigned from the pool.
+	Allocated map[string]net.IP
+}
+
+// ResourceAllocMetadata stores allocated MAC address, VLAN ID or VNI.
+// The resource is stored as a number (MAC address in the lower 48 bits).
+// For allocations from a pool, Pool is the name of the pool.
+type ResourceAllocMetadata struct {
+	Type  ResourceType
+	Value uint64
+	Pool  string
+}
+
+// ResourcePoolMetadata stores parsed resource pool configuration together
+// with the state of allocations made from the pool.
+type ResourcePoolMetadata struct {
+	Type     ResourceType
+	First    uint64
+	Last     uint64
+	Excluded []uint64
+
+	// Allocated maps allocation name to the resource assigned from the pool.
+	Allocated map[string]uint64
 }
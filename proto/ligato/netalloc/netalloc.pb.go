@@ -15,13 +15,18 @@
 // externally, for example by another control-plane agent, IPAM tool or by CNI
 // in containerized environments.
 //
-// But for now, only model for IP address allocations has been implemented.
+// Besides IP addresses, netalloc can also allocate MAC addresses, VLAN IDs
+// and VXLAN VNIs (see ResourceAllocation).
 // To allocate a new IP address, an instance of the proto message IPAllocation
 // should be submitted into the vpp-agent through one of the supported NB
 // transports (etcd, GRPC, ...) under the corresponding key. Network object which
 // references (to-be or already) allocated address will have a dependency on the
 // corresponding key-value instance of IPAllocation and will read and apply the
 // address only once it is available.
+//
+// Instead of entering the address statically, IPAllocation may also refer to
+// an IPPool, in which case netalloc itself assigns a free address from
+// the pool to the interface.
 
 package netalloc
 
@@ -167,6 +172,62 @@ func (IPAddressSource) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{1}
 }
 
+// ResourceType enumerates types of non-IP resources that netalloc can allocate.
+type ResourceType int32
+
+const (
+	ResourceType_UNDEFINED_RESOURCE ResourceType = 0
+	// MAC_ADDRESS is a 48-bit MAC address (e.g. 02:fe:00:00:00:01).
+	ResourceType_MAC_ADDRESS ResourceType = 1
+	// VLAN_ID is a VLAN tag (1-4094), e.g. used as ID of a sub-interface.
+	ResourceType_VLAN_ID ResourceType = 2
+	// VXLAN_VNI is a VXLAN Network Identifier (1-16777215).
+	ResourceType_VXLAN_VNI ResourceType = 3
+)
+
+// Enum value maps for ResourceType.
+var (
+	ResourceType_name = map[int32]string{
+		0: "UNDEFINED_RESOURCE",
+		1: "MAC_ADDRESS",
+		2: "VLAN_ID",
+		3: "VXLAN_VNI",
+	}
+	ResourceType_value = map[string]int32{
+		"UNDEFINED_RESOURCE": 0,
+		"MAC_ADDRESS":        1,
+		"VLAN_ID":            2,
+		"VXLAN_VNI":          3,
+	}
+)
+
+func (x ResourceType) Enum() *ResourceType {
+	p := new(ResourceType)
+	*p = x
+	return p
+}
+
+func (x ResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_netalloc_netalloc_proto_enumTypes[2].Descriptor()
+}
+
+func (ResourceType) Type() protoreflect.EnumType {
+	return &file_ligato_netalloc_netalloc_proto_enumTypes[2]
+}
+
+func (x ResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceType.Descriptor instead.
+func (ResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{2}
+}
+
 // IPAllocation represents a single allocated IP address.
 //
 // To reference allocated address, instead of entering specific IP address
@@ -205,11 +266,15 @@ type IPAllocation struct {
 	// Gw is the address of the default gateway assigned to the interface in
 	// the given network.
 	// If the address is specified without a mask, then either:
-	//
-	//	a) the mask of the <address> is used provided that GW IP falls into the
-	//	   same network IP range, or
-	//	b) the all-ones mask is used otherwise
+	//  a) the mask of the <address> is used provided that GW IP falls into the
+	//     same network IP range, or
+	//  b) the all-ones mask is used otherwise
 	Gw string `protobuf:"bytes,5,opt,name=gw,proto3" json:"gw,omitempty"`
+	// Pool is the name of the IPPool from which the address should be allocated
+	// dynamically. Pool and Address are mutually exclusive.
+	// If Gw is not set for an allocation from a pool, the gateway of the pool
+	// (if any) is used instead.
+	Pool string `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
 func (x *IPAllocation) Reset() {
@@ -272,20 +337,318 @@ func (x *IPAllocation) GetGw() string {
 	return ""
 }
 
+func (x *IPAllocation) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+// IPPool defines a subnet from which netalloc dynamically assigns IP addresses
+// to interfaces (see IPAllocation.pool).
+// A free address of the subnet (excluding the network address, IPv4 broadcast
+// address, the gateway and the excluded ranges) is assigned to each allocation.
+// Point-to-point (/31, /127) and single address (/32, /128) subnets have all
+// their addresses assignable. The search for a free address starts at the
+// position given by the hash of the allocation name, therefore allocations
+// are assigned the same addresses also after the agent restart.
+// Assigned addresses are reported through the metadata of the pool and
+// of the allocations.
+type IPPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is a unique identifier of the pool, referenced from IP allocations.
+	// The pool name is not allowed to contain forward slashes.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Subnet is the IP network from which addresses are allocated
+	// (e.g. 10.10.1.0/24).
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// Gateway is the address of the default gateway for interfaces with
+	// addresses allocated from the pool. It must belong to the subnet and it is
+	// never allocated to an interface.
+	Gateway string `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// Excluded is a list of addresses or sub-networks (in CIDR notation)
+	// of the subnet which should never be allocated.
+	Excluded []string `protobuf:"bytes,4,rep,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (x *IPPool) Reset() {
+	*x = IPPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_netalloc_netalloc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPPool) ProtoMessage() {}
+
+func (x *IPPool) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_netalloc_netalloc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPPool.ProtoReflect.Descriptor instead.
+func (*IPPool) Descriptor() ([]byte, []int) {
+	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IPPool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IPPool) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *IPPool) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *IPPool) GetExcluded() []string {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+// ResourceAllocation represents a single allocated MAC address, VLAN ID
+// or VXLAN VNI.
+//
+// The resource is either given statically (value) or it is assigned by netalloc
+// from a ResourcePool (pool). Netalloc makes sure that the same resource is never
+// allocated twice across all allocations of the same type.
+//
+// Allocated resource is referenced from the configuration of an interface
+// using the same template as is used for IP addresses, i.e.:
+//
+//	"alloc:<network_name>/<interface_name>" or "alloc:<network_name>"
+//
+// The type of the resource is implied by the field where the reference is used.
+type ResourceAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the allocated resource.
+	Type ResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=ligato.netalloc.ResourceType" json:"type,omitempty"`
+	// NetworkName is some label assigned to the network where the resource
+	// was allocated to the given interface.
+	// The network name is not allowed to contain forward slashes.
+	NetworkName string `protobuf:"bytes,2,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`
+	// InterfaceName is the logical VPP or Linux interface name for which the
+	// resource is allocated.
+	InterfaceName string `protobuf:"bytes,3,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	// Value is a statically allocated resource - MAC address or decimal
+	// VLAN ID / VNI. Value and Pool are mutually exclusive.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Pool is the name of the ResourcePool (of the same type) from which
+	// the resource should be allocated dynamically.
+	Pool string `protobuf:"bytes,5,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (x *ResourceAllocation) Reset() {
+	*x = ResourceAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_netalloc_netalloc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceAllocation) ProtoMessage() {}
+
+func (x *ResourceAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_netalloc_netalloc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceAllocation.ProtoReflect.Descriptor instead.
+func (*ResourceAllocation) Descriptor() ([]byte, []int) {
+	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResourceAllocation) GetType() ResourceType {
+	if x != nil {
+		return x.Type
+	}
+	return ResourceType_UNDEFINED_RESOURCE
+}
+
+func (x *ResourceAllocation) GetNetworkName() string {
+	if x != nil {
+		return x.NetworkName
+	}
+	return ""
+}
+
+func (x *ResourceAllocation) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *ResourceAllocation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ResourceAllocation) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+// ResourcePool defines a range of MAC addresses, VLAN IDs or VXLAN VNIs
+// from which netalloc dynamically assigns resources (see ResourceAllocation.pool).
+// The search for a free resource of the range starts at the position given
+// by the hash of the allocation name, therefore allocations are assigned
+// the same resources also after the agent restart.
+// Resources configured statically for VPP interfaces (phys_address, sub_id
+// and vni) are never assigned from pools.
+// MAC address pools may contain only unicast addresses and all addresses
+// of the range must share the first octet.
+type ResourcePool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is a unique identifier of the pool, referenced from allocations.
+	// The pool name is not allowed to contain forward slashes.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of resources in the pool.
+	Type ResourceType `protobuf:"varint,2,opt,name=type,proto3,enum=ligato.netalloc.ResourceType" json:"type,omitempty"`
+	// First is the first resource of the range (inclusive), i.e. MAC address
+	// or decimal VLAN ID / VNI.
+	First string `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	// Last is the last resource of the range (inclusive).
+	Last string `protobuf:"bytes,4,opt,name=last,proto3" json:"last,omitempty"`
+	// Excluded is a list of resources from the range which should never be
+	// allocated.
+	Excluded []string `protobuf:"bytes,5,rep,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (x *ResourcePool) Reset() {
+	*x = ResourcePool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_netalloc_netalloc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourcePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourcePool) ProtoMessage() {}
+
+func (x *ResourcePool) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_netalloc_netalloc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourcePool.ProtoReflect.Descriptor instead.
+func (*ResourcePool) Descriptor() ([]byte, []int) {
+	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourcePool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourcePool) GetType() ResourceType {
+	if x != nil {
+		return x.Type
+	}
+	return ResourceType_UNDEFINED_RESOURCE
+}
+
+func (x *ResourcePool) GetFirst() string {
+	if x != nil {
+		return x.First
+	}
+	return ""
+}
+
+func (x *ResourcePool) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+func (x *ResourcePool) GetExcluded() []string {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
 // ConfigData wraps all configuration items exported by netalloc.
-// TBD: MACs, VXLAN VNIs, memif IDs, etc.
+// TBD: memif IDs, etc.
 type ConfigData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IpAddresses []*IPAllocation `protobuf:"bytes,10,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	IpAddresses   []*IPAllocation       `protobuf:"bytes,10,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	IpPools       []*IPPool             `protobuf:"bytes,11,rep,name=ip_pools,json=ipPools,proto3" json:"ip_pools,omitempty"`
+	Resources     []*ResourceAllocation `protobuf:"bytes,12,rep,name=resources,proto3" json:"resources,omitempty"`
+	ResourcePools []*ResourcePool       `protobuf:"bytes,13,rep,name=resource_pools,json=resourcePools,proto3" json:"resource_pools,omitempty"`
 }
 
 func (x *ConfigData) Reset() {
 	*x = ConfigData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_netalloc_netalloc_proto_msgTypes[1]
+		mi := &file_ligato_netalloc_netalloc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +661,7 @@ func (x *ConfigData) String() string {
 func (*ConfigData) ProtoMessage() {}
 
 func (x *ConfigData) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_netalloc_netalloc_proto_msgTypes[1]
+	mi := &file_ligato_netalloc_netalloc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +674,7 @@ func (x *ConfigData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigData.ProtoReflect.Descriptor instead.
 func (*ConfigData) Descriptor() ([]byte, []int) {
-	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{1}
+	return file_ligato_netalloc_netalloc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ConfigData) GetIpAddresses() []*IPAllocation {
@@ -321,6 +684,27 @@ func (x *ConfigData) GetIpAddresses() []*IPAllocation {
 	return nil
 }
 
+func (x *ConfigData) GetIpPools() []*IPPool {
+	if x != nil {
+		return x.IpPools
+	}
+	return nil
+}
+
+func (x *ConfigData) GetResources() []*ResourceAllocation {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ConfigData) GetResourcePools() []*ResourcePool {
+	if x != nil {
+		return x.ResourcePools
+	}
+	return nil
+}
+
 var File_ligato_netalloc_netalloc_proto protoreflect.FileDescriptor
 
 var file_ligato_netalloc_netalloc_proto_rawDesc = []byte{
@@ -328,7 +712,7 @@ var file_ligato_netalloc_netalloc_proto_rawDesc = []byte{
 	0x63, 0x2f, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f,
 	0x63, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x0c,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x0c,
 	0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12,
@@ -337,29 +721,77 @@ var file_ligato_netalloc_netalloc_proto_rawDesc = []byte{
 	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x07, 0x52, 0x07,
 	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x0a, 0x02, 0x67, 0x77, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x07, 0x52, 0x02, 0x67, 0x77, 0x22, 0x4e,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c,
-	0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61,
-	0x6c, 0x6c, 0x6f, 0x63, 0x2e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2a, 0x69,
-	0x0a, 0x0d, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x12,
-	0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x52,
-	0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x4d, 0x41, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x4e,
-	0x45, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x41,
-	0x44, 0x44, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x10, 0x04, 0x2a, 0x5f, 0x0a, 0x0f, 0x49, 0x50, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x10,
-	0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0d,
-	0x0a, 0x09, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f, 0x52, 0x45, 0x46, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08,
-	0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x07, 0x52, 0x02, 0x67, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f,
+	0x6f, 0x6c, 0x22, 0x78, 0x0a, 0x06, 0x49, 0x50, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12,
+	0x1f, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0xbb, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x2e,
+	0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x69, 0x70, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x2e, 0x49, 0x50,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x07, 0x69, 0x70, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x41, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x2a, 0x69, 0x0a, 0x0d, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41,
+	0x44, 0x44, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x44,
+	0x44, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x10,
+	0x04, 0x2a, 0x5f, 0x0a, 0x0f, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45,
+	0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x41, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x44,
+	0x48, 0x43, 0x50, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f, 0x52,
+	0x45, 0x46, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x04, 0x2a, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41,
+	0x43, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56,
+	0x4c, 0x41, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x58, 0x4c, 0x41,
+	0x4e, 0x5f, 0x56, 0x4e, 0x49, 0x10, 0x03, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x6e, 0x65, 0x74, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -374,21 +806,30 @@ func file_ligato_netalloc_netalloc_proto_rawDescGZIP() []byte {
 	return file_ligato_netalloc_netalloc_proto_rawDescData
 }
 
-var file_ligato_netalloc_netalloc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ligato_netalloc_netalloc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ligato_netalloc_netalloc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_netalloc_netalloc_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_ligato_netalloc_netalloc_proto_goTypes = []interface{}{
-	(IPAddressForm)(0),   // 0: ligato.netalloc.IPAddressForm
-	(IPAddressSource)(0), // 1: ligato.netalloc.IPAddressSource
-	(*IPAllocation)(nil), // 2: ligato.netalloc.IPAllocation
-	(*ConfigData)(nil),   // 3: ligato.netalloc.ConfigData
+	(IPAddressForm)(0),         // 0: ligato.netalloc.IPAddressForm
+	(IPAddressSource)(0),       // 1: ligato.netalloc.IPAddressSource
+	(ResourceType)(0),          // 2: ligato.netalloc.ResourceType
+	(*IPAllocation)(nil),       // 3: ligato.netalloc.IPAllocation
+	(*IPPool)(nil),             // 4: ligato.netalloc.IPPool
+	(*ResourceAllocation)(nil), // 5: ligato.netalloc.ResourceAllocation
+	(*ResourcePool)(nil),       // 6: ligato.netalloc.ResourcePool
+	(*ConfigData)(nil),         // 7: ligato.netalloc.ConfigData
 }
 var file_ligato_netalloc_netalloc_proto_depIdxs = []int32{
-	2, // 0: ligato.netalloc.ConfigData.ip_addresses:type_name -> ligato.netalloc.IPAllocation
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: ligato.netalloc.ResourceAllocation.type:type_name -> ligato.netalloc.ResourceType
+	2, // 1: ligato.netalloc.ResourcePool.type:type_name -> ligato.netalloc.ResourceType
+	3, // 2: ligato.netalloc.ConfigData.ip_addresses:type_name -> ligato.netalloc.IPAllocation
+	4, // 3: ligato.netalloc.ConfigData.ip_pools:type_name -> ligato.netalloc.IPPool
+	5, // 4: ligato.netalloc.ConfigData.resources:type_name -> ligato.netalloc.ResourceAllocation
+	6, // 5: ligato.netalloc.ConfigData.resource_pools:type_name -> ligato.netalloc.ResourcePool
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_ligato_netalloc_netalloc_proto_init() }
@@ -410,6 +851,42 @@ func file_ligato_netalloc_netalloc_proto_init() {
 			}
 		}
 		file_ligato_netalloc_netalloc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_netalloc_netalloc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_netalloc_netalloc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourcePool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_netalloc_netalloc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigData); i {
 			case 0:
 				return &v.state
@@ -427,8 +904,8 @@ func file_ligato_netalloc_netalloc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_netalloc_netalloc_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -137,6 +137,148 @@ func (x *ACL) GetInterfaces() *ACL_Interfaces {
 	return nil
 }
 
+// ACLStats contains hit counters of ACL rules. VPP maintains the counters
+// only when ACL stats are enabled in the ACL plugin config (enable-stats).
+// Note that ACL lookups performed by ABF policies do not update the counters.
+type ACLStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AclName string `protobuf:"bytes,1,opt,name=acl_name,json=aclName,proto3" json:"acl_name,omitempty"`
+	// ACL index assigned by VPP.
+	AclIndex uint32                `protobuf:"varint,2,opt,name=acl_index,json=aclIndex,proto3" json:"acl_index,omitempty"`
+	Rules    []*ACLStats_RuleStats `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ACLStats) Reset() {
+	*x = ACLStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ACLStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLStats) ProtoMessage() {}
+
+func (x *ACLStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLStats.ProtoReflect.Descriptor instead.
+func (*ACLStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_acl_acl_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ACLStats) GetAclName() string {
+	if x != nil {
+		return x.AclName
+	}
+	return ""
+}
+
+func (x *ACLStats) GetAclIndex() uint32 {
+	if x != nil {
+		return x.AclIndex
+	}
+	return 0
+}
+
+func (x *ACLStats) GetRules() []*ACLStats_RuleStats {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ACLInterfaceSessions contains the number of reflexive ACL sessions
+// tracked on an interface (summed over all VPP workers).
+type ACLInterfaceSessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Number of currently active sessions.
+	Sessions uint64 `protobuf:"varint,2,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// Total number of sessions added and deleted.
+	Added   uint64 `protobuf:"varint,3,opt,name=added,proto3" json:"added,omitempty"`
+	Deleted uint64 `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *ACLInterfaceSessions) Reset() {
+	*x = ACLInterfaceSessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ACLInterfaceSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLInterfaceSessions) ProtoMessage() {}
+
+func (x *ACLInterfaceSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLInterfaceSessions.ProtoReflect.Descriptor instead.
+func (*ACLInterfaceSessions) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_acl_acl_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ACLInterfaceSessions) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *ACLInterfaceSessions) GetSessions() uint64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *ACLInterfaceSessions) GetAdded() uint64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ACLInterfaceSessions) GetDeleted() uint64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
 // List of access list entries (Rules). Each Access Control Rule has
 // a list of match criteria and a list of actions.
 // Access List entry that can define:
@@ -157,7 +299,7 @@ type ACL_Rule struct {
 func (x *ACL_Rule) Reset() {
 	*x = ACL_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[1]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -170,7 +312,7 @@ func (x *ACL_Rule) String() string {
 func (*ACL_Rule) ProtoMessage() {}
 
 func (x *ACL_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[1]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +362,7 @@ type ACL_Interfaces struct {
 func (x *ACL_Interfaces) Reset() {
 	*x = ACL_Interfaces{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[2]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +375,7 @@ func (x *ACL_Interfaces) String() string {
 func (*ACL_Interfaces) ProtoMessage() {}
 
 func (x *ACL_Interfaces) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[2]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +419,7 @@ type ACL_Rule_IpRule struct {
 func (x *ACL_Rule_IpRule) Reset() {
 	*x = ACL_Rule_IpRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[3]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -290,7 +432,7 @@ func (x *ACL_Rule_IpRule) String() string {
 func (*ACL_Rule_IpRule) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[3]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +495,7 @@ type ACL_Rule_MacIpRule struct {
 func (x *ACL_Rule_MacIpRule) Reset() {
 	*x = ACL_Rule_MacIpRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[4]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -366,7 +508,7 @@ func (x *ACL_Rule_MacIpRule) String() string {
 func (*ACL_Rule_MacIpRule) ProtoMessage() {}
 
 func (x *ACL_Rule_MacIpRule) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[4]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +572,7 @@ type ACL_Rule_IpRule_Ip struct {
 func (x *ACL_Rule_IpRule_Ip) Reset() {
 	*x = ACL_Rule_IpRule_Ip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[5]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +585,7 @@ func (x *ACL_Rule_IpRule_Ip) String() string {
 func (*ACL_Rule_IpRule_Ip) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule_Ip) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[5]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +637,7 @@ type ACL_Rule_IpRule_Icmp struct {
 func (x *ACL_Rule_IpRule_Icmp) Reset() {
 	*x = ACL_Rule_IpRule_Icmp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[6]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -508,7 +650,7 @@ func (x *ACL_Rule_IpRule_Icmp) String() string {
 func (*ACL_Rule_IpRule_Icmp) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule_Icmp) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[6]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +703,7 @@ type ACL_Rule_IpRule_PortRange struct {
 func (x *ACL_Rule_IpRule_PortRange) Reset() {
 	*x = ACL_Rule_IpRule_PortRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[7]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -574,7 +716,7 @@ func (x *ACL_Rule_IpRule_PortRange) String() string {
 func (*ACL_Rule_IpRule_PortRange) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule_PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[7]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +766,7 @@ type ACL_Rule_IpRule_Tcp struct {
 func (x *ACL_Rule_IpRule_Tcp) Reset() {
 	*x = ACL_Rule_IpRule_Tcp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[8]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -637,7 +779,7 @@ func (x *ACL_Rule_IpRule_Tcp) String() string {
 func (*ACL_Rule_IpRule_Tcp) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule_Tcp) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[8]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +835,7 @@ type ACL_Rule_IpRule_Udp struct {
 func (x *ACL_Rule_IpRule_Udp) Reset() {
 	*x = ACL_Rule_IpRule_Udp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[9]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -706,7 +848,7 @@ func (x *ACL_Rule_IpRule_Udp) String() string {
 func (*ACL_Rule_IpRule_Udp) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule_Udp) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[9]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +890,7 @@ type ACL_Rule_IpRule_Icmp_Range struct {
 func (x *ACL_Rule_IpRule_Icmp_Range) Reset() {
 	*x = ACL_Rule_IpRule_Icmp_Range{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[10]
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -761,7 +903,7 @@ func (x *ACL_Rule_IpRule_Icmp_Range) String() string {
 func (*ACL_Rule_IpRule_Icmp_Range) ProtoMessage() {}
 
 func (x *ACL_Rule_IpRule_Icmp_Range) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[10]
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,6 +933,70 @@ func (x *ACL_Rule_IpRule_Icmp_Range) GetLast() uint32 {
 	return 0
 }
 
+type ACLStats_RuleStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rule index equals to the position of the rule in ACL.
+	RuleIndex uint32 `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"`
+	Packets   uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes     uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *ACLStats_RuleStats) Reset() {
+	*x = ACLStats_RuleStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_acl_acl_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ACLStats_RuleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLStats_RuleStats) ProtoMessage() {}
+
+func (x *ACLStats_RuleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_acl_acl_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLStats_RuleStats.ProtoReflect.Descriptor instead.
+func (*ACLStats_RuleStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_acl_acl_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ACLStats_RuleStats) GetRuleIndex() uint32 {
+	if x != nil {
+		return x.RuleIndex
+	}
+	return 0
+}
+
+func (x *ACLStats_RuleStats) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *ACLStats_RuleStats) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
 var File_ligato_vpp_acl_acl_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_acl_acl_proto_rawDesc = []byte{
@@ -915,11 +1121,33 @@ var file_ligato_vpp_acl_acl_proto_rawDesc = []byte{
 	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c, 0x3b, 0x76, 0x70, 0x70,
-	0x5f, 0x61, 0x63, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x41,
+	0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x63, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x38, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e,
+	0x41, 0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x5a, 0x0a, 0x09, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x41, 0x43, 0x4c, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x61,
+	0x63, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -935,42 +1163,46 @@ func file_ligato_vpp_acl_acl_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_acl_acl_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ligato_vpp_acl_acl_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ligato_vpp_acl_acl_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_ligato_vpp_acl_acl_proto_goTypes = []interface{}{
 	(ACL_Rule_Action)(0),               // 0: ligato.vpp.acl.ACL.Rule.Action
 	(*ACL)(nil),                        // 1: ligato.vpp.acl.ACL
-	(*ACL_Rule)(nil),                   // 2: ligato.vpp.acl.ACL.Rule
-	(*ACL_Interfaces)(nil),             // 3: ligato.vpp.acl.ACL.Interfaces
-	(*ACL_Rule_IpRule)(nil),            // 4: ligato.vpp.acl.ACL.Rule.IpRule
-	(*ACL_Rule_MacIpRule)(nil),         // 5: ligato.vpp.acl.ACL.Rule.MacIpRule
-	(*ACL_Rule_IpRule_Ip)(nil),         // 6: ligato.vpp.acl.ACL.Rule.IpRule.Ip
-	(*ACL_Rule_IpRule_Icmp)(nil),       // 7: ligato.vpp.acl.ACL.Rule.IpRule.Icmp
-	(*ACL_Rule_IpRule_PortRange)(nil),  // 8: ligato.vpp.acl.ACL.Rule.IpRule.PortRange
-	(*ACL_Rule_IpRule_Tcp)(nil),        // 9: ligato.vpp.acl.ACL.Rule.IpRule.Tcp
-	(*ACL_Rule_IpRule_Udp)(nil),        // 10: ligato.vpp.acl.ACL.Rule.IpRule.Udp
-	(*ACL_Rule_IpRule_Icmp_Range)(nil), // 11: ligato.vpp.acl.ACL.Rule.IpRule.Icmp.Range
+	(*ACLStats)(nil),                   // 2: ligato.vpp.acl.ACLStats
+	(*ACLInterfaceSessions)(nil),       // 3: ligato.vpp.acl.ACLInterfaceSessions
+	(*ACL_Rule)(nil),                   // 4: ligato.vpp.acl.ACL.Rule
+	(*ACL_Interfaces)(nil),             // 5: ligato.vpp.acl.ACL.Interfaces
+	(*ACL_Rule_IpRule)(nil),            // 6: ligato.vpp.acl.ACL.Rule.IpRule
+	(*ACL_Rule_MacIpRule)(nil),         // 7: ligato.vpp.acl.ACL.Rule.MacIpRule
+	(*ACL_Rule_IpRule_Ip)(nil),         // 8: ligato.vpp.acl.ACL.Rule.IpRule.Ip
+	(*ACL_Rule_IpRule_Icmp)(nil),       // 9: ligato.vpp.acl.ACL.Rule.IpRule.Icmp
+	(*ACL_Rule_IpRule_PortRange)(nil),  // 10: ligato.vpp.acl.ACL.Rule.IpRule.PortRange
+	(*ACL_Rule_IpRule_Tcp)(nil),        // 11: ligato.vpp.acl.ACL.Rule.IpRule.Tcp
+	(*ACL_Rule_IpRule_Udp)(nil),        // 12: ligato.vpp.acl.ACL.Rule.IpRule.Udp
+	(*ACL_Rule_IpRule_Icmp_Range)(nil), // 13: ligato.vpp.acl.ACL.Rule.IpRule.Icmp.Range
+	(*ACLStats_RuleStats)(nil),         // 14: ligato.vpp.acl.ACLStats.RuleStats
 }
 var file_ligato_vpp_acl_acl_proto_depIdxs = []int32{
-	2,  // 0: ligato.vpp.acl.ACL.rules:type_name -> ligato.vpp.acl.ACL.Rule
-	3,  // 1: ligato.vpp.acl.ACL.interfaces:type_name -> ligato.vpp.acl.ACL.Interfaces
-	0,  // 2: ligato.vpp.acl.ACL.Rule.action:type_name -> ligato.vpp.acl.ACL.Rule.Action
-	4,  // 3: ligato.vpp.acl.ACL.Rule.ip_rule:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
-	5,  // 4: ligato.vpp.acl.ACL.Rule.macip_rule:type_name -> ligato.vpp.acl.ACL.Rule.MacIpRule
-	6,  // 5: ligato.vpp.acl.ACL.Rule.IpRule.ip:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Ip
-	7,  // 6: ligato.vpp.acl.ACL.Rule.IpRule.icmp:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Icmp
-	9,  // 7: ligato.vpp.acl.ACL.Rule.IpRule.tcp:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Tcp
-	10, // 8: ligato.vpp.acl.ACL.Rule.IpRule.udp:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Udp
-	11, // 9: ligato.vpp.acl.ACL.Rule.IpRule.Icmp.icmp_code_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Icmp.Range
-	11, // 10: ligato.vpp.acl.ACL.Rule.IpRule.Icmp.icmp_type_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Icmp.Range
-	8,  // 11: ligato.vpp.acl.ACL.Rule.IpRule.Tcp.destination_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
-	8,  // 12: ligato.vpp.acl.ACL.Rule.IpRule.Tcp.source_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
-	8,  // 13: ligato.vpp.acl.ACL.Rule.IpRule.Udp.destination_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
-	8,  // 14: ligato.vpp.acl.ACL.Rule.IpRule.Udp.source_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	4,  // 0: ligato.vpp.acl.ACL.rules:type_name -> ligato.vpp.acl.ACL.Rule
+	5,  // 1: ligato.vpp.acl.ACL.interfaces:type_name -> ligato.vpp.acl.ACL.Interfaces
+	14, // 2: ligato.vpp.acl.ACLStats.rules:type_name -> ligato.vpp.acl.ACLStats.RuleStats
+	0,  // 3: ligato.vpp.acl.ACL.Rule.action:type_name -> ligato.vpp.acl.ACL.Rule.Action
+	6,  // 4: ligato.vpp.acl.ACL.Rule.ip_rule:type_name -> ligato.vpp.acl.ACL.Rule.IpRule
+	7,  // 5: ligato.vpp.acl.ACL.Rule.macip_rule:type_name -> ligato.vpp.acl.ACL.Rule.MacIpRule
+	8,  // 6: ligato.vpp.acl.ACL.Rule.IpRule.ip:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Ip
+	9,  // 7: ligato.vpp.acl.ACL.Rule.IpRule.icmp:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Icmp
+	11, // 8: ligato.vpp.acl.ACL.Rule.IpRule.tcp:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Tcp
+	12, // 9: ligato.vpp.acl.ACL.Rule.IpRule.udp:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Udp
+	13, // 10: ligato.vpp.acl.ACL.Rule.IpRule.Icmp.icmp_code_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Icmp.Range
+	13, // 11: ligato.vpp.acl.ACL.Rule.IpRule.Icmp.icmp_type_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.Icmp.Range
+	10, // 12: ligato.vpp.acl.ACL.Rule.IpRule.Tcp.destination_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
+	10, // 13: ligato.vpp.acl.ACL.Rule.IpRule.Tcp.source_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
+	10, // 14: ligato.vpp.acl.ACL.Rule.IpRule.Udp.destination_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
+	10, // 15: ligato.vpp.acl.ACL.Rule.IpRule.Udp.source_port_range:type_name -> ligato.vpp.acl.ACL.Rule.IpRule.PortRange
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_acl_acl_proto_init() }
@@ -992,7 +1224,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule); i {
+			switch v := v.(*ACLStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1004,7 +1236,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Interfaces); i {
+			switch v := v.(*ACLInterfaceSessions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1016,7 +1248,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_IpRule); i {
+			switch v := v.(*ACL_Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1028,7 +1260,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_MacIpRule); i {
+			switch v := v.(*ACL_Interfaces); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1040,7 +1272,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_IpRule_Ip); i {
+			switch v := v.(*ACL_Rule_IpRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1052,7 +1284,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_IpRule_Icmp); i {
+			switch v := v.(*ACL_Rule_MacIpRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1064,7 +1296,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_IpRule_PortRange); i {
+			switch v := v.(*ACL_Rule_IpRule_Ip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1076,7 +1308,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_IpRule_Tcp); i {
+			switch v := v.(*ACL_Rule_IpRule_Icmp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1088,7 +1320,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL_Rule_IpRule_Udp); i {
+			switch v := v.(*ACL_Rule_IpRule_PortRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1100,6 +1332,30 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			}
 		}
 		file_ligato_vpp_acl_acl_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACL_Rule_IpRule_Tcp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_acl_acl_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACL_Rule_IpRule_Udp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_acl_acl_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ACL_Rule_IpRule_Icmp_Range); i {
 			case 0:
 				return &v.state
@@ -1111,6 +1367,18 @@ func file_ligato_vpp_acl_acl_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_acl_acl_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLStats_RuleStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1118,7 +1386,7 @@ func file_ligato_vpp_acl_acl_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_acl_acl_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/bfd/bfd.proto
+
+package vpp_bfd
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BfdAuthKey_AuthType int32
+
+const (
+	BfdAuthKey_KEYED_SHA1            BfdAuthKey_AuthType = 0
+	BfdAuthKey_METICULOUS_KEYED_SHA1 BfdAuthKey_AuthType = 1
+)
+
+// Enum value maps for BfdAuthKey_AuthType.
+var (
+	BfdAuthKey_AuthType_name = map[int32]string{
+		0: "KEYED_SHA1",
+		1: "METICULOUS_KEYED_SHA1",
+	}
+	BfdAuthKey_AuthType_value = map[string]int32{
+		"KEYED_SHA1":            0,
+		"METICULOUS_KEYED_SHA1": 1,
+	}
+)
+
+func (x BfdAuthKey_AuthType) Enum() *BfdAuthKey_AuthType {
+	p := new(BfdAuthKey_AuthType)
+	*p = x
+	return p
+}
+
+func (x BfdAuthKey_AuthType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BfdAuthKey_AuthType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_bfd_bfd_proto_enumTypes[0].Descriptor()
+}
+
+func (BfdAuthKey_AuthType) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_bfd_bfd_proto_enumTypes[0]
+}
+
+func (x BfdAuthKey_AuthType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BfdAuthKey_AuthType.Descriptor instead.
+func (BfdAuthKey_AuthType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type BfdSessionState_State int32
+
+const (
+	BfdSessionState_UNKNOWN    BfdSessionState_State = 0
+	BfdSessionState_ADMIN_DOWN BfdSessionState_State = 1
+	BfdSessionState_DOWN       BfdSessionState_State = 2
+	BfdSessionState_INIT       BfdSessionState_State = 3
+	BfdSessionState_UP         BfdSessionState_State = 4
+)
+
+// Enum value maps for BfdSessionState_State.
+var (
+	BfdSessionState_State_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ADMIN_DOWN",
+		2: "DOWN",
+		3: "INIT",
+		4: "UP",
+	}
+	BfdSessionState_State_value = map[string]int32{
+		"UNKNOWN":    0,
+		"ADMIN_DOWN": 1,
+		"DOWN":       2,
+		"INIT":       3,
+		"UP":         4,
+	}
+)
+
+func (x BfdSessionState_State) Enum() *BfdSessionState_State {
+	p := new(BfdSessionState_State)
+	*p = x
+	return p
+}
+
+func (x BfdSessionState_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BfdSessionState_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_bfd_bfd_proto_enumTypes[1].Descriptor()
+}
+
+func (BfdSessionState_State) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_bfd_bfd_proto_enumTypes[1]
+}
+
+func (x BfdSessionState_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BfdSessionState_State.Descriptor instead.
+func (BfdSessionState_State) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// BfdSession defines Bidirectional Forwarding Detection session over UDP
+// (RFC 5880, RFC 5881) with the given peer.
+type BfdSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface the session is bound to.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Local IP address (has to be assigned to the interface).
+	LocalIp string `protobuf:"bytes,2,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	// IP address of the BFD peer (has to be of the same IP version as local IP).
+	PeerIp string `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	// Desired minimum TX interval in microseconds.
+	DesiredMinTxInterval uint32 `protobuf:"varint,4,opt,name=desired_min_tx_interval,json=desiredMinTxInterval,proto3" json:"desired_min_tx_interval,omitempty"`
+	// Required minimum RX interval in microseconds.
+	RequiredMinRxInterval uint32 `protobuf:"varint,5,opt,name=required_min_rx_interval,json=requiredMinRxInterval,proto3" json:"required_min_rx_interval,omitempty"`
+	// Detect multiplier (non-zero).
+	DetectMultiplier uint32 `protobuf:"varint,6,opt,name=detect_multiplier,json=detectMultiplier,proto3" json:"detect_multiplier,omitempty"`
+	// If defined, the session is authenticated.
+	Authentication *BfdSession_Authentication `protobuf:"bytes,7,opt,name=authentication,proto3" json:"authentication,omitempty"`
+}
+
+func (x *BfdSession) Reset() {
+	*x = BfdSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BfdSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BfdSession) ProtoMessage() {}
+
+func (x *BfdSession) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BfdSession.ProtoReflect.Descriptor instead.
+func (*BfdSession) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BfdSession) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *BfdSession) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *BfdSession) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *BfdSession) GetDesiredMinTxInterval() uint32 {
+	if x != nil {
+		return x.DesiredMinTxInterval
+	}
+	return 0
+}
+
+func (x *BfdSession) GetRequiredMinRxInterval() uint32 {
+	if x != nil {
+		return x.RequiredMinRxInterval
+	}
+	return 0
+}
+
+func (x *BfdSession) GetDetectMultiplier() uint32 {
+	if x != nil {
+		return x.DetectMultiplier
+	}
+	return 0
+}
+
+func (x *BfdSession) GetAuthentication() *BfdSession_Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+// BfdAuthKey defines authentication key which can be used by BFD sessions.
+type BfdAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique key ID referenced from BFD sessions.
+	Id   uint32              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type BfdAuthKey_AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=ligato.vpp.bfd.BfdAuthKey_AuthType" json:"type,omitempty"`
+	// Secret (at most 20 bytes).
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *BfdAuthKey) Reset() {
+	*x = BfdAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BfdAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BfdAuthKey) ProtoMessage() {}
+
+func (x *BfdAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BfdAuthKey.ProtoReflect.Descriptor instead.
+func (*BfdAuthKey) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BfdAuthKey) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BfdAuthKey) GetType() BfdAuthKey_AuthType {
+	if x != nil {
+		return x.Type
+	}
+	return BfdAuthKey_KEYED_SHA1
+}
+
+func (x *BfdAuthKey) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// BfdSessionState is the operational state of a BFD session.
+type BfdSessionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string                `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	LocalIp   string                `protobuf:"bytes,2,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	PeerIp    string                `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	State     BfdSessionState_State `protobuf:"varint,4,opt,name=state,proto3,enum=ligato.vpp.bfd.BfdSessionState_State" json:"state,omitempty"`
+	// Unix timestamp of the last state change.
+	LastChange int64 `protobuf:"varint,5,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+}
+
+func (x *BfdSessionState) Reset() {
+	*x = BfdSessionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BfdSessionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BfdSessionState) ProtoMessage() {}
+
+func (x *BfdSessionState) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BfdSessionState.ProtoReflect.Descriptor instead.
+func (*BfdSessionState) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BfdSessionState) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *BfdSessionState) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *BfdSessionState) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *BfdSessionState) GetState() BfdSessionState_State {
+	if x != nil {
+		return x.State
+	}
+	return BfdSessionState_UNKNOWN
+}
+
+func (x *BfdSessionState) GetLastChange() int64 {
+	if x != nil {
+		return x.LastChange
+	}
+	return 0
+}
+
+// BfdSessionNotification is sent when the state of a BFD session changes.
+type BfdSessionNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *BfdSessionState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *BfdSessionNotification) Reset() {
+	*x = BfdSessionNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BfdSessionNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BfdSessionNotification) ProtoMessage() {}
+
+func (x *BfdSessionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BfdSessionNotification.ProtoReflect.Descriptor instead.
+func (*BfdSessionNotification) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BfdSessionNotification) GetState() *BfdSessionState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// Authentication of the BFD control packets.
+type BfdSession_Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the authentication key (see BfdAuthKey) used by the session.
+	KeyId uint32 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Key ID advertised in the BFD control packets (BFD key ID).
+	AdvertisedKeyId uint32 `protobuf:"varint,2,opt,name=advertised_key_id,json=advertisedKeyId,proto3" json:"advertised_key_id,omitempty"`
+}
+
+func (x *BfdSession_Authentication) Reset() {
+	*x = BfdSession_Authentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BfdSession_Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BfdSession_Authentication) ProtoMessage() {}
+
+func (x *BfdSession_Authentication) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_bfd_bfd_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BfdSession_Authentication.ProtoReflect.Descriptor instead.
+func (*BfdSession_Authentication) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_bfd_bfd_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *BfdSession_Authentication) GetKeyId() uint32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *BfdSession_Authentication) GetAdvertisedKeyId() uint32 {
+	if x != nil {
+		return x.AdvertisedKeyId
+	}
+	return 0
+}
+
+var File_ligato_vpp_bfd_bfd_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_bfd_bfd_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x62, 0x66, 0x64,
+	0x2f, 0x62, 0x66, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62, 0x66, 0x64, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x03, 0x0a, 0x0a, 0x42, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x35, 0x0a, 0x17, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e,
+	0x54, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x78, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x52, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x11, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a,
+	0x82, 0x7d, 0x07, 0x12, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x10, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x5d, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x11, 0x61, 0x64, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0xff, 0x01, 0x52, 0x0f, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22, 0xa4,
+	0x01, 0x0a, 0x0a, 0x42, 0x66, 0x64, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66, 0x64,
+	0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x35,
+	0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x45,
+	0x59, 0x45, 0x44, 0x5f, 0x53, 0x48, 0x41, 0x31, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45,
+	0x54, 0x49, 0x43, 0x55, 0x4c, 0x4f, 0x55, 0x53, 0x5f, 0x4b, 0x45, 0x59, 0x45, 0x44, 0x5f, 0x53,
+	0x48, 0x41, 0x31, 0x10, 0x01, 0x22, 0x83, 0x02, 0x0a, 0x0f, 0x42, 0x66, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66, 0x64, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49,
+	0x54, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x04, 0x22, 0x4f, 0x0a, 0x16, 0x42,
+	0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x38, 0x5a, 0x36,
+	0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x62, 0x66, 0x64, 0x3b, 0x76,
+	0x70, 0x70, 0x5f, 0x62, 0x66, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_bfd_bfd_proto_rawDescOnce sync.Once
+	file_ligato_vpp_bfd_bfd_proto_rawDescData = file_ligato_vpp_bfd_bfd_proto_rawDesc
+)
+
+func file_ligato_vpp_bfd_bfd_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_bfd_bfd_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_bfd_bfd_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_bfd_bfd_proto_rawDescData)
+	})
+	return file_ligato_vpp_bfd_bfd_proto_rawDescData
+}
+
+var file_ligato_vpp_bfd_bfd_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ligato_vpp_bfd_bfd_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ligato_vpp_bfd_bfd_proto_goTypes = []interface{}{
+	(BfdAuthKey_AuthType)(0),          // 0: ligato.vpp.bfd.BfdAuthKey.AuthType
+	(BfdSessionState_State)(0),        // 1: ligato.vpp.bfd.BfdSessionState.State
+	(*BfdSession)(nil),                // 2: ligato.vpp.bfd.BfdSession
+	(*BfdAuthKey)(nil),                // 3: ligato.vpp.bfd.BfdAuthKey
+	(*BfdSessionState)(nil),           // 4: ligato.vpp.bfd.BfdSessionState
+	(*BfdSessionNotification)(nil),    // 5: ligato.vpp.bfd.BfdSessionNotification
+	(*BfdSession_Authentication)(nil), // 6: ligato.vpp.bfd.BfdSession.Authentication
+}
+var file_ligato_vpp_bfd_bfd_proto_depIdxs = []int32{
+	6, // 0: ligato.vpp.bfd.BfdSession.authentication:type_name -> ligato.vpp.bfd.BfdSession.Authentication
+	0, // 1: ligato.vpp.bfd.BfdAuthKey.type:type_name -> ligato.vpp.bfd.BfdAuthKey.AuthType
+	1, // 2: ligato.vpp.bfd.BfdSessionState.state:type_name -> ligato.vpp.bfd.BfdSessionState.State
+	4, // 3: ligato.vpp.bfd.BfdSessionNotification.state:type_name -> ligato.vpp.bfd.BfdSessionState
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_bfd_bfd_proto_init() }
+func file_ligato_vpp_bfd_bfd_proto_init() {
+	if File_ligato_vpp_bfd_bfd_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_bfd_bfd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BfdSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_bfd_bfd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BfdAuthKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_bfd_bfd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BfdSessionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_bfd_bfd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BfdSessionNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_bfd_bfd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BfdSession_Authentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_bfd_bfd_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_bfd_bfd_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_bfd_bfd_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_bfd_bfd_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_bfd_bfd_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_bfd_bfd_proto = out.File
+	file_ligato_vpp_bfd_bfd_proto_rawDesc = nil
+	file_ligato_vpp_bfd_bfd_proto_goTypes = nil
+	file_ligato_vpp_bfd_bfd_proto_depIdxs = nil
+}
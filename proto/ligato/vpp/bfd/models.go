@@ -0,0 +1,124 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_bfd
+
+import (
+	"net"
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.bfd"
+
+var (
+	ModelBfdSession models.KnownModel
+	ModelBfdAuthKey models.KnownModel
+)
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_bfd_bfd_proto_init()
+
+	ModelBfdSession = models.Register(&BfdSession{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "session",
+	}, models.WithNameTemplate("{{.Interface}}/peer/{{.PeerIp}}"))
+
+	ModelBfdAuthKey = models.Register(&BfdAuthKey{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "auth-key",
+	}, models.WithNameTemplate("{{.Id}}"))
+}
+
+// SessionKey returns the key under which BFD session configuration is stored.
+func SessionKey(iface, peerIP string) string {
+	return models.Key(&BfdSession{
+		Interface: iface,
+		PeerIp:    peerIP,
+	})
+}
+
+// AuthKeyKey returns the key under which BFD authentication key is stored.
+func AuthKeyKey(id uint32) string {
+	return models.Key(&BfdAuthKey{
+		Id: id,
+	})
+}
+
+const (
+	// sessionStateKeyTemplate is a template for keys representing the state
+	// of BFD sessions (up/down), the peer IP goes first since interface name
+	// may contain forward slashes
+	sessionStateKeyTemplate = "vpp/bfd/session/{peer}/interface/{iface}/state/{state}"
+
+	sessionUpState   = "UP"
+	sessionDownState = "DOWN"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// SessionStateKey returns key representing the state of a BFD session.
+// Peer IP address is normalized, so that the key is the same regardless
+// of the IP address notation.
+func SessionStateKey(iface, peerIP string, isUp bool) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	if ip := net.ParseIP(peerIP); ip != nil {
+		peerIP = ip.String()
+	} else {
+		peerIP = InvalidKeyPart
+	}
+	state := sessionDownState
+	if isUp {
+		state = sessionUpState
+	}
+	key := strings.Replace(sessionStateKeyTemplate, "{peer}", peerIP, 1)
+	key = strings.Replace(key, "{iface}", iface, 1)
+	key = strings.Replace(key, "{state}", state, 1)
+	return key
+}
+
+// ParseSessionStateKey parses key representing the state of a BFD session.
+func ParseSessionStateKey(key string) (iface, peerIP string, isUp, isSessionStateKey bool) {
+	suffix := strings.TrimPrefix(key, "vpp/bfd/session/")
+	if suffix == key {
+		return
+	}
+	parts := strings.Split(suffix, "/")
+	// <peer>/interface/<iface...>/state/<state>
+	if len(parts) < 5 || parts[1] != "interface" || parts[len(parts)-2] != "state" {
+		return
+	}
+	switch parts[len(parts)-1] {
+	case sessionUpState:
+		isUp = true
+	case sessionDownState:
+		isUp = false
+	default:
+		return
+	}
+	peerIP = parts[0]
+	iface = strings.Join(parts[2:len(parts)-2], "/")
+	if peerIP == "" || iface == "" {
+		return "", "", false, false
+	}
+	return iface, peerIP, isUp, true
+}
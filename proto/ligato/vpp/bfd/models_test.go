@@ -0,0 +1,133 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_bfd_test
+
+import (
+	"testing"
+
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+func TestBfdKeys(t *testing.T) {
+	if key := vpp_bfd.SessionKey("tap0", "10.0.0.2"); key != "config/vpp/bfd/v2/session/tap0/peer/10.0.0.2" {
+		t.Errorf("unexpected BFD session key: %q", key)
+	}
+	if key := vpp_bfd.AuthKeyKey(5); key != "config/vpp/bfd/v2/auth-key/5" {
+		t.Errorf("unexpected BFD auth key key: %q", key)
+	}
+}
+
+func TestSessionStateKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		peerIP      string
+		isUp        bool
+		expectedKey string
+	}{
+		{
+			name:        "up",
+			iface:       "tap0",
+			peerIP:      "10.0.0.2",
+			isUp:        true,
+			expectedKey: "vpp/bfd/session/10.0.0.2/interface/tap0/state/UP",
+		},
+		{
+			name:        "down with slash in interface name",
+			iface:       "memif0/1",
+			peerIP:      "fd00::2",
+			isUp:        false,
+			expectedKey: "vpp/bfd/session/fd00::2/interface/memif0/1/state/DOWN",
+		},
+		{
+			name:        "non-canonical IPv6",
+			iface:       "tap0",
+			peerIP:      "FD00:0::2",
+			isUp:        true,
+			expectedKey: "vpp/bfd/session/fd00::2/interface/tap0/state/UP",
+		},
+		{
+			name:        "empty interface",
+			iface:       "",
+			peerIP:      "10.0.0.2",
+			isUp:        true,
+			expectedKey: "vpp/bfd/session/10.0.0.2/interface/<invalid>/state/UP",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := vpp_bfd.SessionStateKey(test.iface, test.peerIP, test.isUp)
+			if key != test.expectedKey {
+				t.Errorf("expected key: %q\tgot: %q", test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseSessionStateKey(t *testing.T) {
+	tests := []struct {
+		name            string
+		key             string
+		expectedIface   string
+		expectedPeerIP  string
+		expectedIsUp    bool
+		expectedIsValid bool
+	}{
+		{
+			name:            "up",
+			key:             "vpp/bfd/session/10.0.0.2/interface/tap0/state/UP",
+			expectedIface:   "tap0",
+			expectedPeerIP:  "10.0.0.2",
+			expectedIsUp:    true,
+			expectedIsValid: true,
+		},
+		{
+			name:            "down with slash in interface name",
+			key:             "vpp/bfd/session/fd00::2/interface/memif0/1/state/DOWN",
+			expectedIface:   "memif0/1",
+			expectedPeerIP:  "fd00::2",
+			expectedIsValid: true,
+		},
+		{
+			name: "invalid state",
+			key:  "vpp/bfd/session/10.0.0.2/interface/tap0/state/INIT",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/bfd/session/10.0.0.2/interface/state/UP",
+		},
+		{
+			name: "session key",
+			key:  "config/vpp/bfd/v2/session/tap0/peer/10.0.0.2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, peerIP, isUp, isValid := vpp_bfd.ParseSessionStateKey(test.key)
+			if isValid != test.expectedIsValid {
+				t.Errorf("expected isValid: %v\tgot: %v", test.expectedIsValid, isValid)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected interface: %q\tgot: %q", test.expectedIface, iface)
+			}
+			if peerIP != test.expectedPeerIP {
+				t.Errorf("expected peer IP: %q\tgot: %q", test.expectedPeerIP, peerIP)
+			}
+			if isUp != test.expectedIsUp {
+				t.Errorf("expected isUp: %v\tgot: %v", test.expectedIsUp, isUp)
+			}
+		})
+	}
+}
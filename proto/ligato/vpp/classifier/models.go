@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_classifier
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.classifier"
+
+var (
+	ModelClassifyTable   models.KnownModel
+	ModelClassifySession models.KnownModel
+	ModelPolicerClassify models.KnownModel
+)
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_classifier_classifier_proto_init()
+
+	ModelClassifyTable = models.Register(&ClassifyTable{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "table",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelClassifySession = models.Register(&ClassifySession{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "session",
+	}, models.WithNameTemplate("{{.Table}}/match/{{.Match}}"))
+
+	ModelPolicerClassify = models.Register(&PolicerClassify{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "policer-classify",
+	}, models.WithNameTemplate("{{.Interface}}"))
+}
+
+// TableKey returns the key under which classify table configuration is stored.
+func TableKey(name string) string {
+	return models.Key(&ClassifyTable{
+		Name: name,
+	})
+}
+
+// SessionKey returns the key under which classify session configuration is stored.
+func SessionKey(table, match string) string {
+	return models.Key(&ClassifySession{
+		Table: table,
+		Match: match,
+	})
+}
+
+// PolicerClassifyKey returns the key under which policer classify configuration
+// for the given interface is stored.
+func PolicerClassifyKey(iface string) string {
+	return models.Key(&PolicerClassify{
+		Interface: iface,
+	})
+}
+
+const (
+	// table to interface template is a derived value key
+	tableToInterfaceTemplate = "vpp/classifier/table/{table}/interface/{direction}/{type}/{iface}"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// ToInterfaceKey returns key representing classify table applied on interface
+// in the given direction for the given traffic type.
+func ToInterfaceKey(table, iface string, direction ClassifyTable_Interface_Direction,
+	tableType ClassifyTable_Interface_Type) string {
+	if table == "" {
+		table = InvalidKeyPart
+	}
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	key := tableToInterfaceTemplate
+	key = strings.Replace(key, "{table}", table, 1)
+	key = strings.Replace(key, "{direction}", strings.ToLower(direction.String()), 1)
+	key = strings.Replace(key, "{type}", strings.ToLower(tableType.String()), 1)
+	key = strings.Replace(key, "{iface}", iface, 1)
+	return key
+}
+
+// ParseToInterfaceKey parses key representing classify table applied on interface.
+func ParseToInterfaceKey(key string) (table, iface string, direction ClassifyTable_Interface_Direction,
+	tableType ClassifyTable_Interface_Type, isTableToInterface bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) >= 8 &&
+		parts[0] == "vpp" && parts[1] == "classifier" && parts[2] == "table" && parts[4] == "interface" {
+		dirVal, validDir := ClassifyTable_Interface_Direction_value[strings.ToUpper(parts[5])]
+		typeVal, validType := ClassifyTable_Interface_Type_value[strings.ToUpper(parts[6])]
+		table = parts[3]
+		iface = strings.Join(parts[7:], "/")
+		if validDir && validType && table != "" && iface != "" {
+			return table, iface, ClassifyTable_Interface_Direction(dirVal),
+				ClassifyTable_Interface_Type(typeVal), true
+		}
+	}
+	return "", "", 0, 0, false
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_classifier_test
+
+import (
+	"testing"
+
+	vpp_classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+func TestClassifierKeys(t *testing.T) {
+	if key := vpp_classifier.TableKey("table1"); key != "config/vpp/classifier/v2/table/table1" {
+		t.Errorf("unexpected table key: %q", key)
+	}
+	if key := vpp_classifier.SessionKey("table1", "0a0b0c"); key != "config/vpp/classifier/v2/session/table1/match/0a0b0c" {
+		t.Errorf("unexpected session key: %q", key)
+	}
+	if key := vpp_classifier.PolicerClassifyKey("tap0"); key != "config/vpp/classifier/v2/policer-classify/tap0" {
+		t.Errorf("unexpected policer classify key: %q", key)
+	}
+}
+
+func TestTableToInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		table       string
+		iface       string
+		direction   vpp_classifier.ClassifyTable_Interface_Direction
+		tableType   vpp_classifier.ClassifyTable_Interface_Type
+		expectedKey string
+	}{
+		{
+			name:        "input ip4",
+			table:       "table1",
+			iface:       "tap0",
+			direction:   vpp_classifier.ClassifyTable_Interface_INPUT,
+			tableType:   vpp_classifier.ClassifyTable_Interface_IP4,
+			expectedKey: "vpp/classifier/table/table1/interface/input/ip4/tap0",
+		},
+		{
+			name:        "output l2",
+			table:       "table1",
+			iface:       "memif0/1",
+			direction:   vpp_classifier.ClassifyTable_Interface_OUTPUT,
+			tableType:   vpp_classifier.ClassifyTable_Interface_L2,
+			expectedKey: "vpp/classifier/table/table1/interface/output/l2/memif0/1",
+		},
+		{
+			name:        "empty interface",
+			table:       "table1",
+			iface:       "",
+			direction:   vpp_classifier.ClassifyTable_Interface_INPUT,
+			tableType:   vpp_classifier.ClassifyTable_Interface_IP6,
+			expectedKey: "vpp/classifier/table/table1/interface/input/ip6/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := vpp_classifier.ToInterfaceKey(test.table, test.iface, test.direction, test.tableType)
+			if key != test.expectedKey {
+				t.Errorf("expected key: %q\tgot: %q", test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseTableToInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name              string
+		key               string
+		expectedTable     string
+		expectedIface     string
+		expectedDirection vpp_classifier.ClassifyTable_Interface_Direction
+		expectedType      vpp_classifier.ClassifyTable_Interface_Type
+		expectedIsValid   bool
+	}{
+		{
+			name:              "input ip6",
+			key:               "vpp/classifier/table/table1/interface/input/ip6/tap0",
+			expectedTable:     "table1",
+			expectedIface:     "tap0",
+			expectedDirection: vpp_classifier.ClassifyTable_Interface_INPUT,
+			expectedType:      vpp_classifier.ClassifyTable_Interface_IP6,
+			expectedIsValid:   true,
+		},
+		{
+			name:              "output with slash in interface name",
+			key:               "vpp/classifier/table/table1/interface/output/l2/memif0/1",
+			expectedTable:     "table1",
+			expectedIface:     "memif0/1",
+			expectedDirection: vpp_classifier.ClassifyTable_Interface_OUTPUT,
+			expectedType:      vpp_classifier.ClassifyTable_Interface_L2,
+			expectedIsValid:   true,
+		},
+		{
+			name: "invalid direction",
+			key:  "vpp/classifier/table/table1/interface/both/ip4/tap0",
+		},
+		{
+			name: "invalid type",
+			key:  "vpp/classifier/table/table1/interface/input/mpls/tap0",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/classifier/table/table1/interface/input/ip4",
+		},
+		{
+			name: "table key",
+			key:  "config/vpp/classifier/v2/table/table1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table, iface, direction, tableType, isValid := vpp_classifier.ParseToInterfaceKey(test.key)
+			if isValid != test.expectedIsValid {
+				t.Errorf("expected isValid: %v\tgot: %v", test.expectedIsValid, isValid)
+			}
+			if table != test.expectedTable {
+				t.Errorf("expected table: %q\tgot: %q", test.expectedTable, table)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected interface: %q\tgot: %q", test.expectedIface, iface)
+			}
+			if direction != test.expectedDirection {
+				t.Errorf("expected direction: %v\tgot: %v", test.expectedDirection, direction)
+			}
+			if tableType != test.expectedType {
+				t.Errorf("expected type: %v\tgot: %v", test.expectedType, tableType)
+			}
+		})
+	}
+}
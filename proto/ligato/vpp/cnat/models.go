@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_cnat
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.cnat"
+
+var (
+	ModelTranslation         models.KnownModel
+	ModelSnatPolicy          models.KnownModel
+	ModelSnatPolicyInterface models.KnownModel
+)
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_cnat_cnat_proto_init()
+
+	ModelTranslation = models.Register(&Translation{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "translation",
+	}, models.WithNameTemplate("{{.Label}}"))
+
+	ModelSnatPolicy = models.Register(&SnatPolicy{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "snat-policy",
+	})
+
+	ModelSnatPolicyInterface = models.Register(&SnatPolicyInterface{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "snat-policy-interface",
+	}, models.WithNameTemplate("{{.Interface}}/table/{{.Table}}"))
+}
+
+// TranslationKey returns the key under which cnat translation
+// with the given label is stored.
+func TranslationKey(label string) string {
+	return models.Key(&Translation{
+		Label: label,
+	})
+}
+
+// SnatPolicyKey returns the key under which the cnat source NAT policy is stored.
+func SnatPolicyKey() string {
+	return models.Key(&SnatPolicy{})
+}
+
+// SnatPolicyInterfaceKey returns the key under which enablement of the source NAT
+// policy for the given interface and table is stored.
+func SnatPolicyInterfaceKey(iface string, table SnatPolicyInterface_Table) string {
+	return models.Key(&SnatPolicyInterface{
+		Interface: iface,
+		Table:     table,
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_cnat_test
+
+import (
+	"testing"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+func TestCnatKeys(t *testing.T) {
+	if key := vpp_cnat.TranslationKey("svc1"); key != "config/vpp/cnat/v2/translation/svc1" {
+		t.Errorf("unexpected translation key: %q", key)
+	}
+	if key := vpp_cnat.SnatPolicyKey(); key != "config/vpp/cnat/v2/snat-policy" {
+		t.Errorf("unexpected snat policy key: %q", key)
+	}
+	key := vpp_cnat.SnatPolicyInterfaceKey("tap0", vpp_cnat.SnatPolicyInterface_POD)
+	if key != "config/vpp/cnat/v2/snat-policy-interface/tap0/table/POD" {
+		t.Errorf("unexpected snat policy interface key: %q", key)
+	}
+}
@@ -43,6 +43,10 @@ const (
 	Interface_IPIP_TUNNEL       Interface_Type = 13
 	Interface_WIREGUARD_TUNNEL  Interface_Type = 14
 	Interface_RDMA              Interface_Type = 15
+	Interface_GENEVE_TUNNEL     Interface_Type = 16
+	Interface_MPLS_TUNNEL       Interface_Type = 18
+	Interface_PPPOE_SESSION     Interface_Type = 19
+	Interface_L2TPV3_TUNNEL     Interface_Type = 20
 )
 
 // Enum value maps for Interface_Type.
@@ -64,6 +68,10 @@ var (
 		13: "IPIP_TUNNEL",
 		14: "WIREGUARD_TUNNEL",
 		15: "RDMA",
+		16: "GENEVE_TUNNEL",
+		18: "MPLS_TUNNEL",
+		19: "PPPOE_SESSION",
+		20: "L2TPV3_TUNNEL",
 	}
 	Interface_Type_value = map[string]int32{
 		"UNDEFINED_TYPE":    0,
@@ -82,6 +90,10 @@ var (
 		"IPIP_TUNNEL":       13,
 		"WIREGUARD_TUNNEL":  14,
 		"RDMA":              15,
+		"GENEVE_TUNNEL":     16,
+		"MPLS_TUNNEL":       18,
+		"PPPOE_SESSION":     19,
+		"L2TPV3_TUNNEL":     20,
 	}
 )
 
@@ -165,7 +177,7 @@ func (x Interface_RxMode_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Interface_RxMode_Type.Descriptor instead.
 func (Interface_RxMode_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 2, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 4, 0}
 }
 
 type SubInterface_TagRewriteOptions int32
@@ -384,7 +396,7 @@ func (x IPSecLink_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IPSecLink_Mode.Descriptor instead.
 func (IPSecLink_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{6, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type BondLink_Mode int32
@@ -442,7 +454,7 @@ func (x BondLink_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BondLink_Mode.Descriptor instead.
 func (BondLink_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{8, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type BondLink_LoadBalance int32
@@ -503,7 +515,7 @@ func (x BondLink_LoadBalance) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BondLink_LoadBalance.Descriptor instead.
 func (BondLink_LoadBalance) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{8, 1}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{9, 1}
 }
 
 type GreLink_Type int32
@@ -558,7 +570,7 @@ func (x GreLink_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GreLink_Type.Descriptor instead.
 func (GreLink_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{9, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type GtpuLink_NextNode int32
@@ -614,7 +626,7 @@ func (x GtpuLink_NextNode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GtpuLink_NextNode.Descriptor instead.
 func (GtpuLink_NextNode) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{10, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type IPIPLink_Mode int32
@@ -662,7 +674,7 @@ func (x IPIPLink_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IPIPLink_Mode.Descriptor instead.
 func (IPIPLink_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{11, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type RDMALink_Mode int32
@@ -715,7 +727,7 @@ func (x RDMALink_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RDMALink_Mode.Descriptor instead.
 func (RDMALink_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{13, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{17, 0}
 }
 
 // Interface defines a VPP interface.
@@ -733,6 +745,8 @@ type Interface struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// PhysAddress represents physical address (MAC) of the interface.
 	// Random address will be assigned if left empty.
+	// MAC address can be also allocated via netalloc plugin and referenced
+	// here, see: proto/ligato/netalloc/netalloc.proto
 	PhysAddress string `protobuf:"bytes,4,opt,name=phys_address,json=physAddress,proto3" json:"phys_address,omitempty"`
 	// IPAddresses define list of IP addresses for the interface and must be
 	// defined in the following format: <ipAddress>/<ipPrefix>.
@@ -745,8 +759,10 @@ type Interface struct {
 	// the interface referenced by the numbered interface and this field is ignored.
 	Vrf uint32 `protobuf:"varint,6,opt,name=vrf,proto3" json:"vrf,omitempty"`
 	// SetDhcpClient enables DHCP client on interface.
-	SetDhcpClient bool             `protobuf:"varint,7,opt,name=set_dhcp_client,json=setDhcpClient,proto3" json:"set_dhcp_client,omitempty"`
-	Ip6Nd         *Interface_IP6ND `protobuf:"bytes,14,opt,name=ip6_nd,json=ip6Nd,proto3" json:"ip6_nd,omitempty"`
+	SetDhcpClient      bool                          `protobuf:"varint,7,opt,name=set_dhcp_client,json=setDhcpClient,proto3" json:"set_dhcp_client,omitempty"`
+	Ip6Nd              *Interface_IP6ND              `protobuf:"bytes,14,opt,name=ip6_nd,json=ip6Nd,proto3" json:"ip6_nd,omitempty"`
+	Dhcpv6PdClient     *Interface_DHCPv6PDClient     `protobuf:"bytes,15,opt,name=dhcpv6_pd_client,json=dhcpv6PdClient,proto3" json:"dhcpv6_pd_client,omitempty"`
+	Ip6PrefixAddresses []*Interface_IP6PrefixAddress `protobuf:"bytes,16,rep,name=ip6_prefix_addresses,json=ip6PrefixAddresses,proto3" json:"ip6_prefix_addresses,omitempty"`
 	// Mtu sets MTU (Maximum Transmission Unit) for this interface.
 	// If set to zero, default MTU (usually 9216) will be used.
 	Mtu          uint32                   `protobuf:"varint,8,opt,name=mtu,proto3" json:"mtu,omitempty"`
@@ -757,7 +773,6 @@ type Interface struct {
 	// It can be nil for some interfaces types like: loopback and DPDK.
 	//
 	// Types that are assignable to Link:
-	//
 	//	*Interface_Sub
 	//	*Interface_Memif
 	//	*Interface_Afpacket
@@ -771,6 +786,10 @@ type Interface struct {
 	//	*Interface_Ipip
 	//	*Interface_Wireguard
 	//	*Interface_Rdma
+	//	*Interface_Geneve
+	//	*Interface_MplsTunnel
+	//	*Interface_Pppoe
+	//	*Interface_L2Tpv3
 	Link isInterface_Link `protobuf_oneof:"link"`
 }
 
@@ -862,6 +881,20 @@ func (x *Interface) GetIp6Nd() *Interface_IP6ND {
 	return nil
 }
 
+func (x *Interface) GetDhcpv6PdClient() *Interface_DHCPv6PDClient {
+	if x != nil {
+		return x.Dhcpv6PdClient
+	}
+	return nil
+}
+
+func (x *Interface) GetIp6PrefixAddresses() []*Interface_IP6PrefixAddress {
+	if x != nil {
+		return x.Ip6PrefixAddresses
+	}
+	return nil
+}
+
 func (x *Interface) GetMtu() uint32 {
 	if x != nil {
 		return x.Mtu
@@ -989,6 +1022,34 @@ func (x *Interface) GetRdma() *RDMALink {
 	return nil
 }
 
+func (x *Interface) GetGeneve() *GeneveLink {
+	if x, ok := x.GetLink().(*Interface_Geneve); ok {
+		return x.Geneve
+	}
+	return nil
+}
+
+func (x *Interface) GetMplsTunnel() *MplsTunnelLink {
+	if x, ok := x.GetLink().(*Interface_MplsTunnel); ok {
+		return x.MplsTunnel
+	}
+	return nil
+}
+
+func (x *Interface) GetPppoe() *PPPoELink {
+	if x, ok := x.GetLink().(*Interface_Pppoe); ok {
+		return x.Pppoe
+	}
+	return nil
+}
+
+func (x *Interface) GetL2Tpv3() *L2TPv3Link {
+	if x, ok := x.GetLink().(*Interface_L2Tpv3); ok {
+		return x.L2Tpv3
+	}
+	return nil
+}
+
 type isInterface_Link interface {
 	isInterface_Link()
 }
@@ -1046,6 +1107,22 @@ type Interface_Rdma struct {
 	Rdma *RDMALink `protobuf:"bytes,112,opt,name=rdma,proto3,oneof"`
 }
 
+type Interface_Geneve struct {
+	Geneve *GeneveLink `protobuf:"bytes,113,opt,name=geneve,proto3,oneof"`
+}
+
+type Interface_MplsTunnel struct {
+	MplsTunnel *MplsTunnelLink `protobuf:"bytes,115,opt,name=mpls_tunnel,json=mplsTunnel,proto3,oneof"`
+}
+
+type Interface_Pppoe struct {
+	Pppoe *PPPoELink `protobuf:"bytes,116,opt,name=pppoe,proto3,oneof"`
+}
+
+type Interface_L2Tpv3 struct {
+	L2Tpv3 *L2TPv3Link `protobuf:"bytes,117,opt,name=l2tpv3,proto3,oneof"`
+}
+
 func (*Interface_Sub) isInterface_Link() {}
 
 func (*Interface_Memif) isInterface_Link() {}
@@ -1072,6 +1149,14 @@ func (*Interface_Wireguard) isInterface_Link() {}
 
 func (*Interface_Rdma) isInterface_Link() {}
 
+func (*Interface_Geneve) isInterface_Link() {}
+
+func (*Interface_MplsTunnel) isInterface_Link() {}
+
+func (*Interface_Pppoe) isInterface_Link() {}
+
+func (*Interface_L2Tpv3) isInterface_Link() {}
+
 // SubInterface defines configuration for interface type: SUB_INTERFACE
 type SubInterface struct {
 	state         protoimpl.MessageState
@@ -1090,6 +1175,9 @@ type SubInterface struct {
 	Tag1 uint32 `protobuf:"varint,5,opt,name=tag1,proto3" json:"tag1,omitempty"`
 	// Second tag (required for PUSH2 and any TRANSLATE)
 	Tag2 uint32 `protobuf:"varint,6,opt,name=tag2,proto3" json:"tag2,omitempty"`
+	// SubIdAlloc is a reference to VLAN ID allocated via netalloc plugin
+	// (see: proto/ligato/netalloc/netalloc.proto), used as sub_id if set.
+	SubIdAlloc string `protobuf:"bytes,7,opt,name=sub_id_alloc,json=subIdAlloc,proto3" json:"sub_id_alloc,omitempty"`
 }
 
 func (x *SubInterface) Reset() {
@@ -1166,6 +1254,13 @@ func (x *SubInterface) GetTag2() uint32 {
 	return 0
 }
 
+func (x *SubInterface) GetSubIdAlloc() string {
+	if x != nil {
+		return x.SubIdAlloc
+	}
+	return ""
+}
+
 // MemifLink defines configuration for interface type: MEMIF
 type MemifLink struct {
 	state         protoimpl.MessageState
@@ -1299,6 +1394,9 @@ type VxlanLink struct {
 	// Multicast defines name of multicast interface
 	Multicast string         `protobuf:"bytes,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
 	Gpe       *VxlanLink_Gpe `protobuf:"bytes,5,opt,name=gpe,proto3" json:"gpe,omitempty"`
+	// VniAlloc is a reference to VNI allocated via netalloc plugin
+	// (see: proto/ligato/netalloc/netalloc.proto), used as vni if set.
+	VniAlloc string `protobuf:"bytes,6,opt,name=vni_alloc,json=vniAlloc,proto3" json:"vni_alloc,omitempty"`
 }
 
 func (x *VxlanLink) Reset() {
@@ -1368,6 +1466,108 @@ func (x *VxlanLink) GetGpe() *VxlanLink_Gpe {
 	return nil
 }
 
+func (x *VxlanLink) GetVniAlloc() string {
+	if x != nil {
+		return x.VniAlloc
+	}
+	return ""
+}
+
+// GeneveLink defines configuration for interface type: GENEVE_TUNNEL
+type GeneveLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SrcAddress is source VTEP address
+	SrcAddress string `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	// DstAddress is destination VTEP address
+	DstAddress string `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	// Vni stands for Virtual Network Identifier
+	Vni uint32 `protobuf:"varint,3,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Multicast defines name of multicast interface
+	Multicast string `protobuf:"bytes,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// DecapNextNode is the VPP node index where decapsulated packets are sent,
+	// l2-input is used if unset (ignored in L3 mode)
+	DecapNextNode uint32 `protobuf:"varint,5,opt,name=decap_next_node,json=decapNextNode,proto3" json:"decap_next_node,omitempty"`
+	// L3Mode makes the tunnel carry IP packets instead of Ethernet frames
+	L3Mode bool `protobuf:"varint,6,opt,name=l3_mode,json=l3Mode,proto3" json:"l3_mode,omitempty"`
+}
+
+func (x *GeneveLink) Reset() {
+	*x = GeneveLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneveLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneveLink) ProtoMessage() {}
+
+func (x *GeneveLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneveLink.ProtoReflect.Descriptor instead.
+func (*GeneveLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GeneveLink) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *GeneveLink) GetMulticast() string {
+	if x != nil {
+		return x.Multicast
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetDecapNextNode() uint32 {
+	if x != nil {
+		return x.DecapNextNode
+	}
+	return 0
+}
+
+func (x *GeneveLink) GetL3Mode() bool {
+	if x != nil {
+		return x.L3Mode
+	}
+	return false
+}
+
 // AfpacketLink defines configuration for interface type: AF_PACKET
 type AfpacketLink struct {
 	state         protoimpl.MessageState
@@ -1399,7 +1599,7 @@ type AfpacketLink struct {
 func (x *AfpacketLink) Reset() {
 	*x = AfpacketLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[4]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1412,7 +1612,7 @@ func (x *AfpacketLink) String() string {
 func (*AfpacketLink) ProtoMessage() {}
 
 func (x *AfpacketLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[4]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,7 +1625,7 @@ func (x *AfpacketLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AfpacketLink.ProtoReflect.Descriptor instead.
 func (*AfpacketLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{4}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AfpacketLink) GetHostIfName() string {
@@ -1472,7 +1672,7 @@ type TapLink struct {
 func (x *TapLink) Reset() {
 	*x = TapLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[5]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1485,7 +1685,7 @@ func (x *TapLink) String() string {
 func (*TapLink) ProtoMessage() {}
 
 func (x *TapLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[5]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,7 +1698,7 @@ func (x *TapLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TapLink.ProtoReflect.Descriptor instead.
 func (*TapLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{5}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TapLink) GetVersion() uint32 {
@@ -1609,7 +1809,7 @@ type IPSecLink struct {
 func (x *IPSecLink) Reset() {
 	*x = IPSecLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[6]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +1822,7 @@ func (x *IPSecLink) String() string {
 func (*IPSecLink) ProtoMessage() {}
 
 func (x *IPSecLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[6]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1835,7 @@ func (x *IPSecLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPSecLink.ProtoReflect.Descriptor instead.
 func (*IPSecLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{6}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *IPSecLink) GetTunnelMode() IPSecLink_Mode {
@@ -1769,7 +1969,7 @@ type VmxNet3Link struct {
 func (x *VmxNet3Link) Reset() {
 	*x = VmxNet3Link{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[7]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1782,7 +1982,7 @@ func (x *VmxNet3Link) String() string {
 func (*VmxNet3Link) ProtoMessage() {}
 
 func (x *VmxNet3Link) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[7]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,7 +1995,7 @@ func (x *VmxNet3Link) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VmxNet3Link.ProtoReflect.Descriptor instead.
 func (*VmxNet3Link) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{7}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VmxNet3Link) GetEnableElog() bool {
@@ -1835,7 +2035,7 @@ type BondLink struct {
 func (x *BondLink) Reset() {
 	*x = BondLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[8]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1848,7 +2048,7 @@ func (x *BondLink) String() string {
 func (*BondLink) ProtoMessage() {}
 
 func (x *BondLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[8]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,7 +2061,7 @@ func (x *BondLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BondLink.ProtoReflect.Descriptor instead.
 func (*BondLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{8}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BondLink) GetId() uint32 {
@@ -1907,7 +2107,7 @@ type GreLink struct {
 func (x *GreLink) Reset() {
 	*x = GreLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[9]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1920,7 +2120,7 @@ func (x *GreLink) String() string {
 func (*GreLink) ProtoMessage() {}
 
 func (x *GreLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[9]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1933,7 +2133,7 @@ func (x *GreLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GreLink.ProtoReflect.Descriptor instead.
 func (*GreLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{9}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GreLink) GetTunnelType() GreLink_Type {
@@ -1999,7 +2199,7 @@ type GtpuLink struct {
 func (x *GtpuLink) Reset() {
 	*x = GtpuLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[10]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2012,7 +2212,7 @@ func (x *GtpuLink) String() string {
 func (*GtpuLink) ProtoMessage() {}
 
 func (x *GtpuLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[10]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2225,7 @@ func (x *GtpuLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GtpuLink.ProtoReflect.Descriptor instead.
 func (*GtpuLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{10}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GtpuLink) GetSrcAddr() string {
@@ -2101,7 +2301,7 @@ type IPIPLink struct {
 func (x *IPIPLink) Reset() {
 	*x = IPIPLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[11]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2114,7 +2314,7 @@ func (x *IPIPLink) String() string {
 func (*IPIPLink) ProtoMessage() {}
 
 func (x *IPIPLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[11]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,7 +2327,7 @@ func (x *IPIPLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPIPLink.ProtoReflect.Descriptor instead.
 func (*IPIPLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{11}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IPIPLink) GetTunnelMode() IPIPLink_Mode {
@@ -2167,7 +2367,7 @@ type WireguardLink struct {
 func (x *WireguardLink) Reset() {
 	*x = WireguardLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[12]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2180,7 +2380,7 @@ func (x *WireguardLink) String() string {
 func (*WireguardLink) ProtoMessage() {}
 
 func (x *WireguardLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[12]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,7 +2393,7 @@ func (x *WireguardLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardLink.ProtoReflect.Descriptor instead.
 func (*WireguardLink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{12}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WireguardLink) GetPrivateKey() string {
@@ -2217,44 +2417,37 @@ func (x *WireguardLink) GetSrcAddr() string {
 	return ""
 }
 
-// https://github.com/FDio/vpp/blob/master/src/plugins/rdma/rdma_doc.rst
-type RDMALink struct {
+// MplsTunnelLink defines configuration for interface type: MPLS_TUNNEL
+// Traffic sent out of the tunnel interface is labelled with out_labels
+// and forwarded via the tunnel paths.
+type MplsTunnelLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Linux interface name representing the RDMA-enabled network device to attach into.
-	HostIfName string `protobuf:"bytes,1,opt,name=host_if_name,json=hostIfName,proto3" json:"host_if_name,omitempty"`
-	// Mode at which the RDMA driver operates.
-	Mode RDMALink_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.vpp.interfaces.RDMALink_Mode" json:"mode,omitempty"`
-	// Number of receive queues.
-	// By default only one RX queue is used.
-	RxqNum uint32 `protobuf:"varint,3,opt,name=rxq_num,json=rxqNum,proto3" json:"rxq_num,omitempty"`
-	// The size of each RX queue.
-	// Default is 1024 bytes.
-	RxqSize uint32 `protobuf:"varint,4,opt,name=rxq_size,json=rxqSize,proto3" json:"rxq_size,omitempty"`
-	// The size of each TX queue.
-	// Default is 1024 bytes.
-	TxqSize uint32 `protobuf:"varint,5,opt,name=txq_size,json=txqSize,proto3" json:"txq_size,omitempty"`
+	Paths []*MplsTunnelLink_Path `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// L2 only tunnel is used to carry L2 traffic (e.g. when put into bridge domain
+	// for VPLS), L3 traffic cannot be routed via such tunnel.
+	L2Only bool `protobuf:"varint,2,opt,name=l2_only,json=l2Only,proto3" json:"l2_only,omitempty"`
 }
 
-func (x *RDMALink) Reset() {
-	*x = RDMALink{}
+func (x *MplsTunnelLink) Reset() {
+	*x = MplsTunnelLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[13]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RDMALink) String() string {
+func (x *MplsTunnelLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RDMALink) ProtoMessage() {}
+func (*MplsTunnelLink) ProtoMessage() {}
 
-func (x *RDMALink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[13]
+func (x *MplsTunnelLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,76 +2458,62 @@ func (x *RDMALink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RDMALink.ProtoReflect.Descriptor instead.
-func (*RDMALink) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *RDMALink) GetHostIfName() string {
-	if x != nil {
-		return x.HostIfName
-	}
-	return ""
-}
-
-func (x *RDMALink) GetMode() RDMALink_Mode {
-	if x != nil {
-		return x.Mode
-	}
-	return RDMALink_AUTO
-}
-
-func (x *RDMALink) GetRxqNum() uint32 {
-	if x != nil {
-		return x.RxqNum
-	}
-	return 0
+// Deprecated: Use MplsTunnelLink.ProtoReflect.Descriptor instead.
+func (*MplsTunnelLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RDMALink) GetRxqSize() uint32 {
+func (x *MplsTunnelLink) GetPaths() []*MplsTunnelLink_Path {
 	if x != nil {
-		return x.RxqSize
+		return x.Paths
 	}
-	return 0
+	return nil
 }
 
-func (x *RDMALink) GetTxqSize() uint32 {
+func (x *MplsTunnelLink) GetL2Only() bool {
 	if x != nil {
-		return x.TxqSize
+		return x.L2Only
 	}
-	return 0
+	return false
 }
 
-// Ip6Nd is used to enable/disable IPv6 ND address autoconfiguration
-// and setting up default routes
-type Interface_IP6ND struct {
+// PPPoELink defines configuration for interface type: PPPOE_SESSION
+// The session is terminated on the interface where the PPPoE discovery
+// with the client took place (learned by VPP from the client MAC address),
+// therefore the discovery has to be finished before the session is created.
+type PPPoELink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Enable IPv6 ND address autoconfiguration.
-	AddressAutoconfig bool `protobuf:"varint,1,opt,name=address_autoconfig,json=addressAutoconfig,proto3" json:"address_autoconfig,omitempty"`
-	// Enable installing default routes.
-	InstallDefaultRoutes bool `protobuf:"varint,2,opt,name=install_default_routes,json=installDefaultRoutes,proto3" json:"install_default_routes,omitempty"`
+	// SessionId is the PPPoE session ID assigned during the discovery.
+	SessionId uint32 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// ClientMac is the ethernet address of the client.
+	ClientMac string `protobuf:"bytes,2,opt,name=client_mac,json=clientMac,proto3" json:"client_mac,omitempty"`
+	// ClientIp is the IP address assigned to the client.
+	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	// DecapVrf is the VRF used to look up the decapsulated packets
+	// (and the client address).
+	DecapVrf uint32 `protobuf:"varint,4,opt,name=decap_vrf,json=decapVrf,proto3" json:"decap_vrf,omitempty"`
 }
 
-func (x *Interface_IP6ND) Reset() {
-	*x = Interface_IP6ND{}
+func (x *PPPoELink) Reset() {
+	*x = PPPoELink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Interface_IP6ND) String() string {
+func (x *PPPoELink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface_IP6ND) ProtoMessage() {}
+func (*PPPoELink) ProtoMessage() {}
 
-func (x *Interface_IP6ND) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+func (x *PPPoELink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2345,12 +2524,290 @@ func (x *Interface_IP6ND) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface_IP6ND.ProtoReflect.Descriptor instead.
-func (*Interface_IP6ND) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use PPPoELink.ProtoReflect.Descriptor instead.
+func (*PPPoELink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Interface_IP6ND) GetAddressAutoconfig() bool {
+func (x *PPPoELink) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *PPPoELink) GetClientMac() string {
+	if x != nil {
+		return x.ClientMac
+	}
+	return ""
+}
+
+func (x *PPPoELink) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *PPPoELink) GetDecapVrf() uint32 {
+	if x != nil {
+		return x.DecapVrf
+	}
+	return 0
+}
+
+// L2TPv3Link defines configuration for interface type: L2TPV3_TUNNEL
+// VPP supports only IPv6 transport for L2TPv3 tunnels.
+// Note: VPP does not provide API to remove L2TPv3 tunnel, removed tunnel
+// interface is only set down and untagged. The tunnel is re-used when
+// configured again with the same addresses and session IDs.
+type L2TPv3Link struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SrcAddr is the local tunnel endpoint address.
+	SrcAddr string `protobuf:"bytes,1,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`
+	// DstAddr is the remote tunnel endpoint address.
+	DstAddr string `protobuf:"bytes,2,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
+	// LocalSessionId is the session ID expected in the received packets.
+	LocalSessionId uint32 `protobuf:"varint,3,opt,name=local_session_id,json=localSessionId,proto3" json:"local_session_id,omitempty"`
+	// RemoteSessionId is the session ID inserted into the sent packets.
+	RemoteSessionId uint32 `protobuf:"varint,4,opt,name=remote_session_id,json=remoteSessionId,proto3" json:"remote_session_id,omitempty"`
+	// LocalCookie is the cookie expected in the received packets.
+	LocalCookie uint64 `protobuf:"varint,5,opt,name=local_cookie,json=localCookie,proto3" json:"local_cookie,omitempty"`
+	// RemoteCookie is the cookie inserted into the sent packets.
+	RemoteCookie uint64 `protobuf:"varint,6,opt,name=remote_cookie,json=remoteCookie,proto3" json:"remote_cookie,omitempty"`
+	// L2SublayerPresent enables the L2-specific sublayer in the packets.
+	L2SublayerPresent bool `protobuf:"varint,7,opt,name=l2_sublayer_present,json=l2SublayerPresent,proto3" json:"l2_sublayer_present,omitempty"`
+	// EncapVrf is the VRF used to route the encapsulated packets.
+	EncapVrf uint32 `protobuf:"varint,8,opt,name=encap_vrf,json=encapVrf,proto3" json:"encap_vrf,omitempty"`
+}
+
+func (x *L2TPv3Link) Reset() {
+	*x = L2TPv3Link{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L2TPv3Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L2TPv3Link) ProtoMessage() {}
+
+func (x *L2TPv3Link) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L2TPv3Link.ProtoReflect.Descriptor instead.
+func (*L2TPv3Link) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *L2TPv3Link) GetSrcAddr() string {
+	if x != nil {
+		return x.SrcAddr
+	}
+	return ""
+}
+
+func (x *L2TPv3Link) GetDstAddr() string {
+	if x != nil {
+		return x.DstAddr
+	}
+	return ""
+}
+
+func (x *L2TPv3Link) GetLocalSessionId() uint32 {
+	if x != nil {
+		return x.LocalSessionId
+	}
+	return 0
+}
+
+func (x *L2TPv3Link) GetRemoteSessionId() uint32 {
+	if x != nil {
+		return x.RemoteSessionId
+	}
+	return 0
+}
+
+func (x *L2TPv3Link) GetLocalCookie() uint64 {
+	if x != nil {
+		return x.LocalCookie
+	}
+	return 0
+}
+
+func (x *L2TPv3Link) GetRemoteCookie() uint64 {
+	if x != nil {
+		return x.RemoteCookie
+	}
+	return 0
+}
+
+func (x *L2TPv3Link) GetL2SublayerPresent() bool {
+	if x != nil {
+		return x.L2SublayerPresent
+	}
+	return false
+}
+
+func (x *L2TPv3Link) GetEncapVrf() uint32 {
+	if x != nil {
+		return x.EncapVrf
+	}
+	return 0
+}
+
+// https://github.com/FDio/vpp/blob/master/src/plugins/rdma/rdma_doc.rst
+type RDMALink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Linux interface name representing the RDMA-enabled network device to attach into.
+	HostIfName string `protobuf:"bytes,1,opt,name=host_if_name,json=hostIfName,proto3" json:"host_if_name,omitempty"`
+	// Mode at which the RDMA driver operates.
+	Mode RDMALink_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.vpp.interfaces.RDMALink_Mode" json:"mode,omitempty"`
+	// Number of receive queues.
+	// By default only one RX queue is used.
+	RxqNum uint32 `protobuf:"varint,3,opt,name=rxq_num,json=rxqNum,proto3" json:"rxq_num,omitempty"`
+	// The size of each RX queue.
+	// Default is 1024 bytes.
+	RxqSize uint32 `protobuf:"varint,4,opt,name=rxq_size,json=rxqSize,proto3" json:"rxq_size,omitempty"`
+	// The size of each TX queue.
+	// Default is 1024 bytes.
+	TxqSize uint32 `protobuf:"varint,5,opt,name=txq_size,json=txqSize,proto3" json:"txq_size,omitempty"`
+}
+
+func (x *RDMALink) Reset() {
+	*x = RDMALink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RDMALink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RDMALink) ProtoMessage() {}
+
+func (x *RDMALink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RDMALink.ProtoReflect.Descriptor instead.
+func (*RDMALink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RDMALink) GetHostIfName() string {
+	if x != nil {
+		return x.HostIfName
+	}
+	return ""
+}
+
+func (x *RDMALink) GetMode() RDMALink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return RDMALink_AUTO
+}
+
+func (x *RDMALink) GetRxqNum() uint32 {
+	if x != nil {
+		return x.RxqNum
+	}
+	return 0
+}
+
+func (x *RDMALink) GetRxqSize() uint32 {
+	if x != nil {
+		return x.RxqSize
+	}
+	return 0
+}
+
+func (x *RDMALink) GetTxqSize() uint32 {
+	if x != nil {
+		return x.TxqSize
+	}
+	return 0
+}
+
+// Ip6Nd is used to enable/disable IPv6 ND address autoconfiguration
+// and setting up default routes
+type Interface_IP6ND struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enable IPv6 ND address autoconfiguration.
+	AddressAutoconfig bool `protobuf:"varint,1,opt,name=address_autoconfig,json=addressAutoconfig,proto3" json:"address_autoconfig,omitempty"`
+	// Enable installing default routes.
+	InstallDefaultRoutes bool                                 `protobuf:"varint,2,opt,name=install_default_routes,json=installDefaultRoutes,proto3" json:"install_default_routes,omitempty"`
+	Ra                   *Interface_IP6ND_RouterAdvertisement `protobuf:"bytes,3,opt,name=ra,proto3" json:"ra,omitempty"`
+}
+
+func (x *Interface_IP6ND) Reset() {
+	*x = Interface_IP6ND{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_IP6ND) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_IP6ND) ProtoMessage() {}
+
+func (x *Interface_IP6ND) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_IP6ND.ProtoReflect.Descriptor instead.
+func (*Interface_IP6ND) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Interface_IP6ND) GetAddressAutoconfig() bool {
 	if x != nil {
 		return x.AddressAutoconfig
 	}
@@ -2364,6 +2821,126 @@ func (x *Interface_IP6ND) GetInstallDefaultRoutes() bool {
 	return false
 }
 
+func (x *Interface_IP6ND) GetRa() *Interface_IP6ND_RouterAdvertisement {
+	if x != nil {
+		return x.Ra
+	}
+	return nil
+}
+
+// DHCPv6PDClient enables DHCPv6 client requesting prefix delegation
+// on the interface.
+type Interface_DHCPv6PDClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PrefixGroup is the name of the group the delegated prefixes
+	// are stored in. It is referenced by IPv6 addresses of the interfaces
+	// using the delegated prefix (see ip6_prefix_addresses).
+	PrefixGroup string `protobuf:"bytes,1,opt,name=prefix_group,json=prefixGroup,proto3" json:"prefix_group,omitempty"`
+}
+
+func (x *Interface_DHCPv6PDClient) Reset() {
+	*x = Interface_DHCPv6PDClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_DHCPv6PDClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_DHCPv6PDClient) ProtoMessage() {}
+
+func (x *Interface_DHCPv6PDClient) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_DHCPv6PDClient.ProtoReflect.Descriptor instead.
+func (*Interface_DHCPv6PDClient) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Interface_DHCPv6PDClient) GetPrefixGroup() string {
+	if x != nil {
+		return x.PrefixGroup
+	}
+	return ""
+}
+
+// IP6PrefixAddress is an IPv6 address constructed from the prefix
+// delegated by the DHCPv6 server. The address is assigned by VPP once
+// the prefix is delegated and it is removed when the prefix expires.
+type Interface_IP6PrefixAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PrefixGroup is the prefix group of the DHCPv6 PD client.
+	PrefixGroup string `protobuf:"bytes,1,opt,name=prefix_group,json=prefixGroup,proto3" json:"prefix_group,omitempty"`
+	// Address is combined with the delegated prefix (bits not covered
+	// by the prefix are taken from the address), e.g. "::1/64".
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *Interface_IP6PrefixAddress) Reset() {
+	*x = Interface_IP6PrefixAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_IP6PrefixAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_IP6PrefixAddress) ProtoMessage() {}
+
+func (x *Interface_IP6PrefixAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_IP6PrefixAddress.ProtoReflect.Descriptor instead.
+func (*Interface_IP6PrefixAddress) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Interface_IP6PrefixAddress) GetPrefixGroup() string {
+	if x != nil {
+		return x.PrefixGroup
+	}
+	return ""
+}
+
+func (x *Interface_IP6PrefixAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 // Unnumbered is used for inheriting IP address from another interface.
 type Interface_Unnumbered struct {
 	state         protoimpl.MessageState
@@ -2377,7 +2954,7 @@ type Interface_Unnumbered struct {
 func (x *Interface_Unnumbered) Reset() {
 	*x = Interface_Unnumbered{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2390,7 +2967,7 @@ func (x *Interface_Unnumbered) String() string {
 func (*Interface_Unnumbered) ProtoMessage() {}
 
 func (x *Interface_Unnumbered) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2403,7 +2980,7 @@ func (x *Interface_Unnumbered) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interface_Unnumbered.ProtoReflect.Descriptor instead.
 func (*Interface_Unnumbered) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 1}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 3}
 }
 
 func (x *Interface_Unnumbered) GetInterfaceWithIp() string {
@@ -2428,7 +3005,7 @@ type Interface_RxMode struct {
 func (x *Interface_RxMode) Reset() {
 	*x = Interface_RxMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2441,7 +3018,7 @@ func (x *Interface_RxMode) String() string {
 func (*Interface_RxMode) ProtoMessage() {}
 
 func (x *Interface_RxMode) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,66 +3026,295 @@ func (x *Interface_RxMode) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_RxMode.ProtoReflect.Descriptor instead.
+func (*Interface_RxMode) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Interface_RxMode) GetQueue() uint32 {
+	if x != nil {
+		return x.Queue
+	}
+	return 0
+}
+
+func (x *Interface_RxMode) GetMode() Interface_RxMode_Type {
+	if x != nil {
+		return x.Mode
+	}
+	return Interface_RxMode_UNKNOWN
+}
+
+func (x *Interface_RxMode) GetDefaultMode() bool {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return false
+}
+
+type Interface_RxPlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Select from interval <0, number-of-queues)
+	Queue uint32 `protobuf:"varint,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Select from interval <0, number-of-workers)
+	Worker uint32 `protobuf:"varint,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	// Let the main thread to process the given queue
+	//  - if enabled, value of <worker> is ignored
+	MainThread bool `protobuf:"varint,3,opt,name=main_thread,json=mainThread,proto3" json:"main_thread,omitempty"`
+}
+
+func (x *Interface_RxPlacement) Reset() {
+	*x = Interface_RxPlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_RxPlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_RxPlacement) ProtoMessage() {}
+
+func (x *Interface_RxPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_RxPlacement.ProtoReflect.Descriptor instead.
+func (*Interface_RxPlacement) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *Interface_RxPlacement) GetQueue() uint32 {
+	if x != nil {
+		return x.Queue
+	}
+	return 0
+}
+
+func (x *Interface_RxPlacement) GetWorker() uint32 {
+	if x != nil {
+		return x.Worker
+	}
+	return 0
+}
+
+func (x *Interface_RxPlacement) GetMainThread() bool {
+	if x != nil {
+		return x.MainThread
+	}
+	return false
+}
+
+// RouterAdvertisement configures IPv6 router advertisements (RA) sent
+// by VPP on the interface. IPv6 is enabled on the interface if it is
+// not enabled yet (i.e. no IPv6 address is assigned).
+//
+// Only the prefix information option is supported. DNS options
+// (RDNSS and DNSSL, RFC 8106) cannot be configured, because the VPP
+// binary API provides no way to set them.
+type Interface_IP6ND_RouterAdvertisement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Suppress sending of router advertisements.
+	Suppress bool `protobuf:"varint,1,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	// Set the managed address configuration flag (M flag).
+	Managed bool `protobuf:"varint,2,opt,name=managed,proto3" json:"managed,omitempty"`
+	// Set the other configuration flag (O flag).
+	Other bool `protobuf:"varint,3,opt,name=other,proto3" json:"other,omitempty"`
+	// Do not include the source link-layer address option.
+	SuppressLinkLayerOption bool `protobuf:"varint,4,opt,name=suppress_link_layer_option,json=suppressLinkLayerOption,proto3" json:"suppress_link_layer_option,omitempty"`
+	// Send unicast router advertisements in response to router solicitations.
+	SendUnicast bool `protobuf:"varint,5,opt,name=send_unicast,json=sendUnicast,proto3" json:"send_unicast,omitempty"`
+	// Cease sending of router advertisements (a final advertisement
+	// with zero router lifetime is sent).
+	Cease bool `protobuf:"varint,6,opt,name=cease,proto3" json:"cease,omitempty"`
+	// Maximum and minimum interval (in seconds) between unsolicited
+	// router advertisements. VPP defaults are used if zero (200 seconds
+	// and 0.75 * max_interval, respectively).
+	MaxInterval uint32 `protobuf:"varint,7,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	MinInterval uint32 `protobuf:"varint,8,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`
+	// Router lifetime (in seconds), it has to be greater than max_interval.
+	// VPP default (3 * max_interval) is used if zero.
+	RouterLifetime uint32 `protobuf:"varint,9,opt,name=router_lifetime,json=routerLifetime,proto3" json:"router_lifetime,omitempty"`
+	// Number of and interval (in seconds) between the initial router
+	// advertisements. VPP defaults are used if zero.
+	InitialCount    uint32                                        `protobuf:"varint,10,opt,name=initial_count,json=initialCount,proto3" json:"initial_count,omitempty"`
+	InitialInterval uint32                                        `protobuf:"varint,11,opt,name=initial_interval,json=initialInterval,proto3" json:"initial_interval,omitempty"`
+	Prefixes        []*Interface_IP6ND_RouterAdvertisement_Prefix `protobuf:"bytes,12,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) Reset() {
+	*x = Interface_IP6ND_RouterAdvertisement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_IP6ND_RouterAdvertisement) ProtoMessage() {}
+
+func (x *Interface_IP6ND_RouterAdvertisement) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_IP6ND_RouterAdvertisement.ProtoReflect.Descriptor instead.
+func (*Interface_IP6ND_RouterAdvertisement) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetSuppress() bool {
+	if x != nil {
+		return x.Suppress
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetOther() bool {
+	if x != nil {
+		return x.Other
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetSuppressLinkLayerOption() bool {
+	if x != nil {
+		return x.SuppressLinkLayerOption
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetSendUnicast() bool {
+	if x != nil {
+		return x.SendUnicast
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetCease() bool {
+	if x != nil {
+		return x.Cease
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetMaxInterval() uint32 {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return 0
 }
 
-// Deprecated: Use Interface_RxMode.ProtoReflect.Descriptor instead.
-func (*Interface_RxMode) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 2}
+func (x *Interface_IP6ND_RouterAdvertisement) GetMinInterval() uint32 {
+	if x != nil {
+		return x.MinInterval
+	}
+	return 0
 }
 
-func (x *Interface_RxMode) GetQueue() uint32 {
+func (x *Interface_IP6ND_RouterAdvertisement) GetRouterLifetime() uint32 {
 	if x != nil {
-		return x.Queue
+		return x.RouterLifetime
 	}
 	return 0
 }
 
-func (x *Interface_RxMode) GetMode() Interface_RxMode_Type {
+func (x *Interface_IP6ND_RouterAdvertisement) GetInitialCount() uint32 {
 	if x != nil {
-		return x.Mode
+		return x.InitialCount
 	}
-	return Interface_RxMode_UNKNOWN
+	return 0
 }
 
-func (x *Interface_RxMode) GetDefaultMode() bool {
+func (x *Interface_IP6ND_RouterAdvertisement) GetInitialInterval() uint32 {
 	if x != nil {
-		return x.DefaultMode
+		return x.InitialInterval
 	}
-	return false
+	return 0
 }
 
-type Interface_RxPlacement struct {
+func (x *Interface_IP6ND_RouterAdvertisement) GetPrefixes() []*Interface_IP6ND_RouterAdvertisement_Prefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+// Prefix defines prefix information option of the router advertisement.
+type Interface_IP6ND_RouterAdvertisement_Prefix struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Select from interval <0, number-of-queues)
-	Queue uint32 `protobuf:"varint,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	// Select from interval <0, number-of-workers)
-	Worker uint32 `protobuf:"varint,2,opt,name=worker,proto3" json:"worker,omitempty"`
-	// Let the main thread to process the given queue
-	//   - if enabled, value of <worker> is ignored
-	MainThread bool `protobuf:"varint,3,opt,name=main_thread,json=mainThread,proto3" json:"main_thread,omitempty"`
-}
-
-func (x *Interface_RxPlacement) Reset() {
-	*x = Interface_RxPlacement{}
+	// IPv6 prefix in the format <address>/<prefix-length>.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Valid and preferred lifetime (in seconds) of the prefix. VPP
+	// defaults (30 and 7 days) are used if both are zero.
+	ValidLifetime     uint32 `protobuf:"varint,2,opt,name=valid_lifetime,json=validLifetime,proto3" json:"valid_lifetime,omitempty"`
+	PreferredLifetime uint32 `protobuf:"varint,3,opt,name=preferred_lifetime,json=preferredLifetime,proto3" json:"preferred_lifetime,omitempty"`
+	// Clear the on-link flag (L flag).
+	OffLink bool `protobuf:"varint,4,opt,name=off_link,json=offLink,proto3" json:"off_link,omitempty"`
+	// Clear the autonomous address-configuration flag (A flag).
+	NoAutoconfig bool `protobuf:"varint,5,opt,name=no_autoconfig,json=noAutoconfig,proto3" json:"no_autoconfig,omitempty"`
+	// Do not advertise the prefix.
+	NoAdvertise bool `protobuf:"varint,6,opt,name=no_advertise,json=noAdvertise,proto3" json:"no_advertise,omitempty"`
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) Reset() {
+	*x = Interface_IP6ND_RouterAdvertisement_Prefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Interface_RxPlacement) String() string {
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface_RxPlacement) ProtoMessage() {}
+func (*Interface_IP6ND_RouterAdvertisement_Prefix) ProtoMessage() {}
 
-func (x *Interface_RxPlacement) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,28 +3325,49 @@ func (x *Interface_RxPlacement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface_RxPlacement.ProtoReflect.Descriptor instead.
-func (*Interface_RxPlacement) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use Interface_IP6ND_RouterAdvertisement_Prefix.ProtoReflect.Descriptor instead.
+func (*Interface_IP6ND_RouterAdvertisement_Prefix) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 0, 0, 0}
 }
 
-func (x *Interface_RxPlacement) GetQueue() uint32 {
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) GetPrefix() string {
 	if x != nil {
-		return x.Queue
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) GetValidLifetime() uint32 {
+	if x != nil {
+		return x.ValidLifetime
 	}
 	return 0
 }
 
-func (x *Interface_RxPlacement) GetWorker() uint32 {
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) GetPreferredLifetime() uint32 {
 	if x != nil {
-		return x.Worker
+		return x.PreferredLifetime
 	}
 	return 0
 }
 
-func (x *Interface_RxPlacement) GetMainThread() bool {
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) GetOffLink() bool {
 	if x != nil {
-		return x.MainThread
+		return x.OffLink
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) GetNoAutoconfig() bool {
+	if x != nil {
+		return x.NoAutoconfig
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement_Prefix) GetNoAdvertise() bool {
+	if x != nil {
+		return x.NoAdvertise
 	}
 	return false
 }
@@ -2559,7 +3386,7 @@ type VxlanLink_Gpe struct {
 func (x *VxlanLink_Gpe) Reset() {
 	*x = VxlanLink_Gpe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2572,7 +3399,7 @@ func (x *VxlanLink_Gpe) String() string {
 func (*VxlanLink_Gpe) ProtoMessage() {}
 
 func (x *VxlanLink_Gpe) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,7 +3442,7 @@ type BondLink_BondedInterface struct {
 func (x *BondLink_BondedInterface) Reset() {
 	*x = BondLink_BondedInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2628,7 +3455,7 @@ func (x *BondLink_BondedInterface) String() string {
 func (*BondLink_BondedInterface) ProtoMessage() {}
 
 func (x *BondLink_BondedInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +3468,7 @@ func (x *BondLink_BondedInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BondLink_BondedInterface.ProtoReflect.Descriptor instead.
 func (*BondLink_BondedInterface) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{8, 0}
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *BondLink_BondedInterface) GetName() string {
@@ -2665,6 +3492,90 @@ func (x *BondLink_BondedInterface) GetIsLongTimeout() bool {
 	return false
 }
 
+type MplsTunnelLink_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Next hop address.
+	NextHopAddr string `protobuf:"bytes,1,opt,name=next_hop_addr,json=nextHopAddr,proto3" json:"next_hop_addr,omitempty"`
+	// Interface name of the outgoing interface.
+	OutgoingInterface string `protobuf:"bytes,2,opt,name=outgoing_interface,json=outgoingInterface,proto3" json:"outgoing_interface,omitempty"`
+	// Labels imposed on the tunnelled traffic (the first one is the outermost).
+	OutLabels []uint32 `protobuf:"varint,3,rep,packed,name=out_labels,json=outLabels,proto3" json:"out_labels,omitempty"`
+	// Weight is used for unequal cost load balancing.
+	Weight uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Preference defines path preference. Lower preference is preferred.
+	Preference uint32 `protobuf:"varint,5,opt,name=preference,proto3" json:"preference,omitempty"`
+}
+
+func (x *MplsTunnelLink_Path) Reset() {
+	*x = MplsTunnelLink_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MplsTunnelLink_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MplsTunnelLink_Path) ProtoMessage() {}
+
+func (x *MplsTunnelLink_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MplsTunnelLink_Path.ProtoReflect.Descriptor instead.
+func (*MplsTunnelLink_Path) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *MplsTunnelLink_Path) GetNextHopAddr() string {
+	if x != nil {
+		return x.NextHopAddr
+	}
+	return ""
+}
+
+func (x *MplsTunnelLink_Path) GetOutgoingInterface() string {
+	if x != nil {
+		return x.OutgoingInterface
+	}
+	return ""
+}
+
+func (x *MplsTunnelLink_Path) GetOutLabels() []uint32 {
+	if x != nil {
+		return x.OutLabels
+	}
+	return nil
+}
+
+func (x *MplsTunnelLink_Path) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *MplsTunnelLink_Path) GetPreference() uint32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
 var File_ligato_vpp_interfaces_interface_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
@@ -2675,7 +3586,7 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63,
 	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
 	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x10, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
 	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
@@ -2695,7 +3606,19 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
 	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
 	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x52, 0x05, 0x69, 0x70,
-	0x36, 0x4e, 0x64, 0x12, 0x1a, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x36, 0x4e, 0x64, 0x12, 0x59, 0x0a, 0x10, 0x64, 0x68, 0x63, 0x70, 0x76, 0x36, 0x5f, 0x70, 0x64,
+	0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e,
+	0x44, 0x48, 0x43, 0x50, 0x76, 0x36, 0x50, 0x44, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0e,
+	0x64, 0x68, 0x63, 0x70, 0x76, 0x36, 0x50, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x63,
+	0x0a, 0x14, 0x69, 0x70, 0x36, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49,
+	0x50, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x12, 0x69, 0x70, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
 	0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0x80, 0x48, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12,
 	0x4b, 0x0a, 0x0a, 0x75, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
@@ -2758,306 +3681,456 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x18, 0x70, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x1a, 0x6c, 0x0a, 0x05,
-	0x49, 0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf, 0x01, 0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-	0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x1a, 0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
-	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45,
-	0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44,
-	0x50, 0x44, 0x4b, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04,
-	0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41,
-	0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50,
-	0x53, 0x45, 0x43, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d, 0x58, 0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47,
-	0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47,
-	0x54, 0x50, 0x55, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b,
-	0x49, 0x50, 0x49, 0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a,
-	0x10, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45,
-	0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x42, 0x06, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59,
-	0x0a, 0x0d, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75,
-	0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61,
-	0x67, 0x52, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73,
-	0x68, 0x5f, 0x64, 0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
-	0x75, 0x73, 0x68, 0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32,
-	0x22, 0x8f, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x50, 0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12,
-	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06,
-	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10,
-	0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32,
-	0x10, 0x08, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x12, 0x3b, 0x0a, 0x06,
+	0x67, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x18, 0x71, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x70, 0x6c,
+	0x73, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x70, 0x70, 0x6f, 0x65, 0x18, 0x74, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x50, 0x50, 0x6f, 0x45,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x70, 0x70, 0x70, 0x6f, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x6c, 0x32, 0x74, 0x70, 0x76, 0x33, 0x18, 0x75, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x32, 0x54, 0x50, 0x76, 0x33, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x06, 0x6c, 0x32, 0x74, 0x70, 0x76, 0x33, 0x1a, 0xb9, 0x07, 0x0a, 0x05, 0x49,
+	0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x02, 0x72, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x02, 0x72, 0x61, 0x1a, 0xfe, 0x05, 0x0a, 0x13, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x75, 0x70,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x73,
+	0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x75,
+	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0x88, 0x0e, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x31, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10,
+	0xa8, 0x46, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0x82, 0x7d, 0x04, 0x12, 0x02,
+	0x10, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0x82, 0x7d, 0x04, 0x12,
+	0x02, 0x10, 0x10, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x5d, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x1a, 0xe0, 0x01, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x06, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x25, 0x0a,
+	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x5f, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x41, 0x64, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x1a, 0x33, 0x0a, 0x0e, 0x44, 0x48, 0x43, 0x50, 0x76, 0x36,
+	0x50, 0x44, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x56, 0x0a, 0x10, 0x49,
+	0x50, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x06, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf, 0x01,
+	0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x40,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55,
+	0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x1a,
+	0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0xf8, 0x02,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55,
+	0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41,
+	0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x50, 0x44, 0x4b, 0x10, 0x03, 0x12, 0x09,
+	0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50,
+	0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10,
+	0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45,
+	0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50, 0x53, 0x45, 0x43, 0x5f, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d, 0x58,
+	0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x09,
+	0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
+	0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x54, 0x50, 0x55, 0x5f, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x50, 0x49, 0x50, 0x5f, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55,
+	0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a, 0x04,
+	0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x45, 0x4e, 0x45, 0x56, 0x45,
+	0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x50, 0x4c,
+	0x53, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x12, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x50,
+	0x50, 0x4f, 0x45, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x13, 0x12, 0x11, 0x0a,
+	0x0d, 0x4c, 0x32, 0x54, 0x50, 0x56, 0x33, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x14,
+	0x22, 0x04, 0x08, 0x11, 0x10, 0x11, 0x2a, 0x10, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x47, 0x42,
+	0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x4a, 0x04, 0x08, 0x72, 0x10, 0x73, 0x52, 0x09, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x5f, 0x67, 0x62,
+	0x70, 0x22, 0x9c, 0x03, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x0d, 0x74, 0x61,
+	0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61, 0x67, 0x52, 0x77, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64, 0x6f,
+	0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x73, 0x68, 0x44,
+	0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x32,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32, 0x12, 0x20, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x49, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x22, 0x8f,
+	0x01, 0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x31,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10, 0x07, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32, 0x10, 0x08,
+	0x22, 0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x3e,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d,
+	0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69, 0x6e, 0x67,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22,
+	0x32, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x50,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43,
+	0x54, 0x10, 0x02, 0x22, 0x97, 0x03, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73,
+	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
 	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x22, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x49, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64,
-	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
-	0x61, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03,
-	0x47, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70,
-	0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6e, 0x69,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6e,
+	0x69, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x1a, 0xb4, 0x01, 0x0a, 0x03, 0x47, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64,
+	0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x40, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e,
+	0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48, 0x10, 0x04, 0x22, 0xd9, 0x01,
+	0x0a, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b,
+	0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
+	0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x03,
+	0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0x7d, 0x07, 0x12, 0x05,
+	0x10, 0xff, 0xff, 0xff, 0x07, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61,
+	0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x33, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6c, 0x33, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52,
+	0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
+	0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xb4, 0x05,
+	0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69,
+	0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x03, 0x65, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x03, 0x65, 0x73, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x61, 0x6e, 0x74,
+	0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x0a, 0x61, 0x6e, 0x74, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x22,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x49, 0x70, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x70, 0x69, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x69, 0x12, 0x3e, 0x0a, 0x0a,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x2c, 0x0a, 0x10,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x11, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x2a, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65,
+	0x79, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x64, 0x70, 0x5f,
+	0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x22,
+	0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x10, 0x01, 0x22, 0x64, 0x0a, 0x0b, 0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c,
+	0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c,
+	0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x45, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42,
+	0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f,
+	0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x3b, 0x0a, 0x02, 0x6c, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c,
+	0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c,
+	0x0a, 0x11, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f,
+	0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x69,
+	0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c,
+	0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55,
+	0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x58, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c,
+	0x41, 0x43, 0x50, 0x10, 0x05, 0x22, 0x3f, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x4c, 0x33, 0x34, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x32, 0x33, 0x10, 0x02, 0x12, 0x06,
+	0x0a, 0x02, 0x52, 0x52, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x43, 0x10, 0x04, 0x12, 0x06,
+	0x0a, 0x02, 0x41, 0x42, 0x10, 0x05, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
 	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x22, 0x40, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34,
-	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45,
-	0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48,
-	0x10, 0x04, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01,
-	0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xb4, 0x05, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
+	0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
+	0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x46, 0x69, 0x62, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x45,
+	0x42, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x22,
+	0xeb, 0x02, 0x0a, 0x08, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08,
+	0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20,
+	0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54,
+	0x65, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70,
+	0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x03,
-	0x65, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x03, 0x65,
-	0x73, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x61, 0x6e, 0x74,
-	0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02,
-	0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x09, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
-	0x70, 0x12, 0x1f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53,
-	0x70, 0x69, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x53, 0x70, 0x69, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f,
-	0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
-	0x18, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41,
-	0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67,
-	0x12, 0x2a, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x55, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49,
-	0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f,
-	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x64, 0x0a,
-	0x0b, 0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x02, 0x6c, 0x62,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42,
-	0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69,
-	0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
-	0x58, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x05, 0x22, 0x3f,
-	0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x06, 0x0a,
-	0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x4c, 0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52, 0x10, 0x03, 0x12,
-	0x06, 0x0a, 0x02, 0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42, 0x10, 0x05, 0x22,
-	0x86, 0x02, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0b, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70,
+	0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65,
+	0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63,
+	0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65,
+	0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49,
+	0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x03, 0x22, 0xca, 0x01,
+	0x0a, 0x08, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64,
 	0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41,
 	0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66,
-	0x69, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x46, 0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c,
-	0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08, 0x47, 0x74, 0x70,
-	0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07,
-	0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
-	0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c,
-	0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x4b,
-	0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64,
-	0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x4c, 0x32, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a,
-	0x03, 0x49, 0x50, 0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50, 0x49, 0x50, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72,
-	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
-	0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08,
-	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
-	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x33,
-	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
-	0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x10, 0x01, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73,
-	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44, 0x4d, 0x41, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21,
-	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x56, 0x10,
-	0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69,
+	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12,
+	0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x73,
+	0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0xa5, 0x02,
+	0x0a, 0x0e, 0x4d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x40, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x32, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x32, 0x4f, 0x6e, 0x6c, 0x79, 0x1a, 0xb7, 0x01, 0x0a, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74,
+	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x50, 0x50, 0x50, 0x6f, 0x45, 0x4c,
+	0x69, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0x82, 0x7d, 0x08, 0x12, 0x06, 0x08, 0x01,
+	0x10, 0xfe, 0xff, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x12, 0x22,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x22,
+	0xbb, 0x02, 0x0a, 0x0a, 0x4c, 0x32, 0x54, 0x50, 0x76, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x03, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x03, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x32, 0x5f, 0x73, 0x75, 0x62, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6c,
+	0x32, 0x53, 0x75, 0x62, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x22, 0xd8, 0x01,
+	0x0a, 0x08, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78,
+	0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78,
+	0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x42, 0x56, 0x10, 0x01,
+	0x12, 0x06, 0x0a, 0x02, 0x44, 0x56, 0x10, 0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3073,82 +4146,100 @@ func file_ligato_vpp_interfaces_interface_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_ligato_vpp_interfaces_interface_proto_goTypes = []interface{}{
-	(Interface_Type)(0),                 // 0: ligato.vpp.interfaces.Interface.Type
-	(Interface_RxMode_Type)(0),          // 1: ligato.vpp.interfaces.Interface.RxMode.Type
-	(SubInterface_TagRewriteOptions)(0), // 2: ligato.vpp.interfaces.SubInterface.TagRewriteOptions
-	(MemifLink_MemifMode)(0),            // 3: ligato.vpp.interfaces.MemifLink.MemifMode
-	(VxlanLink_Gpe_Protocol)(0),         // 4: ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
-	(IPSecLink_Mode)(0),                 // 5: ligato.vpp.interfaces.IPSecLink.Mode
-	(BondLink_Mode)(0),                  // 6: ligato.vpp.interfaces.BondLink.Mode
-	(BondLink_LoadBalance)(0),           // 7: ligato.vpp.interfaces.BondLink.LoadBalance
-	(GreLink_Type)(0),                   // 8: ligato.vpp.interfaces.GreLink.Type
-	(GtpuLink_NextNode)(0),              // 9: ligato.vpp.interfaces.GtpuLink.NextNode
-	(IPIPLink_Mode)(0),                  // 10: ligato.vpp.interfaces.IPIPLink.Mode
-	(RDMALink_Mode)(0),                  // 11: ligato.vpp.interfaces.RDMALink.Mode
-	(*Interface)(nil),                   // 12: ligato.vpp.interfaces.Interface
-	(*SubInterface)(nil),                // 13: ligato.vpp.interfaces.SubInterface
-	(*MemifLink)(nil),                   // 14: ligato.vpp.interfaces.MemifLink
-	(*VxlanLink)(nil),                   // 15: ligato.vpp.interfaces.VxlanLink
-	(*AfpacketLink)(nil),                // 16: ligato.vpp.interfaces.AfpacketLink
-	(*TapLink)(nil),                     // 17: ligato.vpp.interfaces.TapLink
-	(*IPSecLink)(nil),                   // 18: ligato.vpp.interfaces.IPSecLink
-	(*VmxNet3Link)(nil),                 // 19: ligato.vpp.interfaces.VmxNet3Link
-	(*BondLink)(nil),                    // 20: ligato.vpp.interfaces.BondLink
-	(*GreLink)(nil),                     // 21: ligato.vpp.interfaces.GreLink
-	(*GtpuLink)(nil),                    // 22: ligato.vpp.interfaces.GtpuLink
-	(*IPIPLink)(nil),                    // 23: ligato.vpp.interfaces.IPIPLink
-	(*WireguardLink)(nil),               // 24: ligato.vpp.interfaces.WireguardLink
-	(*RDMALink)(nil),                    // 25: ligato.vpp.interfaces.RDMALink
-	(*Interface_IP6ND)(nil),             // 26: ligato.vpp.interfaces.Interface.IP6ND
-	(*Interface_Unnumbered)(nil),        // 27: ligato.vpp.interfaces.Interface.Unnumbered
-	(*Interface_RxMode)(nil),            // 28: ligato.vpp.interfaces.Interface.RxMode
-	(*Interface_RxPlacement)(nil),       // 29: ligato.vpp.interfaces.Interface.RxPlacement
-	(*VxlanLink_Gpe)(nil),               // 30: ligato.vpp.interfaces.VxlanLink.Gpe
-	(*BondLink_BondedInterface)(nil),    // 31: ligato.vpp.interfaces.BondLink.BondedInterface
-	(ipsec.CryptoAlg)(0),                // 32: ligato.vpp.ipsec.CryptoAlg
-	(ipsec.IntegAlg)(0),                 // 33: ligato.vpp.ipsec.IntegAlg
+	(Interface_Type)(0),                                // 0: ligato.vpp.interfaces.Interface.Type
+	(Interface_RxMode_Type)(0),                         // 1: ligato.vpp.interfaces.Interface.RxMode.Type
+	(SubInterface_TagRewriteOptions)(0),                // 2: ligato.vpp.interfaces.SubInterface.TagRewriteOptions
+	(MemifLink_MemifMode)(0),                           // 3: ligato.vpp.interfaces.MemifLink.MemifMode
+	(VxlanLink_Gpe_Protocol)(0),                        // 4: ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
+	(IPSecLink_Mode)(0),                                // 5: ligato.vpp.interfaces.IPSecLink.Mode
+	(BondLink_Mode)(0),                                 // 6: ligato.vpp.interfaces.BondLink.Mode
+	(BondLink_LoadBalance)(0),                          // 7: ligato.vpp.interfaces.BondLink.LoadBalance
+	(GreLink_Type)(0),                                  // 8: ligato.vpp.interfaces.GreLink.Type
+	(GtpuLink_NextNode)(0),                             // 9: ligato.vpp.interfaces.GtpuLink.NextNode
+	(IPIPLink_Mode)(0),                                 // 10: ligato.vpp.interfaces.IPIPLink.Mode
+	(RDMALink_Mode)(0),                                 // 11: ligato.vpp.interfaces.RDMALink.Mode
+	(*Interface)(nil),                                  // 12: ligato.vpp.interfaces.Interface
+	(*SubInterface)(nil),                               // 13: ligato.vpp.interfaces.SubInterface
+	(*MemifLink)(nil),                                  // 14: ligato.vpp.interfaces.MemifLink
+	(*VxlanLink)(nil),                                  // 15: ligato.vpp.interfaces.VxlanLink
+	(*GeneveLink)(nil),                                 // 16: ligato.vpp.interfaces.GeneveLink
+	(*AfpacketLink)(nil),                               // 17: ligato.vpp.interfaces.AfpacketLink
+	(*TapLink)(nil),                                    // 18: ligato.vpp.interfaces.TapLink
+	(*IPSecLink)(nil),                                  // 19: ligato.vpp.interfaces.IPSecLink
+	(*VmxNet3Link)(nil),                                // 20: ligato.vpp.interfaces.VmxNet3Link
+	(*BondLink)(nil),                                   // 21: ligato.vpp.interfaces.BondLink
+	(*GreLink)(nil),                                    // 22: ligato.vpp.interfaces.GreLink
+	(*GtpuLink)(nil),                                   // 23: ligato.vpp.interfaces.GtpuLink
+	(*IPIPLink)(nil),                                   // 24: ligato.vpp.interfaces.IPIPLink
+	(*WireguardLink)(nil),                              // 25: ligato.vpp.interfaces.WireguardLink
+	(*MplsTunnelLink)(nil),                             // 26: ligato.vpp.interfaces.MplsTunnelLink
+	(*PPPoELink)(nil),                                  // 27: ligato.vpp.interfaces.PPPoELink
+	(*L2TPv3Link)(nil),                                 // 28: ligato.vpp.interfaces.L2TPv3Link
+	(*RDMALink)(nil),                                   // 29: ligato.vpp.interfaces.RDMALink
+	(*Interface_IP6ND)(nil),                            // 30: ligato.vpp.interfaces.Interface.IP6ND
+	(*Interface_DHCPv6PDClient)(nil),                   // 31: ligato.vpp.interfaces.Interface.DHCPv6PDClient
+	(*Interface_IP6PrefixAddress)(nil),                 // 32: ligato.vpp.interfaces.Interface.IP6PrefixAddress
+	(*Interface_Unnumbered)(nil),                       // 33: ligato.vpp.interfaces.Interface.Unnumbered
+	(*Interface_RxMode)(nil),                           // 34: ligato.vpp.interfaces.Interface.RxMode
+	(*Interface_RxPlacement)(nil),                      // 35: ligato.vpp.interfaces.Interface.RxPlacement
+	(*Interface_IP6ND_RouterAdvertisement)(nil),        // 36: ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement
+	(*Interface_IP6ND_RouterAdvertisement_Prefix)(nil), // 37: ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement.Prefix
+	(*VxlanLink_Gpe)(nil),                              // 38: ligato.vpp.interfaces.VxlanLink.Gpe
+	(*BondLink_BondedInterface)(nil),                   // 39: ligato.vpp.interfaces.BondLink.BondedInterface
+	(*MplsTunnelLink_Path)(nil),                        // 40: ligato.vpp.interfaces.MplsTunnelLink.Path
+	(ipsec.CryptoAlg)(0),                               // 41: ligato.vpp.ipsec.CryptoAlg
+	(ipsec.IntegAlg)(0),                                // 42: ligato.vpp.ipsec.IntegAlg
 }
 var file_ligato_vpp_interfaces_interface_proto_depIdxs = []int32{
 	0,  // 0: ligato.vpp.interfaces.Interface.type:type_name -> ligato.vpp.interfaces.Interface.Type
-	26, // 1: ligato.vpp.interfaces.Interface.ip6_nd:type_name -> ligato.vpp.interfaces.Interface.IP6ND
-	27, // 2: ligato.vpp.interfaces.Interface.unnumbered:type_name -> ligato.vpp.interfaces.Interface.Unnumbered
-	28, // 3: ligato.vpp.interfaces.Interface.rx_modes:type_name -> ligato.vpp.interfaces.Interface.RxMode
-	29, // 4: ligato.vpp.interfaces.Interface.rx_placements:type_name -> ligato.vpp.interfaces.Interface.RxPlacement
-	13, // 5: ligato.vpp.interfaces.Interface.sub:type_name -> ligato.vpp.interfaces.SubInterface
-	14, // 6: ligato.vpp.interfaces.Interface.memif:type_name -> ligato.vpp.interfaces.MemifLink
-	16, // 7: ligato.vpp.interfaces.Interface.afpacket:type_name -> ligato.vpp.interfaces.AfpacketLink
-	17, // 8: ligato.vpp.interfaces.Interface.tap:type_name -> ligato.vpp.interfaces.TapLink
-	15, // 9: ligato.vpp.interfaces.Interface.vxlan:type_name -> ligato.vpp.interfaces.VxlanLink
-	18, // 10: ligato.vpp.interfaces.Interface.ipsec:type_name -> ligato.vpp.interfaces.IPSecLink
-	19, // 11: ligato.vpp.interfaces.Interface.vmx_net3:type_name -> ligato.vpp.interfaces.VmxNet3Link
-	20, // 12: ligato.vpp.interfaces.Interface.bond:type_name -> ligato.vpp.interfaces.BondLink
-	21, // 13: ligato.vpp.interfaces.Interface.gre:type_name -> ligato.vpp.interfaces.GreLink
-	22, // 14: ligato.vpp.interfaces.Interface.gtpu:type_name -> ligato.vpp.interfaces.GtpuLink
-	23, // 15: ligato.vpp.interfaces.Interface.ipip:type_name -> ligato.vpp.interfaces.IPIPLink
-	24, // 16: ligato.vpp.interfaces.Interface.wireguard:type_name -> ligato.vpp.interfaces.WireguardLink
-	25, // 17: ligato.vpp.interfaces.Interface.rdma:type_name -> ligato.vpp.interfaces.RDMALink
-	2,  // 18: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
-	3,  // 19: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
-	30, // 20: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
-	5,  // 21: ligato.vpp.interfaces.IPSecLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPSecLink.Mode
-	32, // 22: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
-	33, // 23: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
-	6,  // 24: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
-	7,  // 25: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
-	31, // 26: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
-	8,  // 27: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
-	9,  // 28: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
-	10, // 29: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
-	11, // 30: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
-	1,  // 31: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
-	4,  // 32: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	30, // 1: ligato.vpp.interfaces.Interface.ip6_nd:type_name -> ligato.vpp.interfaces.Interface.IP6ND
+	31, // 2: ligato.vpp.interfaces.Interface.dhcpv6_pd_client:type_name -> ligato.vpp.interfaces.Interface.DHCPv6PDClient
+	32, // 3: ligato.vpp.interfaces.Interface.ip6_prefix_addresses:type_name -> ligato.vpp.interfaces.Interface.IP6PrefixAddress
+	33, // 4: ligato.vpp.interfaces.Interface.unnumbered:type_name -> ligato.vpp.interfaces.Interface.Unnumbered
+	34, // 5: ligato.vpp.interfaces.Interface.rx_modes:type_name -> ligato.vpp.interfaces.Interface.RxMode
+	35, // 6: ligato.vpp.interfaces.Interface.rx_placements:type_name -> ligato.vpp.interfaces.Interface.RxPlacement
+	13, // 7: ligato.vpp.interfaces.Interface.sub:type_name -> ligato.vpp.interfaces.SubInterface
+	14, // 8: ligato.vpp.interfaces.Interface.memif:type_name -> ligato.vpp.interfaces.MemifLink
+	17, // 9: ligato.vpp.interfaces.Interface.afpacket:type_name -> ligato.vpp.interfaces.AfpacketLink
+	18, // 10: ligato.vpp.interfaces.Interface.tap:type_name -> ligato.vpp.interfaces.TapLink
+	15, // 11: ligato.vpp.interfaces.Interface.vxlan:type_name -> ligato.vpp.interfaces.VxlanLink
+	19, // 12: ligato.vpp.interfaces.Interface.ipsec:type_name -> ligato.vpp.interfaces.IPSecLink
+	20, // 13: ligato.vpp.interfaces.Interface.vmx_net3:type_name -> ligato.vpp.interfaces.VmxNet3Link
+	21, // 14: ligato.vpp.interfaces.Interface.bond:type_name -> ligato.vpp.interfaces.BondLink
+	22, // 15: ligato.vpp.interfaces.Interface.gre:type_name -> ligato.vpp.interfaces.GreLink
+	23, // 16: ligato.vpp.interfaces.Interface.gtpu:type_name -> ligato.vpp.interfaces.GtpuLink
+	24, // 17: ligato.vpp.interfaces.Interface.ipip:type_name -> ligato.vpp.interfaces.IPIPLink
+	25, // 18: ligato.vpp.interfaces.Interface.wireguard:type_name -> ligato.vpp.interfaces.WireguardLink
+	29, // 19: ligato.vpp.interfaces.Interface.rdma:type_name -> ligato.vpp.interfaces.RDMALink
+	16, // 20: ligato.vpp.interfaces.Interface.geneve:type_name -> ligato.vpp.interfaces.GeneveLink
+	26, // 21: ligato.vpp.interfaces.Interface.mpls_tunnel:type_name -> ligato.vpp.interfaces.MplsTunnelLink
+	27, // 22: ligato.vpp.interfaces.Interface.pppoe:type_name -> ligato.vpp.interfaces.PPPoELink
+	28, // 23: ligato.vpp.interfaces.Interface.l2tpv3:type_name -> ligato.vpp.interfaces.L2TPv3Link
+	2,  // 24: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
+	3,  // 25: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
+	38, // 26: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
+	5,  // 27: ligato.vpp.interfaces.IPSecLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPSecLink.Mode
+	41, // 28: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
+	42, // 29: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
+	6,  // 30: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
+	7,  // 31: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
+	39, // 32: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
+	8,  // 33: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
+	9,  // 34: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
+	10, // 35: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
+	40, // 36: ligato.vpp.interfaces.MplsTunnelLink.paths:type_name -> ligato.vpp.interfaces.MplsTunnelLink.Path
+	11, // 37: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
+	36, // 38: ligato.vpp.interfaces.Interface.IP6ND.ra:type_name -> ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement
+	1,  // 39: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
+	37, // 40: ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement.prefixes:type_name -> ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement.Prefix
+	4,  // 41: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
+	42, // [42:42] is the sub-list for method output_type
+	42, // [42:42] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_interfaces_interface_proto_init() }
@@ -3206,7 +4297,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AfpacketLink); i {
+			switch v := v.(*GeneveLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3218,7 +4309,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapLink); i {
+			switch v := v.(*AfpacketLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3230,7 +4321,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPSecLink); i {
+			switch v := v.(*TapLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3242,7 +4333,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VmxNet3Link); i {
+			switch v := v.(*IPSecLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3254,7 +4345,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BondLink); i {
+			switch v := v.(*VmxNet3Link); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3266,7 +4357,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GreLink); i {
+			switch v := v.(*BondLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3278,7 +4369,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GtpuLink); i {
+			switch v := v.(*GreLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3290,7 +4381,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPIPLink); i {
+			switch v := v.(*GtpuLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3302,7 +4393,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WireguardLink); i {
+			switch v := v.(*IPIPLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3314,7 +4405,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RDMALink); i {
+			switch v := v.(*WireguardLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3326,7 +4417,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_IP6ND); i {
+			switch v := v.(*MplsTunnelLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3338,7 +4429,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_Unnumbered); i {
+			switch v := v.(*PPPoELink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3350,7 +4441,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_RxMode); i {
+			switch v := v.(*L2TPv3Link); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3362,7 +4453,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_RxPlacement); i {
+			switch v := v.(*RDMALink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3374,7 +4465,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VxlanLink_Gpe); i {
+			switch v := v.(*Interface_IP6ND); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3386,6 +4477,102 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_DHCPv6PDClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_IP6PrefixAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_Unnumbered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_RxMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_RxPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_IP6ND_RouterAdvertisement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_IP6ND_RouterAdvertisement_Prefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanLink_Gpe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BondLink_BondedInterface); i {
 			case 0:
 				return &v.state
@@ -3397,6 +4584,18 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MplsTunnelLink_Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_ligato_vpp_interfaces_interface_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Interface_Sub)(nil),
@@ -3412,6 +4611,10 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 		(*Interface_Ipip)(nil),
 		(*Interface_Wireguard)(nil),
 		(*Interface_Rdma)(nil),
+		(*Interface_Geneve)(nil),
+		(*Interface_MplsTunnel)(nil),
+		(*Interface_Pppoe)(nil),
+		(*Interface_L2Tpv3)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3419,7 +4622,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_interfaces_interface_proto_rawDesc,
 			NumEnums:      12,
-			NumMessages:   20,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -140,6 +140,20 @@ const (
 	DHCPLeaseKeyPrefix = "vpp/interface/dhcp-lease/"
 )
 
+/* DHCPv6 prefix delegation (client - derived) */
+const (
+	// DHCPv6PDClientKeyPrefix is used as a common prefix for keys derived from
+	// interfaces to represent enabled DHCPv6 prefix delegation clients.
+	DHCPv6PDClientKeyPrefix = "vpp/interface/dhcpv6-pd-client/"
+)
+
+/* IPv6 address from delegated prefix (derived) */
+const (
+	// ip6PrefixAddressKeyTemplate is a template for (derived) key representing
+	// IPv6 address assigned to interface from a delegated prefix group.
+	ip6PrefixAddressKeyTemplate = "vpp/interface/{iface}/ip6-prefix-address/{prefix-group}/{address}"
+)
+
 /* Interface Link State */
 
 const (
@@ -508,6 +522,73 @@ func ParseNameFromDHCPLeaseKey(key string) (iface string, isDHCPLeaseKey bool) {
 	return
 }
 
+/* DHCPv6 prefix delegation (client - derived) */
+
+// DHCPv6PDClientKey returns a (derived) key used to represent enabled
+// DHCPv6 prefix delegation client.
+func DHCPv6PDClientKey(iface string) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	return DHCPv6PDClientKeyPrefix + iface
+}
+
+// ParseNameFromDHCPv6PDClientKey returns suffix of the key.
+func ParseNameFromDHCPv6PDClientKey(key string) (iface string, isDHCPv6PDClientKey bool) {
+	if suffix := strings.TrimPrefix(key, DHCPv6PDClientKeyPrefix); suffix != key && suffix != "" {
+		return suffix, true
+	}
+	return
+}
+
+/* IPv6 address from delegated prefix (derived) */
+
+// IP6PrefixAddressKey returns key representing IPv6 address assigned to VPP
+// interface from the given delegated prefix group.
+func IP6PrefixAddressKey(iface, prefixGroup, address string) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	if prefixGroup == "" {
+		prefixGroup = InvalidKeyPart
+	}
+	if address == "" {
+		address = InvalidKeyPart
+	}
+	key := strings.Replace(ip6PrefixAddressKeyTemplate, "{iface}", iface, 1)
+	key = strings.Replace(key, "{prefix-group}", prefixGroup, 1)
+	key = strings.Replace(key, "{address}", address, 1)
+	return key
+}
+
+// ParseIP6PrefixAddressKey parses key representing IPv6 address assigned to VPP
+// interface from a delegated prefix group.
+func ParseIP6PrefixAddressKey(key string) (iface, prefixGroup, address string, isIP6PrefixAddressKey bool) {
+	if suffix := strings.TrimPrefix(key, "vpp/interface/"); suffix != key {
+		parts := strings.Split(suffix, "/")
+		prefixAddr := -1
+		for i, part := range parts {
+			if part == "ip6-prefix-address" {
+				prefixAddr = i
+			}
+		}
+		// address is expected in the format <address>/<prefix-length>
+		if prefixAddr < 1 || prefixAddr != len(parts)-4 {
+			return
+		}
+
+		// beware: interface name may contain forward slashes
+		iface = strings.Join(parts[:prefixAddr], "/")
+		prefixGroup = parts[prefixAddr+1]
+		address = strings.Join(parts[prefixAddr+2:], "/")
+		if iface == InvalidKeyPart || prefixGroup == "" || prefixGroup == InvalidKeyPart {
+			return "", "", "", false
+		}
+		isIP6PrefixAddressKey = true
+	}
+	return
+}
+
 /* Link State (notification) */
 
 // LinkStateKey returns key representing link state of a VPP interface.
@@ -914,6 +914,187 @@ func TestDHCPLeaseKey(t *testing.T) {
 	}
 }
 
+func TestDHCPv6PDClientKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		expectedKey string
+	}{
+		{
+			name:        "valid interface name",
+			iface:       "memif0",
+			expectedKey: "vpp/interface/dhcpv6-pd-client/memif0",
+		},
+		{
+			name:        "invalid interface name",
+			iface:       "",
+			expectedKey: "vpp/interface/dhcpv6-pd-client/<invalid>",
+		},
+		{
+			name:        "Gbe interface",
+			iface:       "GigabitEthernet0/8/0",
+			expectedKey: "vpp/interface/dhcpv6-pd-client/GigabitEthernet0/8/0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := DHCPv6PDClientKey(test.iface)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseNameFromDHCPv6PDClientKey(t *testing.T) {
+	tests := []struct {
+		name                        string
+		key                         string
+		expectedIface               string
+		expectedIsDHCPv6PDClientKey bool
+	}{
+		{
+			name:                        "valid interface name",
+			key:                         "vpp/interface/dhcpv6-pd-client/memif0",
+			expectedIface:               "memif0",
+			expectedIsDHCPv6PDClientKey: true,
+		},
+		{
+			name:                        "Gbe interface",
+			key:                         "vpp/interface/dhcpv6-pd-client/GigabitEthernet0/8/0",
+			expectedIface:               "GigabitEthernet0/8/0",
+			expectedIsDHCPv6PDClientKey: true,
+		},
+		{
+			name:                        "not DHCPv6 PD client key",
+			key:                         "vpp/interface/dhcp-client/memif0",
+			expectedIface:               "",
+			expectedIsDHCPv6PDClientKey: false,
+		},
+		{
+			name:                        "not DHCPv6 PD client key (empty interface)",
+			key:                         "vpp/interface/dhcpv6-pd-client/",
+			expectedIface:               "",
+			expectedIsDHCPv6PDClientKey: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, isDHCPv6PDClientKey := ParseNameFromDHCPv6PDClientKey(test.key)
+			if isDHCPv6PDClientKey != test.expectedIsDHCPv6PDClientKey {
+				t.Errorf("expected isDHCPv6PDClientKey: %v\tgot: %v", test.expectedIsDHCPv6PDClientKey, isDHCPv6PDClientKey)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected iface: %s\tgot: %s", test.expectedIface, iface)
+			}
+		})
+	}
+}
+
+func TestIP6PrefixAddressKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		prefixGroup string
+		address     string
+		expectedKey string
+	}{
+		{
+			name:        "valid address",
+			iface:       "memif0",
+			prefixGroup: "wan",
+			address:     "::1:0:0:0:1/64",
+			expectedKey: "vpp/interface/memif0/ip6-prefix-address/wan/::1:0:0:0:1/64",
+		},
+		{
+			name:        "Gbe interface",
+			iface:       "GigabitEthernet0/8/0",
+			prefixGroup: "wan",
+			address:     "::1/64",
+			expectedKey: "vpp/interface/GigabitEthernet0/8/0/ip6-prefix-address/wan/::1/64",
+		},
+		{
+			name:        "invalid interface and prefix group",
+			iface:       "",
+			prefixGroup: "",
+			address:     "::1/64",
+			expectedKey: "vpp/interface/<invalid>/ip6-prefix-address/<invalid>/::1/64",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := IP6PrefixAddressKey(test.iface, test.prefixGroup, test.address)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s prefixGroup=%s address=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.prefixGroup, test.address, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseIP6PrefixAddressKey(t *testing.T) {
+	tests := []struct {
+		name                    string
+		key                     string
+		expectedIface           string
+		expectedPrefixGroup     string
+		expectedAddress         string
+		expectedIsPrefixAddrKey bool
+	}{
+		{
+			name:                    "valid key",
+			key:                     "vpp/interface/memif0/ip6-prefix-address/wan/::1:0:0:0:1/64",
+			expectedIface:           "memif0",
+			expectedPrefixGroup:     "wan",
+			expectedAddress:         "::1:0:0:0:1/64",
+			expectedIsPrefixAddrKey: true,
+		},
+		{
+			name:                    "Gbe interface",
+			key:                     "vpp/interface/GigabitEthernet0/8/0/ip6-prefix-address/wan/::1/64",
+			expectedIface:           "GigabitEthernet0/8/0",
+			expectedPrefixGroup:     "wan",
+			expectedAddress:         "::1/64",
+			expectedIsPrefixAddrKey: true,
+		},
+		{
+			name:                    "invalid interface",
+			key:                     "vpp/interface/<invalid>/ip6-prefix-address/wan/::1/64",
+			expectedIsPrefixAddrKey: false,
+		},
+		{
+			name:                    "missing prefix length",
+			key:                     "vpp/interface/memif0/ip6-prefix-address/wan/::1",
+			expectedIsPrefixAddrKey: false,
+		},
+		{
+			name:                    "interface address key",
+			key:                     "vpp/interface/memif0/address/static/2001:db8::1/64",
+			expectedIsPrefixAddrKey: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, prefixGroup, address, isPrefixAddrKey := ParseIP6PrefixAddressKey(test.key)
+			if isPrefixAddrKey != test.expectedIsPrefixAddrKey {
+				t.Errorf("expected isIP6PrefixAddressKey: %v\tgot: %v", test.expectedIsPrefixAddrKey, isPrefixAddrKey)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected iface: %s\tgot: %s", test.expectedIface, iface)
+			}
+			if prefixGroup != test.expectedPrefixGroup {
+				t.Errorf("expected prefix group: %s\tgot: %s", test.expectedPrefixGroup, prefixGroup)
+			}
+			if address != test.expectedAddress {
+				t.Errorf("expected address: %s\tgot: %s", test.expectedAddress, address)
+			}
+		})
+	}
+}
+
 func TestLinkStateKey(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -310,6 +310,343 @@ func (SecurityAssociation_IPSecProtocol) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type SecurityAssociationNotification_Event int32
+
+const (
+	SecurityAssociationNotification_SOFT_LIFETIME_EXPIRED SecurityAssociationNotification_Event = 0
+	SecurityAssociationNotification_HARD_LIFETIME_EXPIRED SecurityAssociationNotification_Event = 1
+)
+
+// Enum value maps for SecurityAssociationNotification_Event.
+var (
+	SecurityAssociationNotification_Event_name = map[int32]string{
+		0: "SOFT_LIFETIME_EXPIRED",
+		1: "HARD_LIFETIME_EXPIRED",
+	}
+	SecurityAssociationNotification_Event_value = map[string]int32{
+		"SOFT_LIFETIME_EXPIRED": 0,
+		"HARD_LIFETIME_EXPIRED": 1,
+	}
+)
+
+func (x SecurityAssociationNotification_Event) Enum() *SecurityAssociationNotification_Event {
+	p := new(SecurityAssociationNotification_Event)
+	*p = x
+	return p
+}
+
+func (x SecurityAssociationNotification_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityAssociationNotification_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ipsec_proto_enumTypes[5].Descriptor()
+}
+
+func (SecurityAssociationNotification_Event) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ipsec_proto_enumTypes[5]
+}
+
+func (x SecurityAssociationNotification_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecurityAssociationNotification_Event.Descriptor instead.
+func (SecurityAssociationNotification_Event) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type IPSecGlobal_AsyncDispatchMode int32
+
+const (
+	IPSecGlobal_POLLING   IPSecGlobal_AsyncDispatchMode = 0
+	IPSecGlobal_INTERRUPT IPSecGlobal_AsyncDispatchMode = 1
+)
+
+// Enum value maps for IPSecGlobal_AsyncDispatchMode.
+var (
+	IPSecGlobal_AsyncDispatchMode_name = map[int32]string{
+		0: "POLLING",
+		1: "INTERRUPT",
+	}
+	IPSecGlobal_AsyncDispatchMode_value = map[string]int32{
+		"POLLING":   0,
+		"INTERRUPT": 1,
+	}
+)
+
+func (x IPSecGlobal_AsyncDispatchMode) Enum() *IPSecGlobal_AsyncDispatchMode {
+	p := new(IPSecGlobal_AsyncDispatchMode)
+	*p = x
+	return p
+}
+
+func (x IPSecGlobal_AsyncDispatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IPSecGlobal_AsyncDispatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ipsec_proto_enumTypes[6].Descriptor()
+}
+
+func (IPSecGlobal_AsyncDispatchMode) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ipsec_proto_enumTypes[6]
+}
+
+func (x IPSecGlobal_AsyncDispatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IPSecGlobal_AsyncDispatchMode.Descriptor instead.
+func (IPSecGlobal_AsyncDispatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type IPSecGlobal_CryptoHandler_OpClass int32
+
+const (
+	IPSecGlobal_CryptoHandler_SIMPLE  IPSecGlobal_CryptoHandler_OpClass = 0
+	IPSecGlobal_CryptoHandler_CHAINED IPSecGlobal_CryptoHandler_OpClass = 1
+	IPSecGlobal_CryptoHandler_BOTH    IPSecGlobal_CryptoHandler_OpClass = 2
+)
+
+// Enum value maps for IPSecGlobal_CryptoHandler_OpClass.
+var (
+	IPSecGlobal_CryptoHandler_OpClass_name = map[int32]string{
+		0: "SIMPLE",
+		1: "CHAINED",
+		2: "BOTH",
+	}
+	IPSecGlobal_CryptoHandler_OpClass_value = map[string]int32{
+		"SIMPLE":  0,
+		"CHAINED": 1,
+		"BOTH":    2,
+	}
+)
+
+func (x IPSecGlobal_CryptoHandler_OpClass) Enum() *IPSecGlobal_CryptoHandler_OpClass {
+	p := new(IPSecGlobal_CryptoHandler_OpClass)
+	*p = x
+	return p
+}
+
+func (x IPSecGlobal_CryptoHandler_OpClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IPSecGlobal_CryptoHandler_OpClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ipsec_proto_enumTypes[7].Descriptor()
+}
+
+func (IPSecGlobal_CryptoHandler_OpClass) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ipsec_proto_enumTypes[7]
+}
+
+func (x IPSecGlobal_CryptoHandler_OpClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IPSecGlobal_CryptoHandler_OpClass.Descriptor instead.
+func (IPSecGlobal_CryptoHandler_OpClass) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{4, 0, 0}
+}
+
+// Diffie-Hellman group numbers as assigned by IANA.
+type IKEv2Profile_DHGroup int32
+
+const (
+	IKEv2Profile_DH_NONE       IKEv2Profile_DHGroup = 0
+	IKEv2Profile_MODP_768      IKEv2Profile_DHGroup = 1
+	IKEv2Profile_MODP_1024     IKEv2Profile_DHGroup = 2
+	IKEv2Profile_MODP_1536     IKEv2Profile_DHGroup = 5
+	IKEv2Profile_MODP_2048     IKEv2Profile_DHGroup = 14
+	IKEv2Profile_MODP_3072     IKEv2Profile_DHGroup = 15
+	IKEv2Profile_MODP_4096     IKEv2Profile_DHGroup = 16
+	IKEv2Profile_MODP_6144     IKEv2Profile_DHGroup = 17
+	IKEv2Profile_MODP_8192     IKEv2Profile_DHGroup = 18
+	IKEv2Profile_ECP_256       IKEv2Profile_DHGroup = 19
+	IKEv2Profile_ECP_384       IKEv2Profile_DHGroup = 20
+	IKEv2Profile_ECP_521       IKEv2Profile_DHGroup = 21
+	IKEv2Profile_MODP_1024_160 IKEv2Profile_DHGroup = 22
+	IKEv2Profile_MODP_2048_224 IKEv2Profile_DHGroup = 23
+	IKEv2Profile_MODP_2048_256 IKEv2Profile_DHGroup = 24
+	IKEv2Profile_ECP_192       IKEv2Profile_DHGroup = 25
+	IKEv2Profile_ECP_224       IKEv2Profile_DHGroup = 26
+)
+
+// Enum value maps for IKEv2Profile_DHGroup.
+var (
+	IKEv2Profile_DHGroup_name = map[int32]string{
+		0:  "DH_NONE",
+		1:  "MODP_768",
+		2:  "MODP_1024",
+		5:  "MODP_1536",
+		14: "MODP_2048",
+		15: "MODP_3072",
+		16: "MODP_4096",
+		17: "MODP_6144",
+		18: "MODP_8192",
+		19: "ECP_256",
+		20: "ECP_384",
+		21: "ECP_521",
+		22: "MODP_1024_160",
+		23: "MODP_2048_224",
+		24: "MODP_2048_256",
+		25: "ECP_192",
+		26: "ECP_224",
+	}
+	IKEv2Profile_DHGroup_value = map[string]int32{
+		"DH_NONE":       0,
+		"MODP_768":      1,
+		"MODP_1024":     2,
+		"MODP_1536":     5,
+		"MODP_2048":     14,
+		"MODP_3072":     15,
+		"MODP_4096":     16,
+		"MODP_6144":     17,
+		"MODP_8192":     18,
+		"ECP_256":       19,
+		"ECP_384":       20,
+		"ECP_521":       21,
+		"MODP_1024_160": 22,
+		"MODP_2048_224": 23,
+		"MODP_2048_256": 24,
+		"ECP_192":       25,
+		"ECP_224":       26,
+	}
+)
+
+func (x IKEv2Profile_DHGroup) Enum() *IKEv2Profile_DHGroup {
+	p := new(IKEv2Profile_DHGroup)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_DHGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_DHGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ipsec_proto_enumTypes[8].Descriptor()
+}
+
+func (IKEv2Profile_DHGroup) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ipsec_proto_enumTypes[8]
+}
+
+func (x IKEv2Profile_DHGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_DHGroup.Descriptor instead.
+func (IKEv2Profile_DHGroup) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type IKEv2Profile_Authentication_Method int32
+
+const (
+	IKEv2Profile_Authentication_SHARED_KEY    IKEv2Profile_Authentication_Method = 0 // Pre-shared key
+	IKEv2Profile_Authentication_RSA_SIGNATURE IKEv2Profile_Authentication_Method = 1 // RSA digital signature with certificates
+)
+
+// Enum value maps for IKEv2Profile_Authentication_Method.
+var (
+	IKEv2Profile_Authentication_Method_name = map[int32]string{
+		0: "SHARED_KEY",
+		1: "RSA_SIGNATURE",
+	}
+	IKEv2Profile_Authentication_Method_value = map[string]int32{
+		"SHARED_KEY":    0,
+		"RSA_SIGNATURE": 1,
+	}
+)
+
+func (x IKEv2Profile_Authentication_Method) Enum() *IKEv2Profile_Authentication_Method {
+	p := new(IKEv2Profile_Authentication_Method)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Authentication_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Authentication_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ipsec_proto_enumTypes[9].Descriptor()
+}
+
+func (IKEv2Profile_Authentication_Method) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ipsec_proto_enumTypes[9]
+}
+
+func (x IKEv2Profile_Authentication_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Authentication_Method.Descriptor instead.
+func (IKEv2Profile_Authentication_Method) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 0, 0}
+}
+
+type IKEv2Profile_Identity_Type int32
+
+const (
+	IKEv2Profile_Identity_UNDEFINED_ID IKEv2Profile_Identity_Type = 0
+	IKEv2Profile_Identity_IPV4_ADDR    IKEv2Profile_Identity_Type = 1
+	IKEv2Profile_Identity_FQDN         IKEv2Profile_Identity_Type = 2
+	IKEv2Profile_Identity_RFC822_ADDR  IKEv2Profile_Identity_Type = 3
+	IKEv2Profile_Identity_IPV6_ADDR    IKEv2Profile_Identity_Type = 5
+	IKEv2Profile_Identity_KEY_ID       IKEv2Profile_Identity_Type = 11
+)
+
+// Enum value maps for IKEv2Profile_Identity_Type.
+var (
+	IKEv2Profile_Identity_Type_name = map[int32]string{
+		0:  "UNDEFINED_ID",
+		1:  "IPV4_ADDR",
+		2:  "FQDN",
+		3:  "RFC822_ADDR",
+		5:  "IPV6_ADDR",
+		11: "KEY_ID",
+	}
+	IKEv2Profile_Identity_Type_value = map[string]int32{
+		"UNDEFINED_ID": 0,
+		"IPV4_ADDR":    1,
+		"FQDN":         2,
+		"RFC822_ADDR":  3,
+		"IPV6_ADDR":    5,
+		"KEY_ID":       11,
+	}
+)
+
+func (x IKEv2Profile_Identity_Type) Enum() *IKEv2Profile_Identity_Type {
+	p := new(IKEv2Profile_Identity_Type)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Identity_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Identity_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ipsec_proto_enumTypes[10].Descriptor()
+}
+
+func (IKEv2Profile_Identity_Type) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ipsec_proto_enumTypes[10]
+}
+
+func (x IKEv2Profile_Identity_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Identity_Type.Descriptor instead.
+func (IKEv2Profile_Identity_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 1, 0}
+}
+
 // Security Policy Database (SPD)
 type SecurityPolicyDatabase struct {
 	state         protoimpl.MessageState
@@ -537,8 +874,10 @@ type SecurityAssociation struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index          uint32                            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Numerical security association index, serves as a unique identifier
-	Spi            uint32                            `protobuf:"varint,2,opt,name=spi,proto3" json:"spi,omitempty"`     // Security parameter index
+	// Numerical security association index, serves as a unique identifier.
+	// Indexes with the highest bit set are used by VPP for SAs negotiated by IKEv2.
+	Index          uint32                            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Spi            uint32                            `protobuf:"varint,2,opt,name=spi,proto3" json:"spi,omitempty"` // Security parameter index
 	Protocol       SecurityAssociation_IPSecProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=ligato.vpp.ipsec.SecurityAssociation_IPSecProtocol" json:"protocol,omitempty"`
 	CryptoAlg      CryptoAlg                         `protobuf:"varint,4,opt,name=crypto_alg,json=cryptoAlg,proto3,enum=ligato.vpp.ipsec.CryptoAlg" json:"crypto_alg,omitempty"` // Cryptographic algorithm for encryption
 	CryptoKey      string                            `protobuf:"bytes,5,opt,name=crypto_key,json=cryptoKey,proto3" json:"crypto_key,omitempty"`
@@ -550,8 +889,20 @@ type SecurityAssociation struct {
 	TunnelSrcAddr  string                            `protobuf:"bytes,10,opt,name=tunnel_src_addr,json=tunnelSrcAddr,proto3" json:"tunnel_src_addr,omitempty"`
 	TunnelDstAddr  string                            `protobuf:"bytes,11,opt,name=tunnel_dst_addr,json=tunnelDstAddr,proto3" json:"tunnel_dst_addr,omitempty"`
 	EnableUdpEncap bool                              `protobuf:"varint,12,opt,name=enable_udp_encap,json=enableUdpEncap,proto3" json:"enable_udp_encap,omitempty"` // Enable UDP encapsulation for NAT traversal
-	TunnelSrcPort  uint32                            `protobuf:"varint,13,opt,name=tunnel_src_port,json=tunnelSrcPort,proto3" json:"tunnel_src_port,omitempty"`
-	TunnelDstPort  uint32                            `protobuf:"varint,14,opt,name=tunnel_dst_port,json=tunnelDstPort,proto3" json:"tunnel_dst_port,omitempty"`
+	// UDP source and destination ports used with enable_udp_encap
+	// (0 means the default port 4500).
+	TunnelSrcPort uint32 `protobuf:"varint,13,opt,name=tunnel_src_port,json=tunnelSrcPort,proto3" json:"tunnel_src_port,omitempty"`
+	TunnelDstPort uint32 `protobuf:"varint,14,opt,name=tunnel_dst_port,json=tunnelDstPort,proto3" json:"tunnel_dst_port,omitempty"`
+	// Size of the anti-replay window in packets, used with use_anti_replay.
+	// 0 means the VPP default. VPP up to 23.06 supports only the fixed
+	// window of 64 packets.
+	AntiReplayWindowSize uint32 `protobuf:"varint,16,opt,name=anti_replay_window_size,json=antiReplayWindowSize,proto3" json:"anti_replay_window_size,omitempty"`
+	// VPP does not expire SAs on its own. The agent watches the SA counters
+	// and age and sends SecurityAssociationNotification once the soft or hard
+	// lifetime is reached, so that the controller can replace the SA (rekey).
+	// The expired SA is not removed by the agent.
+	SoftLifetime *SecurityAssociation_Lifetime `protobuf:"bytes,17,opt,name=soft_lifetime,json=softLifetime,proto3" json:"soft_lifetime,omitempty"`
+	HardLifetime *SecurityAssociation_Lifetime `protobuf:"bytes,18,opt,name=hard_lifetime,json=hardLifetime,proto3" json:"hard_lifetime,omitempty"`
 }
 
 func (x *SecurityAssociation) Reset() {
@@ -691,25 +1042,45 @@ func (x *SecurityAssociation) GetTunnelDstPort() uint32 {
 	return 0
 }
 
-// TunnelProtection allows enabling IPSec tunnel protection on an existing interface
-// (only IPIP tunnel interfaces are currently supported)
-type TunnelProtection struct {
+func (x *SecurityAssociation) GetAntiReplayWindowSize() uint32 {
+	if x != nil {
+		return x.AntiReplayWindowSize
+	}
+	return 0
+}
+
+func (x *SecurityAssociation) GetSoftLifetime() *SecurityAssociation_Lifetime {
+	if x != nil {
+		return x.SoftLifetime
+	}
+	return nil
+}
+
+func (x *SecurityAssociation) GetHardLifetime() *SecurityAssociation_Lifetime {
+	if x != nil {
+		return x.HardLifetime
+	}
+	return nil
+}
+
+// SecurityAssociationNotification is sent when a security association reaches
+// its soft or hard lifetime.
+type SecurityAssociationNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of the interface to be protected with IPSec.
-	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
-	// Outbound security associations identified by SA index.
-	SaOut []uint32 `protobuf:"varint,2,rep,packed,name=sa_out,json=saOut,proto3" json:"sa_out,omitempty"`
-	// Inbound security associations identified by SA index.
-	SaIn []uint32 `protobuf:"varint,3,rep,packed,name=sa_in,json=saIn,proto3" json:"sa_in,omitempty"`
-	// (Optional) Next hop IP address, used for multipoint tunnels.
-	NextHopAddr string `protobuf:"bytes,4,opt,name=next_hop_addr,json=nextHopAddr,proto3" json:"next_hop_addr,omitempty"`
+	Index uint32                                `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Security association index
+	Spi   uint32                                `protobuf:"varint,2,opt,name=spi,proto3" json:"spi,omitempty"`
+	Event SecurityAssociationNotification_Event `protobuf:"varint,3,opt,name=event,proto3,enum=ligato.vpp.ipsec.SecurityAssociationNotification_Event" json:"event,omitempty"`
+	// SA counters at the moment of the expiry.
+	Bytes      uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Packets    uint64 `protobuf:"varint,5,opt,name=packets,proto3" json:"packets,omitempty"`
+	AgeSeconds uint64 `protobuf:"varint,6,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
 }
 
-func (x *TunnelProtection) Reset() {
-	*x = TunnelProtection{}
+func (x *SecurityAssociationNotification) Reset() {
+	*x = SecurityAssociationNotification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,13 +1088,13 @@ func (x *TunnelProtection) Reset() {
 	}
 }
 
-func (x *TunnelProtection) String() string {
+func (x *SecurityAssociationNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelProtection) ProtoMessage() {}
+func (*SecurityAssociationNotification) ProtoMessage() {}
 
-func (x *TunnelProtection) ProtoReflect() protoreflect.Message {
+func (x *SecurityAssociationNotification) ProtoReflect() protoreflect.Message {
 	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,49 +1106,69 @@ func (x *TunnelProtection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelProtection.ProtoReflect.Descriptor instead.
-func (*TunnelProtection) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecurityAssociationNotification.ProtoReflect.Descriptor instead.
+func (*SecurityAssociationNotification) Descriptor() ([]byte, []int) {
 	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TunnelProtection) GetInterface() string {
+func (x *SecurityAssociationNotification) GetIndex() uint32 {
 	if x != nil {
-		return x.Interface
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *TunnelProtection) GetSaOut() []uint32 {
+func (x *SecurityAssociationNotification) GetSpi() uint32 {
 	if x != nil {
-		return x.SaOut
+		return x.Spi
 	}
-	return nil
+	return 0
 }
 
-func (x *TunnelProtection) GetSaIn() []uint32 {
+func (x *SecurityAssociationNotification) GetEvent() SecurityAssociationNotification_Event {
 	if x != nil {
-		return x.SaIn
+		return x.Event
 	}
-	return nil
+	return SecurityAssociationNotification_SOFT_LIFETIME_EXPIRED
 }
 
-func (x *TunnelProtection) GetNextHopAddr() string {
+func (x *SecurityAssociationNotification) GetBytes() uint64 {
 	if x != nil {
-		return x.NextHopAddr
+		return x.Bytes
 	}
-	return ""
+	return 0
 }
 
-type SecurityPolicyDatabase_Interface struct {
+func (x *SecurityAssociationNotification) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *SecurityAssociationNotification) GetAgeSeconds() uint64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+// IPSecGlobal defines global IPSec settings: crypto engine selection
+// and asynchronous crypto.
+type IPSecGlobal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name of the related interface
+	CryptoHandlers []*IPSecGlobal_CryptoHandler `protobuf:"bytes,1,rep,name=crypto_handlers,json=cryptoHandlers,proto3" json:"crypto_handlers,omitempty"`
+	// Enable asynchronous crypto for IPSec.
+	AsyncMode bool `protobuf:"varint,2,opt,name=async_mode,json=asyncMode,proto3" json:"async_mode,omitempty"`
+	// Dispatch mode of the asynchronous crypto.
+	AsyncDispatchMode IPSecGlobal_AsyncDispatchMode `protobuf:"varint,3,opt,name=async_dispatch_mode,json=asyncDispatchMode,proto3,enum=ligato.vpp.ipsec.IPSecGlobal_AsyncDispatchMode" json:"async_dispatch_mode,omitempty"`
 }
 
-func (x *SecurityPolicyDatabase_Interface) Reset() {
-	*x = SecurityPolicyDatabase_Interface{}
+func (x *IPSecGlobal) Reset() {
+	*x = IPSecGlobal{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,13 +1176,13 @@ func (x *SecurityPolicyDatabase_Interface) Reset() {
 	}
 }
 
-func (x *SecurityPolicyDatabase_Interface) String() string {
+func (x *IPSecGlobal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityPolicyDatabase_Interface) ProtoMessage() {}
+func (*IPSecGlobal) ProtoMessage() {}
 
-func (x *SecurityPolicyDatabase_Interface) ProtoReflect() protoreflect.Message {
+func (x *IPSecGlobal) ProtoReflect() protoreflect.Message {
 	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,55 +1194,899 @@ func (x *SecurityPolicyDatabase_Interface) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityPolicyDatabase_Interface.ProtoReflect.Descriptor instead.
-func (*SecurityPolicyDatabase_Interface) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use IPSecGlobal.ProtoReflect.Descriptor instead.
+func (*IPSecGlobal) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SecurityPolicyDatabase_Interface) GetName() string {
+func (x *IPSecGlobal) GetCryptoHandlers() []*IPSecGlobal_CryptoHandler {
 	if x != nil {
-		return x.Name
+		return x.CryptoHandlers
 	}
-	return ""
+	return nil
 }
 
-type SecurityPolicyDatabase_PolicyEntry struct {
+func (x *IPSecGlobal) GetAsyncMode() bool {
+	if x != nil {
+		return x.AsyncMode
+	}
+	return false
+}
+
+func (x *IPSecGlobal) GetAsyncDispatchMode() IPSecGlobal_AsyncDispatchMode {
+	if x != nil {
+		return x.AsyncDispatchMode
+	}
+	return IPSecGlobal_POLLING
+}
+
+// TunnelProtection allows enabling IPSec tunnel protection on an existing interface
+// (only IPIP tunnel interfaces are currently supported)
+type TunnelProtection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SaIndex         uint32                                    `protobuf:"varint,1,opt,name=sa_index,json=saIndex,proto3" json:"sa_index,omitempty"` // Security association index
-	Priority        int32                                     `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
-	IsOutbound      bool                                      `protobuf:"varint,3,opt,name=is_outbound,json=isOutbound,proto3" json:"is_outbound,omitempty"`
-	RemoteAddrStart string                                    `protobuf:"bytes,4,opt,name=remote_addr_start,json=remoteAddrStart,proto3" json:"remote_addr_start,omitempty"`
-	RemoteAddrStop  string                                    `protobuf:"bytes,5,opt,name=remote_addr_stop,json=remoteAddrStop,proto3" json:"remote_addr_stop,omitempty"`
-	LocalAddrStart  string                                    `protobuf:"bytes,6,opt,name=local_addr_start,json=localAddrStart,proto3" json:"local_addr_start,omitempty"`
-	LocalAddrStop   string                                    `protobuf:"bytes,7,opt,name=local_addr_stop,json=localAddrStop,proto3" json:"local_addr_stop,omitempty"`
-	Protocol        uint32                                    `protobuf:"varint,8,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	RemotePortStart uint32                                    `protobuf:"varint,9,opt,name=remote_port_start,json=remotePortStart,proto3" json:"remote_port_start,omitempty"`
-	RemotePortStop  uint32                                    `protobuf:"varint,10,opt,name=remote_port_stop,json=remotePortStop,proto3" json:"remote_port_stop,omitempty"`
-	LocalPortStart  uint32                                    `protobuf:"varint,11,opt,name=local_port_start,json=localPortStart,proto3" json:"local_port_start,omitempty"`
-	LocalPortStop   uint32                                    `protobuf:"varint,12,opt,name=local_port_stop,json=localPortStop,proto3" json:"local_port_stop,omitempty"`
-	Action          SecurityPolicyDatabase_PolicyEntry_Action `protobuf:"varint,13,opt,name=action,proto3,enum=ligato.vpp.ipsec.SecurityPolicyDatabase_PolicyEntry_Action" json:"action,omitempty"`
+	// Name of the interface to be protected with IPSec.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Outbound security associations identified by SA index.
+	SaOut []uint32 `protobuf:"varint,2,rep,packed,name=sa_out,json=saOut,proto3" json:"sa_out,omitempty"`
+	// Inbound security associations identified by SA index.
+	SaIn []uint32 `protobuf:"varint,3,rep,packed,name=sa_in,json=saIn,proto3" json:"sa_in,omitempty"`
+	// (Optional) Next hop IP address, used for multipoint tunnels.
+	NextHopAddr string `protobuf:"bytes,4,opt,name=next_hop_addr,json=nextHopAddr,proto3" json:"next_hop_addr,omitempty"`
+}
+
+func (x *TunnelProtection) Reset() {
+	*x = TunnelProtection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelProtection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelProtection) ProtoMessage() {}
+
+func (x *TunnelProtection) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelProtection.ProtoReflect.Descriptor instead.
+func (*TunnelProtection) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TunnelProtection) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *TunnelProtection) GetSaOut() []uint32 {
+	if x != nil {
+		return x.SaOut
+	}
+	return nil
+}
+
+func (x *TunnelProtection) GetSaIn() []uint32 {
+	if x != nil {
+		return x.SaIn
+	}
+	return nil
+}
+
+func (x *TunnelProtection) GetNextHopAddr() string {
+	if x != nil {
+		return x.NextHopAddr
+	}
+	return ""
+}
+
+// IKEv2Profile configures VPP to negotiate IPSec security associations
+// for a tunnel interface using the IKEv2 protocol, instead of installing
+// manually keyed security associations.
+type IKEv2Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the profile (up to 63 characters), serves as a unique identifier.
+	Name          string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Auth          *IKEv2Profile_Authentication  `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	LocalId       *IKEv2Profile_Identity        `protobuf:"bytes,3,opt,name=local_id,json=localId,proto3" json:"local_id,omitempty"`
+	RemoteId      *IKEv2Profile_Identity        `protobuf:"bytes,4,opt,name=remote_id,json=remoteId,proto3" json:"remote_id,omitempty"`
+	LocalTs       *IKEv2Profile_TrafficSelector `protobuf:"bytes,5,opt,name=local_ts,json=localTs,proto3" json:"local_ts,omitempty"`
+	RemoteTs      *IKEv2Profile_TrafficSelector `protobuf:"bytes,6,opt,name=remote_ts,json=remoteTs,proto3" json:"remote_ts,omitempty"`
+	Responder     *IKEv2Profile_Responder       `protobuf:"bytes,7,opt,name=responder,proto3" json:"responder,omitempty"`
+	IkeTransforms *IKEv2Profile_IKETransforms   `protobuf:"bytes,8,opt,name=ike_transforms,json=ikeTransforms,proto3" json:"ike_transforms,omitempty"`
+	EspTransforms *IKEv2Profile_ESPTransforms   `protobuf:"bytes,9,opt,name=esp_transforms,json=espTransforms,proto3" json:"esp_transforms,omitempty"`
+	SaLifetime    *IKEv2Profile_SALifetime      `protobuf:"bytes,10,opt,name=sa_lifetime,json=saLifetime,proto3" json:"sa_lifetime,omitempty"`
+	// Tunnel interface (e.g. IPIP) protected by the negotiated child SAs.
+	TunnelInterface     string `protobuf:"bytes,11,opt,name=tunnel_interface,json=tunnelInterface,proto3" json:"tunnel_interface,omitempty"`
+	UdpEncap            bool   `protobuf:"varint,12,opt,name=udp_encap,json=udpEncap,proto3" json:"udp_encap,omitempty"` // Enable UDP encapsulation of ESP packets
+	DisableNatTraversal bool   `protobuf:"varint,13,opt,name=disable_nat_traversal,json=disableNatTraversal,proto3" json:"disable_nat_traversal,omitempty"`
+	// Start negotiation of the IKE SA with the responder once the profile
+	// is configured. Leave unset for profiles where VPP acts as the responder.
+	Initiate bool `protobuf:"varint,14,opt,name=initiate,proto3" json:"initiate,omitempty"`
+}
+
+func (x *IKEv2Profile) Reset() {
+	*x = IKEv2Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile) ProtoMessage() {}
+
+func (x *IKEv2Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IKEv2Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IKEv2Profile) GetAuth() *IKEv2Profile_Authentication {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetLocalId() *IKEv2Profile_Identity {
+	if x != nil {
+		return x.LocalId
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetRemoteId() *IKEv2Profile_Identity {
+	if x != nil {
+		return x.RemoteId
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetLocalTs() *IKEv2Profile_TrafficSelector {
+	if x != nil {
+		return x.LocalTs
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetRemoteTs() *IKEv2Profile_TrafficSelector {
+	if x != nil {
+		return x.RemoteTs
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetResponder() *IKEv2Profile_Responder {
+	if x != nil {
+		return x.Responder
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetIkeTransforms() *IKEv2Profile_IKETransforms {
+	if x != nil {
+		return x.IkeTransforms
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetEspTransforms() *IKEv2Profile_ESPTransforms {
+	if x != nil {
+		return x.EspTransforms
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetSaLifetime() *IKEv2Profile_SALifetime {
+	if x != nil {
+		return x.SaLifetime
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetTunnelInterface() string {
+	if x != nil {
+		return x.TunnelInterface
+	}
+	return ""
+}
+
+func (x *IKEv2Profile) GetUdpEncap() bool {
+	if x != nil {
+		return x.UdpEncap
+	}
+	return false
+}
+
+func (x *IKEv2Profile) GetDisableNatTraversal() bool {
+	if x != nil {
+		return x.DisableNatTraversal
+	}
+	return false
+}
+
+func (x *IKEv2Profile) GetInitiate() bool {
+	if x != nil {
+		return x.Initiate
+	}
+	return false
+}
+
+type SecurityPolicyDatabase_Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name of the related interface
+}
+
+func (x *SecurityPolicyDatabase_Interface) Reset() {
+	*x = SecurityPolicyDatabase_Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityPolicyDatabase_Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityPolicyDatabase_Interface) ProtoMessage() {}
+
+func (x *SecurityPolicyDatabase_Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityPolicyDatabase_Interface.ProtoReflect.Descriptor instead.
+func (*SecurityPolicyDatabase_Interface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *SecurityPolicyDatabase_Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SecurityPolicyDatabase_PolicyEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SaIndex         uint32                                    `protobuf:"varint,1,opt,name=sa_index,json=saIndex,proto3" json:"sa_index,omitempty"` // Security association index
+	Priority        int32                                     `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	IsOutbound      bool                                      `protobuf:"varint,3,opt,name=is_outbound,json=isOutbound,proto3" json:"is_outbound,omitempty"`
+	RemoteAddrStart string                                    `protobuf:"bytes,4,opt,name=remote_addr_start,json=remoteAddrStart,proto3" json:"remote_addr_start,omitempty"`
+	RemoteAddrStop  string                                    `protobuf:"bytes,5,opt,name=remote_addr_stop,json=remoteAddrStop,proto3" json:"remote_addr_stop,omitempty"`
+	LocalAddrStart  string                                    `protobuf:"bytes,6,opt,name=local_addr_start,json=localAddrStart,proto3" json:"local_addr_start,omitempty"`
+	LocalAddrStop   string                                    `protobuf:"bytes,7,opt,name=local_addr_stop,json=localAddrStop,proto3" json:"local_addr_stop,omitempty"`
+	Protocol        uint32                                    `protobuf:"varint,8,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	RemotePortStart uint32                                    `protobuf:"varint,9,opt,name=remote_port_start,json=remotePortStart,proto3" json:"remote_port_start,omitempty"`
+	RemotePortStop  uint32                                    `protobuf:"varint,10,opt,name=remote_port_stop,json=remotePortStop,proto3" json:"remote_port_stop,omitempty"`
+	LocalPortStart  uint32                                    `protobuf:"varint,11,opt,name=local_port_start,json=localPortStart,proto3" json:"local_port_start,omitempty"`
+	LocalPortStop   uint32                                    `protobuf:"varint,12,opt,name=local_port_stop,json=localPortStop,proto3" json:"local_port_stop,omitempty"`
+	Action          SecurityPolicyDatabase_PolicyEntry_Action `protobuf:"varint,13,opt,name=action,proto3,enum=ligato.vpp.ipsec.SecurityPolicyDatabase_PolicyEntry_Action" json:"action,omitempty"`
 }
 
 func (x *SecurityPolicyDatabase_PolicyEntry) Reset() {
 	*x = SecurityPolicyDatabase_PolicyEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[5]
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityPolicyDatabase_PolicyEntry) ProtoMessage() {}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityPolicyDatabase_PolicyEntry.ProtoReflect.Descriptor instead.
+func (*SecurityPolicyDatabase_PolicyEntry) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetSaIndex() uint32 {
+	if x != nil {
+		return x.SaIndex
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetIsOutbound() bool {
+	if x != nil {
+		return x.IsOutbound
+	}
+	return false
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetRemoteAddrStart() string {
+	if x != nil {
+		return x.RemoteAddrStart
+	}
+	return ""
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetRemoteAddrStop() string {
+	if x != nil {
+		return x.RemoteAddrStop
+	}
+	return ""
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalAddrStart() string {
+	if x != nil {
+		return x.LocalAddrStart
+	}
+	return ""
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalAddrStop() string {
+	if x != nil {
+		return x.LocalAddrStop
+	}
+	return ""
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetRemotePortStart() uint32 {
+	if x != nil {
+		return x.RemotePortStart
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetRemotePortStop() uint32 {
+	if x != nil {
+		return x.RemotePortStop
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalPortStart() uint32 {
+	if x != nil {
+		return x.LocalPortStart
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalPortStop() uint32 {
+	if x != nil {
+		return x.LocalPortStop
+	}
+	return 0
+}
+
+func (x *SecurityPolicyDatabase_PolicyEntry) GetAction() SecurityPolicyDatabase_PolicyEntry_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SecurityPolicyDatabase_PolicyEntry_BYPASS
+}
+
+// Lifetime limits of the SA, zero value means no limit.
+type SecurityAssociation_Lifetime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bytes   uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Packets uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Seconds uint64 `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *SecurityAssociation_Lifetime) Reset() {
+	*x = SecurityAssociation_Lifetime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityAssociation_Lifetime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityAssociation_Lifetime) ProtoMessage() {}
+
+func (x *SecurityAssociation_Lifetime) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityAssociation_Lifetime.ProtoReflect.Descriptor instead.
+func (*SecurityAssociation_Lifetime) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SecurityAssociation_Lifetime) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *SecurityAssociation_Lifetime) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *SecurityAssociation_Lifetime) GetSeconds() uint64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// CryptoHandler selects the crypto engine used for the given algorithm,
+// overriding the engine that VPP picks based on engine priorities.
+// Note that VPP cannot revert the selection, removing the handler keeps
+// the engine in use.
+type IPSecGlobal_CryptoHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithm string                            `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // VPP crypto algorithm name (e.g. aes-128-gcm), "all" for all algorithms
+	Engine    string                            `protobuf:"bytes,2,opt,name=engine,proto3" json:"engine,omitempty"`       // Crypto engine name (e.g. ipsecmb, openssl, native)
+	OpClass   IPSecGlobal_CryptoHandler_OpClass `protobuf:"varint,3,opt,name=op_class,json=opClass,proto3,enum=ligato.vpp.ipsec.IPSecGlobal_CryptoHandler_OpClass" json:"op_class,omitempty"`
+	Async     bool                              `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"` // Select the engine for the asynchronous crypto
+}
+
+func (x *IPSecGlobal_CryptoHandler) Reset() {
+	*x = IPSecGlobal_CryptoHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPSecGlobal_CryptoHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPSecGlobal_CryptoHandler) ProtoMessage() {}
+
+func (x *IPSecGlobal_CryptoHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPSecGlobal_CryptoHandler.ProtoReflect.Descriptor instead.
+func (*IPSecGlobal_CryptoHandler) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *IPSecGlobal_CryptoHandler) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *IPSecGlobal_CryptoHandler) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *IPSecGlobal_CryptoHandler) GetOpClass() IPSecGlobal_CryptoHandler_OpClass {
+	if x != nil {
+		return x.OpClass
+	}
+	return IPSecGlobal_CryptoHandler_SIMPLE
+}
+
+func (x *IPSecGlobal_CryptoHandler) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type IKEv2Profile_Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method IKEv2Profile_Authentication_Method `protobuf:"varint,1,opt,name=method,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Authentication_Method" json:"method,omitempty"`
+	// Pre-shared key, used with SHARED_KEY method.
+	SharedKey string `protobuf:"bytes,2,opt,name=shared_key,json=sharedKey,proto3" json:"shared_key,omitempty"`
+	// Pre-shared key is hex-encoded.
+	SharedKeyIsHex bool `protobuf:"varint,3,opt,name=shared_key_is_hex,json=sharedKeyIsHex,proto3" json:"shared_key_is_hex,omitempty"`
+	// Path to the peer certificate file (PEM), used with RSA_SIGNATURE method.
+	PeerCertFile string `protobuf:"bytes,4,opt,name=peer_cert_file,json=peerCertFile,proto3" json:"peer_cert_file,omitempty"`
+	// Path to the local private key file (PEM), used with RSA_SIGNATURE method.
+	// Note that VPP keeps only one local private key shared by all profiles.
+	LocalKeyFile string `protobuf:"bytes,5,opt,name=local_key_file,json=localKeyFile,proto3" json:"local_key_file,omitempty"`
+}
+
+func (x *IKEv2Profile_Authentication) Reset() {
+	*x = IKEv2Profile_Authentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Authentication) ProtoMessage() {}
+
+func (x *IKEv2Profile_Authentication) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Authentication.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Authentication) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *IKEv2Profile_Authentication) GetMethod() IKEv2Profile_Authentication_Method {
+	if x != nil {
+		return x.Method
+	}
+	return IKEv2Profile_Authentication_SHARED_KEY
+}
+
+func (x *IKEv2Profile_Authentication) GetSharedKey() string {
+	if x != nil {
+		return x.SharedKey
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_Authentication) GetSharedKeyIsHex() bool {
+	if x != nil {
+		return x.SharedKeyIsHex
+	}
+	return false
+}
+
+func (x *IKEv2Profile_Authentication) GetPeerCertFile() string {
+	if x != nil {
+		return x.PeerCertFile
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_Authentication) GetLocalKeyFile() string {
+	if x != nil {
+		return x.LocalKeyFile
+	}
+	return ""
+}
+
+type IKEv2Profile_Identity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type IKEv2Profile_Identity_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Identity_Type" json:"type,omitempty"`
+	Data string                     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *IKEv2Profile_Identity) Reset() {
+	*x = IKEv2Profile_Identity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Identity) ProtoMessage() {}
+
+func (x *IKEv2Profile_Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Identity.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Identity) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *IKEv2Profile_Identity) GetType() IKEv2Profile_Identity_Type {
+	if x != nil {
+		return x.Type
+	}
+	return IKEv2Profile_Identity_UNDEFINED_ID
+}
+
+func (x *IKEv2Profile_Identity) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type IKEv2Profile_TrafficSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol  uint32 `protobuf:"varint,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // IP protocol number, 0 means any protocol
+	StartPort uint32 `protobuf:"varint,2,opt,name=start_port,json=startPort,proto3" json:"start_port,omitempty"`
+	EndPort   uint32 `protobuf:"varint,3,opt,name=end_port,json=endPort,proto3" json:"end_port,omitempty"`
+	StartAddr string `protobuf:"bytes,4,opt,name=start_addr,json=startAddr,proto3" json:"start_addr,omitempty"`
+	EndAddr   string `protobuf:"bytes,5,opt,name=end_addr,json=endAddr,proto3" json:"end_addr,omitempty"`
+}
+
+func (x *IKEv2Profile_TrafficSelector) Reset() {
+	*x = IKEv2Profile_TrafficSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_TrafficSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_TrafficSelector) ProtoMessage() {}
+
+func (x *IKEv2Profile_TrafficSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_TrafficSelector.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_TrafficSelector) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetStartPort() uint32 {
+	if x != nil {
+		return x.StartPort
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetEndPort() uint32 {
+	if x != nil {
+		return x.EndPort
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetStartAddr() string {
+	if x != nil {
+		return x.StartAddr
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetEndAddr() string {
+	if x != nil {
+		return x.EndAddr
+	}
+	return ""
+}
+
+// Responder is the peer with which the IKE SA is negotiated when VPP
+// acts as the initiator.
+type IKEv2Profile_Responder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"` // Interface used to reach the responder
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *IKEv2Profile_Responder) Reset() {
+	*x = IKEv2Profile_Responder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Responder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Responder) ProtoMessage() {}
+
+func (x *IKEv2Profile_Responder) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Responder.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Responder) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *IKEv2Profile_Responder) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_Responder) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Transforms proposed for the IKE SA.
+type IKEv2Profile_IKETransforms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CryptoAlg CryptoAlg            `protobuf:"varint,1,opt,name=crypto_alg,json=cryptoAlg,proto3,enum=ligato.vpp.ipsec.CryptoAlg" json:"crypto_alg,omitempty"`
+	IntegAlg  IntegAlg             `protobuf:"varint,2,opt,name=integ_alg,json=integAlg,proto3,enum=ligato.vpp.ipsec.IntegAlg" json:"integ_alg,omitempty"`
+	DhGroup   IKEv2Profile_DHGroup `protobuf:"varint,3,opt,name=dh_group,json=dhGroup,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_DHGroup" json:"dh_group,omitempty"`
+}
+
+func (x *IKEv2Profile_IKETransforms) Reset() {
+	*x = IKEv2Profile_IKETransforms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) String() string {
+func (x *IKEv2Profile_IKETransforms) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityPolicyDatabase_PolicyEntry) ProtoMessage() {}
+func (*IKEv2Profile_IKETransforms) ProtoMessage() {}
 
-func (x *SecurityPolicyDatabase_PolicyEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[5]
+func (x *IKEv2Profile_IKETransforms) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,100 +2097,157 @@ func (x *SecurityPolicyDatabase_PolicyEntry) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityPolicyDatabase_PolicyEntry.ProtoReflect.Descriptor instead.
-func (*SecurityPolicyDatabase_PolicyEntry) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use IKEv2Profile_IKETransforms.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_IKETransforms) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 4}
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetSaIndex() uint32 {
+func (x *IKEv2Profile_IKETransforms) GetCryptoAlg() CryptoAlg {
 	if x != nil {
-		return x.SaIndex
+		return x.CryptoAlg
 	}
-	return 0
+	return CryptoAlg_NONE_CRYPTO
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetPriority() int32 {
+func (x *IKEv2Profile_IKETransforms) GetIntegAlg() IntegAlg {
 	if x != nil {
-		return x.Priority
+		return x.IntegAlg
 	}
-	return 0
+	return IntegAlg_NONE_INTEG
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetIsOutbound() bool {
+func (x *IKEv2Profile_IKETransforms) GetDhGroup() IKEv2Profile_DHGroup {
 	if x != nil {
-		return x.IsOutbound
+		return x.DhGroup
 	}
-	return false
+	return IKEv2Profile_DH_NONE
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetRemoteAddrStart() string {
-	if x != nil {
-		return x.RemoteAddrStart
+// Transforms proposed for the child (ESP) SAs.
+type IKEv2Profile_ESPTransforms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CryptoAlg CryptoAlg `protobuf:"varint,1,opt,name=crypto_alg,json=cryptoAlg,proto3,enum=ligato.vpp.ipsec.CryptoAlg" json:"crypto_alg,omitempty"`
+	IntegAlg  IntegAlg  `protobuf:"varint,2,opt,name=integ_alg,json=integAlg,proto3,enum=ligato.vpp.ipsec.IntegAlg" json:"integ_alg,omitempty"`
+}
+
+func (x *IKEv2Profile_ESPTransforms) Reset() {
+	*x = IKEv2Profile_ESPTransforms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetRemoteAddrStop() string {
-	if x != nil {
-		return x.RemoteAddrStop
+func (x *IKEv2Profile_ESPTransforms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_ESPTransforms) ProtoMessage() {}
+
+func (x *IKEv2Profile_ESPTransforms) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalAddrStart() string {
+// Deprecated: Use IKEv2Profile_ESPTransforms.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_ESPTransforms) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 5}
+}
+
+func (x *IKEv2Profile_ESPTransforms) GetCryptoAlg() CryptoAlg {
 	if x != nil {
-		return x.LocalAddrStart
+		return x.CryptoAlg
 	}
-	return ""
+	return CryptoAlg_NONE_CRYPTO
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalAddrStop() string {
+func (x *IKEv2Profile_ESPTransforms) GetIntegAlg() IntegAlg {
 	if x != nil {
-		return x.LocalAddrStop
+		return x.IntegAlg
 	}
-	return ""
+	return IntegAlg_NONE_INTEG
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetProtocol() uint32 {
-	if x != nil {
-		return x.Protocol
+type IKEv2Profile_SALifetime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lifetime uint64 `protobuf:"varint,1,opt,name=lifetime,proto3" json:"lifetime,omitempty"`              // SA lifetime in seconds
+	Jitter   uint32 `protobuf:"varint,2,opt,name=jitter,proto3" json:"jitter,omitempty"`                  // Random jitter added to the lifetime (seconds)
+	Handover uint32 `protobuf:"varint,3,opt,name=handover,proto3" json:"handover,omitempty"`              // Time to keep the old SA after rekeying (seconds)
+	MaxData  uint64 `protobuf:"varint,4,opt,name=max_data,json=maxData,proto3" json:"max_data,omitempty"` // SA lifetime in bytes
+}
+
+func (x *IKEv2Profile_SALifetime) Reset() {
+	*x = IKEv2Profile_SALifetime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetRemotePortStart() uint32 {
-	if x != nil {
-		return x.RemotePortStart
+func (x *IKEv2Profile_SALifetime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_SALifetime) ProtoMessage() {}
+
+func (x *IKEv2Profile_SALifetime) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ipsec_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetRemotePortStop() uint32 {
+// Deprecated: Use IKEv2Profile_SALifetime.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_SALifetime) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP(), []int{6, 6}
+}
+
+func (x *IKEv2Profile_SALifetime) GetLifetime() uint64 {
 	if x != nil {
-		return x.RemotePortStop
+		return x.Lifetime
 	}
 	return 0
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalPortStart() uint32 {
+func (x *IKEv2Profile_SALifetime) GetJitter() uint32 {
 	if x != nil {
-		return x.LocalPortStart
+		return x.Jitter
 	}
 	return 0
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetLocalPortStop() uint32 {
+func (x *IKEv2Profile_SALifetime) GetHandover() uint32 {
 	if x != nil {
-		return x.LocalPortStop
+		return x.Handover
 	}
 	return 0
 }
 
-func (x *SecurityPolicyDatabase_PolicyEntry) GetAction() SecurityPolicyDatabase_PolicyEntry_Action {
+func (x *IKEv2Profile_SALifetime) GetMaxData() uint64 {
 	if x != nil {
-		return x.Action
+		return x.MaxData
 	}
-	return SecurityPolicyDatabase_PolicyEntry_BYPASS
+	return 0
 }
 
 var File_ligato_vpp_ipsec_ipsec_proto protoreflect.FileDescriptor
@@ -1068,7 +2360,7 @@ var file_ligato_vpp_ipsec_ipsec_proto_rawDesc = []byte{
 	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x59, 0x50, 0x41, 0x53, 0x53,
 	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12,
 	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x10, 0x03, 0x22, 0xb1, 0x05, 0x0a, 0x13, 0x53, 0x65,
+	0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x10, 0x03, 0x22, 0xe8, 0x07, 0x0a, 0x13, 0x53, 0x65,
 	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x70, 0x69, 0x18, 0x02,
@@ -1109,43 +2401,259 @@ var file_ligato_vpp_ipsec_ipsec_proto_rawDesc = []byte{
 	0x6c, 0x53, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x0f, 0x74, 0x75, 0x6e, 0x6e,
 	0x65, 0x6c, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
 	0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x0d, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x20, 0x0a, 0x0d, 0x49,
-	0x50, 0x53, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x06, 0x0a, 0x02,
-	0x41, 0x48, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x53, 0x50, 0x10, 0x01, 0x22, 0x87, 0x01,
-	0x0a, 0x10, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x12, 0x15, 0x0a, 0x06, 0x73, 0x61, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d,
-	0x52, 0x05, 0x73, 0x61, 0x4f, 0x75, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x61, 0x5f, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x61, 0x49, 0x6e, 0x12, 0x29, 0x0a, 0x0d,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74,
-	0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x2a, 0xd0, 0x01, 0x0a, 0x09, 0x43, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x4e, 0x45, 0x5f, 0x43, 0x52,
-	0x59, 0x50, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x43, 0x42,
-	0x43, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x43,
-	0x42, 0x43, 0x5f, 0x31, 0x39, 0x32, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f,
-	0x43, 0x42, 0x43, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53,
-	0x5f, 0x43, 0x54, 0x52, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45,
-	0x53, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x31, 0x39, 0x32, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x41,
-	0x45, 0x53, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b,
-	0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x07, 0x12, 0x0f, 0x0a,
-	0x0b, 0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x31, 0x39, 0x32, 0x10, 0x08, 0x12, 0x0f,
-	0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x09, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x10, 0x0a, 0x12, 0x0c, 0x0a, 0x08,
-	0x44, 0x45, 0x53, 0x33, 0x5f, 0x43, 0x42, 0x43, 0x10, 0x0b, 0x2a, 0x76, 0x0a, 0x08, 0x49, 0x6e,
-	0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x4e, 0x45, 0x5f, 0x49,
-	0x4e, 0x54, 0x45, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x44, 0x35, 0x5f, 0x39, 0x36,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x41, 0x31, 0x5f, 0x39, 0x36, 0x10, 0x02, 0x12,
-	0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x39, 0x36, 0x10, 0x03, 0x12,
-	0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x04,
-	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x5f, 0x33, 0x38, 0x34, 0x5f, 0x31, 0x39, 0x32, 0x10,
-	0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x5f, 0x35, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36,
-	0x10, 0x06, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x70, 0x73, 0x65, 0x63,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x61,
+	0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x6e,
+	0x74, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x73, 0x6f, 0x66, 0x74, 0x4c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x64, 0x5f,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65,
+	0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0c,
+	0x68, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x54, 0x0a, 0x08,
+	0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x20, 0x0a, 0x0d, 0x49, 0x50, 0x53, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x48, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45,
+	0x53, 0x50, 0x10, 0x01, 0x22, 0xa8, 0x02, 0x0a, 0x1f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x70, 0x69,
+	0x12, 0x4d, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x37, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x3d, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x46,
+	0x54, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4c, 0x49, 0x46,
+	0x45, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x22,
+	0xf0, 0x03, 0x0a, 0x0b, 0x49, 0x50, 0x53, 0x65, 0x63, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12,
+	0x54, 0x0a, 0x0f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x50, 0x53, 0x65,
+	0x63, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x64, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x11, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0xd9, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x4e, 0x0a,
+	0x08, 0x6f, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x33, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73,
+	0x79, 0x6e, 0x63, 0x22, 0x2c, 0x0a, 0x07, 0x4f, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10,
+	0x02, 0x22, 0x2f, 0x0a, 0x11, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54,
+	0x10, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x61, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x61, 0x4f, 0x75, 0x74, 0x12, 0x13, 0x0a, 0x05,
+	0x73, 0x61, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x61, 0x49,
+	0x6e, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0xab, 0x12, 0x0a,
+	0x0c, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x41, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x12, 0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49,
+	0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x49,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e,
+	0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x54, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45,
+	0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x53,
+	0x0a, 0x0e, 0x69, 0x6b, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x4b, 0x45, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x52, 0x0d, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x0e, 0x65, 0x73, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49,
+	0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x53, 0x50, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x0d, 0x65, 0x73, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x73, 0x61, 0x5f, 0x6c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x41,
+	0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0a, 0x73, 0x61, 0x4c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x75, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x12, 0x32, 0x0a, 0x15,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x1a, 0xa1, 0x02, 0x0a,
+	0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4c, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x11,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x73, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x49, 0x73, 0x48, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x65, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x22, 0x2b, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a,
+	0x0a, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x52, 0x53, 0x41, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01,
+	0x1a, 0xbf, 0x01, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49,
+	0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55,
+	0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x49, 0x50, 0x56, 0x34, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x51, 0x44, 0x4e, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x46, 0x43, 0x38, 0x32, 0x32,
+	0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x50, 0x56, 0x36, 0x5f,
+	0x41, 0x44, 0x44, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x44,
+	0x10, 0x0b, 0x1a, 0xc5, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09,
+	0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
+	0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x4a, 0x0a, 0x09, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0xc7, 0x01, 0x0a, 0x0d, 0x49, 0x4b, 0x45, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x41, 0x6c, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x41, 0x6c, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x41, 0x0a,
+	0x08, 0x64, 0x68, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x44, 0x48, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x07, 0x64, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x1a, 0x84, 0x01, 0x0a, 0x0d, 0x45, 0x53, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x41, 0x6c, 0x67, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x37,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x1a, 0x77, 0x0a, 0x0a, 0x53, 0x41, 0x4c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x87, 0x02, 0x0a, 0x07, 0x44, 0x48, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x48, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44,
+	0x50, 0x5f, 0x37, 0x36, 0x38, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f,
+	0x31, 0x30, 0x32, 0x34, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x31,
+	0x35, 0x33, 0x36, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x32, 0x30,
+	0x34, 0x38, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x33, 0x30, 0x37,
+	0x32, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x34, 0x30, 0x39, 0x36,
+	0x10, 0x10, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x36, 0x31, 0x34, 0x34, 0x10,
+	0x11, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x10, 0x12,
+	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x43, 0x50, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x13, 0x12, 0x0b, 0x0a,
+	0x07, 0x45, 0x43, 0x50, 0x5f, 0x33, 0x38, 0x34, 0x10, 0x14, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x43,
+	0x50, 0x5f, 0x35, 0x32, 0x31, 0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x50, 0x5f,
+	0x31, 0x30, 0x32, 0x34, 0x5f, 0x31, 0x36, 0x30, 0x10, 0x16, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f,
+	0x44, 0x50, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x5f, 0x32, 0x32, 0x34, 0x10, 0x17, 0x12, 0x11, 0x0a,
+	0x0d, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x18,
+	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x43, 0x50, 0x5f, 0x31, 0x39, 0x32, 0x10, 0x19, 0x12, 0x0b, 0x0a,
+	0x07, 0x45, 0x43, 0x50, 0x5f, 0x32, 0x32, 0x34, 0x10, 0x1a, 0x2a, 0xd0, 0x01, 0x0a, 0x09, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x4e, 0x45,
+	0x5f, 0x43, 0x52, 0x59, 0x50, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53,
+	0x5f, 0x43, 0x42, 0x43, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45,
+	0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x31, 0x39, 0x32, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41,
+	0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b,
+	0x41, 0x45, 0x53, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x04, 0x12, 0x0f, 0x0a,
+	0x0b, 0x41, 0x45, 0x53, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x31, 0x39, 0x32, 0x10, 0x05, 0x12, 0x0f,
+	0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x43, 0x54, 0x52, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x06, 0x12,
+	0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x07,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x31, 0x39, 0x32, 0x10,
+	0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x32, 0x35, 0x36,
+	0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x10, 0x0a, 0x12,
+	0x0c, 0x0a, 0x08, 0x44, 0x45, 0x53, 0x33, 0x5f, 0x43, 0x42, 0x43, 0x10, 0x0b, 0x2a, 0x76, 0x0a,
+	0x08, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x4e,
+	0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x44, 0x35,
+	0x5f, 0x39, 0x36, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x41, 0x31, 0x5f, 0x39, 0x36,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x39, 0x36,
+	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x31, 0x32,
+	0x38, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x5f, 0x33, 0x38, 0x34, 0x5f, 0x31,
+	0x39, 0x32, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x5f, 0x35, 0x31, 0x32, 0x5f,
+	0x32, 0x35, 0x36, 0x10, 0x06, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
+	0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1160,34 +2668,74 @@ func file_ligato_vpp_ipsec_ipsec_proto_rawDescGZIP() []byte {
 	return file_ligato_vpp_ipsec_ipsec_proto_rawDescData
 }
 
-var file_ligato_vpp_ipsec_ipsec_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_ligato_vpp_ipsec_ipsec_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_ligato_vpp_ipsec_ipsec_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_ligato_vpp_ipsec_ipsec_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_ligato_vpp_ipsec_ipsec_proto_goTypes = []interface{}{
 	(CryptoAlg)(0), // 0: ligato.vpp.ipsec.CryptoAlg
 	(IntegAlg)(0),  // 1: ligato.vpp.ipsec.IntegAlg
 	(SecurityPolicyDatabase_PolicyEntry_Action)(0), // 2: ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry.Action
 	(SecurityPolicy_Action)(0),                     // 3: ligato.vpp.ipsec.SecurityPolicy.Action
 	(SecurityAssociation_IPSecProtocol)(0),         // 4: ligato.vpp.ipsec.SecurityAssociation.IPSecProtocol
-	(*SecurityPolicyDatabase)(nil),                 // 5: ligato.vpp.ipsec.SecurityPolicyDatabase
-	(*SecurityPolicy)(nil),                         // 6: ligato.vpp.ipsec.SecurityPolicy
-	(*SecurityAssociation)(nil),                    // 7: ligato.vpp.ipsec.SecurityAssociation
-	(*TunnelProtection)(nil),                       // 8: ligato.vpp.ipsec.TunnelProtection
-	(*SecurityPolicyDatabase_Interface)(nil),       // 9: ligato.vpp.ipsec.SecurityPolicyDatabase.Interface
-	(*SecurityPolicyDatabase_PolicyEntry)(nil),     // 10: ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry
+	(SecurityAssociationNotification_Event)(0),     // 5: ligato.vpp.ipsec.SecurityAssociationNotification.Event
+	(IPSecGlobal_AsyncDispatchMode)(0),             // 6: ligato.vpp.ipsec.IPSecGlobal.AsyncDispatchMode
+	(IPSecGlobal_CryptoHandler_OpClass)(0),         // 7: ligato.vpp.ipsec.IPSecGlobal.CryptoHandler.OpClass
+	(IKEv2Profile_DHGroup)(0),                      // 8: ligato.vpp.ipsec.IKEv2Profile.DHGroup
+	(IKEv2Profile_Authentication_Method)(0),        // 9: ligato.vpp.ipsec.IKEv2Profile.Authentication.Method
+	(IKEv2Profile_Identity_Type)(0),                // 10: ligato.vpp.ipsec.IKEv2Profile.Identity.Type
+	(*SecurityPolicyDatabase)(nil),                 // 11: ligato.vpp.ipsec.SecurityPolicyDatabase
+	(*SecurityPolicy)(nil),                         // 12: ligato.vpp.ipsec.SecurityPolicy
+	(*SecurityAssociation)(nil),                    // 13: ligato.vpp.ipsec.SecurityAssociation
+	(*SecurityAssociationNotification)(nil),        // 14: ligato.vpp.ipsec.SecurityAssociationNotification
+	(*IPSecGlobal)(nil),                            // 15: ligato.vpp.ipsec.IPSecGlobal
+	(*TunnelProtection)(nil),                       // 16: ligato.vpp.ipsec.TunnelProtection
+	(*IKEv2Profile)(nil),                           // 17: ligato.vpp.ipsec.IKEv2Profile
+	(*SecurityPolicyDatabase_Interface)(nil),       // 18: ligato.vpp.ipsec.SecurityPolicyDatabase.Interface
+	(*SecurityPolicyDatabase_PolicyEntry)(nil),     // 19: ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry
+	(*SecurityAssociation_Lifetime)(nil),           // 20: ligato.vpp.ipsec.SecurityAssociation.Lifetime
+	(*IPSecGlobal_CryptoHandler)(nil),              // 21: ligato.vpp.ipsec.IPSecGlobal.CryptoHandler
+	(*IKEv2Profile_Authentication)(nil),            // 22: ligato.vpp.ipsec.IKEv2Profile.Authentication
+	(*IKEv2Profile_Identity)(nil),                  // 23: ligato.vpp.ipsec.IKEv2Profile.Identity
+	(*IKEv2Profile_TrafficSelector)(nil),           // 24: ligato.vpp.ipsec.IKEv2Profile.TrafficSelector
+	(*IKEv2Profile_Responder)(nil),                 // 25: ligato.vpp.ipsec.IKEv2Profile.Responder
+	(*IKEv2Profile_IKETransforms)(nil),             // 26: ligato.vpp.ipsec.IKEv2Profile.IKETransforms
+	(*IKEv2Profile_ESPTransforms)(nil),             // 27: ligato.vpp.ipsec.IKEv2Profile.ESPTransforms
+	(*IKEv2Profile_SALifetime)(nil),                // 28: ligato.vpp.ipsec.IKEv2Profile.SALifetime
 }
 var file_ligato_vpp_ipsec_ipsec_proto_depIdxs = []int32{
-	9,  // 0: ligato.vpp.ipsec.SecurityPolicyDatabase.interfaces:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase.Interface
-	10, // 1: ligato.vpp.ipsec.SecurityPolicyDatabase.policy_entries:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry
+	18, // 0: ligato.vpp.ipsec.SecurityPolicyDatabase.interfaces:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase.Interface
+	19, // 1: ligato.vpp.ipsec.SecurityPolicyDatabase.policy_entries:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry
 	3,  // 2: ligato.vpp.ipsec.SecurityPolicy.action:type_name -> ligato.vpp.ipsec.SecurityPolicy.Action
 	4,  // 3: ligato.vpp.ipsec.SecurityAssociation.protocol:type_name -> ligato.vpp.ipsec.SecurityAssociation.IPSecProtocol
 	0,  // 4: ligato.vpp.ipsec.SecurityAssociation.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
 	1,  // 5: ligato.vpp.ipsec.SecurityAssociation.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
-	2,  // 6: ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry.action:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry.Action
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	20, // 6: ligato.vpp.ipsec.SecurityAssociation.soft_lifetime:type_name -> ligato.vpp.ipsec.SecurityAssociation.Lifetime
+	20, // 7: ligato.vpp.ipsec.SecurityAssociation.hard_lifetime:type_name -> ligato.vpp.ipsec.SecurityAssociation.Lifetime
+	5,  // 8: ligato.vpp.ipsec.SecurityAssociationNotification.event:type_name -> ligato.vpp.ipsec.SecurityAssociationNotification.Event
+	21, // 9: ligato.vpp.ipsec.IPSecGlobal.crypto_handlers:type_name -> ligato.vpp.ipsec.IPSecGlobal.CryptoHandler
+	6,  // 10: ligato.vpp.ipsec.IPSecGlobal.async_dispatch_mode:type_name -> ligato.vpp.ipsec.IPSecGlobal.AsyncDispatchMode
+	22, // 11: ligato.vpp.ipsec.IKEv2Profile.auth:type_name -> ligato.vpp.ipsec.IKEv2Profile.Authentication
+	23, // 12: ligato.vpp.ipsec.IKEv2Profile.local_id:type_name -> ligato.vpp.ipsec.IKEv2Profile.Identity
+	23, // 13: ligato.vpp.ipsec.IKEv2Profile.remote_id:type_name -> ligato.vpp.ipsec.IKEv2Profile.Identity
+	24, // 14: ligato.vpp.ipsec.IKEv2Profile.local_ts:type_name -> ligato.vpp.ipsec.IKEv2Profile.TrafficSelector
+	24, // 15: ligato.vpp.ipsec.IKEv2Profile.remote_ts:type_name -> ligato.vpp.ipsec.IKEv2Profile.TrafficSelector
+	25, // 16: ligato.vpp.ipsec.IKEv2Profile.responder:type_name -> ligato.vpp.ipsec.IKEv2Profile.Responder
+	26, // 17: ligato.vpp.ipsec.IKEv2Profile.ike_transforms:type_name -> ligato.vpp.ipsec.IKEv2Profile.IKETransforms
+	27, // 18: ligato.vpp.ipsec.IKEv2Profile.esp_transforms:type_name -> ligato.vpp.ipsec.IKEv2Profile.ESPTransforms
+	28, // 19: ligato.vpp.ipsec.IKEv2Profile.sa_lifetime:type_name -> ligato.vpp.ipsec.IKEv2Profile.SALifetime
+	2,  // 20: ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry.action:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase.PolicyEntry.Action
+	7,  // 21: ligato.vpp.ipsec.IPSecGlobal.CryptoHandler.op_class:type_name -> ligato.vpp.ipsec.IPSecGlobal.CryptoHandler.OpClass
+	9,  // 22: ligato.vpp.ipsec.IKEv2Profile.Authentication.method:type_name -> ligato.vpp.ipsec.IKEv2Profile.Authentication.Method
+	10, // 23: ligato.vpp.ipsec.IKEv2Profile.Identity.type:type_name -> ligato.vpp.ipsec.IKEv2Profile.Identity.Type
+	0,  // 24: ligato.vpp.ipsec.IKEv2Profile.IKETransforms.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
+	1,  // 25: ligato.vpp.ipsec.IKEv2Profile.IKETransforms.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
+	8,  // 26: ligato.vpp.ipsec.IKEv2Profile.IKETransforms.dh_group:type_name -> ligato.vpp.ipsec.IKEv2Profile.DHGroup
+	0,  // 27: ligato.vpp.ipsec.IKEv2Profile.ESPTransforms.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
+	1,  // 28: ligato.vpp.ipsec.IKEv2Profile.ESPTransforms.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_ipsec_ipsec_proto_init() }
@@ -1233,7 +2781,7 @@ func file_ligato_vpp_ipsec_ipsec_proto_init() {
 			}
 		}
 		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelProtection); i {
+			switch v := v.(*SecurityAssociationNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1245,7 +2793,7 @@ func file_ligato_vpp_ipsec_ipsec_proto_init() {
 			}
 		}
 		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityPolicyDatabase_Interface); i {
+			switch v := v.(*IPSecGlobal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1257,6 +2805,42 @@ func file_ligato_vpp_ipsec_ipsec_proto_init() {
 			}
 		}
 		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelProtection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityPolicyDatabase_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecurityPolicyDatabase_PolicyEntry); i {
 			case 0:
 				return &v.state
@@ -1268,14 +2852,122 @@ func file_ligato_vpp_ipsec_ipsec_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityAssociation_Lifetime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPSecGlobal_CryptoHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Authentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Identity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_TrafficSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Responder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_IKETransforms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_ESPTransforms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ipsec_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_SALifetime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_ipsec_ipsec_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   6,
+			NumEnums:      11,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -29,6 +29,8 @@ var (
 	ModelSecurityPolicy         models.KnownModel
 	ModelSecurityAssociation    models.KnownModel
 	ModelTunnelProtection       models.KnownModel
+	ModelIKEv2Profile           models.KnownModel
+	ModelIPSecGlobal            models.KnownModel
 )
 
 func init() {
@@ -66,6 +68,18 @@ func init() {
 		`{{.Interface}}`+
 			`{{if .NextHopAddr}}/nh/{{.NextHopAddr}}{{end}}`,
 	))
+
+	ModelIKEv2Profile = models.Register(&IKEv2Profile{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "ikev2-profile",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelIPSecGlobal = models.Register(&IPSecGlobal{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "global",
+	})
 }
 
 // SPDKey returns the key used in NB DB to store the configuration of the
@@ -84,6 +98,19 @@ func SAKey(index uint32) string {
 	})
 }
 
+// IKEv2ProfileKey returns the key used in NB DB to store the configuration of the
+// given IKEv2 profile.
+func IKEv2ProfileKey(name string) string {
+	return models.Key(&IKEv2Profile{
+		Name: name,
+	})
+}
+
+// GlobalKey returns the key under which the global IPSec settings are stored.
+func GlobalKey() string {
+	return models.Key(&IPSecGlobal{})
+}
+
 /* SPD <-> interface binding (derived) */
 const (
 	// spdInterfaceKeyTemplate is a template for (derived) key representing binding
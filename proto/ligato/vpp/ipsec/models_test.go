@@ -280,3 +280,32 @@ func TestParseIPSecSANameFromKey(t *testing.T) {
 		})
 	}
 }*/
+
+func TestIKEv2ProfileKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     string
+		expectedKey string
+	}{
+		{
+			name:        "valid profile name",
+			profile:     "profile1",
+			expectedKey: "config/vpp/ipsec/v2/ikev2-profile/profile1",
+		},
+		{
+			name:        "profile name with dash",
+			profile:     "site-a",
+			expectedKey: "config/vpp/ipsec/v2/ikev2-profile/site-a",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := ipsec.IKEv2ProfileKey(test.profile)
+			if key != test.expectedKey {
+				t.Errorf("failed for: profile=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.name, test.expectedKey, key)
+			}
+		})
+	}
+}
@@ -21,6 +21,123 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Override of the BD-wide feature flag for this interface.
+type BridgeDomain_Interface_FeatureOverride int32
+
+const (
+	BridgeDomain_Interface_INHERIT BridgeDomain_Interface_FeatureOverride = 0 // use the BD-wide setting
+	BridgeDomain_Interface_ENABLE  BridgeDomain_Interface_FeatureOverride = 1
+	BridgeDomain_Interface_DISABLE BridgeDomain_Interface_FeatureOverride = 2
+)
+
+// Enum value maps for BridgeDomain_Interface_FeatureOverride.
+var (
+	BridgeDomain_Interface_FeatureOverride_name = map[int32]string{
+		0: "INHERIT",
+		1: "ENABLE",
+		2: "DISABLE",
+	}
+	BridgeDomain_Interface_FeatureOverride_value = map[string]int32{
+		"INHERIT": 0,
+		"ENABLE":  1,
+		"DISABLE": 2,
+	}
+)
+
+func (x BridgeDomain_Interface_FeatureOverride) Enum() *BridgeDomain_Interface_FeatureOverride {
+	p := new(BridgeDomain_Interface_FeatureOverride)
+	*p = x
+	return p
+}
+
+func (x BridgeDomain_Interface_FeatureOverride) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeDomain_Interface_FeatureOverride) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_l2_bridge_domain_proto_enumTypes[0].Descriptor()
+}
+
+func (BridgeDomain_Interface_FeatureOverride) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_l2_bridge_domain_proto_enumTypes[0]
+}
+
+func (x BridgeDomain_Interface_FeatureOverride) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeDomain_Interface_FeatureOverride.Descriptor instead.
+func (BridgeDomain_Interface_FeatureOverride) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_l2_bridge_domain_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+type BridgeDomain_Interface_VlanTagRewrite_Operation int32
+
+const (
+	BridgeDomain_Interface_VlanTagRewrite_DISABLED    BridgeDomain_Interface_VlanTagRewrite_Operation = 0
+	BridgeDomain_Interface_VlanTagRewrite_PUSH1       BridgeDomain_Interface_VlanTagRewrite_Operation = 1
+	BridgeDomain_Interface_VlanTagRewrite_PUSH2       BridgeDomain_Interface_VlanTagRewrite_Operation = 2
+	BridgeDomain_Interface_VlanTagRewrite_POP1        BridgeDomain_Interface_VlanTagRewrite_Operation = 3
+	BridgeDomain_Interface_VlanTagRewrite_POP2        BridgeDomain_Interface_VlanTagRewrite_Operation = 4
+	BridgeDomain_Interface_VlanTagRewrite_TRANSLATE11 BridgeDomain_Interface_VlanTagRewrite_Operation = 5
+	BridgeDomain_Interface_VlanTagRewrite_TRANSLATE12 BridgeDomain_Interface_VlanTagRewrite_Operation = 6
+	BridgeDomain_Interface_VlanTagRewrite_TRANSLATE21 BridgeDomain_Interface_VlanTagRewrite_Operation = 7
+	BridgeDomain_Interface_VlanTagRewrite_TRANSLATE22 BridgeDomain_Interface_VlanTagRewrite_Operation = 8
+)
+
+// Enum value maps for BridgeDomain_Interface_VlanTagRewrite_Operation.
+var (
+	BridgeDomain_Interface_VlanTagRewrite_Operation_name = map[int32]string{
+		0: "DISABLED",
+		1: "PUSH1",
+		2: "PUSH2",
+		3: "POP1",
+		4: "POP2",
+		5: "TRANSLATE11",
+		6: "TRANSLATE12",
+		7: "TRANSLATE21",
+		8: "TRANSLATE22",
+	}
+	BridgeDomain_Interface_VlanTagRewrite_Operation_value = map[string]int32{
+		"DISABLED":    0,
+		"PUSH1":       1,
+		"PUSH2":       2,
+		"POP1":        3,
+		"POP2":        4,
+		"TRANSLATE11": 5,
+		"TRANSLATE12": 6,
+		"TRANSLATE21": 7,
+		"TRANSLATE22": 8,
+	}
+)
+
+func (x BridgeDomain_Interface_VlanTagRewrite_Operation) Enum() *BridgeDomain_Interface_VlanTagRewrite_Operation {
+	p := new(BridgeDomain_Interface_VlanTagRewrite_Operation)
+	*p = x
+	return p
+}
+
+func (x BridgeDomain_Interface_VlanTagRewrite_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeDomain_Interface_VlanTagRewrite_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_l2_bridge_domain_proto_enumTypes[1].Descriptor()
+}
+
+func (BridgeDomain_Interface_VlanTagRewrite_Operation) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_l2_bridge_domain_proto_enumTypes[1]
+}
+
+func (x BridgeDomain_Interface_VlanTagRewrite_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeDomain_Interface_VlanTagRewrite_Operation.Descriptor instead.
+func (BridgeDomain_Interface_VlanTagRewrite_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_l2_bridge_domain_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
 type BridgeDomain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,8 +150,10 @@ type BridgeDomain struct {
 	Learn               bool                                `protobuf:"varint,5,opt,name=learn,proto3" json:"learn,omitempty"`                                                           // enable/disable learning on all interfaces in the BD
 	ArpTermination      bool                                `protobuf:"varint,6,opt,name=arp_termination,json=arpTermination,proto3" json:"arp_termination,omitempty"`                   // enable/disable ARP termination in the BD
 	MacAge              uint32                              `protobuf:"varint,7,opt,name=mac_age,json=macAge,proto3" json:"mac_age,omitempty"`                                           // MAC aging time in min, 0 for disabled aging
+	MacLearnLimit       uint32                              `protobuf:"varint,8,opt,name=mac_learn_limit,json=macLearnLimit,proto3" json:"mac_learn_limit,omitempty"`                    // max number of learned MACs in the BD, 0 for VPP default
 	Interfaces          []*BridgeDomain_Interface           `protobuf:"bytes,100,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                                                // list of interfaces
 	ArpTerminationTable []*BridgeDomain_ArpTerminationEntry `protobuf:"bytes,102,rep,name=arp_termination_table,json=arpTerminationTable,proto3" json:"arp_termination_table,omitempty"` // list of ARP termination entries
+	NdTerminationTable  []*BridgeDomain_ArpTerminationEntry `protobuf:"bytes,103,rep,name=nd_termination_table,json=ndTerminationTable,proto3" json:"nd_termination_table,omitempty"`    // list of IPv6 ND termination entries (enabled by arp_termination)
 }
 
 func (x *BridgeDomain) Reset() {
@@ -118,6 +237,13 @@ func (x *BridgeDomain) GetMacAge() uint32 {
 	return 0
 }
 
+func (x *BridgeDomain) GetMacLearnLimit() uint32 {
+	if x != nil {
+		return x.MacLearnLimit
+	}
+	return 0
+}
+
 func (x *BridgeDomain) GetInterfaces() []*BridgeDomain_Interface {
 	if x != nil {
 		return x.Interfaces
@@ -132,14 +258,25 @@ func (x *BridgeDomain) GetArpTerminationTable() []*BridgeDomain_ArpTerminationEn
 	return nil
 }
 
+func (x *BridgeDomain) GetNdTerminationTable() []*BridgeDomain_ArpTerminationEntry {
+	if x != nil {
+		return x.NdTerminationTable
+	}
+	return nil
+}
+
 type BridgeDomain_Interface struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                         // interface name belonging to this bridge domain
-	BridgedVirtualInterface bool   `protobuf:"varint,2,opt,name=bridged_virtual_interface,json=bridgedVirtualInterface,proto3" json:"bridged_virtual_interface,omitempty"` // true if this is a BVI interface
-	SplitHorizonGroup       uint32 `protobuf:"varint,3,opt,name=split_horizon_group,json=splitHorizonGroup,proto3" json:"split_horizon_group,omitempty"`                   // VXLANs in the same BD need the same non-zero SHG
+	Name                    string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                                                       // interface name belonging to this bridge domain
+	BridgedVirtualInterface bool                                   `protobuf:"varint,2,opt,name=bridged_virtual_interface,json=bridgedVirtualInterface,proto3" json:"bridged_virtual_interface,omitempty"`                                               // true if this is a BVI interface
+	SplitHorizonGroup       uint32                                 `protobuf:"varint,3,opt,name=split_horizon_group,json=splitHorizonGroup,proto3" json:"split_horizon_group,omitempty"`                                                                 // VXLANs in the same BD need the same non-zero SHG
+	Flood                   BridgeDomain_Interface_FeatureOverride `protobuf:"varint,4,opt,name=flood,proto3,enum=ligato.vpp.l2.BridgeDomain_Interface_FeatureOverride" json:"flood,omitempty"`                                                          // broadcast/multicast flooding to this interface
+	UnknownUnicastFlood     BridgeDomain_Interface_FeatureOverride `protobuf:"varint,5,opt,name=unknown_unicast_flood,json=unknownUnicastFlood,proto3,enum=ligato.vpp.l2.BridgeDomain_Interface_FeatureOverride" json:"unknown_unicast_flood,omitempty"` // unknown unicast flooding to this interface
+	Learn                   BridgeDomain_Interface_FeatureOverride `protobuf:"varint,6,opt,name=learn,proto3,enum=ligato.vpp.l2.BridgeDomain_Interface_FeatureOverride" json:"learn,omitempty"`                                                          // MAC learning on this interface
+	VlanTagRewrite          *BridgeDomain_Interface_VlanTagRewrite `protobuf:"bytes,7,opt,name=vlan_tag_rewrite,json=vlanTagRewrite,proto3" json:"vlan_tag_rewrite,omitempty"`
 }
 
 func (x *BridgeDomain_Interface) Reset() {
@@ -195,6 +332,34 @@ func (x *BridgeDomain_Interface) GetSplitHorizonGroup() uint32 {
 	return 0
 }
 
+func (x *BridgeDomain_Interface) GetFlood() BridgeDomain_Interface_FeatureOverride {
+	if x != nil {
+		return x.Flood
+	}
+	return BridgeDomain_Interface_INHERIT
+}
+
+func (x *BridgeDomain_Interface) GetUnknownUnicastFlood() BridgeDomain_Interface_FeatureOverride {
+	if x != nil {
+		return x.UnknownUnicastFlood
+	}
+	return BridgeDomain_Interface_INHERIT
+}
+
+func (x *BridgeDomain_Interface) GetLearn() BridgeDomain_Interface_FeatureOverride {
+	if x != nil {
+		return x.Learn
+	}
+	return BridgeDomain_Interface_INHERIT
+}
+
+func (x *BridgeDomain_Interface) GetVlanTagRewrite() *BridgeDomain_Interface_VlanTagRewrite {
+	if x != nil {
+		return x.VlanTagRewrite
+	}
+	return nil
+}
+
 type BridgeDomain_ArpTerminationEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -250,6 +415,79 @@ func (x *BridgeDomain_ArpTerminationEntry) GetPhysAddress() string {
 	return ""
 }
 
+// VLAN tag rewrite applied on the bridge domain port. Should not be
+// combined with the tag rewrite of the sub-interface itself.
+type BridgeDomain_Interface_VlanTagRewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation BridgeDomain_Interface_VlanTagRewrite_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=ligato.vpp.l2.BridgeDomain_Interface_VlanTagRewrite_Operation" json:"operation,omitempty"`
+	PushDot1Q bool                                            `protobuf:"varint,2,opt,name=push_dot1q,json=pushDot1q,proto3" json:"push_dot1q,omitempty"` // ether-type of the first tag is dot1q if true, dot1ad otherwise
+	Tag1      uint32                                          `protobuf:"varint,3,opt,name=tag1,proto3" json:"tag1,omitempty"`                            // first tag (required for PUSH1 and any TRANSLATE)
+	Tag2      uint32                                          `protobuf:"varint,4,opt,name=tag2,proto3" json:"tag2,omitempty"`                            // second tag (required for PUSH2 and any TRANSLATE)
+}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) Reset() {
+	*x = BridgeDomain_Interface_VlanTagRewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l2_bridge_domain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeDomain_Interface_VlanTagRewrite) ProtoMessage() {}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l2_bridge_domain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeDomain_Interface_VlanTagRewrite.ProtoReflect.Descriptor instead.
+func (*BridgeDomain_Interface_VlanTagRewrite) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l2_bridge_domain_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) GetOperation() BridgeDomain_Interface_VlanTagRewrite_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return BridgeDomain_Interface_VlanTagRewrite_DISABLED
+}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) GetPushDot1Q() bool {
+	if x != nil {
+		return x.PushDot1Q
+	}
+	return false
+}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) GetTag1() uint32 {
+	if x != nil {
+		return x.Tag1
+	}
+	return 0
+}
+
+func (x *BridgeDomain_Interface_VlanTagRewrite) GetTag2() uint32 {
+	if x != nil {
+		return x.Tag2
+	}
+	return 0
+}
+
 var File_ligato_vpp_l2_bridge_domain_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_l2_bridge_domain_proto_rawDesc = []byte{
@@ -257,7 +495,7 @@ var file_ligato_vpp_l2_bridge_domain_proto_rawDesc = []byte{
 	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
 	0x6c, 0x32, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x04, 0x0a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x0b, 0x0a,
 	0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
@@ -271,37 +509,92 @@ var file_ligato_vpp_l2_bridge_domain_proto_rawDesc = []byte{
 	0x72, 0x70, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x72, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x67, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x63, 0x41, 0x67, 0x65, 0x12, 0x45, 0x0a,
-	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x63, 0x41, 0x67, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x61, 0x63, 0x5f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x63, 0x4c, 0x65, 0x61, 0x72, 0x6e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x15,
+	0x61, 0x72, 0x70, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x66, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x72, 0x70, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x61, 0x72,
+	0x70, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x61, 0x0a, 0x14, 0x6e, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x67, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x72, 0x70,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x6e, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x1a, 0xeb, 0x06, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x64, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x4b, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c,
+	0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x12,
+	0x69, 0x0a, 0x15, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x13, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x55, 0x6e,
+	0x69, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x6f, 0x64, 0x12, 0x4b, 0x0a, 0x05, 0x6c, 0x65,
+	0x61, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x05, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x12, 0x5e, 0x0a, 0x10, 0x76, 0x6c, 0x61, 0x6e, 0x5f,
+	0x74, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c,
 	0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x15, 0x61, 0x72, 0x70, 0x5f, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x66, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x2e, 0x41, 0x72, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x61, 0x72, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x8b, 0x01, 0x0a, 0x09, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x70, 0x6c, 0x69, 0x74,
-	0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x48, 0x6f, 0x72, 0x69, 0x7a,
-	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x5e, 0x0a, 0x13, 0x41, 0x72, 0x70, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24,
-	0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x32, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0e, 0x76, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x1a, 0xbf, 0x02, 0x0a, 0x0e, 0x56, 0x6c, 0x61, 0x6e,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68,
+	0x5f, 0x64, 0x6f, 0x74, 0x31, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75,
+	0x73, 0x68, 0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32, 0x22,
+	0x87, 0x01, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a,
+	0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50,
+	0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50,
+	0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41,
+	0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c,
+	0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32, 0x10, 0x08, 0x22, 0x37, 0x0a, 0x0f, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x49, 0x4e, 0x48, 0x45, 0x52, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x02, 0x1a, 0x5e, 0x0a, 0x13, 0x41, 0x72, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0a, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x6c, 0x32, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -316,20 +609,30 @@ func file_ligato_vpp_l2_bridge_domain_proto_rawDescGZIP() []byte {
 	return file_ligato_vpp_l2_bridge_domain_proto_rawDescData
 }
 
-var file_ligato_vpp_l2_bridge_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_l2_bridge_domain_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ligato_vpp_l2_bridge_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_ligato_vpp_l2_bridge_domain_proto_goTypes = []interface{}{
-	(*BridgeDomain)(nil),                     // 0: ligato.vpp.l2.BridgeDomain
-	(*BridgeDomain_Interface)(nil),           // 1: ligato.vpp.l2.BridgeDomain.Interface
-	(*BridgeDomain_ArpTerminationEntry)(nil), // 2: ligato.vpp.l2.BridgeDomain.ArpTerminationEntry
+	(BridgeDomain_Interface_FeatureOverride)(0),          // 0: ligato.vpp.l2.BridgeDomain.Interface.FeatureOverride
+	(BridgeDomain_Interface_VlanTagRewrite_Operation)(0), // 1: ligato.vpp.l2.BridgeDomain.Interface.VlanTagRewrite.Operation
+	(*BridgeDomain)(nil),                                 // 2: ligato.vpp.l2.BridgeDomain
+	(*BridgeDomain_Interface)(nil),                       // 3: ligato.vpp.l2.BridgeDomain.Interface
+	(*BridgeDomain_ArpTerminationEntry)(nil),             // 4: ligato.vpp.l2.BridgeDomain.ArpTerminationEntry
+	(*BridgeDomain_Interface_VlanTagRewrite)(nil),        // 5: ligato.vpp.l2.BridgeDomain.Interface.VlanTagRewrite
 }
 var file_ligato_vpp_l2_bridge_domain_proto_depIdxs = []int32{
-	1, // 0: ligato.vpp.l2.BridgeDomain.interfaces:type_name -> ligato.vpp.l2.BridgeDomain.Interface
-	2, // 1: ligato.vpp.l2.BridgeDomain.arp_termination_table:type_name -> ligato.vpp.l2.BridgeDomain.ArpTerminationEntry
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: ligato.vpp.l2.BridgeDomain.interfaces:type_name -> ligato.vpp.l2.BridgeDomain.Interface
+	4, // 1: ligato.vpp.l2.BridgeDomain.arp_termination_table:type_name -> ligato.vpp.l2.BridgeDomain.ArpTerminationEntry
+	4, // 2: ligato.vpp.l2.BridgeDomain.nd_termination_table:type_name -> ligato.vpp.l2.BridgeDomain.ArpTerminationEntry
+	0, // 3: ligato.vpp.l2.BridgeDomain.Interface.flood:type_name -> ligato.vpp.l2.BridgeDomain.Interface.FeatureOverride
+	0, // 4: ligato.vpp.l2.BridgeDomain.Interface.unknown_unicast_flood:type_name -> ligato.vpp.l2.BridgeDomain.Interface.FeatureOverride
+	0, // 5: ligato.vpp.l2.BridgeDomain.Interface.learn:type_name -> ligato.vpp.l2.BridgeDomain.Interface.FeatureOverride
+	5, // 6: ligato.vpp.l2.BridgeDomain.Interface.vlan_tag_rewrite:type_name -> ligato.vpp.l2.BridgeDomain.Interface.VlanTagRewrite
+	1, // 7: ligato.vpp.l2.BridgeDomain.Interface.VlanTagRewrite.operation:type_name -> ligato.vpp.l2.BridgeDomain.Interface.VlanTagRewrite.Operation
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_l2_bridge_domain_proto_init() }
@@ -374,19 +677,32 @@ func file_ligato_vpp_l2_bridge_domain_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_l2_bridge_domain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomain_Interface_VlanTagRewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_l2_bridge_domain_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ligato_vpp_l2_bridge_domain_proto_goTypes,
 		DependencyIndexes: file_ligato_vpp_l2_bridge_domain_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_l2_bridge_domain_proto_enumTypes,
 		MessageInfos:      file_ligato_vpp_l2_bridge_domain_proto_msgTypes,
 	}.Build()
 	File_ligato_vpp_l2_bridge_domain_proto = out.File
@@ -16,6 +16,7 @@ package vpp_l3
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
@@ -34,6 +35,11 @@ var (
 	ModelL3XC           models.KnownModel
 	ModelTeib           models.KnownModel
 	ModelVRRPEntry      models.KnownModel
+	ModelMplsTable      models.KnownModel
+	ModelMplsInterface  models.KnownModel
+	ModelMplsRoute      models.KnownModel
+	ModelMultipathRoute models.KnownModel
+	ModelMRoute         models.KnownModel
 )
 
 func init() {
@@ -45,6 +51,8 @@ func init() {
 	file_ligato_vpp_l3_l3xc_proto_init()
 	file_ligato_vpp_l3_teib_proto_init()
 	file_ligato_vpp_l3_vrrp_proto_init()
+	file_ligato_vpp_l3_mpls_proto_init()
+	file_ligato_vpp_l3_mroute_proto_init()
 
 	ModelARPEntry = models.Register(&ARPEntry{}, models.Spec{
 		Module:  ModuleName,
@@ -66,6 +74,25 @@ func init() {
 			`{{if .NextHopAddr}}gw/{{.NextHopAddr}}{{end}}`,
 	))
 
+	ModelMultipathRoute = models.Register(&MultipathRoute{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "multipath-route",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`vrf/{{.VrfId}}/`+
+			`{{with ipnet .DstNetwork}}{{printf "dst/%s/%d" .IP .MaskSize}}`+
+			`{{else}}{{printf "dst/%s" .DstNetwork}}{{end}}`,
+	))
+
+	ModelMRoute = models.Register(&MRoute{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "mroute",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`vrf/{{.VrfId}}/group/{{.GroupAddress}}`+
+			`{{if .SourceAddress}}/source/{{.SourceAddress}}{{end}}`,
+	))
+
 	ModelProxyARP = models.Register(&ProxyARP{}, models.Spec{
 		Module:  ModuleName,
 		Type:    "proxyarp-global",
@@ -117,6 +144,30 @@ func init() {
 	}, models.WithNameTemplate(
 		"{{.Interface}}/vrid/{{.VrId}}",
 	))
+
+	ModelMplsTable = models.Register(&MplsTable{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "mpls-table",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`id/{{.Id}}`,
+	))
+
+	ModelMplsInterface = models.Register(&MplsInterface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "mpls-interface",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{.Interface}}",
+	))
+
+	ModelMplsRoute = models.Register(&MplsRoute{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "mpls-route",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`table/{{.TableId}}/label/{{.Label}}/{{if .Eos}}eos{{else}}non-eos{{end}}`,
+	))
 }
 
 // ProxyARPKey returns key for global proxy arp
@@ -139,6 +190,24 @@ func RouteKey(iface string, vrf uint32, dstNet string, nextHopAddr string) strin
 	})
 }
 
+// MultipathRouteKey returns the key used to represent multipath route.
+func MultipathRouteKey(vrf uint32, dstNet string) string {
+	return models.Key(&MultipathRoute{
+		VrfId:      vrf,
+		DstNetwork: dstNet,
+	})
+}
+
+// MRouteKey returns the key used to represent IP multicast route.
+// Source address is empty for (*,G) route.
+func MRouteKey(vrf uint32, groupAddr, srcAddr string) string {
+	return models.Key(&MRoute{
+		VrfId:         vrf,
+		GroupAddress:  groupAddr,
+		SourceAddress: srcAddr,
+	})
+}
+
 // ArpEntryKey returns the key to store ARP entry
 func ArpEntryKey(iface, ipAddr string) string {
 	return models.Key(&ARPEntry{
@@ -222,6 +291,61 @@ func VrrpEntryKey(iface string, vrId uint32) string {
 	})
 }
 
+const (
+	// vrrpMasterKeyTemplate is a template for keys representing virtual routers
+	// in the master role, the VR ID goes first since interface name may contain
+	// forward slashes
+	vrrpMasterKeyPrefix   = "vpp/vrrp/master/vrid/"
+	vrrpMasterKeyTemplate = vrrpMasterKeyPrefix + "{vrid}/interface/{iface}"
+)
+
+// VrrpMasterKey returns the key representing virtual router in the master role.
+func VrrpMasterKey(iface string, vrID uint32) string {
+	key := strings.Replace(vrrpMasterKeyTemplate, "{vrid}", fmt.Sprint(vrID), 1)
+	key = strings.Replace(key, "{iface}", iface, 1)
+	return key
+}
+
+// ParseVrrpMasterKey parses key representing virtual router in the master role.
+func ParseVrrpMasterKey(key string) (iface string, vrID uint32, isVrrpMasterKey bool) {
+	suffix := strings.TrimPrefix(key, vrrpMasterKeyPrefix)
+	if suffix == key {
+		return "", 0, false
+	}
+	parts := strings.SplitN(suffix, "/interface/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", 0, false
+	}
+	id, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil || id == 0 {
+		return "", 0, false
+	}
+	return parts[1], uint32(id), true
+}
+
+// MplsTableKey returns the key used to represent configuration for VPP MPLS table.
+func MplsTableKey(id uint32) string {
+	return models.Key(&MplsTable{
+		Id: id,
+	})
+}
+
+// MplsInterfaceKey returns the key used to represent interface with enabled MPLS.
+func MplsInterfaceKey(iface string) string {
+	return models.Key(&MplsInterface{
+		Interface: iface,
+	})
+}
+
+// MplsRouteKey returns the key used to represent MPLS label route.
+func MplsRouteKey(tableID, label uint32, eos bool) string {
+	return models.Key(&MplsRoute{
+		TableId: tableID,
+		Label:   label,
+		Eos:     eos,
+	})
+}
+
 func getRouteKeyItem(items []string, itemLabel, nextItemLabel string) (value string, found bool) {
 	begin := len(items)
 	end := len(items)
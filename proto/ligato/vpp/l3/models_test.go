@@ -194,3 +194,152 @@ func TestParseRouteKey(t *testing.T) {
 		})
 	}
 }
+
+func TestMplsRouteKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		tableID     uint32
+		label       uint32
+		eos         bool
+		expectedKey string
+	}{
+		{
+			name:        "eos",
+			tableID:     0,
+			label:       100,
+			eos:         true,
+			expectedKey: "config/vpp/v2/mpls-route/table/0/label/100/eos",
+		},
+		{
+			name:        "non-eos",
+			tableID:     5,
+			label:       1048575,
+			expectedKey: "config/vpp/v2/mpls-route/table/5/label/1048575/non-eos",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			key := MplsRouteKey(test.tableID, test.label, test.eos)
+			Expect(key).To(Equal(test.expectedKey))
+		})
+	}
+}
+
+func TestMultipathRouteKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		vrf         uint32
+		dstNet      string
+		expectedKey string
+	}{
+		{
+			name:        "ipv4",
+			vrf:         0,
+			dstNet:      "10.10.0.0/24",
+			expectedKey: "config/vpp/v2/multipath-route/vrf/0/dst/10.10.0.0/24",
+		},
+		{
+			name:        "ipv6-not-normalized",
+			vrf:         2,
+			dstNet:      "2001:DB8::0001/32",
+			expectedKey: "config/vpp/v2/multipath-route/vrf/2/dst/2001:db8::/32",
+		},
+		{
+			name:        "invalid-dst",
+			vrf:         1,
+			dstNet:      "INVALID",
+			expectedKey: "config/vpp/v2/multipath-route/vrf/1/dst/<invalid>/0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			key := MultipathRouteKey(test.vrf, test.dstNet)
+			Expect(key).To(Equal(test.expectedKey))
+		})
+	}
+}
+
+func TestMRouteKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		vrf         uint32
+		groupAddr   string
+		srcAddr     string
+		expectedKey string
+	}{
+		{
+			name:        "star-g",
+			vrf:         0,
+			groupAddr:   "232.1.1.1",
+			expectedKey: "config/vpp/v2/mroute/vrf/0/group/232.1.1.1",
+		},
+		{
+			name:        "star-g-prefix",
+			vrf:         1,
+			groupAddr:   "232.0.0.0/8",
+			expectedKey: "config/vpp/v2/mroute/vrf/1/group/232.0.0.0/8",
+		},
+		{
+			name:        "s-g",
+			vrf:         2,
+			groupAddr:   "232.1.1.1",
+			srcAddr:     "10.0.0.1",
+			expectedKey: "config/vpp/v2/mroute/vrf/2/group/232.1.1.1/source/10.0.0.1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			key := MRouteKey(test.vrf, test.groupAddr, test.srcAddr)
+			Expect(key).To(Equal(test.expectedKey))
+		})
+	}
+}
+
+func TestVrrpMasterKey(t *testing.T) {
+	tests := []struct {
+		name            string
+		key             string
+		expectedIface   string
+		expectedVrID    uint32
+		expectedIsValid bool
+	}{
+		{
+			name:            "valid",
+			key:             VrrpMasterKey("tap0", 10),
+			expectedIface:   "tap0",
+			expectedVrID:    10,
+			expectedIsValid: true,
+		},
+		{
+			name:            "slash in interface name",
+			key:             "vpp/vrrp/master/vrid/1/interface/memif0/1",
+			expectedIface:   "memif0/1",
+			expectedVrID:    1,
+			expectedIsValid: true,
+		},
+		{
+			name: "invalid VR ID",
+			key:  "vpp/vrrp/master/vrid/256/interface/tap0",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/vrrp/master/vrid/1/interface/",
+		},
+		{
+			name: "VRRP entry key",
+			key:  VrrpEntryKey("tap0", 1),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			iface, vrID, isValid := ParseVrrpMasterKey(test.key)
+			Expect(isValid).To(Equal(test.expectedIsValid))
+			Expect(iface).To(Equal(test.expectedIface))
+			Expect(vrID).To(Equal(test.expectedVrID))
+		})
+	}
+}
@@ -102,6 +102,18 @@ type Route struct {
 	Preference uint32 `protobuf:"varint,7,opt,name=preference,proto3" json:"preference,omitempty"`
 	// Specifies VRF ID for the next hop lookup / recursive lookup
 	ViaVrfId uint32 `protobuf:"varint,8,opt,name=via_vrf_id,json=viaVrfId,proto3" json:"via_vrf_id,omitempty"`
+	// If enabled, the route is configured only while the BFD session
+	// with the next hop over the outgoing interface is up
+	// (see ligato.vpp.bfd.BfdSession). Requires both next_hop_addr
+	// and outgoing_interface to be set.
+	RequireBfdSession bool `protobuf:"varint,11,opt,name=require_bfd_session,json=requireBfdSession,proto3" json:"require_bfd_session,omitempty"`
+	// Labels imposed on the traffic forwarded via the next hop
+	// (the first one is the outermost). Used for labelled IP paths,
+	// e.g. towards remote L3VPN PE.
+	LabelStack []*MplsLabel `protobuf:"bytes,12,rep,name=label_stack,json=labelStack,proto3" json:"label_stack,omitempty"`
+	// If set, the route is configured only while the referenced
+	// virtual router is in the master role.
+	RequireVrrpMaster *Route_VRRPMaster `protobuf:"bytes,13,opt,name=require_vrrp_master,json=requireVrrpMaster,proto3" json:"require_vrrp_master,omitempty"`
 }
 
 func (x *Route) Reset() {
@@ -192,6 +204,268 @@ func (x *Route) GetViaVrfId() uint32 {
 	return 0
 }
 
+func (x *Route) GetRequireBfdSession() bool {
+	if x != nil {
+		return x.RequireBfdSession
+	}
+	return false
+}
+
+func (x *Route) GetLabelStack() []*MplsLabel {
+	if x != nil {
+		return x.LabelStack
+	}
+	return nil
+}
+
+func (x *Route) GetRequireVrrpMaster() *Route_VRRPMaster {
+	if x != nil {
+		return x.RequireVrrpMaster
+	}
+	return nil
+}
+
+// MultipathRoute is a static route defined together with the complete list
+// of its paths (ECMP/UCMP). Unlike Route, which configures a single path,
+// the whole path set is programmed with one request, therefore paths can be
+// added, removed or re-weighted without blackholing the traffic.
+// The same destination network should not be configured in the same VRF
+// by both Route and MultipathRoute.
+type MultipathRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF identifier. Non-zero VRF has to be explicitly created
+	// (see api/models/vpp/l3/vrf.proto)
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// Destination network defined by IP address and prefix (format: <address>/<prefix>).
+	DstNetwork string `protobuf:"bytes,2,opt,name=dst_network,json=dstNetwork,proto3" json:"dst_network,omitempty"`
+	// List of paths, at least one is required.
+	Paths []*MultipathRoute_Path `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *MultipathRoute) Reset() {
+	*x = MultipathRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_route_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultipathRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipathRoute) ProtoMessage() {}
+
+func (x *MultipathRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_route_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipathRoute.ProtoReflect.Descriptor instead.
+func (*MultipathRoute) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_route_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MultipathRoute) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *MultipathRoute) GetDstNetwork() string {
+	if x != nil {
+		return x.DstNetwork
+	}
+	return ""
+}
+
+func (x *MultipathRoute) GetPaths() []*MultipathRoute_Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// VRRPMaster identifies a virtual router (see ligato.vpp.l3.VRRPEntry).
+type Route_VRRPMaster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	VrId      uint32 `protobuf:"varint,2,opt,name=vr_id,json=vrId,proto3" json:"vr_id,omitempty"`
+}
+
+func (x *Route_VRRPMaster) Reset() {
+	*x = Route_VRRPMaster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_route_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Route_VRRPMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route_VRRPMaster) ProtoMessage() {}
+
+func (x *Route_VRRPMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_route_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route_VRRPMaster.ProtoReflect.Descriptor instead.
+func (*Route_VRRPMaster) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_route_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Route_VRRPMaster) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Route_VRRPMaster) GetVrId() uint32 {
+	if x != nil {
+		return x.VrId
+	}
+	return 0
+}
+
+type MultipathRoute_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the path, the meaning is the same as for Route.
+	// DROP path cannot be combined with other paths.
+	Type Route_RouteType `protobuf:"varint,1,opt,name=type,proto3,enum=ligato.vpp.l3.Route_RouteType" json:"type,omitempty"`
+	// Next hop address.
+	NextHopAddr string `protobuf:"bytes,2,opt,name=next_hop_addr,json=nextHopAddr,proto3" json:"next_hop_addr,omitempty"`
+	// Interface name of the outgoing interface.
+	OutgoingInterface string `protobuf:"bytes,3,opt,name=outgoing_interface,json=outgoingInterface,proto3" json:"outgoing_interface,omitempty"`
+	// Weight is used for unequal cost load balancing.
+	Weight uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Preference defines path preference. Lower preference is preferred.
+	Preference uint32 `protobuf:"varint,5,opt,name=preference,proto3" json:"preference,omitempty"`
+	// Specifies VRF ID for the next hop lookup (INTER_VRF path only).
+	ViaVrfId uint32 `protobuf:"varint,6,opt,name=via_vrf_id,json=viaVrfId,proto3" json:"via_vrf_id,omitempty"`
+	// Resolve the next hop only via host (/32 or /128) routes.
+	ResolveViaHost bool `protobuf:"varint,7,opt,name=resolve_via_host,json=resolveViaHost,proto3" json:"resolve_via_host,omitempty"`
+	// Resolve the next hop only via attached (connected) routes.
+	ResolveViaAttached bool `protobuf:"varint,8,opt,name=resolve_via_attached,json=resolveViaAttached,proto3" json:"resolve_via_attached,omitempty"`
+}
+
+func (x *MultipathRoute_Path) Reset() {
+	*x = MultipathRoute_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_route_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultipathRoute_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipathRoute_Path) ProtoMessage() {}
+
+func (x *MultipathRoute_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_route_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipathRoute_Path.ProtoReflect.Descriptor instead.
+func (*MultipathRoute_Path) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_route_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *MultipathRoute_Path) GetType() Route_RouteType {
+	if x != nil {
+		return x.Type
+	}
+	return Route_INTRA_VRF
+}
+
+func (x *MultipathRoute_Path) GetNextHopAddr() string {
+	if x != nil {
+		return x.NextHopAddr
+	}
+	return ""
+}
+
+func (x *MultipathRoute_Path) GetOutgoingInterface() string {
+	if x != nil {
+		return x.OutgoingInterface
+	}
+	return ""
+}
+
+func (x *MultipathRoute_Path) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *MultipathRoute_Path) GetPreference() uint32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
+func (x *MultipathRoute_Path) GetViaVrfId() uint32 {
+	if x != nil {
+		return x.ViaVrfId
+	}
+	return 0
+}
+
+func (x *MultipathRoute_Path) GetResolveViaHost() bool {
+	if x != nil {
+		return x.ResolveViaHost
+	}
+	return false
+}
+
+func (x *MultipathRoute_Path) GetResolveViaAttached() bool {
+	if x != nil {
+		return x.ResolveViaAttached
+	}
+	return false
+}
+
 var File_ligato_vpp_l3_route_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_l3_route_proto_rawDesc = []byte{
@@ -199,33 +473,80 @@ var file_ligato_vpp_l3_route_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69, 0x67,
 	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x02, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x32,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x6c, 0x33, 0x2f, 0x6d, 0x70, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8,
+	0x04, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72,
+	0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52,
+	0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48,
+	0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x0a, 0x76, 0x69, 0x61, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x76, 0x69, 0x61, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x62, 0x66, 0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x42, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33,
+	0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x5f, 0x76, 0x72, 0x72, 0x70, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x56, 0x52, 0x52, 0x50, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x56, 0x72, 0x72,
+	0x70, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x4b, 0x0a, 0x0a, 0x56, 0x52, 0x52, 0x50, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0a, 0x82, 0x7d, 0x07, 0x12, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x04,
+	0x76, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x52, 0x41, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x22, 0xd2, 0x03, 0x0a, 0x0e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x61, 0x74, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72,
+	0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52,
+	0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x38, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x61, 0x74, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x1a, 0xc6, 0x02, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x32,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75,
 	0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74,
-	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
-	0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a, 0x12,
-	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x76, 0x69, 0x61, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x69, 0x61, 0x56, 0x72, 0x66, 0x49,
-	0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d,
-	0x0a, 0x09, 0x49, 0x4e, 0x54, 0x52, 0x41, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x33, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a,
+	0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x76, 0x69, 0x61, 0x5f, 0x76, 0x72, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x69, 0x61, 0x56, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x76, 0x69,
+	0x61, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x69, 0x61, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x76, 0x69, 0x61, 0x5f, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x56, 0x69, 0x61, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x36,
+	0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x3b,
+	0x76, 0x70, 0x70, 0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -241,18 +562,26 @@ func file_ligato_vpp_l3_route_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_l3_route_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ligato_vpp_l3_route_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_vpp_l3_route_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_ligato_vpp_l3_route_proto_goTypes = []interface{}{
-	(Route_RouteType)(0), // 0: ligato.vpp.l3.Route.RouteType
-	(*Route)(nil),        // 1: ligato.vpp.l3.Route
+	(Route_RouteType)(0),        // 0: ligato.vpp.l3.Route.RouteType
+	(*Route)(nil),               // 1: ligato.vpp.l3.Route
+	(*MultipathRoute)(nil),      // 2: ligato.vpp.l3.MultipathRoute
+	(*Route_VRRPMaster)(nil),    // 3: ligato.vpp.l3.Route.VRRPMaster
+	(*MultipathRoute_Path)(nil), // 4: ligato.vpp.l3.MultipathRoute.Path
+	(*MplsLabel)(nil),           // 5: ligato.vpp.l3.MplsLabel
 }
 var file_ligato_vpp_l3_route_proto_depIdxs = []int32{
 	0, // 0: ligato.vpp.l3.Route.type:type_name -> ligato.vpp.l3.Route.RouteType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 1: ligato.vpp.l3.Route.label_stack:type_name -> ligato.vpp.l3.MplsLabel
+	3, // 2: ligato.vpp.l3.Route.require_vrrp_master:type_name -> ligato.vpp.l3.Route.VRRPMaster
+	4, // 3: ligato.vpp.l3.MultipathRoute.paths:type_name -> ligato.vpp.l3.MultipathRoute.Path
+	0, // 4: ligato.vpp.l3.MultipathRoute.Path.type:type_name -> ligato.vpp.l3.Route.RouteType
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_l3_route_proto_init() }
@@ -260,6 +589,7 @@ func file_ligato_vpp_l3_route_proto_init() {
 	if File_ligato_vpp_l3_route_proto != nil {
 		return
 	}
+	file_ligato_vpp_l3_mpls_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_ligato_vpp_l3_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Route); i {
@@ -273,6 +603,42 @@ func file_ligato_vpp_l3_route_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_l3_route_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultipathRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_l3_route_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route_VRRPMaster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_l3_route_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultipathRoute_Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -280,7 +646,7 @@ func file_ligato_vpp_l3_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_l3_route_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
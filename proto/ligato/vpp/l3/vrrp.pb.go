@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VRRPState_Role int32
+
+const (
+	VRRPState_INIT           VRRPState_Role = 0
+	VRRPState_BACKUP         VRRPState_Role = 1
+	VRRPState_MASTER         VRRPState_Role = 2
+	VRRPState_INTERFACE_DOWN VRRPState_Role = 3
+)
+
+// Enum value maps for VRRPState_Role.
+var (
+	VRRPState_Role_name = map[int32]string{
+		0: "INIT",
+		1: "BACKUP",
+		2: "MASTER",
+		3: "INTERFACE_DOWN",
+	}
+	VRRPState_Role_value = map[string]int32{
+		"INIT":           0,
+		"BACKUP":         1,
+		"MASTER":         2,
+		"INTERFACE_DOWN": 3,
+	}
+)
+
+func (x VRRPState_Role) Enum() *VRRPState_Role {
+	p := new(VRRPState_Role)
+	*p = x
+	return p
+}
+
+func (x VRRPState_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VRRPState_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_l3_vrrp_proto_enumTypes[0].Descriptor()
+}
+
+func (VRRPState_Role) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_l3_vrrp_proto_enumTypes[0]
+}
+
+func (x VRRPState_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VRRPState_Role.Descriptor instead.
+func (VRRPState_Role) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_vrrp_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // VRRPEntry represents Virtual Router desired state.
 type VRRPEntry struct {
 	state         protoimpl.MessageState
@@ -49,8 +101,9 @@ type VRRPEntry struct {
 	// the use of token ring functional addresses.
 	Unicast bool `protobuf:"varint,7,opt,name=unicast,proto3" json:"unicast,omitempty"`
 	// Ip address quantity should be > 0 and <= 255.
-	IpAddresses []string `protobuf:"bytes,8,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
-	Enabled     bool     `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IpAddresses       []string                      `protobuf:"bytes,8,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	Enabled           bool                          `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	TrackedInterfaces []*VRRPEntry_TrackedInterface `protobuf:"bytes,10,rep,name=tracked_interfaces,json=trackedInterfaces,proto3" json:"tracked_interfaces,omitempty"`
 }
 
 func (x *VRRPEntry) Reset() {
@@ -148,6 +201,192 @@ func (x *VRRPEntry) GetEnabled() bool {
 	return false
 }
 
+func (x *VRRPEntry) GetTrackedInterfaces() []*VRRPEntry_TrackedInterface {
+	if x != nil {
+		return x.TrackedInterfaces
+	}
+	return nil
+}
+
+// VRRPState describes the current state of a virtual router.
+type VRRPState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string         `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	VrId      uint32         `protobuf:"varint,2,opt,name=vr_id,json=vrId,proto3" json:"vr_id,omitempty"`
+	Role      VRRPState_Role `protobuf:"varint,3,opt,name=role,proto3,enum=ligato.vpp.l3.VRRPState_Role" json:"role,omitempty"`
+	// Unix timestamp of the last role change.
+	LastChange int64 `protobuf:"varint,4,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+}
+
+func (x *VRRPState) Reset() {
+	*x = VRRPState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_vrrp_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VRRPState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VRRPState) ProtoMessage() {}
+
+func (x *VRRPState) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_vrrp_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VRRPState.ProtoReflect.Descriptor instead.
+func (*VRRPState) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_vrrp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VRRPState) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *VRRPState) GetVrId() uint32 {
+	if x != nil {
+		return x.VrId
+	}
+	return 0
+}
+
+func (x *VRRPState) GetRole() VRRPState_Role {
+	if x != nil {
+		return x.Role
+	}
+	return VRRPState_INIT
+}
+
+func (x *VRRPState) GetLastChange() int64 {
+	if x != nil {
+		return x.LastChange
+	}
+	return 0
+}
+
+// VRRPNotification is sent when the role of a virtual router changes.
+type VRRPNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *VRRPState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *VRRPNotification) Reset() {
+	*x = VRRPNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_vrrp_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VRRPNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VRRPNotification) ProtoMessage() {}
+
+func (x *VRRPNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_vrrp_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VRRPNotification.ProtoReflect.Descriptor instead.
+func (*VRRPNotification) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_vrrp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VRRPNotification) GetState() *VRRPState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// TrackedInterface is an interface monitored by the virtual router.
+// While the interface is down, the priority of the virtual router
+// is decremented by the given value.
+type VRRPEntry_TrackedInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Priority  uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *VRRPEntry_TrackedInterface) Reset() {
+	*x = VRRPEntry_TrackedInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_vrrp_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VRRPEntry_TrackedInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VRRPEntry_TrackedInterface) ProtoMessage() {}
+
+func (x *VRRPEntry_TrackedInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_vrrp_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VRRPEntry_TrackedInterface.ProtoReflect.Descriptor instead.
+func (*VRRPEntry_TrackedInterface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_vrrp_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *VRRPEntry_TrackedInterface) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *VRRPEntry_TrackedInterface) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 var File_ligato_vpp_l3_vrrp_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_l3_vrrp_proto_rawDesc = []byte{
@@ -155,7 +394,7 @@ var file_ligato_vpp_l3_vrrp_proto_rawDesc = []byte{
 	0x76, 0x72, 0x72, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xab, 0x02, 0x0a, 0x09, 0x56, 0x52, 0x52, 0x50, 0x45, 0x6e, 0x74, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x03, 0x0a, 0x09, 0x56, 0x52, 0x52, 0x50, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
 	0x1f, 0x0a, 0x05, 0x76, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a,
@@ -174,11 +413,39 @@ var file_ligato_vpp_l3_vrrp_proto_rawDesc = []byte{
 	0x03, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
 	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x6c, 0x33, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x64, 0x12, 0x58, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x52,
+	0x52, 0x50, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x58, 0x0a, 0x10, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0a, 0x82, 0x7d, 0x07, 0x12, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd0, 0x01, 0x0a, 0x09, 0x56, 0x52, 0x52, 0x50, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x76, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x52, 0x52, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x04, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54,
+	0x45, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43,
+	0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x22, 0x42, 0x0a, 0x10, 0x56, 0x52, 0x52, 0x50,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x52, 0x52, 0x50,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x36, 0x5a, 0x34,
+	0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x3b, 0x76, 0x70,
+	0x70, 0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -193,16 +460,24 @@ func file_ligato_vpp_l3_vrrp_proto_rawDescGZIP() []byte {
 	return file_ligato_vpp_l3_vrrp_proto_rawDescData
 }
 
-var file_ligato_vpp_l3_vrrp_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_vpp_l3_vrrp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_vpp_l3_vrrp_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_ligato_vpp_l3_vrrp_proto_goTypes = []interface{}{
-	(*VRRPEntry)(nil), // 0: ligato.vpp.l3.VRRPEntry
+	(VRRPState_Role)(0),                // 0: ligato.vpp.l3.VRRPState.Role
+	(*VRRPEntry)(nil),                  // 1: ligato.vpp.l3.VRRPEntry
+	(*VRRPState)(nil),                  // 2: ligato.vpp.l3.VRRPState
+	(*VRRPNotification)(nil),           // 3: ligato.vpp.l3.VRRPNotification
+	(*VRRPEntry_TrackedInterface)(nil), // 4: ligato.vpp.l3.VRRPEntry.TrackedInterface
 }
 var file_ligato_vpp_l3_vrrp_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: ligato.vpp.l3.VRRPEntry.tracked_interfaces:type_name -> ligato.vpp.l3.VRRPEntry.TrackedInterface
+	0, // 1: ligato.vpp.l3.VRRPState.role:type_name -> ligato.vpp.l3.VRRPState.Role
+	2, // 2: ligato.vpp.l3.VRRPNotification.state:type_name -> ligato.vpp.l3.VRRPState
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_l3_vrrp_proto_init() }
@@ -223,19 +498,56 @@ func file_ligato_vpp_l3_vrrp_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_l3_vrrp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VRRPState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_l3_vrrp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VRRPNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_l3_vrrp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VRRPEntry_TrackedInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_l3_vrrp_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ligato_vpp_l3_vrrp_proto_goTypes,
 		DependencyIndexes: file_ligato_vpp_l3_vrrp_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_l3_vrrp_proto_enumTypes,
 		MessageInfos:      file_ligato_vpp_l3_vrrp_proto_msgTypes,
 	}.Build()
 	File_ligato_vpp_l3_vrrp_proto = out.File
@@ -0,0 +1,57 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_lcp
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.lcp"
+
+var (
+	ModelLCPInterfacePair models.KnownModel
+	ModelLCPGlobals       models.KnownModel
+)
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_lcp_lcp_proto_init()
+
+	ModelLCPInterfacePair = models.Register(&LCPInterfacePair{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "interface-pair",
+	}, models.WithNameTemplate("{{.Interface}}"))
+
+	ModelLCPGlobals = models.Register(&LCPGlobals{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "globals",
+	})
+}
+
+// InterfacePairKey returns the key under which linux-cp interface pair
+// of the given VPP interface is stored.
+func InterfacePairKey(iface string) string {
+	return models.Key(&LCPInterfacePair{
+		Interface: iface,
+	})
+}
+
+// GlobalsKey returns the key under which global linux-cp settings are stored.
+func GlobalsKey() string {
+	return models.Key(&LCPGlobals{})
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_lcp_test
+
+import (
+	"testing"
+
+	vpp_lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
+)
+
+func TestLCPKeys(t *testing.T) {
+	if key := vpp_lcp.InterfacePairKey("gbe0"); key != "config/vpp/lcp/v2/interface-pair/gbe0" {
+		t.Errorf("unexpected interface pair key: %q", key)
+	}
+	if key := vpp_lcp.InterfacePairKey("memif0/1"); key != "config/vpp/lcp/v2/interface-pair/memif0/1" {
+		t.Errorf("unexpected interface pair key: %q", key)
+	}
+	if key := vpp_lcp.GlobalsKey(); key != "config/vpp/lcp/v2/globals" {
+		t.Errorf("unexpected globals key: %q", key)
+	}
+}
@@ -32,11 +32,23 @@ var (
 	ModelNat44AddressPool models.KnownModel
 	ModelNat44VrfTable    models.KnownModel
 	ModelNat44VrfRoute    models.KnownModel
+
+	ModelNat64Global      models.KnownModel
+	ModelNat64Interface   models.KnownModel
+	ModelNat64AddressPool models.KnownModel
+	ModelNat64Prefix      models.KnownModel
+	ModelNat64StaticBib   models.KnownModel
+
+	ModelNat66Global        models.KnownModel
+	ModelNat66Interface     models.KnownModel
+	ModelNat66StaticMapping models.KnownModel
 )
 
 func init() {
 	// models.Register requires protoreflect capabilities, so we initialize them first
 	file_ligato_vpp_nat_nat_proto_init()
+	file_ligato_vpp_nat_nat64_proto_init()
+	file_ligato_vpp_nat_nat66_proto_init()
 
 	ModelNat44Global = models.Register(&Nat44Global{}, models.Spec{
 		Module:  ModuleName,
@@ -81,6 +93,60 @@ func init() {
 		Type:    "nat44-vrf-route",
 		Version: "v2",
 	}, models.WithNameTemplate("vrfid/{{.SrcVrfId}}/destvrfid/{{.DestVrfId}}"))
+
+	ModelNat64Global = models.Register(&Nat64Global{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-global",
+		Version: "v2",
+	})
+
+	ModelNat64Interface = models.Register(&Nat64Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat64AddressPool = models.Register(&Nat64AddressPool{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-pool",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"vrf/{{.VrfId}}"+
+			"/address/{{.FirstIp}}"+
+			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}",
+	))
+
+	ModelNat64Prefix = models.Register(&Nat64Prefix{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-prefix",
+		Version: "v2",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}/prefix/{{.Prefix}}"))
+
+	ModelNat64StaticBib = models.Register(&Nat64StaticBib{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-static-bib",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"vrf/{{.VrfId}}/protocol/{{.Protocol}}/inside/{{.InsideIp}}/port/{{.InsidePort}}",
+	))
+
+	ModelNat66Global = models.Register(&Nat66Global{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat66-global",
+		Version: "v2",
+	})
+
+	ModelNat66Interface = models.Register(&Nat66Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat66-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat66StaticMapping = models.Register(&Nat66StaticMapping{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat66-static-mapping",
+		Version: "v2",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}/local/{{.LocalIp}}"))
 }
 
 // GlobalNAT44Key returns key for Nat44Global.
@@ -110,6 +176,32 @@ func Nat44VrfTableKey(vrfId uint32) string {
 	})
 }
 
+// GlobalNAT64Key returns key for Nat64Global.
+func GlobalNAT64Key() string {
+	return models.Key(&Nat64Global{})
+}
+
+// Nat64InterfaceKey returns the key used in NB DB to store the configuration of the
+// given NAT64 interface.
+func Nat64InterfaceKey(name string) string {
+	return models.Key(&Nat64Interface{
+		Name: name,
+	})
+}
+
+// GlobalNAT66Key returns key for Nat66Global.
+func GlobalNAT66Key() string {
+	return models.Key(&Nat66Global{})
+}
+
+// Nat66InterfaceKey returns the key used in NB DB to store the configuration of the
+// given NAT66 interface.
+func Nat66InterfaceKey(name string) string {
+	return models.Key(&Nat66Interface{
+		Name: name,
+	})
+}
+
 /* NAT44 mode (derived) */
 
 const (
@@ -17,6 +17,8 @@ package vpp_nat
 import (
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 )
 
@@ -348,3 +350,74 @@ func TestNat44AddressPoolKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNat64Nat66Keys(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       proto.Message
+		expectedKey string
+	}{
+		{
+			"nat64 global",
+			&Nat64Global{BibBuckets: 1024},
+			"config/vpp/nat/v2/nat64-global",
+		},
+		{
+			"nat64 interface",
+			&Nat64Interface{Name: "tap0", NatInside: true},
+			"config/vpp/nat/v2/nat64-interface/tap0",
+		},
+		{
+			"nat64 pool with 1 IP address",
+			&Nat64AddressPool{VrfId: 0, FirstIp: "1.2.3.4"},
+			"config/vpp/nat/v2/nat64-pool/vrf/0/address/1.2.3.4",
+		},
+		{
+			"nat64 pool with multiple IP addresses",
+			&Nat64AddressPool{VrfId: 1, FirstIp: "1.2.3.4", LastIp: "1.2.3.10"},
+			"config/vpp/nat/v2/nat64-pool/vrf/1/address/1.2.3.4-1.2.3.10",
+		},
+		{
+			"nat64 prefix",
+			&Nat64Prefix{VrfId: 0, Prefix: "64:ff9b::/96"},
+			"config/vpp/nat/v2/nat64-prefix/vrf/0/prefix/64:ff9b::/96",
+		},
+		{
+			"nat64 static BIB",
+			&Nat64StaticBib{
+				VrfId:       0,
+				Protocol:    Nat64StaticBib_UDP,
+				InsideIp:    "2001:db8::1",
+				InsidePort:  53,
+				OutsideIp:   "10.0.0.1",
+				OutsidePort: 5353,
+			},
+			"config/vpp/nat/v2/nat64-static-bib/vrf/0/protocol/UDP/inside/2001:db8::1/port/53",
+		},
+		{
+			"nat66 global",
+			&Nat66Global{OutsideVrfId: 2},
+			"config/vpp/nat/v2/nat66-global",
+		},
+		{
+			"nat66 interface",
+			&Nat66Interface{Name: "tap1", NatOutside: true},
+			"config/vpp/nat/v2/nat66-interface/tap1",
+		},
+		{
+			"nat66 static mapping",
+			&Nat66StaticMapping{VrfId: 0, LocalIp: "fd00::1", ExternalIp: "2001:db8::1"},
+			"config/vpp/nat/v2/nat66-static-mapping/vrf/0/local/fd00::1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.model)
+			if key != test.expectedKey {
+				t.Errorf("failed key for %+v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.model, test.expectedKey, key)
+			}
+		})
+	}
+}
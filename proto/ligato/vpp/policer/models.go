@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_policer
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.policer"
+
+var ModelPolicer models.KnownModel
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_policer_policer_proto_init()
+
+	ModelPolicer = models.Register(&Policer{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "policer",
+	}, models.WithNameTemplate("{{.Name}}"))
+}
+
+// Key returns the key under which policer configuration is stored.
+func Key(name string) string {
+	return models.Key(&Policer{
+		Name: name,
+	})
+}
+
+const (
+	// policer to interface template is a derived value key
+	policerToInterfaceTemplate = "vpp/policer/{policer}/interface/{direction}/{iface}"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// ToInterfaceKey returns key representing policer applied on interface
+// in the given direction.
+func ToInterfaceKey(policer, iface string, direction Policer_Interface_Direction) string {
+	if policer == "" {
+		policer = InvalidKeyPart
+	}
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	key := policerToInterfaceTemplate
+	key = strings.Replace(key, "{policer}", policer, 1)
+	key = strings.Replace(key, "{direction}", strings.ToLower(direction.String()), 1)
+	key = strings.Replace(key, "{iface}", iface, 1)
+	return key
+}
+
+// ParseToInterfaceKey parses key representing policer applied on interface.
+func ParseToInterfaceKey(key string) (policer, iface string, direction Policer_Interface_Direction, isPolicerToInterface bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) >= 6 &&
+		parts[0] == "vpp" && parts[1] == "policer" && parts[3] == "interface" {
+		dirVal, validDir := Policer_Interface_Direction_value[strings.ToUpper(parts[4])]
+		policer = parts[2]
+		iface = strings.Join(parts[5:], "/")
+		if validDir && policer != "" && iface != "" {
+			return policer, iface, Policer_Interface_Direction(dirVal), true
+		}
+	}
+	return "", "", 0, false
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_policer_test
+
+import (
+	"testing"
+
+	vpp_policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+func TestPolicerKey(t *testing.T) {
+	key := vpp_policer.Key("policer1")
+	if key != "config/vpp/policer/v2/policer/policer1" {
+		t.Errorf("unexpected policer key: %q", key)
+	}
+}
+
+func TestPolicerToInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		policer     string
+		iface       string
+		direction   vpp_policer.Policer_Interface_Direction
+		expectedKey string
+	}{
+		{
+			name:        "input",
+			policer:     "policer1",
+			iface:       "tap0",
+			direction:   vpp_policer.Policer_Interface_INPUT,
+			expectedKey: "vpp/policer/policer1/interface/input/tap0",
+		},
+		{
+			name:        "output",
+			policer:     "policer1",
+			iface:       "memif0/1",
+			direction:   vpp_policer.Policer_Interface_OUTPUT,
+			expectedKey: "vpp/policer/policer1/interface/output/memif0/1",
+		},
+		{
+			name:        "empty interface",
+			policer:     "policer1",
+			iface:       "",
+			direction:   vpp_policer.Policer_Interface_INPUT,
+			expectedKey: "vpp/policer/policer1/interface/input/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := vpp_policer.ToInterfaceKey(test.policer, test.iface, test.direction)
+			if key != test.expectedKey {
+				t.Errorf("expected key: %q\tgot: %q", test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParsePolicerToInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name              string
+		key               string
+		expectedPolicer   string
+		expectedIface     string
+		expectedDirection vpp_policer.Policer_Interface_Direction
+		expectedIsValid   bool
+	}{
+		{
+			name:              "input",
+			key:               "vpp/policer/policer1/interface/input/tap0",
+			expectedPolicer:   "policer1",
+			expectedIface:     "tap0",
+			expectedDirection: vpp_policer.Policer_Interface_INPUT,
+			expectedIsValid:   true,
+		},
+		{
+			name:              "output with slash in interface name",
+			key:               "vpp/policer/policer1/interface/output/memif0/1",
+			expectedPolicer:   "policer1",
+			expectedIface:     "memif0/1",
+			expectedDirection: vpp_policer.Policer_Interface_OUTPUT,
+			expectedIsValid:   true,
+		},
+		{
+			name: "invalid direction",
+			key:  "vpp/policer/policer1/interface/both/tap0",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/policer/policer1/interface/input",
+		},
+		{
+			name: "policer key",
+			key:  "config/vpp/policer/v2/policer/policer1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policer, iface, direction, isValid := vpp_policer.ParseToInterfaceKey(test.key)
+			if isValid != test.expectedIsValid {
+				t.Errorf("expected isValid: %v\tgot: %v", test.expectedIsValid, isValid)
+			}
+			if policer != test.expectedPolicer {
+				t.Errorf("expected policer: %q\tgot: %q", test.expectedPolicer, policer)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected interface: %q\tgot: %q", test.expectedIface, iface)
+			}
+			if direction != test.expectedDirection {
+				t.Errorf("expected direction: %v\tgot: %v", test.expectedDirection, direction)
+			}
+		})
+	}
+}
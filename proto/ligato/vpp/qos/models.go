@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_qos
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.qos"
+
+var (
+	ModelQosEgressMap models.KnownModel
+	ModelQosRecord    models.KnownModel
+	ModelQosMark      models.KnownModel
+)
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_qos_qos_proto_init()
+
+	ModelQosEgressMap = models.Register(&QosEgressMap{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "egress-map",
+	}, models.WithNameTemplate("{{.Id}}"))
+
+	ModelQosRecord = models.Register(&QosRecord{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "record",
+	}, models.WithNameTemplate("{{.Interface}}/source/{{.InputSource}}"))
+
+	ModelQosMark = models.Register(&QosMark{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "mark",
+	}, models.WithNameTemplate("{{.Interface}}/source/{{.OutputSource}}"))
+}
+
+// EgressMapKey returns the key under which QoS egress map configuration is stored.
+func EgressMapKey(id uint32) string {
+	return models.Key(&QosEgressMap{
+		Id: id,
+	})
+}
+
+// RecordKey returns the key under which QoS record configuration is stored.
+func RecordKey(iface string, source Source) string {
+	return models.Key(&QosRecord{
+		Interface:   iface,
+		InputSource: source,
+	})
+}
+
+// MarkKey returns the key under which QoS mark configuration is stored.
+func MarkKey(iface string, source Source) string {
+	return models.Key(&QosMark{
+		Interface:    iface,
+		OutputSource: source,
+	})
+}
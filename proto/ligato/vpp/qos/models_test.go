@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_qos_test
+
+import (
+	"testing"
+
+	vpp_qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+)
+
+func TestEgressMapKey(t *testing.T) {
+	key := vpp_qos.EgressMapKey(5)
+	if key != "config/vpp/qos/v2/egress-map/5" {
+		t.Errorf("unexpected egress map key: %q", key)
+	}
+}
+
+func TestRecordKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		source      vpp_qos.Source
+		expectedKey string
+	}{
+		{
+			name:        "ip",
+			iface:       "tap0",
+			source:      vpp_qos.Source_IP,
+			expectedKey: "config/vpp/qos/v2/record/tap0/source/IP",
+		},
+		{
+			name:        "vlan with slash in interface name",
+			iface:       "memif0/1",
+			source:      vpp_qos.Source_VLAN,
+			expectedKey: "config/vpp/qos/v2/record/memif0/1/source/VLAN",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := vpp_qos.RecordKey(test.iface, test.source)
+			if key != test.expectedKey {
+				t.Errorf("expected key: %q\tgot: %q", test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestMarkKey(t *testing.T) {
+	key := vpp_qos.MarkKey("tap0", vpp_qos.Source_MPLS)
+	if key != "config/vpp/qos/v2/mark/tap0/source/MPLS" {
+		t.Errorf("unexpected mark key: %q", key)
+	}
+}
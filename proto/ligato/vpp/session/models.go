@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_session
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.session"
+
+// DefaultAppNamespace is the identifier of the app namespace created by VPP.
+const DefaultAppNamespace = "default"
+
+var (
+	ModelSessionGlobal models.KnownModel
+	ModelAppNamespace  models.KnownModel
+	ModelSessionRule   models.KnownModel
+)
+
+func init() {
+	// models.Register requires protoreflect capabilities, so we initialize them first
+	file_ligato_vpp_session_session_proto_init()
+
+	ModelSessionGlobal = models.Register(&SessionGlobal{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "global",
+	})
+
+	ModelAppNamespace = models.Register(&AppNamespace{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "app-namespace",
+	}, models.WithNameTemplate("{{.NamespaceId}}"))
+
+	ModelSessionRule = models.Register(&SessionRule{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "rule",
+	}, models.WithNameTemplate("{{.Tag}}"))
+}
+
+// GlobalKey returns the key under which the global session layer
+// configuration is stored.
+func GlobalKey() string {
+	return models.Key(&SessionGlobal{})
+}
+
+// AppNamespaceKey returns the key under which app namespace configuration is stored.
+func AppNamespaceKey(namespaceID string) string {
+	return models.Key(&AppNamespace{
+		NamespaceId: namespaceID,
+	})
+}
+
+// RuleKey returns the key under which session rule configuration is stored.
+func RuleKey(tag string) string {
+	return models.Key(&SessionRule{
+		Tag: tag,
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_session_test
+
+import (
+	"testing"
+
+	vpp_session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
+)
+
+func TestGlobalKey(t *testing.T) {
+	key := vpp_session.GlobalKey()
+	if key != "config/vpp/session/v2/global" {
+		t.Errorf("unexpected session global key: %q", key)
+	}
+}
+
+func TestAppNamespaceKey(t *testing.T) {
+	key := vpp_session.AppNamespaceKey("proxy")
+	if key != "config/vpp/session/v2/app-namespace/proxy" {
+		t.Errorf("unexpected app namespace key: %q", key)
+	}
+}
+
+func TestRuleKey(t *testing.T) {
+	key := vpp_session.RuleKey("deny-http")
+	if key != "config/vpp/session/v2/rule/deny-http" {
+		t.Errorf("unexpected session rule key: %q", key)
+	}
+}
@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/session/session.proto
+
+package vpp_session
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SessionRule_TransportProto int32
+
+const (
+	SessionRule_TCP  SessionRule_TransportProto = 0
+	SessionRule_UDP  SessionRule_TransportProto = 1
+	SessionRule_TLS  SessionRule_TransportProto = 2
+	SessionRule_QUIC SessionRule_TransportProto = 3
+)
+
+// Enum value maps for SessionRule_TransportProto.
+var (
+	SessionRule_TransportProto_name = map[int32]string{
+		0: "TCP",
+		1: "UDP",
+		2: "TLS",
+		3: "QUIC",
+	}
+	SessionRule_TransportProto_value = map[string]int32{
+		"TCP":  0,
+		"UDP":  1,
+		"TLS":  2,
+		"QUIC": 3,
+	}
+)
+
+func (x SessionRule_TransportProto) Enum() *SessionRule_TransportProto {
+	p := new(SessionRule_TransportProto)
+	*p = x
+	return p
+}
+
+func (x SessionRule_TransportProto) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionRule_TransportProto) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_session_session_proto_enumTypes[0].Descriptor()
+}
+
+func (SessionRule_TransportProto) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_session_session_proto_enumTypes[0]
+}
+
+func (x SessionRule_TransportProto) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionRule_TransportProto.Descriptor instead.
+func (SessionRule_TransportProto) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_session_session_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type SessionRule_Action int32
+
+const (
+	SessionRule_DROP  SessionRule_Action = 0
+	SessionRule_ALLOW SessionRule_Action = 1
+	// Redirect the matching sessions to the application (see app_index).
+	SessionRule_REDIRECT SessionRule_Action = 2
+)
+
+// Enum value maps for SessionRule_Action.
+var (
+	SessionRule_Action_name = map[int32]string{
+		0: "DROP",
+		1: "ALLOW",
+		2: "REDIRECT",
+	}
+	SessionRule_Action_value = map[string]int32{
+		"DROP":     0,
+		"ALLOW":    1,
+		"REDIRECT": 2,
+	}
+)
+
+func (x SessionRule_Action) Enum() *SessionRule_Action {
+	p := new(SessionRule_Action)
+	*p = x
+	return p
+}
+
+func (x SessionRule_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionRule_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_session_session_proto_enumTypes[1].Descriptor()
+}
+
+func (SessionRule_Action) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_session_session_proto_enumTypes[1]
+}
+
+func (x SessionRule_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionRule_Action.Descriptor instead.
+func (SessionRule_Action) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_session_session_proto_rawDescGZIP(), []int{2, 1}
+}
+
+type SessionRule_Scope int32
+
+const (
+	SessionRule_GLOBAL SessionRule_Scope = 0 // table of the VRF used by the namespace
+	SessionRule_LOCAL  SessionRule_Scope = 1 // table of the namespace
+	SessionRule_BOTH   SessionRule_Scope = 2
+)
+
+// Enum value maps for SessionRule_Scope.
+var (
+	SessionRule_Scope_name = map[int32]string{
+		0: "GLOBAL",
+		1: "LOCAL",
+		2: "BOTH",
+	}
+	SessionRule_Scope_value = map[string]int32{
+		"GLOBAL": 0,
+		"LOCAL":  1,
+		"BOTH":   2,
+	}
+)
+
+func (x SessionRule_Scope) Enum() *SessionRule_Scope {
+	p := new(SessionRule_Scope)
+	*p = x
+	return p
+}
+
+func (x SessionRule_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionRule_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_session_session_proto_enumTypes[2].Descriptor()
+}
+
+func (SessionRule_Scope) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_session_session_proto_enumTypes[2]
+}
+
+func (x SessionRule_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionRule_Scope.Descriptor instead.
+func (SessionRule_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_session_session_proto_rawDescGZIP(), []int{2, 2}
+}
+
+// SessionGlobal defines global configuration of the VPP host-stack
+// session layer.
+type SessionGlobal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enable the session layer (required by VCL applications).
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Enable the session API socket, allowing applications to attach
+	// through the socket of the app namespace (see AppNamespace.socket_path).
+	SocketApiEnabled bool `protobuf:"varint,2,opt,name=socket_api_enabled,json=socketApiEnabled,proto3" json:"socket_api_enabled,omitempty"`
+}
+
+func (x *SessionGlobal) Reset() {
+	*x = SessionGlobal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_session_session_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionGlobal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionGlobal) ProtoMessage() {}
+
+func (x *SessionGlobal) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_session_session_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionGlobal.ProtoReflect.Descriptor instead.
+func (*SessionGlobal) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_session_session_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SessionGlobal) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SessionGlobal) GetSocketApiEnabled() bool {
+	if x != nil {
+		return x.SocketApiEnabled
+	}
+	return false
+}
+
+// AppNamespace is an application namespace of the session layer, used to
+// isolate VCL applications and to bind them to an interface or VRF tables.
+type AppNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique namespace identifier (at most 63 characters, "default" is
+	// reserved for the namespace created by VPP).
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Secret that applications have to present to attach to the namespace.
+	Secret uint64 `protobuf:"varint,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Interface the namespace is attached to. VRF tables of the interface
+	// are used, therefore ip4_vrf/ip6_vrf must not be set with interface.
+	Interface string `protobuf:"bytes,3,opt,name=interface,proto3" json:"interface,omitempty"`
+	// VRF tables used by the namespace if no interface is set.
+	Ip4Vrf uint32 `protobuf:"varint,4,opt,name=ip4_vrf,json=ip4Vrf,proto3" json:"ip4_vrf,omitempty"`
+	Ip6Vrf uint32 `protobuf:"varint,5,opt,name=ip6_vrf,json=ip6Vrf,proto3" json:"ip6_vrf,omitempty"`
+	// Path of the socket applications attach through (requires
+	// SessionGlobal.socket_api_enabled). VPP default is used if empty.
+	SocketPath string `protobuf:"bytes,6,opt,name=socket_path,json=socketPath,proto3" json:"socket_path,omitempty"`
+}
+
+func (x *AppNamespace) Reset() {
+	*x = AppNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_session_session_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppNamespace) ProtoMessage() {}
+
+func (x *AppNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_session_session_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppNamespace.ProtoReflect.Descriptor instead.
+func (*AppNamespace) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_session_session_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppNamespace) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *AppNamespace) GetSecret() uint64 {
+	if x != nil {
+		return x.Secret
+	}
+	return 0
+}
+
+func (x *AppNamespace) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *AppNamespace) GetIp4Vrf() uint32 {
+	if x != nil {
+		return x.Ip4Vrf
+	}
+	return 0
+}
+
+func (x *AppNamespace) GetIp6Vrf() uint32 {
+	if x != nil {
+		return x.Ip6Vrf
+	}
+	return 0
+}
+
+func (x *AppNamespace) GetSocketPath() string {
+	if x != nil {
+		return x.SocketPath
+	}
+	return ""
+}
+
+// SessionRule is a rule of the session layer local (namespace) or global
+// (VRF) table applied to the sessions matching the 5-tuple.
+type SessionRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique rule identifier (stored as the rule tag in VPP, at most 63 characters).
+	Tag            string                     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	TransportProto SessionRule_TransportProto `protobuf:"varint,2,opt,name=transport_proto,json=transportProto,proto3,enum=ligato.vpp.session.SessionRule_TransportProto" json:"transport_proto,omitempty"`
+	// Local and remote network in the format <address>/<prefix-length>,
+	// both have to be of the same IP version.
+	LocalNetwork  string             `protobuf:"bytes,3,opt,name=local_network,json=localNetwork,proto3" json:"local_network,omitempty"`
+	LocalPort     uint32             `protobuf:"varint,4,opt,name=local_port,json=localPort,proto3" json:"local_port,omitempty"`
+	RemoteNetwork string             `protobuf:"bytes,5,opt,name=remote_network,json=remoteNetwork,proto3" json:"remote_network,omitempty"`
+	RemotePort    uint32             `protobuf:"varint,6,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	Action        SessionRule_Action `protobuf:"varint,7,opt,name=action,proto3,enum=ligato.vpp.session.SessionRule_Action" json:"action,omitempty"`
+	// Index of the application the matching sessions are redirected to
+	// (REDIRECT only).
+	AppIndex uint32            `protobuf:"varint,8,opt,name=app_index,json=appIndex,proto3" json:"app_index,omitempty"`
+	Scope    SessionRule_Scope `protobuf:"varint,9,opt,name=scope,proto3,enum=ligato.vpp.session.SessionRule_Scope" json:"scope,omitempty"`
+	// Namespace the rule belongs to, the default namespace is used if empty.
+	AppNamespace string `protobuf:"bytes,10,opt,name=app_namespace,json=appNamespace,proto3" json:"app_namespace,omitempty"`
+}
+
+func (x *SessionRule) Reset() {
+	*x = SessionRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_session_session_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRule) ProtoMessage() {}
+
+func (x *SessionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_session_session_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRule.ProtoReflect.Descriptor instead.
+func (*SessionRule) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_session_session_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SessionRule) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SessionRule) GetTransportProto() SessionRule_TransportProto {
+	if x != nil {
+		return x.TransportProto
+	}
+	return SessionRule_TCP
+}
+
+func (x *SessionRule) GetLocalNetwork() string {
+	if x != nil {
+		return x.LocalNetwork
+	}
+	return ""
+}
+
+func (x *SessionRule) GetLocalPort() uint32 {
+	if x != nil {
+		return x.LocalPort
+	}
+	return 0
+}
+
+func (x *SessionRule) GetRemoteNetwork() string {
+	if x != nil {
+		return x.RemoteNetwork
+	}
+	return ""
+}
+
+func (x *SessionRule) GetRemotePort() uint32 {
+	if x != nil {
+		return x.RemotePort
+	}
+	return 0
+}
+
+func (x *SessionRule) GetAction() SessionRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SessionRule_DROP
+}
+
+func (x *SessionRule) GetAppIndex() uint32 {
+	if x != nil {
+		return x.AppIndex
+	}
+	return 0
+}
+
+func (x *SessionRule) GetScope() SessionRule_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return SessionRule_GLOBAL
+}
+
+func (x *SessionRule) GetAppNamespace() string {
+	if x != nil {
+		return x.AppNamespace
+	}
+	return ""
+}
+
+var File_ligato_vpp_session_session_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_session_session_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x57, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x41,
+	0x70, 0x69, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x41, 0x70,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x34, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x70, 0x34, 0x56, 0x72, 0x66, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x70, 0x36, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69,
+	0x70, 0x36, 0x56, 0x72, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0xf5, 0x04, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x57, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x2a, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52,
+	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x28, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2a, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12,
+	0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x3e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0x35, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55,
+	0x44, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x51, 0x55, 0x49, 0x43, 0x10, 0x03, 0x22, 0x2b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x10, 0x02, 0x22, 0x28, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x02, 0x42, 0x40,
+	0x5a, 0x3e, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_session_session_proto_rawDescOnce sync.Once
+	file_ligato_vpp_session_session_proto_rawDescData = file_ligato_vpp_session_session_proto_rawDesc
+)
+
+func file_ligato_vpp_session_session_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_session_session_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_session_session_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_session_session_proto_rawDescData)
+	})
+	return file_ligato_vpp_session_session_proto_rawDescData
+}
+
+var file_ligato_vpp_session_session_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_vpp_session_session_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_session_session_proto_goTypes = []interface{}{
+	(SessionRule_TransportProto)(0), // 0: ligato.vpp.session.SessionRule.TransportProto
+	(SessionRule_Action)(0),         // 1: ligato.vpp.session.SessionRule.Action
+	(SessionRule_Scope)(0),          // 2: ligato.vpp.session.SessionRule.Scope
+	(*SessionGlobal)(nil),           // 3: ligato.vpp.session.SessionGlobal
+	(*AppNamespace)(nil),            // 4: ligato.vpp.session.AppNamespace
+	(*SessionRule)(nil),             // 5: ligato.vpp.session.SessionRule
+}
+var file_ligato_vpp_session_session_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.session.SessionRule.transport_proto:type_name -> ligato.vpp.session.SessionRule.TransportProto
+	1, // 1: ligato.vpp.session.SessionRule.action:type_name -> ligato.vpp.session.SessionRule.Action
+	2, // 2: ligato.vpp.session.SessionRule.scope:type_name -> ligato.vpp.session.SessionRule.Scope
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_session_session_proto_init() }
+func file_ligato_vpp_session_session_proto_init() {
+	if File_ligato_vpp_session_session_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_session_session_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionGlobal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_session_session_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_session_session_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_session_session_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_session_session_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_session_session_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_session_session_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_session_session_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_session_session_proto = out.File
+	file_ligato_vpp_session_session_proto_rawDesc = nil
+	file_ligato_vpp_session_session_proto_goTypes = nil
+	file_ligato_vpp_session_session_proto_depIdxs = nil
+}
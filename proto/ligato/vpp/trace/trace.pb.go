@@ -0,0 +1,1372 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/trace/trace.proto
+
+package vpp_trace
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StartTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Graph input nodes whose packets are traced (e.g. "af-packet-input",
+	// "memif-input", "dpdk-input").
+	InputNodes []string `protobuf:"bytes,1,rep,name=input_nodes,json=inputNodes,proto3" json:"input_nodes,omitempty"`
+	// Number of packets to trace on each input node. Zero means the default
+	// set by the agent configuration, larger values are capped by it.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Name of the classify table (configured via the classifier plugin)
+	// selecting which packets are traced. All packets are traced if empty.
+	ClassifyTable string `protobuf:"bytes,3,opt,name=classify_table,json=classifyTable,proto3" json:"classify_table,omitempty"`
+	// Verbose enables detailed per-node trace output.
+	Verbose bool `protobuf:"varint,4,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (x *StartTraceRequest) Reset() {
+	*x = StartTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTraceRequest) ProtoMessage() {}
+
+func (x *StartTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTraceRequest.ProtoReflect.Descriptor instead.
+func (*StartTraceRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartTraceRequest) GetInputNodes() []string {
+	if x != nil {
+		return x.InputNodes
+	}
+	return nil
+}
+
+func (x *StartTraceRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StartTraceRequest) GetClassifyTable() string {
+	if x != nil {
+		return x.ClassifyTable
+	}
+	return ""
+}
+
+func (x *StartTraceRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+type StartTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartTraceResponse) Reset() {
+	*x = StartTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTraceResponse) ProtoMessage() {}
+
+func (x *StartTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTraceResponse.ProtoReflect.Descriptor instead.
+func (*StartTraceResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{1}
+}
+
+type GetTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Clear removes the traced packets from VPP after they are returned.
+	Clear bool `protobuf:"varint,1,opt,name=clear,proto3" json:"clear,omitempty"`
+}
+
+func (x *GetTraceRequest) Reset() {
+	*x = GetTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTraceRequest) ProtoMessage() {}
+
+func (x *GetTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTraceRequest.ProtoReflect.Descriptor instead.
+func (*GetTraceRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTraceRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+// TracedPacket is a single packet captured by the VPP packet tracer.
+type TracedPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the VPP worker thread which processed the packet.
+	ThreadId uint32 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	// Position of the packet in the trace buffer of the thread.
+	Position uint32               `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Nodes    []*TracedPacket_Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *TracedPacket) Reset() {
+	*x = TracedPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracedPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracedPacket) ProtoMessage() {}
+
+func (x *TracedPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracedPacket.ProtoReflect.Descriptor instead.
+func (*TracedPacket) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TracedPacket) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *TracedPacket) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *TracedPacket) GetNodes() []*TracedPacket_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type GetTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packets []*TracedPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+}
+
+func (x *GetTraceResponse) Reset() {
+	*x = GetTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTraceResponse) ProtoMessage() {}
+
+func (x *GetTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTraceResponse.ProtoReflect.Descriptor instead.
+func (*GetTraceResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTraceResponse) GetPackets() []*TracedPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+type ClearTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearTraceRequest) Reset() {
+	*x = ClearTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearTraceRequest) ProtoMessage() {}
+
+func (x *ClearTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearTraceRequest.ProtoReflect.Descriptor instead.
+func (*ClearTraceRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{5}
+}
+
+type ClearTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearTraceResponse) Reset() {
+	*x = ClearTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearTraceResponse) ProtoMessage() {}
+
+func (x *ClearTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearTraceResponse.ProtoReflect.Descriptor instead.
+func (*ClearTraceResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{6}
+}
+
+type StartPcapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface whose packets are captured. Packets of all
+	// interfaces are captured if empty.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Capture received, transmitted and/or dropped packets. Received and
+	// transmitted packets are captured if none is selected.
+	CaptureRx   bool `protobuf:"varint,2,opt,name=capture_rx,json=captureRx,proto3" json:"capture_rx,omitempty"`
+	CaptureTx   bool `protobuf:"varint,3,opt,name=capture_tx,json=captureTx,proto3" json:"capture_tx,omitempty"`
+	CaptureDrop bool `protobuf:"varint,4,opt,name=capture_drop,json=captureDrop,proto3" json:"capture_drop,omitempty"`
+	// Maximum number of captured packets. Zero means the default set by the
+	// agent configuration, larger values are capped by it.
+	MaxPackets uint32 `protobuf:"varint,5,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	// Maximum number of captured bytes of each packet. Zero means the default
+	// set by the agent configuration, larger values are capped by it.
+	MaxBytesPerPacket uint32 `protobuf:"varint,6,opt,name=max_bytes_per_packet,json=maxBytesPerPacket,proto3" json:"max_bytes_per_packet,omitempty"`
+	// Duration of the capture (in seconds), after which the capture is stopped
+	// automatically. Zero means the default set by the agent configuration,
+	// larger values are capped by it.
+	DurationSec uint32 `protobuf:"varint,7,opt,name=duration_sec,json=durationSec,proto3" json:"duration_sec,omitempty"`
+}
+
+func (x *StartPcapRequest) Reset() {
+	*x = StartPcapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPcapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPcapRequest) ProtoMessage() {}
+
+func (x *StartPcapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPcapRequest.ProtoReflect.Descriptor instead.
+func (*StartPcapRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartPcapRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *StartPcapRequest) GetCaptureRx() bool {
+	if x != nil {
+		return x.CaptureRx
+	}
+	return false
+}
+
+func (x *StartPcapRequest) GetCaptureTx() bool {
+	if x != nil {
+		return x.CaptureTx
+	}
+	return false
+}
+
+func (x *StartPcapRequest) GetCaptureDrop() bool {
+	if x != nil {
+		return x.CaptureDrop
+	}
+	return false
+}
+
+func (x *StartPcapRequest) GetMaxPackets() uint32 {
+	if x != nil {
+		return x.MaxPackets
+	}
+	return 0
+}
+
+func (x *StartPcapRequest) GetMaxBytesPerPacket() uint32 {
+	if x != nil {
+		return x.MaxBytesPerPacket
+	}
+	return 0
+}
+
+func (x *StartPcapRequest) GetDurationSec() uint32 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+// PcapStatus describes the state of the pcap capture.
+type PcapStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Running is true while the capture is in progress.
+	Running bool `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	// Path of the pcap file written by VPP.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Time (unix seconds) when the capture was started.
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Time (unix seconds) when the running capture is stopped automatically
+	// or when the capture was stopped.
+	StopTime int64 `protobuf:"varint,4,opt,name=stop_time,json=stopTime,proto3" json:"stop_time,omitempty"`
+	// Limits applied to the capture.
+	MaxPackets        uint32 `protobuf:"varint,5,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	MaxBytesPerPacket uint32 `protobuf:"varint,6,opt,name=max_bytes_per_packet,json=maxBytesPerPacket,proto3" json:"max_bytes_per_packet,omitempty"`
+}
+
+func (x *PcapStatus) Reset() {
+	*x = PcapStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PcapStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PcapStatus) ProtoMessage() {}
+
+func (x *PcapStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PcapStatus.ProtoReflect.Descriptor instead.
+func (*PcapStatus) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PcapStatus) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *PcapStatus) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *PcapStatus) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PcapStatus) GetStopTime() int64 {
+	if x != nil {
+		return x.StopTime
+	}
+	return 0
+}
+
+func (x *PcapStatus) GetMaxPackets() uint32 {
+	if x != nil {
+		return x.MaxPackets
+	}
+	return 0
+}
+
+func (x *PcapStatus) GetMaxBytesPerPacket() uint32 {
+	if x != nil {
+		return x.MaxBytesPerPacket
+	}
+	return 0
+}
+
+type StartPcapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PcapStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StartPcapResponse) Reset() {
+	*x = StartPcapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPcapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPcapResponse) ProtoMessage() {}
+
+func (x *StartPcapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPcapResponse.ProtoReflect.Descriptor instead.
+func (*StartPcapResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartPcapResponse) GetStatus() *PcapStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StopPcapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopPcapRequest) Reset() {
+	*x = StopPcapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPcapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPcapRequest) ProtoMessage() {}
+
+func (x *StopPcapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPcapRequest.ProtoReflect.Descriptor instead.
+func (*StopPcapRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{10}
+}
+
+type StopPcapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PcapStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StopPcapResponse) Reset() {
+	*x = StopPcapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPcapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPcapResponse) ProtoMessage() {}
+
+func (x *StopPcapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPcapResponse.ProtoReflect.Descriptor instead.
+func (*StopPcapResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StopPcapResponse) GetStatus() *PcapStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetPcapStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPcapStatusRequest) Reset() {
+	*x = GetPcapStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPcapStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPcapStatusRequest) ProtoMessage() {}
+
+func (x *GetPcapStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPcapStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPcapStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{12}
+}
+
+type GetPcapStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PcapStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetPcapStatusResponse) Reset() {
+	*x = GetPcapStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPcapStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPcapStatusResponse) ProtoMessage() {}
+
+func (x *GetPcapStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPcapStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPcapStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPcapStatusResponse) GetStatus() *PcapStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DownloadPcapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DownloadPcapRequest) Reset() {
+	*x = DownloadPcapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadPcapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadPcapRequest) ProtoMessage() {}
+
+func (x *DownloadPcapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadPcapRequest.ProtoReflect.Descriptor instead.
+func (*DownloadPcapRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{14}
+}
+
+type DownloadPcapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chunk of the pcap file content.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DownloadPcapResponse) Reset() {
+	*x = DownloadPcapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadPcapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadPcapResponse) ProtoMessage() {}
+
+func (x *DownloadPcapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadPcapResponse.ProtoReflect.Descriptor instead.
+func (*DownloadPcapResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DownloadPcapResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Node is the trace record of a single graph node traversed by the packet.
+type TracedPacket_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time of the packet arrival to the node as printed by VPP.
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Name of the graph node.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Node-specific trace data.
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TracedPacket_Node) Reset() {
+	*x = TracedPacket_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_trace_trace_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracedPacket_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracedPacket_Node) ProtoMessage() {}
+
+func (x *TracedPacket_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_trace_trace_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracedPacket_Node.ProtoReflect.Descriptor instead.
+func (*TracedPacket_Node) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_trace_trace_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *TracedPacket_Node) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *TracedPacket_Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TracedPacket_Node) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_ligato_vpp_trace_trace_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_trace_trace_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x22, 0x8b, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0x14,
+	0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0xd0, 0x01,
+	0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x1a, 0x4c, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x13,
+	0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x54, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x50, 0x63, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x63,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x50, 0x63,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x11, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x63, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x50, 0x63, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x50, 0x63, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x63, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x2e, 0x50, 0x63, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x8d, 0x05, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x63, 0x61, 0x70,
+	0x12, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x63, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x53,
+	0x74, 0x6f, 0x70, 0x50, 0x63, 0x61, 0x70, 0x12, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50,
+	0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x63, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x63, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x63, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x63, 0x61, 0x70, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x3b, 0x76, 0x70, 0x70, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_trace_trace_proto_rawDescOnce sync.Once
+	file_ligato_vpp_trace_trace_proto_rawDescData = file_ligato_vpp_trace_trace_proto_rawDesc
+)
+
+func file_ligato_vpp_trace_trace_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_trace_trace_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_trace_trace_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_trace_trace_proto_rawDescData)
+	})
+	return file_ligato_vpp_trace_trace_proto_rawDescData
+}
+
+var file_ligato_vpp_trace_trace_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_ligato_vpp_trace_trace_proto_goTypes = []interface{}{
+	(*StartTraceRequest)(nil),     // 0: ligato.vpp.trace.StartTraceRequest
+	(*StartTraceResponse)(nil),    // 1: ligato.vpp.trace.StartTraceResponse
+	(*GetTraceRequest)(nil),       // 2: ligato.vpp.trace.GetTraceRequest
+	(*TracedPacket)(nil),          // 3: ligato.vpp.trace.TracedPacket
+	(*GetTraceResponse)(nil),      // 4: ligato.vpp.trace.GetTraceResponse
+	(*ClearTraceRequest)(nil),     // 5: ligato.vpp.trace.ClearTraceRequest
+	(*ClearTraceResponse)(nil),    // 6: ligato.vpp.trace.ClearTraceResponse
+	(*StartPcapRequest)(nil),      // 7: ligato.vpp.trace.StartPcapRequest
+	(*PcapStatus)(nil),            // 8: ligato.vpp.trace.PcapStatus
+	(*StartPcapResponse)(nil),     // 9: ligato.vpp.trace.StartPcapResponse
+	(*StopPcapRequest)(nil),       // 10: ligato.vpp.trace.StopPcapRequest
+	(*StopPcapResponse)(nil),      // 11: ligato.vpp.trace.StopPcapResponse
+	(*GetPcapStatusRequest)(nil),  // 12: ligato.vpp.trace.GetPcapStatusRequest
+	(*GetPcapStatusResponse)(nil), // 13: ligato.vpp.trace.GetPcapStatusResponse
+	(*DownloadPcapRequest)(nil),   // 14: ligato.vpp.trace.DownloadPcapRequest
+	(*DownloadPcapResponse)(nil),  // 15: ligato.vpp.trace.DownloadPcapResponse
+	(*TracedPacket_Node)(nil),     // 16: ligato.vpp.trace.TracedPacket.Node
+}
+var file_ligato_vpp_trace_trace_proto_depIdxs = []int32{
+	16, // 0: ligato.vpp.trace.TracedPacket.nodes:type_name -> ligato.vpp.trace.TracedPacket.Node
+	3,  // 1: ligato.vpp.trace.GetTraceResponse.packets:type_name -> ligato.vpp.trace.TracedPacket
+	8,  // 2: ligato.vpp.trace.StartPcapResponse.status:type_name -> ligato.vpp.trace.PcapStatus
+	8,  // 3: ligato.vpp.trace.StopPcapResponse.status:type_name -> ligato.vpp.trace.PcapStatus
+	8,  // 4: ligato.vpp.trace.GetPcapStatusResponse.status:type_name -> ligato.vpp.trace.PcapStatus
+	0,  // 5: ligato.vpp.trace.TraceService.StartTrace:input_type -> ligato.vpp.trace.StartTraceRequest
+	2,  // 6: ligato.vpp.trace.TraceService.GetTrace:input_type -> ligato.vpp.trace.GetTraceRequest
+	5,  // 7: ligato.vpp.trace.TraceService.ClearTrace:input_type -> ligato.vpp.trace.ClearTraceRequest
+	7,  // 8: ligato.vpp.trace.TraceService.StartPcap:input_type -> ligato.vpp.trace.StartPcapRequest
+	10, // 9: ligato.vpp.trace.TraceService.StopPcap:input_type -> ligato.vpp.trace.StopPcapRequest
+	12, // 10: ligato.vpp.trace.TraceService.GetPcapStatus:input_type -> ligato.vpp.trace.GetPcapStatusRequest
+	14, // 11: ligato.vpp.trace.TraceService.DownloadPcap:input_type -> ligato.vpp.trace.DownloadPcapRequest
+	1,  // 12: ligato.vpp.trace.TraceService.StartTrace:output_type -> ligato.vpp.trace.StartTraceResponse
+	4,  // 13: ligato.vpp.trace.TraceService.GetTrace:output_type -> ligato.vpp.trace.GetTraceResponse
+	6,  // 14: ligato.vpp.trace.TraceService.ClearTrace:output_type -> ligato.vpp.trace.ClearTraceResponse
+	9,  // 15: ligato.vpp.trace.TraceService.StartPcap:output_type -> ligato.vpp.trace.StartPcapResponse
+	11, // 16: ligato.vpp.trace.TraceService.StopPcap:output_type -> ligato.vpp.trace.StopPcapResponse
+	13, // 17: ligato.vpp.trace.TraceService.GetPcapStatus:output_type -> ligato.vpp.trace.GetPcapStatusResponse
+	15, // 18: ligato.vpp.trace.TraceService.DownloadPcap:output_type -> ligato.vpp.trace.DownloadPcapResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_trace_trace_proto_init() }
+func file_ligato_vpp_trace_trace_proto_init() {
+	if File_ligato_vpp_trace_trace_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_trace_trace_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTraceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracedPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTraceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearTraceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPcapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PcapStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPcapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPcapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPcapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPcapStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPcapStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadPcapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadPcapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_trace_trace_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracedPacket_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_trace_trace_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ligato_vpp_trace_trace_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_trace_trace_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_trace_trace_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_trace_trace_proto = out.File
+	file_ligato_vpp_trace_trace_proto_rawDesc = nil
+	file_ligato_vpp_trace_trace_proto_goTypes = nil
+	file_ligato_vpp_trace_trace_proto_depIdxs = nil
+}
@@ -17,12 +17,19 @@ package vpp
 import (
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	vpp_classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+	vpp_cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lcp"
 	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+	vpp_policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
 	vpp_punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
+	vpp_qos "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/qos"
+	vpp_session "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/session"
 	vpp_srv6 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/srv6"
 	vpp_stn "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/stn"
 	vpp_wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
@@ -51,6 +58,15 @@ type (
 	L3XConnect  = vpp_l3.L3XConnect
 	DHCPProxy   = vpp_l3.DHCPProxy
 
+	// L3 multipath & multicast routes
+	MultipathRoute = vpp_l3.MultipathRoute
+	MRoute         = vpp_l3.MRoute
+
+	// MPLS
+	MplsTable     = vpp_l3.MplsTable
+	MplsInterface = vpp_l3.MplsInterface
+	MplsRoute     = vpp_l3.MplsRoute
+
 	// NAT
 	NAT44Global      = vpp_nat.Nat44Global
 	DNAT44           = vpp_nat.DNat44
@@ -59,10 +75,22 @@ type (
 	Nat44EdVrfTable  = vpp_nat.Nat44VrfTable
 	Nat44EdVrfRoute  = vpp_nat.Nat44VrfRoute
 
+	// NAT64 & NAT66
+	Nat64Global        = vpp_nat.Nat64Global
+	Nat64Interface     = vpp_nat.Nat64Interface
+	Nat64AddressPool   = vpp_nat.Nat64AddressPool
+	Nat64Prefix        = vpp_nat.Nat64Prefix
+	Nat64StaticBib     = vpp_nat.Nat64StaticBib
+	Nat66Global        = vpp_nat.Nat66Global
+	Nat66Interface     = vpp_nat.Nat66Interface
+	Nat66StaticMapping = vpp_nat.Nat66StaticMapping
+
 	// IPSec
-	IPSecSPD = vpp_ipsec.SecurityPolicyDatabase
-	IPSecSP  = vpp_ipsec.SecurityPolicy
-	IPSecSA  = vpp_ipsec.SecurityAssociation
+	IPSecSPD          = vpp_ipsec.SecurityPolicyDatabase
+	IPSecSP           = vpp_ipsec.SecurityPolicy
+	IPSecSA           = vpp_ipsec.SecurityAssociation
+	IPSecIKEv2Profile = vpp_ipsec.IKEv2Profile
+	IPSecGlobal       = vpp_ipsec.IPSecGlobal
 
 	// Punt
 	PuntIPRedirect = vpp_punt.IPRedirect
@@ -80,4 +108,35 @@ type (
 
 	// Wireguard
 	WgPeer = vpp_wg.Peer
+
+	// Policer
+	Policer = vpp_policer.Policer
+
+	// BFD
+	BfdSession = vpp_bfd.BfdSession
+	BfdAuthKey = vpp_bfd.BfdAuthKey
+
+	// Linux-CP
+	LCPGlobals       = vpp_lcp.LCPGlobals
+	LCPInterfacePair = vpp_lcp.LCPInterfacePair
+
+	// CNAT
+	CnatTranslation         = vpp_cnat.Translation
+	CnatSnatPolicy          = vpp_cnat.SnatPolicy
+	CnatSnatPolicyInterface = vpp_cnat.SnatPolicyInterface
+
+	// Classifier
+	ClassifyTable   = vpp_classifier.ClassifyTable
+	ClassifySession = vpp_classifier.ClassifySession
+	PolicerClassify = vpp_classifier.PolicerClassify
+
+	// QoS
+	QosEgressMap = vpp_qos.QosEgressMap
+	QosRecord    = vpp_qos.QosRecord
+	QosMark      = vpp_qos.QosMark
+
+	// Session layer
+	SessionGlobal = vpp_session.SessionGlobal
+	AppNamespace  = vpp_session.AppNamespace
+	SessionRule   = vpp_session.SessionRule
 )